@@ -5,12 +5,22 @@ import (
 	"fmt"
 	"net"
 	"os"
+	"strings"
 	"time"
 
+	"github.com/go-redis/redis"
 	log "github.com/sirupsen/logrus"
 	"github.com/spf13/cobra"
+	apiv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation"
 	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/kubernetes/scheme"
+	typedcorev1 "k8s.io/client-go/kubernetes/typed/core/v1"
 	"k8s.io/client-go/tools/clientcmd"
+	"k8s.io/client-go/tools/leaderelection"
+	"k8s.io/client-go/tools/leaderelection/resourcelock"
+	"k8s.io/client-go/tools/record"
 
 	// load the gcp plugin (required to authenticate against GKE clusters).
 	_ "k8s.io/client-go/plugin/pkg/client/auth/gcp"
@@ -23,6 +33,7 @@ import (
 	"github.com/argoproj/argo-cd/errors"
 	appclientset "github.com/argoproj/argo-cd/pkg/client/clientset/versioned"
 	"github.com/argoproj/argo-cd/reposerver"
+	"github.com/argoproj/argo-cd/util/cache"
 	"github.com/argoproj/argo-cd/util/cli"
 	"github.com/argoproj/argo-cd/util/stats"
 	"github.com/argoproj/argo-cd/util/tls"
@@ -33,24 +44,62 @@ const (
 	cliName = "argocd-application-controller"
 	// Default time in seconds for application resync period
 	defaultAppResyncPeriod = 180
+	// Default duration live resources of a cluster are shared between application comparisons
+	defaultLiveStateBatchWindow = 10 * time.Second
+	// Default duration sync artifacts are kept for
+	defaultSyncArtifactsExpiration = 7 * 24 * time.Hour
+	// Default port of the metrics server
+	defaultMetricsPort = 8082
+	// Default max number of deployments kept in the history of an application
+	defaultHistoryLimit = 5
+	// Default name of the config map holding the leader election lock
+	defaultLeaderElectionLock = "argocd-application-controller-leader"
 )
 
+// leaderElectionOptions configures the election of the replica of the controller which processes the
+// applications
+type leaderElectionOptions struct {
+	enabled       bool
+	lockName      string
+	leaseDuration time.Duration
+	renewDeadline time.Duration
+	retryPeriod   time.Duration
+}
+
 func newCommand() *cobra.Command {
 	var (
 		clientConfig           clientcmd.ClientConfig
 		appResyncPeriod        int64
 		repoServerAddress      string
+		repoServerResolver     string
 		statusProcessors       int
 		operationProcessors    int
 		logLevel               string
+		logFormat              string
 		glogLevel              int
 		tlsConfigCustomizerSrc func() (tls.ConfigCustomizer, error)
+		liveStateBatchWindow   time.Duration
+		snapshotMaxAge         time.Duration
+		liveStateCache         bool
+		readOnly               bool
+		syncArtifacts          bool
+		syncArtifactsExpiry    time.Duration
+		redisAddress           string
+		metricsPort            int
+		applyConcurrency       int64
+		historyRetention       controller.HistoryRetention
+		instanceID             string
+		queueItemMinInterval   time.Duration
+		persistDiffs           bool
+		staleOperationTimeout  time.Duration
+		leaderElection         leaderElectionOptions
 	)
 	var command = cobra.Command{
 		Use:   cliName,
 		Short: "application-controller is a controller to operate on applications CRD",
 		RunE: func(c *cobra.Command, args []string) error {
 			cli.SetLogLevel(logLevel)
+			cli.SetLogFormat(logFormat)
 			cli.SetGLogLevel(glogLevel)
 
 			config, err := clientConfig.ClientConfig()
@@ -62,25 +111,46 @@ func newCommand() *cobra.Command {
 			namespace, _, err := clientConfig.Namespace()
 			errors.CheckError(err)
 
+			if statusProcessors < 1 || operationProcessors < 1 {
+				log.Fatalf("The number of status and operation processors must be at least 1")
+			}
+			if leaderElection.enabled && leaderElection.renewDeadline >= leaderElection.leaseDuration {
+				log.Fatalf("The leader election renew deadline must be less than the lease duration")
+			}
+			if errs := validation.IsValidLabelValue(instanceID); len(errs) > 0 {
+				log.Fatalf("Invalid instance ID '%s': %s", instanceID, strings.Join(errs, "; "))
+			}
+
 			resyncDuration := time.Duration(appResyncPeriod) * time.Second
-			repoClientset := reposerver.NewRepositoryServerClientset(repoServerAddress)
+			repoClientset, err := reposerver.NewRepositoryServerClientsetWithResolver(repoServerAddress, repoServerResolver, kubeClient, namespace)
+			errors.CheckError(err)
 			appController := controller.NewApplicationController(
 				namespace,
 				kubeClient,
 				appClient,
 				repoClientset,
-				resyncDuration)
+				resyncDuration,
+				liveStateBatchWindow,
+				newLiveStateSnapshots(snapshotMaxAge, redisAddress),
+				liveStateCache,
+				readOnly,
+				newSyncArtifactsCache(syncArtifacts, syncArtifactsExpiry, redisAddress),
+				applyConcurrency,
+				historyRetention,
+				instanceID,
+				queueItemMinInterval,
+				newDiffStore(persistDiffs, redisAddress),
+				staleOperationTimeout)
 
 			ctx, cancel := context.WithCancel(context.Background())
 			defer cancel()
 
-			log.Infof("Application Controller (version: %s) starting (namespace: %s)", argocd.GetVersion(), namespace)
+			log.Infof("Application Controller (version: %s) starting (namespace: %s, instance: %s)", argocd.GetVersion(), namespace, instanceID)
 			stats.RegisterStackDumper()
 			stats.StartStatsTicker(10 * time.Minute)
 			stats.RegisterHeapDumper("memprofile")
 
-			go appController.Run(ctx, statusProcessors, operationProcessors)
-			go func() {
+			serveGRPC := func() {
 				tlsConfigCustomizer, err := tlsConfigCustomizerSrc()
 				errors.CheckError(err)
 				server, err := appController.CreateGRPC(tlsConfigCustomizer)
@@ -92,6 +162,23 @@ func newCommand() *cobra.Command {
 
 				err = server.Serve(listener)
 				errors.CheckError(err)
+			}
+			if leaderElection.enabled {
+				// the gRPC API is only served by the leader, since the followers do not refresh the
+				// applications. The readiness probe of the gRPC port keeps the followers out of the
+				// endpoints of the controller service.
+				go runLeaderElection(ctx, kubeClient, namespace, leaderElection, func(ctx context.Context) {
+					go serveGRPC()
+					appController.Run(ctx, statusProcessors, operationProcessors)
+				})
+			} else {
+				go appController.Run(ctx, statusProcessors, operationProcessors)
+				go serveGRPC()
+			}
+			go func() {
+				metricsServ := appController.NewMetricsServer(metricsPort)
+				log.Infof("application-controller metrics serving on port %d", metricsPort)
+				errors.CheckError(metricsServ.ListenAndServe())
 			}()
 			// Wait forever
 			select {}
@@ -101,14 +188,115 @@ func newCommand() *cobra.Command {
 	clientConfig = cli.AddKubectlFlagsToCmd(&command)
 	command.Flags().Int64Var(&appResyncPeriod, "app-resync", defaultAppResyncPeriod, "Time period in seconds for application resync.")
 	command.Flags().StringVar(&repoServerAddress, "repo-server", common.DefaultRepoServerAddr, "Repo server address.")
+	command.Flags().StringVar(&repoServerResolver, "repo-server-resolver", reposerver.ResolverDNS, "Resolver of the repo server address. One of: dns|endpoints")
 	command.Flags().IntVar(&statusProcessors, "status-processors", 1, "Number of application status processors")
 	command.Flags().IntVar(&operationProcessors, "operation-processors", 1, "Number of application operation processors")
+	command.Flags().DurationVar(&queueItemMinInterval, "queue-item-min-interval", 0, "Minimum duration between two refreshes, or two operation steps, of the same application. Limits the load of applications which are requeued often. Unlimited if 0")
+	command.Flags().IntVar(&metricsPort, "metrics-port", defaultMetricsPort, "Port of the metrics server")
 	command.Flags().StringVar(&logLevel, "loglevel", "info", "Set the logging level. One of: debug|info|warn|error")
+	command.Flags().StringVar(&logFormat, "logformat", "text", "Set the logging format. One of: text|json")
 	command.Flags().IntVar(&glogLevel, "gloglevel", 0, "Set the glog logging level")
+	command.Flags().DurationVar(&liveStateBatchWindow, "live-state-batch-window", defaultLiveStateBatchWindow, "Duration live resources of a cluster are shared between application comparisons. Set to 0 to query resources per application")
+	command.Flags().DurationVar(&snapshotMaxAge, "live-state-snapshot-max-age", 0, "Max age of the snapshots of the live state of clusters, which are stored in redis and rehydrated after controller restarts. Set to 0 to disable snapshots")
+	command.Flags().BoolVar(&liveStateCache, "live-state-cache", false, "Watch the live resources of clusters and keep them in memory, instead of listing them for application comparisons. Takes precedence over the live state batch window and snapshots")
+	command.Flags().BoolVar(&readOnly, "read-only", false, "Only report the sync and health status of applications. Operations, automated syncs and cascaded deletions are refused")
+	command.Flags().BoolVar(&syncArtifacts, "sync-artifacts", false, "Store rendered manifests applied by each successful sync")
+	command.Flags().DurationVar(&syncArtifactsExpiry, "sync-artifacts-expiration", defaultSyncArtifactsExpiration, "Duration sync artifacts are kept for")
+	command.Flags().StringVar(&redisAddress, "redis", "", "Redis server address used to store sync artifacts, live state snapshots and resource diffs. Artifacts are kept in memory if not specified")
+	command.Flags().BoolVar(&persistDiffs, "persist-diffs", false, "Persist the diffs of the live resources against their target state in redis, so that a restarted controller does not diff every resource again")
+	command.Flags().IntVar(&historyRetention.Limit, "history-limit", defaultHistoryLimit, "Max number of deployments kept in the history of an application")
+	command.Flags().DurationVar(&historyRetention.MaxAge, "history-max-age", 0, "Duration after which deployments are removed from the history of an application. The latest deployment is always kept. Set to 0 to keep deployments regardless of their age")
+	command.Flags().IntVar(&historyRetention.OperationHistoryLimit, "operation-history-limit", 0, "Max number of completed operations kept in the operation history of an application, including the failed ones. The operation history is not kept if 0")
+	command.Flags().DurationVar(&historyRetention.CompactAfter, "operation-compact-after", 0, "Duration after which the resource results of a completed operation are compacted to a summary. Set to 0 to never compact them. Can be overridden per application with the "+common.AnnotationKeyOperationCompactAfter+" annotation")
+	command.Flags().Int64Var(&applyConcurrency, "apply-concurrency", 0, "Max number of resources pruned or applied in parallel by all syncs of the controller. Unlimited if 0")
+	command.Flags().DurationVar(&staleOperationTimeout, "stale-operation-timeout", 0, "Duration after which a running operation whose state did not change since before the controller started is failed instead of resumed. Operations are always resumed if 0")
+	command.Flags().BoolVar(&leaderElection.enabled, "leader-elect", false, "Elect a leader among the replicas of the controller, which is the only replica processing applications. Enables running several replicas for fast failover")
+	command.Flags().StringVar(&leaderElection.lockName, "leader-elect-lock", defaultLeaderElectionLock, "Name of the config map holding the leader election lock. Controller instances with different instance IDs need different locks")
+	command.Flags().DurationVar(&leaderElection.leaseDuration, "leader-elect-lease-duration", 15*time.Second, "Duration followers wait since the leader last renewed its lease before taking over the leadership")
+	command.Flags().DurationVar(&leaderElection.renewDeadline, "leader-elect-renew-deadline", 10*time.Second, "Duration the leader retries renewing its lease before giving up the leadership. Must be less than the lease duration")
+	command.Flags().DurationVar(&leaderElection.retryPeriod, "leader-elect-retry-period", 2*time.Second, "Duration between attempts to acquire or renew the leadership")
+	command.Flags().StringVar(&instanceID, "instance-id", "", "ID of the controller instance. The controller only manages the applications labeled with "+common.LabelKeyApplicationControllerInstanceID+"=<instance-id>, or the unlabeled applications if not specified")
 	tlsConfigCustomizerSrc = tls.AddTLSFlagsToCmd(&command)
 	return &command
 }
 
+// runLeaderElection runs the controller once this replica is elected leader. The replica exits if it
+// loses the leadership, so that it restarts as a follower instead of processing applications along
+// with the new leader.
+func runLeaderElection(ctx context.Context, kubeClient kubernetes.Interface, namespace string, opts leaderElectionOptions, run func(ctx context.Context)) {
+	id, err := os.Hostname()
+	errors.CheckError(err)
+	broadcaster := record.NewBroadcaster()
+	broadcaster.StartRecordingToSink(&typedcorev1.EventSinkImpl{Interface: kubeClient.CoreV1().Events(namespace)})
+	lock := &resourcelock.ConfigMapLock{
+		ConfigMapMeta: metav1.ObjectMeta{Namespace: namespace, Name: opts.lockName},
+		Client:        kubeClient.CoreV1(),
+		LockConfig: resourcelock.ResourceLockConfig{
+			Identity:      id,
+			EventRecorder: broadcaster.NewRecorder(scheme.Scheme, apiv1.EventSource{Component: cliName}),
+		},
+	}
+	log.Infof("Waiting for the leadership of lock %s as %s", opts.lockName, id)
+	leaderelection.RunOrDie(ctx, leaderelection.LeaderElectionConfig{
+		Lock:          lock,
+		LeaseDuration: opts.leaseDuration,
+		RenewDeadline: opts.renewDeadline,
+		RetryPeriod:   opts.retryPeriod,
+		Callbacks: leaderelection.LeaderCallbacks{
+			OnStartedLeading: func(ctx context.Context) {
+				log.Infof("Elected leader of lock %s", opts.lockName)
+				run(ctx)
+			},
+			OnStoppedLeading: func() {
+				log.Fatalf("Lost the leadership of lock %s", opts.lockName)
+			},
+		},
+	})
+}
+
+func newSyncArtifactsCache(enabled bool, expiration time.Duration, redisAddress string) cache.Cache {
+	if !enabled {
+		return nil
+	}
+	if redisAddress == "" {
+		return cache.NewInMemoryCache(expiration)
+	}
+	client := redis.NewClient(&redis.Options{
+		Addr: redisAddress,
+	})
+	return cache.NewRedisCache(client, expiration)
+}
+
+// newLiveStateSnapshots returns the live state snapshots, which are only persisted across restarts in redis
+func newLiveStateSnapshots(maxAge time.Duration, redisAddress string) controller.LiveStateSnapshots {
+	if maxAge <= 0 {
+		return controller.LiveStateSnapshots{}
+	}
+	if redisAddress == "" {
+		log.Warn("Live state snapshots are disabled, since no redis server is specified")
+		return controller.LiveStateSnapshots{}
+	}
+	client := redis.NewClient(&redis.Options{
+		Addr: redisAddress,
+	})
+	return controller.LiveStateSnapshots{Cache: cache.NewRedisCache(client, maxAge), MaxAge: maxAge}
+}
+
+// newDiffStore returns the store of the diffs of resources, which are only persisted across restarts in redis
+func newDiffStore(enabled bool, redisAddress string) cache.Cache {
+	if !enabled {
+		return nil
+	}
+	if redisAddress == "" {
+		log.Warn("Persisted diffs are disabled, since no redis server is specified")
+		return nil
+	}
+	client := redis.NewClient(&redis.Options{
+		Addr: redisAddress,
+	})
+	return cache.NewRedisCache(client, 0)
+}
+
 func main() {
 	if err := newCommand().Execute(); err != nil {
 		fmt.Println(err)
@@ -25,11 +25,15 @@ const (
 	// CLIName is the name of the CLI
 	cliName = "argocd-repo-server"
 	port    = 8081
+	// Default port of the metrics server
+	defaultMetricsPort = 8084
 )
 
 func newCommand() *cobra.Command {
 	var (
 		logLevel               string
+		logFormat              string
+		metricsPort            int
 		tlsConfigCustomizerSrc func() (tls.ConfigCustomizer, error)
 	)
 	var command = cobra.Command{
@@ -37,6 +41,7 @@ func newCommand() *cobra.Command {
 		Short: "Run argocd-repo-server",
 		RunE: func(c *cobra.Command, args []string) error {
 			cli.SetLogLevel(logLevel)
+			cli.SetLogFormat(logFormat)
 
 			tlsConfigCustomizer, err := tlsConfigCustomizerSrc()
 			errors.CheckError(err)
@@ -55,6 +60,12 @@ func newCommand() *cobra.Command {
 			stats.RegisterStackDumper()
 			stats.StartStatsTicker(10 * time.Minute)
 			stats.RegisterHeapDumper("memprofile")
+			metricsServ, err := reposerver.NewMetricsServer(metricsPort)
+			errors.CheckError(err)
+			go func() {
+				log.Infof("argocd-repo-server metrics serving on port %d", metricsPort)
+				errors.CheckError(metricsServ.ListenAndServe())
+			}()
 			err = grpc.Serve(listener)
 			errors.CheckError(err)
 			return nil
@@ -62,6 +73,8 @@ func newCommand() *cobra.Command {
 	}
 
 	command.Flags().StringVar(&logLevel, "loglevel", "info", "Set the logging level. One of: debug|info|warn|error")
+	command.Flags().StringVar(&logFormat, "logformat", "text", "Set the logging format. One of: text|json")
+	command.Flags().IntVar(&metricsPort, "metrics-port", defaultMetricsPort, "Port of the metrics server")
 	tlsConfigCustomizerSrc = tls.AddTLSFlagsToCmd(&command)
 	return &command
 }
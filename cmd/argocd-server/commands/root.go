@@ -24,10 +24,12 @@ func NewCommand() *cobra.Command {
 	var (
 		insecure                   bool
 		logLevel                   string
+		logFormat                  string
 		glogLevel                  int
 		clientConfig               clientcmd.ClientConfig
 		staticAssetsDir            string
 		repoServerAddress          string
+		repoServerResolver         string
 		appControllerServerAddress string
 		dexServerAddress           string
 		disableAuth                bool
@@ -39,6 +41,7 @@ func NewCommand() *cobra.Command {
 		Long:  "Run the argocd API server",
 		Run: func(c *cobra.Command, args []string) {
 			cli.SetLogLevel(logLevel)
+			cli.SetLogFormat(logFormat)
 			cli.SetGLogLevel(glogLevel)
 
 			config, err := clientConfig.ClientConfig()
@@ -52,7 +55,8 @@ func NewCommand() *cobra.Command {
 
 			kubeclientset := kubernetes.NewForConfigOrDie(config)
 			appclientset := appclientset.NewForConfigOrDie(config)
-			repoclientset := reposerver.NewRepositoryServerClientset(repoServerAddress)
+			repoclientset, err := reposerver.NewRepositoryServerClientsetWithResolver(repoServerAddress, repoServerResolver, kubeclientset, namespace)
+			errors.CheckError(err)
 			appcontrollerclientset := controller.NewAppControllerClientset(appControllerServerAddress)
 
 			argoCDOpts := server.ArgoCDServerOpts{
@@ -66,6 +70,7 @@ func NewCommand() *cobra.Command {
 				DisableAuth:            disableAuth,
 				TLSConfigCustomizer:    tlsConfigCustomizer,
 				AppControllerClientset: appcontrollerclientset,
+				LogLevel:               logLevel,
 			}
 
 			stats.RegisterStackDumper()
@@ -86,8 +91,10 @@ func NewCommand() *cobra.Command {
 	command.Flags().BoolVar(&insecure, "insecure", false, "Run server without TLS")
 	command.Flags().StringVar(&staticAssetsDir, "staticassets", "", "Static assets directory path")
 	command.Flags().StringVar(&logLevel, "loglevel", "info", "Set the logging level. One of: debug|info|warn|error")
+	command.Flags().StringVar(&logFormat, "logformat", "text", "Set the logging format. One of: text|json")
 	command.Flags().IntVar(&glogLevel, "gloglevel", 0, "Set the glog logging level")
 	command.Flags().StringVar(&repoServerAddress, "repo-server", common.DefaultRepoServerAddr, "Repo server address")
+	command.Flags().StringVar(&repoServerResolver, "repo-server-resolver", reposerver.ResolverDNS, "Resolver of the repo server address. One of: dns|endpoints")
 	command.Flags().StringVar(&appControllerServerAddress, "app-controller-server", common.DefaultAppControllerServerAddr, "App controller server address")
 	command.Flags().StringVar(&dexServerAddress, "dex-server", common.DefaultDexServerAddr, "Dex server address")
 	command.Flags().BoolVar(&disableAuth, "disable-auth", false, "Disable client authentication")
@@ -4,7 +4,9 @@ import (
 	"context"
 	"fmt"
 	"os"
+	"strings"
 	"syscall"
+	"text/tabwriter"
 
 	"github.com/argoproj/argo-cd/errors"
 	argocdclient "github.com/argoproj/argo-cd/pkg/apiclient"
@@ -25,6 +27,7 @@ func NewAccountCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
 		},
 	}
 	command.AddCommand(NewAccountUpdatePasswordCommand(clientOpts))
+	command.AddCommand(NewAccountEvaluatePolicyCommand(clientOpts))
 	return command
 }
 
@@ -72,3 +75,51 @@ func NewAccountUpdatePasswordCommand(clientOpts *argocdclient.ClientOptions) *co
 	command.Flags().StringVar(&newPassword, "new-password", "", "new password you want to update to")
 	return command
 }
+
+// NewAccountEvaluatePolicyCommand returns a new instance of an `argocd account evaluate-policy` command
+func NewAccountEvaluatePolicyCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	var (
+		groups []string
+	)
+	var command = &cobra.Command{
+		Use:   "evaluate-policy SUBJECT RESOURCE ACTION[,ACTION...] OBJECT",
+		Short: "Evaluate the RBAC policy for the requests of a subject, e.g. to debug why a user is denied an action",
+		Example: `  # Check whether a member of a team can sync an application, and which policies allow or deny it
+  argocd account evaluate-policy alice@example.com applications get,sync my-project/guestbook --group my-org:my-team`,
+		Run: func(c *cobra.Command, args []string) {
+			if len(args) != 4 {
+				c.HelpFunc()(c, args)
+				os.Exit(1)
+			}
+			subject, resource, actions, object := args[0], args[1], strings.Split(args[2], ","), args[3]
+			evaluatePolicyRequest := account.EvaluatePolicyRequest{
+				Subject: subject,
+				Groups:  groups,
+			}
+			for _, action := range actions {
+				evaluatePolicyRequest.Requests = append(evaluatePolicyRequest.Requests, &account.PolicyRequest{
+					Resource: resource,
+					Action:   action,
+					Object:   object,
+				})
+			}
+
+			conn, usrIf := argocdclient.NewClientOrDie(clientOpts).NewAccountClientOrDie()
+			defer util.Close(conn)
+			resp, err := usrIf.EvaluatePolicy(context.Background(), &evaluatePolicyRequest)
+			errors.CheckError(err)
+			w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+			fmt.Fprintf(w, "RESOURCE\tACTION\tOBJECT\tRESULT\tPOLICIES\n")
+			for _, res := range resp.Results {
+				result := "deny"
+				if res.Allowed {
+					result = "allow"
+				}
+				fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\n", res.Resource, res.Action, res.Object, result, strings.Join(res.Policies, "; "))
+			}
+			_ = w.Flush()
+		},
+	}
+	command.Flags().StringArrayVar(&groups, "group", []string{}, "group the subject is a member of, e.g. an SSO group (can be repeated)")
+	return command
+}
@@ -17,10 +17,9 @@ import (
 	log "github.com/sirupsen/logrus"
 	"github.com/spf13/cobra"
 	"github.com/spf13/pflag"
+	"github.com/yudai/gojsondiff"
 	"github.com/yudai/gojsondiff/formatter"
 	"golang.org/x/crypto/ssh/terminal"
-	"google.golang.org/grpc/codes"
-	"google.golang.org/grpc/status"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 
@@ -30,6 +29,8 @@ import (
 	argocdclient "github.com/argoproj/argo-cd/pkg/apiclient"
 	argoappv1 "github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1"
 	"github.com/argoproj/argo-cd/server/application"
+	"github.com/argoproj/argo-cd/server/cluster"
+	"github.com/argoproj/argo-cd/server/settings"
 	"github.com/argoproj/argo-cd/util"
 	"github.com/argoproj/argo-cd/util/argo"
 	"github.com/argoproj/argo-cd/util/config"
@@ -56,11 +57,17 @@ func NewApplicationCommand(clientOpts *argocdclient.ClientOptions) *cobra.Comman
 	command.AddCommand(NewApplicationSyncCommand(clientOpts))
 	command.AddCommand(NewApplicationHistoryCommand(clientOpts))
 	command.AddCommand(NewApplicationRollbackCommand(clientOpts))
+	command.AddCommand(NewApplicationMoveCommand(clientOpts))
 	command.AddCommand(NewApplicationListCommand(clientOpts))
+	command.AddCommand(NewApplicationRevisionsCommand(clientOpts))
+	command.AddCommand(NewApplicationTopCommand(clientOpts))
 	command.AddCommand(NewApplicationDeleteCommand(clientOpts))
 	command.AddCommand(NewApplicationWaitCommand(clientOpts))
 	command.AddCommand(NewApplicationManifestsCommand(clientOpts))
+	command.AddCommand(NewApplicationResourceTreeCommand(clientOpts))
 	command.AddCommand(NewApplicationTerminateOpCommand(clientOpts))
+	command.AddCommand(NewApplicationPatchResourceCommand(clientOpts))
+	command.AddCommand(NewApplicationDeleteResourceCommand(clientOpts))
 	return command
 }
 
@@ -129,6 +136,7 @@ func NewApplicationGetCommand(clientOpts *argocdclient.ClientOptions) *cobra.Com
 		showParams    bool
 		showOperation bool
 		refresh       bool
+		hardRefresh   bool
 	)
 	var command = &cobra.Command{
 		Use:   "get APPNAME",
@@ -142,7 +150,7 @@ func NewApplicationGetCommand(clientOpts *argocdclient.ClientOptions) *cobra.Com
 			conn, appIf := acdClient.NewApplicationClientOrDie()
 			defer util.Close(conn)
 			appName := args[0]
-			app, err := appIf.Get(context.Background(), &application.ApplicationQuery{Name: &appName, Refresh: refresh})
+			app, err := appIf.Get(context.Background(), &application.ApplicationQuery{Name: &appName, Refresh: refresh, RefreshType: getRefreshType(refresh, hardRefresh)})
 			errors.CheckError(err)
 			switch output {
 			case "yaml":
@@ -156,6 +164,9 @@ func NewApplicationGetCommand(clientOpts *argocdclient.ClientOptions) *cobra.Com
 			case "":
 				fmt.Printf(printOpFmtStr, "Name:", app.Name)
 				fmt.Printf(printOpFmtStr, "Server:", app.Spec.Destination.Server)
+				if app.Spec.Destination.SelectsClusters() {
+					fmt.Printf(printOpFmtStr, "Selector:", app.Spec.Destination.Selector)
+				}
 				fmt.Printf(printOpFmtStr, "Namespace:", app.Spec.Destination.Namespace)
 				fmt.Printf(printOpFmtStr, "URL:", appURL(acdClient, app))
 				fmt.Printf(printOpFmtStr, "Repo:", app.Spec.Source.RepoURL)
@@ -168,10 +179,19 @@ func NewApplicationGetCommand(clientOpts *argocdclient.ClientOptions) *cobra.Com
 					if app.Spec.SyncPolicy.Automated.Prune {
 						syncPolicy += " (Prune)"
 					}
+					if app.Spec.SyncPolicy.Automated.SelfHeal {
+						syncPolicy += " (Self Heal)"
+					}
 				} else {
 					syncPolicy = "<none>"
 				}
 				fmt.Printf(printOpFmtStr, "Sync Policy:", syncPolicy)
+				if app.Spec.SyncPolicy != nil && len(app.Spec.SyncPolicy.SyncOptions) > 0 {
+					fmt.Printf(printOpFmtStr, "Sync Options:", strings.Join(app.Spec.SyncPolicy.SyncOptions, ","))
+				}
+				if app.Spec.SyncPolicy != nil && app.Spec.SyncPolicy.Retry != nil {
+					fmt.Printf(printOpFmtStr, "Sync Retry Limit:", strconv.FormatInt(app.Spec.SyncPolicy.Retry.Limit, 10))
+				}
 
 				if len(app.Status.Conditions) > 0 {
 					fmt.Println()
@@ -180,6 +200,12 @@ func NewApplicationGetCommand(clientOpts *argocdclient.ClientOptions) *cobra.Com
 					_ = w.Flush()
 					fmt.Println()
 				}
+				if len(app.Status.Destinations) > 0 {
+					fmt.Println()
+					w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+					printAppDestinations(w, app)
+					_ = w.Flush()
+				}
 				if showOperation && app.Status.OperationState != nil {
 					fmt.Println()
 					printOperationResult(app.Status.OperationState)
@@ -193,6 +219,12 @@ func NewApplicationGetCommand(clientOpts *argocdclient.ClientOptions) *cobra.Com
 					printAppResources(w, app, showOperation)
 					_ = w.Flush()
 				}
+				if len(app.Status.OrphanedResources) > 0 {
+					fmt.Println()
+					w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+					printAppOrphanedResources(w, app)
+					_ = w.Flush()
+				}
 			default:
 				log.Fatalf("Unknown output format: %s", output)
 			}
@@ -202,10 +234,25 @@ func NewApplicationGetCommand(clientOpts *argocdclient.ClientOptions) *cobra.Com
 	command.Flags().BoolVar(&showOperation, "show-operation", false, "Show application operation")
 	command.Flags().BoolVar(&showParams, "show-params", false, "Show application parameters and overrides")
 	command.Flags().BoolVar(&refresh, "refresh", false, "Refresh application data when retrieving")
+	command.Flags().BoolVar(&hardRefresh, "hard-refresh", false, "Refresh application data as well as target manifests cache")
 	return command
 }
 
+// getRefreshType returns the refresh type requested by the refresh flags
+func getRefreshType(refresh, hardRefresh bool) string {
+	switch {
+	case hardRefresh:
+		return string(argoappv1.RefreshTypeHard)
+	case refresh:
+		return string(argoappv1.RefreshTypeNormal)
+	}
+	return string(argoappv1.RefreshTypeNone)
+}
+
 func printAppSourceDetails(appSrc *argoappv1.ApplicationSource) {
+	if appSrc.IsHelmChart() {
+		fmt.Printf(printOpFmtStr, "Helm Chart:", appSrc.Chart)
+	}
 	if env := argoappv1.KsonnetEnv(appSrc); env != "" {
 		fmt.Printf(printOpFmtStr, "Environment:", env)
 	}
@@ -227,6 +274,32 @@ func printAppConditions(w io.Writer, app *argoappv1.Application) {
 	}
 }
 
+// printAppDestinations prints the status of every destination of an application in a tabwriter table
+func printAppDestinations(w io.Writer, app *argoappv1.Application) {
+	operations := make(map[argoappv1.ApplicationDestination]argoappv1.OperationPhase)
+	if app.Status.OperationState != nil {
+		for _, res := range app.Status.OperationState.DestinationResults {
+			operations[res.Destination] = res.Phase
+		}
+	}
+	fmt.Fprintf(w, "SERVER\tNAMESPACE\tSTATUS\tHEALTH\tOPERATION\tMESSAGE\n")
+	for _, dest := range app.Status.Destinations {
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%s\n", dest.Destination.Server, dest.Destination.Namespace, dest.Status, dest.Health.Status, operations[dest.Destination], dest.Message)
+	}
+}
+
+// printAppOrphanedResources prints the orphaned resources of the destination namespaces of an application in a tabwriter table
+func printAppOrphanedResources(w io.Writer, app *argoappv1.Application) {
+	fmt.Fprintf(w, "ORPHANED RESOURCE\tNAMESPACE\tNAME\n")
+	for _, res := range app.Status.OrphanedResources {
+		kind := res.Kind
+		if res.Group != "" {
+			kind = fmt.Sprintf("%s/%s", res.Group, res.Kind)
+		}
+		fmt.Fprintf(w, "%s\t%s\t%s\n", kind, res.Namespace, res.Name)
+	}
+}
+
 // appURL returns the URL of an application
 func appURL(acdClient argocdclient.Client, app *argoappv1.Application) string {
 	var scheme string
@@ -319,6 +392,12 @@ func NewApplicationSetCommand(clientOpts *argocdclient.ClientOptions) *cobra.Com
 				}
 				app.Spec.SyncPolicy.Automated.Prune = appOpts.autoPrune
 			}
+			if c.Flags().Changed("self-heal") {
+				if app.Spec.SyncPolicy == nil || app.Spec.SyncPolicy.Automated == nil {
+					log.Fatal("Cannot set --self-heal: application not configured with automatic sync")
+				}
+				app.Spec.SyncPolicy.Automated.SelfHeal = appOpts.selfHeal
+			}
 			setParameterOverrides(app, appOpts.parameters)
 			oldOverrides := app.Spec.Source.ComponentParameterOverrides
 			updatedSpec, err := appIf.UpdateSpec(context.Background(), &application.ApplicationUpdateSpecRequest{
@@ -344,38 +423,94 @@ func setAppOptions(flags *pflag.FlagSet, app *argoappv1.Application, appOpts *ap
 			app.Spec.Source.RepoURL = appOpts.repoURL
 		case "path":
 			app.Spec.Source.Path = appOpts.appPath
+		case "helm-chart":
+			app.Spec.Source.Chart = appOpts.helmChart
 		case "env":
 			setKsonnetOpt(&app.Spec.Source, &appOpts.env)
 		case "revision":
 			app.Spec.Source.TargetRevision = appOpts.revision
 		case "values":
-			setHelmOpt(&app.Spec.Source, appOpts.valuesFiles, nil)
+			setHelmOpt(&app.Spec.Source, appOpts.valuesFiles, nil, nil)
 		case "release-name":
-			setHelmOpt(&app.Spec.Source, nil, &appOpts.releaseName)
+			setHelmOpt(&app.Spec.Source, nil, &appOpts.releaseName, nil)
+		case "values-schema":
+			setHelmOpt(&app.Spec.Source, nil, nil, &appOpts.valuesSchemaFile)
 		case "dest-server":
 			app.Spec.Destination.Server = appOpts.destServer
 		case "dest-namespace":
 			app.Spec.Destination.Namespace = appOpts.destNamespace
+		case "dest-selector":
+			app.Spec.Destination.Selector = appOpts.destSelector
+		case "additional-dest":
+			app.Spec.AdditionalDestinations = parseAdditionalDestinations(appOpts.additionalDests)
 		case "project":
 			app.Spec.Project = appOpts.project
 		case "nameprefix":
 			setKustomizeOpt(&app.Spec.Source, &appOpts.namePrefix)
 		case "sync-policy":
+			var syncOptions []string
+			var retry *argoappv1.RetryStrategy
+			if app.Spec.SyncPolicy != nil {
+				syncOptions = app.Spec.SyncPolicy.SyncOptions
+				retry = app.Spec.SyncPolicy.Retry
+			}
 			switch appOpts.syncPolicy {
 			case "automated":
 				app.Spec.SyncPolicy = &argoappv1.SyncPolicy{
-					Automated: &argoappv1.SyncPolicyAutomated{},
+					Automated:   &argoappv1.SyncPolicyAutomated{},
+					SyncOptions: syncOptions,
+					Retry:       retry,
 				}
 			case "none":
 				app.Spec.SyncPolicy = nil
+				if len(syncOptions) > 0 {
+					app.Spec.SyncPolicy = &argoappv1.SyncPolicy{SyncOptions: syncOptions}
+				}
 			default:
 				log.Fatalf("Invalid sync-policy: %s", appOpts.syncPolicy)
 			}
 		}
 	})
+	// sync options are set after the sync policy, which would otherwise replace them
+	if flags.Changed("sync-option") {
+		if app.Spec.SyncPolicy == nil {
+			app.Spec.SyncPolicy = &argoappv1.SyncPolicy{}
+		}
+		app.Spec.SyncPolicy.SyncOptions = appOpts.syncOptions
+	}
+	// a retry limit of 0 disables the retries of failed automated syncs
+	if flags.Changed("sync-retry-limit") {
+		if appOpts.retryLimit > 0 {
+			if app.Spec.SyncPolicy == nil {
+				app.Spec.SyncPolicy = &argoappv1.SyncPolicy{}
+			}
+			app.Spec.SyncPolicy.Retry = &argoappv1.RetryStrategy{
+				Limit: appOpts.retryLimit,
+				Backoff: &argoappv1.Backoff{
+					Duration:    appOpts.retryBackoffDuration,
+					MaxDuration: appOpts.retryBackoffMaxDuration,
+				},
+			}
+		} else if app.Spec.SyncPolicy != nil {
+			app.Spec.SyncPolicy.Retry = nil
+		}
+	}
 	return visited
 }
 
+// parseAdditionalDestinations parses destinations in the form SERVER,NAMESPACE
+func parseAdditionalDestinations(dests []string) []argoappv1.ApplicationDestination {
+	var destinations []argoappv1.ApplicationDestination
+	for _, dest := range dests {
+		parts := strings.SplitN(dest, ",", 2)
+		if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+			log.Fatalf("Invalid additional destination '%s': expected SERVER,NAMESPACE", dest)
+		}
+		destinations = append(destinations, argoappv1.ApplicationDestination{Server: parts[0], Namespace: parts[1]})
+	}
+	return destinations
+}
+
 func setKsonnetOpt(src *argoappv1.ApplicationSource, env *string) {
 	if src.Ksonnet == nil {
 		src.Ksonnet = &argoappv1.ApplicationSourceKsonnet{}
@@ -394,7 +529,7 @@ func setKustomizeOpt(src *argoappv1.ApplicationSource, namePrefix *string) {
 	}
 }
 
-func setHelmOpt(src *argoappv1.ApplicationSource, valueFiles []string, releaseName *string) {
+func setHelmOpt(src *argoappv1.ApplicationSource, valueFiles []string, releaseName *string, valuesSchemaFile *string) {
 	if src.Helm == nil {
 		src.Helm = &argoappv1.ApplicationSourceHelm{}
 	}
@@ -404,6 +539,9 @@ func setHelmOpt(src *argoappv1.ApplicationSource, valueFiles []string, releaseNa
 	if releaseName != nil {
 		src.Helm.ReleaseName = *releaseName
 	}
+	if valuesSchemaFile != nil {
+		src.Helm.ValuesSchemaFile = *valuesSchemaFile
+	}
 }
 
 func checkDroppedParams(newOverrides []argoappv1.ComponentParameter, oldOverrides []argoappv1.ComponentParameter) {
@@ -419,35 +557,54 @@ func checkDroppedParams(newOverrides []argoappv1.ComponentParameter, oldOverride
 }
 
 type appOptions struct {
-	repoURL       string
-	appPath       string
-	env           string
-	revision      string
-	destServer    string
-	destNamespace string
-	parameters    []string
-	valuesFiles   []string
-	releaseName   string
-	project       string
-	syncPolicy    string
-	autoPrune     bool
-	namePrefix    string
+	repoURL          string
+	appPath          string
+	helmChart        string
+	env              string
+	revision         string
+	destServer       string
+	destNamespace    string
+	destSelector     string
+	additionalDests  []string
+	parameters       []string
+	valuesFiles      []string
+	releaseName      string
+	valuesSchemaFile string
+	project          string
+	syncPolicy       string
+	autoPrune        bool
+	selfHeal         bool
+	syncOptions      []string
+	namePrefix       string
+	// retryLimit and the backoff are the retry strategy of failed automated syncs
+	retryLimit              int64
+	retryBackoffDuration    string
+	retryBackoffMaxDuration string
 }
 
 func addAppFlags(command *cobra.Command, opts *appOptions) {
 	command.Flags().StringVar(&opts.repoURL, "repo", "", "Repository URL, ignored if a file is set")
 	command.Flags().StringVar(&opts.appPath, "path", "", "Path in repository to the ksonnet app directory, ignored if a file is set")
+	command.Flags().StringVar(&opts.helmChart, "helm-chart", "", "Chart of the helm repository set by --repo, deployed at the chart version set by --revision")
 	command.Flags().StringVar(&opts.env, "env", "", "Application environment to monitor")
 	command.Flags().StringVar(&opts.revision, "revision", "HEAD", "The tracking source branch, tag, or commit the application will sync to")
 	command.Flags().StringVar(&opts.destServer, "dest-server", "", "K8s cluster URL (overrides the server URL specified in the ksonnet app.yaml)")
 	command.Flags().StringVar(&opts.destNamespace, "dest-namespace", "", "K8s target namespace (overrides the namespace specified in the ksonnet app.yaml)")
+	command.Flags().StringVar(&opts.destSelector, "dest-selector", "", "Label selector of the clusters to deploy the application to, instead of --dest-server (e.g. env=prod,region!=eu)")
+	command.Flags().StringArrayVar(&opts.additionalDests, "additional-dest", []string{}, "Additional destination to deploy the application to, as SERVER,NAMESPACE (e.g. --additional-dest https://10.0.0.2,default)")
 	command.Flags().StringArrayVarP(&opts.parameters, "parameter", "p", []string{}, "set a parameter override (e.g. -p guestbook=image=example/guestbook:latest)")
 	command.Flags().StringArrayVar(&opts.valuesFiles, "values", []string{}, "Helm values file(s) to use")
 	command.Flags().StringVar(&opts.releaseName, "release-name", "", "Helm release-name")
+	command.Flags().StringVar(&opts.valuesSchemaFile, "values-schema", "", "Helm values JSON schema file, relative to the chart")
 	command.Flags().StringVar(&opts.project, "project", "", "Application project name")
 	command.Flags().StringVar(&opts.syncPolicy, "sync-policy", "", "Set the sync policy (one of: automated, none)")
 	command.Flags().BoolVar(&opts.autoPrune, "auto-prune", false, "Set automatic pruning when sync is automated")
+	command.Flags().BoolVar(&opts.selfHeal, "self-heal", false, "Set self healing when sync is automated")
+	command.Flags().StringArrayVar(&opts.syncOptions, "sync-option", []string{}, "Set a sync option of all resources of the application (e.g. --sync-option Replace=true)")
 	command.Flags().StringVar(&opts.namePrefix, "nameprefix", "", "Kustomize nameprefix")
+	command.Flags().Int64Var(&opts.retryLimit, "sync-retry-limit", 0, "Max number of retries of a failed automated sync. 0 disables the retries")
+	command.Flags().StringVar(&opts.retryBackoffDuration, "sync-retry-backoff-duration", "", "Delay before the first retry of a failed automated sync (e.g. 5s, 2m). Defaults to 5s")
+	command.Flags().StringVar(&opts.retryBackoffMaxDuration, "sync-retry-backoff-max-duration", "", "Max delay between the retries of a failed automated sync (e.g. 3m). Defaults to 3m")
 }
 
 // NewApplicationUnsetCommand returns a new instance of an `argocd app unset` command
@@ -507,7 +664,7 @@ func NewApplicationUnsetCommand(clientOpts *argocdclient.ClientOptions) *cobra.C
 					}
 				}
 			}
-			setHelmOpt(&app.Spec.Source, specValueFiles, nil)
+			setHelmOpt(&app.Spec.Source, specValueFiles, nil, nil)
 			if !updated {
 				return
 			}
@@ -553,9 +710,12 @@ func liveObjects(resources []*argoappv1.ResourceState) ([]*unstructured.Unstruct
 // NewApplicationDiffCommand returns a new instance of an `argocd app diff` command
 func NewApplicationDiffCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
 	var (
-		refresh bool
-		local   string
-		env     string
+		refresh     bool
+		hardRefresh bool
+		local       string
+		env         string
+		revision    string
+		output      string
 	)
 	var command = &cobra.Command{
 		Use:   "diff APPNAME",
@@ -568,8 +728,17 @@ func NewApplicationDiffCommand(clientOpts *argocdclient.ClientOptions) *cobra.Co
 			conn, appIf := argocdclient.NewClientOrDie(clientOpts).NewApplicationClientOrDie()
 			defer util.Close(conn)
 			appName := args[0]
-			app, err := appIf.Get(context.Background(), &application.ApplicationQuery{Name: &appName, Refresh: refresh})
+			app, err := appIf.Get(context.Background(), &application.ApplicationQuery{Name: &appName, Refresh: refresh, RefreshType: getRefreshType(refresh, hardRefresh)})
 			errors.CheckError(err)
+			if revision != "" || output != "" {
+				if local != "" {
+					log.Fatal("--revision and --output options invalid when performing local diff")
+				}
+				diffRes, err := appIf.Diff(context.Background(), &application.ApplicationDiffQuery{Name: &appName, Revision: revision})
+				errors.CheckError(err)
+				printApplicationDiff(diffRes, output)
+				return
+			}
 			resources, err := appIf.Resources(context.Background(), &services.ResourcesQuery{ApplicationName: &appName})
 			errors.CheckError(err)
 			liveObjs, err := liveObjects(resources.Items)
@@ -598,7 +767,7 @@ func NewApplicationDiffCommand(clientOpts *argocdclient.ClientOptions) *cobra.Co
 
 			// In order for the diff to be clean, need to set our app labels
 			setAppLabels(appName, compareObjs)
-			diffResults, err := diff.DiffArray(compareObjs, liveObjs)
+			diffResults, err := diff.DiffArray(compareObjs, liveObjs, getAppNormalizer(clientOpts, app))
 			errors.CheckError(err)
 			for i := 0; i < len(compareObjs); i++ {
 				kind, name := getObjKindName(compareObjs[i], liveObjs[i])
@@ -619,11 +788,43 @@ func NewApplicationDiffCommand(clientOpts *argocdclient.ClientOptions) *cobra.Co
 		},
 	}
 	command.Flags().BoolVar(&refresh, "refresh", false, "Refresh application data when retrieving")
+	command.Flags().BoolVar(&hardRefresh, "hard-refresh", false, "Refresh application data as well as target manifests cache")
 	command.Flags().StringVar(&local, "local", "", "Compare live app to a local ksonnet app")
 	command.Flags().StringVar(&env, "env", "", "Compare live app to a specific environment")
+	command.Flags().StringVar(&revision, "revision", "", "Compare live app to the manifests of a specific revision")
+	command.Flags().StringVarP(&output, "output", "o", "", "Output format. One of: json")
 	return command
 }
 
+// printApplicationDiff prints the diff of an application which was computed by the API server
+func printApplicationDiff(diffRes *application.ApplicationDiffResponse, output string) {
+	switch output {
+	case "json":
+		jsonBytes, err := json.MarshalIndent(diffRes, "", "  ")
+		errors.CheckError(err)
+		fmt.Println(string(jsonBytes))
+	case "":
+		for _, item := range diffRes.Items {
+			fmt.Printf("===== %s %s ======\n", item.Kind, item.Name)
+			if item.Status != string(argoappv1.ComparisonStatusOutOfSync) {
+				continue
+			}
+			live := make(map[string]interface{})
+			errors.CheckError(json.Unmarshal([]byte(item.LiveState), &live))
+			predicted := make(map[string]interface{})
+			errors.CheckError(json.Unmarshal([]byte(item.PredictedLiveState), &predicted))
+			formatOpts := formatter.AsciiFormatterConfig{
+				Coloring: terminal.IsTerminal(int(os.Stdout.Fd())),
+			}
+			out, err := formatter.NewAsciiFormatter(live, formatOpts).Format(gojsondiff.New().CompareObjects(live, predicted))
+			errors.CheckError(err)
+			fmt.Println(out)
+		}
+	default:
+		log.Fatalf("Unknown output format: %s", output)
+	}
+}
+
 func getObjKindName(compare, live *unstructured.Unstructured) (string, string) {
 	if compare == nil {
 		return live.GetKind(), live.GetName()
@@ -640,10 +841,64 @@ func setAppLabels(appName string, compareObjs []*unstructured.Unstructured) {
 	}
 }
 
+// getAppNormalizer returns the normalizer of the normalizer profiles of the cluster of the application,
+// of the resource customizations of the settings and of the ignored differences of the application.
+// The normalizer profiles are not applied if the cluster cannot be retrieved, e.g. due to missing
+// permissions
+func getAppNormalizer(clientOpts *argocdclient.ClientOptions, app *argoappv1.Application) diff.Normalizer {
+	server := app.Spec.Destination.Server
+	acdClient := argocdclient.NewClientOrDie(clientOpts)
+	conn, clusterIf := acdClient.NewClusterClientOrDie()
+	defer util.Close(conn)
+	var profiles []string
+	clst, err := clusterIf.Get(context.Background(), &cluster.ClusterQuery{Server: server})
+	if err != nil {
+		log.Warnf("Unable to get the normalizer profiles of cluster %s: %v", server, err)
+	} else {
+		profiles = clst.NormalizerProfiles
+	}
+	setConn, setIf := acdClient.NewSettingsClientOrDie()
+	defer util.Close(setConn)
+	var ignoreDifferences []argoappv1.ResourceIgnoreDifferences
+	var scripts []diff.NormalizerScript
+	acdSet, err := setIf.Get(context.Background(), &settings.SettingsQuery{})
+	if err != nil {
+		log.Warnf("Unable to get the resource customizations of the settings: %v", err)
+	} else {
+		for _, item := range acdSet.ResourceIgnoreDifferences {
+			ignoreDifferences = append(ignoreDifferences, argoappv1.ResourceIgnoreDifferences{
+				Group:        item.Group,
+				Kind:         item.Kind,
+				JSONPointers: item.JSONPointers,
+			})
+		}
+		for _, item := range acdSet.ResourceNormalizerScripts {
+			scripts = append(scripts, diff.NormalizerScript{
+				Group:  item.Group,
+				Kind:   item.Kind,
+				Script: item.Script,
+			})
+		}
+	}
+	normalizer, err := diff.NewNormalizer(profiles, append(ignoreDifferences, app.Spec.IgnoreDifferences...))
+	if err != nil {
+		log.Warnf("Ignoring the normalizer profiles of cluster %s and the ignored differences: %v", server, err)
+		normalizer = nil
+	}
+	scriptNormalizer, err := diff.NewScriptNormalizer(scripts)
+	if err != nil {
+		log.Warnf("Ignoring the normalizer scripts of the resource customizations: %v", err)
+		scriptNormalizer = nil
+	}
+	return diff.ComposeNormalizers(scriptNormalizer, normalizer)
+}
+
 // NewApplicationDeleteCommand returns a new instance of an `argocd app delete` command
 func NewApplicationDeleteCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
 	var (
-		cascade bool
+		cascade            bool
+		confirmCRDDeletion bool
+		propagationPolicy  string
 	)
 	var command = &cobra.Command{
 		Use:   "delete APPNAME",
@@ -657,7 +912,9 @@ func NewApplicationDeleteCommand(clientOpts *argocdclient.ClientOptions) *cobra.
 			defer util.Close(conn)
 			for _, appName := range args {
 				appDeleteReq := application.ApplicationDeleteRequest{
-					Name: &appName,
+					Name:               &appName,
+					ConfirmCRDDeletion: confirmCRDDeletion,
+					PropagationPolicy:  propagationPolicy,
 				}
 				if c.Flag("cascade").Changed {
 					appDeleteReq.Cascade = &cascade
@@ -668,6 +925,8 @@ func NewApplicationDeleteCommand(clientOpts *argocdclient.ClientOptions) *cobra.
 		},
 	}
 	command.Flags().BoolVar(&cascade, "cascade", true, "Perform a cascaded deletion of all application resources")
+	command.Flags().BoolVar(&confirmCRDDeletion, "confirm-crd-deletion", false, "Allow deleting custom resource definitions which have instances outside of the application")
+	command.Flags().StringVar(&propagationPolicy, "propagation-policy", "", "Deletion propagation policy of the resources of a cascaded deletion (one of: foreground|background)")
 	return command
 }
 
@@ -716,6 +975,89 @@ func NewApplicationListCommand(clientOpts *argocdclient.ClientOptions) *cobra.Co
 	return command
 }
 
+// NewApplicationRevisionsCommand returns a new instance of an `argocd app revisions` command
+func NewApplicationRevisionsCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	var (
+		projects    []string
+		mutableOnly bool
+	)
+	var command = &cobra.Command{
+		Use:   "revisions",
+		Short: "Report the target revisions of applications, and whether they track branches or are pinned to tags or commits",
+		Run: func(c *cobra.Command, args []string) {
+			conn, appIf := argocdclient.NewClientOrDie(clientOpts).NewApplicationClientOrDie()
+			defer util.Close(conn)
+			report, err := appIf.RevisionReport(context.Background(), &application.ApplicationQuery{Projects: projects})
+			errors.CheckError(err)
+			w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+			fmt.Fprintf(w, "NAME\tPROJECT\tTARGET\tTYPE\tMUTABLE\tRESOLVED\tDEPLOYED\n")
+			for _, entry := range report.Items {
+				if mutableOnly && !entry.Mutable {
+					continue
+				}
+				resolved := entry.ResolvedRevision
+				if entry.Error != "" {
+					resolved = fmt.Sprintf("<%s>", entry.Error)
+				}
+				fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%v\t%s\t%s\n",
+					entry.Name, entry.Project, entry.TargetRevision, entry.RevisionType, entry.Mutable, resolved, entry.DeployedRevision)
+			}
+			_ = w.Flush()
+		},
+	}
+	command.Flags().StringArrayVarP(&projects, "project", "p", []string{}, "Only report applications of the project")
+	command.Flags().BoolVar(&mutableOnly, "mutable", false, "Only report applications which track mutable revisions (branches or HEAD)")
+	return command
+}
+
+// NewApplicationTopCommand returns a new instance of an `argocd app top` command
+func NewApplicationTopCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	var (
+		projects []string
+		limit    int64
+	)
+	var command = &cobra.Command{
+		Use:   "top",
+		Short: "Summarize the applications by sync status, health, project and cluster, and their failed and running operations",
+		Run: func(c *cobra.Command, args []string) {
+			conn, appIf := argocdclient.NewClientOrDie(clientOpts).NewApplicationClientOrDie()
+			defer util.Close(conn)
+			summary, err := appIf.Summary(context.Background(), &application.ApplicationSummaryQuery{Projects: projects, Limit: limit})
+			errors.CheckError(err)
+			w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+			fmt.Fprintf(w, "APPLICATIONS:\t%d\n", summary.Total)
+			printSummaryCounts(w, "SYNC STATUS", summary.SyncStatuses)
+			printSummaryCounts(w, "HEALTH", summary.HealthStatuses)
+			printSummaryCounts(w, "PROJECT", summary.Projects)
+			printSummaryCounts(w, "CLUSTER", summary.Clusters)
+			if len(summary.FailedOperations) > 0 {
+				fmt.Fprintf(w, "\nRECENTLY FAILED\tPROJECT\tPHASE\tFINISHED\tDURATION\tMESSAGE\n")
+				for _, op := range summary.FailedOperations {
+					fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%v\t%s\n", op.Name, op.Project, op.Phase, op.FinishedAt, time.Duration(op.Duration)*time.Second, op.Message)
+				}
+			}
+			if len(summary.RunningOperations) > 0 {
+				fmt.Fprintf(w, "\nLONGEST RUNNING\tPROJECT\tPHASE\tSTARTED\tDURATION\tMESSAGE\n")
+				for _, op := range summary.RunningOperations {
+					fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%v\t%s\n", op.Name, op.Project, op.Phase, op.StartedAt, time.Duration(op.Duration)*time.Second, op.Message)
+				}
+			}
+			_ = w.Flush()
+		},
+	}
+	command.Flags().StringArrayVarP(&projects, "project", "p", []string{}, "Only summarize applications of the project")
+	command.Flags().Int64Var(&limit, "limit", 0, "Max number of failed and of running operations listed (default 10)")
+	return command
+}
+
+// printSummaryCounts prints the numbers of applications by the values of a property
+func printSummaryCounts(w io.Writer, title string, counts []application.ApplicationSummaryCount) {
+	fmt.Fprintf(w, "\n%s\tCOUNT\n", title)
+	for _, count := range counts {
+		fmt.Fprintf(w, "%s\t%d\n", count.Key, count.Count)
+	}
+}
+
 func formatConditionsSummary(app argoappv1.Application) string {
 	typeToCnt := make(map[string]int)
 	for i := range app.Status.Conditions {
@@ -777,59 +1119,6 @@ func NewApplicationWaitCommand(clientOpts *argocdclient.ClientOptions) *cobra.Co
 	return command
 }
 
-func isCanceledContextErr(err error) bool {
-	if err == context.Canceled {
-		return true
-	}
-	if stat, ok := status.FromError(err); ok {
-		if stat.Code() == codes.Canceled {
-			return true
-		}
-	}
-	return false
-}
-
-// watchApp returns a channel of watch events for an app, retrying the watch upon errors. Closes
-// the returned channel when the context is discovered to be canceled.
-func watchApp(ctx context.Context, appIf application.ApplicationServiceClient, appName string) chan *argoappv1.ApplicationWatchEvent {
-	appEventsCh := make(chan *argoappv1.ApplicationWatchEvent)
-	go func() {
-		defer close(appEventsCh)
-		for {
-			wc, err := appIf.Watch(ctx, &application.ApplicationQuery{
-				Name: &appName,
-			})
-			if err != nil {
-				if isCanceledContextErr(err) {
-					return
-				}
-				if err != io.EOF {
-					log.Warnf("watch err: %v", err)
-				}
-				time.Sleep(1 * time.Second)
-				continue
-			}
-			for {
-				appEvent, err := wc.Recv()
-				if err != nil {
-					if isCanceledContextErr(err) {
-						return
-					}
-					if err != io.EOF {
-						log.Warnf("recv err: %v", err)
-					}
-					time.Sleep(1 * time.Second)
-					break
-				} else {
-					appEventsCh <- appEvent
-				}
-			}
-		}
-
-	}()
-	return appEventsCh
-}
-
 // printAppResources prints the resources of an application in a tabwriter table
 // Optionally prints the message from the operation state
 func printAppResources(w io.Writer, app *argoappv1.Application, showOperation bool) {
@@ -846,7 +1135,7 @@ func printAppResources(w io.Writer, app *argoappv1.Application, showOperation bo
 		}
 		if syncRes != nil {
 			for _, resDetails := range syncRes.Resources {
-				messages[fmt.Sprintf("%s/%s", resDetails.Kind, resDetails.Name)] = resDetails.Message
+				messages[fmt.Sprintf("%s/%s", resDetails.Kind, resDetails.Name)] = firstLine(resDetails.Message)
 			}
 			for _, hook := range syncRes.Hooks {
 				if hook.Type == argoappv1.HookTypePreSync {
@@ -879,13 +1168,29 @@ func printAppResources(w io.Writer, app *argoappv1.Application, showOperation bo
 // NewApplicationSyncCommand returns a new instance of an `argocd app sync` command
 func NewApplicationSyncCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
 	var (
-		revision  string
-		resources *[]string
-		prune     bool
-		dryRun    bool
-		timeout   uint
-		strategy  string
-		force     bool
+		revision           string
+		resources          *[]string
+		excludedResources  *[]string
+		prune              bool
+		dryRun             bool
+		timeout            uint
+		strategy           string
+		force              bool
+		confirmCRDDeletion bool
+		propagationPolicy  string
+		retryLimit         int64
+		retryBackoff       argoappv1.Backoff
+		retryFactor        int64
+		retryFailedOnly    bool
+		operationTimeout   string
+		applyConcurrency   int64
+		preset             string
+		idempotencyKey     string
+		batchSize          int64
+		healthTimeout      string
+		gracePeriod        int64
+		overrideWindows    bool
+		output             string
 	)
 	const (
 		resourceFieldDelimiter = ":"
@@ -902,10 +1207,12 @@ func NewApplicationSyncCommand(clientOpts *argocdclient.ClientOptions) *cobra.Co
 			conn, appIf := argocdclient.NewClientOrDie(clientOpts).NewApplicationClientOrDie()
 			defer util.Close(conn)
 			appName := args[0]
-			var syncResources []argoappv1.SyncOperationResource
-			if resources != nil {
-				syncResources = []argoappv1.SyncOperationResource{}
-				for _, r := range *resources {
+			if output != "" && (output != "json" || !dryRun) {
+				log.Fatalf("Unsupported output format: %s. Dry-run syncs support: json", output)
+			}
+			parseSyncResources := func(resources []string) []argoappv1.SyncOperationResource {
+				syncResources := []argoappv1.SyncOperationResource{}
+				for _, r := range resources {
 					fields := strings.Split(r, resourceFieldDelimiter)
 					if len(fields) != resourceFieldCount {
 						log.Fatalf("Resource should have GROUP%sKIND%sNAME, but instead got: %s", resourceFieldDelimiter, resourceFieldDelimiter, r)
@@ -917,30 +1224,71 @@ func NewApplicationSyncCommand(clientOpts *argocdclient.ClientOptions) *cobra.Co
 					}
 					syncResources = append(syncResources, rsrc)
 				}
+				return syncResources
+			}
+			var syncResources []argoappv1.SyncOperationResource
+			if resources != nil {
+				syncResources = parseSyncResources(*resources)
+			}
+			var excludedSyncResources []argoappv1.SyncOperationResource
+			if excludedResources != nil && len(*excludedResources) > 0 {
+				excludedSyncResources = parseSyncResources(*excludedResources)
 			}
 			syncReq := application.ApplicationSyncRequest{
-				Name:      &appName,
-				DryRun:    dryRun,
-				Revision:  revision,
-				Resources: syncResources,
-				Prune:     prune,
+				Name:                   &appName,
+				DryRun:                 dryRun,
+				Revision:               revision,
+				Resources:              syncResources,
+				Prune:                  prune,
+				ConfirmCRDDeletion:     confirmCRDDeletion,
+				PrunePropagationPolicy: propagationPolicy,
+				Timeout:                operationTimeout,
+				ApplyConcurrency:       applyConcurrency,
+				ExcludedResources:      excludedSyncResources,
+				Preset:                 preset,
+				IdempotencyKey:         idempotencyKey,
+				OverrideSyncWindows:    overrideWindows,
+			}
+			if c.Flags().Changed("termination-grace-period") {
+				syncReq.TerminationGracePeriodSeconds = &gracePeriod
+			}
+			if retryLimit > 0 {
+				syncReq.Retry = &argoappv1.RetryStrategy{Limit: retryLimit, Backoff: &retryBackoff, FailedOnly: retryFailedOnly}
+				if c.Flags().Changed("retry-backoff-factor") {
+					syncReq.Retry.Backoff.Factor = &retryFactor
+				}
 			}
 			switch strategy {
 			case "apply":
 				syncReq.Strategy = &argoappv1.SyncStrategy{Apply: &argoappv1.SyncStrategyApply{}}
 				syncReq.Strategy.Apply.Force = force
 			case "", "hook":
-				syncReq.Strategy = &argoappv1.SyncStrategy{Hook: &argoappv1.SyncStrategyHook{}}
+				syncReq.Strategy = &argoappv1.SyncStrategy{Hook: &argoappv1.SyncStrategyHook{HealthTimeout: healthTimeout}}
 				syncReq.Strategy.Hook.Force = force
+			case "progressive":
+				syncReq.Strategy = &argoappv1.SyncStrategy{Progressive: &argoappv1.SyncStrategyProgressive{BatchSize: batchSize}}
+				syncReq.Strategy.Progressive.Force = force
 			default:
 				log.Fatalf("Unknown sync strategy: '%s'", strategy)
 			}
+			if healthTimeout != "" && syncReq.Strategy.Hook == nil {
+				log.Fatal("--health-timeout requires the hook sync strategy")
+			}
 			ctx := context.Background()
 			_, err := appIf.Sync(ctx, &syncReq)
 			errors.CheckError(err)
 
-			app, err := waitOnApplicationStatus(appIf, appName, timeout, false, false, true, syncResources)
-			errors.CheckError(err)
+			var app *argoappv1.Application
+			if output == "json" {
+				app, err = waitOnOperation(appIf, appName, timeout)
+				errors.CheckError(err)
+				jsonBytes, err := json.MarshalIndent(newDryRunReport(app), "", "  ")
+				errors.CheckError(err)
+				fmt.Println(string(jsonBytes))
+			} else {
+				app, err = waitOnApplicationStatus(appIf, appName, timeout, false, false, true, syncResources)
+				errors.CheckError(err)
+			}
 
 			pruningRequired := 0
 			for _, resDetails := range app.Status.OperationState.SyncResult.Resources {
@@ -961,9 +1309,26 @@ func NewApplicationSyncCommand(clientOpts *argocdclient.ClientOptions) *cobra.Co
 	command.Flags().BoolVar(&prune, "prune", false, "Allow deleting unexpected resources")
 	command.Flags().StringVar(&revision, "revision", "", "Sync to a specific revision. Preserves parameter overrides")
 	resources = command.Flags().StringArray("resource", nil, fmt.Sprintf("Sync only specific resources as GROUP%sKIND%sNAME. Fields may be blank. This option may be specified repeatedly", resourceFieldDelimiter, resourceFieldDelimiter))
+	command.Flags().StringVar(&preset, "preset", "", "Apply the parameter overrides of the named preset of the application or its project")
+	excludedResources = command.Flags().StringArray("exclude-resource", nil, fmt.Sprintf("Skip specific resources as GROUP%sKIND%sNAME. Fields may be blank. This option may be specified repeatedly", resourceFieldDelimiter, resourceFieldDelimiter))
 	command.Flags().UintVar(&timeout, "timeout", defaultCheckTimeoutSeconds, "Time out after this many seconds")
-	command.Flags().StringVar(&strategy, "strategy", "", "Sync strategy (one of: apply|hook)")
+	command.Flags().StringVar(&strategy, "strategy", "", "Sync strategy (one of: apply|hook|progressive)")
+	command.Flags().Int64Var(&batchSize, "batch-size", 1, "Max number of resources applied in each batch of a progressive sync")
+	command.Flags().StringVar(&healthTimeout, "health-timeout", "", "Fail the sync if the application is not healthy this long after its resources were applied (e.g. 5m), instead of running the PostSync hooks")
 	command.Flags().BoolVar(&force, "force", false, "Use a force apply")
+	command.Flags().BoolVar(&confirmCRDDeletion, "confirm-crd-deletion", false, "Allow pruning custom resource definitions which have instances outside of the application")
+	command.Flags().StringVar(&propagationPolicy, "prune-propagation-policy", "", "Deletion propagation policy of pruned resources (one of: foreground|background|orphan)")
+	command.Flags().Int64Var(&retryLimit, "retry-limit", 0, "Max number of allowed sync retries")
+	command.Flags().StringVar(&retryBackoff.Duration, "retry-backoff-duration", "", "Delay before the first retry (e.g. 5s, 2m). Defaults to 5s")
+	command.Flags().StringVar(&retryBackoff.MaxDuration, "retry-backoff-max-duration", "", "Max delay between retries (e.g. 3m). Defaults to 3m")
+	command.Flags().Int64Var(&retryFactor, "retry-backoff-factor", 2, "Factor which multiplies the delay after each retry")
+	command.Flags().BoolVar(&retryFailedOnly, "retry-failed-only", false, "Retry only the resources which failed to sync in the previous attempt")
+	command.Flags().StringVar(&operationTimeout, "operation-timeout", "", "Fail the sync if it is still running after this duration (e.g. 10m)")
+	command.Flags().Int64Var(&gracePeriod, "termination-grace-period", 0, "Grace period in seconds of the running hooks which are deleted when the sync is terminated. Defaults to the grace period of the hook pods")
+	command.Flags().Int64Var(&applyConcurrency, "apply-concurrency", 0, "Max number of resources pruned or applied in parallel. Unlimited if 0")
+	command.Flags().StringVar(&idempotencyKey, "idempotency-key", "", "Unique key of the sync. If a sync with the key was already started, it is not started again")
+	command.Flags().BoolVar(&overrideWindows, "override-sync-windows", false, "Sync even if the sync windows of the project do not permit it. Intended for emergencies")
+	command.Flags().StringVarP(&output, "output", "o", "", "Output format of the report of a dry-run sync. One of: json")
 	return command
 }
 
@@ -1052,7 +1417,7 @@ func calculateResourceStates(app *argoappv1.Application, syncResources []argoapp
 	}
 
 	for _, res := range opResult.Resources {
-		newState := newResourceState(res.Kind, res.Name, "", "", "", res.Message)
+		newState := newResourceState(res.Kind, res.Name, "", "", "", firstLine(res.Message))
 		key := newState.Key()
 		if prev, ok := resStates[key]; ok {
 			prev.Merge(newState)
@@ -1091,6 +1456,9 @@ func waitOnApplicationStatus(appClient application.ApplicationServiceClient, app
 			printAppResources(w, app, watchOperation)
 			_ = w.Flush()
 		}
+		if watchOperation {
+			printDryRunDiffs(app.Status.OperationState)
+		}
 	}
 
 	if timeout != 0 {
@@ -1103,7 +1471,7 @@ func waitOnApplicationStatus(appClient application.ApplicationServiceClient, app
 	fmt.Fprintln(w, "KIND\tNAME\tSTATUS\tHEALTH\tHOOK\tOPERATIONMSG")
 
 	prevStates := make(map[string]*resourceState)
-	appEventCh := watchApp(ctx, appClient, appName)
+	appEventCh := argocdclient.WatchApplication(ctx, appClient, appName)
 	var app *argoappv1.Application
 
 	for appEvent := range appEventCh {
@@ -1140,6 +1508,59 @@ func waitOnApplicationStatus(appClient application.ApplicationServiceClient, app
 	return nil, fmt.Errorf("Timed out (%ds) waiting for app %q match desired state", timeout, appName)
 }
 
+// waitOnOperation waits until the operation of an application completes, without printing its progress
+func waitOnOperation(appClient application.ApplicationServiceClient, appName string, timeout uint) (*argoappv1.Application, error) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	if timeout != 0 {
+		time.AfterFunc(time.Duration(timeout)*time.Second, func() {
+			cancel()
+		})
+	}
+	for appEvent := range argocdclient.WatchApplication(ctx, appClient, appName) {
+		if appEvent.Application.Operation == nil {
+			return &appEvent.Application, nil
+		}
+	}
+	return nil, fmt.Errorf("Timed out (%ds) waiting for the operation of app %q to complete", timeout, appName)
+}
+
+// dryRunReport is the machine-readable report of a dry-run sync, e.g. for CI systems to post as a
+// comment of a pull request
+type dryRunReport struct {
+	Application string                       `json:"application"`
+	Revision    string                       `json:"revision"`
+	Phase       argoappv1.OperationPhase     `json:"phase"`
+	Message     string                       `json:"message,omitempty"`
+	Resources   []*argoappv1.ResourceDetails `json:"resources"`
+	Hooks       []*argoappv1.HookStatus      `json:"hooks"`
+}
+
+// newDryRunReport returns the report of the last operation of an application
+func newDryRunReport(app *argoappv1.Application) *dryRunReport {
+	report := &dryRunReport{
+		Application: app.Name,
+		Resources:   []*argoappv1.ResourceDetails{},
+		Hooks:       []*argoappv1.HookStatus{},
+	}
+	opState := app.Status.OperationState
+	if opState == nil {
+		return report
+	}
+	report.Phase = opState.Phase
+	report.Message = opState.Message
+	if opState.SyncResult != nil {
+		report.Revision = opState.SyncResult.Revision
+		if opState.SyncResult.Resources != nil {
+			report.Resources = opState.SyncResult.Resources
+		}
+		if opState.SyncResult.Hooks != nil {
+			report.Hooks = opState.SyncResult.Hooks
+		}
+	}
+	return report
+}
+
 // setParameterOverrides updates an existing or appends a new parameter override in the application
 // If the app is a ksonnet app, then parameters are expected to be in the form: component=param=value
 // Otherwise, the app is assumed to be a helm app and is expected to be in the form:
@@ -1196,7 +1617,13 @@ func setParameterOverrides(app *argoappv1.Application, parameters []string) {
 // NewApplicationHistoryCommand returns a new instance of an `argocd app history` command
 func NewApplicationHistoryCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
 	var (
-		output string
+		output     string
+		since      string
+		until      string
+		limit      int64
+		offset     int64
+		operations bool
+		phases     []string
 	)
 	var command = &cobra.Command{
 		Use:   "history APPNAME",
@@ -1209,33 +1636,81 @@ func NewApplicationHistoryCommand(clientOpts *argocdclient.ClientOptions) *cobra
 			conn, appIf := argocdclient.NewClientOrDie(clientOpts).NewApplicationClientOrDie()
 			defer util.Close(conn)
 			appName := args[0]
-			app, err := appIf.Get(context.Background(), &application.ApplicationQuery{Name: &appName})
+			history, err := appIf.History(context.Background(), &application.ApplicationHistoryQuery{
+				Name:       &appName,
+				Since:      since,
+				Until:      until,
+				Limit:      limit,
+				Offset:     offset,
+				Operations: operations,
+				Phases:     phases,
+			})
 			errors.CheckError(err)
+			if operations || len(phases) > 0 {
+				printOperationHistory(history.Operations)
+				return
+			}
 			w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
 			switch output {
 			case "wide":
-				fmt.Fprintf(w, "ID\tDATE\tCOMMIT\tPARAMETERS\n")
+				fmt.Fprintf(w, "ID\tDATE\tCOMMIT\tPRESET\tDESTINATION\tPARAMETERS\n")
 			default:
-				fmt.Fprintf(w, "ID\tDATE\tCOMMIT\n")
+				fmt.Fprintf(w, "ID\tDATE\tCOMMIT\tPRESET\tDESTINATION\n")
 			}
-			for _, depInfo := range app.Status.History {
+			// the history is returned newest first, but printed oldest first
+			for i := len(history.Items) - 1; i >= 0; i-- {
+				depInfo := history.Items[i]
+				dest := ""
+				if depInfo.Destination != nil {
+					dest = fmt.Sprintf("%s/%s", depInfo.Destination.Server, depInfo.Destination.Namespace)
+				}
 				switch output {
 				case "wide":
 					manifest, err := appIf.GetManifests(context.Background(), &application.ApplicationManifestQuery{Name: &appName, Revision: depInfo.Revision})
 					errors.CheckError(err)
 					paramStr := paramString(manifest.GetParams())
-					fmt.Fprintf(w, "%d\t%s\t%s\t%s\n", depInfo.ID, depInfo.DeployedAt, depInfo.Revision, paramStr)
+					fmt.Fprintf(w, "%d\t%s\t%s\t%s\t%s\t%s\n", depInfo.ID, depInfo.DeployedAt, depInfo.Revision, depInfo.Preset, dest, paramStr)
 				default:
-					fmt.Fprintf(w, "%d\t%s\t%s\n", depInfo.ID, depInfo.DeployedAt, depInfo.Revision)
+					fmt.Fprintf(w, "%d\t%s\t%s\t%s\t%s\n", depInfo.ID, depInfo.DeployedAt, depInfo.Revision, depInfo.Preset, dest)
 				}
 			}
 			_ = w.Flush()
 		},
 	}
 	command.Flags().StringVarP(&output, "output", "o", "", "Output format. One of: wide")
+	command.Flags().StringVar(&since, "since", "", "Only show the deployments made, or the operations started, at or after the time, in RFC3339 format")
+	command.Flags().StringVar(&until, "until", "", "Only show the deployments made, or the operations started, before the time, in RFC3339 format")
+	command.Flags().Int64Var(&limit, "limit", 0, "Only show the latest deployments or operations, up to the limit. Unlimited if 0")
+	command.Flags().Int64Var(&offset, "offset", 0, "Skip the number of the latest deployments or operations")
+	command.Flags().BoolVar(&operations, "operations", false, "Show the history of the completed operations, including the failed ones, instead of the deployments")
+	command.Flags().StringArrayVar(&phases, "phase", []string{}, "Only show the operations which completed in the phase, one of Succeeded, Failed or Error (can be repeated). Implies --operations")
 	return command
 }
 
+// printOperationHistory prints the completed operations of the operation history. They are returned newest
+// first, but printed oldest first
+func printOperationHistory(history []argoappv1.OperationState) {
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintf(w, "STARTED\tFINISHED\tPHASE\tREVISION\tRETRIES\tMESSAGE\n")
+	for i := len(history) - 1; i >= 0; i-- {
+		opState := history[i]
+		finishedAt := ""
+		if opState.FinishedAt != nil {
+			finishedAt = opState.FinishedAt.String()
+		}
+		revision := ""
+		if opState.SyncResult != nil {
+			revision = opState.SyncResult.Revision
+		}
+		phase := string(opState.Phase)
+		if opState.Terminated {
+			phase = "Terminated"
+		}
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%d\t%s\n", opState.StartedAt, finishedAt, phase, revision, len(opState.Attempts), opState.Message)
+	}
+	_ = w.Flush()
+}
+
 func paramString(params []*argoappv1.ComponentParameter) string {
 	if len(params) == 0 {
 		return ""
@@ -1250,8 +1725,9 @@ func paramString(params []*argoappv1.ComponentParameter) string {
 // NewApplicationRollbackCommand returns a new instance of an `argocd app rollback` command
 func NewApplicationRollbackCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
 	var (
-		prune   bool
-		timeout uint
+		prune          bool
+		timeout        uint
+		idempotencyKey string
 	)
 	var command = &cobra.Command{
 		Use:   "rollback APPNAME",
@@ -1281,9 +1757,10 @@ func NewApplicationRollbackCommand(clientOpts *argocdclient.ClientOptions) *cobr
 			}
 
 			_, err = appIf.Rollback(ctx, &application.ApplicationRollbackRequest{
-				Name:  &appName,
-				ID:    int64(depID),
-				Prune: prune,
+				Name:           &appName,
+				ID:             int64(depID),
+				Prune:          prune,
+				IdempotencyKey: idempotencyKey,
 			})
 			errors.CheckError(err)
 
@@ -1293,6 +1770,80 @@ func NewApplicationRollbackCommand(clientOpts *argocdclient.ClientOptions) *cobr
 	}
 	command.Flags().BoolVar(&prune, "prune", false, "Allow deleting unexpected resources")
 	command.Flags().UintVar(&timeout, "timeout", defaultCheckTimeoutSeconds, "Time out after this many seconds")
+	command.Flags().StringVar(&idempotencyKey, "idempotency-key", "", "Unique key of the rollback. If a rollback with the key was already started, it is not started again")
+	return command
+}
+
+// NewApplicationMoveCommand returns a new instance of an `argocd app move` command
+func NewApplicationMoveCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	var (
+		destServer         string
+		destNamespace      string
+		dryRun             bool
+		prune              bool
+		confirmCRDDeletion bool
+		propagationPolicy  string
+		timeout            uint
+	)
+	var command = &cobra.Command{
+		Use:   "move APPNAME",
+		Short: "Move an application to a new destination cluster or namespace",
+		Long:  "Move an application to a new destination cluster or namespace. Its resources are created in the new destination, and pruned from the current destination once they are healthy.",
+		Run: func(c *cobra.Command, args []string) {
+			if len(args) != 1 || (destServer == "" && destNamespace == "") {
+				c.HelpFunc()(c, args)
+				os.Exit(1)
+			}
+			appName := args[0]
+			conn, appIf := argocdclient.NewClientOrDie(clientOpts).NewApplicationClientOrDie()
+			defer util.Close(conn)
+			ctx := context.Background()
+			app, err := appIf.Get(ctx, &application.ApplicationQuery{Name: &appName})
+			errors.CheckError(err)
+			from := app.Spec.Destination
+			dest := from
+			if destServer != "" {
+				dest.Server = destServer
+			}
+			if destNamespace != "" {
+				dest.Namespace = destNamespace
+			}
+			res, err := appIf.Move(ctx, &application.ApplicationMoveRequest{
+				Name:                   &appName,
+				Destination:            dest,
+				DryRun:                 dryRun,
+				Prune:                  prune,
+				ConfirmCRDDeletion:     confirmCRDDeletion,
+				PrunePropagationPolicy: propagationPolicy,
+			})
+			errors.CheckError(err)
+			w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+			fmt.Fprintf(w, "DESTINATION\tACTION\tGROUP\tKIND\tNAMESPACE\tNAME\n")
+			for _, planRes := range res.Target {
+				fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%s\n", dest.Server, planRes.Action, planRes.Group, planRes.Kind, planRes.Namespace, planRes.Name)
+			}
+			for _, planRes := range res.Source {
+				fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%s\n", from.Server, planRes.Action, planRes.Group, planRes.Kind, planRes.Namespace, planRes.Name)
+			}
+			_ = w.Flush()
+			if dryRun {
+				return
+			}
+			fmt.Println()
+			app, err = waitOnApplicationStatus(appIf, appName, timeout, false, false, true, nil)
+			errors.CheckError(err)
+			if !app.Status.OperationState.Phase.Successful() {
+				os.Exit(1)
+			}
+		},
+	}
+	command.Flags().StringVar(&destServer, "dest-server", "", "K8s cluster URL to move the application to. Defaults to the current cluster")
+	command.Flags().StringVar(&destNamespace, "dest-namespace", "", "K8s namespace to move the application to. Defaults to the current namespace")
+	command.Flags().BoolVar(&dryRun, "dry-run", false, "Print the plan of the move without moving the application")
+	command.Flags().BoolVar(&prune, "prune", false, "Allow deleting unexpected resources in the new destination")
+	command.Flags().BoolVar(&confirmCRDDeletion, "confirm-crd-deletion", false, "Allow pruning custom resource definitions which have instances outside of the application")
+	command.Flags().StringVar(&propagationPolicy, "prune-propagation-policy", "", "Deletion propagation policy of pruned resources (one of: foreground|background|orphan)")
+	command.Flags().UintVar(&timeout, "timeout", defaultCheckTimeoutSeconds, "Time out after this many seconds")
 	return command
 }
 
@@ -1303,7 +1854,14 @@ func printOperationResult(opState *argoappv1.OperationState) {
 	if opState.SyncResult != nil {
 		fmt.Printf(printOpFmtStr, "Operation:", "Sync")
 	}
+	if opState.Operation.Sync != nil && opState.Operation.Sync.MoveFrom != nil {
+		moveFrom := opState.Operation.Sync.MoveFrom
+		fmt.Printf(printOpFmtStr, "Moved From:", fmt.Sprintf("%s/%s", moveFrom.Server, moveFrom.Namespace))
+	}
 	fmt.Printf(printOpFmtStr, "Phase:", opState.Phase)
+	if opState.Terminated {
+		fmt.Printf(printOpFmtStr, "Terminated:", "true")
+	}
 	fmt.Printf(printOpFmtStr, "Start:", opState.StartedAt)
 	fmt.Printf(printOpFmtStr, "Finished:", opState.FinishedAt)
 	var duration time.Duration
@@ -1313,16 +1871,43 @@ func printOperationResult(opState *argoappv1.OperationState) {
 		duration = time.Second * time.Duration(time.Now().UTC().Unix()-opState.StartedAt.Unix())
 	}
 	fmt.Printf(printOpFmtStr, "Duration:", duration)
+	if len(opState.Attempts) > 0 {
+		fmt.Printf(printOpFmtStr, "Retries:", fmt.Sprintf("%d", len(opState.Attempts)))
+	}
+	if opState.SyncResult != nil && opState.SyncResult.Summary != "" {
+		fmt.Printf(printOpFmtStr, "Summary:", opState.SyncResult.Summary)
+	}
 	if opState.Message != "" {
 		fmt.Printf(printOpFmtStr, "Message:", opState.Message)
 	}
 }
 
+// printDryRunDiffs prints the diffs of the resources which a dry-run sync would update
+func printDryRunDiffs(opState *argoappv1.OperationState) {
+	if opState == nil || opState.SyncResult == nil || opState.Operation.Sync == nil || !opState.Operation.Sync.DryRun {
+		return
+	}
+	for _, res := range opState.SyncResult.Resources {
+		if res.Diff == "" {
+			continue
+		}
+		fmt.Println()
+		fmt.Printf("===== %s %s ======\n", res.Kind, res.Name)
+		fmt.Println(res.Diff)
+	}
+}
+
+// firstLine returns the first line of a message, which is shown in tables
+func firstLine(message string) string {
+	return strings.SplitN(message, "\n", 2)[0]
+}
+
 // NewApplicationManifestsCommand returns a new instance of an `argocd app manifests` command
 func NewApplicationManifestsCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
 	var (
-		source   string
-		revision string
+		source    string
+		revision  string
+		historyID int64
 	)
 	var command = &cobra.Command{
 		Use:   "manifests APPNAME",
@@ -1363,6 +1948,17 @@ func NewApplicationManifestsCommand(clientOpts *argocdclient.ClientOptions) *cob
 				liveObjs, err := liveObjects(resources.Items)
 				errors.CheckError(err)
 				unstructureds = liveObjs
+			case "history":
+				if historyID < 0 {
+					log.Fatal("--history-id is required when source is 'history'")
+				}
+				res, err := appIf.SyncedManifests(ctx, &services.SyncedManifestsQuery{ApplicationName: &appName, Id: &historyID})
+				errors.CheckError(err)
+				for _, mfst := range res.Manifests {
+					obj, err := argoappv1.UnmarshalToUnstructured(mfst)
+					errors.CheckError(err)
+					unstructureds = append(unstructureds, obj)
+				}
 			default:
 				log.Fatalf("Unknown source type '%s'", source)
 			}
@@ -1375,11 +1971,73 @@ func NewApplicationManifestsCommand(clientOpts *argocdclient.ClientOptions) *cob
 			}
 		},
 	}
-	command.Flags().StringVar(&source, "source", "git", "Source of manifests. One of: live|git")
+	command.Flags().StringVar(&source, "source", "git", "Source of manifests. One of: live|git|history")
 	command.Flags().StringVar(&revision, "revision", "", "Show manifests at a specific revision")
+	command.Flags().Int64Var(&historyID, "history-id", -1, "Show manifests applied by the deployment with the given history id")
+	return command
+}
+
+// NewApplicationResourceTreeCommand returns a new instance of an `argocd app resource-tree` command
+func NewApplicationResourceTreeCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	var output string
+	var command = &cobra.Command{
+		Use:   "resource-tree APPNAME",
+		Short: "Print the live resources of an application, and the resources they control",
+		Run: func(c *cobra.Command, args []string) {
+			if len(args) != 1 {
+				c.HelpFunc()(c, args)
+				os.Exit(1)
+			}
+			appName := args[0]
+			conn, appIf := argocdclient.NewClientOrDie(clientOpts).NewApplicationClientOrDie()
+			defer util.Close(conn)
+			tree, err := appIf.ResourceTree(context.Background(), &services.ResourcesQuery{ApplicationName: &appName})
+			errors.CheckError(err)
+			switch output {
+			case "json":
+				jsonBytes, err := json.MarshalIndent(tree, "", "  ")
+				errors.CheckError(err)
+				fmt.Println(string(jsonBytes))
+			case "":
+				w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+				fmt.Fprintf(w, "KIND\tNAMESPACE\tNAME\tHEALTH\n")
+				printResourceTree(w, tree.Nodes, nil, 0)
+				_ = w.Flush()
+			default:
+				log.Fatalf("Unknown output format: %s", output)
+			}
+		},
+	}
+	command.Flags().StringVarP(&output, "output", "o", "", "Output format. One of: json")
 	return command
 }
 
+// printResourceTree prints the nodes whose parent is the given resource, each followed by its own
+// children indented one level deeper
+func printResourceTree(w io.Writer, nodes []argoappv1.ResourceTreeNode, parent *argoappv1.ResourceRef, depth int) {
+	for i := range nodes {
+		node := nodes[i]
+		if (parent == nil && len(node.ParentRefs) > 0) || (parent != nil && !hasParentRef(node, *parent)) {
+			continue
+		}
+		var health argoappv1.HealthStatusCode
+		if node.Health != nil {
+			health = node.Health.Status
+		}
+		fmt.Fprintf(w, "%s%s\t%s\t%s\t%s\n", strings.Repeat("  ", depth), node.Kind, node.Namespace, node.Name, health)
+		printResourceTree(w, nodes, &node.ResourceRef, depth+1)
+	}
+}
+
+func hasParentRef(node argoappv1.ResourceTreeNode, parent argoappv1.ResourceRef) bool {
+	for _, ref := range node.ParentRefs {
+		if ref == parent {
+			return true
+		}
+	}
+	return false
+}
+
 // NewApplicationTerminateOpCommand returns a new instance of an `argocd app terminate-op` command
 func NewApplicationTerminateOpCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
 	var command = &cobra.Command{
@@ -1401,3 +2059,77 @@ func NewApplicationTerminateOpCommand(clientOpts *argocdclient.ClientOptions) *c
 	}
 	return command
 }
+
+// NewApplicationPatchResourceCommand returns a new instance of an `argocd app patch-resource` command
+func NewApplicationPatchResourceCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	var (
+		resourceName string
+		apiVersion   string
+		kind         string
+		patch        string
+		patchType    string
+	)
+	var command = &cobra.Command{
+		Use:   "patch-resource APPNAME",
+		Short: "Patch a live resource of an application",
+		Run: func(c *cobra.Command, args []string) {
+			if len(args) != 1 || resourceName == "" || apiVersion == "" || kind == "" || patch == "" {
+				c.HelpFunc()(c, args)
+				os.Exit(1)
+			}
+			appName := args[0]
+			conn, appIf := argocdclient.NewClientOrDie(clientOpts).NewApplicationClientOrDie()
+			defer util.Close(conn)
+			_, err := appIf.PatchResource(context.Background(), &application.ApplicationPatchResourceRequest{
+				Name:         &appName,
+				ResourceName: resourceName,
+				APIVersion:   apiVersion,
+				Kind:         kind,
+				Patch:        patch,
+				PatchType:    patchType,
+			})
+			errors.CheckError(err)
+			fmt.Printf("%s %s of application '%s' patched\n", kind, resourceName, appName)
+		},
+	}
+	command.Flags().StringVar(&resourceName, "resource-name", "", "Name of the resource")
+	command.Flags().StringVar(&apiVersion, "api-version", "", "API version of the resource (e.g. v1, apps/v1)")
+	command.Flags().StringVar(&kind, "kind", "", "Kind of the resource")
+	command.Flags().StringVar(&patch, "patch", "", "Patch applied to the resource")
+	command.Flags().StringVar(&patchType, "patch-type", "merge", "Type of the patch (one of: json|merge|strategic)")
+	return command
+}
+
+// NewApplicationDeleteResourceCommand returns a new instance of an `argocd app delete-resource` command
+func NewApplicationDeleteResourceCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	var (
+		resourceName string
+		apiVersion   string
+		kind         string
+	)
+	var command = &cobra.Command{
+		Use:   "delete-resource APPNAME",
+		Short: "Delete a live resource of an application",
+		Run: func(c *cobra.Command, args []string) {
+			if len(args) != 1 || resourceName == "" || apiVersion == "" || kind == "" {
+				c.HelpFunc()(c, args)
+				os.Exit(1)
+			}
+			appName := args[0]
+			conn, appIf := argocdclient.NewClientOrDie(clientOpts).NewApplicationClientOrDie()
+			defer util.Close(conn)
+			_, err := appIf.DeleteResource(context.Background(), &application.ApplicationDeleteResourceRequest{
+				Name:         &appName,
+				ResourceName: resourceName,
+				APIVersion:   apiVersion,
+				Kind:         kind,
+			})
+			errors.CheckError(err)
+			fmt.Printf("%s %s of application '%s' deleted\n", kind, resourceName, appName)
+		},
+	}
+	command.Flags().StringVar(&resourceName, "resource-name", "", "Name of the resource")
+	command.Flags().StringVar(&apiVersion, "api-version", "", "API version of the resource (e.g. v1, apps/v1)")
+	command.Flags().StringVar(&kind, "kind", "", "Kind of the resource")
+	return command
+}
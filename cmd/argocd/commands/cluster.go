@@ -15,6 +15,7 @@ import (
 	argoappv1 "github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1"
 	"github.com/argoproj/argo-cd/server/cluster"
 	"github.com/argoproj/argo-cd/util"
+	"github.com/argoproj/argo-cd/util/diff"
 	"github.com/ghodss/yaml"
 	log "github.com/sirupsen/logrus"
 	"github.com/spf13/cobra"
@@ -44,15 +45,24 @@ func NewClusterCommand(clientOpts *argocdclient.ClientOptions, pathOpts *clientc
 // NewClusterAddCommand returns a new instance of an `argocd cluster add` command
 func NewClusterAddCommand(clientOpts *argocdclient.ClientOptions, pathOpts *clientcmd.PathOptions) *cobra.Command {
 	var (
-		inCluster      bool
-		upsert         bool
-		awsRoleArn     string
-		awsClusterName string
+		inCluster          bool
+		serviceAccount     bool
+		upsert             bool
+		awsRoleArn         string
+		awsClusterName     string
+		networkConfig      argoappv1.ClusterNetworkConfig
+		normalizerProfiles []string
+		labels             []string
 	)
 	var command = &cobra.Command{
 		Use:   "add",
 		Short: fmt.Sprintf("%s cluster add CONTEXT", cliName),
 		Run: func(c *cobra.Command, args []string) {
+			clusterLabels := parseClusterLabels(labels)
+			if serviceAccount {
+				addInClusterServiceAccount(clientOpts, upsert, networkConfig, normalizerProfiles, clusterLabels)
+				return
+			}
 			var configAccess clientcmd.ConfigAccess = pathOpts
 			if len(args) == 0 {
 				log.Error("Choose a context name from:")
@@ -93,6 +103,11 @@ func NewClusterAddCommand(clientOpts *argocdclient.ClientOptions, pathOpts *clie
 			if inCluster {
 				clst.Server = common.KubernetesInternalAPIServerAddr
 			}
+			if networkConfig != (argoappv1.ClusterNetworkConfig{}) {
+				clst.Config.NetworkConfig = &networkConfig
+			}
+			clst.NormalizerProfiles = normalizerProfiles
+			clst.Labels = clusterLabels
 			clstCreateReq := cluster.ClusterCreateRequest{
 				Cluster: clst,
 				Upsert:  upsert,
@@ -104,12 +119,57 @@ func NewClusterAddCommand(clientOpts *argocdclient.ClientOptions, pathOpts *clie
 	}
 	command.PersistentFlags().StringVar(&pathOpts.LoadingRules.ExplicitPath, pathOpts.ExplicitFileFlag, pathOpts.LoadingRules.ExplicitPath, "use a particular kubeconfig file")
 	command.Flags().BoolVar(&inCluster, "in-cluster", false, "Indicates Argo CD resides inside this cluster and should connect using the internal k8s hostname (kubernetes.default.svc)")
+	command.Flags().BoolVar(&serviceAccount, "in-cluster-service-account", false, "Register the cluster Argo CD resides in, which is accessed using the service accounts of Argo CD instead of a bearer token. No context is required")
 	command.Flags().BoolVar(&upsert, "upsert", false, "Override an existing cluster with the same name even if the spec differs")
 	command.Flags().StringVar(&awsClusterName, "aws-cluster-name", "", "AWS Cluster name if set then aws-iam-authenticator will be used to access cluster")
 	command.Flags().StringVar(&awsRoleArn, "aws-role-arn", "", "Optional AWS role arn. If set then AWS IAM Authenticator assume a role to perform cluster operations instead of the default AWS credential provider chain.")
+	command.Flags().Int64Var(&networkConfig.DialTimeoutSeconds, "dial-timeout", 0, "Time in seconds to wait for a connection to the cluster to be established")
+	command.Flags().Int64Var(&networkConfig.RequestTimeoutSeconds, "request-timeout", 0, "Time in seconds to wait for the response of a single request to the cluster")
+	command.Flags().Int64Var(&networkConfig.RetryLimit, "retry-limit", 0, "Number of times failed read requests to the cluster are retried")
+	command.Flags().Int64Var(&networkConfig.RetryBackoffSeconds, "retry-backoff", 0, "Time in seconds to wait before retrying a failed request (default 1). Doubles after every retry")
+	command.Flags().StringArrayVar(&normalizerProfiles, "normalizer-profile", []string{}, fmt.Sprintf("Ignore the fields set by an operator when diffing the resources of the cluster. One of: %s", strings.Join(diff.NormalizerProfiles(), ", ")))
+	command.Flags().StringArrayVar(&labels, "label", []string{}, "Label of the cluster, selected by the destination selectors of applications (e.g. --label env=prod)")
 	return command
 }
 
+// parseClusterLabels parses the labels of a cluster in the form KEY=VALUE
+func parseClusterLabels(labels []string) map[string]string {
+	if len(labels) == 0 {
+		return nil
+	}
+	clusterLabels := make(map[string]string)
+	for _, label := range labels {
+		parts := strings.SplitN(label, "=", 2)
+		if len(parts) != 2 {
+			log.Fatalf("Expected label of the form: KEY=VALUE. Received: %s", label)
+		}
+		clusterLabels[parts[0]] = parts[1]
+	}
+	return clusterLabels
+}
+
+// addInClusterServiceAccount registers the cluster Argo CD resides in, without credentials of its
+// own. Argo CD accesses it using the service accounts mounted into its pods.
+func addInClusterServiceAccount(clientOpts *argocdclient.ClientOptions, upsert bool, networkConfig argoappv1.ClusterNetworkConfig, normalizerProfiles []string, labels map[string]string) {
+	clst := &argoappv1.Cluster{
+		Server:             common.KubernetesInternalAPIServerAddr,
+		Name:               common.InClusterName,
+		NormalizerProfiles: normalizerProfiles,
+		Labels:             labels,
+	}
+	if networkConfig != (argoappv1.ClusterNetworkConfig{}) {
+		clst.Config.NetworkConfig = &networkConfig
+	}
+	conn, clusterIf := argocdclient.NewClientOrDie(clientOpts).NewClusterClientOrDie()
+	defer util.Close(conn)
+	clst, err := clusterIf.Create(context.Background(), &cluster.ClusterCreateRequest{
+		Cluster: clst,
+		Upsert:  upsert,
+	})
+	errors.CheckError(err)
+	fmt.Printf("Cluster '%s' added\n", clst.Name)
+}
+
 func printKubeContexts(ca clientcmd.ConfigAccess) {
 	config, err := ca.GetStartingConfig()
 	errors.CheckError(err)
@@ -17,7 +17,6 @@ import (
 
 	"github.com/argoproj/argo-cd/errors"
 	argocdclient "github.com/argoproj/argo-cd/pkg/apiclient"
-	"github.com/argoproj/argo-cd/server/session"
 	"github.com/argoproj/argo-cd/server/settings"
 	"github.com/argoproj/argo-cd/util"
 	"github.com/argoproj/argo-cd/util/cli"
@@ -274,13 +273,7 @@ func oauth2Login(ctx context.Context, port int, oauth2conf *oauth2.Config, provi
 
 func passwordLogin(acdClient argocdclient.Client, username, password string) string {
 	username, password = cli.PromptCredentials(username, password)
-	sessConn, sessionIf := acdClient.NewSessionClientOrDie()
-	defer util.Close(sessConn)
-	sessionRequest := session.SessionCreateRequest{
-		Username: username,
-		Password: password,
-	}
-	createdSession, err := sessionIf.Create(context.Background(), &sessionRequest)
+	err := acdClient.Login(context.Background(), username, password)
 	errors.CheckError(err)
-	return createdSession.Token
+	return acdClient.ClientOptions().AuthToken
 }
@@ -27,6 +27,9 @@ type projectOpts struct {
 	description  string
 	destinations []string
 	sources      []string
+
+	orphanedResourcesEnabled bool
+	orphanedResourcesWarn    bool
 }
 
 type policyOpts struct {
@@ -51,6 +54,20 @@ func (opts *projectOpts) GetDestinations() []v1alpha1.ApplicationDestination {
 	return destinations
 }
 
+// GetOrphanedResourcesSettings returns the orphaned resources monitoring settings of the project, or nil
+// if orphaned resources are not monitored
+func (opts *projectOpts) GetOrphanedResourcesSettings(flags *pflag.FlagSet) *v1alpha1.OrphanedResourcesMonitorSettings {
+	warnChanged := flags.Changed("orphaned-resources-warn")
+	if !opts.orphanedResourcesEnabled && !warnChanged {
+		return nil
+	}
+	settings := &v1alpha1.OrphanedResourcesMonitorSettings{}
+	if warnChanged {
+		settings.Warn = &opts.orphanedResourcesWarn
+	}
+	return settings
+}
+
 // NewProjectCommand returns a new instance of an `argocd proj` command
 func NewProjectCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
 	var command = &cobra.Command{
@@ -83,6 +100,8 @@ func addProjFlags(command *cobra.Command, opts *projectOpts) {
 	command.Flags().StringArrayVarP(&opts.destinations, "dest", "d", []string{},
 		"Permitted destination server and namespace (e.g. https://192.168.99.100:8443,default)")
 	command.Flags().StringArrayVarP(&opts.sources, "src", "s", []string{}, "Permitted git source repository URL")
+	command.Flags().BoolVar(&opts.orphanedResourcesEnabled, "orphaned-resources", false, "Enables orphaned resources monitoring")
+	command.Flags().BoolVar(&opts.orphanedResourcesWarn, "orphaned-resources-warn", true, "Specifies if applications should have a warning condition when orphaned resources are detected")
 }
 
 func addPolicyFlags(command *cobra.Command, opts *policyOpts) {
@@ -116,6 +135,8 @@ func NewProjectCreateCommand(clientOpts *argocdclient.ClientOptions) *cobra.Comm
 					Description:  opts.description,
 					Destinations: opts.GetDestinations(),
 					SourceRepos:  opts.sources,
+
+					OrphanedResources: opts.GetOrphanedResourcesSettings(c.Flags()),
 				},
 			}
 			conn, projIf := argocdclient.NewClientOrDie(clientOpts).NewProjectClientOrDie()
@@ -159,6 +180,8 @@ func NewProjectSetCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command
 					proj.Spec.Destinations = opts.GetDestinations()
 				case "src":
 					proj.Spec.SourceRepos = opts.sources
+				case "orphaned-resources", "orphaned-resources-warn":
+					proj.Spec.OrphanedResources = opts.GetOrphanedResourcesSettings(c.Flags())
 				}
 			})
 			if visited == 0 {
@@ -557,6 +580,13 @@ func NewProjectGetCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command
 			for i := 1; i < len(p.Spec.NamespaceResourceBlacklist); i++ {
 				fmt.Printf(printProjFmtStr, "", fmt.Sprintf("%s/%s", p.Spec.NamespaceResourceBlacklist[i].Group, p.Spec.NamespaceResourceBlacklist[i].Kind))
 			}
+
+			// Print orphaned resources monitoring settings
+			orphanedResources := "disabled"
+			if p.Spec.OrphanedResources != nil {
+				orphanedResources = fmt.Sprintf("enabled (warn=%v)", p.Spec.OrphanedResources.IsWarn())
+			}
+			fmt.Printf(printProjFmtStr, "Orphaned Resources:", orphanedResources)
 		},
 	}
 	return command
@@ -5,6 +5,8 @@ import (
 	"fmt"
 	"io/ioutil"
 	"os"
+	"path/filepath"
+	"strings"
 	"text/tabwriter"
 
 	log "github.com/sirupsen/logrus"
@@ -17,6 +19,7 @@ import (
 	"github.com/argoproj/argo-cd/util"
 	"github.com/argoproj/argo-cd/util/cli"
 	"github.com/argoproj/argo-cd/util/git"
+	"github.com/argoproj/argo-cd/util/helm"
 )
 
 // NewRepoCommand returns a new instance of an `argocd repo` command
@@ -33,6 +36,7 @@ func NewRepoCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
 	command.AddCommand(NewRepoAddCommand(clientOpts))
 	command.AddCommand(NewRepoListCommand(clientOpts))
 	command.AddCommand(NewRepoRemoveCommand(clientOpts))
+	command.AddCommand(NewRepoConvertKsonnetCommand(clientOpts))
 	return command
 }
 
@@ -45,7 +49,7 @@ func NewRepoAddCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
 	)
 	var command = &cobra.Command{
 		Use:   "add REPO",
-		Short: "Add git repository credentials",
+		Short: "Add git or helm repository credentials",
 		Run: func(c *cobra.Command, args []string) {
 			if len(args) != 1 {
 				c.HelpFunc()(c, args)
@@ -64,9 +68,14 @@ func NewRepoAddCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
 			// NOTE: it is important not to run git commands to test git credentials on the user's
 			// system since it may mess with their git credential store (e.g. osx keychain).
 			// See issue #315
-			err := git.TestRepo(repo.Repo, "", "", repo.SSHPrivateKey)
+			var err error
+			if repo.IsHelm() {
+				err = helm.TestRepo(repo.Repo, "", "")
+			} else {
+				err = git.TestRepo(repo.Repo, "", "", repo.SSHPrivateKey)
+			}
 			if err != nil {
-				if git.IsSSHURL(repo.Repo) {
+				if !repo.IsHelm() && git.IsSSHURL(repo.Repo) {
 					// If we failed using git SSH credentials, then the repo is automatically bad
 					log.Fatal(err)
 				}
@@ -89,6 +98,7 @@ func NewRepoAddCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
 	command.Flags().StringVar(&repo.Password, "password", "", "password to the repository")
 	command.Flags().StringVar(&sshPrivateKeyPath, "ssh-private-key-path", "", "path to the private ssh key (e.g. ~/.ssh/id_rsa)")
 	command.Flags().BoolVar(&upsert, "upsert", false, "Override an existing repository with the same name even if the spec differs")
+	command.Flags().StringVar(&repo.Type, "type", "", "type of the repository, one of: git|helm (defaults to git)")
 	return command
 }
 
@@ -133,3 +143,50 @@ func NewRepoListCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
 	}
 	return command
 }
+
+// NewRepoConvertKsonnetCommand returns a new instance of an `argocd repo convert-ksonnet` command
+func NewRepoConvertKsonnetCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	var (
+		path      string
+		revision  string
+		format    string
+		envs      []string
+		outputDir string
+	)
+	var command = &cobra.Command{
+		Use:   "convert-ksonnet REPO",
+		Short: "Convert a ksonnet app of a repository to plain manifests of each environment",
+		Run: func(c *cobra.Command, args []string) {
+			if len(args) != 1 || path == "" || outputDir == "" {
+				c.HelpFunc()(c, args)
+				os.Exit(1)
+			}
+			conn, repoIf := argocdclient.NewClientOrDie(clientOpts).NewRepoClientOrDie()
+			defer util.Close(conn)
+			res, err := repoIf.ConvertKsonnetApp(context.Background(), &repository.RepoKsonnetConversionQuery{
+				Repo:         args[0],
+				Revision:     revision,
+				Path:         path,
+				Format:       format,
+				Environments: envs,
+			})
+			errors.CheckError(err)
+			for _, f := range res.Files {
+				filePath := filepath.Join(outputDir, filepath.FromSlash(f.Path))
+				if !strings.HasPrefix(filePath, filepath.Clean(outputDir)+string(filepath.Separator)) {
+					log.Fatalf("Converted file %s is outside of the output directory", f.Path)
+				}
+				errors.CheckError(os.MkdirAll(filepath.Dir(filePath), 0755))
+				errors.CheckError(ioutil.WriteFile(filePath, f.Data, 0644))
+				fmt.Println(filePath)
+			}
+			fmt.Printf("Converted %s at revision %s\n", path, res.Revision)
+		},
+	}
+	command.Flags().StringVar(&path, "path", "", "Path of the ksonnet app in the repository")
+	command.Flags().StringVar(&revision, "revision", "", "Revision of the repository (defaults to HEAD)")
+	command.Flags().StringVar(&format, "format", "directory", "Structure of the converted app. One of: directory|kustomize")
+	command.Flags().StringArrayVar(&envs, "env", []string{}, "Environment to convert (can be repeated). All environments are converted if not specified")
+	command.Flags().StringVar(&outputDir, "output-dir", "", "Directory the converted app is written to")
+	return command
+}
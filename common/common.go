@@ -20,6 +20,9 @@ const (
 
 	// KubernetesInternalAPIServerAddr is address of the k8s API server when accessing internal to the cluster
 	KubernetesInternalAPIServerAddr = "https://kubernetes.default.svc"
+	// InClusterName designates the cluster Argo CD runs in, which is accessed using the service
+	// accounts mounted into the Argo CD pods. It may be used instead of the internal API server address
+	InClusterName = "in-cluster"
 
 	// ManagedByAnnotation is annotation name which indicates that k8s resource is managed by an application.
 	ManagedByAnnotation = "managed-by"
@@ -66,8 +69,19 @@ const (
 	EnvVarRBACDebug = "ARGOCD_RBAC_DEBUG"
 	// DefaultAppProjectName contains name of default app project. The default app project allows deploying application to any cluster.
 	DefaultAppProjectName = "default"
+	// ArgoCDSelfAppName is the name of the application which Argo CD uses to manage its own components
+	ArgoCDSelfAppName = "argocd"
 )
 
+// ArgoCDComponentUpgradeOrder is the order in which Argo CD component deployments are updated when
+// Argo CD syncs itself. The repo server is updated first so that the controller and API server
+// never run against an older repo server.
+var ArgoCDComponentUpgradeOrder = []string{
+	"argocd-repo-server",
+	"application-controller",
+	"argocd-server",
+}
+
 var (
 	// LabelKeyAppInstance refers to the application instance resource name
 	LabelKeyAppInstance = MetadataPrefix + "/app-instance"
@@ -86,22 +100,63 @@ var (
 	AnnotationHook = MetadataPrefix + "/hook"
 	// AnnotationHookDeletePolicy is the policy of deleting a hook
 	AnnotationHookDeletePolicy = MetadataPrefix + "/hook-delete-policy"
+	// AnnotationHookWeight orders the hooks of a phase. Hooks of lower weights complete before hooks of higher weights start
+	AnnotationHookWeight = MetadataPrefix + "/hook-weight"
+	// AnnotationSyncWave is the sync wave a resource is applied in
+	AnnotationSyncWave = MetadataPrefix + "/sync-wave"
+	// AnnotationSyncOptions contains a comma separated list of sync options of a resource
+	AnnotationSyncOptions = MetadataPrefix + "/sync-options"
+	// AnnotationConfirmCRDDeletion confirms the cascaded deletion of custom resource definitions, which have instances outside of the application
+	AnnotationConfirmCRDDeletion = MetadataPrefix + "/confirm-crd-deletion"
+	// AnnotationDeletionPropagationPolicy is the deletion propagation policy (foreground or background) of the resources of the cascaded deletion of an application
+	AnnotationDeletionPropagationPolicy = MetadataPrefix + "/deletion-propagation-policy"
 	// AnnotationHelmHook is the helm hook annotation
 	AnnotationHelmHook = "helm.sh/hook"
 
 	// HelmHookCRDInstall is a value of crd helm hook
 	HelmHookCRDInstall = "crd-install"
 
+	// SyncOptionSkipDryRunOnMissingResource skips the dry run of a resource whose type is not yet known to the cluster
+	SyncOptionSkipDryRunOnMissingResource = "SkipDryRunOnMissingResource=true"
+	// SyncOptionReplace deletes and re-creates an out of sync resource instead of applying it
+	SyncOptionReplace = "Replace=true"
+	// SyncOptionReplaceOnImmutableFieldError deletes and re-creates a resource whose apply fails because an immutable field changed
+	SyncOptionReplaceOnImmutableFieldError = "ReplaceOnImmutableFieldError=true"
+	// SyncOptionApplyOutOfSyncOnly skips the resources of an application which are already in sync
+	SyncOptionApplyOutOfSyncOnly = "ApplyOutOfSyncOnly=true"
+	// SyncOptionRewriteDeprecatedAPIs rewrites resources of deprecated API versions to the versions served by the destination cluster
+	SyncOptionRewriteDeprecatedAPIs = "RewriteDeprecatedAPIs=true"
+	// SyncOptionDisablePrune prevents a resource from being pruned, even if pruning is enabled
+	SyncOptionDisablePrune = "Prune=false"
+	// SyncOptionCreateNamespace creates the destination namespace of an application if it does not exist
+	SyncOptionCreateNamespace = "CreateNamespace=true"
+	// SyncOptionWaitForDeletion waits for pruned resources to be deleted before proceeding with the sync
+	SyncOptionWaitForDeletion = "WaitForDeletion=true"
+	// SyncOptionDisableValidation applies resources without validating them against their schema
+	SyncOptionDisableValidation = "Validate=false"
+	// SyncOptionDisableQuotaCheck syncs an application without checking its resources against the resource quotas of the destination namespaces
+	SyncOptionDisableQuotaCheck = "QuotaCheck=false"
+	// SyncOptionServerSideDiff diffs the live state of a resource against the object returned by a server-side dry-run apply of its target state
+	SyncOptionServerSideDiff = "ServerSideDiff=true"
+
 	// LabelKeyApplicationControllerInstanceID is the label which allows to separate application among multiple running application controllers.
 	LabelKeyApplicationControllerInstanceID = application.ApplicationFullName + "/controller-instanceid"
 
 	// LabelApplicationName is the label which indicates that resource belongs to application with the specified name
 	LabelApplicationName = application.ApplicationFullName + "/app-name"
 
-	// AnnotationKeyRefresh is the annotation key in the application which is updated with an
-	// arbitrary value (i.e. timestamp) on a git event, to  force the controller to wake up and
-	// re-evaluate the application
+	// AnnotationKeyRefresh is the annotation key in the application which is updated with a
+	// timestamp when a refresh is requested (e.g. on a git event), to force the controller to wake up
+	// and re-evaluate the application if it was not compared since the timestamp
 	AnnotationKeyRefresh = application.ApplicationFullName + "/refresh"
+	// AnnotationKeyHardRefresh is the annotation key in the application which is updated with a
+	// timestamp when a hard refresh is requested. Comparisons which happen before the comparison
+	// timestamp passes it regenerate the manifests instead of using the manifests cached by the
+	// repo server
+	AnnotationKeyHardRefresh = application.ApplicationFullName + "/hard-refresh"
+	// AnnotationKeyOperationCompactAfter is the annotation key in the application which overrides the
+	// duration after which the details of its completed operations are compacted, e.g. 1h
+	AnnotationKeyOperationCompactAfter = application.ApplicationFullName + "/operation-compact-after"
 )
 
 // ArgoCDManagerServiceAccount is the name of the service account for managing a cluster
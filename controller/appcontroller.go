@@ -29,9 +29,9 @@ import (
 	"k8s.io/apimachinery/pkg/util/strategicpatch"
 	"k8s.io/apimachinery/pkg/util/wait"
 	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/client-go/dynamic"
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/tools/cache"
-	"k8s.io/client-go/util/workqueue"
 
 	"github.com/argoproj/argo-cd/common"
 	"github.com/argoproj/argo-cd/controller/services"
@@ -52,6 +52,32 @@ import (
 const (
 	watchResourcesRetryTimeout  = 10 * time.Second
 	updateOperationStateTimeout = 1 * time.Second
+	// terminationRecheckDelay is the delay after which a terminating operation checks again whether
+	// its hooks were deleted
+	terminationRecheckDelay = 5 * time.Second
+	// operationTimedOutMessage prefixes the message of operations terminated since they timed out
+	operationTimedOutMessage = "Operation timed out after"
+	// repoServerWaitTimeout is how long a sync operation waits for a repo server to become available
+	// before it fails
+	repoServerWaitTimeout = 5 * time.Minute
+	// repoServerRecheckDelay is the delay after which an operation waiting for a repo server checks
+	// again whether one is available
+	repoServerRecheckDelay = 10 * time.Second
+	// waitingForRepoServerMessage prefixes the message of operations waiting for a repo server
+	waitingForRepoServerMessage = "Waiting for a repo server to become available"
+	// componentRolloutRecheckDelay is the delay after which a sync of Argo CD itself checks again
+	// whether the component it updated was rolled out
+	componentRolloutRecheckDelay = 10 * time.Second
+	// waitingForComponentRolloutMessage prefixes the message of syncs of Argo CD itself waiting for an
+	// updated component to be rolled out
+	waitingForComponentRolloutMessage = "waiting for Argo CD component"
+	// selfHealBackoffDuration is the delay between a sync and the first self-heal of the application
+	selfHealBackoffDuration = 5 * time.Second
+	// selfHealBackoffMaxDuration is the maximum delay between consecutive self-heals
+	selfHealBackoffMaxDuration = 3 * time.Minute
+	// selfHealResetDuration is how long an application has to stay synced for the self-heal backoff to
+	// restart
+	selfHealResetDuration = 10 * time.Minute
 )
 
 // ApplicationController is the controller for application resources.
@@ -61,8 +87,8 @@ type ApplicationController struct {
 	kubectl               kube.Kubectl
 	applicationClientset  appclientset.Interface
 	auditLogger           *argo.AuditLogger
-	appRefreshQueue       workqueue.RateLimitingInterface
-	appOperationQueue     workqueue.RateLimitingInterface
+	appRefreshQueue       appQueue
+	appOperationQueue     appQueue
 	appInformer           cache.SharedIndexInformer
 	appStateManager       AppStateManager
 	statusRefreshTimeout  time.Duration
@@ -71,6 +97,40 @@ type ApplicationController struct {
 	forceRefreshApps      map[string]bool
 	forceRefreshAppsMutex *sync.Mutex
 	appResources          cache_util.Cache
+	settingsMgr           *settings_util.SettingsManager
+	syncArtifacts         cache_util.Cache
+	metrics               *controllerMetrics
+	credentialsExpiry     *credentialsExpiryChecker
+	// settings are the settings last updated by the settings notifier
+	settings settingsCache
+	// readOnly prevents the controller from making any changes to the managed clusters
+	readOnly bool
+	// historyRetention controls when the operation state of applications is compacted
+	historyRetention HistoryRetention
+	// defaultStatusRefreshTimeout is the status refresh timeout of the --app-resync flag, which
+	// applies unless argocd-cm changes it at runtime
+	defaultStatusRefreshTimeout time.Duration
+	// statusRefreshTimeoutMutex protects the status refresh timeout from concurrent updates
+	statusRefreshTimeoutMutex *sync.RWMutex
+	// defaultLogLevel is the log level of the --loglevel flag, which applies unless argocd-cm changes it at runtime
+	defaultLogLevel string
+	// instanceID is the ID of the controller instance, which only manages the applications and
+	// resources labeled with it
+	instanceID string
+	// startedAt is the time the controller started
+	startedAt time.Time
+	// staleOperationTimeout is how long the state of a running operation can stay unchanged since
+	// before the controller started, after which the operation is failed instead of resumed
+	staleOperationTimeout time.Duration
+	// resumedOperations are the applications whose operation was resumed since the controller started,
+	// which are checked for stale operations once
+	resumedOperations      map[string]bool
+	resumedOperationsMutex *sync.Mutex
+	// delayedRefreshes are the pending delayed refreshes of the applications, at most one per application
+	delayedRefreshes      map[string]*delayedRefresh
+	delayedRefreshesMutex *sync.Mutex
+	// orphaned holds the orphaned resources of the applications, which are refreshed periodically
+	orphaned *orphanedResourcesCache
 }
 
 type ApplicationControllerConfig struct {
@@ -78,43 +138,109 @@ type ApplicationControllerConfig struct {
 	Namespace  string
 }
 
-// NewApplicationController creates new instance of ApplicationController.
+// NewApplicationController creates new instance of ApplicationController. Live resources of a
+// cluster are retrieved once per liveStateBatchWindow for all applications, unless it is zero, and
+// are rehydrated from liveStateSnapshots after restarts. If liveStateCache is true, live resources
+// are instead watched and kept in memory.
+// A read-only controller only reports the sync and health status of applications, and refuses to
+// perform operations. Rendered manifests of successful syncs are stored in syncArtifacts, unless
+// it is nil. The number of resources pruned or applied in parallel by all syncs is limited to
+// applyConcurrency, unless it is zero. The history and operation state of applications are kept
+// according to historyRetention. Multiple controllers with distinct instanceID can run in the same
+// cluster without managing each other's applications and resources. An application is refreshed or
+// operated at most once per queueItemMinInterval, unless it is zero. The diffs of the resources are
+// persisted in diffStore, unless it is nil, so that they are not recomputed after restarts. Running
+// operations whose state did not change for staleOperationTimeout since before the controller
+// started are failed, unless it is zero.
 func NewApplicationController(
 	namespace string,
 	kubeClientset kubernetes.Interface,
 	applicationClientset appclientset.Interface,
 	repoClientset reposerver.Clientset,
 	appResyncPeriod time.Duration,
+	liveStateBatchWindow time.Duration,
+	liveStateSnapshots LiveStateSnapshots,
+	liveStateCache bool,
+	readOnly bool,
+	syncArtifacts cache_util.Cache,
+	applyConcurrency int64,
+	historyRetention HistoryRetention,
+	instanceID string,
+	queueItemMinInterval time.Duration,
+	diffStore cache_util.Cache,
+	staleOperationTimeout time.Duration,
 ) *ApplicationController {
 	settingsMgr := settings_util.NewSettingsManager(kubeClientset, namespace)
 	db := db.NewDB(namespace, settingsMgr, kubeClientset)
 	kubectlCmd := kube.KubectlCmd{}
-	appStateManager := NewAppStateManager(db, applicationClientset, repoClientset, namespace, kubectlCmd)
+	appStateManager := NewAppStateManager(db, applicationClientset, repoClientset, namespace, kubectlCmd, liveStateBatchWindow, liveStateSnapshots, liveStateCache, syncArtifacts, settingsMgr, applyConcurrency, historyRetention, diffStore)
 	ctrl := ApplicationController{
-		namespace:             namespace,
-		kubeClientset:         kubeClientset,
-		kubectl:               kubectlCmd,
-		applicationClientset:  applicationClientset,
-		repoClientset:         repoClientset,
-		appRefreshQueue:       workqueue.NewRateLimitingQueue(workqueue.DefaultControllerRateLimiter()),
-		appOperationQueue:     workqueue.NewRateLimitingQueue(workqueue.DefaultControllerRateLimiter()),
-		appStateManager:       appStateManager,
-		db:                    db,
-		statusRefreshTimeout:  appResyncPeriod,
-		forceRefreshApps:      make(map[string]bool),
-		forceRefreshAppsMutex: &sync.Mutex{},
-		auditLogger:           argo.NewAuditLogger(namespace, kubeClientset, "application-controller"),
-		appResources:          cache_util.NewInMemoryCache(24 * time.Hour),
-	}
+		namespace:                   namespace,
+		kubeClientset:               kubeClientset,
+		kubectl:                     kubectlCmd,
+		applicationClientset:        applicationClientset,
+		repoClientset:               repoClientset,
+		appStateManager:             appStateManager,
+		db:                          db,
+		statusRefreshTimeout:        appResyncPeriod,
+		defaultStatusRefreshTimeout: appResyncPeriod,
+		statusRefreshTimeoutMutex:   &sync.RWMutex{},
+		defaultLogLevel:             log.GetLevel().String(),
+		forceRefreshApps:            make(map[string]bool),
+		forceRefreshAppsMutex:       &sync.Mutex{},
+		auditLogger:                 argo.NewAuditLogger(namespace, kubeClientset, "application-controller"),
+		appResources:                cache_util.NewInMemoryCache(24 * time.Hour),
+		settingsMgr:                 settingsMgr,
+		syncArtifacts:               syncArtifacts,
+		readOnly:                    readOnly,
+		metrics:                     newControllerMetrics(),
+		historyRetention:            historyRetention,
+		instanceID:                  instanceID,
+		startedAt:                   time.Now(),
+		staleOperationTimeout:       staleOperationTimeout,
+		resumedOperations:           make(map[string]bool),
+		resumedOperationsMutex:      &sync.Mutex{},
+		delayedRefreshes:            make(map[string]*delayedRefresh),
+		delayedRefreshesMutex:       &sync.Mutex{},
+		orphaned:                    newOrphanedResourcesCache(),
+	}
+	ctrl.credentialsExpiry = newCredentialsExpiryChecker(ctrl.metrics)
+	// applications are processed in turn per project, so that a project with many applications to
+	// refresh or sync does not delay the other projects
+	ctrl.appRefreshQueue = newFairQueue("refresh", ctrl.appProjectOf, ctrl.metrics, queueItemMinInterval)
+	ctrl.appOperationQueue = newFairQueue("operation", ctrl.appProjectOf, ctrl.metrics, queueItemMinInterval)
 	ctrl.appInformer = ctrl.newApplicationInformer()
 	return &ctrl
 }
 
+// appProjectOf returns the project of the application with the given key. Applications which are
+// not in the informer cache are assigned to the default project.
+func (ctrl *ApplicationController) appProjectOf(key string) string {
+	obj, exists, err := ctrl.appInformer.GetIndexer().GetByKey(key)
+	if err == nil && exists {
+		if app, ok := obj.(*appv1.Application); ok && app.Spec.Project != "" {
+			return app.Spec.Project
+		}
+	}
+	return common.DefaultAppProjectName
+}
+
+// getApp returns the application of the name from the informer cache, or nil if it does not exist
+func (ctrl *ApplicationController) getApp(appName string) *appv1.Application {
+	obj, exists, err := ctrl.appInformer.GetIndexer().GetByKey(ctrl.namespace + "/" + appName)
+	if err != nil || !exists {
+		return nil
+	}
+	app, _ := obj.(*appv1.Application)
+	return app
+}
+
 func (ctrl *ApplicationController) setAppResources(appName string, resources []appv1.ResourceState) {
 	err := ctrl.appResources.Set(&cache_util.Item{Object: resources, Key: appName})
 	if err != nil {
 		log.Warnf("Unable to save app resources state in cache: %v", err)
 	}
+	ctrl.setAppTree(appName, resources)
 }
 
 func (ctrl *ApplicationController) Resources(ctx context.Context, q *services.ResourcesQuery) (*services.ResourcesResponse, error) {
@@ -150,10 +276,6 @@ func (ctrl *ApplicationController) Resources(ctx context.Context, q *services.Re
 			if q.Kind != nil && gvk.Kind != *q.Kind {
 				continue
 			}
-			var data map[string]interface{}
-			res.LiveState, data = hideSecretData(res.LiveState, nil)
-			res.TargetState, _ = hideSecretData(res.TargetState, data)
-			res.ChildLiveResources = hideNodesSecrets(res.ChildLiveResources)
 			items = append(items, &res)
 		}
 		return &services.ResourcesResponse{Items: items}, nil
@@ -161,60 +283,18 @@ func (ctrl *ApplicationController) Resources(ctx context.Context, q *services.Re
 	return &services.ResourcesResponse{Items: make([]*appv1.ResourceState, 0)}, nil
 }
 
-func toString(val interface{}) string {
-	if val == nil {
-		return ""
-	}
-	return fmt.Sprintf("%s", val)
-}
-
-// hideSecretData checks if given object kind is Secret, replaces data keys with stars and returns unchanged data map. The method additionally check if data key if different
-// from corresponding key of optional parameter `otherData` and adds extra star to keep information about difference. So if secret data is out of sync user still can see which
-// fields are different.
-func hideSecretData(state string, otherData map[string]interface{}) (string, map[string]interface{}) {
-	obj, err := appv1.UnmarshalToUnstructured(state)
-	if err == nil {
-		if obj != nil && obj.GetKind() == kube.SecretKind {
-			if data, ok, err := unstructured.NestedMap(obj.Object, "data"); err == nil && ok {
-				unchangedData := make(map[string]interface{})
-				for k, v := range data {
-					unchangedData[k] = v
-				}
-				for k := range data {
-					replacement := "********"
-					if otherData != nil {
-						if val, ok := otherData[k]; ok && toString(val) != toString(data[k]) {
-							replacement = replacement + "*"
-						}
-					}
-					data[k] = replacement
-				}
-				_ = unstructured.SetNestedMap(obj.Object, data, "data")
-				newState, err := json.Marshal(obj)
-				if err == nil {
-					return string(newState), unchangedData
-				}
-			}
-		}
-	}
-	return state, nil
-}
-
-func hideNodesSecrets(nodes []appv1.ResourceNode) []appv1.ResourceNode {
-	for i := range nodes {
-		node := nodes[i]
-		node.State, _ = hideSecretData(node.State, nil)
-		node.Children = hideNodesSecrets(node.Children)
-		nodes[i] = node
-	}
-	return nodes
-}
-
 // Run starts the Application CRD controller.
 func (ctrl *ApplicationController) Run(ctx context.Context, statusProcessors int, operationProcessors int) {
 	defer runtime.HandleCrash()
 	defer ctrl.appRefreshQueue.ShutDown()
 
+	// the controller starts processing the applications now, e.g. once elected leader, so the
+	// operations which did not change since are stale, and are checked again once resumed
+	ctrl.startedAt = time.Now()
+	ctrl.resumedOperationsMutex.Lock()
+	ctrl.resumedOperations = make(map[string]bool)
+	ctrl.resumedOperationsMutex.Unlock()
+
 	go ctrl.appInformer.Run(ctx.Done())
 
 	if !cache.WaitForCacheSync(ctx.Done(), ctrl.appInformer.HasSynced) {
@@ -223,6 +303,9 @@ func (ctrl *ApplicationController) Run(ctx context.Context, statusProcessors int
 	}
 
 	go ctrl.watchAppsResources()
+	go ctrl.watchSettings(ctx)
+	go ctrl.requeueAppsPeriodically(ctx)
+	go wait.Until(ctrl.refreshOrphanedResourcesOfApps, orphanedResourcesRefreshInterval, ctx.Done())
 
 	for i := 0; i < statusProcessors; i++ {
 		go wait.Until(func() {
@@ -260,15 +343,17 @@ func (ctrl *ApplicationController) CreateGRPC(tlsConfCustomizer tlsutil.ConfigCu
 	tlsConfig := &tls.Config{Certificates: []tls.Certificate{*cert}}
 	tlsConfCustomizer(tlsConfig)
 
-	logEntry := log.NewEntry(log.New())
+	logEntry := log.NewEntry(log.StandardLogger())
 	server := grpc.NewServer(
 		grpc.Creds(credentials.NewTLS(tlsConfig)),
 		grpc.StreamInterceptor(grpc_middleware.ChainStreamServer(
 			grpc_logrus.StreamServerInterceptor(logEntry),
+			grpc_util.CorrelationIDStreamServerInterceptor(false),
 			grpc_util.PanicLoggerStreamServerInterceptor(logEntry),
 		)),
 		grpc.UnaryInterceptor(grpc_middleware.ChainUnaryServer(
 			grpc_logrus.UnaryServerInterceptor(logEntry),
+			grpc_util.CorrelationIDUnaryServerInterceptor(false),
 			grpc_util.PanicLoggerUnaryServerInterceptor(logEntry),
 		)),
 	)
@@ -301,12 +386,13 @@ func (ctrl *ApplicationController) watchClusterResources(ctx context.Context, it
 				err = fmt.Errorf("Recovered from panic: %v\n", r)
 			}
 		}()
-		config := item.RESTConfig()
+		config := kube.ClusterRESTConfig(&item)
 		watchStartTime := time.Now()
+		changes := newResourceChanges(ctrl.kubectl, ctrl.getApp, ctrl.appStateManager.GetNormalizer)
 		ch, err := ctrl.kubectl.WatchResources(ctx, config, "", func(gvk schema.GroupVersionKind) metav1.ListOptions {
 			ops := metav1.ListOptions{}
 			if !kube.IsCRDGroupVersionKind(gvk) {
-				ops.LabelSelector = common.LabelApplicationName
+				ops.LabelSelector = fmt.Sprintf("%s,%s", common.LabelApplicationName, instanceSelector(ctrl.instanceID))
 			}
 			return ops
 		})
@@ -324,11 +410,17 @@ func (ctrl *ApplicationController) watchClusterResources(ctx context.Context, it
 					return fmt.Errorf("Restarting the watch because a CRD was deleted.")
 				}
 			}
+			if !isInstanceObject(eventObj, ctrl.instanceID) {
+				continue
+			}
 			objLabels := eventObj.GetLabels()
 			if objLabels == nil {
 				objLabels = make(map[string]string)
 			}
 			if appName, ok := objLabels[common.LabelApplicationName]; ok {
+				if !changes.changed(event.Type, eventObj, appName) {
+					continue
+				}
 				ctrl.forceAppRefresh(appName)
 				ctrl.appRefreshQueue.Add(ctrl.namespace + "/" + appName)
 			}
@@ -340,8 +432,12 @@ func (ctrl *ApplicationController) watchClusterResources(ctx context.Context, it
 
 func isClusterHasApps(apps []interface{}, cluster *appv1.Cluster) bool {
 	for _, obj := range apps {
-		if app, ok := obj.(*appv1.Application); ok && app.Spec.Destination.Server == cluster.Server {
-			return true
+		if app, ok := obj.(*appv1.Application); ok {
+			for _, dest := range app.Spec.GetDestinations() {
+				if argo.DestinationSelectsCluster(dest, cluster) {
+					return true
+				}
+			}
 		}
 	}
 	return false
@@ -378,16 +474,31 @@ func (ctrl *ApplicationController) watchAppsResources() {
 
 		onAppModified := func(obj interface{}) {
 			if app, ok := obj.(*appv1.Application); ok {
-				var cluster *appv1.Cluster
-				info, infoOk := watchingClusters[app.Spec.Destination.Server]
-				if infoOk {
-					cluster = info.cluster
-				} else {
-					cluster, _ = ctrl.db.GetCluster(context.Background(), app.Spec.Destination.Server)
-				}
-				if cluster != nil {
-					// trigger cluster event every time when app created/deleted to either start or stop watching resources
-					clusterEventCallback(&db.ClusterEvent{Cluster: cluster, Type: watch.Modified})
+				for _, dest := range app.Spec.GetDestinations() {
+					if dest.SelectsClusters() {
+						clusters, err := ctrl.db.ListClusters(context.Background())
+						if err != nil {
+							log.Warnf("Failed to list the clusters selected by application '%s': %v", app.Name, err)
+							continue
+						}
+						for i := range clusters.Items {
+							if argo.DestinationSelectsCluster(dest, &clusters.Items[i]) {
+								clusterEventCallback(&db.ClusterEvent{Cluster: &clusters.Items[i], Type: watch.Modified})
+							}
+						}
+						continue
+					}
+					var cluster *appv1.Cluster
+					info, infoOk := watchingClusters[dest.Server]
+					if infoOk {
+						cluster = info.cluster
+					} else {
+						cluster, _ = ctrl.db.GetCluster(context.Background(), dest.Server)
+					}
+					if cluster != nil {
+						// trigger cluster event every time when app created/deleted to either start or stop watching resources
+						clusterEventCallback(&db.ClusterEvent{Cluster: cluster, Type: watch.Modified})
+					}
 				}
 			}
 		}
@@ -473,6 +584,9 @@ func (ctrl *ApplicationController) processAppOperationQueueItem() (processNext b
 
 func (ctrl *ApplicationController) finalizeApplicationDeletion(app *appv1.Application) error {
 	logCtx := log.WithField("application", app.Name)
+	if ctrl.readOnly {
+		return fmt.Errorf("cascaded deletion is disabled since the controller is read-only")
+	}
 	logCtx.Infof("Deleting resources")
 	// Get refreshed application info, since informer app copy might be stale
 	app, err := ctrl.applicationClientset.ArgoprojV1alpha1().Applications(app.Namespace).Get(app.Name, metav1.GetOptions{})
@@ -482,21 +596,20 @@ func (ctrl *ApplicationController) finalizeApplicationDeletion(app *appv1.Applic
 		}
 		return nil
 	}
-	clst, err := ctrl.db.GetCluster(context.Background(), app.Spec.Destination.Server)
+	dests, err := ctrl.deletionDestinations(app)
 	if err != nil {
 		return err
 	}
-	config := clst.RESTConfig()
-	err = kube.DeleteResourcesWithLabel(config, app.Spec.Destination.Namespace, common.LabelApplicationName, app.Name)
-	if err != nil {
-		return err
-	}
-	objs, err := kube.GetResourcesWithLabel(config, app.Spec.Destination.Namespace, common.LabelApplicationName, app.Name)
-	if err != nil {
-		return err
+	remaining := 0
+	for _, dest := range dests {
+		count, err := ctrl.deleteDestinationResources(app, dest)
+		if err != nil {
+			return err
+		}
+		remaining += count
 	}
-	if len(objs) > 0 {
-		logCtx.Info("%d objects remaining for deletion", len(objs))
+	if remaining > 0 {
+		logCtx.Infof("%d objects remaining for deletion", remaining)
 		return nil
 	}
 	app.SetCascadedDeletion(false)
@@ -515,10 +628,93 @@ func (ctrl *ApplicationController) finalizeApplicationDeletion(app *appv1.Applic
 	if err != nil {
 		logCtx.Warnf("Failed to purge app cache after deletion")
 	}
+	err = ctrl.appResources.Delete(appTreeKey(app.Name))
+	if err != nil {
+		logCtx.Warnf("Failed to purge app resource tree after deletion")
+	}
 	logCtx.Info("Successfully deleted resources")
 	return nil
 }
 
+// deletionDestinations returns the destinations whose resources are deleted with the application. The
+// destinations which select clusters are resolved into every cluster they select, and the destinations
+// recorded in the status are added, in case the labels of a cluster changed since the application was
+// deployed to it.
+func (ctrl *ApplicationController) deletionDestinations(app *appv1.Application) ([]appv1.ApplicationDestination, error) {
+	dests := app.Spec.GetDestinations()
+	for _, dest := range dests {
+		if dest.SelectsClusters() {
+			clusters, err := ctrl.db.ListClusters(context.Background())
+			if err != nil {
+				return nil, err
+			}
+			// the resources are deleted even from clusters the project no longer permits
+			dests, err = argo.SelectDestinations(dests, clusters.Items, func(appv1.ApplicationDestination) bool { return true })
+			if err != nil {
+				return nil, err
+			}
+			break
+		}
+	}
+	for _, status := range app.Status.Destinations {
+		found := false
+		for _, dest := range dests {
+			found = found || dest == status.Destination
+		}
+		if !found {
+			dests = append(dests, status.Destination)
+		}
+	}
+	return dests, nil
+}
+
+// deleteDestinationResources deletes the resources of the application in the destination, and
+// returns the number of resources which remain to be deleted
+func (ctrl *ApplicationController) deleteDestinationResources(app *appv1.Application, dest appv1.ApplicationDestination) (int, error) {
+	clst, err := ctrl.db.GetCluster(context.Background(), dest.Server)
+	if err != nil {
+		return 0, err
+	}
+	config := kube.ClusterRESTConfig(clst)
+	selector, err := appResourcesSelector(app.Name, appInstanceID(app))
+	if err != nil {
+		return 0, err
+	}
+	propagationPolicy, err := deletionPropagationPolicy(app)
+	if err != nil {
+		return 0, err
+	}
+	err = kube.DeleteResourcesWithSelector(config, dest.Namespace, selector, propagationPolicy)
+	if err != nil {
+		return 0, err
+	}
+	objs, err := kube.GetResourcesWithSelector(config, dest.Namespace, selector)
+	if err != nil {
+		return 0, err
+	}
+	if len(objs) > 0 {
+		dynamicIf, err := dynamic.NewForConfig(config)
+		if err != nil {
+			return 0, err
+		}
+		err = deleteAppCRDs(dynamicIf, app, objs)
+		if err != nil {
+			return 0, err
+		}
+	}
+	return len(objs), nil
+}
+
+// deletionPropagationPolicy returns the deletion propagation policy of the resources of the cascaded
+// deletion of the application, which defaults to foreground
+func deletionPropagationPolicy(app *appv1.Application) (metav1.DeletionPropagation, error) {
+	policy := appv1.PropagationPolicy(app.Annotations[common.AnnotationDeletionPropagationPolicy])
+	if policy == appv1.PropagationPolicyOrphan {
+		return "", fmt.Errorf("the resources of a cascaded deletion cannot be orphaned")
+	}
+	return policy.DeletionPropagation()
+}
+
 func (ctrl *ApplicationController) setAppCondition(app *appv1.Application, condition appv1.ApplicationCondition) {
 	index := -1
 	for i, exiting := range app.Status.Conditions {
@@ -547,8 +743,18 @@ func (ctrl *ApplicationController) setAppCondition(app *appv1.Application, condi
 }
 
 func (ctrl *ApplicationController) processRequestedAppOperation(app *appv1.Application) {
+	// the status of the application is updated as the operation progresses, so the application of
+	// the informer is not modified
+	app = app.DeepCopy()
 	logCtx := log.WithField("application", app.Name)
+	if app.Operation != nil && app.Operation.CorrelationID != "" {
+		logCtx = logCtx.WithField(grpc_util.CorrelationIDField, app.Operation.CorrelationID)
+	}
 	var state *appv1.OperationState
+	// timeoutMessage is set if the operation is terminated since it timed out
+	timeoutMessage := ""
+	// stale is set if the operation is failed instead of resumed, since it was stale
+	stale := false
 	// Recover from any unexpected panics and automatically set the status to be failed
 	defer func() {
 		if r := recover(); r != nil {
@@ -578,13 +784,56 @@ func (ctrl *ApplicationController) processRequestedAppOperation(app *appv1.Appli
 		}
 		app = freshApp
 		state = app.Status.OperationState.DeepCopy()
+		var changedAt *metav1.Time
+		if ctrl.markOperationResumed(app.Name) {
+			// operations are only stale when the controller resumes them, since their state might
+			// not change for a while once resumed, e.g. while they wait for the resources to be healthy
+			changedAt = ctrl.staleOperationChangedAt(state)
+		}
+		if changedAt != nil {
+			logCtx.Warnf("Operation did not change since %s, failing it", changedAt.Format(time.RFC3339))
+			stale = true
+			state.Phase = appv1.OperationFailed
+			state.Message = fmt.Sprintf("Operation was stale: its state did not change since %s, before the controller restarted (last message: %s)", changedAt.Format(time.RFC3339), state.Message)
+		} else if timeout := operationTimeout(state); timeout > 0 {
+			logCtx.Infof("Operation timed out after %v, terminating", timeout)
+			state.Phase = appv1.OperationTerminating
+			timeoutMessage = fmt.Sprintf("%s %v", operationTimedOutMessage, timeout)
+			state.Message = timeoutMessage
+		} else if state.Phase == appv1.OperationTerminating {
+			// the termination of a timed out operation may take several passes, e.g. while hooks are deleted
+			timeoutMessage = operationTimeoutMessage(state.Message)
+		} else if delay := retryDelay(state); delay > 0 && state.Phase == appv1.OperationRunning {
+			logCtx.Debugf("Operation is waiting %v to be retried", delay)
+			ctrl.requeueAppOperation(app, delay)
+			return
+		}
 		logCtx.Infof("Resuming in-progress operation. phase: %s, message: %s", state.Phase, state.Message)
 	} else {
 		state = &appv1.OperationState{Phase: appv1.OperationRunning, Operation: *app.Operation, StartedAt: metav1.Now()}
 		ctrl.setOperationState(app, state)
 		logCtx.Infof("Initialized new operation: %v", *app.Operation)
 	}
-	ctrl.appStateManager.SyncAppState(app, state)
+	if ctrl.readOnly {
+		state.Phase = appv1.OperationFailed
+		state.Message = "operations are disabled since the controller is read-only"
+	} else if !stale {
+		terminating := state.Phase == appv1.OperationTerminating
+		ctrl.appStateManager.SyncAppState(app, state)
+		if terminating && state.Phase.Completed() {
+			// the results of the resources synced before termination are kept
+			state.Terminated = true
+		}
+		if timeoutMessage != "" {
+			message := timeoutMessage
+			if state.Phase != appv1.OperationFailed {
+				message = fmt.Sprintf("%s: %s", message, state.Message)
+			}
+			state.Message = message
+		} else if !terminating && (state.Phase == appv1.OperationFailed || state.Phase == appv1.OperationError) {
+			ctrl.retryFailedOperation(app, state)
+		}
+	}
 
 	if state.Phase == appv1.OperationRunning {
 		// It's possible for an app to be terminated while we were operating on it. We do not want
@@ -606,7 +855,164 @@ func (ctrl *ApplicationController) processRequestedAppOperation(app *appv1.Appli
 		// if we just completed an operation, force a refresh so that UI will report up-to-date
 		// sync/health information
 		ctrl.forceAppRefresh(app.ObjectMeta.Name)
+	} else if isWaitingForRepoServer(state.Phase, state.Message) {
+		// check again whether a repo server is available, since the operation is not resumed otherwise
+		ctrl.requeueAppOperation(app, repoServerRecheckDelay)
+	} else if isWaitingForComponentRollout(state.Phase, state.Message) {
+		// check again whether the updated component was rolled out, in case nothing else triggers it
+		ctrl.requeueAppOperation(app, componentRolloutRecheckDelay)
+	} else if timeout, _ := state.Operation.TimeoutDuration(); timeout > 0 && state.Phase == appv1.OperationRunning {
+		// process the operation once it times out, in case nothing else triggers it
+		ctrl.requeueAppOperation(app, time.Until(state.StartedAt.Add(timeout)))
+	} else if state.Phase == appv1.OperationTerminating {
+		// check again whether the hooks of the terminating operation were deleted
+		ctrl.requeueAppOperation(app, terminationRecheckDelay)
+	}
+	if delay := healthWaitTimeoutDelay(state); delay > 0 {
+		// process the sync once its wait for the application health times out, in case nothing else
+		// triggers it
+		ctrl.requeueAppOperation(app, delay)
+	}
+}
+
+// operationTimeoutMessage returns the message of the timeout of a terminating operation, which prefixes
+// its message, or an empty string if it is terminated for another reason
+func operationTimeoutMessage(message string) string {
+	if !strings.HasPrefix(message, operationTimedOutMessage) {
+		return ""
+	}
+	if i := strings.Index(message, ": "); i >= 0 {
+		return message[:i]
+	}
+	return message
+}
+
+// operationTimeout returns the timeout of a running operation which ran for longer than its
+// timeout, or 0 if the operation did not time out
+func operationTimeout(state *appv1.OperationState) time.Duration {
+	timeout, err := state.Operation.TimeoutDuration()
+	if err != nil || timeout == 0 || state.Phase != appv1.OperationRunning {
+		return 0
+	}
+	if time.Since(state.StartedAt.Time) < timeout {
+		return 0
+	}
+	return timeout
+}
+
+// staleOperationChangedAt returns when the state of the running operation last changed, if it is
+// stale: it did not change since before the controller started, and for longer than the stale
+// operation timeout. Operations are never stale if the timeout is zero.
+func (ctrl *ApplicationController) staleOperationChangedAt(state *appv1.OperationState) *metav1.Time {
+	if ctrl.staleOperationTimeout <= 0 || state.Phase != appv1.OperationRunning {
+		return nil
+	}
+	changedAt := state.StartedAt.DeepCopy()
+	if state.StateChangedAt != nil {
+		changedAt = state.StateChangedAt.DeepCopy()
+	}
+	if !changedAt.Time.Before(ctrl.startedAt) || time.Since(changedAt.Time) < ctrl.staleOperationTimeout {
+		return nil
+	}
+	return changedAt
+}
+
+// markOperationResumed records that the controller resumed the operation of the application, and
+// returns whether it is the first time since the controller started
+func (ctrl *ApplicationController) markOperationResumed(appName string) bool {
+	ctrl.resumedOperationsMutex.Lock()
+	defer ctrl.resumedOperationsMutex.Unlock()
+	if ctrl.resumedOperations[appName] {
+		return false
+	}
+	ctrl.resumedOperations[appName] = true
+	return true
+}
+
+// retryFailedOperation records the failed attempt of the operation, and schedules a retry if the
+// retry limit of the operation is not reached
+func (ctrl *ApplicationController) retryFailedOperation(app *appv1.Application, state *appv1.OperationState) {
+	syncOp := state.Operation.Sync
+	if syncOp == nil || syncOp.Retry == nil || int64(len(state.Attempts)) >= syncOp.Retry.Limit {
+		if len(state.Attempts) > 0 {
+			state.Message = fmt.Sprintf("%s (retried %d times)", state.Message, len(state.Attempts))
+		}
+		return
+	}
+	now := metav1.Now()
+	retryAt, err := syncOp.Retry.NextRetryAt(now.Time, len(state.Attempts))
+	if err != nil {
+		state.Message = fmt.Sprintf("%s (failed to retry: %v)", state.Message, err)
+		return
+	}
+	state.Attempts = append(state.Attempts, appv1.OperationAttempt{Phase: state.Phase, Message: state.Message, FinishedAt: now})
+	state.Phase = appv1.OperationRunning
+	state.Message = fmt.Sprintf("%s. Retrying attempt #%d at %s", state.Message, len(state.Attempts), retryAt.Format(time.Kitchen))
+	if state.SyncResult != nil {
+		state.SyncResult = retrySyncResult(state.SyncResult, syncOp.Retry.FailedOnly)
+	}
+	for i := range state.DestinationResults {
+		// only the destinations which failed are synced again
+		if res := &state.DestinationResults[i]; !res.Phase.Successful() {
+			res.Phase = appv1.OperationRunning
+			res.Message = ""
+			if res.SyncResult != nil {
+				res.SyncResult = retrySyncResult(res.SyncResult, syncOp.Retry.FailedOnly)
+			}
+		}
+	}
+	ctrl.requeueAppOperation(app, time.Until(retryAt))
+}
+
+// retrySyncResult returns the sync result a retry starts over with. The retry syncs to the same
+// revision as the failed attempt and, if only failed resources are retried, records the resources
+// which failed in the attempt. All resources are retried if a hook failed, since the hooks run again.
+func retrySyncResult(res *appv1.SyncOperationResult, failedOnly bool) *appv1.SyncOperationResult {
+	retryRes := appv1.SyncOperationResult{Revision: res.Revision}
+	if !failedOnly {
+		return &retryRes
+	}
+	for _, hook := range res.Hooks {
+		if hook.Status == appv1.OperationFailed || hook.Status == appv1.OperationError {
+			return &retryRes
+		}
+	}
+	for _, resDetails := range res.Resources {
+		if resDetails.Status == appv1.ResourceDetailsSyncFailed {
+			retryRes.FailedResources = append(retryRes.FailedResources, &appv1.ResourceDetails{
+				Name:         resDetails.Name,
+				Kind:         resDetails.Kind,
+				Namespace:    resDetails.Namespace,
+				Message:      resDetails.Message,
+				Status:       resDetails.Status,
+				GenerateName: resDetails.GenerateName,
+			})
+		}
+	}
+	return &retryRes
+}
+
+// retryDelay returns how long a failed operation has to wait before it is retried
+func retryDelay(state *appv1.OperationState) time.Duration {
+	if len(state.Attempts) == 0 || state.Operation.Sync == nil {
+		return 0
+	}
+	lastAttempt := state.Attempts[len(state.Attempts)-1]
+	retryAt, err := state.Operation.Sync.Retry.NextRetryAt(lastAttempt.FinishedAt.Time, len(state.Attempts)-1)
+	if err != nil {
+		return 0
+	}
+	return time.Until(retryAt)
+}
+
+// requeueAppOperation processes the operation of the application again after the delay
+func (ctrl *ApplicationController) requeueAppOperation(app *appv1.Application, delay time.Duration) {
+	key, err := cache.MetaNamespaceKeyFunc(app)
+	if err != nil {
+		log.WithField("application", app.Name).Warnf("Failed to requeue operation: %v", err)
+		return
 	}
+	ctrl.appOperationQueue.AddAfter(key, delay)
 }
 
 func (ctrl *ApplicationController) setOperationState(app *appv1.Application, state *appv1.OperationState) {
@@ -619,10 +1025,11 @@ func (ctrl *ApplicationController) setOperationState(app *appv1.Application, sta
 			now := metav1.Now()
 			state.FinishedAt = &now
 		}
+		status := map[string]interface{}{
+			"operationState": state,
+		}
 		patch := map[string]interface{}{
-			"status": map[string]interface{}{
-				"operationState": state,
-			},
+			"status": status,
 		}
 		if state.Phase.Completed() {
 			// If operation is completed, clear the operation field to indicate no operation is
@@ -633,6 +1040,15 @@ func (ctrl *ApplicationController) setOperationState(app *appv1.Application, sta
 			log.Infof("No operation updates necessary to '%s'. Skipping patch", app.Name)
 			return nil
 		}
+		changedAt := metav1.Now()
+		state.StateChangedAt = &changedAt
+		completed := state.Phase.Completed() && (app.Status.OperationState == nil || !app.Status.OperationState.Phase.Completed())
+		var operationHistory []appv1.OperationState
+		if completed {
+			// lists are replaced by merge patches
+			operationHistory = ctrl.historyRetention.appendOperationHistory(app.Status.OperationHistory, state)
+			status["operationHistory"] = operationHistory
+		}
 		patchJSON, err := json.Marshal(patch)
 		if err != nil {
 			return err
@@ -643,10 +1059,16 @@ func (ctrl *ApplicationController) setOperationState(app *appv1.Application, sta
 			return err
 		}
 		log.Infof("updated '%s' operation (phase: %s)", app.Name, state.Phase)
+		if completed && ctrl.metrics != nil {
+			ctrl.metrics.incOperations(app.Spec.Project, state)
+		}
+		for _, event := range operationEvents(app.Status.OperationState, state) {
+			ctrl.auditLogger.LogAppEvent(app, event.info, event.message)
+		}
 		if state.Phase.Completed() {
 			eventInfo := argo.EventInfo{Reason: argo.EventReasonOperationCompleted}
 			var messages []string
-			if state.Operation.Sync != nil && len(state.Operation.Sync.Resources) > 0 {
+			if state.Operation.Sync != nil && state.Operation.Sync.IsPartial() {
 				messages = []string{"Partial sync operation"}
 			} else {
 				messages = []string{"Sync operation"}
@@ -663,6 +1085,12 @@ func (ctrl *ApplicationController) setOperationState(app *appv1.Application, sta
 			}
 			ctrl.auditLogger.LogAppEvent(app, eventInfo, strings.Join(messages, " "))
 		}
+		// the next update of the operation is compared with the patched state, rather than with the
+		// state of the previous operation, e.g. when a new operation completes in a single pass
+		app.Status.OperationState = state.DeepCopy()
+		if completed {
+			app.Status.OperationHistory = operationHistory
+		}
 		return nil
 	}, "Update application operation state", context.Background(), updateOperationStateTimeout)
 }
@@ -695,45 +1123,73 @@ func (ctrl *ApplicationController) processAppRefreshQueueItem() (processNext boo
 		log.Warnf("Key '%s' in index is not an application", appKey)
 		return
 	}
-	if !ctrl.needRefreshAppStatus(app, ctrl.statusRefreshTimeout) {
+	if !ctrl.needRefreshAppStatus(app, ctrl.getStatusRefreshTimeout()) {
 		return
 	}
+	ctrl.compactAppOperationState(app)
 
 	app = app.DeepCopy()
 	conditions, hasErrors := ctrl.refreshAppConditions(app)
+	// the destinations which select clusters are compared in every selected cluster
+	resolvedApp := app
+	if !hasErrors {
+		resolvedApp, err = ctrl.appStateManager.ResolveDestinations(app)
+		if err != nil {
+			conditions = append(conditions, appv1.ApplicationCondition{Type: appv1.ApplicationConditionComparisonError, Message: fmt.Sprintf("Failed to resolve the destinations of the application: %v", err)})
+			hasErrors = true
+		}
+	}
 	if hasErrors {
 		comparisonResult := app.Status.ComparisonResult.DeepCopy()
 		comparisonResult.Status = appv1.ComparisonStatusUnknown
 		health := app.Status.Health.DeepCopy()
 		health.Status = appv1.HealthStatusUnknown
-		ctrl.updateAppStatus(app, comparisonResult, health, nil, conditions)
+		ctrl.updateAppStatus(app, comparisonResult, health, nil, conditions, app.Status.Destinations, app.Status.OrphanedResources)
 		return
 	}
 
-	comparisonResult, manifestInfo, resources, compConditions, err := ctrl.appStateManager.CompareAppState(app, "", nil)
+	comparedAt := time.Now()
+	comparisonResult, manifestInfo, resources, compConditions, err := ctrl.appStateManager.CompareAppState(resolvedApp, "", nil)
 	if err != nil {
 		conditions = append(conditions, appv1.ApplicationCondition{Type: appv1.ApplicationConditionComparisonError, Message: err.Error()})
 	} else {
 		conditions = append(conditions, compConditions...)
 	}
+	if comparisonResult != nil {
+		// the destinations which select clusters are recorded as in the spec, rather than resolved
+		comparisonResult.ComparedToDestination = app.Spec.Destination
+	}
 
 	var parameters []*appv1.ComponentParameter
 	if manifestInfo != nil {
 		parameters = manifestInfo.Params
 	}
 
-	healthState, err := setApplicationHealth(ctrl.kubectl, comparisonResult, resources)
+	deadline := newProgressingDeadline(app)
+	healthState, err := setApplicationHealth(ctrl.kubectl, &deadline, comparisonResult, resources)
 	if err != nil {
 		conditions = append(conditions, appv1.ApplicationCondition{Type: appv1.ApplicationConditionComparisonError, Message: err.Error()})
 	}
 	ctrl.setAppResources(app.Name, resources)
 
-	syncErrCond := ctrl.autoSync(app, comparisonResult)
-	if syncErrCond != nil {
-		conditions = append(conditions, *syncErrCond)
+	var destinations []appv1.DestinationStatus
+	if resolvedApp.Spec.HasAdditionalDestinations() && comparisonResult != nil {
+		destinations = ctrl.refreshAdditionalDestinations(resolvedApp, comparisonResult, healthState)
+	}
+
+	orphanedResources, orphanedCond := ctrl.getOrphanedResources(app)
+	if orphanedCond != nil {
+		conditions = append(conditions, *orphanedCond)
+	}
+
+	if !ctrl.deferAutoSync(resolvedApp, comparedAt) {
+		syncErrCond := ctrl.autoSync(app, comparisonResult)
+		if syncErrCond != nil {
+			conditions = append(conditions, *syncErrCond)
+		}
 	}
 
-	ctrl.updateAppStatus(app, comparisonResult, healthState, parameters, conditions)
+	ctrl.updateAppStatus(app, comparisonResult, healthState, parameters, conditions, destinations, orphanedResources)
 	return
 }
 
@@ -745,10 +1201,16 @@ func (ctrl *ApplicationController) needRefreshAppStatus(app *appv1.Application,
 	expired := app.Status.ComparisonResult.ComparedAt.Add(statusRefreshTimeout).Before(time.Now().UTC())
 	if ctrl.isRefreshForced(app.Name) {
 		reason = "force refresh"
+	} else if hardRefreshRequested(app) {
+		reason = "hard refresh requested"
+	} else if refreshRequested(app) {
+		reason = "refresh requested"
 	} else if app.Status.ComparisonResult.Status == appv1.ComparisonStatusUnknown && expired {
 		reason = "comparison status unknown"
 	} else if !app.Spec.Source.Equals(app.Status.ComparisonResult.ComparedTo) {
 		reason = "spec.source differs"
+	} else if app.Spec.Destination != app.Status.ComparisonResult.ComparedToDestination {
+		reason = "spec.destination differs"
 	} else if expired {
 		reason = fmt.Sprintf("comparison expired. comparedAt: %v, expiry: %v", app.Status.ComparisonResult.ComparedAt, statusRefreshTimeout)
 	}
@@ -785,14 +1247,21 @@ func (ctrl *ApplicationController) refreshAppConditions(app *appv1.Application)
 			conditions = append(conditions, specConditions...)
 		}
 	}
+	conditions = append(conditions, ctrl.credentialsExpiryConditions(app)...)
 
 	// List of condition types which have to be reevaluated by controller; all remaining conditions should stay as is.
 	reevaluateTypes := map[appv1.ApplicationConditionType]bool{
-		appv1.ApplicationConditionInvalidSpecError:      true,
-		appv1.ApplicationConditionUnknownError:          true,
-		appv1.ApplicationConditionComparisonError:       true,
-		appv1.ApplicationConditionSharedResourceWarning: true,
-		appv1.ApplicationConditionSyncError:             true,
+		appv1.ApplicationConditionInvalidSpecError:           true,
+		appv1.ApplicationConditionUnknownError:               true,
+		appv1.ApplicationConditionComparisonError:            true,
+		appv1.ApplicationConditionSharedResourceWarning:      true,
+		appv1.ApplicationConditionSyncError:                  true,
+		appv1.ApplicationConditionCredentialsExpiryWarning:   true,
+		appv1.ApplicationConditionRepoServerUnavailableError: true,
+		appv1.ApplicationConditionDuplicateResourceError:     true,
+		appv1.ApplicationConditionOrphanedResourceWarning:    true,
+		appv1.ApplicationConditionExcludedResourceWarning:    true,
+		appv1.ApplicationConditionServerSideDiffWarning:      true,
 	}
 	appConditions := make([]appv1.ApplicationCondition, 0)
 	for i := 0; i < len(app.Status.Conditions); i++ {
@@ -813,9 +1282,26 @@ func (ctrl *ApplicationController) refreshAppConditions(app *appv1.Application)
 	return appConditions, hasErrors
 }
 
-// setApplicationHealth updates the health statuses of all resources performed in the comparison
-func setApplicationHealth(kubectl kube.Kubectl, comparisonResult *appv1.ComparisonResult, resources []appv1.ResourceState) (*appv1.HealthStatus, error) {
+// credentialsExpiryConditions returns warning conditions for the credentials of the destination
+// cluster and the source repository of the application which expire soon. Clusters and repositories
+// which cannot be retrieved are reported by the spec conditions.
+func (ctrl *ApplicationController) credentialsExpiryConditions(app *appv1.Application) []appv1.ApplicationCondition {
+	cluster, err := ctrl.db.GetCluster(context.Background(), app.Spec.Destination.Server)
+	if err != nil {
+		cluster = nil
+	}
+	repo, err := ctrl.db.GetRepository(context.Background(), app.Spec.Source.RepoURL)
+	if err != nil {
+		repo = nil
+	}
+	return ctrl.credentialsExpiry.conditions(cluster, repo)
+}
+
+// setApplicationHealth updates the health statuses of all resources performed in the comparison.
+// Resources which have been Progressing for longer than the progressing deadline are Degraded.
+func setApplicationHealth(kubectl kube.Kubectl, deadline *progressingDeadline, comparisonResult *appv1.ComparisonResult, resources []appv1.ResourceState) (*appv1.HealthStatus, error) {
 	var savedErr error
+	now := time.Now()
 	appHealth := appv1.HealthStatus{Status: appv1.HealthStatusHealthy}
 	if comparisonResult.Status == appv1.ComparisonStatusUnknown {
 		appHealth.Status = appv1.HealthStatusUnknown
@@ -834,6 +1320,8 @@ func setApplicationHealth(kubectl kube.Kubectl, comparisonResult *appv1.Comparis
 				savedErr = err
 			}
 			resource.Health = *healthState
+			summary := comparisonResult.Resources[i]
+			deadline.apply(summary.Group, summary.Kind, summary.Namespace, summary.Name, &resource.Health, now)
 		}
 		resources[i] = resource
 		comparisonResult.Resources[i].Health = resource.Health
@@ -851,6 +1339,8 @@ func (ctrl *ApplicationController) updateAppStatus(
 	healthState *appv1.HealthStatus,
 	parameters []*appv1.ComponentParameter,
 	conditions []appv1.ApplicationCondition,
+	destinations []appv1.DestinationStatus,
+	orphanedResources []appv1.OrphanedResource,
 ) {
 	logCtx := log.WithFields(log.Fields{"application": app.Name})
 	modifiedApp := app.DeepCopy()
@@ -878,6 +1368,11 @@ func (ctrl *ApplicationController) updateAppStatus(
 	if conditions != nil {
 		modifiedApp.Status.Conditions = conditions
 	}
+	modifiedApp.Status.Destinations = destinations
+	modifiedApp.Status.OrphanedResources = orphanedResources
+	// the comparison was attempted, even if it failed, so refreshes requested before now are done
+	now := metav1.Now()
+	modifiedApp.Status.ReconciledAt = &now
 	origBytes, err := json.Marshal(app)
 	if err != nil {
 		logCtx.Errorf("Error updating (marshal orig app): %v", err)
@@ -912,6 +1407,10 @@ func (ctrl *ApplicationController) autoSync(app *appv1.Application, comparisonRe
 		return nil
 	}
 	logCtx := log.WithFields(log.Fields{"application": app.Name})
+	if ctrl.readOnly {
+		logCtx.Debugf("Skipping auto-sync: controller is read-only")
+		return nil
+	}
 	if app.Operation != nil {
 		logCtx.Infof("Skipping auto-sync: another operation is in progress")
 		return nil
@@ -927,23 +1426,47 @@ func (ctrl *ApplicationController) autoSync(app *appv1.Application, comparisonRe
 	// It is possible for manifests to remain OutOfSync even after a sync/kubectl apply (e.g.
 	// auto-sync with pruning disabled). We need to ensure that we do not keep Syncing an
 	// application in an infinite loop. To detect this, we only attempt the Sync if the revision
-	// and parameter overrides are different from our most recent sync operation.
+	// and parameter overrides are different from our most recent sync operation. Failed automated syncs
+	// are retried by the sync operation itself, according to the retry strategy of the sync policy.
+	// Applications which self-heal are synced again when their live state drifts from the revision which
+	// was already synced, with a backoff between consecutive self-heals.
+	var selfHealAttemptsCount int64
 	if alreadyAttemptedSync(app, desiredCommitSHA) {
 		if app.Status.OperationState.Phase != appv1.OperationSucceeded {
 			logCtx.Warnf("Skipping auto-sync: failed previous sync attempt to %s", desiredCommitSHA)
 			message := fmt.Sprintf("Failed sync attempt to %s: %s", desiredCommitSHA, app.Status.OperationState.Message)
 			return &appv1.ApplicationCondition{Type: appv1.ApplicationConditionSyncError, Message: message}
 		}
-		logCtx.Infof("Skipping auto-sync: most recent sync already to %s", desiredCommitSHA)
-		return nil
+		if !app.Spec.SyncPolicy.Automated.SelfHeal {
+			logCtx.Infof("Skipping auto-sync: most recent sync already to %s", desiredCommitSHA)
+			return nil
+		}
+		var delay time.Duration
+		selfHealAttemptsCount, delay = selfHealBackoff(app.Status.OperationState)
+		if delay > 0 {
+			logCtx.Infof("Skipping self-heal: most recent sync already to %s, retrying in %v", desiredCommitSHA, delay)
+			ctrl.requestAppRefreshAfter(app, delay)
+			return nil
+		}
+	}
+	// auto-syncs are deferred until the sync windows permit them. Failures to load the project are
+	// reported by the sync operation.
+	if proj, err := argo.GetAppProject(&app.Spec, ctrl.applicationClientset, ctrl.namespace); err == nil {
+		if ok, reason := proj.IsSyncPermitted(app.Name, time.Now()); !ok {
+			logCtx.Infof("Skipping auto-sync: %s", reason)
+			return nil
+		}
 	}
 
 	op := appv1.Operation{
 		Sync: &appv1.SyncOperation{
-			Revision:           desiredCommitSHA,
-			Prune:              app.Spec.SyncPolicy.Automated.Prune,
-			ParameterOverrides: app.Spec.Source.ComponentParameterOverrides,
+			Revision:              desiredCommitSHA,
+			Prune:                 app.Spec.SyncPolicy.Automated.Prune,
+			ParameterOverrides:    app.Spec.Source.ComponentParameterOverrides,
+			SelfHealAttemptsCount: selfHealAttemptsCount,
+			Retry:                 app.Spec.SyncPolicy.Retry,
 		},
+		CorrelationID: grpc_util.NewCorrelationID(),
 	}
 	appIf := ctrl.applicationClientset.ArgoprojV1alpha1().Applications(app.Namespace)
 	_, err := argo.SetAppOperation(appIf, app.Name, &op)
@@ -952,11 +1475,88 @@ func (ctrl *ApplicationController) autoSync(app *appv1.Application, comparisonRe
 		return &appv1.ApplicationCondition{Type: appv1.ApplicationConditionSyncError, Message: err.Error()}
 	}
 	message := fmt.Sprintf("Initiated automated sync to '%s'", desiredCommitSHA)
+	if selfHealAttemptsCount > 0 {
+		message = fmt.Sprintf("Initiated self-heal of the drift from '%s'", desiredCommitSHA)
+	}
 	ctrl.auditLogger.LogAppEvent(app, argo.EventInfo{Reason: argo.EventReasonOperationStarted, Type: v1.EventTypeNormal}, message)
-	logCtx.Info(message)
+	logCtx.WithField(grpc_util.CorrelationIDField, op.CorrelationID).Info(message)
 	return nil
 }
 
+// deferAutoSync returns whether the auto-sync of the application is deferred because the application
+// was compared against the live state rehydrated from a snapshot, which may be outdated. The
+// application is refreshed again once the live state is listed from the clusters.
+func (ctrl *ApplicationController) deferAutoSync(app *appv1.Application, comparedAt time.Time) bool {
+	if app.Spec.SyncPolicy == nil || app.Spec.SyncPolicy.Automated == nil {
+		return false
+	}
+	snapshotExpiresAt := ctrl.appStateManager.GetLiveStateSnapshotExpiration(app)
+	if !comparedAt.Before(snapshotExpiresAt) {
+		return false
+	}
+	log.WithField("application", app.Name).Infof("Deferring auto-sync: live state was rehydrated from a snapshot until %s", snapshotExpiresAt.Format(time.RFC3339))
+	ctrl.requestAppRefreshAfter(app, time.Until(snapshotExpiresAt))
+	return true
+}
+
+// selfHealBackoff returns the number of consecutive self-heals of an application, including the next
+// one, and how long the next self-heal has to wait after the most recent sync. The backoff restarts once
+// the application stayed synced for a while.
+func selfHealBackoff(state *appv1.OperationState) (int64, time.Duration) {
+	if state.FinishedAt == nil {
+		return 1, 0
+	}
+	sinceLastSync := time.Since(state.FinishedAt.Time)
+	attempts := state.Operation.Sync.SelfHealAttemptsCount
+	if sinceLastSync > selfHealResetDuration {
+		attempts = 0
+	}
+	delay := selfHealBackoffDuration
+	for i := int64(0); i < attempts && delay < selfHealBackoffMaxDuration; i++ {
+		delay *= 2
+	}
+	if delay > selfHealBackoffMaxDuration {
+		delay = selfHealBackoffMaxDuration
+	}
+	return attempts + 1, delay - sinceLastSync
+}
+
+// delayedRefresh is a pending refresh of an application
+type delayedRefresh struct {
+	timer *time.Timer
+	at    time.Time
+}
+
+// requestAppRefreshAfter refreshes the application once the delay passed. An application has at most
+// one pending refresh, so a refresh which is already due sooner is kept, and a later one is replaced.
+func (ctrl *ApplicationController) requestAppRefreshAfter(app *appv1.Application, delay time.Duration) {
+	key, err := cache.MetaNamespaceKeyFunc(app)
+	if err != nil {
+		log.WithField("application", app.Name).Warnf("Failed to requeue refresh: %v", err)
+		return
+	}
+	at := time.Now().Add(delay)
+	ctrl.delayedRefreshesMutex.Lock()
+	defer ctrl.delayedRefreshesMutex.Unlock()
+	if pending, ok := ctrl.delayedRefreshes[key]; ok {
+		if !pending.at.After(at) {
+			return
+		}
+		pending.timer.Stop()
+	}
+	refresh := &delayedRefresh{at: at}
+	refresh.timer = time.AfterFunc(delay, func() {
+		ctrl.delayedRefreshesMutex.Lock()
+		if ctrl.delayedRefreshes[key] == refresh {
+			delete(ctrl.delayedRefreshes, key)
+		}
+		ctrl.delayedRefreshesMutex.Unlock()
+		ctrl.forceAppRefresh(app.Name)
+		ctrl.appRefreshQueue.Add(key)
+	})
+	ctrl.delayedRefreshes[key] = refresh
+}
+
 // alreadyAttemptedSync returns whether or not the most recent sync was performed against the
 // commitSHA and with the same parameter overrides which are currently set in the app
 func alreadyAttemptedSync(app *appv1.Application, commitSHA string) bool {
@@ -977,7 +1577,10 @@ func (ctrl *ApplicationController) newApplicationInformer() cache.SharedIndexInf
 		ctrl.applicationClientset,
 		ctrl.statusRefreshTimeout,
 		ctrl.namespace,
-		func(options *metav1.ListOptions) {},
+		func(options *metav1.ListOptions) {
+			// only the applications of the controller instance are watched
+			options.LabelSelector = instanceSelector(ctrl.instanceID)
+		},
 	)
 	informer := appInformerFactory.Argoproj().V1alpha1().Applications().Informer()
 	informer.AddEventHandler(
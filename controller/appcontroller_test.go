@@ -1,16 +1,19 @@
 package controller
 
 import (
+	"context"
 	"testing"
 	"time"
 
 	"github.com/ghodss/yaml"
+	"github.com/prometheus/client_golang/prometheus/testutil"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/client-go/kubernetes/fake"
 	kubetesting "k8s.io/client-go/testing"
 
+	"github.com/argoproj/argo-cd/common"
 	argoappv1 "github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1"
 	appclientset "github.com/argoproj/argo-cd/pkg/client/clientset/versioned/fake"
 	reposerver "github.com/argoproj/argo-cd/reposerver/mocks"
@@ -26,13 +29,29 @@ func newFakeController(apps ...runtime.Object) *ApplicationController {
 	kubeClientset := fake.NewSimpleClientset(&clust)
 	appClientset := appclientset.NewSimpleClientset(apps...)
 	repoClientset := reposerver.Clientset{}
-	return NewApplicationController(
+	ctrl := NewApplicationController(
 		"argocd",
 		kubeClientset,
 		appClientset,
 		&repoClientset,
 		time.Minute,
+		0,
+		LiveStateSnapshots{},
+		false,
+		false,
+		nil,
+		0,
+		HistoryRetention{},
+		"",
+		0,
+		nil,
+		0,
 	)
+	// the certificates of fake clusters are not checked
+	ctrl.credentialsExpiry.serverCertificateExpiry = func(address string, serverName string) (time.Time, error) {
+		return time.Time{}, nil
+	}
+	return ctrl
 }
 
 var fakeCluster = `
@@ -119,6 +138,23 @@ func TestAutoSync(t *testing.T) {
 	assert.False(t, app.Operation.Sync.Prune)
 }
 
+func TestAutoSyncRetry(t *testing.T) {
+	app := newFakeApp()
+	app.Spec.SyncPolicy.Retry = &argoappv1.RetryStrategy{Limit: 3, Backoff: &argoappv1.Backoff{Duration: "10s"}}
+	ctrl := newFakeController(app)
+	compRes := argoappv1.ComparisonResult{
+		Status:   argoappv1.ComparisonStatusOutOfSync,
+		Revision: "bbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb",
+	}
+	cond := ctrl.autoSync(app, &compRes)
+	assert.Nil(t, cond)
+	app, err := ctrl.applicationClientset.ArgoprojV1alpha1().Applications("argocd").Get("my-app", metav1.GetOptions{})
+	assert.NoError(t, err)
+	if assert.NotNil(t, app.Operation) && assert.NotNil(t, app.Operation.Sync) {
+		assert.Equal(t, &argoappv1.RetryStrategy{Limit: 3, Backoff: &argoappv1.Backoff{Duration: "10s"}}, app.Operation.Sync.Retry)
+	}
+}
+
 func TestSkipAutoSync(t *testing.T) {
 	// Verify we skip when we previously synced to it in our most recent history
 	// Set current to 'aaaaa', desired to 'aaaa' and mark system OutOfSync
@@ -185,6 +221,27 @@ func TestSkipAutoSync(t *testing.T) {
 	assert.Nil(t, app.Operation)
 }
 
+// TestAutoSyncOutsideSyncWindows verifies we defer auto-sync while the sync windows do not permit it
+func TestAutoSyncOutsideSyncWindows(t *testing.T) {
+	app := newFakeApp()
+	proj := &argoappv1.AppProject{
+		ObjectMeta: metav1.ObjectMeta{Name: "default", Namespace: "argocd"},
+		Spec: argoappv1.AppProjectSpec{
+			SyncWindows: []argoappv1.SyncWindow{{Kind: argoappv1.SyncWindowKindDeny, Schedule: "* * * * *", Duration: "1h"}},
+		},
+	}
+	ctrl := newFakeController(app, proj)
+	compRes := argoappv1.ComparisonResult{
+		Status:   argoappv1.ComparisonStatusOutOfSync,
+		Revision: "bbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb",
+	}
+	cond := ctrl.autoSync(app, &compRes)
+	assert.Nil(t, cond)
+	app, err := ctrl.applicationClientset.ArgoprojV1alpha1().Applications("argocd").Get("my-app", metav1.GetOptions{})
+	assert.NoError(t, err)
+	assert.Nil(t, app.Operation)
+}
+
 // TestAutoSyncIndicateError verifies we skip auto-sync and return error condition if previous sync failed
 func TestAutoSyncIndicateError(t *testing.T) {
 	app := newFakeApp()
@@ -259,7 +316,101 @@ func TestAutoSyncParameterOverrides(t *testing.T) {
 	assert.NotNil(t, app.Operation)
 }
 
+// TestAutoSyncSelfHeal verifies we sync again to the revision of the most recent sync if self heal is enabled
+func TestAutoSyncSelfHeal(t *testing.T) {
+	app := newFakeApp()
+	app.Spec.SyncPolicy.Automated.SelfHeal = true
+	ctrl := newFakeController(app)
+	compRes := argoappv1.ComparisonResult{
+		Status:   argoappv1.ComparisonStatusOutOfSync,
+		Revision: "aaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa",
+	}
+	cond := ctrl.autoSync(app, &compRes)
+	assert.Nil(t, cond)
+	updatedApp, err := ctrl.applicationClientset.ArgoprojV1alpha1().Applications("argocd").Get("my-app", metav1.GetOptions{})
+	assert.NoError(t, err)
+	assert.NotNil(t, updatedApp.Operation)
+	assert.Equal(t, int64(1), updatedApp.Operation.Sync.SelfHealAttemptsCount)
+
+	// self heals are deferred until the backoff passed
+	app = newFakeApp()
+	app.Spec.SyncPolicy.Automated.SelfHeal = true
+	now := metav1.Now()
+	app.Status.OperationState.FinishedAt = &now
+	ctrl = newFakeController(app)
+	cond = ctrl.autoSync(app, &compRes)
+	assert.Nil(t, cond)
+	updatedApp, err = ctrl.applicationClientset.ArgoprojV1alpha1().Applications("argocd").Get("my-app", metav1.GetOptions{})
+	assert.NoError(t, err)
+	assert.Nil(t, updatedApp.Operation)
+}
+
+func TestSelfHealBackoff(t *testing.T) {
+	finishedAt := metav1.NewTime(time.Now().Add(-time.Minute))
+	state := &argoappv1.OperationState{
+		Operation:  argoappv1.Operation{Sync: &argoappv1.SyncOperation{}},
+		FinishedAt: &finishedAt,
+	}
+	attempts, delay := selfHealBackoff(state)
+	assert.Equal(t, int64(1), attempts)
+	assert.True(t, delay <= 0)
+
+	// the delay doubles with each consecutive self heal
+	state.Operation.Sync.SelfHealAttemptsCount = 4
+	attempts, delay = selfHealBackoff(state)
+	assert.Equal(t, int64(5), attempts)
+	assert.True(t, delay > 0 && delay <= 20*time.Second)
+
+	state.Operation.Sync.SelfHealAttemptsCount = 100
+	_, delay = selfHealBackoff(state)
+	assert.True(t, delay > time.Minute && delay <= 2*time.Minute)
+
+	// the backoff restarts once the application stayed synced
+	finishedAt = metav1.NewTime(time.Now().Add(-time.Hour))
+	attempts, delay = selfHealBackoff(state)
+	assert.Equal(t, int64(1), attempts)
+	assert.True(t, delay <= 0)
+}
+
 // TestFinalizeAppDeletion verifies application deletion
+func TestDeferAutoSyncAfterSnapshotRehydrate(t *testing.T) {
+	app := newFakeApp()
+	ctrl := newFakeController(app)
+	comparedAt := time.Now()
+	assert.False(t, ctrl.deferAutoSync(app, comparedAt))
+
+	// applications compared against a rehydrated snapshot are auto-synced once the live state is listed
+	liveState := ctrl.appStateManager.(*appStateManager).liveState
+	liveState.snapshotExpiresAt["https://localhost:6443"] = comparedAt.Add(time.Minute)
+	assert.True(t, ctrl.deferAutoSync(app, comparedAt))
+	assert.False(t, ctrl.deferAutoSync(app, comparedAt.Add(time.Minute)))
+
+	app.Spec.SyncPolicy = nil
+	assert.False(t, ctrl.deferAutoSync(app, comparedAt))
+}
+
+func TestRequestAppRefreshAfterKeepsOnePendingRefresh(t *testing.T) {
+	app := newFakeApp()
+	ctrl := newFakeController(app)
+	key := app.Namespace + "/" + app.Name
+
+	ctrl.requestAppRefreshAfter(app, time.Hour)
+	first := ctrl.delayedRefreshes[key]
+	assert.NotNil(t, first)
+
+	// a later refresh is not scheduled while a sooner one is pending
+	ctrl.requestAppRefreshAfter(app, 2*time.Hour)
+	assert.Len(t, ctrl.delayedRefreshes, 1)
+	assert.Equal(t, first, ctrl.delayedRefreshes[key])
+
+	// a sooner refresh replaces the pending one
+	ctrl.requestAppRefreshAfter(app, time.Minute)
+	assert.Len(t, ctrl.delayedRefreshes, 1)
+	assert.True(t, ctrl.delayedRefreshes[key].at.Before(first.at))
+	assert.False(t, first.timer.Stop())
+	ctrl.delayedRefreshes[key].timer.Stop()
+}
+
 func TestFinalizeAppDeletion(t *testing.T) {
 	app := newFakeApp()
 	ctrl := newFakeController(app)
@@ -278,3 +429,286 @@ func TestFinalizeAppDeletion(t *testing.T) {
 	assert.False(t, patched) // Change this to assert.True when we stub out GetResourcesWithLabel/DeleteResourceWithLabel
 
 }
+
+func TestDeletionDestinationsOfSelectorApp(t *testing.T) {
+	app := newFakeApp()
+	app.Spec.Destination = argoappv1.ApplicationDestination{Selector: "env=prod", Namespace: "default"}
+	// the application was deployed to a cluster whose labels changed since
+	deployed := argoappv1.ApplicationDestination{Server: "https://cluster-b", Namespace: "default"}
+	app.Status.Destinations = []argoappv1.DestinationStatus{{Destination: deployed}}
+	ctrl := newFakeController(app)
+	_, err := ctrl.db.CreateCluster(context.Background(), &argoappv1.Cluster{Server: "https://cluster-a", Name: "cluster-a", Labels: map[string]string{"env": "prod"}})
+	assert.NoError(t, err)
+
+	dests, err := ctrl.deletionDestinations(app)
+	assert.NoError(t, err)
+	assert.Equal(t, []argoappv1.ApplicationDestination{{Server: "https://cluster-a", Namespace: "default"}, deployed}, dests)
+}
+
+func TestSetOperationStateTerminated(t *testing.T) {
+	app := newFakeApp()
+	app.Status.OperationState = &argoappv1.OperationState{
+		Operation: argoappv1.Operation{Sync: &argoappv1.SyncOperation{}},
+		Phase:     argoappv1.OperationTerminating,
+	}
+	ctrl := newFakeController(app)
+	ctrl.historyRetention.OperationHistoryLimit = 5
+
+	syncRes := &argoappv1.SyncOperationResult{
+		Resources: []*argoappv1.ResourceDetails{{Name: "guestbook-ui", Kind: "Deployment", Status: argoappv1.ResourceDetailsSynced}},
+	}
+	ctrl.setOperationState(app, &argoappv1.OperationState{
+		Operation:  app.Status.OperationState.Operation,
+		Phase:      argoappv1.OperationFailed,
+		Message:    "Operation terminated",
+		SyncResult: syncRes,
+		Terminated: true,
+	})
+	app, err := ctrl.applicationClientset.ArgoprojV1alpha1().Applications("argocd").Get("my-app", metav1.GetOptions{})
+	assert.NoError(t, err)
+	assert.True(t, app.Status.OperationState.Terminated)
+	// the results of the resources synced before termination are kept
+	assert.Len(t, app.Status.OperationState.SyncResult.Resources, 1)
+	if assert.Len(t, app.Status.OperationHistory, 1) {
+		assert.True(t, app.Status.OperationHistory[0].Terminated)
+	}
+	assert.Equal(t, 1, int(testutil.ToFloat64(ctrl.metrics.operations.WithLabelValues(app.Spec.Project, operationPhaseTerminated))))
+	assert.Equal(t, 0, int(testutil.ToFloat64(ctrl.metrics.operations.WithLabelValues(app.Spec.Project, string(argoappv1.OperationFailed)))))
+}
+
+// TestReadOnlyController verifies a read-only controller refuses operations, auto-sync and cascaded deletion
+func TestReadOnlyController(t *testing.T) {
+	app := newFakeApp()
+	app.Operation = &argoappv1.Operation{
+		Sync: &argoappv1.SyncOperation{},
+	}
+	app.Status.OperationState = nil
+	ctrl := newFakeController(app)
+	ctrl.readOnly = true
+
+	ctrl.processRequestedAppOperation(app)
+	app, err := ctrl.applicationClientset.ArgoprojV1alpha1().Applications("argocd").Get("my-app", metav1.GetOptions{})
+	assert.NoError(t, err)
+	assert.NotNil(t, app.Status.OperationState)
+	assert.Equal(t, argoappv1.OperationFailed, app.Status.OperationState.Phase)
+	assert.Contains(t, app.Status.OperationState.Message, "read-only")
+
+	app = newFakeApp()
+	ctrl = newFakeController(app)
+	ctrl.readOnly = true
+	compRes := argoappv1.ComparisonResult{
+		Status:   argoappv1.ComparisonStatusOutOfSync,
+		Revision: "bbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb",
+	}
+	cond := ctrl.autoSync(app, &compRes)
+	assert.Nil(t, cond)
+	app, err = ctrl.applicationClientset.ArgoprojV1alpha1().Applications("argocd").Get("my-app", metav1.GetOptions{})
+	assert.NoError(t, err)
+	assert.Nil(t, app.Operation)
+
+	err = ctrl.finalizeApplicationDeletion(app)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "read-only")
+}
+
+// TestSinglePassOperationsRecordedInHistory verifies operations which complete in the pass which started
+// them are recorded in the history, also when the previous operation completed
+func TestSinglePassOperationsRecordedInHistory(t *testing.T) {
+	app := newFakeApp()
+	app.Operation = &argoappv1.Operation{Sync: &argoappv1.SyncOperation{Revision: "HEAD"}}
+	ctrl := newFakeController(app)
+	ctrl.historyRetention.OperationHistoryLimit = 5
+	// the read-only controller fails the operations in the pass which started them
+	ctrl.readOnly = true
+
+	for i := 1; i <= 2; i++ {
+		ctrl.processRequestedAppOperation(app)
+		updated, err := ctrl.applicationClientset.ArgoprojV1alpha1().Applications("argocd").Get("my-app", metav1.GetOptions{})
+		assert.NoError(t, err)
+		assert.Equal(t, argoappv1.OperationFailed, updated.Status.OperationState.Phase)
+		assert.Len(t, updated.Status.OperationHistory, i)
+
+		app = updated
+		app.Operation = &argoappv1.Operation{Sync: &argoappv1.SyncOperation{Revision: "HEAD"}}
+	}
+}
+
+// TestOperationsMetricCountsOperationAfterCompletedOne verifies a new operation is counted when it
+// completes, although the operation it replaces in the status had completed too
+func TestOperationsMetricCountsOperationAfterCompletedOne(t *testing.T) {
+	app := newFakeApp()
+	app.Operation = &argoappv1.Operation{Sync: &argoappv1.SyncOperation{Revision: "HEAD"}}
+	ctrl := newFakeController(app)
+	ctrl.readOnly = true
+
+	ctrl.processRequestedAppOperation(app)
+	assert.Equal(t, 1, int(testutil.ToFloat64(ctrl.metrics.operations.WithLabelValues(app.Spec.Project, string(argoappv1.OperationFailed)))))
+	// the operation is counted once, also when its completed state is updated again
+	updated, err := ctrl.applicationClientset.ArgoprojV1alpha1().Applications("argocd").Get("my-app", metav1.GetOptions{})
+	assert.NoError(t, err)
+	state := updated.Status.OperationState.DeepCopy()
+	state.Message = "updated"
+	ctrl.setOperationState(updated, state)
+	assert.Equal(t, 1, int(testutil.ToFloat64(ctrl.metrics.operations.WithLabelValues(app.Spec.Project, string(argoappv1.OperationFailed)))))
+}
+
+// TestRetryFailedOperation verifies failed operations are retried with backoff until the retry limit is reached
+func TestRetryFailedOperation(t *testing.T) {
+	app := newFakeApp()
+	ctrl := newFakeController(app)
+	state := &argoappv1.OperationState{
+		Operation: argoappv1.Operation{
+			Sync: &argoappv1.SyncOperation{
+				Retry: &argoappv1.RetryStrategy{Limit: 2, Backoff: &argoappv1.Backoff{Duration: "1m"}},
+			},
+		},
+		Phase:      argoappv1.OperationFailed,
+		Message:    "one or more objects failed to apply",
+		SyncResult: &argoappv1.SyncOperationResult{Revision: "abc123", Resources: []*argoappv1.ResourceDetails{{Name: "my-config"}}},
+	}
+
+	ctrl.retryFailedOperation(app, state)
+	assert.Equal(t, argoappv1.OperationRunning, state.Phase)
+	assert.Contains(t, state.Message, "Retrying attempt #1")
+	assert.Len(t, state.Attempts, 1)
+	assert.Equal(t, argoappv1.OperationFailed, state.Attempts[0].Phase)
+	assert.Equal(t, &argoappv1.SyncOperationResult{Revision: "abc123"}, state.SyncResult)
+	delay := retryDelay(state)
+	assert.True(t, delay > 50*time.Second && delay <= time.Minute)
+
+	state.Phase = argoappv1.OperationError
+	ctrl.retryFailedOperation(app, state)
+	assert.Equal(t, argoappv1.OperationRunning, state.Phase)
+	assert.Len(t, state.Attempts, 2)
+	delay = retryDelay(state)
+	assert.True(t, delay > 110*time.Second && delay <= 2*time.Minute)
+
+	state.Phase = argoappv1.OperationFailed
+	state.Message = "one or more objects failed to apply"
+	ctrl.retryFailedOperation(app, state)
+	assert.Equal(t, argoappv1.OperationFailed, state.Phase)
+	assert.Equal(t, "one or more objects failed to apply (retried 2 times)", state.Message)
+	assert.Len(t, state.Attempts, 2)
+}
+
+func TestRetryFailedOperationFailedOnly(t *testing.T) {
+	app := newFakeApp()
+	ctrl := newFakeController(app)
+	state := &argoappv1.OperationState{
+		Operation: argoappv1.Operation{
+			Sync: &argoappv1.SyncOperation{
+				Retry: &argoappv1.RetryStrategy{Limit: 2, FailedOnly: true},
+			},
+		},
+		Phase:   argoappv1.OperationFailed,
+		Message: "one or more objects failed to apply",
+		SyncResult: &argoappv1.SyncOperationResult{Revision: "abc123", Resources: []*argoappv1.ResourceDetails{
+			{Kind: "ConfigMap", Name: "my-config", Status: argoappv1.ResourceDetailsSynced},
+			{Kind: "Deployment", Name: "my-app", Status: argoappv1.ResourceDetailsSyncFailed, Message: "admission webhook denied the request"},
+		}},
+	}
+
+	ctrl.retryFailedOperation(app, state)
+	assert.Equal(t, argoappv1.OperationRunning, state.Phase)
+	assert.Equal(t, "abc123", state.SyncResult.Revision)
+	assert.Empty(t, state.SyncResult.Resources)
+	assert.Len(t, state.SyncResult.FailedResources, 1)
+	assert.Equal(t, "my-app", state.SyncResult.FailedResources[0].Name)
+	assert.Equal(t, "admission webhook denied the request", state.SyncResult.FailedResources[0].Message)
+
+	// an attempt which failed for another reason retries all resources
+	state.Phase = argoappv1.OperationFailed
+	state.SyncResult.Hooks = []*argoappv1.HookStatus{{Name: "my-hook", Status: argoappv1.OperationFailed}}
+	ctrl.retryFailedOperation(app, state)
+	assert.Equal(t, &argoappv1.SyncOperationResult{Revision: "abc123"}, state.SyncResult)
+
+	// all resources are retried if a hook failed, even if resources failed as well
+	res := retrySyncResult(&argoappv1.SyncOperationResult{
+		Revision:  "abc123",
+		Resources: []*argoappv1.ResourceDetails{{Kind: "Deployment", Name: "my-app", Status: argoappv1.ResourceDetailsSyncFailed}},
+		Hooks:     []*argoappv1.HookStatus{{Name: "my-hook", Status: argoappv1.OperationError}},
+	}, true)
+	assert.Equal(t, &argoappv1.SyncOperationResult{Revision: "abc123"}, res)
+}
+
+func TestDeletionPropagationPolicy(t *testing.T) {
+	app := newFakeApp()
+	policy, err := deletionPropagationPolicy(app)
+	assert.NoError(t, err)
+	assert.Equal(t, metav1.DeletePropagationForeground, policy)
+
+	app.Annotations = map[string]string{common.AnnotationDeletionPropagationPolicy: "background"}
+	policy, err = deletionPropagationPolicy(app)
+	assert.NoError(t, err)
+	assert.Equal(t, metav1.DeletePropagationBackground, policy)
+
+	app.Annotations[common.AnnotationDeletionPropagationPolicy] = "orphan"
+	_, err = deletionPropagationPolicy(app)
+	assert.Error(t, err)
+}
+
+func TestOperationTimeout(t *testing.T) {
+	state := &argoappv1.OperationState{
+		Operation: argoappv1.Operation{Sync: &argoappv1.SyncOperation{}, Timeout: "10m"},
+		Phase:     argoappv1.OperationRunning,
+		StartedAt: metav1.NewTime(time.Now().Add(-5 * time.Minute)),
+	}
+	assert.Equal(t, time.Duration(0), operationTimeout(state))
+
+	state.StartedAt = metav1.NewTime(time.Now().Add(-11 * time.Minute))
+	assert.Equal(t, 10*time.Minute, operationTimeout(state))
+
+	// completed operations do not time out
+	state.Phase = argoappv1.OperationSucceeded
+	assert.Equal(t, time.Duration(0), operationTimeout(state))
+
+	state.Phase = argoappv1.OperationRunning
+	state.Operation.Timeout = ""
+	assert.Equal(t, time.Duration(0), operationTimeout(state))
+}
+
+func TestOperationTimeoutMessage(t *testing.T) {
+	// the timeout is remembered while the hooks of the timed out operation are deleted
+	assert.Equal(t, "Operation timed out after 10m0s", operationTimeoutMessage("Operation timed out after 10m0s: waiting for hooks to be deleted"))
+	assert.Equal(t, "Operation timed out after 10m0s", operationTimeoutMessage("Operation timed out after 10m0s"))
+	assert.Equal(t, "", operationTimeoutMessage("waiting for hooks to be deleted"))
+	assert.Equal(t, "", operationTimeoutMessage("operation is terminating"))
+}
+
+func TestStaleOperationChangedAt(t *testing.T) {
+	ctrl := &ApplicationController{startedAt: time.Now(), staleOperationTimeout: 10 * time.Minute}
+	state := &argoappv1.OperationState{
+		Operation: argoappv1.Operation{Sync: &argoappv1.SyncOperation{}},
+		Phase:     argoappv1.OperationRunning,
+		StartedAt: metav1.NewTime(time.Now().Add(-time.Hour)),
+	}
+	changedAt := metav1.NewTime(time.Now().Add(-5 * time.Minute))
+	state.StateChangedAt = &changedAt
+	assert.Nil(t, ctrl.staleOperationChangedAt(state))
+
+	// the start time is used if the state never changed
+	state.StateChangedAt = nil
+	if assert.NotNil(t, ctrl.staleOperationChangedAt(state)) {
+		assert.True(t, ctrl.staleOperationChangedAt(state).Equal(&state.StartedAt))
+	}
+
+	// operations which changed since the controller started are not stale
+	ctrl.startedAt = time.Now().Add(-2 * time.Hour)
+	assert.Nil(t, ctrl.staleOperationChangedAt(state))
+
+	ctrl.startedAt = time.Now()
+	state.Phase = argoappv1.OperationFailed
+	assert.Nil(t, ctrl.staleOperationChangedAt(state))
+
+	state.Phase = argoappv1.OperationRunning
+	ctrl.staleOperationTimeout = 0
+	assert.Nil(t, ctrl.staleOperationChangedAt(state))
+}
+
+func TestMarkOperationResumed(t *testing.T) {
+	ctrl := newFakeController()
+	assert.True(t, ctrl.markOperationResumed("my-app"))
+	// the operation is only checked for staleness the first time it is resumed
+	assert.False(t, ctrl.markOperationResumed("my-app"))
+	assert.True(t, ctrl.markOperationResumed("other-app"))
+}
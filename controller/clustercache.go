@@ -0,0 +1,423 @@
+package controller
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"sync"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/cache"
+
+	"github.com/argoproj/argo-cd/common"
+	"github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1"
+	kubeutil "github.com/argoproj/argo-cd/util/kube"
+)
+
+const (
+	// clusterCacheSyncTimeout is how long a comparison waits for the resources of a cluster to be listed
+	clusterCacheSyncTimeout = time.Minute
+	// clusterCacheIdleTimeout is how long the resources of a cluster are watched after the latest
+	// comparison of an application which targets the cluster
+	clusterCacheIdleTimeout = time.Hour
+	// clusterCacheDiscoveryInterval is the min duration between two discoveries of the resource kinds
+	// of a cluster, which are discovered again when an application manages a kind the cluster did not serve
+	clusterCacheDiscoveryInterval = time.Minute
+	// appNameIndex indexes the cached resources by the name of their application
+	appNameIndex = "appName"
+)
+
+// clusterCache keeps the live resources of the clusters in memory, so that comparisons get them without
+// querying the API servers. Once an application which targets a cluster is compared, the resources of
+// every kind of the cluster which are labeled with an application are listed, and then kept up to date
+// by watches. The resources of the kinds managed by the applications are watched regardless of their
+// labels, so that the resources created outside of Argo CD are found as well. A cluster is no longer
+// watched once none of its applications was compared for clusterCacheIdleTimeout. The resources are not
+// persisted, so a restarted controller lists them again.
+type clusterCache struct {
+	lock     sync.Mutex
+	clusters map[string]*clusterResources
+	// getAPIResources returns the kinds of resources of a cluster which can be listed and watched
+	getAPIResources func(config *rest.Config) ([]kubeutil.APIResourceInfo, error)
+	// newListWatch returns the list and watch functions of the resources of a kind, in all namespaces,
+	// which match the label selector
+	newListWatch func(config *rest.Config, res kubeutil.APIResourceInfo, labelSelector string) (cache.ListerWatcher, error)
+	// isExcluded returns whether the resources of a kind of a cluster are not watched, unless it is nil
+	isExcluded func(server string, gvk schema.GroupVersionKind) bool
+}
+
+// clusterResources holds the informers of the resources of a cluster
+type clusterResources struct {
+	server string
+	config *rest.Config
+	// configHash is the hash of the configuration of the cluster the watches were started with
+	configHash string
+	// usedAt is the time of the latest comparison which got resources of the cluster. Protected by the
+	// lock of the cluster cache
+	usedAt time.Time
+
+	lock sync.Mutex
+	// apiResources holds the kinds of resources served by the cluster, which are discovered once the
+	// cluster is first used
+	apiResources map[schema.GroupVersionKind]kubeutil.APIResourceInfo
+	discoveredAt time.Time
+	informers    map[schema.GroupVersionKind]*kindInformer
+	// stopped is whether the cluster is no longer watched
+	stopped bool
+}
+
+// kindInformer watches the resources of a kind
+type kindInformer struct {
+	gvk        schema.GroupVersionKind
+	informer   cache.SharedIndexInformer
+	stopCh     chan struct{}
+	namespaced bool
+	// managed is whether the resources are watched regardless of their labels
+	managed bool
+	// syncTimedOut is whether the resources were not listed within clusterCacheSyncTimeout, in which
+	// case comparisons no longer wait for them. Protected by the lock of the cluster
+	syncTimedOut bool
+}
+
+func newClusterCache() *clusterCache {
+	return &clusterCache{
+		clusters:        make(map[string]*clusterResources),
+		getAPIResources: kubeutil.GetWatchableAPIResources,
+		newListWatch:    newDynamicListWatch,
+	}
+}
+
+// newDynamicListWatch returns the list and watch functions of the resources of a kind, using the dynamic client
+func newDynamicListWatch(config *rest.Config, res kubeutil.APIResourceInfo, labelSelector string) (cache.ListerWatcher, error) {
+	dynamicIf, err := dynamic.NewForConfig(config)
+	if err != nil {
+		return nil, err
+	}
+	resource := kubeutil.ToGroupVersionResource(res.GroupVersionKind.GroupVersion().String(), &res.APIResource)
+	resourceIf := kubeutil.ToResourceInterface(dynamicIf, &res.APIResource, resource, "")
+	return &cache.ListWatch{
+		ListFunc: func(options metav1.ListOptions) (runtime.Object, error) {
+			options.LabelSelector = labelSelector
+			return resourceIf.List(options)
+		},
+		WatchFunc: func(options metav1.ListOptions) (watch.Interface, error) {
+			options.LabelSelector = labelSelector
+			return resourceIf.Watch(options)
+		},
+	}, nil
+}
+
+// getAppLiveObjs returns the cached live resources labeled with the application name which belong to
+// the given controller instance, in one of the given namespaces or cluster-scoped. The resources of the
+// managed kinds are watched regardless of their labels from now on, so that they can be looked up with
+// getLiveObj.
+func (c *clusterCache) getAppLiveObjs(clst *v1alpha1.Cluster, managedKinds []schema.GroupVersionKind, namespaces []string, appName string, instanceID string) ([]*unstructured.Unstructured, error) {
+	cluster, err := c.getClusterResources(clst)
+	if err != nil {
+		return nil, err
+	}
+	informers, err := c.watch(cluster, managedKinds)
+	if err != nil {
+		return nil, err
+	}
+	informers = cluster.waitForInformers(informers)
+	var objs []*unstructured.Unstructured
+	for _, inf := range informers {
+		items, err := inf.informer.GetIndexer().ByIndex(appNameIndex, appName)
+		if err != nil {
+			return nil, err
+		}
+		for _, item := range items {
+			obj, ok := item.(*unstructured.Unstructured)
+			if !ok || !isInstanceObject(obj, instanceID) || !isInNamespaces(obj, namespaces) {
+				continue
+			}
+			// cached objects are shared by all comparisons, so must not be modified
+			objs = append(objs, obj.DeepCopy())
+		}
+	}
+	return objs, nil
+}
+
+// getLiveObj returns the cached live resource of the target object, regardless of its labels, or nil if
+// there is none. The target object must be of a kind managed by a previous call of getAppLiveObjs.
+// Namespaced resources without a namespace are looked up in the given namespace.
+func (c *clusterCache) getLiveObj(clst *v1alpha1.Cluster, targetObj *unstructured.Unstructured, namespace string) (*unstructured.Unstructured, error) {
+	cluster, err := c.getClusterResources(clst)
+	if err != nil {
+		return nil, err
+	}
+	cluster.lock.Lock()
+	inf, ok := cluster.informers[targetObj.GroupVersionKind()]
+	cluster.lock.Unlock()
+	if !ok || !inf.managed || !inf.informer.HasSynced() {
+		// the kind is not served by the cluster, e.g. a custom resource which has yet to be registered
+		return nil, nil
+	}
+	key := targetObj.GetName()
+	if inf.namespaced {
+		if targetObj.GetNamespace() != "" {
+			namespace = targetObj.GetNamespace()
+		}
+		key = fmt.Sprintf("%s/%s", namespace, key)
+	}
+	item, exists, err := inf.informer.GetIndexer().GetByKey(key)
+	if err != nil || !exists {
+		return nil, err
+	}
+	obj, ok := item.(*unstructured.Unstructured)
+	if !ok {
+		return nil, nil
+	}
+	return obj.DeepCopy(), nil
+}
+
+// getClusterResources returns the cached resources of the cluster. The watches of the clusters which
+// were not used for clusterCacheIdleTimeout are stopped, as well as the watches of the cluster if its
+// configuration changed, e.g. because its credentials were rotated.
+func (c *clusterCache) getClusterResources(clst *v1alpha1.Cluster) (*clusterResources, error) {
+	configHash, err := clusterConfigHash(clst)
+	if err != nil {
+		return nil, err
+	}
+	var stale []*clusterResources
+	defer func() {
+		// stopping the watches locks the cluster, which might be discovering its resources, so is done
+		// once the cache is unlocked
+		for _, cluster := range stale {
+			cluster.stop()
+		}
+	}()
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	now := time.Now()
+	for otherServer, cluster := range c.clusters {
+		if otherServer != clst.Server && now.Sub(cluster.usedAt) > clusterCacheIdleTimeout {
+			log.Infof("Stop watching resources of cluster %s, which was not used since %s", otherServer, cluster.usedAt.Format(time.RFC3339))
+			stale = append(stale, cluster)
+			delete(c.clusters, otherServer)
+		}
+	}
+	cluster, ok := c.clusters[clst.Server]
+	if ok && cluster.configHash != configHash {
+		log.Infof("Configuration of cluster %s changed, restarting the watches of its resources", clst.Server)
+		stale = append(stale, cluster)
+		ok = false
+	}
+	if !ok {
+		cluster = &clusterResources{
+			server:     clst.Server,
+			config:     kubeutil.ClusterRESTConfig(clst),
+			configHash: configHash,
+			informers:  make(map[schema.GroupVersionKind]*kindInformer),
+		}
+		c.clusters[clst.Server] = cluster
+	}
+	cluster.usedAt = now
+	return cluster, nil
+}
+
+// clusterConfigHash returns the hash of the serialized server and configuration of the cluster
+func clusterConfigHash(clst *v1alpha1.Cluster) (string, error) {
+	data, err := json.Marshal(struct {
+		Server string                 `json:"server"`
+		Config v1alpha1.ClusterConfig `json:"config"`
+	}{clst.Server, clst.Config})
+	if err != nil {
+		return "", err
+	}
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:]), nil
+}
+
+// watch starts watching the labeled resources of the cluster unless it already does, as well as all the
+// resources of the managed kinds. Returns the informers of all the watched kinds.
+func (c *clusterCache) watch(cluster *clusterResources, managedKinds []schema.GroupVersionKind) ([]*kindInformer, error) {
+	cluster.lock.Lock()
+	defer cluster.lock.Unlock()
+	if cluster.stopped {
+		return nil, fmt.Errorf("resources of cluster %s are no longer watched", cluster.server)
+	}
+	if cluster.apiResources == nil {
+		if err := c.discover(cluster); err != nil {
+			return nil, err
+		}
+	}
+	for _, gvk := range managedKinds {
+		if inf, ok := cluster.informers[gvk]; ok && inf.managed {
+			continue
+		}
+		res, ok := cluster.apiResources[gvk]
+		if !ok && time.Since(cluster.discoveredAt) >= clusterCacheDiscoveryInterval {
+			// the kind might be a custom resource which was registered since the latest discovery
+			kubeutil.FlushCachedServerResources(cluster.config.Host)
+			if err := c.discover(cluster); err != nil {
+				return nil, err
+			}
+			res, ok = cluster.apiResources[gvk]
+		}
+		if !ok {
+			continue
+		}
+		if err := c.startInformer(cluster, res, true); err != nil {
+			return nil, err
+		}
+	}
+	informers := make([]*kindInformer, 0, len(cluster.informers))
+	for _, inf := range cluster.informers {
+		informers = append(informers, inf)
+	}
+	return informers, nil
+}
+
+// discover updates the kinds of resources served by the cluster, except the excluded kinds. The labeled
+// resources of the new kinds are watched, and the watches of the kinds which are no longer served or
+// excluded are stopped.
+func (c *clusterCache) discover(cluster *clusterResources) error {
+	infos, err := c.getAPIResources(cluster.config)
+	if err != nil {
+		return err
+	}
+	apiResources := make(map[schema.GroupVersionKind]kubeutil.APIResourceInfo)
+	for _, info := range infos {
+		if c.isExcluded != nil && c.isExcluded(cluster.server, info.GroupVersionKind) {
+			continue
+		}
+		apiResources[info.GroupVersionKind] = info
+	}
+	for gvk, inf := range cluster.informers {
+		if _, ok := apiResources[gvk]; !ok {
+			close(inf.stopCh)
+			delete(cluster.informers, gvk)
+		}
+	}
+	for gvk, info := range apiResources {
+		if _, ok := cluster.informers[gvk]; ok {
+			continue
+		}
+		if err := c.startInformer(cluster, info, false); err != nil {
+			return err
+		}
+	}
+	cluster.apiResources = apiResources
+	cluster.discoveredAt = time.Now()
+	return nil
+}
+
+// rediscover discovers the kinds of resources of every cluster again once the cluster is next used, so
+// that changed exclusions apply to the watches
+func (c *clusterCache) rediscover() {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	for _, cluster := range c.clusters {
+		cluster.lock.Lock()
+		cluster.apiResources = nil
+		cluster.lock.Unlock()
+	}
+}
+
+// startInformer starts watching the resources of a kind, which are labeled with an application unless
+// the kind is managed, and replaces the previous informer of the kind, if any
+func (c *clusterCache) startInformer(cluster *clusterResources, res kubeutil.APIResourceInfo, managed bool) error {
+	labelSelector := common.LabelApplicationName
+	if managed {
+		labelSelector = ""
+	}
+	lw, err := c.newListWatch(cluster.config, res, labelSelector)
+	if err != nil {
+		return err
+	}
+	if inf, ok := cluster.informers[res.GroupVersionKind]; ok {
+		close(inf.stopCh)
+	}
+	inf := &kindInformer{
+		gvk:        res.GroupVersionKind,
+		informer:   cache.NewSharedIndexInformer(lw, &unstructured.Unstructured{}, 0, cache.Indexers{appNameIndex: indexByAppName}),
+		stopCh:     make(chan struct{}),
+		namespaced: res.APIResource.Namespaced,
+		managed:    managed,
+	}
+	cluster.informers[res.GroupVersionKind] = inf
+	go inf.informer.Run(inf.stopCh)
+	return nil
+}
+
+// stop stops watching the resources of the cluster
+func (cluster *clusterResources) stop() {
+	cluster.lock.Lock()
+	defer cluster.lock.Unlock()
+	cluster.stopped = true
+	for gvk, inf := range cluster.informers {
+		close(inf.stopCh)
+		delete(cluster.informers, gvk)
+	}
+}
+
+// waitForInformers waits for the informers to list the resources they watch, and returns the informers
+// which did. The informers which do not list their resources within clusterCacheSyncTimeout, e.g. because
+// listing an aggregated API fails, are ignored, so that they do not fail the comparisons of every
+// application of the cluster, and are no longer waited for until they list their resources.
+func (cluster *clusterResources) waitForInformers(informers []*kindInformer) []*kindInformer {
+	var synced []cache.InformerSynced
+	cluster.lock.Lock()
+	for i := range informers {
+		if !informers[i].syncTimedOut {
+			synced = append(synced, informers[i].informer.HasSynced)
+		}
+	}
+	cluster.lock.Unlock()
+	stopCh := make(chan struct{})
+	timer := time.AfterFunc(clusterCacheSyncTimeout, func() {
+		close(stopCh)
+	})
+	defer timer.Stop()
+	cache.WaitForCacheSync(stopCh, synced...)
+
+	cluster.lock.Lock()
+	defer cluster.lock.Unlock()
+	res := make([]*kindInformer, 0, len(informers))
+	for _, inf := range informers {
+		if inf.informer.HasSynced() {
+			inf.syncTimedOut = false
+			res = append(res, inf)
+			continue
+		}
+		if !inf.syncTimedOut {
+			log.Warnf("Timed out waiting for the resources of kind %s of cluster %s to be listed, ignoring them", inf.gvk, cluster.server)
+			inf.syncTimedOut = true
+		}
+	}
+	return res
+}
+
+// indexByAppName indexes the resources by the value of their application label
+func indexByAppName(obj interface{}) ([]string, error) {
+	if un, ok := obj.(*unstructured.Unstructured); ok {
+		if appName := un.GetLabels()[common.LabelApplicationName]; appName != "" {
+			return []string{appName}, nil
+		}
+	}
+	return nil, nil
+}
+
+// targetKinds returns the kinds of the target objects
+func targetKinds(targetObjs []*unstructured.Unstructured) []schema.GroupVersionKind {
+	var kinds []schema.GroupVersionKind
+	seen := make(map[schema.GroupVersionKind]bool)
+	for _, obj := range targetObjs {
+		if gvk := obj.GroupVersionKind(); gvk.Kind != "" && !seen[gvk] {
+			seen[gvk] = true
+			kinds = append(kinds, gvk)
+		}
+	}
+	return kinds
+}
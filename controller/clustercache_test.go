@@ -0,0 +1,130 @@
+package controller
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/cache"
+
+	"github.com/argoproj/argo-cd/common"
+	"github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1"
+	kubeutil "github.com/argoproj/argo-cd/util/kube"
+)
+
+var (
+	configMapGVK  = schema.GroupVersionKind{Version: "v1", Kind: "ConfigMap"}
+	deploymentGVK = schema.GroupVersionKind{Group: "apps", Version: "v1", Kind: "Deployment"}
+)
+
+func newFakeClusterCache(objs ...*unstructured.Unstructured) *clusterCache {
+	c := newClusterCache()
+	c.getAPIResources = func(config *rest.Config) ([]kubeutil.APIResourceInfo, error) {
+		return []kubeutil.APIResourceInfo{
+			{GroupVersionKind: configMapGVK, APIResource: metav1.APIResource{Name: "configmaps", Kind: "ConfigMap", Namespaced: true}},
+			{GroupVersionKind: deploymentGVK, APIResource: metav1.APIResource{Name: "deployments", Kind: "Deployment", Namespaced: true}},
+		}, nil
+	}
+	c.newListWatch = func(config *rest.Config, res kubeutil.APIResourceInfo, labelSelector string) (cache.ListerWatcher, error) {
+		return &cache.ListWatch{
+			ListFunc: func(options metav1.ListOptions) (runtime.Object, error) {
+				list := &unstructured.UnstructuredList{}
+				for _, obj := range objs {
+					if obj.GroupVersionKind() != res.GroupVersionKind {
+						continue
+					}
+					if _, ok := obj.GetLabels()[labelSelector]; labelSelector != "" && !ok {
+						continue
+					}
+					list.Items = append(list.Items, *obj.DeepCopy())
+				}
+				return list, nil
+			},
+			WatchFunc: func(options metav1.ListOptions) (watch.Interface, error) {
+				return watch.NewFake(), nil
+			},
+		}, nil
+	}
+	return c
+}
+
+func newCachedObj(gvk schema.GroupVersionKind, name string, appName string) *unstructured.Unstructured {
+	obj := &unstructured.Unstructured{}
+	obj.SetGroupVersionKind(gvk)
+	obj.SetNamespace("default")
+	obj.SetName(name)
+	if appName != "" {
+		obj.SetLabels(map[string]string{common.LabelApplicationName: appName})
+	}
+	return obj
+}
+
+func TestClusterCacheGetAppLiveObjs(t *testing.T) {
+	c := newFakeClusterCache(
+		newCachedObj(configMapGVK, "my-app-config", "my-app"),
+		newCachedObj(configMapGVK, "other-app-config", "other-app"),
+		newCachedObj(configMapGVK, "manual-config", ""),
+		newCachedObj(deploymentGVK, "manual", ""),
+	)
+	clst := &v1alpha1.Cluster{Server: "https://localhost:6443"}
+	cluster, err := c.getClusterResources(clst)
+	assert.NoError(t, err)
+	defer cluster.stop()
+
+	objs, err := c.getAppLiveObjs(clst, []schema.GroupVersionKind{deploymentGVK}, []string{"default"}, "my-app", "")
+	assert.NoError(t, err)
+	if assert.Len(t, objs, 1) {
+		assert.Equal(t, "my-app-config", objs[0].GetName())
+	}
+
+	// resources of the managed kinds are cached regardless of their labels
+	liveObj, err := c.getLiveObj(clst, newCachedObj(deploymentGVK, "manual", ""), "default")
+	assert.NoError(t, err)
+	if assert.NotNil(t, liveObj) {
+		assert.Equal(t, "manual", liveObj.GetName())
+	}
+	liveObj, err = c.getLiveObj(clst, newCachedObj(deploymentGVK, "missing", ""), "default")
+	assert.NoError(t, err)
+	assert.Nil(t, liveObj)
+
+	// unlabeled resources of other kinds are not cached
+	liveObj, err = c.getLiveObj(clst, newCachedObj(configMapGVK, "manual-config", ""), "default")
+	assert.NoError(t, err)
+	assert.Nil(t, liveObj)
+}
+
+func TestClusterCacheConfigChanged(t *testing.T) {
+	c := newFakeClusterCache()
+	newCluster := func(token string) *v1alpha1.Cluster {
+		return &v1alpha1.Cluster{Server: "https://localhost:6443", Config: v1alpha1.ClusterConfig{BearerToken: token}}
+	}
+	cluster, err := c.getClusterResources(newCluster("token"))
+	assert.NoError(t, err)
+	// the connection state is not part of the configuration
+	sameCluster := newCluster("token")
+	sameCluster.ConnectionState.Status = v1alpha1.ConnectionStatusFailed
+	same, err := c.getClusterResources(sameCluster)
+	assert.NoError(t, err)
+	assert.True(t, cluster == same)
+
+	rotated, err := c.getClusterResources(newCluster("rotated"))
+	assert.NoError(t, err)
+	assert.True(t, cluster != rotated)
+	assert.True(t, cluster.stopped)
+	_, err = c.watch(cluster, nil)
+	assert.Error(t, err)
+}
+
+func TestTargetKinds(t *testing.T) {
+	kinds := targetKinds([]*unstructured.Unstructured{
+		newCachedObj(deploymentGVK, "guestbook-ui", ""),
+		newCachedObj(configMapGVK, "guestbook-config", ""),
+		newCachedObj(deploymentGVK, "guestbook-redis", ""),
+	})
+	assert.Equal(t, []schema.GroupVersionKind{deploymentGVK, configMapGVK}, kinds)
+}
@@ -0,0 +1,58 @@
+package controller
+
+import (
+	"fmt"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/dynamic"
+
+	"github.com/argoproj/argo-cd/common"
+	appv1 "github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/util/kube"
+)
+
+var crdResource = schema.GroupVersionResource{Group: "apiextensions.k8s.io", Version: "v1beta1", Resource: "customresourcedefinitions"}
+
+// verifyCRDDeletion returns an error if deleting the custom resource definition would also delete
+// custom resources which do not belong to the application
+func verifyCRDDeletion(dynamicIf dynamic.Interface, crd *unstructured.Unstructured, appName string) error {
+	instances, err := kube.ListCRDInstances(dynamicIf, crd)
+	if err != nil {
+		return fmt.Errorf("failed to list instances of CustomResourceDefinition '%s': %v", crd.GetName(), err)
+	}
+	count := 0
+	for _, instance := range instances {
+		if instance.GetLabels()[common.LabelApplicationName] != appName {
+			count++
+		}
+	}
+	if count > 0 {
+		return fmt.Errorf("CustomResourceDefinition '%s' has %d instance(s) outside of the application, which would be deleted along with it. The deletion must be confirmed explicitly", crd.GetName(), count)
+	}
+	return nil
+}
+
+// deleteAppCRDs deletes the custom resource definitions of an application, which are not deleted
+// along with the other application resources. Unless confirmed by the application annotation,
+// fails if any definition has instances which do not belong to the application.
+func deleteAppCRDs(dynamicIf dynamic.Interface, app *appv1.Application, objs []*unstructured.Unstructured) error {
+	confirmed := app.Annotations[common.AnnotationConfirmCRDDeletion] == "true"
+	propagationPolicy := metav1.DeletePropagationForeground
+	for _, obj := range objs {
+		if !kube.IsCRD(obj) || obj.GetDeletionTimestamp() != nil {
+			continue
+		}
+		if !confirmed {
+			if err := verifyCRDDeletion(dynamicIf, obj, app.Name); err != nil {
+				return err
+			}
+		}
+		err := dynamicIf.Resource(crdResource).Delete(obj.GetName(), &metav1.DeleteOptions{PropagationPolicy: &propagationPolicy})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,97 @@
+package controller
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	apierr "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	fakedynamic "k8s.io/client-go/dynamic/fake"
+
+	"github.com/argoproj/argo-cd/common"
+	"github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1"
+)
+
+var testCRD = `
+{
+  "apiVersion": "apiextensions.k8s.io/v1beta1",
+  "kind": "CustomResourceDefinition",
+  "metadata": {
+    "name": "foos.example.com",
+    "labels": {
+      "applications.argoproj.io/app-name": "my-app"
+    }
+  },
+  "spec": {
+    "group": "example.com",
+    "version": "v1",
+    "scope": "Namespaced",
+    "names": {
+      "kind": "Foo",
+      "plural": "foos"
+    }
+  }
+}`
+
+func newTestFoo(name string, appName string) *unstructured.Unstructured {
+	foo := &unstructured.Unstructured{}
+	foo.SetAPIVersion("example.com/v1")
+	foo.SetKind("Foo")
+	foo.SetNamespace("default")
+	foo.SetName(name)
+	if appName != "" {
+		foo.SetLabels(map[string]string{common.LabelApplicationName: appName})
+	}
+	return foo
+}
+
+func TestVerifyCRDDeletion(t *testing.T) {
+	crd, err := v1alpha1.UnmarshalToUnstructured(testCRD)
+	assert.NoError(t, err)
+
+	dynamicIf := fakedynamic.NewSimpleDynamicClient(runtime.NewScheme(), newTestFoo("app-foo", "my-app"))
+	assert.NoError(t, verifyCRDDeletion(dynamicIf, crd, "my-app"))
+
+	dynamicIf = fakedynamic.NewSimpleDynamicClient(runtime.NewScheme(),
+		newTestFoo("app-foo", "my-app"), newTestFoo("other-foo", "other-app"), newTestFoo("unmanaged-foo", ""))
+	err = verifyCRDDeletion(dynamicIf, crd, "my-app")
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "has 2 instance(s)")
+}
+
+func TestDeleteAppCRDs(t *testing.T) {
+	crd, err := v1alpha1.UnmarshalToUnstructured(testCRD)
+	assert.NoError(t, err)
+	app := newFakeApp()
+	dynamicIf := fakedynamic.NewSimpleDynamicClient(runtime.NewScheme(), crd.DeepCopy(), newTestFoo("unmanaged-foo", ""))
+
+	err = deleteAppCRDs(dynamicIf, app, []*unstructured.Unstructured{crd})
+	assert.Error(t, err)
+	_, err = dynamicIf.Resource(crdResource).Get(crd.GetName(), metav1.GetOptions{})
+	assert.NoError(t, err)
+
+	app.Annotations = map[string]string{common.AnnotationConfirmCRDDeletion: "true"}
+	err = deleteAppCRDs(dynamicIf, app, []*unstructured.Unstructured{crd})
+	assert.NoError(t, err)
+	_, err = dynamicIf.Resource(crdResource).Get(crd.GetName(), metav1.GetOptions{})
+	assert.True(t, apierr.IsNotFound(err))
+}
+
+func TestPruneCRDWithInstances(t *testing.T) {
+	crd, err := v1alpha1.UnmarshalToUnstructured(testCRD)
+	assert.NoError(t, err)
+	syncCtx := newTestSyncCtx()
+	syncCtx.appName = "my-app"
+	syncCtx.kubectl = mockKubectlCmd{}
+	syncCtx.dynamicIf = fakedynamic.NewSimpleDynamicClient(runtime.NewScheme(), newTestFoo("unmanaged-foo", ""))
+
+	resDetails := syncCtx.pruneObject(crd, true, true)
+	assert.Equal(t, v1alpha1.ResourceDetailsSyncFailed, resDetails.Status)
+	assert.Contains(t, resDetails.Message, "has 1 instance(s)")
+
+	syncCtx.syncOp.ConfirmCRDDeletion = true
+	resDetails = syncCtx.pruneObject(crd, true, true)
+	assert.Equal(t, v1alpha1.ResourceDetailsSyncedAndPruned, resDetails.Status)
+}
@@ -0,0 +1,266 @@
+package controller
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/argoproj/argo-cd/common"
+	appv1 "github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/reposerver/repository"
+	"github.com/argoproj/argo-cd/util/argo"
+	grpc_util "github.com/argoproj/argo-cd/util/grpc"
+	"github.com/argoproj/argo-cd/util/health"
+)
+
+// destinationSyncConcurrency is the maximum number of destinations of an application which are synced
+// in parallel
+const destinationSyncConcurrency = 10
+
+// destinationApp returns a copy of the application which is deployed to the given destination only.
+// The resource statuses of the application, which are those of its primary destination, are omitted.
+func destinationApp(app *appv1.Application, dest appv1.ApplicationDestination) *appv1.Application {
+	destApp := app.DeepCopy()
+	destApp.Spec.Destination = dest
+	destApp.Spec.AdditionalDestinations = nil
+	destApp.Status.ComparisonResult.Resources = nil
+	return destApp
+}
+
+// resolveDestinations returns a copy of the application, in which the destinations which select
+// clusters by their labels are resolved into a destination in each selected cluster. The application
+// itself is returned if none of its destinations select clusters.
+func (s *appStateManager) resolveDestinations(app *appv1.Application) (*appv1.Application, error) {
+	selects := false
+	for _, dest := range app.Spec.GetDestinations() {
+		selects = selects || dest.SelectsClusters()
+	}
+	if !selects {
+		return app, nil
+	}
+	proj, err := argo.GetAppProject(&app.Spec, s.appclientset, s.namespace)
+	if err != nil {
+		return nil, err
+	}
+	dests, err := argo.ResolveDestinations(context.Background(), s.db, app, proj)
+	if err != nil {
+		return nil, err
+	}
+	resolved := app.DeepCopy()
+	resolved.Spec.Destination = dests[0]
+	resolved.Spec.AdditionalDestinations = dests[1:]
+	return resolved, nil
+}
+
+// refreshAdditionalDestinations compares the application state in its additional destinations
+// concurrently, and returns the status of every destination of the application. The sync status
+// and health of the primary destination are combined with the status of all destinations.
+func (ctrl *ApplicationController) refreshAdditionalDestinations(app *appv1.Application, comparisonResult *appv1.ComparisonResult, healthState *appv1.HealthStatus) []appv1.DestinationStatus {
+	dests := app.Spec.GetDestinations()
+	statuses := make([]appv1.DestinationStatus, len(dests))
+	statuses[0] = appv1.DestinationStatus{Destination: dests[0], Status: comparisonResult.Status}
+	if healthState != nil {
+		statuses[0].Health = *healthState
+	}
+	var wg sync.WaitGroup
+	for i := 1; i < len(dests); i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			statuses[i] = ctrl.refreshDestination(destinationApp(app, dests[i]))
+		}(i)
+	}
+	wg.Wait()
+
+	for _, status := range statuses[1:] {
+		switch {
+		case status.Status == appv1.ComparisonStatusOutOfSync:
+			comparisonResult.Status = appv1.ComparisonStatusOutOfSync
+		case status.Status == appv1.ComparisonStatusUnknown && comparisonResult.Status == appv1.ComparisonStatusSynced:
+			comparisonResult.Status = appv1.ComparisonStatusUnknown
+		}
+		if healthState != nil && health.IsWorse(healthState.Status, status.Health.Status) {
+			healthState.Status = status.Health.Status
+		}
+	}
+	return statuses
+}
+
+// refreshDestination returns the sync status and health of an application with a single destination
+func (ctrl *ApplicationController) refreshDestination(app *appv1.Application) appv1.DestinationStatus {
+	status := appv1.DestinationStatus{
+		Destination: app.Spec.Destination,
+		Status:      appv1.ComparisonStatusUnknown,
+		Health:      appv1.HealthStatus{Status: appv1.HealthStatusUnknown},
+	}
+	comparisonResult, _, resources, conditions, err := ctrl.appStateManager.CompareAppState(app, "", nil)
+	if err != nil {
+		status.Message = err.Error()
+		return status
+	}
+	errConditions := make([]appv1.ApplicationCondition, 0)
+	for i := range conditions {
+		if conditions[i].IsError() {
+			errConditions = append(errConditions, conditions[i])
+		}
+	}
+	if len(errConditions) > 0 {
+		status.Message = argo.FormatAppConditions(errConditions)
+	}
+	status.Status = comparisonResult.Status
+	deadline := newProgressingDeadline(app)
+	healthState, err := setApplicationHealth(ctrl.kubectl, &deadline, comparisonResult, resources)
+	if err != nil {
+		status.Message = err.Error()
+	}
+	if healthState != nil {
+		status.Health = *healthState
+	}
+	return status
+}
+
+// manifestsKey returns the key of the manifests generated for a destination of an application. The
+// manifests depend on the namespace of the destination, and on the API versions of its cluster if
+// deprecated APIs are rewritten.
+func manifestsKey(app *appv1.Application, dest appv1.ApplicationDestination) appv1.ApplicationDestination {
+	if !app.Spec.SyncPolicy.HasSyncOption(common.SyncOptionRewriteDeprecatedAPIs) {
+		dest.Server = ""
+	}
+	return dest
+}
+
+// syncAppDestinations syncs an application with additional destinations to the given destinations
+// concurrently. The phase, message and sync result of every destination are tracked in the
+// destination results of the operation, so that the operation of each destination progresses
+// independently, and completed destinations are not synced again when the operation resumes.
+// The revision of the operation is resolved once, and the manifests are generated once for each
+// destination namespace, so that every destination is synced to the same commit.
+func (s *appStateManager) syncAppDestinations(app *appv1.Application, dests []appv1.ApplicationDestination, state *appv1.OperationState) {
+	results := make([]appv1.DestinationOperationResult, len(dests))
+	for i, dest := range dests {
+		results[i] = appv1.DestinationOperationResult{Destination: dest, Phase: appv1.OperationRunning}
+		for _, res := range state.DestinationResults {
+			if res.Destination == dest {
+				results[i] = res
+				break
+			}
+		}
+	}
+
+	// a resumed operation keeps the revision which was resolved when it started
+	var revision string
+	var overrides []appv1.ComponentParameter
+	if state.Operation.Sync != nil {
+		revision = state.Operation.Sync.Revision
+		overrides = []appv1.ComponentParameter(state.Operation.Sync.ParameterOverrides)
+	}
+	for _, res := range results {
+		if res.SyncResult != nil && res.SyncResult.Revision != "" {
+			revision = res.SyncResult.Revision
+			break
+		}
+	}
+	ctx := grpc_util.ContextWithCorrelationID(context.Background(), state.Operation.CorrelationID)
+	rendered := make(map[appv1.ApplicationDestination]*renderedManifests)
+	for _, res := range results {
+		if res.Phase.Completed() || state.Operation.Sync == nil {
+			continue
+		}
+		key := manifestsKey(app, res.Destination)
+		if rendered[key] != nil {
+			continue
+		}
+		manifestInfo, err := s.generateManifests(ctx, destinationApp(app, res.Destination), revision, overrides)
+		if err == nil {
+			// the other destinations are rendered at the commit the revision resolved to
+			revision = manifestInfo.Revision
+		}
+		rendered[key] = &renderedManifests{manifestInfo: manifestInfo, err: err}
+	}
+
+	manifests := make([]*repository.ManifestResponse, len(dests))
+	limiter := newConcurrencyLimiter(destinationSyncConcurrency)
+	var wg sync.WaitGroup
+	for i := range results {
+		if results[i].Phase.Completed() {
+			continue
+		}
+		if state.Phase == appv1.OperationTerminating {
+			results[i].Phase = appv1.OperationTerminating
+		}
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			limiter.acquire()
+			defer limiter.release()
+			destState := &appv1.OperationState{
+				Operation:  state.Operation,
+				Phase:      results[i].Phase,
+				Message:    results[i].Message,
+				SyncResult: results[i].SyncResult,
+				StartedAt:  state.StartedAt,
+			}
+			manifests[i] = s.syncAppDestination(destinationApp(app, results[i].Destination), destState, rendered[manifestsKey(app, results[i].Destination)])
+			results[i].Phase = destState.Phase
+			results[i].Message = destState.Message
+			results[i].SyncResult = destState.SyncResult
+		}(i)
+	}
+	wg.Wait()
+
+	state.DestinationResults = results
+	// the sync result of the operation reports the revision synced to the primary destination
+	state.SyncResult = results[0].SyncResult
+	state.Phase, state.Message = combineDestinationResults(results)
+	for _, res := range results {
+		if isWaitingForRepoServer(res.Phase, res.Message) && state.Phase == appv1.OperationRunning {
+			state.Message = fmt.Sprintf("%s: %s", waitingForRepoServerMessage, state.Message)
+			break
+		}
+	}
+	// every destination was synced to the same revision, which is recorded once in the history
+	for _, manifestInfo := range manifests {
+		if manifestInfo != nil {
+			s.persistSync(app, state, manifestInfo)
+			break
+		}
+	}
+}
+
+// combineDestinationResults returns the phase and message of an operation from the results of
+// the operation in each destination. The operation completes once it completed in all destinations.
+func combineDestinationResults(results []appv1.DestinationOperationResult) (appv1.OperationPhase, string) {
+	phase := appv1.OperationSucceeded
+	completed := 0
+	var failures []string
+	for _, res := range results {
+		switch res.Phase {
+		case appv1.OperationRunning, appv1.OperationTerminating:
+			if phase != appv1.OperationTerminating {
+				phase = res.Phase
+			}
+			continue
+		case appv1.OperationError:
+			if phase.Completed() {
+				phase = appv1.OperationError
+			}
+		case appv1.OperationFailed:
+			if phase == appv1.OperationSucceeded {
+				phase = appv1.OperationFailed
+			}
+		}
+		completed++
+		if !res.Phase.Successful() {
+			failures = append(failures, fmt.Sprintf("%s/%s: %s", res.Destination.Server, res.Destination.Namespace, res.Message))
+		}
+	}
+	switch {
+	case !phase.Completed():
+		return phase, fmt.Sprintf("completed in %d of %d destinations", completed, len(results))
+	case phase.Successful():
+		return phase, fmt.Sprintf("successfully synced to %d destinations", len(results))
+	default:
+		return phase, fmt.Sprintf("failed in %d of %d destinations: %s", len(failures), len(results), strings.Join(failures, "; "))
+	}
+}
@@ -0,0 +1,73 @@
+package controller
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/argoproj/argo-cd/common"
+	"github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1"
+)
+
+var (
+	destA = v1alpha1.ApplicationDestination{Server: "https://cluster-a", Namespace: "default"}
+	destB = v1alpha1.ApplicationDestination{Server: "https://cluster-b", Namespace: "default"}
+)
+
+func TestDestinationApp(t *testing.T) {
+	app := newFakeApp()
+	app.Spec.AdditionalDestinations = []v1alpha1.ApplicationDestination{destA, destB}
+	assert.Equal(t, 3, len(app.Spec.GetDestinations()))
+
+	destApp := destinationApp(app, destB)
+	assert.Equal(t, destB, destApp.Spec.Destination)
+	assert.False(t, destApp.Spec.HasAdditionalDestinations())
+	// the original application is not modified
+	assert.Equal(t, 2, len(app.Spec.AdditionalDestinations))
+}
+
+func TestManifestsKey(t *testing.T) {
+	app := newFakeApp()
+	// the destinations in the same namespace share their manifests
+	assert.Equal(t, manifestsKey(app, destA), manifestsKey(app, destB))
+	assert.NotEqual(t, manifestsKey(app, destA), manifestsKey(app, v1alpha1.ApplicationDestination{Server: destA.Server, Namespace: "other"}))
+
+	// unless the manifests are rewritten to the API versions of each cluster
+	app.Spec.SyncPolicy = &v1alpha1.SyncPolicy{SyncOptions: []string{common.SyncOptionRewriteDeprecatedAPIs}}
+	assert.NotEqual(t, manifestsKey(app, destA), manifestsKey(app, destB))
+}
+
+func TestCombineDestinationResults(t *testing.T) {
+	phase, message := combineDestinationResults([]v1alpha1.DestinationOperationResult{
+		{Destination: destA, Phase: v1alpha1.OperationSucceeded},
+		{Destination: destB, Phase: v1alpha1.OperationSucceeded},
+	})
+	assert.Equal(t, v1alpha1.OperationSucceeded, phase)
+	assert.Equal(t, "successfully synced to 2 destinations", message)
+
+	phase, message = combineDestinationResults([]v1alpha1.DestinationOperationResult{
+		{Destination: destA, Phase: v1alpha1.OperationFailed, Message: "one or more objects failed to apply"},
+		{Destination: destB, Phase: v1alpha1.OperationRunning},
+	})
+	assert.Equal(t, v1alpha1.OperationRunning, phase)
+	assert.Equal(t, "completed in 1 of 2 destinations", message)
+
+	phase, message = combineDestinationResults([]v1alpha1.DestinationOperationResult{
+		{Destination: destA, Phase: v1alpha1.OperationFailed, Message: "one or more objects failed to apply"},
+		{Destination: destB, Phase: v1alpha1.OperationSucceeded},
+	})
+	assert.Equal(t, v1alpha1.OperationFailed, phase)
+	assert.Equal(t, "failed in 1 of 2 destinations: https://cluster-a/default: one or more objects failed to apply", message)
+
+	phase, _ = combineDestinationResults([]v1alpha1.DestinationOperationResult{
+		{Destination: destA, Phase: v1alpha1.OperationFailed},
+		{Destination: destB, Phase: v1alpha1.OperationError},
+	})
+	assert.Equal(t, v1alpha1.OperationError, phase)
+
+	phase, _ = combineDestinationResults([]v1alpha1.DestinationOperationResult{
+		{Destination: destA, Phase: v1alpha1.OperationTerminating},
+		{Destination: destB, Phase: v1alpha1.OperationRunning},
+	})
+	assert.Equal(t, v1alpha1.OperationTerminating, phase)
+}
@@ -0,0 +1,202 @@
+package controller
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	gocache "github.com/patrickmn/go-cache"
+	log "github.com/sirupsen/logrus"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	cache_util "github.com/argoproj/argo-cd/util/cache"
+	"github.com/argoproj/argo-cd/util/diff"
+)
+
+const (
+	// diffCacheExpiration is how long the diff of a resource is kept after it was last used
+	diffCacheExpiration = time.Hour
+	// diffStoreExpiration is how long the persisted diff of a resource is kept after it was computed
+	diffStoreExpiration = 24 * time.Hour
+	// diffParallelism is the number of resources of an application which are diffed concurrently
+	diffParallelism = 4
+	// serverSideDiffKeyPrefix prefixes the keys of the server-side diffs, which are cached apart from
+	// the diffs against the target state
+	serverSideDiffKeyPrefix = "server-side|"
+)
+
+// diffCache caches the diffs of the live resources against their target state. The diff of a resource
+// is reused as long as the version of the live resource, the target manifest and the normalizer are
+// unchanged, so that comparisons only diff the resources which changed since the previous comparison.
+// Whether the resources are modified is also persisted in the store, unless it is nil, so that a
+// restarted controller does not diff every resource again. The diffs restored from the store only tell
+// whether the resources are modified, which is all the comparisons need.
+type diffCache struct {
+	cache *gocache.Cache
+	store cache_util.Cache
+}
+
+// diffCacheEntry is the diff of a live resource, and what it was computed from
+type diffCacheEntry struct {
+	resourceVersion string
+	targetHash      string
+	normalizerKey   string
+	result          diff.DiffResult
+}
+
+// persistedDiff is the persisted diff of a live resource. Only whether the resource is modified is
+// persisted, which is all comparisons need.
+type persistedDiff struct {
+	ResourceVersion string
+	TargetHash      string
+	NormalizerKey   string
+	Modified        bool
+}
+
+func newDiffCache(store cache_util.Cache) *diffCache {
+	return &diffCache{cache: gocache.New(diffCacheExpiration, 10*time.Minute), store: store}
+}
+
+// diffArray diffs the target and live objects like diff.DiffArray, and reuses the cached diffs of the
+// live objects whose version and target are unchanged. The other objects are diffed concurrently. The
+// normalizer key identifies the configuration of the normalizer. The results may only tell whether the
+// objects are modified. Diffs are not cached if the diff cache is nil.
+func (c *diffCache) diffArray(targetObjs, liveObjs []*unstructured.Unstructured, normalizer diff.Normalizer, normalizerKey string) (*diff.DiffResultList, error) {
+	opts := diff.DiffArrayOpts{Parallelism: diffParallelism}
+	if c == nil {
+		return diff.DiffArrayWithOpts(targetObjs, liveObjs, normalizer, opts)
+	}
+	if len(targetObjs) != len(liveObjs) {
+		return nil, fmt.Errorf("left and right arrays have mismatched lengths")
+	}
+	diffResults := diff.DiffResultList{Diffs: make([]diff.DiffResult, len(targetObjs))}
+	var outdated []int
+	var outdatedEntries []*diffCacheEntry
+	var outdatedTargetObjs, outdatedLiveObjs []*unstructured.Unstructured
+	for i := range targetObjs {
+		result, entry, ok := c.get("", targetObjs[i], liveObjs[i], normalizerKey)
+		if ok {
+			diffResults.Diffs[i] = result
+			continue
+		}
+		outdated = append(outdated, i)
+		outdatedEntries = append(outdatedEntries, entry)
+		outdatedTargetObjs = append(outdatedTargetObjs, targetObjs[i])
+		outdatedLiveObjs = append(outdatedLiveObjs, liveObjs[i])
+	}
+	if len(outdated) > 0 {
+		outdatedResults, err := diff.DiffArrayWithOpts(outdatedTargetObjs, outdatedLiveObjs, normalizer, opts)
+		if err != nil {
+			return nil, err
+		}
+		for j, i := range outdated {
+			diffResults.Diffs[i] = outdatedResults.Diffs[j]
+			if entry := outdatedEntries[j]; entry != nil {
+				entry.result = outdatedResults.Diffs[j]
+				c.set(string(liveObjs[i].GetUID()), *entry)
+			}
+		}
+	}
+	for _, result := range diffResults.Diffs {
+		if result.Modified {
+			diffResults.Modified = true
+		}
+	}
+	return &diffResults, nil
+}
+
+// serverSideDiff returns the cached server-side diff of the live object, if it is up to date, and
+// otherwise computes it with compute and caches it, so that the dry-run is skipped as long as the live
+// resource and its target are unchanged. Failed dry-runs are not cached.
+func (c *diffCache) serverSideDiff(targetObj, liveObj *unstructured.Unstructured, normalizerKey string, compute func() (*diff.DiffResult, error)) (*diff.DiffResult, error) {
+	if c == nil {
+		return compute()
+	}
+	result, entry, ok := c.get(serverSideDiffKeyPrefix, targetObj, liveObj, normalizerKey)
+	if ok {
+		return &result, nil
+	}
+	computed, err := compute()
+	if err != nil {
+		return nil, err
+	}
+	if entry != nil {
+		entry.result = *computed
+		c.set(serverSideDiffKeyPrefix+string(liveObj.GetUID()), *entry)
+	}
+	return computed, nil
+}
+
+// get returns the cached diff of the live object under the key prefix, if it is up to date. Otherwise,
+// it returns the entry which caches the diff once it is computed, or nil if the diff cannot be cached.
+func (c *diffCache) get(prefix string, targetObj, liveObj *unstructured.Unstructured, normalizerKey string) (diff.DiffResult, *diffCacheEntry, bool) {
+	// missing objects are cheap to diff
+	if targetObj == nil || liveObj == nil || liveObj.GetUID() == "" || liveObj.GetResourceVersion() == "" {
+		return diff.DiffResult{}, nil, false
+	}
+	targetBytes, err := json.Marshal(targetObj.Object)
+	if err != nil {
+		return diff.DiffResult{}, nil, false
+	}
+	targetHash := sha256.Sum256(targetBytes)
+	entry := diffCacheEntry{
+		resourceVersion: liveObj.GetResourceVersion(),
+		targetHash:      hex.EncodeToString(targetHash[:]),
+		normalizerKey:   normalizerKey,
+	}
+	key := prefix + string(liveObj.GetUID())
+	if cached, ok := c.cache.Get(key); ok {
+		cachedEntry := cached.(diffCacheEntry)
+		if cachedEntry.resourceVersion == entry.resourceVersion && cachedEntry.targetHash == entry.targetHash && cachedEntry.normalizerKey == entry.normalizerKey {
+			// keeps the diff for another expiration period
+			c.cache.Set(key, cachedEntry, gocache.DefaultExpiration)
+			return cachedEntry.result, nil, true
+		}
+	}
+	if c.store != nil {
+		var persisted persistedDiff
+		err := c.store.Get(diffCacheKey(key), &persisted)
+		if err == nil && persisted.ResourceVersion == entry.resourceVersion && persisted.TargetHash == entry.targetHash && persisted.NormalizerKey == entry.normalizerKey {
+			entry.result = diff.DiffResult{Modified: persisted.Modified}
+			c.cache.Set(key, entry, gocache.DefaultExpiration)
+			return entry.result, nil, true
+		}
+	}
+	return diff.DiffResult{}, &entry, false
+}
+
+// invalidate drops the cached diffs. The persisted diffs are kept, since they are only reused with the
+// normalizer they were computed with.
+func (c *diffCache) invalidate() {
+	if c != nil {
+		c.cache.Flush()
+	}
+}
+
+// set caches the diff of the live resource under the key, and persists it in the store
+func (c *diffCache) set(key string, entry diffCacheEntry) {
+	c.cache.Set(key, entry, gocache.DefaultExpiration)
+	if c.store == nil {
+		return
+	}
+	err := c.store.Set(&cache_util.Item{
+		Key: diffCacheKey(key),
+		Object: persistedDiff{
+			ResourceVersion: entry.resourceVersion,
+			TargetHash:      entry.targetHash,
+			NormalizerKey:   entry.normalizerKey,
+			Modified:        entry.result.Modified,
+		},
+		Expiration: diffStoreExpiration,
+	})
+	if err != nil {
+		log.Warnf("Failed to persist the diff of resource %s: %v", key, err)
+	}
+}
+
+// diffCacheKey returns the key of the persisted diff of the live resource with the given UID
+func diffCacheKey(uid string) string {
+	return fmt.Sprintf("diff|%s", uid)
+}
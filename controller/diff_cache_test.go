@@ -0,0 +1,72 @@
+package controller
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+
+	cache_util "github.com/argoproj/argo-cd/util/cache"
+)
+
+func TestDiffCache(t *testing.T) {
+	c := newDiffCache(nil)
+	targetPod := newPod()
+	livePod := newPod()
+	livePod.SetUID(types.UID("a4ddb2c5-3d9f-4b1c-8f2a-1d6a3f1c1b6e"))
+	livePod.SetResourceVersion("1")
+	setImage := func(pod *unstructured.Unstructured, image string) {
+		containers, _, _ := unstructured.NestedSlice(pod.Object, "spec", "containers")
+		containers[0].(map[string]interface{})["image"] = image
+		assert.NoError(t, unstructured.SetNestedSlice(pod.Object, containers, "spec", "containers"))
+	}
+	diffArray := func(targetObj, liveObj *unstructured.Unstructured, normalizerKey string) bool {
+		diffResults, err := c.diffArray([]*unstructured.Unstructured{targetObj}, []*unstructured.Unstructured{liveObj}, nil, normalizerKey)
+		assert.NoError(t, err)
+		return diffResults.Modified
+	}
+	assert.False(t, diffArray(targetPod, livePod, ""))
+
+	// the diff is reused while the version of the live pod is unchanged
+	setImage(livePod, "nginx:1.9.0")
+	assert.False(t, diffArray(targetPod, livePod, ""))
+
+	livePod.SetResourceVersion("2")
+	assert.True(t, diffArray(targetPod, livePod, ""))
+
+	// the diff is recomputed once the target or the normalizer change
+	setImage(targetPod, "nginx:1.9.0")
+	assert.False(t, diffArray(targetPod, livePod, ""))
+	setImage(livePod, "nginx:1.7.9")
+	assert.False(t, diffArray(targetPod, livePod, ""))
+	assert.True(t, diffArray(targetPod, livePod, "ignore-images"))
+
+	// missing live resources are diffed without the cache
+	assert.True(t, diffArray(targetPod, nil, ""))
+}
+
+func TestDiffCachePersisted(t *testing.T) {
+	store := cache_util.NewInMemoryCache(time.Hour)
+	targetPod := newPod()
+	livePod := newPod()
+	livePod.SetUID(types.UID("a4ddb2c5-3d9f-4b1c-8f2a-1d6a3f1c1b6e"))
+	livePod.SetResourceVersion("1")
+	diffArray := func(c *diffCache) bool {
+		diffResults, err := c.diffArray([]*unstructured.Unstructured{targetPod}, []*unstructured.Unstructured{livePod}, nil, "")
+		assert.NoError(t, err)
+		return diffResults.Modified
+	}
+	assert.False(t, diffArray(newDiffCache(store)))
+
+	// a restarted controller reuses the persisted diff while the version of the live pod is unchanged
+	containers, _, _ := unstructured.NestedSlice(livePod.Object, "spec", "containers")
+	containers[0].(map[string]interface{})["image"] = "nginx:1.9.0"
+	assert.NoError(t, unstructured.SetNestedSlice(livePod.Object, containers, "spec", "containers"))
+	assert.False(t, diffArray(newDiffCache(store)))
+	assert.True(t, diffArray(newDiffCache(nil)))
+
+	livePod.SetResourceVersion("2")
+	assert.True(t, diffArray(newDiffCache(store)))
+}
@@ -0,0 +1,83 @@
+package controller
+
+import (
+	"fmt"
+
+	"k8s.io/api/core/v1"
+
+	appv1 "github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/util/argo"
+)
+
+// operationEvent is an event of the application about the progress of its operation
+type operationEvent struct {
+	info    argo.EventInfo
+	message string
+}
+
+// operationEvents returns the events of the resources which failed to sync or were pruned, and of the
+// hooks which failed, since the previous state of the operation. The previous state is ignored if it
+// is the state of another operation, i.e. started at another time. Dry-runs have no events.
+func operationEvents(prev *appv1.OperationState, state *appv1.OperationState) []operationEvent {
+	if state.SyncResult == nil || (state.Operation.Sync != nil && state.Operation.Sync.DryRun) {
+		return nil
+	}
+	var prevResult *appv1.SyncOperationResult
+	// start times are persisted with a precision of a second
+	if prev != nil && prev.StartedAt.Unix() == state.StartedAt.Unix() {
+		prevResult = prev.SyncResult
+	}
+	reported := make(map[string]bool)
+	if prevResult != nil {
+		for _, res := range append(prevResult.Resources, prevResult.MovedResources...) {
+			reported[resourceEventKey(res.Kind, res.Namespace, res.Name, string(res.Status), res.Message)] = true
+		}
+		for _, hook := range prevResult.Hooks {
+			reported[resourceEventKey(hook.Kind, hook.Namespace, hook.Name, string(hook.Status), hook.Message)] = true
+		}
+	}
+
+	var events []operationEvent
+	for _, res := range append(state.SyncResult.Resources, state.SyncResult.MovedResources...) {
+		if reported[resourceEventKey(res.Kind, res.Namespace, res.Name, string(res.Status), res.Message)] {
+			continue
+		}
+		switch res.Status {
+		case appv1.ResourceDetailsSyncFailed:
+			events = append(events, operationEvent{
+				info:    argo.EventInfo{Reason: argo.EventReasonResourceSyncFailed, Type: v1.EventTypeWarning},
+				message: fmt.Sprintf("Failed to sync %s %s: %s", res.Kind, resourceEventName(res.Namespace, res.Name), res.Message),
+			})
+		case appv1.ResourceDetailsSyncedAndPruned:
+			events = append(events, operationEvent{
+				info:    argo.EventInfo{Reason: argo.EventReasonResourcePruned, Type: v1.EventTypeNormal},
+				message: fmt.Sprintf("Pruned %s %s: %s", res.Kind, resourceEventName(res.Namespace, res.Name), res.Message),
+			})
+		}
+	}
+	for _, hook := range state.SyncResult.Hooks {
+		if hook.Status != appv1.OperationFailed && hook.Status != appv1.OperationError {
+			continue
+		}
+		if reported[resourceEventKey(hook.Kind, hook.Namespace, hook.Name, string(hook.Status), hook.Message)] {
+			continue
+		}
+		events = append(events, operationEvent{
+			info:    argo.EventInfo{Reason: argo.EventReasonHookFailed, Type: v1.EventTypeWarning},
+			message: fmt.Sprintf("%s hook %s %s failed: %s", hook.Type, hook.Kind, resourceEventName(hook.Namespace, hook.Name), hook.Message),
+		})
+	}
+	return events
+}
+
+func resourceEventKey(kind, namespace, name, status, message string) string {
+	return fmt.Sprintf("%s/%s/%s/%s/%s", kind, namespace, name, status, message)
+}
+
+// resourceEventName returns the namespace qualified name of a resource, or its name if it is cluster-scoped
+func resourceEventName(namespace, name string) string {
+	if namespace == "" {
+		return name
+	}
+	return namespace + "/" + name
+}
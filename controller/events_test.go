@@ -0,0 +1,44 @@
+package controller
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	appv1 "github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/util/argo"
+)
+
+func TestOperationEvents(t *testing.T) {
+	failed := &appv1.ResourceDetails{Kind: "Deployment", Namespace: "default", Name: "guestbook-ui", Status: appv1.ResourceDetailsSyncFailed, Message: "invalid spec"}
+	pruned := &appv1.ResourceDetails{Kind: "ClusterRole", Name: "guestbook", Status: appv1.ResourceDetailsSyncedAndPruned, Message: "pruned"}
+	synced := &appv1.ResourceDetails{Kind: "Service", Namespace: "default", Name: "guestbook-ui", Status: appv1.ResourceDetailsSynced}
+	hook := &appv1.HookStatus{Kind: "Job", Namespace: "default", Name: "migrate", Type: appv1.HookTypePreSync, Status: appv1.OperationFailed, Message: "backoff limit exceeded"}
+	state := &appv1.OperationState{
+		Operation:  appv1.Operation{Sync: &appv1.SyncOperation{}},
+		SyncResult: &appv1.SyncOperationResult{Resources: []*appv1.ResourceDetails{failed, pruned, synced}, Hooks: []*appv1.HookStatus{hook}},
+	}
+
+	events := operationEvents(nil, state)
+	if assert.Len(t, events, 3) {
+		assert.Equal(t, argo.EventReasonResourceSyncFailed, events[0].info.Reason)
+		assert.Equal(t, "Failed to sync Deployment default/guestbook-ui: invalid spec", events[0].message)
+		assert.Equal(t, argo.EventReasonResourcePruned, events[1].info.Reason)
+		assert.Equal(t, "Pruned ClusterRole guestbook: pruned", events[1].message)
+		assert.Equal(t, argo.EventReasonHookFailed, events[2].info.Reason)
+		assert.Equal(t, "PreSync hook Job default/migrate failed: backoff limit exceeded", events[2].message)
+	}
+
+	// results which were already reported have no events
+	assert.Empty(t, operationEvents(state.DeepCopy(), state))
+
+	// results of the previous operation are reported again
+	prev := state.DeepCopy()
+	state.StartedAt = metav1.NewTime(prev.StartedAt.Add(time.Minute))
+	assert.Len(t, operationEvents(prev, state), 3)
+
+	state.Operation.Sync.DryRun = true
+	assert.Empty(t, operationEvents(nil, state))
+}
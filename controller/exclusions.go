@@ -0,0 +1,89 @@
+package controller
+
+import (
+	"fmt"
+	"strings"
+
+	log "github.com/sirupsen/logrus"
+	apierr "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	appv1 "github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1"
+	settings_util "github.com/argoproj/argo-cd/util/settings"
+)
+
+// resourceFilter returns whether the resources of a group and kind are excluded. A nil filter excludes
+// nothing.
+type resourceFilter func(group, kind string) bool
+
+// excludes returns whether the resources of the kind are excluded by the filter
+func (f resourceFilter) excludes(gvk schema.GroupVersionKind) bool {
+	return f != nil && f(gvk.Group, gvk.Kind)
+}
+
+// resourceExclusions returns the filter of the resources of the cluster which are excluded by the
+// cached settings. Nothing is excluded if the settings do not exist.
+func resourceExclusions(settingsMgr *settings_util.SettingsManager, settingsCache *settingsCache, server string) (resourceFilter, error) {
+	if settingsMgr == nil {
+		return nil, nil
+	}
+	settings, err := settingsCache.get(settingsMgr)
+	if settings == nil {
+		if apierr.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	// the exclusions are set in argocd-cm, so errors reading argocd-secret do not matter
+	if len(settings.ResourceExclusions) == 0 {
+		return nil, nil
+	}
+	server = appv1.NormalizeServer(server)
+	return func(group, kind string) bool {
+		return settings.IsExcludedResource(group, kind, server)
+	}, nil
+}
+
+// excludedResourceFilter returns the filter of the kinds of the cluster which are not watched. The
+// cached settings are read at each discovery of the kinds of the cluster, and nothing is excluded if
+// they cannot be read.
+func excludedResourceFilter(settingsMgr *settings_util.SettingsManager, settingsCache *settingsCache) func(server string, gvk schema.GroupVersionKind) bool {
+	return func(server string, gvk schema.GroupVersionKind) bool {
+		filter, err := resourceExclusions(settingsMgr, settingsCache, server)
+		if err != nil {
+			log.Warnf("Failed to read the resource exclusions of cluster %s: %v", server, err)
+			return false
+		}
+		return filter.excludes(gvk)
+	}
+}
+
+// filterExcludedObjs returns the objects which are not excluded by the filter, and a warning condition
+// listing the excluded objects, if there are any
+func filterExcludedObjs(objs []*unstructured.Unstructured, filter resourceFilter) ([]*unstructured.Unstructured, *appv1.ApplicationCondition) {
+	if filter == nil {
+		return objs, nil
+	}
+	kept := make([]*unstructured.Unstructured, 0, len(objs))
+	var excluded []string
+	for _, obj := range objs {
+		gvk := obj.GroupVersionKind()
+		if !filter.excludes(gvk) {
+			kept = append(kept, obj)
+			continue
+		}
+		name := obj.GetName()
+		if obj.GetNamespace() != "" {
+			name = obj.GetNamespace() + "/" + name
+		}
+		excluded = append(excluded, fmt.Sprintf("%s %s", gvk.GroupKind(), name))
+	}
+	if len(excluded) == 0 {
+		return kept, nil
+	}
+	return kept, &appv1.ApplicationCondition{
+		Type:    appv1.ApplicationConditionExcludedResourceWarning,
+		Message: fmt.Sprintf("Resources excluded by the settings are neither compared nor synced: %s", strings.Join(excluded, ", ")),
+	}
+}
@@ -0,0 +1,85 @@
+package controller
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/kubernetes/fake"
+
+	appv1 "github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/util/settings"
+)
+
+func TestIsExcludedResource(t *testing.T) {
+	s := settings.ArgoCDSettings{ResourceExclusions: []settings.FilteredResource{
+		{APIGroups: []string{"events.k8s.io", "metrics.k8s.io"}},
+		{APIGroups: []string{"velero.io"}, Kinds: []string{"Backup"}, Clusters: []string{"https://*.example.com"}},
+	}}
+	assert.True(t, s.IsExcludedResource("events.k8s.io", "Event", "https://kubernetes.default.svc"))
+	assert.True(t, s.IsExcludedResource("velero.io", "Backup", "https://prod.example.com"))
+	assert.False(t, s.IsExcludedResource("velero.io", "Backup", "https://kubernetes.default.svc"))
+	assert.False(t, s.IsExcludedResource("velero.io", "Restore", "https://prod.example.com"))
+	assert.False(t, s.IsExcludedResource("apps", "Deployment", "https://prod.example.com"))
+}
+
+func TestFilterExcludedObjs(t *testing.T) {
+	backupGVK := schema.GroupVersionKind{Group: "velero.io", Version: "v1", Kind: "Backup"}
+	objs := []*unstructured.Unstructured{
+		newCachedObj(deploymentGVK, "guestbook-ui", ""),
+		newCachedObj(backupGVK, "daily", ""),
+	}
+	kept, cond := filterExcludedObjs(objs, nil)
+	assert.Len(t, kept, 2)
+	assert.Nil(t, cond)
+
+	kept, cond = filterExcludedObjs(objs, func(group, kind string) bool {
+		return group == "velero.io"
+	})
+	if assert.Len(t, kept, 1) {
+		assert.Equal(t, "guestbook-ui", kept[0].GetName())
+	}
+	if assert.NotNil(t, cond) {
+		assert.Equal(t, appv1.ApplicationConditionExcludedResourceWarning, cond.Type)
+		assert.Contains(t, cond.Message, "Backup.velero.io default/daily")
+	}
+}
+
+func TestClusterCacheExcludedKinds(t *testing.T) {
+	c := newFakeClusterCache(newCachedObj(configMapGVK, "my-app-config", "my-app"))
+	c.isExcluded = func(server string, gvk schema.GroupVersionKind) bool {
+		return gvk == configMapGVK
+	}
+	cluster, err := c.getClusterResources(&appv1.Cluster{Server: "https://localhost:6443"})
+	assert.NoError(t, err)
+	defer cluster.stop()
+
+	informers, err = c.watch(cluster, []schema.GroupVersionKind{configMapGVK})
+	assert.NoError(t, err)
+	assert.Len(t, informers, 1)
+	_, ok := cluster.informers[configMapGVK]
+	assert.False(t, ok)
+}
+
+func TestUpdateSettingsRediscoversExcludedKinds(t *testing.T) {
+	c := newFakeClusterCache(newCachedObj(configMapGVK, "my-app-config", "my-app"))
+	mgr := &appStateManager{clusterCache: c}
+	c.isExcluded = excludedResourceFilter(settings.NewSettingsManager(fake.NewSimpleClientset(), "argocd"), &mgr.settings)
+	mgr.UpdateSettings(&settings.ArgoCDSettings{ResourceExclusions: []settings.FilteredResource{{APIGroups: []string{""}, Kinds: []string{"ConfigMap"}}}})
+	cluster, err := c.getClusterResources(&appv1.Cluster{Server: "https://localhost:6443"})
+	assert.NoError(t, err)
+	defer cluster.stop()
+
+	_, err = c.watch(cluster, nil)
+	assert.NoError(t, err)
+	_, ok := cluster.informers[configMapGVK]
+	assert.False(t, ok)
+
+	// the kinds are discovered again once the exclusions change
+	mgr.UpdateSettings(&settings.ArgoCDSettings{})
+	_, err = c.watch(cluster, nil)
+	assert.NoError(t, err)
+	_, ok = cluster.informers[configMapGVK]
+	assert.True(t, ok)
+}
@@ -0,0 +1,221 @@
+package controller
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+	"net"
+	"net/url"
+	"sync"
+	"time"
+
+	jwtgo "github.com/dgrijalva/jwt-go"
+	log "github.com/sirupsen/logrus"
+
+	appv1 "github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1"
+	jwtutil "github.com/argoproj/argo-cd/util/jwt"
+)
+
+const (
+	// credentialsExpiryWarningPeriod is how long before the expiry of credentials the applications
+	// using them get a warning condition
+	credentialsExpiryWarningPeriod = 7 * 24 * time.Hour
+	// serverCertificateExpiryTTL is how long the expiry of the TLS certificate of an API server is
+	// cached, to avoid a TLS handshake on every refresh
+	serverCertificateExpiryTTL = time.Hour
+	// serverCertificateDialTimeout is the timeout of the TLS handshake with an API server
+	serverCertificateDialTimeout = 10 * time.Second
+)
+
+// Kinds of credentials whose expiry is checked, as reported in the kind label of the metrics
+const (
+	credentialsKindClusterToken             = "cluster-token"
+	credentialsKindClusterClientCertificate = "cluster-client-certificate"
+	credentialsKindClusterServerCertificate = "cluster-server-certificate"
+	credentialsKindRepositoryToken          = "repository-token"
+)
+
+// credentialsExpiry is the expiry of credentials used by an application
+type credentialsExpiry struct {
+	kind      string
+	name      string
+	expiresAt time.Time
+}
+
+// cachedCertificateExpiry is the expiry of a server certificate, as of the time it was checked
+type cachedCertificateExpiry struct {
+	expiresAt time.Time
+	checkedAt time.Time
+}
+
+// credentialsExpiryChecker detects the credentials of clusters and repositories which expire soon:
+// JWT bearer tokens and passwords, client certificates, and the TLS certificates served by API servers
+type credentialsExpiryChecker struct {
+	metrics *controllerMetrics
+	now     func() time.Time
+	// serverCertificateExpiry returns the expiry of the TLS certificate served at the address
+	serverCertificateExpiry func(address string, serverName string) (time.Time, error)
+
+	lock        sync.Mutex
+	serverCerts map[string]cachedCertificateExpiry
+}
+
+func newCredentialsExpiryChecker(metrics *controllerMetrics) *credentialsExpiryChecker {
+	return &credentialsExpiryChecker{
+		metrics:                 metrics,
+		now:                     time.Now,
+		serverCertificateExpiry: dialServerCertificateExpiry,
+		serverCerts:             make(map[string]cachedCertificateExpiry),
+	}
+}
+
+// conditions returns a warning condition for every credential of the cluster and the repository
+// which expires within the warning period, or has already expired. The repository may be nil.
+func (c *credentialsExpiryChecker) conditions(cluster *appv1.Cluster, repo *appv1.Repository) []appv1.ApplicationCondition {
+	now := c.now()
+	var conditions []appv1.ApplicationCondition
+	for _, expiry := range c.expiries(cluster, repo) {
+		c.metrics.credentialsExpiry.WithLabelValues(expiry.kind, expiry.name).Set(float64(expiry.expiresAt.Unix()))
+		if expiry.expiresAt.Sub(now) > credentialsExpiryWarningPeriod {
+			continue
+		}
+		var message string
+		if expiry.expiresAt.After(now) {
+			message = fmt.Sprintf("The %s %s expires in %s (%s)", credentialsDescription(expiry.kind), expiry.name, expiry.expiresAt.Sub(now).Round(time.Minute), expiry.expiresAt.UTC().Format(time.RFC3339))
+		} else {
+			message = fmt.Sprintf("The %s %s expired at %s", credentialsDescription(expiry.kind), expiry.name, expiry.expiresAt.UTC().Format(time.RFC3339))
+		}
+		conditions = append(conditions, appv1.ApplicationCondition{
+			Type:    appv1.ApplicationConditionCredentialsExpiryWarning,
+			Message: message,
+		})
+	}
+	return conditions
+}
+
+// expiries returns the expiry of the credentials of the cluster and the repository which expire
+func (c *credentialsExpiryChecker) expiries(cluster *appv1.Cluster, repo *appv1.Repository) []credentialsExpiry {
+	var expiries []credentialsExpiry
+	if cluster != nil {
+		if expiresAt, ok := tokenExpiry(cluster.Config.BearerToken); ok {
+			expiries = append(expiries, credentialsExpiry{kind: credentialsKindClusterToken, name: cluster.Server, expiresAt: expiresAt})
+		}
+		if expiresAt, ok := certificateExpiry(cluster.Config.CertData); ok {
+			expiries = append(expiries, credentialsExpiry{kind: credentialsKindClusterClientCertificate, name: cluster.Server, expiresAt: expiresAt})
+		}
+		if expiresAt, ok := c.cachedServerCertificateExpiry(cluster); ok {
+			expiries = append(expiries, credentialsExpiry{kind: credentialsKindClusterServerCertificate, name: cluster.Server, expiresAt: expiresAt})
+		}
+	}
+	if repo != nil {
+		if expiresAt, ok := tokenExpiry(repo.Password); ok {
+			expiries = append(expiries, credentialsExpiry{kind: credentialsKindRepositoryToken, name: repo.Repo, expiresAt: expiresAt})
+		}
+	}
+	return expiries
+}
+
+// cachedServerCertificateExpiry returns the expiry of the TLS certificate served by the API server of
+// the cluster. Failures to check the certificate are logged and retried after the cache TTL.
+func (c *credentialsExpiryChecker) cachedServerCertificateExpiry(cluster *appv1.Cluster) (time.Time, bool) {
+	serverURL, err := url.Parse(cluster.Server)
+	if err != nil || serverURL.Scheme != "https" {
+		return time.Time{}, false
+	}
+	address := serverURL.Host
+	if serverURL.Port() == "" {
+		address = net.JoinHostPort(serverURL.Hostname(), "443")
+	}
+	now := c.now()
+	c.lock.Lock()
+	cached, ok := c.serverCerts[cluster.Server]
+	c.lock.Unlock()
+	if ok && now.Sub(cached.checkedAt) <= serverCertificateExpiryTTL {
+		return cached.expiresAt, !cached.expiresAt.IsZero()
+	}
+	serverName := cluster.Config.ServerName
+	if serverName == "" {
+		serverName = serverURL.Hostname()
+	}
+	expiresAt, err := c.serverCertificateExpiry(address, serverName)
+	if err != nil {
+		log.Warnf("Failed to check the TLS certificate of %s: %v", cluster.Server, err)
+	}
+	c.lock.Lock()
+	c.serverCerts[cluster.Server] = cachedCertificateExpiry{expiresAt: expiresAt, checkedAt: now}
+	c.lock.Unlock()
+	return expiresAt, !expiresAt.IsZero()
+}
+
+// dialServerCertificateExpiry returns the expiry of the TLS certificate served at the address. The
+// certificate is not verified, since only its expiry is inspected and no credentials are sent.
+func dialServerCertificateExpiry(address string, serverName string) (time.Time, error) {
+	conn, err := tls.DialWithDialer(&net.Dialer{Timeout: serverCertificateDialTimeout}, "tcp", address, &tls.Config{
+		ServerName:         serverName,
+		InsecureSkipVerify: true,
+	})
+	if err != nil {
+		return time.Time{}, err
+	}
+	defer func() { _ = conn.Close() }()
+	certs := conn.ConnectionState().PeerCertificates
+	if len(certs) == 0 {
+		return time.Time{}, fmt.Errorf("no certificate served at %s", address)
+	}
+	return certs[0].NotAfter, nil
+}
+
+// tokenExpiry returns the expiry of a JWT token. Tokens which are not JWTs or have no expiry, such
+// as legacy service account tokens, do not expire.
+func tokenExpiry(token string) (time.Time, bool) {
+	if token == "" {
+		return time.Time{}, false
+	}
+	var claims jwtgo.MapClaims
+	parser := &jwtgo.Parser{}
+	if _, _, err := parser.ParseUnverified(token, &claims); err != nil {
+		return time.Time{}, false
+	}
+	if _, ok := claims["exp"]; !ok {
+		return time.Time{}, false
+	}
+	exp, err := jwtutil.GetExpiresAt(claims)
+	if err != nil {
+		return time.Time{}, false
+	}
+	return time.Unix(exp, 0), true
+}
+
+// certificateExpiry returns the earliest expiry of the PEM-encoded certificates
+func certificateExpiry(data []byte) (time.Time, bool) {
+	var expiresAt time.Time
+	for block, rest := pem.Decode(data); block != nil; block, rest = pem.Decode(rest) {
+		if block.Type != "CERTIFICATE" {
+			continue
+		}
+		cert, err := x509.ParseCertificate(block.Bytes)
+		if err != nil {
+			continue
+		}
+		if expiresAt.IsZero() || cert.NotAfter.Before(expiresAt) {
+			expiresAt = cert.NotAfter
+		}
+	}
+	return expiresAt, !expiresAt.IsZero()
+}
+
+// credentialsDescription returns a human readable description of a kind of credentials
+func credentialsDescription(kind string) string {
+	switch kind {
+	case credentialsKindClusterToken:
+		return "bearer token of cluster"
+	case credentialsKindClusterClientCertificate:
+		return "client certificate of cluster"
+	case credentialsKindClusterServerCertificate:
+		return "TLS certificate of cluster"
+	case credentialsKindRepositoryToken:
+		return "password token of repository"
+	}
+	return kind
+}
@@ -0,0 +1,104 @@
+package controller
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	jwtgo "github.com/dgrijalva/jwt-go"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+
+	appv1 "github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1"
+	tlsutil "github.com/argoproj/argo-cd/util/tls"
+)
+
+func newTestToken(t *testing.T, claims jwtgo.MapClaims) string {
+	token, err := jwtgo.NewWithClaims(jwtgo.SigningMethodHS256, claims).SignedString([]byte("secret"))
+	assert.NoError(t, err)
+	return token
+}
+
+func newTestCertificate(t *testing.T, validFor time.Duration) []byte {
+	cert, err := tlsutil.GenerateX509KeyPair(tlsutil.CertOptions{
+		Hosts:        []string{"localhost"},
+		Organization: "Argo CD",
+		ValidFor:     validFor,
+	})
+	assert.NoError(t, err)
+	certPEM, _ := tlsutil.EncodeX509KeyPair(*cert)
+	return certPEM
+}
+
+func TestTokenExpiry(t *testing.T) {
+	expiresAt := time.Now().Add(time.Hour).Truncate(time.Second)
+	exp, ok := tokenExpiry(newTestToken(t, jwtgo.MapClaims{"sub": "argocd-manager", "exp": expiresAt.Unix()}))
+	assert.True(t, ok)
+	assert.Equal(t, expiresAt.Unix(), exp.Unix())
+
+	_, ok = tokenExpiry(newTestToken(t, jwtgo.MapClaims{"sub": "argocd-manager"}))
+	assert.False(t, ok)
+	_, ok = tokenExpiry("not-a-jwt")
+	assert.False(t, ok)
+	_, ok = tokenExpiry("")
+	assert.False(t, ok)
+}
+
+func TestCertificateExpiry(t *testing.T) {
+	certPEM := newTestCertificate(t, 48*time.Hour)
+	exp, ok := certificateExpiry(certPEM)
+	assert.True(t, ok)
+	assert.WithinDuration(t, time.Now().Add(48*time.Hour), exp, time.Minute)
+
+	_, ok = certificateExpiry(nil)
+	assert.False(t, ok)
+}
+
+func TestCredentialsExpiryConditions(t *testing.T) {
+	now := time.Now()
+	dials := 0
+	checker := newCredentialsExpiryChecker(newControllerMetrics())
+	checker.now = func() time.Time { return now }
+	checker.serverCertificateExpiry = func(address string, serverName string) (time.Time, error) {
+		dials++
+		assert.Equal(t, "cluster.example.com:443", address)
+		return now.Add(365 * 24 * time.Hour), nil
+	}
+	cluster := &appv1.Cluster{
+		Server: "https://cluster.example.com",
+		Config: appv1.ClusterConfig{
+			BearerToken:     newTestToken(t, jwtgo.MapClaims{"exp": now.Add(72 * time.Hour).Unix()}),
+			TLSClientConfig: appv1.TLSClientConfig{CertData: newTestCertificate(t, 365*24*time.Hour)},
+		},
+	}
+	repo := &appv1.Repository{
+		Repo:     "https://git.example.com/repo.git",
+		Password: newTestToken(t, jwtgo.MapClaims{"exp": now.Add(-time.Hour).Unix()}),
+	}
+
+	conditions := checker.conditions(cluster, repo)
+	assert.Len(t, conditions, 2)
+	for _, condition := range conditions {
+		assert.Equal(t, appv1.ApplicationConditionCredentialsExpiryWarning, condition.Type)
+		assert.False(t, condition.IsError())
+	}
+	assert.Equal(t, "The bearer token of cluster https://cluster.example.com expires in 72h0m0s ("+now.Add(72*time.Hour).UTC().Format(time.RFC3339)+")", conditions[0].Message)
+	assert.Contains(t, conditions[1].Message, "The password token of repository https://git.example.com/repo.git expired at")
+	assert.Equal(t, float64(now.Add(365*24*time.Hour).Unix()), testutil.ToFloat64(checker.metrics.credentialsExpiry.WithLabelValues(credentialsKindClusterServerCertificate, cluster.Server)))
+
+	// the server certificate is checked once per TTL
+	_ = checker.conditions(cluster, nil)
+	assert.Equal(t, 1, dials)
+	now = now.Add(serverCertificateExpiryTTL + time.Minute)
+	_ = checker.conditions(cluster, nil)
+	assert.Equal(t, 2, dials)
+}
+
+func TestCredentialsExpiryServerCertificateError(t *testing.T) {
+	checker := newCredentialsExpiryChecker(newControllerMetrics())
+	checker.serverCertificateExpiry = func(address string, serverName string) (time.Time, error) {
+		return time.Time{}, fmt.Errorf("connection refused")
+	}
+	conditions := checker.conditions(&appv1.Cluster{Server: "https://cluster.example.com:6443"}, nil)
+	assert.Empty(t, conditions)
+}
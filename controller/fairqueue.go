@@ -0,0 +1,226 @@
+package controller
+
+import (
+	"sync"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+)
+
+// starvationThreshold is the time an application can wait in a queue before it is reported as starved
+const starvationThreshold = 1 * time.Minute
+
+// appQueue is the work queue of applications processed by the controller
+type appQueue interface {
+	Add(key interface{})
+	AddAfter(key interface{}, delay time.Duration)
+	Get() (key interface{}, shutdown bool)
+	Done(key interface{})
+	Len() int
+	ShutDown()
+}
+
+// queuedItem is an item waiting in a project queue
+type queuedItem struct {
+	key     string
+	addedAt time.Time
+}
+
+// fairQueue is a work queue which hands out the applications of each project in turn, so that a
+// project with many queued applications does not delay the applications of other projects. Like
+// the client-go work queue, an application is queued at most once, and is never processed by
+// several workers at the same time. Unless itemMinInterval is zero, an application is not handed
+// out again before itemMinInterval passed since it was last handed out.
+type fairQueue struct {
+	name string
+	// projectOf returns the project of the application with the given key
+	projectOf func(key string) string
+	metrics   *controllerMetrics
+	// itemMinInterval is the minimum duration between two hand outs of the same application
+	itemMinInterval time.Duration
+
+	cond *sync.Cond
+	// queues holds the queued applications of each project
+	queues map[string][]queuedItem
+	// projects lists the projects with queued applications, in the order they are served
+	projects []string
+	// dirty holds the applications which need to be processed
+	dirty map[string]bool
+	// processing holds the applications which are being processed
+	processing map[string]bool
+	// handedOutAt holds when the applications were last handed out, if it was less than
+	// itemMinInterval ago
+	handedOutAt map[string]time.Time
+	// prunedAt is when the expired hand out times were last removed
+	prunedAt     time.Time
+	shuttingDown bool
+}
+
+func newFairQueue(name string, projectOf func(key string) string, metrics *controllerMetrics, itemMinInterval time.Duration) *fairQueue {
+	return &fairQueue{
+		name:            name,
+		projectOf:       projectOf,
+		metrics:         metrics,
+		itemMinInterval: itemMinInterval,
+		cond:            sync.NewCond(&sync.Mutex{}),
+		queues:          make(map[string][]queuedItem),
+		dirty:           make(map[string]bool),
+		processing:      make(map[string]bool),
+		handedOutAt:     make(map[string]time.Time),
+	}
+}
+
+// Add queues the application, unless it is already queued. An application which is being
+// processed is queued once it is done. An application which was handed out less than
+// itemMinInterval ago is queued once the interval passed.
+func (q *fairQueue) Add(key interface{}) {
+	q.cond.L.Lock()
+	defer q.cond.L.Unlock()
+	if q.shuttingDown {
+		return
+	}
+	k := key.(string)
+	if q.dirty[k] {
+		return
+	}
+	q.dirty[k] = true
+	if q.processing[k] {
+		return
+	}
+	q.enqueueLimited(k)
+}
+
+// AddAfter queues the application once the delay passed
+func (q *fairQueue) AddAfter(key interface{}, delay time.Duration) {
+	if delay <= 0 {
+		q.Add(key)
+		return
+	}
+	time.AfterFunc(delay, func() { q.Add(key) })
+}
+
+// Get blocks until an application is queued, and returns the next application of the next project
+// in turn. Done must be called once the application is processed.
+func (q *fairQueue) Get() (interface{}, bool) {
+	q.cond.L.Lock()
+	defer q.cond.L.Unlock()
+	for len(q.projects) == 0 && !q.shuttingDown {
+		q.cond.Wait()
+	}
+	if len(q.projects) == 0 {
+		return nil, true
+	}
+	project := q.projects[0]
+	items := q.queues[project]
+	item := items[0]
+	if len(items) > 1 {
+		q.queues[project] = items[1:]
+		// the project is served again after all other projects
+		q.projects = append(q.projects[1:], project)
+	} else {
+		delete(q.queues, project)
+		q.projects = q.projects[1:]
+	}
+	q.processing[item.key] = true
+	delete(q.dirty, item.key)
+	if q.itemMinInterval > 0 {
+		q.pruneHandedOutAt()
+		q.handedOutAt[item.key] = time.Now()
+	}
+
+	waited := time.Since(item.addedAt)
+	if q.metrics != nil {
+		q.metrics.queueDepth.WithLabelValues(q.name, project).Set(float64(len(q.queues[project])))
+		q.metrics.queueLatency.WithLabelValues(q.name, project).Observe(waited.Seconds())
+	}
+	if waited > starvationThreshold {
+		log.WithField("application", item.key).Warnf("Application of project '%s' waited %v in the %s queue", project, waited, q.name)
+		if q.metrics != nil {
+			q.metrics.queueStarvations.WithLabelValues(q.name, project).Inc()
+		}
+	}
+	return item.key, false
+}
+
+// Done marks the application as processed, and queues it again if it was added in the meantime
+func (q *fairQueue) Done(key interface{}) {
+	q.cond.L.Lock()
+	defer q.cond.L.Unlock()
+	k := key.(string)
+	delete(q.processing, k)
+	if q.dirty[k] {
+		q.enqueueLimited(k)
+	}
+}
+
+// Len returns the number of queued applications
+func (q *fairQueue) Len() int {
+	q.cond.L.Lock()
+	defer q.cond.L.Unlock()
+	count := 0
+	for _, items := range q.queues {
+		count += len(items)
+	}
+	return count
+}
+
+// ShutDown stops the queue from accepting applications, and releases the workers waiting in Get
+// once the queued applications are handed out
+func (q *fairQueue) ShutDown() {
+	q.cond.L.Lock()
+	defer q.cond.L.Unlock()
+	q.shuttingDown = true
+	q.cond.Broadcast()
+}
+
+// enqueueLimited queues the application and wakes up a worker, or delays it until itemMinInterval
+// passed since the application was last handed out. The application stays dirty while it is
+// delayed, so that it is not queued twice. Must be called with the lock held.
+func (q *fairQueue) enqueueLimited(key string) {
+	if handedOutAt, ok := q.handedOutAt[key]; ok {
+		if delay := q.itemMinInterval - time.Since(handedOutAt); delay > 0 {
+			time.AfterFunc(delay, func() {
+				q.cond.L.Lock()
+				defer q.cond.L.Unlock()
+				if q.shuttingDown {
+					return
+				}
+				delete(q.handedOutAt, key)
+				q.enqueue(key)
+				q.cond.Signal()
+			})
+			return
+		}
+		delete(q.handedOutAt, key)
+	}
+	q.enqueue(key)
+	q.cond.Signal()
+}
+
+// pruneHandedOutAt removes the hand out times older than itemMinInterval, at most once per
+// interval, so that the applications which are not queued again (e.g. deleted applications) are
+// not kept forever. Must be called with the lock held.
+func (q *fairQueue) pruneHandedOutAt() {
+	if time.Since(q.prunedAt) < q.itemMinInterval {
+		return
+	}
+	for key, handedOutAt := range q.handedOutAt {
+		if time.Since(handedOutAt) >= q.itemMinInterval && !q.dirty[key] {
+			delete(q.handedOutAt, key)
+		}
+	}
+	q.prunedAt = time.Now()
+}
+
+// enqueue appends the application to the queue of its project. Must be called with the lock held.
+func (q *fairQueue) enqueue(key string) {
+	project := q.projectOf(key)
+	items, ok := q.queues[project]
+	if !ok {
+		q.projects = append(q.projects, project)
+	}
+	q.queues[project] = append(items, queuedItem{key: key, addedAt: time.Now()})
+	if q.metrics != nil {
+		q.metrics.queueDepth.WithLabelValues(q.name, project).Set(float64(len(q.queues[project])))
+	}
+}
@@ -0,0 +1,95 @@
+package controller
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+)
+
+// projectOfKey returns the project of keys in the form project/name
+func projectOfKey(key string) string {
+	return strings.Split(key, "/")[0]
+}
+
+func getAll(q *fairQueue) []string {
+	var keys []string
+	for q.Len() > 0 {
+		key, _ := q.Get()
+		keys = append(keys, key.(string))
+		q.Done(key)
+	}
+	return keys
+}
+
+func TestFairQueueServesProjectsInTurn(t *testing.T) {
+	q := newFairQueue("refresh", projectOfKey, newControllerMetrics(), 0)
+	q.Add("busy/app1")
+	q.Add("busy/app2")
+	q.Add("busy/app3")
+	q.Add("quiet/app1")
+	q.Add("other/app1")
+	q.Add("other/app2")
+
+	assert.Equal(t, 3, int(testutil.ToFloat64(q.metrics.queueDepth.WithLabelValues("refresh", "busy"))))
+	assert.Equal(t, []string{"busy/app1", "quiet/app1", "other/app1", "busy/app2", "other/app2", "busy/app3"}, getAll(q))
+	assert.Equal(t, 0, int(testutil.ToFloat64(q.metrics.queueDepth.WithLabelValues("refresh", "busy"))))
+}
+
+func TestFairQueueDeduplicates(t *testing.T) {
+	q := newFairQueue("refresh", projectOfKey, nil, 0)
+	q.Add("proj/app1")
+	q.Add("proj/app1")
+	assert.Equal(t, 1, q.Len())
+
+	key, shutdown := q.Get()
+	assert.False(t, shutdown)
+	// an application added while it is processed is queued once it is done
+	q.Add(key)
+	assert.Equal(t, 0, q.Len())
+	q.Done(key)
+	assert.Equal(t, 1, q.Len())
+}
+
+func TestFairQueueAddAfter(t *testing.T) {
+	q := newFairQueue("operation", projectOfKey, nil, 0)
+	q.AddAfter("proj/app1", 10*time.Millisecond)
+	assert.Equal(t, 0, q.Len())
+	key, _ := q.Get()
+	assert.Equal(t, "proj/app1", key)
+}
+
+func TestFairQueueShutDown(t *testing.T) {
+	q := newFairQueue("refresh", projectOfKey, nil, 0)
+	done := make(chan bool)
+	go func() {
+		_, shutdown := q.Get()
+		done <- shutdown
+	}()
+	q.ShutDown()
+	assert.True(t, <-done)
+	q.Add("proj/app1")
+	assert.Equal(t, 0, q.Len())
+}
+
+func TestFairQueueItemMinInterval(t *testing.T) {
+	q := newFairQueue("refresh", projectOfKey, nil, 50*time.Millisecond)
+	q.Add("proj/app1")
+	key, _ := q.Get()
+	q.Done(key)
+
+	// the application was handed out less than the min interval ago
+	q.Add("proj/app1")
+	q.Add("proj/app1")
+	q.Add("proj/app2")
+	assert.Equal(t, []string{"proj/app2"}, getAll(q))
+
+	start := time.Now()
+	key, _ = q.Get()
+	assert.Equal(t, "proj/app1", key)
+	assert.True(t, time.Since(start) > 20*time.Millisecond)
+	q.Done(key)
+	assert.Equal(t, 0, q.Len())
+}
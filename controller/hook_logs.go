@@ -0,0 +1,110 @@
+package controller
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	apiv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+const (
+	// hookLogTailLines is the number of log lines of a failed hook container which are added to the
+	// message of the hook status
+	hookLogTailLines = 20
+	// hookLogMaxBytes limits the size of the logs added to the message of the hook status, since the
+	// operation state is stored in the application resource
+	hookLogMaxBytes = 2048
+)
+
+// failedHookLogs returns the last log lines of the failed container of a failed hook pod, or of the
+// last failed pod of a failed hook job. Returns an empty string if the logs are not available.
+func (sc *syncContext) failedHookLogs(hook *unstructured.Unstructured) string {
+	var pod *apiv1.Pod
+	var err error
+	gvk := hook.GroupVersionKind()
+	switch {
+	case isPod(gvk):
+		pod, err = toPod(hook)
+	case isBatchJob(gvk):
+		pod, err = sc.lastFailedJobPod(hook)
+	}
+	if err != nil {
+		sc.log.Warnf("Failed to get pod of failed hook %s/%s: %v", hook.GetKind(), hook.GetName(), err)
+		return ""
+	}
+	if pod == nil {
+		return ""
+	}
+	container := failedContainer(pod)
+	if container == "" {
+		return ""
+	}
+	logs, err := sc.kubectl.GetPodLogs(sc.config, pod.Namespace, pod.Name, container, hookLogTailLines)
+	if err != nil {
+		sc.log.Warnf("Failed to get logs of failed hook pod %s: %v", pod.Name, err)
+		return ""
+	}
+	logs = strings.TrimSpace(logs)
+	if len(logs) > hookLogMaxBytes {
+		logs = logs[len(logs)-hookLogMaxBytes:]
+	}
+	if logs == "" {
+		return ""
+	}
+	return fmt.Sprintf("logs of pod %s, container %s:\n%s", pod.Name, container, logs)
+}
+
+// lastFailedJobPod returns the most recently created failed pod of a job, or nil if no pod failed
+func (sc *syncContext) lastFailedJobPod(job *unstructured.Unstructured) (*apiv1.Pod, error) {
+	podIf := sc.dynamicIf.Resource(schema.GroupVersionResource{Version: "v1", Resource: "pods"}).Namespace(job.GetNamespace())
+	list, err := podIf.List(metav1.ListOptions{LabelSelector: fmt.Sprintf("controller-uid=%s", job.GetUID())})
+	if err != nil {
+		return nil, err
+	}
+	var pods []*apiv1.Pod
+	for i := range list.Items {
+		pod, err := toPod(&list.Items[i])
+		if err != nil {
+			return nil, err
+		}
+		if pod.Status.Phase == apiv1.PodFailed {
+			pods = append(pods, pod)
+		}
+	}
+	if len(pods) == 0 {
+		return nil, nil
+	}
+	sort.Slice(pods, func(i, j int) bool {
+		return pods[i].CreationTimestamp.Before(&pods[j].CreationTimestamp)
+	})
+	return pods[len(pods)-1], nil
+}
+
+// failedContainer returns the name of the first container of the pod which terminated with an error,
+// or of the last container if no container terminated with an error
+func failedContainer(pod *apiv1.Pod) string {
+	statuses := append(pod.Status.InitContainerStatuses, pod.Status.ContainerStatuses...)
+	for _, ctr := range statuses {
+		if ctr.State.Terminated != nil && ctr.State.Terminated.ExitCode != 0 {
+			return ctr.Name
+		}
+	}
+	if len(statuses) > 0 {
+		return statuses[len(statuses)-1].Name
+	}
+	return ""
+}
+
+func toPod(obj *unstructured.Unstructured) (*apiv1.Pod, error) {
+	var pod apiv1.Pod
+	err := runtime.DefaultUnstructuredConverter.FromUnstructured(obj.Object, &pod)
+	if err != nil {
+		return nil, err
+	}
+	return &pod, nil
+}
@@ -0,0 +1,38 @@
+package controller
+
+import (
+	"fmt"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+
+	"github.com/argoproj/argo-cd/common"
+	appv1 "github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1"
+)
+
+// instanceSelector returns the label selector of the applications and resources which belong to the
+// controller instance. A controller without instance ID only manages unlabeled objects, so that it
+// does not claim the objects of the other instances in the cluster.
+func instanceSelector(instanceID string) string {
+	if instanceID == "" {
+		return "!" + common.LabelKeyApplicationControllerInstanceID
+	}
+	return fmt.Sprintf("%s=%s", common.LabelKeyApplicationControllerInstanceID, instanceID)
+}
+
+// appInstanceID returns the ID of the controller instance which manages the application. The
+// resources of the application are tracked by the same ID.
+func appInstanceID(app *appv1.Application) string {
+	return app.Labels[common.LabelKeyApplicationControllerInstanceID]
+}
+
+// isInstanceObject returns whether the application or resource belongs to the controller instance
+func isInstanceObject(obj metav1.Object, instanceID string) bool {
+	return obj.GetLabels()[common.LabelKeyApplicationControllerInstanceID] == instanceID
+}
+
+// appResourcesSelector returns the label selector of the resources of the application which belong
+// to the controller instance
+func appResourcesSelector(appName string, instanceID string) (labels.Selector, error) {
+	return labels.Parse(fmt.Sprintf("%s=%s,%s", common.LabelApplicationName, appName, instanceSelector(instanceID)))
+}
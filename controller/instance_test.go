@@ -0,0 +1,38 @@
+package controller
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/labels"
+
+	"github.com/argoproj/argo-cd/common"
+)
+
+func TestAppResourcesSelector(t *testing.T) {
+	selector, err := appResourcesSelector("guestbook", "team-a")
+	assert.NoError(t, err)
+	assert.True(t, selector.Matches(labels.Set{common.LabelApplicationName: "guestbook", common.LabelKeyApplicationControllerInstanceID: "team-a"}))
+	assert.False(t, selector.Matches(labels.Set{common.LabelApplicationName: "guestbook", common.LabelKeyApplicationControllerInstanceID: "team-b"}))
+	assert.False(t, selector.Matches(labels.Set{common.LabelApplicationName: "guestbook"}))
+	assert.False(t, selector.Matches(labels.Set{common.LabelApplicationName: "other", common.LabelKeyApplicationControllerInstanceID: "team-a"}))
+
+	selector, err = appResourcesSelector("guestbook", "")
+	assert.NoError(t, err)
+	assert.True(t, selector.Matches(labels.Set{common.LabelApplicationName: "guestbook"}))
+	assert.False(t, selector.Matches(labels.Set{common.LabelApplicationName: "guestbook", common.LabelKeyApplicationControllerInstanceID: "team-a"}))
+
+	_, err = appResourcesSelector("guestbook", "invalid id")
+	assert.Error(t, err)
+}
+
+func TestIsInstanceObject(t *testing.T) {
+	app := newFakeApp()
+	assert.True(t, isInstanceObject(app, ""))
+	assert.False(t, isInstanceObject(app, "team-a"))
+
+	app.Labels = map[string]string{common.LabelKeyApplicationControllerInstanceID: "team-a"}
+	assert.True(t, isInstanceObject(app, "team-a"))
+	assert.False(t, isInstanceObject(app, ""))
+	assert.Equal(t, "team-a", appInstanceID(app))
+}
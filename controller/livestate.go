@@ -0,0 +1,345 @@
+package controller
+
+import (
+	"encoding/json"
+	"fmt"
+	"sync"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/rest"
+
+	"github.com/argoproj/argo-cd/common"
+	appv1 "github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1"
+	cache_util "github.com/argoproj/argo-cd/util/cache"
+	kubeutil "github.com/argoproj/argo-cd/util/kube"
+)
+
+const (
+	// liveStateSnapshotVersion is the version of the format of live state snapshots. Snapshots of
+	// other versions are ignored
+	liveStateSnapshotVersion = 2
+	// liveStateSnapshotInterval is the min duration between two snapshots of the live state of a cluster
+	liveStateSnapshotInterval = time.Minute
+)
+
+// LiveStateSnapshots controls the snapshots of the live state of clusters, which are persisted so
+// that a restarted controller compares applications against them, instead of listing the resources
+// of every cluster at once
+type LiveStateSnapshots struct {
+	// Cache stores the snapshots, which are disabled if nil. Should be shared by the controller
+	// restarts (e.g. redis)
+	Cache cache_util.Cache
+	// MaxAge is the age after which snapshots are no longer rehydrated
+	MaxAge time.Duration
+}
+
+// liveStateSnapshot is the persisted live state of a cluster
+type liveStateSnapshot struct {
+	Version int
+	Server  string
+	SavedAt time.Time
+	// Manifests are the live resources of all applications in the cluster except secrets, in JSON format
+	Manifests []string
+}
+
+// liveStateBatcher shares the live resources of a cluster between the comparisons of all the
+// applications which target the cluster. Instead of listing every resource type for each
+// application, the resources of all applications are retrieved with a single LIST per resource
+// type, at most once per batch window.
+type liveStateBatcher struct {
+	window time.Duration
+	// listResources lists the resources of all applications in the cluster
+	listResources func(config *rest.Config) ([]*unstructured.Unstructured, error)
+	// listSecrets lists the secrets of all applications in the cluster, which are not snapshotted
+	listSecrets func(config *rest.Config) ([]*unstructured.Unstructured, error)
+	lock        sync.Mutex
+	clusters    map[string]*clusterLiveState
+	snapshots   LiveStateSnapshots
+	// snapshotAt holds the time of the latest snapshot of each cluster
+	snapshotAt map[string]time.Time
+	// rehydrated holds the clusters whose live state was loaded since the controller started, after
+	// which snapshots are no longer rehydrated
+	rehydrated map[string]bool
+	// snapshotExpiresAt holds when the live state rehydrated from the snapshot of each cluster expires
+	snapshotExpiresAt map[string]time.Time
+}
+
+// clusterLiveState holds the live resources of a cluster, grouped by application name
+type clusterLiveState struct {
+	// loaded is closed once the resources are retrieved
+	loaded    chan struct{}
+	expiresAt time.Time
+	appObjs   map[string][]*unstructured.Unstructured
+	err       error
+}
+
+func newLiveStateBatcher(window time.Duration, snapshots LiveStateSnapshots) *liveStateBatcher {
+	return &liveStateBatcher{
+		window: window,
+		listResources: func(config *rest.Config) ([]*unstructured.Unstructured, error) {
+			return kubeutil.GetResourcesWithLabelKey(config, common.LabelApplicationName)
+		},
+		listSecrets: func(config *rest.Config) ([]*unstructured.Unstructured, error) {
+			dynamicIf, err := dynamic.NewForConfig(config)
+			if err != nil {
+				return nil, err
+			}
+			return kubeutil.ListResources(dynamicIf, secretsAPIResource, "", metav1.ListOptions{LabelSelector: common.LabelApplicationName})
+		},
+		clusters:          make(map[string]*clusterLiveState),
+		snapshots:         snapshots,
+		snapshotAt:        make(map[string]time.Time),
+		rehydrated:        make(map[string]bool),
+		snapshotExpiresAt: make(map[string]time.Time),
+	}
+}
+
+var secretsAPIResource = metav1.APIResource{Name: "secrets", Namespaced: true, Kind: kubeutil.SecretKind, Version: "v1"}
+
+// isSecret returns whether the object is a secret, which contains credentials that must not be
+// persisted in snapshots
+func isSecret(obj *unstructured.Unstructured) bool {
+	gvk := obj.GroupVersionKind()
+	return gvk.Group == "" && gvk.Kind == kubeutil.SecretKind
+}
+
+// getAppLiveObjs returns the live resources labeled with the application name which belong to the
+// given controller instance, in one of the given namespaces or cluster-scoped. Resources of all
+// namespaces are returned if no namespace is given. Falls back to querying the resources of the
+// application alone if batching is disabled.
+func (b *liveStateBatcher) getAppLiveObjs(server string, config *rest.Config, namespaces []string, appName string, instanceID string) ([]*unstructured.Unstructured, error) {
+	server = appv1.NormalizeServer(server)
+	if b == nil || b.window <= 0 {
+		selector, err := appResourcesSelector(appName, instanceID)
+		if err != nil {
+			return nil, err
+		}
+		if len(namespaces) == 1 {
+			return kubeutil.GetResourcesWithSelector(config, namespaces[0], selector)
+		}
+		objs, err := kubeutil.GetResourcesWithSelector(config, "", selector)
+		if err != nil {
+			return nil, err
+		}
+		var nsObjs []*unstructured.Unstructured
+		for _, obj := range objs {
+			if isInNamespaces(obj, namespaces) {
+				nsObjs = append(nsObjs, obj)
+			}
+		}
+		return nsObjs, nil
+	}
+	state := b.getClusterLiveState(server, config)
+	<-state.loaded
+	if state.err != nil {
+		return nil, state.err
+	}
+	var objs []*unstructured.Unstructured
+	for _, obj := range state.appObjs[appName] {
+		if !isInstanceObject(obj, instanceID) {
+			continue
+		}
+		if isInNamespaces(obj, namespaces) {
+			// objects are shared by comparisons within the batch window, so must not be modified
+			objs = append(objs, obj.DeepCopy())
+		}
+	}
+	return objs, nil
+}
+
+// isInNamespaces returns whether the object is cluster-scoped or in one of the namespaces, or any
+// namespace if none is given
+func isInNamespaces(obj *unstructured.Unstructured, namespaces []string) bool {
+	if len(namespaces) == 0 || obj.GetNamespace() == "" {
+		return true
+	}
+	for _, namespace := range namespaces {
+		if namespace == "" || obj.GetNamespace() == namespace {
+			return true
+		}
+	}
+	return false
+}
+
+// getClusterLiveState returns the live state of the cluster which is loading or loaded within the
+// batch window, or starts loading the live state if there is none. The first live state of a cluster
+// since the controller started is rehydrated from its snapshot, if any, along with the secrets, which
+// are listed from the cluster.
+func (b *liveStateBatcher) getClusterLiveState(server string, config *rest.Config) *clusterLiveState {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	if state, ok := b.clusters[server]; ok {
+		select {
+		case <-state.loaded:
+			if state.err == nil && time.Now().Before(state.expiresAt) {
+				return state
+			}
+		default:
+			return state
+		}
+	}
+	state := &clusterLiveState{loaded: make(chan struct{})}
+	b.clusters[server] = state
+	rehydrate := !b.rehydrated[server]
+	b.rehydrated[server] = true
+	go func() {
+		var objs []*unstructured.Unstructured
+		if rehydrate {
+			objs = b.loadSnapshot(server)
+		}
+		if objs != nil {
+			secrets, err := b.listSecrets(config)
+			if err != nil {
+				log.Warnf("Failed to list the secrets of cluster %s, ignoring its snapshot: %v", server, err)
+				objs = nil
+			} else {
+				objs = append(objs, secrets...)
+			}
+		}
+		listed := objs == nil
+		if listed {
+			var err error
+			if objs, err = b.listResources(config); err != nil {
+				state.err = err
+				close(state.loaded)
+				return
+			}
+		}
+		state.appObjs = make(map[string][]*unstructured.Unstructured)
+		for _, obj := range objs {
+			appName := obj.GetLabels()[common.LabelApplicationName]
+			state.appObjs[appName] = append(state.appObjs[appName], obj)
+		}
+		state.expiresAt = time.Now().Add(b.window)
+		if listed {
+			b.saveSnapshot(server, objs)
+		} else {
+			b.lock.Lock()
+			b.snapshotExpiresAt[server] = state.expiresAt
+			b.lock.Unlock()
+		}
+		close(state.loaded)
+	}()
+	return state
+}
+
+// loadSnapshot returns the live resources of the snapshot of the cluster, or nil if there is no
+// consistent snapshot
+func (b *liveStateBatcher) loadSnapshot(server string) []*unstructured.Unstructured {
+	if b.snapshots.Cache == nil {
+		return nil
+	}
+	var snapshot liveStateSnapshot
+	if err := b.snapshots.Cache.Get(liveStateSnapshotKey(server), &snapshot); err != nil {
+		if err != cache_util.ErrCacheMiss {
+			log.Warnf("Failed to load snapshot of the live state of cluster %s: %v", server, err)
+		}
+		return nil
+	}
+	objs, err := snapshot.check(server, b.snapshots.MaxAge, time.Now())
+	if err != nil {
+		log.Warnf("Ignoring snapshot of the live state of cluster %s: %v", server, err)
+		return nil
+	}
+	log.Infof("Rehydrated live state of cluster %s from snapshot saved at %s (%d resources)", server, snapshot.SavedAt.Format(time.RFC3339), len(objs))
+	return objs
+}
+
+// check returns the live resources of the snapshot, or an error if the snapshot is not consistent
+// with the cluster, too old, or of another format
+func (s *liveStateSnapshot) check(server string, maxAge time.Duration, now time.Time) ([]*unstructured.Unstructured, error) {
+	if s.Version != liveStateSnapshotVersion {
+		return nil, fmt.Errorf("unsupported version %d", s.Version)
+	}
+	if s.Server != server {
+		return nil, fmt.Errorf("snapshot of cluster %s", s.Server)
+	}
+	if maxAge > 0 && now.Sub(s.SavedAt) > maxAge {
+		return nil, fmt.Errorf("saved at %s, more than %v ago", s.SavedAt.Format(time.RFC3339), maxAge)
+	}
+	objs := make([]*unstructured.Unstructured, 0, len(s.Manifests))
+	for _, manifest := range s.Manifests {
+		obj := &unstructured.Unstructured{}
+		if err := json.Unmarshal([]byte(manifest), &obj.Object); err != nil {
+			return nil, fmt.Errorf("invalid resource: %v", err)
+		}
+		if obj.GetLabels()[common.LabelApplicationName] == "" {
+			return nil, fmt.Errorf("resource %s/%s is not labeled with an application", obj.GetKind(), obj.GetName())
+		}
+		if isSecret(obj) {
+			return nil, fmt.Errorf("resource %s/%s is a secret", obj.GetKind(), obj.GetName())
+		}
+		objs = append(objs, obj)
+	}
+	return objs, nil
+}
+
+// saveSnapshot persists the live resources of the cluster, unless it was saved recently
+func (b *liveStateBatcher) saveSnapshot(server string, objs []*unstructured.Unstructured) {
+	if b.snapshots.Cache == nil {
+		return
+	}
+	now := time.Now()
+	b.lock.Lock()
+	if now.Sub(b.snapshotAt[server]) < liveStateSnapshotInterval {
+		b.lock.Unlock()
+		return
+	}
+	b.snapshotAt[server] = now
+	b.lock.Unlock()
+	snapshot := liveStateSnapshot{Version: liveStateSnapshotVersion, Server: server, SavedAt: now}
+	for _, obj := range objs {
+		if isSecret(obj) {
+			continue
+		}
+		manifest, err := json.Marshal(obj.Object)
+		if err != nil {
+			log.Warnf("Failed to snapshot the live state of cluster %s: %v", server, err)
+			return
+		}
+		snapshot.Manifests = append(snapshot.Manifests, string(manifest))
+	}
+	err := b.snapshots.Cache.Set(&cache_util.Item{Key: liveStateSnapshotKey(server), Object: &snapshot, Expiration: b.snapshots.MaxAge})
+	if err != nil {
+		log.Warnf("Failed to save snapshot of the live state of cluster %s: %v", server, err)
+	}
+}
+
+func liveStateSnapshotKey(server string) string {
+	return fmt.Sprintf("livestate|%s", server)
+}
+
+// getSnapshotExpiration returns when the live state of the cluster rehydrated from its snapshot
+// expires, or the zero time if the live state of the cluster was never rehydrated
+func (b *liveStateBatcher) getSnapshotExpiration(server string) time.Time {
+	if b == nil {
+		return time.Time{}
+	}
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	return b.snapshotExpiresAt[appv1.NormalizeServer(server)]
+}
+
+// invalidate discards the live state of the cluster, so that the next comparison of an
+// application which targets the cluster retrieves fresh resources
+func (b *liveStateBatcher) invalidate(server string) {
+	if b == nil {
+		return
+	}
+	server = appv1.NormalizeServer(server)
+	b.lock.Lock()
+	delete(b.clusters, server)
+	// the snapshot is outdated as well, and is replaced by the next live state
+	delete(b.snapshotAt, server)
+	delete(b.snapshotExpiresAt, server)
+	b.lock.Unlock()
+	if b.snapshots.Cache != nil {
+		if err := b.snapshots.Cache.Delete(liveStateSnapshotKey(server)); err != nil {
+			log.Warnf("Failed to delete snapshot of the live state of cluster %s: %v", server, err)
+		}
+	}
+}
@@ -0,0 +1,231 @@
+package controller
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/client-go/rest"
+
+	"github.com/argoproj/argo-cd/common"
+	cache_util "github.com/argoproj/argo-cd/util/cache"
+	kubeutil "github.com/argoproj/argo-cd/util/kube"
+)
+
+func newAppObj(appName, namespace, name string) *unstructured.Unstructured {
+	obj := &unstructured.Unstructured{}
+	obj.SetAPIVersion("v1")
+	obj.SetKind("ConfigMap")
+	obj.SetNamespace(namespace)
+	obj.SetName(name)
+	obj.SetLabels(map[string]string{common.LabelApplicationName: appName})
+	return obj
+}
+
+func newTestBatcher(window time.Duration, objs ...*unstructured.Unstructured) (*liveStateBatcher, *int) {
+	var lock sync.Mutex
+	lists := 0
+	b := newLiveStateBatcher(window, LiveStateSnapshots{})
+	b.listResources = func(config *rest.Config) ([]*unstructured.Unstructured, error) {
+		lock.Lock()
+		defer lock.Unlock()
+		lists++
+		return objs, nil
+	}
+	b.listSecrets = func(config *rest.Config) ([]*unstructured.Unstructured, error) {
+		var secrets []*unstructured.Unstructured
+		for _, obj := range objs {
+			if isSecret(obj) {
+				secrets = append(secrets, obj)
+			}
+		}
+		return secrets, nil
+	}
+	return b, &lists
+}
+
+func TestLiveStateBatcherSharesList(t *testing.T) {
+	b, lists := newTestBatcher(time.Minute,
+		newAppObj("app1", "default", "config1"),
+		newAppObj("app1", "other", "config2"),
+		newAppObj("app2", "default", "config3"),
+		newAppObj("app2", "", "cluster-config"),
+	)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			objs, err := b.getAppLiveObjs("https://localhost:6443", &rest.Config{}, []string{"default"}, fmt.Sprintf("app%d", i%2+1), "")
+			assert.NoError(t, err)
+			assert.Len(t, objs, 1+i%2)
+		}(i)
+	}
+	wg.Wait()
+	assert.Equal(t, 1, *lists)
+
+	objs, err := b.getAppLiveObjs("https://localhost:6443", &rest.Config{}, []string{"default"}, "app1", "")
+	assert.NoError(t, err)
+	assert.Len(t, objs, 1)
+	assert.Equal(t, "config1", objs[0].GetName())
+
+	objs, err = b.getAppLiveObjs("https://localhost:6443", &rest.Config{}, nil, "app1", "")
+	assert.NoError(t, err)
+	assert.Len(t, objs, 2)
+
+	objs, err = b.getAppLiveObjs("https://localhost:6443", &rest.Config{}, []string{"default", "other"}, "app1", "")
+	assert.NoError(t, err)
+	assert.Len(t, objs, 2)
+	assert.Equal(t, 1, *lists)
+
+	_, err = b.getAppLiveObjs("https://kubernetes.default.svc", &rest.Config{}, []string{"default"}, "app1", "")
+	assert.NoError(t, err)
+	assert.Equal(t, 2, *lists)
+}
+
+func TestLiveStateBatcherReturnsCopies(t *testing.T) {
+	b, _ := newTestBatcher(time.Minute, newAppObj("app1", "default", "config1"))
+
+	objs, err := b.getAppLiveObjs("https://localhost:6443", &rest.Config{}, []string{"default"}, "app1", "")
+	assert.NoError(t, err)
+	objs[0].SetName("modified")
+
+	objs, err = b.getAppLiveObjs("https://localhost:6443", &rest.Config{}, []string{"default"}, "app1", "")
+	assert.NoError(t, err)
+	assert.Equal(t, "config1", objs[0].GetName())
+}
+
+func TestLiveStateBatcherExpiration(t *testing.T) {
+	b, lists := newTestBatcher(time.Minute, newAppObj("app1", "default", "config1"))
+
+	_, err := b.getAppLiveObjs("https://localhost:6443", &rest.Config{}, []string{"default"}, "app1", "")
+	assert.NoError(t, err)
+	b.invalidate("https://localhost:6443")
+	_, err = b.getAppLiveObjs("https://localhost:6443", &rest.Config{}, []string{"default"}, "app1", "")
+	assert.NoError(t, err)
+	assert.Equal(t, 2, *lists)
+
+	b.clusters["https://localhost:6443"].expiresAt = time.Now().Add(-time.Second)
+	_, err = b.getAppLiveObjs("https://localhost:6443", &rest.Config{}, []string{"default"}, "app1", "")
+	assert.NoError(t, err)
+	assert.Equal(t, 3, *lists)
+}
+
+func TestLiveStateBatcherError(t *testing.T) {
+	b := newLiveStateBatcher(time.Minute, LiveStateSnapshots{})
+	lists := 0
+	b.listResources = func(config *rest.Config) ([]*unstructured.Unstructured, error) {
+		lists++
+		return nil, fmt.Errorf("connection refused")
+	}
+
+	_, err := b.getAppLiveObjs("https://localhost:6443", &rest.Config{}, []string{"default"}, "app1", "")
+	assert.Error(t, err)
+	_, err = b.getAppLiveObjs("https://localhost:6443", &rest.Config{}, []string{"default"}, "app1", "")
+	assert.Error(t, err)
+	assert.Equal(t, 2, lists)
+}
+
+func TestLiveStateBatcherInstanceID(t *testing.T) {
+	owned := newAppObj("app1", "default", "config1")
+	owned.SetLabels(map[string]string{common.LabelApplicationName: "app1", common.LabelKeyApplicationControllerInstanceID: "team-a"})
+	other := newAppObj("app1", "default", "config2")
+	other.SetLabels(map[string]string{common.LabelApplicationName: "app1", common.LabelKeyApplicationControllerInstanceID: "team-b"})
+	b, lists := newTestBatcher(time.Minute, owned, other, newAppObj("app1", "default", "config3"))
+
+	objs, err := b.getAppLiveObjs("https://localhost:6443", &rest.Config{}, []string{"default"}, "app1", "team-a")
+	assert.NoError(t, err)
+	assert.Len(t, objs, 1)
+	assert.Equal(t, "config1", objs[0].GetName())
+
+	objs, err = b.getAppLiveObjs("https://localhost:6443", &rest.Config{}, []string{"default"}, "app1", "")
+	assert.NoError(t, err)
+	assert.Len(t, objs, 1)
+	assert.Equal(t, "config3", objs[0].GetName())
+	assert.Equal(t, 1, *lists)
+}
+
+func TestLiveStateBatcherSnapshots(t *testing.T) {
+	snapshots := LiveStateSnapshots{Cache: cache_util.NewInMemoryCache(time.Hour), MaxAge: time.Hour}
+	newBatcher := func() (*liveStateBatcher, *int) {
+		secret := newAppObj("app1", "default", "secret1")
+		secret.SetKind(kubeutil.SecretKind)
+		b, lists := newTestBatcher(time.Minute, newAppObj("app1", "default", "config1"), secret, newAppObj("app2", "default", "config2"))
+		b.snapshots = snapshots
+		return b, lists
+	}
+
+	b, lists := newBatcher()
+	_, err := b.getAppLiveObjs("https://localhost:6443", &rest.Config{}, []string{"default"}, "app1", "")
+	assert.NoError(t, err)
+	assert.Equal(t, 1, *lists)
+	assert.True(t, b.getSnapshotExpiration("https://localhost:6443").IsZero())
+
+	// secrets are not snapshotted
+	var snapshot liveStateSnapshot
+	err = snapshots.Cache.Get(liveStateSnapshotKey("https://localhost:6443"), &snapshot)
+	assert.NoError(t, err)
+	assert.Len(t, snapshot.Manifests, 2)
+	for _, manifest := range snapshot.Manifests {
+		assert.NotContains(t, manifest, "secret1")
+	}
+
+	// a restarted controller rehydrates the live state from the snapshot, and lists the secrets
+	b, lists = newBatcher()
+	objs, err := b.getAppLiveObjs("https://localhost:6443", &rest.Config{}, []string{"default"}, "app1", "")
+	assert.NoError(t, err)
+	assert.Len(t, objs, 2)
+	assert.Equal(t, "config1", objs[0].GetName())
+	assert.Equal(t, "secret1", objs[1].GetName())
+	assert.Equal(t, 0, *lists)
+	assert.False(t, b.getSnapshotExpiration("https://localhost:6443").IsZero())
+
+	// snapshots are only rehydrated once
+	b.clusters["https://localhost:6443"].expiresAt = time.Now().Add(-time.Second)
+	_, err = b.getAppLiveObjs("https://localhost:6443", &rest.Config{}, []string{"default"}, "app1", "")
+	assert.NoError(t, err)
+	assert.Equal(t, 1, *lists)
+
+	// invalidated live states are not rehydrated after restarts
+	b.invalidate("https://localhost:6443")
+	assert.True(t, b.getSnapshotExpiration("https://localhost:6443").IsZero())
+	b, lists = newBatcher()
+	_, err = b.getAppLiveObjs("https://localhost:6443", &rest.Config{}, []string{"default"}, "app1", "")
+	assert.NoError(t, err)
+	assert.Equal(t, 1, *lists)
+}
+
+func TestLiveStateSnapshotCheck(t *testing.T) {
+	now := time.Now()
+	snapshot := liveStateSnapshot{
+		Version:   liveStateSnapshotVersion,
+		Server:    "https://localhost:6443",
+		SavedAt:   now.Add(-time.Minute),
+		Manifests: []string{`{"apiVersion":"v1","kind":"ConfigMap","metadata":{"name":"config1","labels":{"` + common.LabelApplicationName + `":"app1"}}}`},
+	}
+	objs, err := snapshot.check("https://localhost:6443", time.Hour, now)
+	assert.NoError(t, err)
+	assert.Len(t, objs, 1)
+
+	_, err = snapshot.check("https://kubernetes.default.svc", time.Hour, now)
+	assert.Error(t, err)
+	_, err = snapshot.check("https://localhost:6443", time.Second, now)
+	assert.Error(t, err)
+
+	secretSnapshot := snapshot
+	secretSnapshot.Manifests = append(snapshot.Manifests, `{"apiVersion":"v1","kind":"Secret","metadata":{"name":"secret1","labels":{"`+common.LabelApplicationName+`":"app1"}}}`)
+	_, err = secretSnapshot.check("https://localhost:6443", time.Hour, now)
+	assert.Error(t, err)
+
+	snapshot.Manifests = append(snapshot.Manifests, `{"apiVersion":"v1","kind":"ConfigMap","metadata":{"name":"unlabeled"}}`)
+	_, err = snapshot.check("https://localhost:6443", time.Hour, now)
+	assert.Error(t, err)
+
+	snapshot.Version = liveStateSnapshotVersion + 1
+	_, err = snapshot.check("https://localhost:6443", time.Hour, now)
+	assert.Error(t, err)
+}
@@ -0,0 +1,79 @@
+package controller
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+
+	appv1 "github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1"
+)
+
+const (
+	// MetricsPath is the endpoint to collect controller metrics
+	MetricsPath = "/metrics"
+	// operationPhaseTerminated is the phase label of the terminated operations
+	operationPhaseTerminated = "Terminated"
+)
+
+// controllerMetrics holds the metrics of the work queues of the controller
+type controllerMetrics struct {
+	registry         *prometheus.Registry
+	queueDepth       *prometheus.GaugeVec
+	queueLatency     *prometheus.HistogramVec
+	queueStarvations *prometheus.CounterVec
+	// credentialsExpiry holds the expiry of the credentials of clusters and repositories
+	credentialsExpiry *prometheus.GaugeVec
+	// operations counts the completed operations of applications
+	operations *prometheus.CounterVec
+}
+
+func newControllerMetrics() *controllerMetrics {
+	queueLabels := []string{"queue", "project"}
+	metrics := &controllerMetrics{
+		registry: prometheus.NewRegistry(),
+		queueDepth: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Name: "argocd_app_queue_depth",
+			Help: "Number of applications of a project waiting in a controller queue.",
+		}, queueLabels),
+		queueLatency: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Name:    "argocd_app_queue_latency_seconds",
+			Help:    "Time applications of a project waited in a controller queue before being processed.",
+			Buckets: []float64{0.01, 0.1, 0.5, 1, 5, 10, 30, 60, 120, 300},
+		}, queueLabels),
+		queueStarvations: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "argocd_app_queue_starvations_total",
+			Help: "Number of times applications of a project waited longer than a minute in a controller queue.",
+		}, queueLabels),
+		credentialsExpiry: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Name: "argocd_credentials_expiry_timestamp_seconds",
+			Help: "Expiry time in unix timestamp of the credentials of a cluster or repository.",
+		}, []string{"kind", "name"}),
+		operations: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "argocd_app_operations_total",
+			Help: "Number of completed operations of the applications of a project, by phase. Terminated operations are counted in the Terminated phase.",
+		}, []string{"project", "phase"}),
+	}
+	metrics.registry.MustRegister(metrics.queueDepth, metrics.queueLatency, metrics.queueStarvations, metrics.credentialsExpiry, metrics.operations)
+	return metrics
+}
+
+// incOperations counts the completed operation of an application of the project
+func (m *controllerMetrics) incOperations(project string, state *appv1.OperationState) {
+	phase := string(state.Phase)
+	if state.Terminated {
+		phase = operationPhaseTerminated
+	}
+	m.operations.WithLabelValues(project, phase).Inc()
+}
+
+// NewMetricsServer returns a new prometheus server which exposes the metrics of the controller
+func (ctrl *ApplicationController) NewMetricsServer(port int) *http.Server {
+	mux := http.NewServeMux()
+	mux.Handle(MetricsPath, promhttp.HandlerFor(ctrl.metrics.registry, promhttp.HandlerOpts{}))
+	return &http.Server{
+		Addr:    fmt.Sprintf("0.0.0.0:%d", port),
+		Handler: mux,
+	}
+}
@@ -0,0 +1,140 @@
+package controller
+
+import (
+	"context"
+	"fmt"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/rest"
+
+	"github.com/argoproj/argo-cd/common"
+	appv1 "github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/util/argo"
+	"github.com/argoproj/argo-cd/util/kube"
+)
+
+// moveSource holds the previous destination of an application which is moved to a new
+// destination, and the resources of the application which are left in it
+type moveSource struct {
+	destination appv1.ApplicationDestination
+	config      *rest.Config
+	dynamicIf   dynamic.Interface
+	liveObjs    []*unstructured.Unstructured
+}
+
+// getMoveSource returns the resources of the application in the destination it is moved from.
+// Resources which are also managed in the new destination (e.g. cluster-scoped resources of a move
+// between namespaces of the same cluster) are left untouched.
+func (s *appStateManager) getMoveSource(app *appv1.Application, from appv1.ApplicationDestination, resources []appv1.ResourceState) (*moveSource, error) {
+	clst, err := s.db.GetCluster(context.Background(), from.Server)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get cluster %s the application is moved from: %v", from.Server, err)
+	}
+	config := kube.ClusterRESTConfig(clst)
+	dynamicIf, err := dynamic.NewForConfig(config)
+	if err != nil {
+		return nil, fmt.Errorf("failed to initialize dynamic client: %v", err)
+	}
+	if appv1.NormalizeServer(from.Server) != appv1.NormalizeServer(app.Spec.Destination.Server) {
+		s.liveState.invalidate(from.Server)
+		defer s.liveState.invalidate(from.Server)
+	}
+	labeledObjs, err := s.liveState.getAppLiveObjs(from.Server, config, []string{from.Namespace}, app.Name, appInstanceID(app))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get resources of the application in %s: %v", from.Server, err)
+	}
+	managed := make(map[types.UID]bool)
+	if appv1.NormalizeServer(from.Server) == appv1.NormalizeServer(app.Spec.Destination.Server) {
+		for _, res := range resources {
+			liveObj, err := res.LiveObject()
+			if err != nil {
+				return nil, err
+			}
+			if liveObj != nil {
+				managed[liveObj.GetUID()] = true
+			}
+		}
+	}
+	src := moveSource{destination: from, config: config, dynamicIf: dynamicIf}
+	for _, obj := range labeledObjs {
+		if isHook(obj) || hasParent(obj) || managed[obj.GetUID()] {
+			continue
+		}
+		src.liveObjs = append(src.liveObjs, obj)
+	}
+	return &src, nil
+}
+
+// pruneMoveSource prunes the resources of a moved application from its previous destination, once
+// all resources of the sync tasks are healthy in the new destination. The resources are pruned only
+// once per operation. Returns true if the resources were pruned, and false if the sync has to wait
+// or failed.
+func (sc *syncContext) pruneMoveSource(syncTasks []syncTask) bool {
+	src := sc.moveSource
+	if src == nil || len(sc.syncRes.MovedResources) > 0 {
+		return true
+	}
+	healthy, err := sc.isWaveHealthy(syncTasks)
+	if err != nil {
+		sc.setOperationPhase(appv1.OperationError, fmt.Sprintf("failed to check health of the resources in the new destination: %v", err))
+		return false
+	}
+	if !healthy {
+		sc.setOperationPhase(appv1.OperationRunning, fmt.Sprintf("waiting for the resources in the new destination to become %s before pruning %s", appv1.HealthStatusHealthy, formatDestination(src.destination)))
+		return false
+	}
+	failed := false
+	for _, liveObj := range src.liveObjs {
+		resDetails := sc.pruneMovedObject(src, liveObj)
+		if resDetails.Status == appv1.ResourceDetailsSyncFailed {
+			failed = true
+		}
+		sc.syncRes.MovedResources = append(sc.syncRes.MovedResources, &resDetails)
+	}
+	if failed {
+		sc.setOperationPhase(appv1.OperationFailed, fmt.Sprintf("one or more objects failed to be pruned from %s", formatDestination(src.destination)))
+		return false
+	}
+	return true
+}
+
+// pruneMovedObject deletes an object of a moved application from its previous destination
+func (sc *syncContext) pruneMovedObject(src *moveSource, liveObj *unstructured.Unstructured) appv1.ResourceDetails {
+	resDetails := appv1.ResourceDetails{
+		Name:      liveObj.GetName(),
+		Kind:      liveObj.GetKind(),
+		Namespace: liveObj.GetNamespace(),
+	}
+	if argo.HasSyncOption(liveObj, common.SyncOptionDisablePrune) {
+		resDetails.Message = "skipped (prune disabled)"
+		resDetails.Status = appv1.ResourceDetailsSynced
+		return resDetails
+	}
+	if kube.IsCRD(liveObj) && !sc.syncOp.ConfirmCRDDeletion {
+		if err := verifyCRDDeletion(src.dynamicIf, liveObj, sc.appName); err != nil {
+			resDetails.Message = err.Error()
+			resDetails.Status = appv1.ResourceDetailsSyncFailed
+			return resDetails
+		}
+	}
+	propagationPolicy, err := sc.syncOp.PrunePropagationPolicy.DeletionPropagation()
+	if err == nil {
+		err = sc.kubectl.DeleteResource(src.config, liveObj, src.destination.Namespace, metav1.DeleteOptions{PropagationPolicy: &propagationPolicy})
+	}
+	if err != nil {
+		resDetails.Message = err.Error()
+		resDetails.Status = appv1.ResourceDetailsSyncFailed
+		return resDetails
+	}
+	resDetails.Message = fmt.Sprintf("pruned from %s", formatDestination(src.destination))
+	resDetails.Status = appv1.ResourceDetailsSyncedAndPruned
+	return resDetails
+}
+
+// formatDestination formats a destination as <server>/<namespace>
+func formatDestination(dest appv1.ApplicationDestination) string {
+	return fmt.Sprintf("%s/%s", dest.Server, dest.Namespace)
+}
@@ -0,0 +1,232 @@
+package controller
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"sort"
+	"sync"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/client-go/tools/cache"
+
+	"github.com/argoproj/argo-cd/common"
+	appv1 "github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/util/argo"
+	"github.com/argoproj/argo-cd/util/kube"
+)
+
+// orphanedResourcesRefreshInterval is the period of the refreshes of the orphaned resources of the
+// applications, which list every namespaced resource type of their destination namespaces, so are not
+// done by every refresh of the applications
+const orphanedResourcesRefreshInterval = 5 * time.Minute
+
+// orphanedResourcesCache holds the orphaned resources of the applications found by the latest periodic
+// refresh, by application name
+type orphanedResourcesCache struct {
+	lock sync.Mutex
+	apps map[string]orphanedResourcesState
+}
+
+// orphanedResourcesState is the orphaned resources of an application, and its warning condition
+type orphanedResourcesState struct {
+	resources []appv1.OrphanedResource
+	condition *appv1.ApplicationCondition
+}
+
+func newOrphanedResourcesCache() *orphanedResourcesCache {
+	return &orphanedResourcesCache{apps: make(map[string]orphanedResourcesState)}
+}
+
+// getOrphanedResources returns the orphaned resources of the application found by the latest periodic
+// refresh, and its warning condition. Until the first refresh of the application, the orphaned
+// resources of its status are kept, along with their warning condition.
+func (ctrl *ApplicationController) getOrphanedResources(app *appv1.Application) ([]appv1.OrphanedResource, *appv1.ApplicationCondition) {
+	ctrl.orphaned.lock.Lock()
+	state, ok := ctrl.orphaned.apps[app.Name]
+	ctrl.orphaned.lock.Unlock()
+	if ok {
+		return state.resources, state.condition
+	}
+	if len(app.Status.OrphanedResources) == 0 {
+		return nil, nil
+	}
+	proj, err := argo.GetAppProject(&app.Spec, ctrl.applicationClientset, ctrl.namespace)
+	if err != nil || proj.Spec.OrphanedResources == nil {
+		return nil, nil
+	}
+	return app.Status.OrphanedResources, orphanedResourcesCondition(proj, app.Status.OrphanedResources)
+}
+
+// refreshOrphanedResourcesOfApps refreshes the orphaned resources of every application, and requests a
+// refresh of the applications whose orphaned resources changed, so that their status is updated. The
+// previous orphaned resources of an application are kept if the resources of its destination
+// namespaces cannot be listed.
+func (ctrl *ApplicationController) refreshOrphanedResourcesOfApps() {
+	appNames := make(map[string]bool)
+	for _, obj := range ctrl.appInformer.GetIndexer().List() {
+		app, ok := obj.(*appv1.Application)
+		if !ok {
+			continue
+		}
+		appNames[app.Name] = true
+		resolvedApp, err := ctrl.appStateManager.ResolveDestinations(app)
+		if err != nil {
+			continue
+		}
+		resources, condition, err := ctrl.refreshOrphanedResources(resolvedApp)
+		if err != nil {
+			log.WithField("application", app.Name).Warnf("Failed to refresh the orphaned resources: %v", err)
+			continue
+		}
+		ctrl.orphaned.lock.Lock()
+		ctrl.orphaned.apps[app.Name] = orphanedResourcesState{resources: resources, condition: condition}
+		ctrl.orphaned.lock.Unlock()
+		if orphanedResourcesChanged(app, resources, condition) {
+			key, err := cache.MetaNamespaceKeyFunc(app)
+			if err == nil {
+				ctrl.forceAppRefresh(app.Name)
+				ctrl.appRefreshQueue.Add(key)
+			}
+		}
+	}
+	ctrl.orphaned.lock.Lock()
+	defer ctrl.orphaned.lock.Unlock()
+	for appName := range ctrl.orphaned.apps {
+		if !appNames[appName] {
+			delete(ctrl.orphaned.apps, appName)
+		}
+	}
+}
+
+// orphanedResourcesChanged returns whether the orphaned resources, or their warning condition, differ
+// from the status of the application
+func orphanedResourcesChanged(app *appv1.Application, resources []appv1.OrphanedResource, condition *appv1.ApplicationCondition) bool {
+	if len(resources) != len(app.Status.OrphanedResources) || len(resources) > 0 && !reflect.DeepEqual(resources, app.Status.OrphanedResources) {
+		return true
+	}
+	var current *appv1.ApplicationCondition
+	for i := range app.Status.Conditions {
+		if app.Status.Conditions[i].Type == appv1.ApplicationConditionOrphanedResourceWarning {
+			current = &app.Status.Conditions[i]
+		}
+	}
+	return !reflect.DeepEqual(current, condition)
+}
+
+// refreshOrphanedResources returns the orphaned resources of the destination namespaces of the
+// application, and a warning condition if there are any, if the project of the application monitors
+// orphaned resources
+func (ctrl *ApplicationController) refreshOrphanedResources(app *appv1.Application) ([]appv1.OrphanedResource, *appv1.ApplicationCondition, error) {
+	proj, err := argo.GetAppProject(&app.Spec, ctrl.applicationClientset, ctrl.namespace)
+	if err != nil || proj.Spec.OrphanedResources == nil {
+		return nil, nil, nil
+	}
+	appNames := make(map[string]bool)
+	for _, obj := range ctrl.appInformer.GetIndexer().List() {
+		if otherApp, ok := obj.(*appv1.Application); ok {
+			appNames[otherApp.Name] = true
+		}
+	}
+	orphaned := make([]appv1.OrphanedResource, 0)
+	visited := make(map[appv1.ApplicationDestination]bool)
+	for _, dest := range app.Spec.GetDestinations() {
+		if dest.Namespace == "" || visited[dest] {
+			continue
+		}
+		visited[dest] = true
+		objs, err := ctrl.getNamespaceResources(dest)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to list the resources of namespace %s: %v", dest.Namespace, err)
+		}
+		exclusions, err := resourceExclusions(ctrl.settingsMgr, &ctrl.settings, dest.Server)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to read the resource exclusions of cluster %s: %v", dest.Server, err)
+		}
+		objs, _ = filterExcludedObjs(objs, exclusions)
+		orphaned = append(orphaned, orphanedResources(objs, appNames)...)
+	}
+	sort.Slice(orphaned, func(i, j int) bool {
+		a, b := orphaned[i], orphaned[j]
+		if a.Namespace != b.Namespace {
+			return a.Namespace < b.Namespace
+		}
+		if a.Group != b.Group {
+			return a.Group < b.Group
+		}
+		if a.Kind != b.Kind {
+			return a.Kind < b.Kind
+		}
+		return a.Name < b.Name
+	})
+	return orphaned, orphanedResourcesCondition(proj, orphaned), nil
+}
+
+// orphanedResourcesCondition returns the warning condition of the orphaned resources, if the project
+// warns about them
+func orphanedResourcesCondition(proj *appv1.AppProject, orphaned []appv1.OrphanedResource) *appv1.ApplicationCondition {
+	if len(orphaned) == 0 || !proj.Spec.OrphanedResources.IsWarn() {
+		return nil
+	}
+	return &appv1.ApplicationCondition{
+		Type:    appv1.ApplicationConditionOrphanedResourceWarning,
+		Message: fmt.Sprintf("Application has %d orphaned resources", len(orphaned)),
+	}
+}
+
+// getNamespaceResources returns the resources of the namespace of the destination
+func (ctrl *ApplicationController) getNamespaceResources(dest appv1.ApplicationDestination) ([]*unstructured.Unstructured, error) {
+	clst, err := ctrl.db.GetCluster(context.Background(), dest.Server)
+	if err != nil {
+		return nil, err
+	}
+	return kube.GetNamespaceResources(kube.ClusterRESTConfig(clst), dest.Namespace)
+}
+
+// orphanedResources returns the resources which are not managed by any of the applications, nor created
+// by another resource or by kubernetes
+func orphanedResources(objs []*unstructured.Unstructured, appNames map[string]bool) []appv1.OrphanedResource {
+	var orphaned []appv1.OrphanedResource
+	for _, obj := range objs {
+		if !isOrphanedResource(obj, appNames) {
+			continue
+		}
+		gvk := obj.GroupVersionKind()
+		orphaned = append(orphaned, appv1.OrphanedResource{
+			Group:     gvk.Group,
+			Kind:      gvk.Kind,
+			Namespace: obj.GetNamespace(),
+			Name:      obj.GetName(),
+		})
+	}
+	return orphaned
+}
+
+func isOrphanedResource(obj *unstructured.Unstructured, appNames map[string]bool) bool {
+	if appName, ok := obj.GetLabels()[common.LabelApplicationName]; ok && appNames[appName] {
+		return false
+	}
+	// children of other resources (e.g. the pods of replica sets) are not orphaned themselves
+	if len(obj.GetOwnerReferences()) > 0 {
+		return false
+	}
+	gvk := obj.GroupVersionKind()
+	if gvk.Group != "" {
+		return true
+	}
+	// resources which kubernetes creates in every namespace, or for other resources
+	switch gvk.Kind {
+	case kube.EndpointsKind:
+		return false
+	case "ServiceAccount":
+		return obj.GetName() != "default"
+	case "ConfigMap":
+		return obj.GetName() != "kube-root-ca.crt"
+	case kube.SecretKind:
+		secretType, _, _ := unstructured.NestedString(obj.Object, "type")
+		return secretType != "kubernetes.io/service-account-token"
+	}
+	return true
+}
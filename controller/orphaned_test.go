@@ -0,0 +1,87 @@
+package controller
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/argoproj/argo-cd/common"
+	argoappv1 "github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1"
+)
+
+func TestOrphanedResources(t *testing.T) {
+	newObj := func(apiVersion, kind, name string) *unstructured.Unstructured {
+		obj := &unstructured.Unstructured{}
+		obj.SetAPIVersion(apiVersion)
+		obj.SetKind(kind)
+		obj.SetNamespace("default")
+		obj.SetName(name)
+		return obj
+	}
+	managed := newPod()
+	managed.SetLabels(map[string]string{common.LabelApplicationName: "my-app"})
+	deletedApp := newObj("v1", "ConfigMap", "deleted-app-config")
+	deletedApp.SetLabels(map[string]string{common.LabelApplicationName: "deleted-app"})
+	child := newObj("v1", "Pod", "guestbook-ui-5d8f7c9b6-x2k4j")
+	child.SetOwnerReferences([]metav1.OwnerReference{{APIVersion: "apps/v1", Kind: "ReplicaSet", Name: "guestbook-ui-5d8f7c9b6"}})
+	tokenSecret := newObj("v1", "Secret", "default-token-x2k4j")
+	tokenSecret.Object["type"] = "kubernetes.io/service-account-token"
+	objs := []*unstructured.Unstructured{
+		managed,
+		deletedApp,
+		child,
+		newObj("v1", "Endpoints", "guestbook-ui"),
+		newObj("v1", "ServiceAccount", "default"),
+		tokenSecret,
+		newObj("apps/v1", "Deployment", "manual"),
+	}
+	orphaned := orphanedResources(objs, map[string]bool{"my-app": true})
+	assert.Equal(t, []argoappv1.OrphanedResource{
+		{Kind: "ConfigMap", Namespace: "default", Name: "deleted-app-config"},
+		{Group: "apps", Kind: "Deployment", Namespace: "default", Name: "manual"},
+	}, orphaned)
+}
+
+func TestRefreshOrphanedResourcesNotMonitored(t *testing.T) {
+	app := newFakeApp()
+	proj := &argoappv1.AppProject{ObjectMeta: metav1.ObjectMeta{Name: "default", Namespace: "argocd"}}
+	ctrl := newFakeController(app, proj)
+	orphaned, cond, err := ctrl.refreshOrphanedResources(app)
+	assert.NoError(t, err)
+	assert.Nil(t, orphaned)
+	assert.Nil(t, cond)
+}
+
+func TestGetOrphanedResources(t *testing.T) {
+	app := newFakeApp()
+	app.Status.OrphanedResources = []argoappv1.OrphanedResource{{Kind: "ConfigMap", Namespace: "default", Name: "manual-config"}}
+	proj := &argoappv1.AppProject{
+		ObjectMeta: metav1.ObjectMeta{Name: "default", Namespace: "argocd"},
+		Spec:       argoappv1.AppProjectSpec{OrphanedResources: &argoappv1.OrphanedResourcesMonitorSettings{}},
+	}
+	ctrl := newFakeController(app, proj)
+
+	// the orphaned resources of the status, and their warning, are kept until the first refresh
+	orphaned, cond := ctrl.getOrphanedResources(app)
+	assert.Equal(t, app.Status.OrphanedResources, orphaned)
+	if assert.NotNil(t, cond) {
+		assert.Equal(t, argoappv1.ApplicationConditionOrphanedResourceWarning, cond.Type)
+	}
+	assert.True(t, orphanedResourcesChanged(app, orphaned, cond))
+	app.Status.Conditions = []argoappv1.ApplicationCondition{*cond}
+	assert.False(t, orphanedResourcesChanged(app, orphaned, cond))
+
+	ctrl.orphaned.apps[app.Name] = orphanedResourcesState{}
+	orphaned, cond = ctrl.getOrphanedResources(app)
+	assert.Empty(t, orphaned)
+	assert.Nil(t, cond)
+	assert.True(t, orphanedResourcesChanged(app, orphaned, cond))
+}
+
+func TestOrphanedResourcesMonitorSettings(t *testing.T) {
+	warn := false
+	assert.True(t, (&argoappv1.OrphanedResourcesMonitorSettings{}).IsWarn())
+	assert.False(t, (&argoappv1.OrphanedResourcesMonitorSettings{Warn: &warn}).IsWarn())
+}
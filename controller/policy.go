@@ -0,0 +1,78 @@
+package controller
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	apierr "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	appv1 "github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/util/policy"
+)
+
+// policyChecker returns the checker of the policy service of the cached settings, which is consulted
+// before syncs, and whether syncs are allowed if the service cannot be consulted. Returns a nil
+// checker if no policy service is configured.
+func (s *appStateManager) policyChecker() (policy.Checker, bool, error) {
+	if s.settingsMgr == nil {
+		return nil, false, nil
+	}
+	settings, err := s.getSettings()
+	if settings == nil {
+		if apierr.IsNotFound(err) {
+			return nil, false, nil
+		}
+		return nil, false, err
+	}
+	// the policy service is set in argocd-cm, so errors reading argocd-secret do not matter
+	if settings.Policy == nil {
+		return nil, false, nil
+	}
+	checker, err := policy.NewChecker(settings.Policy)
+	if err != nil {
+		return nil, false, err
+	}
+	return checker, settings.Policy.FailOpen, nil
+}
+
+// checkPolicy consults the policy service with the manifests of the sync tasks. If the sync is
+// denied, the reasons are recorded in the sync result and the operation fails. Returns whether the
+// sync is allowed.
+func (sc *syncContext) checkPolicy(syncTasks []syncTask) bool {
+	if sc.policy == nil {
+		return true
+	}
+	input := policy.Input{
+		Application: sc.appName,
+		Project:     sc.proj.Name,
+		Destination: appv1.ApplicationDestination{Server: sc.server, Namespace: sc.namespace},
+		Revision:    sc.syncRes.Revision,
+		Manifests:   make([]*unstructured.Unstructured, 0),
+	}
+	for _, task := range syncTasks {
+		if task.targetObj != nil {
+			input.Manifests = append(input.Manifests, task.targetObj)
+		}
+	}
+	decision, err := sc.policy.Check(context.Background(), input)
+	if err != nil {
+		if sc.policyFailOpen {
+			sc.log.Warnf("Failed to consult policy service, allowing sync: %v", err)
+			return true
+		}
+		sc.setOperationPhase(appv1.OperationError, fmt.Sprintf("failed to consult policy service: %v", err))
+		return false
+	}
+	if !decision.Allowed {
+		sc.syncRes.DeniedReasons = decision.Reasons
+		message := "sync denied by policy"
+		if len(decision.Reasons) > 0 {
+			message = fmt.Sprintf("%s: %s", message, strings.Join(decision.Reasons, "; "))
+		}
+		sc.setOperationPhase(appv1.OperationFailed, message)
+		return false
+	}
+	return true
+}
@@ -0,0 +1,87 @@
+package controller
+
+import (
+	"fmt"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	appv1 "github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/util/health"
+)
+
+// progressingDeadline marks resources Degraded once they have been Progressing for longer than the
+// progressing deadline of their application
+type progressingDeadline struct {
+	deadline time.Duration
+	// previous is the last refreshed health of the resources, which holds the time they became Progressing
+	previous []appv1.ResourceSummary
+	// startedAt is the start of the operation which waits for the resources, if any. Resources which
+	// were already Progressing are considered Progressing since the operation started.
+	startedAt time.Time
+}
+
+// newProgressingDeadline returns the progressing deadline of the application. Invalid deadlines are
+// reported by the spec conditions of the application, and are ignored.
+func newProgressingDeadline(app *appv1.Application) progressingDeadline {
+	deadline, _ := app.Spec.ProgressingDeadlineDuration()
+	return progressingDeadline{deadline: deadline, previous: app.Status.ComparisonResult.Resources}
+}
+
+// apply records the time the resource became Progressing in its health, and marks it Degraded if it
+// has been Progressing for longer than the deadline. Returns whether the deadline expired.
+func (d *progressingDeadline) apply(group, kind, namespace, name string, healthStatus *appv1.HealthStatus, now time.Time) bool {
+	var previous *appv1.HealthStatus
+	for i := range d.previous {
+		res := d.previous[i]
+		if res.Group == group && res.Kind == kind && res.Namespace == namespace && res.Name == name {
+			previous = &d.previous[i].Health
+			break
+		}
+	}
+	if previous != nil && previous.ProgressingSince != nil && previous.ProgressingSince.Time.Before(d.startedAt) {
+		since := metav1.NewTime(d.startedAt)
+		previous = &appv1.HealthStatus{Status: previous.Status, ProgressingSince: &since}
+	}
+	return health.SetProgressingDeadline(healthStatus, previous, d.deadline, now)
+}
+
+// expiredProgressingDeadline returns a message describing the first applied resource of the sync
+// tasks which has been Progressing for longer than the progressing deadline of the application, or
+// an empty string if there is none
+func (sc *syncContext) expiredProgressingDeadline(syncTasks []syncTask) (string, error) {
+	if sc.progressingDeadline.deadline <= 0 {
+		return "", nil
+	}
+	now := time.Now()
+	for _, task := range syncTasks {
+		if task.targetObj == nil || task.liveObj == nil || isHook(task.targetObj) {
+			continue
+		}
+		healthState, err := health.GetAppHealth(sc.kubectl, task.liveObj)
+		if err != nil {
+			return "", err
+		}
+		if sc.progressingDeadline.apply(task.liveObj.GroupVersionKind().Group, task.liveObj.GetKind(), task.liveObj.GetNamespace(), task.liveObj.GetName(), healthState, now) {
+			return progressingDeadlineMessage(task.liveObj.GetKind(), task.liveObj.GetName(), sc.progressingDeadline.deadline), nil
+		}
+	}
+	return "", nil
+}
+
+// expiredProgressingDeadlineResource returns the first resource of the comparison which was Degraded
+// by the progressing deadline, or nil if there is none
+func expiredProgressingDeadlineResource(comparison *appv1.ComparisonResult) *appv1.ResourceSummary {
+	for i := range comparison.Resources {
+		res := comparison.Resources[i]
+		if res.Health.Status == appv1.HealthStatusDegraded && res.Health.ProgressingSince != nil {
+			return &comparison.Resources[i]
+		}
+	}
+	return nil
+}
+
+// progressingDeadlineMessage describes a resource which has been Progressing for longer than the deadline
+func progressingDeadlineMessage(kind, name string, deadline time.Duration) string {
+	return fmt.Sprintf("%s '%s' has been %s for longer than the progressing deadline of %s", kind, name, appv1.HealthStatusProgressing, deadline)
+}
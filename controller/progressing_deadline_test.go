@@ -0,0 +1,110 @@
+package controller
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1"
+)
+
+const pendingClaim = `{"apiVersion":"v1","kind":"PersistentVolumeClaim","metadata":{"name":"data"},"status":{"phase":"Pending"}}`
+
+// progressingClaim returns the previous status of a claim which has been Progressing for the duration
+func progressingClaim(progressingFor time.Duration) []v1alpha1.ResourceSummary {
+	since := v1.NewTime(time.Now().Add(-progressingFor))
+	return []v1alpha1.ResourceSummary{{
+		Version: "v1",
+		Kind:    "PersistentVolumeClaim",
+		Name:    "data",
+		Health:  v1alpha1.HealthStatus{Status: v1alpha1.HealthStatusProgressing, ProgressingSince: &since},
+	}}
+}
+
+func TestSetApplicationHealthProgressingDeadline(t *testing.T) {
+	newComparison := func() (*v1alpha1.ComparisonResult, []v1alpha1.ResourceState) {
+		comparison := &v1alpha1.ComparisonResult{Resources: []v1alpha1.ResourceSummary{{Version: "v1", Kind: "PersistentVolumeClaim", Name: "data"}}}
+		return comparison, []v1alpha1.ResourceState{{LiveState: pendingClaim}}
+	}
+
+	// the time the resource became Progressing is recorded
+	comparison, resources := newComparison()
+	healthState, err := setApplicationHealth(mockKubectlCmd{}, &progressingDeadline{deadline: 10 * time.Minute}, comparison, resources)
+	assert.NoError(t, err)
+	assert.Equal(t, v1alpha1.HealthStatusProgressing, healthState.Status)
+	assert.NotNil(t, comparison.Resources[0].Health.ProgressingSince)
+
+	comparison, resources = newComparison()
+	previous := progressingClaim(15 * time.Minute)
+	healthState, err = setApplicationHealth(mockKubectlCmd{}, &progressingDeadline{deadline: 10 * time.Minute, previous: previous}, comparison, resources)
+	assert.NoError(t, err)
+	assert.Equal(t, v1alpha1.HealthStatusDegraded, healthState.Status)
+	assert.Equal(t, v1alpha1.HealthStatusDegraded, comparison.Resources[0].Health.Status)
+	assert.Equal(t, previous[0].Health.ProgressingSince, comparison.Resources[0].Health.ProgressingSince)
+	assert.Equal(t, &comparison.Resources[0], expiredProgressingDeadlineResource(comparison))
+
+	// without a deadline, resources may be Progressing indefinitely
+	comparison, resources = newComparison()
+	healthState, err = setApplicationHealth(mockKubectlCmd{}, &progressingDeadline{previous: previous}, comparison, resources)
+	assert.NoError(t, err)
+	assert.Equal(t, v1alpha1.HealthStatusProgressing, healthState.Status)
+	assert.Nil(t, expiredProgressingDeadlineResource(comparison))
+}
+
+func TestSyncWaveProgressingDeadline(t *testing.T) {
+	syncCtx := newTestSyncCtx(&v1.APIResourceList{
+		GroupVersion: "v1",
+		APIResources: []v1.APIResource{{Kind: "PersistentVolumeClaim", Namespaced: true}},
+	})
+	syncCtx.kubectl = mockKubectlCmd{}
+	syncCtx.progressingDeadline = progressingDeadline{deadline: 10 * time.Minute, previous: progressingClaim(5 * time.Minute)}
+	syncCtx.resources = []v1alpha1.ResourceState{{
+		TargetState: `{"kind":"service","metadata":{"name":"frontend","annotations":{"argocd.argoproj.io/sync-wave":"1"}}}`,
+	}, {
+		TargetState: pendingClaim,
+	}}
+	syncCtx.sync()
+	assert.Len(t, syncCtx.syncRes.Resources, 1)
+
+	// the next wave waits while the claim is Progressing within the deadline
+	syncCtx.resources[1].LiveState = pendingClaim
+	syncCtx.sync()
+	assert.Equal(t, v1alpha1.OperationRunning, syncCtx.opState.Phase)
+	assert.Contains(t, syncCtx.opState.Message, "waiting for sync wave 0")
+
+	syncCtx.progressingDeadline.previous = progressingClaim(15 * time.Minute)
+	syncCtx.sync()
+	assert.Equal(t, v1alpha1.OperationFailed, syncCtx.opState.Phase)
+	assert.Equal(t, "sync wave 0 did not become Healthy: PersistentVolumeClaim 'data' has been Progressing for longer than the progressing deadline of 10m0s", syncCtx.opState.Message)
+	assert.Len(t, syncCtx.syncRes.Resources, 1)
+}
+
+func TestProgressingDeadlineSinceOperationStart(t *testing.T) {
+	now := time.Now()
+	deadline := progressingDeadline{deadline: 10 * time.Minute, previous: progressingClaim(15 * time.Minute), startedAt: now.Add(-5 * time.Minute)}
+
+	// the claim was Progressing before the operation started, which only waits for 5 minutes yet
+	healthState := &v1alpha1.HealthStatus{Status: v1alpha1.HealthStatusProgressing}
+	assert.False(t, deadline.apply("", "PersistentVolumeClaim", "", "data", healthState, now))
+	assert.Equal(t, v1alpha1.HealthStatusProgressing, healthState.Status)
+	assert.Equal(t, deadline.startedAt.Unix(), healthState.ProgressingSince.Unix())
+
+	healthState = &v1alpha1.HealthStatus{Status: v1alpha1.HealthStatusProgressing}
+	assert.True(t, deadline.apply("", "PersistentVolumeClaim", "", "data", healthState, now.Add(6*time.Minute)))
+	assert.Equal(t, v1alpha1.HealthStatusDegraded, healthState.Status)
+}
+
+func TestProgressingDeadlineKeyedByNamespace(t *testing.T) {
+	now := time.Now()
+	deadline := progressingDeadline{deadline: 10 * time.Minute, previous: progressingClaim(15 * time.Minute)}
+
+	// a claim of the same name in another namespace has only just become Progressing
+	healthState := &v1alpha1.HealthStatus{Status: v1alpha1.HealthStatusProgressing}
+	assert.False(t, deadline.apply("", "PersistentVolumeClaim", "other-namespace", "data", healthState, now))
+	assert.Equal(t, now.Unix(), healthState.ProgressingSince.Unix())
+
+	healthState = &v1alpha1.HealthStatus{Status: v1alpha1.HealthStatusProgressing}
+	assert.True(t, deadline.apply("", "PersistentVolumeClaim", "", "data", healthState, now))
+}
@@ -0,0 +1,234 @@
+package controller
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	apiv1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/argoproj/argo-cd/common"
+	appv1 "github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/util/kube"
+)
+
+// checkQuota verifies that the resources of the sync tasks fit into the resource quotas of their
+// namespaces, so that the sync fails before anything is applied rather than after some resources
+// were rejected by the quota. Returns whether the sync may proceed.
+func (sc *syncContext) checkQuota(syncTasks []syncTask) bool {
+	if sc.syncPolicy.HasSyncOption(common.SyncOptionDisableQuotaCheck) {
+		return true
+	}
+	requested, err := sc.quotaRequests(syncTasks)
+	if err != nil {
+		sc.setOperationPhase(appv1.OperationError, fmt.Sprintf("failed to compute quota usage: %v", err))
+		return false
+	}
+	var violations []string
+	namespaces := make([]string, 0, len(requested))
+	for namespace := range requested {
+		namespaces = append(namespaces, namespace)
+	}
+	sort.Strings(namespaces)
+	for _, namespace := range namespaces {
+		quotaIf := sc.dynamicIf.Resource(schema.GroupVersionResource{Version: "v1", Resource: "resourcequotas"}).Namespace(namespace)
+		list, err := quotaIf.List(metav1.ListOptions{})
+		if err != nil {
+			sc.setOperationPhase(appv1.OperationError, fmt.Sprintf("failed to list resource quotas of namespace %s: %v", namespace, err))
+			return false
+		}
+		for i := range list.Items {
+			var quota apiv1.ResourceQuota
+			err = runtime.DefaultUnstructuredConverter.FromUnstructured(list.Items[i].Object, &quota)
+			if err != nil {
+				sc.setOperationPhase(appv1.OperationError, fmt.Sprintf("failed to read resource quota %s/%s: %v", namespace, list.Items[i].GetName(), err))
+				return false
+			}
+			violations = append(violations, quotaViolations(&quota, requested[namespace])...)
+		}
+	}
+	if len(violations) > 0 {
+		sc.setOperationPhase(appv1.OperationFailed, fmt.Sprintf("sync exceeds resource quota: %s", strings.Join(violations, "; ")))
+		return false
+	}
+	return true
+}
+
+// quotaViolations returns the resources of the quota which the requested usage would exceed. Quotas
+// with scopes are skipped, since the resources they track cannot be determined from the manifests.
+func quotaViolations(quota *apiv1.ResourceQuota, requested apiv1.ResourceList) []string {
+	if len(quota.Spec.Scopes) > 0 || quota.Spec.ScopeSelector != nil {
+		return nil
+	}
+	names := make([]string, 0, len(quota.Spec.Hard))
+	for name := range quota.Spec.Hard {
+		names = append(names, string(name))
+	}
+	sort.Strings(names)
+	var violations []string
+	for _, name := range names {
+		req, ok := requested[apiv1.ResourceName(name)]
+		if !ok || req.Sign() <= 0 {
+			continue
+		}
+		hard := quota.Spec.Hard[apiv1.ResourceName(name)]
+		used := quota.Status.Used[apiv1.ResourceName(name)]
+		total := used.DeepCopy()
+		total.Add(req)
+		if total.Cmp(hard) > 0 {
+			violations = append(violations, fmt.Sprintf("%s/%s: %s used %s + requested %s > hard %s",
+				quota.Namespace, quota.Name, name, used.String(), req.String(), hard.String()))
+		}
+	}
+	return violations
+}
+
+// quotaRequests returns the additional quota usage of the sync tasks per namespace: the usage of
+// the target resources minus the usage of the live resources they replace. Pruned resources are
+// not subtracted, since they are deleted after the other resources are applied.
+func (sc *syncContext) quotaRequests(syncTasks []syncTask) (map[string]apiv1.ResourceList, error) {
+	requested := make(map[string]apiv1.ResourceList)
+	for _, task := range syncTasks {
+		if task.targetObj == nil || isHook(task.targetObj) {
+			continue
+		}
+		namespace := sc.resourceNamespace(task.targetObj)
+		targetUsage, err := sc.quotaUsage(task.targetObj)
+		if err != nil {
+			return nil, err
+		}
+		if len(targetUsage) == 0 {
+			continue
+		}
+		if requested[namespace] == nil {
+			requested[namespace] = apiv1.ResourceList{}
+		}
+		addResources(requested[namespace], targetUsage, 1)
+		if task.liveObj != nil {
+			liveUsage, err := sc.quotaUsage(task.liveObj)
+			if err != nil {
+				return nil, err
+			}
+			addResources(requested[namespace], liveUsage, -1)
+		}
+	}
+	return requested, nil
+}
+
+// quotaUsage returns the usage of resource quotas by an object: the compute resources of its pods,
+// the storage of persistent volume claims and the object count
+func (sc *syncContext) quotaUsage(obj *unstructured.Unstructured) (apiv1.ResourceList, error) {
+	usage := apiv1.ResourceList{}
+	gvk := obj.GroupVersionKind()
+	if apiResource, err := kube.ServerResourceForGroupVersionKind(sc.disco, gvk); err == nil {
+		if !apiResource.Namespaced {
+			return nil, nil
+		}
+		countName := "count/" + apiResource.Name
+		if gvk.Group != "" {
+			countName += "." + gvk.Group
+		}
+		usage[apiv1.ResourceName(countName)] = *resource.NewQuantity(1, resource.DecimalSI)
+		if gvk.Group == "" {
+			switch apiResource.Name {
+			case "services", "secrets", "configmaps", "persistentvolumeclaims", "replicationcontrollers":
+				usage[apiv1.ResourceName(apiResource.Name)] = *resource.NewQuantity(1, resource.DecimalSI)
+			}
+		}
+	}
+
+	var podSpecPath []string
+	replicas := int64(1)
+	switch {
+	case gvk.Group == "" && gvk.Kind == "Pod":
+		podSpecPath = []string{"spec"}
+	case gvk.Group == "" && gvk.Kind == "ReplicationController",
+		(gvk.Group == "apps" || gvk.Group == "extensions") && (gvk.Kind == kube.DeploymentKind || gvk.Kind == kube.ReplicaSetKind || gvk.Kind == kube.StatefulSetKind):
+		podSpecPath = []string{"spec", "template", "spec"}
+		if val, ok, err := unstructured.NestedInt64(obj.Object, "spec", "replicas"); err == nil && ok {
+			replicas = val
+		}
+	case gvk.Group == "batch" && gvk.Kind == kube.JobKind:
+		podSpecPath = []string{"spec", "template", "spec"}
+		if val, ok, err := unstructured.NestedInt64(obj.Object, "spec", "parallelism"); err == nil && ok {
+			replicas = val
+		}
+	case gvk.Group == "" && gvk.Kind == kube.PersistentVolumeClaimKind:
+		storage, ok, err := unstructured.NestedString(obj.Object, "spec", "resources", "requests", "storage")
+		if err == nil && ok {
+			quantity, err := resource.ParseQuantity(storage)
+			if err != nil {
+				return nil, fmt.Errorf("invalid storage request of %s/%s: %v", gvk.Kind, obj.GetName(), err)
+			}
+			usage[apiv1.ResourceRequestsStorage] = quantity
+		}
+	}
+	if podSpecPath == nil || replicas <= 0 {
+		return usage, nil
+	}
+	podSpecObj, ok, err := unstructured.NestedMap(obj.Object, podSpecPath...)
+	if err != nil || !ok {
+		return usage, nil
+	}
+	var podSpec apiv1.PodSpec
+	err = runtime.DefaultUnstructuredConverter.FromUnstructured(podSpecObj, &podSpec)
+	if err != nil {
+		return nil, fmt.Errorf("invalid pod spec of %s/%s: %v", gvk.Kind, obj.GetName(), err)
+	}
+	usage[apiv1.ResourcePods] = *resource.NewQuantity(replicas, resource.DecimalSI)
+	addResources(usage, podComputeUsage(&podSpec), replicas)
+	return usage, nil
+}
+
+// podComputeUsage returns the compute resources of a pod as accounted by resource quotas: the sum of
+// its containers, or the largest init container if that is greater
+func podComputeUsage(podSpec *apiv1.PodSpec) apiv1.ResourceList {
+	containers := apiv1.ResourceList{}
+	for _, c := range podSpec.Containers {
+		addResources(containers, containerComputeUsage(c), 1)
+	}
+	for _, c := range podSpec.InitContainers {
+		for name, quantity := range containerComputeUsage(c) {
+			if current, ok := containers[name]; !ok || quantity.Cmp(current) > 0 {
+				containers[name] = quantity
+			}
+		}
+	}
+	return containers
+}
+
+func containerComputeUsage(c apiv1.Container) apiv1.ResourceList {
+	usage := apiv1.ResourceList{}
+	for name, quantity := range c.Resources.Requests {
+		switch name {
+		case apiv1.ResourceCPU, apiv1.ResourceMemory:
+			usage[name] = quantity
+			usage[apiv1.ResourceName("requests."+string(name))] = quantity
+		}
+	}
+	for name, quantity := range c.Resources.Limits {
+		switch name {
+		case apiv1.ResourceCPU, apiv1.ResourceMemory:
+			usage[apiv1.ResourceName("limits."+string(name))] = quantity
+		}
+	}
+	return usage
+}
+
+// addResources adds the quantities of the resources multiplied by the factor to the list
+func addResources(list apiv1.ResourceList, resources apiv1.ResourceList, factor int64) {
+	for name, quantity := range resources {
+		total := list[name]
+		// the quantity of the resources may share its decimal with the copy, so it must not be modified
+		scaled := quantity.DeepCopy()
+		product := scaled.AsDec()
+		product.Mul(product, resource.NewQuantity(factor, resource.DecimalSI).AsDec())
+		total.Add(*resource.NewDecimalQuantity(*product, quantity.Format))
+		list[name] = total
+	}
+}
@@ -0,0 +1,119 @@
+package controller
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/watch"
+
+	appv1 "github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/util/diff"
+	"github.com/argoproj/argo-cd/util/health"
+	"github.com/argoproj/argo-cd/util/kube"
+)
+
+// resourceChanges detects the changes of the live resources of applications which only touch their
+// status or the fields ignored by their application, so that they do not trigger a refresh of the
+// application. Controllers which update the status of their resources every few seconds would
+// otherwise cause refresh storms. Changes of the status which change the health of a resource are
+// not skipped, since they change the health of its application. Neither are the changes of hooks, nor
+// the changes of the resources of applications with an operation in progress, which waits for the
+// status of the resources, e.g. for a hook Job to complete.
+type resourceChanges struct {
+	kubectl kube.Kubectl
+	// getApp returns the application of the name, or nil if it does not exist
+	getApp func(appName string) *appv1.Application
+	// getNormalizer returns the normalizer of the fields ignored by the application
+	getNormalizer func(app *appv1.Application) (diff.Normalizer, error)
+	// hashes are the hashes of the compared fields of the resources, by UID
+	hashes map[types.UID]string
+	// normalizers are the normalizers of the applications, by name
+	normalizers map[string]appNormalizer
+}
+
+// appNormalizer is the normalizer of a version of an application
+type appNormalizer struct {
+	resourceVersion string
+	normalizer      diff.Normalizer
+}
+
+func newResourceChanges(kubectl kube.Kubectl, getApp func(appName string) *appv1.Application, getNormalizer func(app *appv1.Application) (diff.Normalizer, error)) *resourceChanges {
+	return &resourceChanges{
+		kubectl:       kubectl,
+		getApp:        getApp,
+		getNormalizer: getNormalizer,
+		hashes:        make(map[types.UID]string),
+		normalizers:   make(map[string]appNormalizer),
+	}
+}
+
+// changed records the event of a resource of the application, and returns whether the application
+// needs to be refreshed: unless the resource was modified, but only its status or ignored fields
+// changed since its previous event.
+func (c *resourceChanges) changed(eventType watch.EventType, obj *unstructured.Unstructured, appName string) bool {
+	uid := obj.GetUID()
+	if eventType == watch.Deleted || uid == "" || isHook(obj) || c.operationInProgress(appName) {
+		delete(c.hashes, uid)
+		return true
+	}
+	hash, err := c.hash(obj, appName)
+	if err != nil {
+		delete(c.hashes, uid)
+		return true
+	}
+	previous, ok := c.hashes[uid]
+	c.hashes[uid] = hash
+	return eventType != watch.Modified || !ok || previous != hash
+}
+
+// hash returns the hash of the resource without its status, version and the fields ignored by the
+// application, but with its health
+func (c *resourceChanges) hash(obj *unstructured.Unstructured, appName string) (string, error) {
+	healthStatus, err := health.GetAppHealth(c.kubectl, obj)
+	if err != nil {
+		return "", err
+	}
+	obj = obj.DeepCopy()
+	unstructured.RemoveNestedField(obj.Object, "status")
+	unstructured.RemoveNestedField(obj.Object, "metadata", "resourceVersion")
+	normalizer, err := c.normalizer(appName)
+	if err != nil {
+		return "", err
+	}
+	if normalizer != nil {
+		normalizer.Normalize(obj)
+	}
+	data, err := json.Marshal(obj.Object)
+	if err != nil {
+		return "", err
+	}
+	hash := sha256.Sum256(append(data, healthStatus.Status...))
+	return hex.EncodeToString(hash[:]), nil
+}
+
+// operationInProgress returns whether an operation of the application is in progress
+func (c *resourceChanges) operationInProgress(appName string) bool {
+	app := c.getApp(appName)
+	return app != nil && app.Operation != nil
+}
+
+// normalizer returns the normalizer of the application, which is cached as long as the application is
+// unchanged
+func (c *resourceChanges) normalizer(appName string) (diff.Normalizer, error) {
+	app := c.getApp(appName)
+	if app == nil {
+		return nil, nil
+	}
+	if cached, ok := c.normalizers[appName]; ok && cached.resourceVersion == app.ResourceVersion {
+		return cached.normalizer, nil
+	}
+	normalizer, err := c.getNormalizer(app)
+	if err != nil {
+		return nil, err
+	}
+	c.normalizers[appName] = appNormalizer{resourceVersion: app.ResourceVersion, normalizer: normalizer}
+	return normalizer, nil
+}
@@ -0,0 +1,87 @@
+package controller
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/watch"
+
+	"github.com/argoproj/argo-cd/common"
+	argoappv1 "github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/util/diff"
+	"github.com/argoproj/argo-cd/util/kube"
+)
+
+func TestResourceChanges(t *testing.T) {
+	app := &argoappv1.Application{
+		ObjectMeta: metav1.ObjectMeta{Name: "my-app", ResourceVersion: "1"},
+		Spec: argoappv1.ApplicationSpec{
+			IgnoreDifferences: []argoappv1.ResourceIgnoreDifferences{{Kind: "ConfigMap", JSONPointers: []string{"/data/ignored"}}},
+		},
+	}
+	normalized := 0
+	changes := newResourceChanges(kube.KubectlCmd{}, func(appName string) *argoappv1.Application {
+		return app
+	}, func(app *argoappv1.Application) (diff.Normalizer, error) {
+		normalized++
+		return diff.NewNormalizer(nil, app.Spec.IgnoreDifferences)
+	})
+	obj := newCachedObj(configMapGVK, "my-app-config", "my-app")
+	obj.SetUID("1")
+	obj.SetResourceVersion("1")
+	obj.Object["data"] = map[string]interface{}{"key": "value", "ignored": "value"}
+	assert.True(t, changes.changed(watch.Added, obj, "my-app"))
+
+	// status only changes are skipped
+	obj.SetResourceVersion("2")
+	obj.Object["status"] = map[string]interface{}{"observedAt": "now"}
+	assert.False(t, changes.changed(watch.Modified, obj, "my-app"))
+
+	// so are changes of the ignored fields
+	obj.SetResourceVersion("3")
+	assert.NoError(t, unstructured.SetNestedField(obj.Object, "changed", "data", "ignored"))
+	assert.False(t, changes.changed(watch.Modified, obj, "my-app"))
+	assert.Equal(t, 1, normalized)
+
+	obj.SetResourceVersion("4")
+	assert.NoError(t, unstructured.SetNestedField(obj.Object, "changed", "data", "key"))
+	assert.True(t, changes.changed(watch.Modified, obj, "my-app"))
+
+	assert.True(t, changes.changed(watch.Deleted, obj, "my-app"))
+	// the first modification of resources which are not known is not skipped
+	assert.True(t, changes.changed(watch.Modified, obj, "my-app"))
+}
+
+func TestResourceChangesOfHooksAndOperations(t *testing.T) {
+	app := &argoappv1.Application{ObjectMeta: metav1.ObjectMeta{Name: "my-app", ResourceVersion: "1"}}
+	changes := newResourceChanges(kube.KubectlCmd{}, func(appName string) *argoappv1.Application {
+		return app
+	}, func(app *argoappv1.Application) (diff.Normalizer, error) {
+		return nil, nil
+	})
+
+	// the completion of a hook Job resumes the operation which waits for it
+	hook := newCachedObj(schema.GroupVersionKind{Group: "batch", Version: "v1", Kind: "Job"}, "my-hook", "my-app")
+	hook.SetUID("1")
+	hook.SetAnnotations(map[string]string{common.AnnotationHook: "PostSync"})
+	hook.Object["status"] = map[string]interface{}{"active": int64(1)}
+	assert.True(t, changes.changed(watch.Added, hook, "my-app"))
+	hook.Object["status"] = map[string]interface{}{"succeeded": int64(1), "completionTime": "2019-01-01T00:00:00Z"}
+	assert.True(t, changes.changed(watch.Modified, hook, "my-app"))
+
+	// the status changes of resources are not skipped while an operation is in progress
+	obj := newCachedObj(configMapGVK, "my-app-config", "my-app")
+	obj.SetUID("2")
+	assert.True(t, changes.changed(watch.Added, obj, "my-app"))
+	app.Operation = &argoappv1.Operation{Sync: &argoappv1.SyncOperation{}}
+	obj.Object["status"] = map[string]interface{}{"observedAt": "now"}
+	assert.True(t, changes.changed(watch.Modified, obj, "my-app"))
+
+	app.Operation = nil
+	assert.True(t, changes.changed(watch.Modified, obj, "my-app"))
+	obj.Object["status"] = map[string]interface{}{"observedAt": "later"}
+	assert.False(t, changes.changed(watch.Modified, obj, "my-app"))
+}
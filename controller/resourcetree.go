@@ -0,0 +1,100 @@
+package controller
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/argoproj/argo-cd/controller/services"
+	appv1 "github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1"
+	cache_util "github.com/argoproj/argo-cd/util/cache"
+)
+
+// ResourceTree returns the tree of the live resources of the application as of its latest comparison
+func (ctrl *ApplicationController) ResourceTree(ctx context.Context, q *services.ResourcesQuery) (*appv1.ApplicationTree, error) {
+	if q.ApplicationName == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "application name is not specified")
+	}
+	tree := appv1.ApplicationTree{}
+	err := ctrl.appResources.Get(appTreeKey(*q.ApplicationName), &tree)
+	if err != nil && err != cache_util.ErrCacheMiss {
+		return nil, err
+	}
+	return &tree, nil
+}
+
+// setAppTree stores the tree of the live resources of the application
+func (ctrl *ApplicationController) setAppTree(appName string, resources []appv1.ResourceState) {
+	tree, err := buildApplicationTree(resources)
+	if err != nil {
+		log.Warnf("Unable to build app resource tree: %v", err)
+		return
+	}
+	err = ctrl.appResources.Set(&cache_util.Item{Object: tree, Key: appTreeKey(appName)})
+	if err != nil {
+		log.Warnf("Unable to save app resource tree in cache: %v", err)
+	}
+}
+
+func appTreeKey(appName string) string {
+	return fmt.Sprintf("tree|%s", appName)
+}
+
+// buildApplicationTree returns the tree of the live resources of an application. Each resource is
+// followed by the resources it controls, which reference it as their parent.
+func buildApplicationTree(resources []appv1.ResourceState) (*appv1.ApplicationTree, error) {
+	tree := &appv1.ApplicationTree{Nodes: make([]appv1.ResourceTreeNode, 0)}
+	for i := range resources {
+		liveObj, err := resources[i].LiveObject()
+		if err != nil {
+			return nil, err
+		}
+		if liveObj == nil {
+			continue
+		}
+		node := newResourceTreeNode(liveObj, nil)
+		health := resources[i].Health
+		node.Health = &health
+		tree.Nodes = append(tree.Nodes, node)
+		if err := addChildNodes(tree, node.ResourceRef, resources[i].ChildLiveResources); err != nil {
+			return nil, err
+		}
+	}
+	return tree, nil
+}
+
+func addChildNodes(tree *appv1.ApplicationTree, parent appv1.ResourceRef, children []appv1.ResourceNode) error {
+	for _, child := range children {
+		var obj unstructured.Unstructured
+		if err := json.Unmarshal([]byte(child.State), &obj); err != nil {
+			return err
+		}
+		node := newResourceTreeNode(&obj, []appv1.ResourceRef{parent})
+		tree.Nodes = append(tree.Nodes, node)
+		if err := addChildNodes(tree, node.ResourceRef, child.Children); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func newResourceTreeNode(obj *unstructured.Unstructured, parentRefs []appv1.ResourceRef) appv1.ResourceTreeNode {
+	gvk := obj.GroupVersionKind()
+	return appv1.ResourceTreeNode{
+		ResourceRef: appv1.ResourceRef{
+			Group:     gvk.Group,
+			Version:   gvk.Version,
+			Kind:      gvk.Kind,
+			Namespace: obj.GetNamespace(),
+			Name:      obj.GetName(),
+			UID:       string(obj.GetUID()),
+		},
+		ParentRefs:      parentRefs,
+		ResourceVersion: obj.GetResourceVersion(),
+	}
+}
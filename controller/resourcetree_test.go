@@ -0,0 +1,71 @@
+package controller
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+
+	argoappv1 "github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1"
+)
+
+func TestBuildApplicationTree(t *testing.T) {
+	newObj := func(apiVersion, kind, name string) *unstructured.Unstructured {
+		obj := &unstructured.Unstructured{}
+		obj.SetAPIVersion(apiVersion)
+		obj.SetKind(kind)
+		obj.SetNamespace("default")
+		obj.SetName(name)
+		obj.SetUID(types.UID(name))
+		return obj
+	}
+	toJSON := func(obj *unstructured.Unstructured) string {
+		data, err := json.Marshal(obj)
+		assert.NoError(t, err)
+		return string(data)
+	}
+	deploy := newObj("apps/v1", "Deployment", "guestbook-ui")
+	replicaSet := newObj("apps/v1", "ReplicaSet", "guestbook-ui-5d8f7c9b6")
+	replicaSet.SetOwnerReferences([]metav1.OwnerReference{{APIVersion: "apps/v1", Kind: "Deployment", Name: "guestbook-ui"}})
+	pod := newObj("v1", "Pod", "guestbook-ui-5d8f7c9b6-x2k4j")
+	service := newObj("v1", "Service", "guestbook-ui")
+	endpoints := newObj("v1", "Endpoints", "guestbook-ui")
+
+	tree, err := buildApplicationTree([]argoappv1.ResourceState{{
+		LiveState: toJSON(deploy),
+		Health:    argoappv1.HealthStatus{Status: argoappv1.HealthStatusHealthy},
+		ChildLiveResources: []argoappv1.ResourceNode{{
+			State:    toJSON(replicaSet),
+			Children: []argoappv1.ResourceNode{{State: toJSON(pod)}},
+		}},
+	}, {
+		LiveState:          toJSON(service),
+		Health:             argoappv1.HealthStatus{Status: argoappv1.HealthStatusHealthy},
+		ChildLiveResources: []argoappv1.ResourceNode{{State: toJSON(endpoints)}},
+	}, {
+		// resources which are missing from the cluster are not part of the tree
+		LiveState: "null",
+		Health:    argoappv1.HealthStatus{Status: argoappv1.HealthStatusMissing},
+	}})
+	assert.NoError(t, err)
+
+	deployRef := argoappv1.ResourceRef{Group: "apps", Version: "v1", Kind: "Deployment", Namespace: "default", Name: "guestbook-ui", UID: "guestbook-ui"}
+	replicaSetRef := argoappv1.ResourceRef{Group: "apps", Version: "v1", Kind: "ReplicaSet", Namespace: "default", Name: "guestbook-ui-5d8f7c9b6", UID: "guestbook-ui-5d8f7c9b6"}
+	serviceRef := argoappv1.ResourceRef{Version: "v1", Kind: "Service", Namespace: "default", Name: "guestbook-ui", UID: "guestbook-ui"}
+	if assert.Len(t, tree.Nodes, 5) {
+		assert.Equal(t, deployRef, tree.Nodes[0].ResourceRef)
+		assert.Empty(t, tree.Nodes[0].ParentRefs)
+		assert.Equal(t, argoappv1.HealthStatusHealthy, tree.Nodes[0].Health.Status)
+		assert.Equal(t, replicaSetRef, tree.Nodes[1].ResourceRef)
+		assert.Equal(t, []argoappv1.ResourceRef{deployRef}, tree.Nodes[1].ParentRefs)
+		assert.Nil(t, tree.Nodes[1].Health)
+		assert.Equal(t, "Pod", tree.Nodes[2].Kind)
+		assert.Equal(t, []argoappv1.ResourceRef{replicaSetRef}, tree.Nodes[2].ParentRefs)
+		assert.Equal(t, serviceRef, tree.Nodes[3].ResourceRef)
+		assert.Equal(t, "Endpoints", tree.Nodes[4].Kind)
+		assert.Equal(t, []argoappv1.ResourceRef{serviceRef}, tree.Nodes[4].ParentRefs)
+	}
+}
@@ -0,0 +1,222 @@
+package controller
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	apierr "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/argoproj/argo-cd/common"
+	appv1 "github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1"
+)
+
+// HistoryRetention controls how long the deployment history and the operation state of
+// applications are kept
+type HistoryRetention struct {
+	// Limit is the max number of deployments kept in the history. Defaults to 5
+	Limit int
+	// MaxAge is the duration after which deployments are removed from the history, unless zero.
+	// The latest deployment is always kept
+	MaxAge time.Duration
+	// CompactAfter is the duration after which the results of the resources and hooks of a completed
+	// operation are compacted to a summary, unless zero
+	CompactAfter time.Duration
+	// OperationHistoryLimit is the max number of completed operations kept in the operation history of
+	// an application. The operation history is not kept if zero
+	OperationHistoryLimit int
+}
+
+// forApp returns the retention of an application, whose annotation may override the duration after
+// which its completed operations are compacted
+func (r HistoryRetention) forApp(app *appv1.Application) HistoryRetention {
+	value, ok := app.Annotations[common.AnnotationKeyOperationCompactAfter]
+	if !ok {
+		return r
+	}
+	compactAfter, err := time.ParseDuration(value)
+	if err != nil || compactAfter < 0 {
+		log.WithField("application", app.Name).Warnf("Ignoring invalid %s annotation '%s'", common.AnnotationKeyOperationCompactAfter, value)
+		return r
+	}
+	r.CompactAfter = compactAfter
+	return r
+}
+
+// trimHistory returns the deployments of the history which are retained, and the ones which are
+// removed
+func (r HistoryRetention) trimHistory(history []appv1.DeploymentInfo, now time.Time) ([]appv1.DeploymentInfo, []appv1.DeploymentInfo) {
+	limit := r.Limit
+	if limit <= 0 {
+		limit = maxHistoryCnt
+	}
+	start := 0
+	if len(history) > limit {
+		start = len(history) - limit
+	}
+	if r.MaxAge > 0 {
+		for start < len(history)-1 && history[start].DeployedAt.Add(r.MaxAge).Before(now) {
+			start++
+		}
+	}
+	return history[start:], history[:start]
+}
+
+// compactOperationState replaces the results of the resources and hooks of an operation which
+// completed before CompactAfter with a summary, in the sync result of the operation and in the sync
+// results of its destinations, and clears its failed attempts, whose number the message of the
+// operation reports. Returns whether the operation state was compacted.
+func (r HistoryRetention) compactOperationState(state *appv1.OperationState, now time.Time) bool {
+	if r.CompactAfter <= 0 || state == nil || !state.Phase.Completed() || state.FinishedAt == nil {
+		return false
+	}
+	if !state.FinishedAt.Add(r.CompactAfter).Before(now) {
+		return false
+	}
+	compacted := compactSyncResult(state.SyncResult)
+	if len(state.Attempts) > 0 {
+		state.Attempts = nil
+		compacted = true
+	}
+	for i := range state.DestinationResults {
+		if compactSyncResult(state.DestinationResults[i].SyncResult) {
+			compacted = true
+		}
+	}
+	return compacted
+}
+
+// appendOperationHistory returns the operation history with the completed operation appended, and its
+// oldest operations removed beyond the limit. The results of the resources and hooks of the operation
+// are compacted to a summary, but its failed attempts are kept.
+func (r HistoryRetention) appendOperationHistory(history []appv1.OperationState, state *appv1.OperationState) []appv1.OperationState {
+	if r.OperationHistoryLimit <= 0 {
+		return nil
+	}
+	entry := state.DeepCopy()
+	compactSyncResult(entry.SyncResult)
+	for i := range entry.DestinationResults {
+		compactSyncResult(entry.DestinationResults[i].SyncResult)
+	}
+	history = append(append([]appv1.OperationState{}, history...), *entry)
+	if len(history) > r.OperationHistoryLimit {
+		history = history[len(history)-r.OperationHistoryLimit:]
+	}
+	return history
+}
+
+// compactSyncResult replaces the results of the resources, hooks and moved resources of a sync with
+// a summary, and drops the failed resources kept for retries. Returns whether the sync result was
+// compacted.
+func compactSyncResult(res *appv1.SyncOperationResult) bool {
+	if res == nil {
+		return false
+	}
+	compacted := false
+	if len(res.Resources) > 0 || len(res.Hooks) > 0 || len(res.MovedResources) > 0 {
+		resourceCounts := make(map[string]int)
+		for _, resDetails := range res.Resources {
+			resourceCounts[string(resDetails.Status)]++
+		}
+		hookCounts := make(map[string]int)
+		for _, hook := range res.Hooks {
+			hookCounts[string(hook.Status)]++
+		}
+		res.Summary = fmt.Sprintf("%s, %s",
+			summarizeCounts("resources", len(res.Resources), resourceCounts),
+			summarizeCounts("hooks", len(res.Hooks), hookCounts))
+		if len(res.MovedResources) > 0 {
+			movedCounts := make(map[string]int)
+			for _, resDetails := range res.MovedResources {
+				movedCounts[string(resDetails.Status)]++
+			}
+			res.Summary = fmt.Sprintf("%s, %s", res.Summary, summarizeCounts("moved resources", len(res.MovedResources), movedCounts))
+		}
+		res.Resources = nil
+		res.Hooks = nil
+		res.MovedResources = nil
+		compacted = true
+	}
+	if len(res.FailedResources) > 0 {
+		res.FailedResources = nil
+		compacted = true
+	}
+	return compacted
+}
+
+// summarizeCounts formats the number of items per status, e.g. "3 resources (2 Synced, 1 SyncFailed)"
+func summarizeCounts(items string, total int, counts map[string]int) string {
+	if total == 0 {
+		return fmt.Sprintf("0 %s", items)
+	}
+	statuses := make([]string, 0, len(counts))
+	for status := range counts {
+		statuses = append(statuses, status)
+	}
+	sort.Strings(statuses)
+	parts := make([]string, len(statuses))
+	for i, status := range statuses {
+		parts[i] = fmt.Sprintf("%d %s", counts[status], status)
+	}
+	return fmt.Sprintf("%d %s (%s)", total, items, strings.Join(parts, ", "))
+}
+
+// compactAppOperationState compacts the operation state of an application which completed its last
+// operation before the retention allows. The patch is conditional on the resource version of the
+// application, so that the state of an operation which started in the meantime is not compacted.
+func (ctrl *ApplicationController) compactAppOperationState(app *appv1.Application) {
+	if app.Operation != nil || app.Status.OperationState == nil {
+		return
+	}
+	state := app.Status.OperationState.DeepCopy()
+	if !ctrl.historyRetention.forApp(app).compactOperationState(state, time.Now().UTC()) {
+		return
+	}
+	logCtx := log.WithField("application", app.Name)
+	operationState := map[string]interface{}{
+		"attempts": nil,
+	}
+	if state.SyncResult != nil {
+		operationState["syncResult"] = map[string]interface{}{
+			"resources":       nil,
+			"hooks":           nil,
+			"movedResources":  nil,
+			"failedResources": nil,
+			"summary":         state.SyncResult.Summary,
+		}
+	}
+	if len(state.DestinationResults) > 0 {
+		// lists are replaced by merge patches
+		operationState["destinationResults"] = state.DestinationResults
+	}
+	patch, err := json.Marshal(map[string]interface{}{
+		"metadata": map[string]interface{}{
+			"resourceVersion": app.ResourceVersion,
+		},
+		"status": map[string]interface{}{
+			"operationState": operationState,
+		},
+	})
+	if err != nil {
+		logCtx.Errorf("Error compacting operation state (marshal patch): %v", err)
+		return
+	}
+	_, err = ctrl.applicationClientset.ArgoprojV1alpha1().Applications(app.Namespace).Patch(app.Name, types.MergePatchType, patch)
+	if err != nil {
+		if apierr.IsConflict(err) {
+			logCtx.Infof("Application changed while compacting operation state. Retrying on next refresh")
+		} else {
+			logCtx.Warnf("Error compacting operation state: %v", err)
+		}
+		return
+	}
+	if state.SyncResult != nil && state.SyncResult.Summary != "" {
+		logCtx.Infof("Compacted operation state: %s", state.SyncResult.Summary)
+	} else {
+		logCtx.Info("Compacted operation state")
+	}
+}
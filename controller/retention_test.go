@@ -0,0 +1,176 @@
+package controller
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/argoproj/argo-cd/common"
+	appv1 "github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1"
+)
+
+func newHistory(now time.Time, ages ...time.Duration) []appv1.DeploymentInfo {
+	history := make([]appv1.DeploymentInfo, len(ages))
+	for i, age := range ages {
+		history[i] = appv1.DeploymentInfo{ID: int64(i), DeployedAt: metav1.NewTime(now.Add(-age))}
+	}
+	return history
+}
+
+func historyIDs(history []appv1.DeploymentInfo) []int64 {
+	ids := make([]int64, len(history))
+	for i := range history {
+		ids[i] = history[i].ID
+	}
+	return ids
+}
+
+func TestTrimHistory(t *testing.T) {
+	now := time.Now().UTC()
+	history := newHistory(now, 96*time.Hour, 72*time.Hour, 48*time.Hour, 24*time.Hour, time.Hour, 0)
+
+	// the history is limited to 5 deployments by default
+	kept, removed := HistoryRetention{}.trimHistory(history, now)
+	assert.Equal(t, []int64{1, 2, 3, 4, 5}, historyIDs(kept))
+	assert.Equal(t, []int64{0}, historyIDs(removed))
+
+	kept, removed = HistoryRetention{Limit: 2}.trimHistory(history, now)
+	assert.Equal(t, []int64{4, 5}, historyIDs(kept))
+	assert.Equal(t, []int64{0, 1, 2, 3}, historyIDs(removed))
+
+	kept, _ = HistoryRetention{Limit: 10, MaxAge: 50 * time.Hour}.trimHistory(history, now)
+	assert.Equal(t, []int64{2, 3, 4, 5}, historyIDs(kept))
+
+	// the latest deployment is kept regardless of its age
+	kept, _ = HistoryRetention{MaxAge: time.Minute}.trimHistory(newHistory(now, 96*time.Hour, 48*time.Hour), now)
+	assert.Equal(t, []int64{1}, historyIDs(kept))
+}
+
+func TestCompactOperationState(t *testing.T) {
+	now := time.Now().UTC()
+	finishedAt := metav1.NewTime(now.Add(-2 * time.Hour))
+	newState := func() *appv1.OperationState {
+		return &appv1.OperationState{
+			Phase:      appv1.OperationSucceeded,
+			FinishedAt: &finishedAt,
+			SyncResult: &appv1.SyncOperationResult{
+				Revision: "abc123",
+				Resources: []*appv1.ResourceDetails{
+					{Name: "guestbook-ui", Kind: "Deployment", Status: appv1.ResourceDetailsSynced},
+					{Name: "guestbook-ui", Kind: "Service", Status: appv1.ResourceDetailsSynced},
+					{Name: "redis", Kind: "Service", Status: appv1.ResourceDetailsSyncedAndPruned},
+				},
+				Hooks: []*appv1.HookStatus{{Name: "db-migrate", Kind: "Job", Status: appv1.OperationSucceeded}},
+			},
+		}
+	}
+
+	state := newState()
+	assert.False(t, HistoryRetention{}.compactOperationState(state, now))
+	assert.False(t, HistoryRetention{CompactAfter: 3 * time.Hour}.compactOperationState(state, now))
+	assert.Len(t, state.SyncResult.Resources, 3)
+
+	state.Phase = appv1.OperationRunning
+	assert.False(t, HistoryRetention{CompactAfter: time.Hour}.compactOperationState(state, now))
+
+	state = newState()
+	assert.True(t, HistoryRetention{CompactAfter: time.Hour}.compactOperationState(state, now))
+	assert.Nil(t, state.SyncResult.Resources)
+	assert.Nil(t, state.SyncResult.Hooks)
+	assert.Equal(t, "abc123", state.SyncResult.Revision)
+	assert.Equal(t, "3 resources (2 Synced, 1 SyncedAndPruned), 1 hooks (1 Succeeded)", state.SyncResult.Summary)
+
+	// compacted operation states are not compacted again
+	assert.False(t, HistoryRetention{CompactAfter: time.Hour}.compactOperationState(state, now))
+}
+
+func TestCompactOperationStateDetails(t *testing.T) {
+	now := time.Now().UTC()
+	finishedAt := metav1.NewTime(now.Add(-2 * time.Hour))
+	state := &appv1.OperationState{
+		Phase:      appv1.OperationFailed,
+		FinishedAt: &finishedAt,
+		Attempts:   []appv1.OperationAttempt{{Phase: appv1.OperationFailed, Message: "one or more objects failed to apply"}},
+		SyncResult: &appv1.SyncOperationResult{
+			Revision: "abc123",
+			Resources: []*appv1.ResourceDetails{
+				{Name: "guestbook-ui", Kind: "Deployment", Status: appv1.ResourceDetailsSyncFailed},
+			},
+			MovedResources: []*appv1.ResourceDetails{
+				{Name: "guestbook-ui", Kind: "Service", Status: appv1.ResourceDetailsSyncedAndPruned},
+			},
+			FailedResources: []*appv1.ResourceDetails{
+				{Name: "guestbook-ui", Kind: "Deployment", Status: appv1.ResourceDetailsSyncFailed},
+			},
+		},
+		DestinationResults: []appv1.DestinationOperationResult{{
+			Phase: appv1.OperationSucceeded,
+			SyncResult: &appv1.SyncOperationResult{
+				Revision:  "abc123",
+				Resources: []*appv1.ResourceDetails{{Name: "guestbook-ui", Kind: "Service", Status: appv1.ResourceDetailsSynced}},
+			},
+		}},
+	}
+	assert.True(t, HistoryRetention{CompactAfter: time.Hour}.compactOperationState(state, now))
+	assert.Nil(t, state.Attempts)
+	assert.Nil(t, state.SyncResult.MovedResources)
+	assert.Nil(t, state.SyncResult.FailedResources)
+	assert.Equal(t, "1 resources (1 SyncFailed), 0 hooks, 1 moved resources (1 SyncedAndPruned)", state.SyncResult.Summary)
+	assert.Nil(t, state.DestinationResults[0].SyncResult.Resources)
+	assert.Equal(t, "1 resources (1 Synced), 0 hooks", state.DestinationResults[0].SyncResult.Summary)
+
+	// the summary of compacted sync results is kept
+	assert.False(t, HistoryRetention{CompactAfter: time.Hour}.compactOperationState(state, now))
+	assert.Equal(t, "1 resources (1 SyncFailed), 0 hooks, 1 moved resources (1 SyncedAndPruned)", state.SyncResult.Summary)
+}
+
+func TestAppendOperationHistory(t *testing.T) {
+	newState := func(revision string, phase appv1.OperationPhase) *appv1.OperationState {
+		return &appv1.OperationState{
+			Phase:    phase,
+			Attempts: []appv1.OperationAttempt{{Phase: appv1.OperationFailed, Message: "one or more objects failed to apply"}},
+			SyncResult: &appv1.SyncOperationResult{
+				Revision:  revision,
+				Resources: []*appv1.ResourceDetails{{Name: "guestbook-ui", Kind: "Deployment", Status: appv1.ResourceDetailsSynced}},
+			},
+		}
+	}
+	assert.Nil(t, HistoryRetention{}.appendOperationHistory(nil, newState("abc123", appv1.OperationSucceeded)))
+
+	retention := HistoryRetention{OperationHistoryLimit: 2}
+	state := newState("abc123", appv1.OperationFailed)
+	history := retention.appendOperationHistory(nil, state)
+	if assert.Len(t, history, 1) {
+		assert.Equal(t, appv1.OperationFailed, history[0].Phase)
+		assert.Nil(t, history[0].SyncResult.Resources)
+		assert.Equal(t, "1 resources (1 Synced), 0 hooks", history[0].SyncResult.Summary)
+		// the failed attempts are kept
+		assert.Len(t, history[0].Attempts, 1)
+	}
+	// the operation state itself is not compacted
+	assert.Len(t, state.SyncResult.Resources, 1)
+
+	history = retention.appendOperationHistory(history, newState("def456", appv1.OperationSucceeded))
+	history = retention.appendOperationHistory(history, newState("ghi789", appv1.OperationSucceeded))
+	if assert.Len(t, history, 2) {
+		assert.Equal(t, "def456", history[0].SyncResult.Revision)
+		assert.Equal(t, "ghi789", history[1].SyncResult.Revision)
+	}
+}
+
+func TestHistoryRetentionForApp(t *testing.T) {
+	retention := HistoryRetention{Limit: 10, CompactAfter: 24 * time.Hour}
+	app := &appv1.Application{}
+	assert.Equal(t, retention, retention.forApp(app))
+
+	app.Annotations = map[string]string{common.AnnotationKeyOperationCompactAfter: "1h"}
+	assert.Equal(t, HistoryRetention{Limit: 10, CompactAfter: time.Hour}, retention.forApp(app))
+
+	app.Annotations[common.AnnotationKeyOperationCompactAfter] = "0"
+	assert.Equal(t, time.Duration(0), retention.forApp(app).CompactAfter)
+
+	app.Annotations[common.AnnotationKeyOperationCompactAfter] = "daily"
+	assert.Equal(t, retention, retention.forApp(app))
+}
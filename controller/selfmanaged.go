@@ -0,0 +1,153 @@
+package controller
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+
+	log "github.com/sirupsen/logrus"
+	apierr "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/argoproj/argo-cd/common"
+	appv1 "github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/util/health"
+	"github.com/argoproj/argo-cd/util/kube"
+	settings_util "github.com/argoproj/argo-cd/util/settings"
+)
+
+// ensureSelfManagedApp creates or updates the application which manages Argo CD's own components
+func (ctrl *ApplicationController) ensureSelfManagedApp(settings *settings_util.ArgoCDSettings) error {
+	if !settings.IsSelfManaged() {
+		return nil
+	}
+	source := appv1.ApplicationSource{
+		RepoURL:        settings.SelfManagement.RepoURL,
+		Path:           settings.SelfManagement.Path,
+		TargetRevision: settings.SelfManagement.TargetRevision,
+	}
+	appIf := ctrl.applicationClientset.ArgoprojV1alpha1().Applications(ctrl.namespace)
+	app, err := appIf.Get(common.ArgoCDSelfAppName, metav1.GetOptions{})
+	if err != nil {
+		if !apierr.IsNotFound(err) {
+			return err
+		}
+		app = &appv1.Application{
+			ObjectMeta: metav1.ObjectMeta{Name: common.ArgoCDSelfAppName},
+			Spec: appv1.ApplicationSpec{
+				Source: source,
+				Destination: appv1.ApplicationDestination{
+					Server:    common.KubernetesInternalAPIServerAddr,
+					Namespace: ctrl.namespace,
+				},
+				Project: common.DefaultAppProjectName,
+			},
+		}
+		_, err = appIf.Create(app)
+		if err == nil {
+			log.Infof("Created self managed application '%s'", common.ArgoCDSelfAppName)
+		}
+		return err
+	}
+	if reflect.DeepEqual(app.Spec.Source, source) {
+		return nil
+	}
+	app.Spec.Source = source
+	_, err = appIf.Update(app)
+	if err == nil {
+		log.Infof("Updated source of self managed application '%s'", common.ArgoCDSelfAppName)
+	}
+	return err
+}
+
+// isSelfUpgrade returns whether or not resources of the given kind are Argo CD components which
+// need to be updated in upgrade order
+func (sc *syncContext) isSelfUpgrade(gvk schema.GroupVersionKind) bool {
+	return sc.appName == common.ArgoCDSelfAppName && gvk.Kind == kube.DeploymentKind
+}
+
+// componentOrder returns the position of the named deployment in the Argo CD component upgrade
+// order. Deployments which are not Argo CD components are updated last.
+func componentOrder(name string) int {
+	for i, component := range common.ArgoCDComponentUpgradeOrder {
+		if component == name {
+			return i
+		}
+	}
+	return len(common.ArgoCDComponentUpgradeOrder)
+}
+
+// sortArgoCDComponents sorts the tasks according to the Argo CD component upgrade order
+func sortArgoCDComponents(tasks []syncTask) {
+	sort.SliceStable(tasks, func(i, j int) bool {
+		return componentOrder(tasks[i].targetObj.GetName()) < componentOrder(tasks[j].targetObj.GetName())
+	})
+}
+
+// isArgoCDComponent returns whether the named deployment is an Argo CD component
+func isArgoCDComponent(name string) bool {
+	return componentOrder(name) < len(common.ArgoCDComponentUpgradeOrder)
+}
+
+// checkComponentRollouts returns whether the Argo CD components which were updated by the previous
+// passes of the sync operation are rolled out, so that the next component can be updated. Rather
+// than waiting for a component to become healthy, the operation keeps running and is resumed once the
+// application is refreshed. This includes the application controller, which is restarted by its own
+// update, so that the operation is resumed by the next leader. It also returns false if a component
+// became degraded, which fails its sync.
+func (sc *syncContext) checkComponentRollouts(syncTasks []syncTask) (rolledOut bool, successful bool) {
+	for _, task := range syncTasks {
+		if task.targetObj == nil || !sc.isSelfUpgrade(task.targetObj.GroupVersionKind()) || !isArgoCDComponent(task.targetObj.GetName()) || !sc.isTaskSynced(task) {
+			continue
+		}
+		healthStatus := &appv1.HealthStatus{Status: appv1.HealthStatusMissing}
+		if task.liveObj != nil {
+			var err error
+			healthStatus, err = health.GetAppHealth(sc.kubectl, task.liveObj)
+			if err != nil {
+				sc.setComponentRolloutFailed(task, fmt.Sprintf("failed to check health of %s '%s': %v", task.targetObj.GetKind(), task.targetObj.GetName(), err))
+				return false, false
+			}
+		}
+		switch healthStatus.Status {
+		case appv1.HealthStatusHealthy:
+			continue
+		case appv1.HealthStatusDegraded:
+			message := fmt.Sprintf("%s '%s' did not become %s", task.targetObj.GetKind(), task.targetObj.GetName(), appv1.HealthStatusHealthy)
+			if healthStatus.StatusDetails != "" {
+				message = fmt.Sprintf("%s: %s", message, healthStatus.StatusDetails)
+			}
+			sc.setComponentRolloutFailed(task, message)
+			return false, false
+		}
+		sc.setOperationPhase(appv1.OperationRunning, componentRolloutMessage(task.targetObj))
+		return false, true
+	}
+	return true, true
+}
+
+// setComponentRolloutFailed marks the sync of the Argo CD component as failed
+func (sc *syncContext) setComponentRolloutFailed(task syncTask, message string) {
+	sc.setResourceDetails(&appv1.ResourceDetails{
+		Name:      task.targetObj.GetName(),
+		Kind:      task.targetObj.GetKind(),
+		Namespace: sc.resourceNamespace(task.targetObj),
+		Message:   message,
+		Status:    appv1.ResourceDetailsSyncFailed,
+	})
+}
+
+// componentRolloutMessage returns the message of a sync operation which waits for the Argo CD
+// component to be rolled out
+func componentRolloutMessage(targetObj *unstructured.Unstructured) string {
+	return fmt.Sprintf("%s %s '%s' to become %s", waitingForComponentRolloutMessage, targetObj.GetKind(), targetObj.GetName(), appv1.HealthStatusHealthy)
+}
+
+// isWaitingForComponentRollout returns whether a running sync operation waits for an Argo CD
+// component to be rolled out
+func isWaitingForComponentRollout(phase appv1.OperationPhase, message string) bool {
+	return phase == appv1.OperationRunning && strings.HasPrefix(message, waitingForComponentRolloutMessage)
+}
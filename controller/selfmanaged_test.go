@@ -0,0 +1,80 @@
+package controller
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/argoproj/argo-cd/common"
+	"github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1"
+)
+
+// componentDeployment returns the manifest of the deployment of an Argo CD component, with the given
+// status
+func componentDeployment(name string, status string) string {
+	return fmt.Sprintf(`{"apiVersion":"apps/v1","kind":"Deployment","metadata":{"name":"%s"},"spec":{"replicas":1},"status":%s}`, name, status)
+}
+
+const (
+	rolledOutStatus   = `{"replicas":1,"updatedReplicas":1,"availableReplicas":1}`
+	rollingOutStatus  = `{"replicas":1,"updatedReplicas":0}`
+	rolloutFailStatus = `{"conditions":[{"type":"Progressing","status":"False","reason":"ProgressDeadlineExceeded"}]}`
+)
+
+func newSelfUpgradeSyncCtx() *syncContext {
+	syncCtx := newTestSyncCtx(progressiveAPIResources...)
+	syncCtx.kubectl = mockKubectlCmd{}
+	syncCtx.appName = common.ArgoCDSelfAppName
+	syncCtx.resources = []v1alpha1.ResourceState{
+		{TargetState: componentDeployment("argocd-server", "{}")},
+		{TargetState: componentDeployment("argocd-repo-server", "{}")},
+	}
+	return syncCtx
+}
+
+func TestSelfUpgradeRollsOutComponentsInOrder(t *testing.T) {
+	syncCtx := newSelfUpgradeSyncCtx()
+
+	// the repo server is updated first, and the sync is resumed once it is rolled out
+	syncCtx.sync()
+	assert.Len(t, syncCtx.syncRes.Resources, 1)
+	assert.Equal(t, "argocd-repo-server", syncCtx.syncRes.Resources[0].Name)
+	assert.True(t, isWaitingForComponentRollout(syncCtx.opState.Phase, syncCtx.opState.Message))
+	assert.Contains(t, syncCtx.opState.Message, "'argocd-repo-server'")
+
+	syncCtx.resources[1].LiveState = componentDeployment("argocd-repo-server", rollingOutStatus)
+	syncCtx.sync()
+	assert.Len(t, syncCtx.syncRes.Resources, 1)
+	assert.True(t, isWaitingForComponentRollout(syncCtx.opState.Phase, syncCtx.opState.Message))
+
+	syncCtx.resources[1].LiveState = componentDeployment("argocd-repo-server", rolledOutStatus)
+	syncCtx.sync()
+	assert.Len(t, syncCtx.syncRes.Resources, 2)
+	assert.Equal(t, "argocd-server", syncCtx.syncRes.Resources[1].Name)
+	assert.Contains(t, syncCtx.opState.Message, "'argocd-server'")
+
+	syncCtx.resources[0].LiveState = componentDeployment("argocd-server", rolledOutStatus)
+	syncCtx.sync()
+	assert.Equal(t, v1alpha1.OperationSucceeded, syncCtx.opState.Phase)
+}
+
+func TestSelfUpgradeComponentRolloutFailed(t *testing.T) {
+	syncCtx := newSelfUpgradeSyncCtx()
+	syncCtx.sync()
+	assert.Len(t, syncCtx.syncRes.Resources, 1)
+
+	syncCtx.resources[1].LiveState = componentDeployment("argocd-repo-server", rolloutFailStatus)
+	syncCtx.sync()
+	assert.Equal(t, v1alpha1.OperationFailed, syncCtx.opState.Phase)
+	// the next component is not updated
+	assert.Len(t, syncCtx.syncRes.Resources, 1)
+	assert.Equal(t, v1alpha1.ResourceDetailsSyncFailed, syncCtx.syncRes.Resources[0].Status)
+	assert.Contains(t, syncCtx.syncRes.Resources[0].Message, "exceeded its progress deadline")
+}
+
+func TestIsArgoCDComponent(t *testing.T) {
+	assert.True(t, isArgoCDComponent("argocd-repo-server"))
+	assert.True(t, isArgoCDComponent("application-controller"))
+	assert.False(t, isArgoCDComponent("guestbook-ui"))
+}
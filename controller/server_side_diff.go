@@ -0,0 +1,80 @@
+package controller
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	log "github.com/sirupsen/logrus"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/client-go/rest"
+
+	"github.com/argoproj/argo-cd/common"
+	"github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/util/argo"
+	"github.com/argoproj/argo-cd/util/diff"
+	"github.com/argoproj/argo-cd/util/kube"
+)
+
+// usesServerSideDiff returns whether the live state of a resource is diffed against the object
+// returned by a server-side dry-run apply of its target state, rather than against the target state
+func usesServerSideDiff(app *v1alpha1.Application, targetObj *unstructured.Unstructured) bool {
+	return argo.HasSyncOption(targetObj, common.SyncOptionServerSideDiff) || app.Spec.SyncPolicy.HasSyncOption(common.SyncOptionServerSideDiff)
+}
+
+// serverSideDiff replaces the diffs of the resources which use server-side diffs with the diffs of
+// their live objects against the objects predicted by a server-side dry-run apply of their target
+// objects. The server-side diffs are cached like the other diffs, unless the diff cache is nil. Resources
+// which are missing keep their diffs, and so do the resources whose dry-run fails, e.g. since the
+// cluster does not support server-side dry-runs, which are reported by the returned warning condition.
+func (s *appStateManager) serverSideDiff(app *v1alpha1.Application, targetObjs, liveObjs []*unstructured.Unstructured, normalizer diff.Normalizer, normalizerKey string, diffCache *diffCache, diffResults *diff.DiffResultList) (*v1alpha1.ApplicationCondition, error) {
+	var restConfig *rest.Config
+	var failed []string
+	for i, targetObj := range targetObjs {
+		if targetObj == nil || liveObjs[i] == nil || !usesServerSideDiff(app, targetObj) {
+			continue
+		}
+		var configErr error
+		result, err := diffCache.serverSideDiff(targetObj, liveObjs[i], normalizerKey, func() (*diff.DiffResult, error) {
+			if restConfig == nil {
+				clst, err := s.db.GetCluster(context.Background(), app.Spec.Destination.Server)
+				if err != nil {
+					configErr = err
+					return nil, err
+				}
+				restConfig = kube.ClusterRESTConfig(clst)
+			}
+			namespace := targetObj.GetNamespace()
+			if namespace == "" {
+				namespace = app.Spec.Destination.Namespace
+			}
+			predictedObj, err := s.kubectl.DryRunApplyResource(restConfig, targetObj, namespace)
+			if err != nil {
+				return nil, err
+			}
+			return diff.PredictedDiff(predictedObj, liveObjs[i], normalizer), nil
+		})
+		if configErr != nil {
+			return nil, configErr
+		}
+		if err != nil {
+			log.WithField("application", app.Name).Warnf("Server-side dry-run of %s/%s failed, diffing its target state instead: %v", targetObj.GetKind(), targetObj.GetName(), err)
+			failed = append(failed, fmt.Sprintf("%s %s (%v)", targetObj.GroupVersionKind().GroupKind(), targetObj.GetName(), err))
+			continue
+		}
+		diffResults.Diffs[i] = *result
+	}
+	diffResults.Modified = false
+	for _, diffRes := range diffResults.Diffs {
+		if diffRes.Modified {
+			diffResults.Modified = true
+		}
+	}
+	if len(failed) == 0 {
+		return nil, nil
+	}
+	return &v1alpha1.ApplicationCondition{
+		Type:    v1alpha1.ApplicationConditionServerSideDiffWarning,
+		Message: fmt.Sprintf("Server-side dry-runs failed, so the resources are diffed against their target state: %s", strings.Join(failed, ", ")),
+	}, nil
+}
@@ -0,0 +1,125 @@
+package controller
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"github.com/argoproj/argo-cd/common"
+	"github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/util/db"
+	"github.com/argoproj/argo-cd/util/diff"
+	"github.com/argoproj/argo-cd/util/settings"
+)
+
+func TestUsesServerSideDiff(t *testing.T) {
+	app := newFakeApp()
+	pod := newPod()
+	assert.False(t, usesServerSideDiff(app, pod))
+
+	pod.SetAnnotations(map[string]string{common.AnnotationSyncOptions: common.SyncOptionServerSideDiff})
+	assert.True(t, usesServerSideDiff(app, pod))
+
+	app.Spec.SyncPolicy = &v1alpha1.SyncPolicy{SyncOptions: []string{common.SyncOptionServerSideDiff}}
+	assert.True(t, usesServerSideDiff(app, newPod()))
+}
+
+func TestServerSideDiff(t *testing.T) {
+	kubeClientset := fake.NewSimpleClientset()
+	argoDB := db.NewDB("argocd", settings.NewSettingsManager(kubeClientset, "argocd"), kubeClientset)
+	_, err := argoDB.CreateCluster(context.Background(), &v1alpha1.Cluster{Server: "https://localhost:6443"})
+	assert.NoError(t, err)
+
+	app := newFakeApp()
+	app.Spec.Destination.Server = "https://localhost:6443"
+	app.Spec.SyncPolicy = &v1alpha1.SyncPolicy{SyncOptions: []string{common.SyncOptionServerSideDiff}}
+
+	newNamedPod := func(name string) *unstructured.Unstructured {
+		pod := newPod()
+		pod.SetName(name)
+		return pod
+	}
+	// the images of the live pods are qualified by an admission webhook
+	mutatedPod := func(name string) *unstructured.Unstructured {
+		pod := newNamedPod(name)
+		containers, _, _ := unstructured.NestedSlice(pod.Object, "spec", "containers")
+		containers[0].(map[string]interface{})["image"] = "docker.io/library/nginx:1.7.9"
+		assert.NoError(t, unstructured.SetNestedSlice(pod.Object, containers, "spec", "containers"))
+		return pod
+	}
+	targetObjs := []*unstructured.Unstructured{newNamedPod("mutated"), newNamedPod("failed"), newNamedPod("missing")}
+	liveObjs := []*unstructured.Unstructured{mutatedPod("mutated"), mutatedPod("failed"), nil}
+	diffResults, err := diff.DiffArray(targetObjs, liveObjs, nil)
+	assert.NoError(t, err)
+	assert.True(t, diffResults.Diffs[0].Modified)
+
+	mgr := &appStateManager{db: argoDB, kubectl: mockKubectlCmd{
+		commands:  map[string]kubectlOutput{"failed": {err: fmt.Errorf("server-side dry-run is not supported")}},
+		predicted: map[string]*unstructured.Unstructured{"mutated": mutatedPod("mutated")},
+	}}
+	condition, err := mgr.serverSideDiff(app, targetObjs, liveObjs, nil, "", nil, diffResults)
+	assert.NoError(t, err)
+	assert.False(t, diffResults.Diffs[0].Modified)
+	// the resources whose dry-run fails keep the diffs of their target states, and are reported
+	assert.True(t, diffResults.Diffs[1].Modified)
+	assert.True(t, diffResults.Diffs[2].Modified)
+	assert.True(t, diffResults.Modified)
+	if assert.NotNil(t, condition) {
+		assert.Equal(t, v1alpha1.ApplicationConditionServerSideDiffWarning, condition.Type)
+		assert.Contains(t, condition.Message, "Pod failed (server-side dry-run is not supported)")
+		assert.NotContains(t, condition.Message, "mutated")
+	}
+}
+
+func TestServerSideDiffCached(t *testing.T) {
+	kubeClientset := fake.NewSimpleClientset()
+	argoDB := db.NewDB("argocd", settings.NewSettingsManager(kubeClientset, "argocd"), kubeClientset)
+	_, err := argoDB.CreateCluster(context.Background(), &v1alpha1.Cluster{Server: "https://localhost:6443"})
+	assert.NoError(t, err)
+
+	app := newFakeApp()
+	app.Spec.Destination.Server = "https://localhost:6443"
+	app.Spec.SyncPolicy = &v1alpha1.SyncPolicy{SyncOptions: []string{common.SyncOptionServerSideDiff}}
+
+	targetObj := newPod()
+	liveObj := newPod()
+	liveObj.SetUID("pod-uid")
+	liveObj.SetResourceVersion("1")
+	// the image of the live pod is qualified by an admission webhook
+	containers, _, _ := unstructured.NestedSlice(liveObj.Object, "spec", "containers")
+	containers[0].(map[string]interface{})["image"] = "docker.io/library/nginx:1.7.9"
+	assert.NoError(t, unstructured.SetNestedSlice(liveObj.Object, containers, "spec", "containers"))
+	serverSideDiff := func(mgr *appStateManager, cache *diffCache) (*diff.DiffResultList, *v1alpha1.ApplicationCondition) {
+		targetObjs := []*unstructured.Unstructured{targetObj}
+		liveObjs := []*unstructured.Unstructured{liveObj}
+		diffResults, err := diff.DiffArray(targetObjs, liveObjs, nil)
+		assert.NoError(t, err)
+		condition, err := mgr.serverSideDiff(app, targetObjs, liveObjs, nil, "", cache, diffResults)
+		assert.NoError(t, err)
+		return diffResults, condition
+	}
+
+	cache := newDiffCache(nil)
+	diffResults, condition := serverSideDiff(&appStateManager{db: argoDB, kubectl: mockKubectlCmd{
+		predicted: map[string]*unstructured.Unstructured{liveObj.GetName(): liveObj.DeepCopy()},
+	}}, cache)
+	assert.Nil(t, condition)
+	assert.False(t, diffResults.Modified)
+
+	// the dry-run is skipped as long as the live and target objects are unchanged
+	failing := &appStateManager{db: argoDB, kubectl: mockKubectlCmd{
+		commands: map[string]kubectlOutput{liveObj.GetName(): {err: fmt.Errorf("server-side dry-run is not supported")}},
+	}}
+	diffResults, condition = serverSideDiff(failing, cache)
+	assert.Nil(t, condition)
+	assert.False(t, diffResults.Modified)
+
+	liveObj.SetResourceVersion("2")
+	diffResults, condition = serverSideDiff(failing, cache)
+	assert.NotNil(t, condition)
+	assert.True(t, diffResults.Modified)
+}
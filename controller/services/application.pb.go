@@ -40,7 +40,7 @@ func (m *ResourcesQuery) Reset()         { *m = ResourcesQuery{} }
 func (m *ResourcesQuery) String() string { return proto.CompactTextString(m) }
 func (*ResourcesQuery) ProtoMessage()    {}
 func (*ResourcesQuery) Descriptor() ([]byte, []int) {
-	return fileDescriptor_application_ceea98df6b81388c, []int{0}
+	return fileDescriptor_application_b6a54afaab5cfaaf, []int{0}
 }
 func (m *ResourcesQuery) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -108,7 +108,7 @@ func (m *ResourcesResponse) Reset()         { *m = ResourcesResponse{} }
 func (m *ResourcesResponse) String() string { return proto.CompactTextString(m) }
 func (*ResourcesResponse) ProtoMessage()    {}
 func (*ResourcesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_application_ceea98df6b81388c, []int{1}
+	return fileDescriptor_application_b6a54afaab5cfaaf, []int{1}
 }
 func (m *ResourcesResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -144,9 +144,121 @@ func (m *ResourcesResponse) GetItems() []*v1alpha1.ResourceState {
 	return nil
 }
 
+type SyncedManifestsQuery struct {
+	ApplicationName      *string  `protobuf:"bytes,1,req,name=applicationName" json:"applicationName,omitempty"`
+	Id                   *int64   `protobuf:"varint,2,req,name=id" json:"id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SyncedManifestsQuery) Reset()         { *m = SyncedManifestsQuery{} }
+func (m *SyncedManifestsQuery) String() string { return proto.CompactTextString(m) }
+func (*SyncedManifestsQuery) ProtoMessage()    {}
+func (*SyncedManifestsQuery) Descriptor() ([]byte, []int) {
+	return fileDescriptor_application_b6a54afaab5cfaaf, []int{2}
+}
+func (m *SyncedManifestsQuery) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SyncedManifestsQuery) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SyncedManifestsQuery.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (dst *SyncedManifestsQuery) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SyncedManifestsQuery.Merge(dst, src)
+}
+func (m *SyncedManifestsQuery) XXX_Size() int {
+	return m.Size()
+}
+func (m *SyncedManifestsQuery) XXX_DiscardUnknown() {
+	xxx_messageInfo_SyncedManifestsQuery.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SyncedManifestsQuery proto.InternalMessageInfo
+
+func (m *SyncedManifestsQuery) GetApplicationName() string {
+	if m != nil && m.ApplicationName != nil {
+		return *m.ApplicationName
+	}
+	return ""
+}
+
+func (m *SyncedManifestsQuery) GetId() int64 {
+	if m != nil && m.Id != nil {
+		return *m.Id
+	}
+	return 0
+}
+
+type SyncedManifestsResponse struct {
+	Manifests            []string `protobuf:"bytes,1,rep,name=manifests" json:"manifests,omitempty"`
+	Revision             *string  `protobuf:"bytes,2,opt,name=revision" json:"revision,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SyncedManifestsResponse) Reset()         { *m = SyncedManifestsResponse{} }
+func (m *SyncedManifestsResponse) String() string { return proto.CompactTextString(m) }
+func (*SyncedManifestsResponse) ProtoMessage()    {}
+func (*SyncedManifestsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_application_b6a54afaab5cfaaf, []int{3}
+}
+func (m *SyncedManifestsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SyncedManifestsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SyncedManifestsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (dst *SyncedManifestsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SyncedManifestsResponse.Merge(dst, src)
+}
+func (m *SyncedManifestsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *SyncedManifestsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SyncedManifestsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SyncedManifestsResponse proto.InternalMessageInfo
+
+func (m *SyncedManifestsResponse) GetManifests() []string {
+	if m != nil {
+		return m.Manifests
+	}
+	return nil
+}
+
+func (m *SyncedManifestsResponse) GetRevision() string {
+	if m != nil && m.Revision != nil {
+		return *m.Revision
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*ResourcesQuery)(nil), "github.com.argoproj.argo_cd.controller.services.ResourcesQuery")
 	proto.RegisterType((*ResourcesResponse)(nil), "github.com.argoproj.argo_cd.controller.services.ResourcesResponse")
+	proto.RegisterType((*SyncedManifestsQuery)(nil), "github.com.argoproj.argo_cd.controller.services.SyncedManifestsQuery")
+	proto.RegisterType((*SyncedManifestsResponse)(nil), "github.com.argoproj.argo_cd.controller.services.SyncedManifestsResponse")
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -162,6 +274,10 @@ const _ = grpc.SupportPackageIsVersion4
 type ApplicationServiceClient interface {
 	// Resources returns information about expected and observed application resources
 	Resources(ctx context.Context, in *ResourcesQuery, opts ...grpc.CallOption) (*ResourcesResponse, error)
+	// SyncedManifests returns the rendered manifests applied by a deployment from the application history
+	SyncedManifests(ctx context.Context, in *SyncedManifestsQuery, opts ...grpc.CallOption) (*SyncedManifestsResponse, error)
+	// ResourceTree returns the tree of the live resources of an application, and of the resources they control
+	ResourceTree(ctx context.Context, in *ResourcesQuery, opts ...grpc.CallOption) (*v1alpha1.ApplicationTree, error)
 }
 
 type applicationServiceClient struct {
@@ -181,11 +297,33 @@ func (c *applicationServiceClient) Resources(ctx context.Context, in *ResourcesQ
 	return out, nil
 }
 
+func (c *applicationServiceClient) SyncedManifests(ctx context.Context, in *SyncedManifestsQuery, opts ...grpc.CallOption) (*SyncedManifestsResponse, error) {
+	out := new(SyncedManifestsResponse)
+	err := c.cc.Invoke(ctx, "/github.com.argoproj.argo_cd.controller.services.ApplicationService/SyncedManifests", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *applicationServiceClient) ResourceTree(ctx context.Context, in *ResourcesQuery, opts ...grpc.CallOption) (*v1alpha1.ApplicationTree, error) {
+	out := new(v1alpha1.ApplicationTree)
+	err := c.cc.Invoke(ctx, "/github.com.argoproj.argo_cd.controller.services.ApplicationService/ResourceTree", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // Server API for ApplicationService service
 
 type ApplicationServiceServer interface {
 	// Resources returns information about expected and observed application resources
 	Resources(context.Context, *ResourcesQuery) (*ResourcesResponse, error)
+	// SyncedManifests returns the rendered manifests applied by a deployment from the application history
+	SyncedManifests(context.Context, *SyncedManifestsQuery) (*SyncedManifestsResponse, error)
+	// ResourceTree returns the tree of the live resources of an application, and of the resources they control
+	ResourceTree(context.Context, *ResourcesQuery) (*v1alpha1.ApplicationTree, error)
 }
 
 func RegisterApplicationServiceServer(s *grpc.Server, srv ApplicationServiceServer) {
@@ -210,6 +348,42 @@ func _ApplicationService_Resources_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ApplicationService_SyncedManifests_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SyncedManifestsQuery)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApplicationServiceServer).SyncedManifests(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/github.com.argoproj.argo_cd.controller.services.ApplicationService/SyncedManifests",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApplicationServiceServer).SyncedManifests(ctx, req.(*SyncedManifestsQuery))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApplicationService_ResourceTree_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResourcesQuery)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApplicationServiceServer).ResourceTree(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/github.com.argoproj.argo_cd.controller.services.ApplicationService/ResourceTree",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApplicationServiceServer).ResourceTree(ctx, req.(*ResourcesQuery))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _ApplicationService_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "github.com.argoproj.argo_cd.controller.services.ApplicationService",
 	HandlerType: (*ApplicationServiceServer)(nil),
@@ -218,6 +392,14 @@ var _ApplicationService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Resources",
 			Handler:    _ApplicationService_Resources_Handler,
 		},
+		{
+			MethodName: "SyncedManifests",
+			Handler:    _ApplicationService_SyncedManifests_Handler,
+		},
+		{
+			MethodName: "ResourceTree",
+			Handler:    _ApplicationService_ResourceTree_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "controller/services/application.proto",
@@ -303,6 +485,84 @@ func (m *ResourcesResponse) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
+func (m *SyncedManifestsQuery) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SyncedManifestsQuery) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.ApplicationName == nil {
+		return 0, github_com_gogo_protobuf_proto.NewRequiredNotSetError("applicationName")
+	} else {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintApplication(dAtA, i, uint64(len(*m.ApplicationName)))
+		i += copy(dAtA[i:], *m.ApplicationName)
+	}
+	if m.Id == nil {
+		return 0, github_com_gogo_protobuf_proto.NewRequiredNotSetError("id")
+	} else {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintApplication(dAtA, i, uint64(*m.Id))
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *SyncedManifestsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SyncedManifestsResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Manifests) > 0 {
+		for _, s := range m.Manifests {
+			dAtA[i] = 0xa
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	if m.Revision != nil {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintApplication(dAtA, i, uint64(len(*m.Revision)))
+		i += copy(dAtA[i:], *m.Revision)
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
 func encodeVarintApplication(dAtA []byte, offset int, v uint64) int {
 	for v >= 1<<7 {
 		dAtA[offset] = uint8(v&0x7f | 0x80)
@@ -352,6 +612,41 @@ func (m *ResourcesResponse) Size() (n int) {
 	return n
 }
 
+func (m *SyncedManifestsQuery) Size() (n int) {
+	var l int
+	_ = l
+	if m.ApplicationName != nil {
+		l = len(*m.ApplicationName)
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	if m.Id != nil {
+		n += 1 + sovApplication(uint64(*m.Id))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *SyncedManifestsResponse) Size() (n int) {
+	var l int
+	_ = l
+	if len(m.Manifests) > 0 {
+		for _, s := range m.Manifests {
+			l = len(s)
+			n += 1 + l + sovApplication(uint64(l))
+		}
+	}
+	if m.Revision != nil {
+		l = len(*m.Revision)
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
 func sovApplication(x uint64) (n int) {
 	for {
 		n++
@@ -623,6 +918,226 @@ func (m *ResourcesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *SyncedManifestsQuery) Unmarshal(dAtA []byte) error {
+	var hasFields [1]uint64
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApplication
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SyncedManifestsQuery: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SyncedManifestsQuery: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApplicationName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.ApplicationName = &s
+			iNdEx = postIndex
+			hasFields[0] |= uint64(0x00000001)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var v int64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Id = &v
+			hasFields[0] |= uint64(0x00000002)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApplication(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+	if hasFields[0]&uint64(0x00000001) == 0 {
+		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("applicationName")
+	}
+	if hasFields[0]&uint64(0x00000002) == 0 {
+		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("id")
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SyncedManifestsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApplication
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SyncedManifestsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SyncedManifestsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Manifests", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Manifests = append(m.Manifests, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Revision", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.Revision = &s
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApplication(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipApplication(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -729,30 +1244,36 @@ var (
 )
 
 func init() {
-	proto.RegisterFile("controller/services/application.proto", fileDescriptor_application_ceea98df6b81388c)
-}
-
-var fileDescriptor_application_ceea98df6b81388c = []byte{
-	// 328 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x52, 0xcb, 0x4a, 0x03, 0x31,
-	0x14, 0x35, 0x7d, 0x20, 0x8d, 0xa0, 0x18, 0x5c, 0x0c, 0x5d, 0x94, 0x52, 0x10, 0x66, 0x63, 0x42,
-	0xbb, 0x17, 0xb1, 0x2b, 0xdd, 0x08, 0x4e, 0x77, 0x2e, 0x94, 0x98, 0xb9, 0x4c, 0x63, 0xa7, 0x73,
-	0x43, 0x92, 0x29, 0xb8, 0xf1, 0x23, 0xfc, 0x0b, 0xff, 0xc4, 0xa5, 0x9f, 0x20, 0xfd, 0x12, 0x71,
-	0x86, 0xf4, 0x21, 0x52, 0xd0, 0xdd, 0xb9, 0x87, 0x39, 0xe7, 0xcc, 0x3d, 0x37, 0xf4, 0x54, 0x61,
-	0xe1, 0x2d, 0xe6, 0x39, 0x58, 0xe1, 0xc0, 0x2e, 0xb4, 0x02, 0x27, 0xa4, 0x31, 0xb9, 0x56, 0xd2,
-	0x6b, 0x2c, 0xb8, 0xb1, 0xe8, 0x91, 0x89, 0x4c, 0xfb, 0x69, 0xf9, 0xc8, 0x15, 0xce, 0xb9, 0xb4,
-	0x19, 0x1a, 0x8b, 0x4f, 0x15, 0x78, 0x50, 0x29, 0x5f, 0x5b, 0xf0, 0x60, 0xd1, 0xbd, 0x5e, 0x0b,
-	0x44, 0x10, 0x54, 0xe0, 0x4c, 0xa5, 0xc2, 0xcc, 0x32, 0x21, 0x8d, 0xde, 0x0a, 0x12, 0x8b, 0xa1,
-	0xcc, 0xcd, 0x54, 0x0e, 0x45, 0x06, 0x05, 0x58, 0xe9, 0x21, 0xad, 0xb3, 0x07, 0x2f, 0xf4, 0x30,
-	0x01, 0x87, 0xa5, 0x55, 0xe0, 0x6e, 0x4b, 0xb0, 0xcf, 0x2c, 0xa6, 0x47, 0x1b, 0xca, 0x1b, 0x39,
-	0x87, 0x88, 0xf4, 0x1b, 0x71, 0x27, 0xf9, 0x49, 0xb3, 0x13, 0xda, 0xce, 0x2c, 0x96, 0x26, 0x6a,
-	0xf4, 0x49, 0xdc, 0x49, 0xea, 0x81, 0x45, 0x74, 0x7f, 0x01, 0xd6, 0x69, 0x2c, 0xa2, 0x66, 0xc5,
-	0x87, 0x91, 0x31, 0xda, 0x9a, 0xe9, 0x22, 0x8d, 0x5a, 0x15, 0x5d, 0xe1, 0x81, 0xa3, 0xc7, 0xab,
-	0xfc, 0x04, 0x9c, 0xc1, 0xc2, 0x01, 0xbb, 0xa7, 0x6d, 0xed, 0x61, 0xee, 0x22, 0xd2, 0x6f, 0xc6,
-	0x07, 0xa3, 0x2b, 0xbe, 0xab, 0x20, 0x33, 0xcb, 0xf8, 0xf7, 0xbe, 0x7c, 0xb3, 0xd8, 0xb0, 0x2f,
-	0x0f, 0xe6, 0x13, 0x2f, 0x3d, 0x24, 0xb5, 0xed, 0xe8, 0x8d, 0x50, 0x76, 0xb9, 0xfe, 0x7a, 0x52,
-	0xf7, 0xca, 0x5e, 0x09, 0xed, 0xac, 0x7e, 0x86, 0x5d, 0xf0, 0x3f, 0x9e, 0x85, 0x6f, 0x17, 0xd9,
-	0x1d, 0xff, 0xdf, 0x20, 0x34, 0x31, 0xd8, 0x1b, 0x9f, 0xbf, 0x2f, 0x7b, 0xe4, 0x63, 0xd9, 0x23,
-	0x9f, 0xcb, 0x1e, 0xb9, 0x13, 0xbb, 0x2e, 0xff, 0xcb, 0x6b, 0xfb, 0x0a, 0x00, 0x00, 0xff, 0xff,
-	0x8d, 0xcd, 0xc9, 0xf5, 0x83, 0x02, 0x00, 0x00,
+	proto.RegisterFile("controller/services/application.proto", fileDescriptor_application_b6a54afaab5cfaaf)
+}
+
+var fileDescriptor_application_b6a54afaab5cfaaf = []byte{
+	// 432 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x54, 0xc1, 0x6a, 0x14, 0x41,
+	0x10, 0xb5, 0x67, 0x13, 0x74, 0x4a, 0x49, 0xb0, 0x09, 0x38, 0x2c, 0xb2, 0x2c, 0x03, 0xc2, 0x5c,
+	0xec, 0x26, 0xb9, 0x8b, 0x18, 0x10, 0xa2, 0xa0, 0xe8, 0xac, 0x27, 0x0f, 0x4a, 0xdb, 0x53, 0x4e,
+	0xda, 0xdd, 0xed, 0x6e, 0xba, 0x7b, 0x17, 0x72, 0xf1, 0x23, 0xbc, 0x7a, 0xf5, 0x63, 0x3c, 0xfa,
+	0x09, 0xb2, 0x1f, 0xe0, 0x37, 0x48, 0x66, 0xe8, 0x9d, 0xc9, 0x12, 0x02, 0x9b, 0xbd, 0x55, 0x3d,
+	0xa8, 0x57, 0xf5, 0xde, 0xeb, 0x19, 0x78, 0x22, 0x8d, 0x0e, 0xce, 0xcc, 0x66, 0xe8, 0xb8, 0x47,
+	0xb7, 0x54, 0x12, 0x3d, 0x17, 0xd6, 0xce, 0x94, 0x14, 0x41, 0x19, 0xcd, 0xac, 0x33, 0xc1, 0x50,
+	0x5e, 0xab, 0x70, 0xbe, 0xf8, 0xc2, 0xa4, 0x99, 0x33, 0xe1, 0x6a, 0x63, 0x9d, 0xf9, 0xd6, 0x14,
+	0x9f, 0x65, 0xc5, 0x3a, 0x0a, 0x16, 0x29, 0x86, 0xaf, 0xba, 0x01, 0x1e, 0x07, 0x9a, 0xe2, 0xa9,
+	0xac, 0xb8, 0x9d, 0xd6, 0x5c, 0x58, 0x75, 0x65, 0x11, 0x5f, 0x1e, 0x8b, 0x99, 0x3d, 0x17, 0xc7,
+	0xbc, 0x46, 0x8d, 0x4e, 0x04, 0xac, 0xda, 0xdd, 0xf9, 0x77, 0x38, 0x28, 0xd1, 0x9b, 0x85, 0x93,
+	0xe8, 0xdf, 0x2f, 0xd0, 0x5d, 0xd0, 0x02, 0x0e, 0x7b, 0x93, 0x6f, 0xc5, 0x1c, 0x33, 0x32, 0x4e,
+	0x8a, 0xb4, 0xdc, 0x84, 0xe9, 0x11, 0xec, 0xd7, 0xce, 0x2c, 0x6c, 0x96, 0x8c, 0x49, 0x91, 0x96,
+	0x6d, 0x43, 0x33, 0xb8, 0xbb, 0x44, 0xe7, 0x95, 0xd1, 0xd9, 0xa0, 0xc1, 0x63, 0x4b, 0x29, 0xec,
+	0x4d, 0x95, 0xae, 0xb2, 0xbd, 0x06, 0x6e, 0xea, 0xdc, 0xc3, 0xc3, 0xf5, 0xfe, 0x12, 0xbd, 0x35,
+	0xda, 0x23, 0xfd, 0x04, 0xfb, 0x2a, 0xe0, 0xdc, 0x67, 0x64, 0x3c, 0x28, 0xee, 0x9f, 0x9c, 0xb1,
+	0x9b, 0x0c, 0xb2, 0xd3, 0x9a, 0x5d, 0xea, 0x65, 0x7d, 0x63, 0xa3, 0x5e, 0x16, 0xc9, 0x27, 0x41,
+	0x04, 0x2c, 0x5b, 0xda, 0xfc, 0x1d, 0x1c, 0x4d, 0x2e, 0xb4, 0xc4, 0xea, 0x8d, 0xd0, 0xea, 0x2b,
+	0xfa, 0xb0, 0xb5, 0xf4, 0x03, 0x48, 0x54, 0x95, 0x25, 0xe3, 0xa4, 0x18, 0x94, 0x89, 0xaa, 0xf2,
+	0x09, 0x3c, 0xda, 0x60, 0x5c, 0x8b, 0x79, 0x0c, 0xe9, 0x3c, 0x82, 0x8d, 0xa0, 0xb4, 0xec, 0x00,
+	0x3a, 0x84, 0x7b, 0x0e, 0x97, 0xaa, 0xb1, 0xab, 0xb5, 0x71, 0xdd, 0x9f, 0xfc, 0x1b, 0x00, 0x7d,
+	0xd1, 0x2d, 0x9e, 0xb4, 0xf1, 0xd3, 0x1f, 0x04, 0xd2, 0xb5, 0x67, 0xf4, 0x39, 0xdb, 0xf2, 0xf5,
+	0xb0, 0xab, 0x79, 0x0f, 0x4f, 0x6f, 0x4f, 0x10, 0x35, 0xe6, 0x77, 0xe8, 0x2f, 0x02, 0x87, 0x1b,
+	0x0e, 0xd0, 0x97, 0x5b, 0x33, 0x5f, 0x97, 0xca, 0xf0, 0x6c, 0x57, 0x9a, 0xde, 0x99, 0x3f, 0x09,
+	0x3c, 0x88, 0xe7, 0x7f, 0x70, 0x88, 0xbb, 0xdb, 0xf7, 0x7a, 0x87, 0xc7, 0xd9, 0x0b, 0xf7, 0xf2,
+	0x98, 0xd3, 0x67, 0xbf, 0x57, 0x23, 0xf2, 0x67, 0x35, 0x22, 0x7f, 0x57, 0x23, 0xf2, 0x91, 0xdf,
+	0xf4, 0x95, 0x5f, 0xf3, 0x67, 0xf9, 0x3f, 0x00, 0x4d, 0xa8, 0xb1, 0x2d, 0x6f, 0x04, 0x00, 0x00,
 }
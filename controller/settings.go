@@ -0,0 +1,179 @@
+package controller
+
+import (
+	"context"
+	"reflect"
+	"sync"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"k8s.io/client-go/tools/cache"
+
+	"github.com/argoproj/argo-cd/common"
+	appv1 "github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/util/cli"
+	settings_util "github.com/argoproj/argo-cd/util/settings"
+)
+
+// requeueAppsRecheckInterval is the interval at which the controller checks whether applications
+// need to be queued periodically, while they do not
+const requeueAppsRecheckInterval = time.Minute
+
+// watchSettings applies the settings configured in argocd-cm whenever they change: the self managed
+// "argocd" application is kept in sync with the self management settings, the log level and the
+// application resync period are updated without restarting the controller, and comparisons and syncs
+// use the updated settings rather than getting them from the API server
+func (ctrl *ApplicationController) watchSettings(ctx context.Context) {
+	settings := &settings_util.ArgoCDSettings{}
+	updateCh := make(chan struct{}, 1)
+	ctrl.settingsMgr.Subscribe(updateCh)
+	defer ctrl.settingsMgr.Unsubscribe(updateCh)
+	ctrl.settingsMgr.StartNotifier(ctx, settings)
+	for {
+		select {
+		case <-updateCh:
+			ctrl.applyRuntimeSettings(settings)
+			if err := ctrl.ensureSelfManagedApp(settings); err != nil {
+				log.Warnf("Failed to reconcile self managed application '%s': %v", common.ArgoCDSelfAppName, err)
+			}
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// applyRuntimeSettings applies the settings which can be changed while the controller is running.
+// Settings which are removed from argocd-cm revert to the values of the controller flags.
+func (ctrl *ApplicationController) applyRuntimeSettings(settings *settings_util.ArgoCDSettings) {
+	ctrl.settings.update(settings)
+	if ctrl.appStateManager.UpdateSettings(settings) {
+		log.Info("Resource customizations or exclusions changed, comparing all applications again")
+		ctrl.refreshApps()
+	}
+	cli.UpdateLogLevel(settings.ControllerLogLevel, ctrl.defaultLogLevel)
+
+	timeout := ctrl.defaultStatusRefreshTimeout
+	if settings.AppResyncPeriod != "" {
+		period, err := time.ParseDuration(settings.AppResyncPeriod)
+		if err != nil || period <= 0 {
+			log.Warnf("Ignoring invalid application resync period '%s'", settings.AppResyncPeriod)
+			return
+		}
+		timeout = period
+	}
+	ctrl.statusRefreshTimeoutMutex.Lock()
+	defer ctrl.statusRefreshTimeoutMutex.Unlock()
+	if timeout != ctrl.statusRefreshTimeout {
+		log.Infof("Changing application resync period from %v to %v", ctrl.statusRefreshTimeout, timeout)
+		ctrl.statusRefreshTimeout = timeout
+	}
+}
+
+// getStatusRefreshTimeout returns the period after which applications are compared again
+func (ctrl *ApplicationController) getStatusRefreshTimeout() time.Duration {
+	ctrl.statusRefreshTimeoutMutex.RLock()
+	defer ctrl.statusRefreshTimeoutMutex.RUnlock()
+	return ctrl.statusRefreshTimeout
+}
+
+// requeueAppsPeriodically queues all applications for a refresh once per status refresh timeout,
+// if it was reduced below the resync period of the application informer. The informer is created
+// with the period of the --app-resync flag, and cannot change its period once it is running.
+func (ctrl *ApplicationController) requeueAppsPeriodically(ctx context.Context) {
+	for {
+		wait := ctrl.requeuePeriod()
+		if wait <= 0 {
+			// only check again whether the period changed
+			wait = requeueAppsRecheckInterval
+		}
+		select {
+		case <-time.After(wait):
+		case <-ctx.Done():
+			return
+		}
+		if ctrl.requeuePeriod() <= 0 {
+			continue
+		}
+		for _, obj := range ctrl.appInformer.GetIndexer().List() {
+			key, err := cache.MetaNamespaceKeyFunc(obj)
+			if err == nil {
+				ctrl.appRefreshQueue.Add(key)
+			}
+		}
+	}
+}
+
+// requeuePeriod returns the period at which all applications are queued for a refresh, or 0 if the
+// application informer resyncs them often enough, or if periodic refreshes are disabled
+func (ctrl *ApplicationController) requeuePeriod() time.Duration {
+	timeout := ctrl.getStatusRefreshTimeout()
+	if timeout <= 0 || ctrl.defaultStatusRefreshTimeout > 0 && timeout >= ctrl.defaultStatusRefreshTimeout {
+		return 0
+	}
+	return timeout
+}
+
+// settingsCache holds the settings last updated by the settings notifier of the controller, so that
+// comparisons and syncs do not get argocd-cm and argocd-secret from the API server
+type settingsCache struct {
+	lock     sync.RWMutex
+	settings *settings_util.ArgoCDSettings
+}
+
+// get returns the settings like SettingsManager.GetSettings. The settings are got from the API server
+// until they are first updated, e.g. in the API server, which does not run the notifier.
+func (c *settingsCache) get(settingsMgr *settings_util.SettingsManager) (*settings_util.ArgoCDSettings, error) {
+	c.lock.RLock()
+	settings := c.settings
+	c.lock.RUnlock()
+	if settings != nil {
+		return settings, nil
+	}
+	return settingsMgr.GetSettings()
+}
+
+// update keeps a copy of the settings, since the settings notifier updates them in place. Returns the
+// previous settings, or nil if the settings were never updated.
+func (c *settingsCache) update(settings *settings_util.ArgoCDSettings) *settings_util.ArgoCDSettings {
+	copied := *settings
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	previous := c.settings
+	c.settings = &copied
+	return previous
+}
+
+// getSettings returns the settings of the comparisons and syncs like SettingsManager.GetSettings
+func (s *appStateManager) getSettings() (*settings_util.ArgoCDSettings, error) {
+	return s.settings.get(s.settingsMgr)
+}
+
+func (s *appStateManager) UpdateSettings(settings *settings_util.ArgoCDSettings) bool {
+	previous := s.settings.update(settings)
+	exclusionsChanged := previous == nil || !reflect.DeepEqual(previous.ResourceExclusions, settings.ResourceExclusions)
+	if s.clusterCache != nil && exclusionsChanged {
+		s.clusterCache.rediscover()
+	}
+	// the settings are first updated when the controller starts, before anything was compared
+	if previous == nil || (!exclusionsChanged && reflect.DeepEqual(previous.ResourceCustomizations, settings.ResourceCustomizations)) {
+		return false
+	}
+	// the diffs computed with the previous customizations are never used again
+	s.diffCache.invalidate()
+	return true
+}
+
+// refreshApps compares all applications with their target state again
+func (ctrl *ApplicationController) refreshApps() {
+	for _, obj := range ctrl.appInformer.GetIndexer().List() {
+		app, ok := obj.(*appv1.Application)
+		if !ok {
+			continue
+		}
+		key, err := cache.MetaNamespaceKeyFunc(app)
+		if err == nil {
+			ctrl.forceAppRefresh(app.Name)
+			ctrl.appRefreshQueue.Add(key)
+		}
+	}
+}
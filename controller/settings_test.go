@@ -0,0 +1,88 @@
+package controller
+
+import (
+	"testing"
+	"time"
+
+	gocache "github.com/patrickmn/go-cache"
+	log "github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"github.com/argoproj/argo-cd/util/diff"
+	settings_util "github.com/argoproj/argo-cd/util/settings"
+)
+
+func TestApplyRuntimeSettings(t *testing.T) {
+	defer log.SetLevel(log.GetLevel())
+	ctrl := newFakeController()
+	ctrl.defaultLogLevel = "info"
+
+	ctrl.applyRuntimeSettings(&settings_util.ArgoCDSettings{AppResyncPeriod: "30s", ControllerLogLevel: "debug"})
+	assert.Equal(t, 30*time.Second, ctrl.getStatusRefreshTimeout())
+	assert.Equal(t, log.DebugLevel, log.GetLevel())
+
+	// invalid values are ignored
+	ctrl.applyRuntimeSettings(&settings_util.ArgoCDSettings{AppResyncPeriod: "-1m", ControllerLogLevel: "verbose"})
+	assert.Equal(t, 30*time.Second, ctrl.getStatusRefreshTimeout())
+	assert.Equal(t, log.DebugLevel, log.GetLevel())
+
+	// removed settings revert to the flags
+	ctrl.applyRuntimeSettings(&settings_util.ArgoCDSettings{})
+	assert.Equal(t, time.Minute, ctrl.getStatusRefreshTimeout())
+	assert.Equal(t, log.InfoLevel, log.GetLevel())
+}
+
+func TestResourceCustomizationsFromUpdatedSettings(t *testing.T) {
+	// argocd-cm does not exist
+	mgr := &appStateManager{settingsMgr: settings_util.NewSettingsManager(fake.NewSimpleClientset(), "argocd")}
+	_, scripts, err := mgr.resourceCustomizations()
+	assert.NoError(t, err)
+	assert.Empty(t, scripts)
+
+	// the settings updated by the notifier are used instead of argocd-cm
+	mgr.UpdateSettings(&settings_util.ArgoCDSettings{ResourceCustomizations: map[string]settings_util.ResourceCustomization{
+		"example.com/Route": {NormalizerLua: "return obj"},
+	}})
+	_, scripts, err = mgr.resourceCustomizations()
+	assert.NoError(t, err)
+	assert.Equal(t, []diff.NormalizerScript{{Group: "example.com", Kind: "Route", Script: "return obj"}}, scripts)
+}
+
+func TestApplyRuntimeSettingsRefreshesApps(t *testing.T) {
+	ctrl := newFakeController()
+	app := newFakeApp()
+	assert.NoError(t, ctrl.appInformer.GetIndexer().Add(app))
+	diffCache := ctrl.appStateManager.(*appStateManager).diffCache
+
+	// the applications are compared anyway when the controller starts
+	ctrl.applyRuntimeSettings(&settings_util.ArgoCDSettings{})
+	assert.Equal(t, 0, ctrl.appRefreshQueue.Len())
+
+	diffCache.cache.Set("pod-uid", diffCacheEntry{}, gocache.DefaultExpiration)
+	ctrl.applyRuntimeSettings(&settings_util.ArgoCDSettings{ResourceCustomizations: map[string]settings_util.ResourceCustomization{
+		"example.com/Route": {NormalizerLua: "return obj"},
+	}})
+	assert.Equal(t, 1, ctrl.appRefreshQueue.Len())
+	assert.True(t, ctrl.isRefreshForced(app.Name))
+	assert.Equal(t, 0, diffCache.cache.ItemCount())
+}
+
+func TestRequeuePeriod(t *testing.T) {
+	ctrl := newFakeController()
+	ctrl.defaultStatusRefreshTimeout = 3 * time.Minute
+
+	ctrl.statusRefreshTimeout = time.Minute
+	assert.Equal(t, time.Minute, ctrl.requeuePeriod())
+	// the informer resyncs the applications
+	ctrl.statusRefreshTimeout = 3 * time.Minute
+	assert.Equal(t, time.Duration(0), ctrl.requeuePeriod())
+	// periodic refreshes are disabled
+	ctrl.statusRefreshTimeout = 0
+	assert.Equal(t, time.Duration(0), ctrl.requeuePeriod())
+
+	// the informer does not resync the applications if the --app-resync flag is 0
+	ctrl.defaultStatusRefreshTimeout = 0
+	ctrl.statusRefreshTimeout = 3 * time.Minute
+	assert.Equal(t, 3*time.Minute, ctrl.requeuePeriod())
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"sync"
 	"time"
 
 	log "github.com/sirupsen/logrus"
@@ -20,9 +21,12 @@ import (
 	"github.com/argoproj/argo-cd/reposerver"
 	"github.com/argoproj/argo-cd/reposerver/repository"
 	"github.com/argoproj/argo-cd/util"
+	"github.com/argoproj/argo-cd/util/argo"
+	cache_util "github.com/argoproj/argo-cd/util/cache"
 	"github.com/argoproj/argo-cd/util/db"
 	"github.com/argoproj/argo-cd/util/diff"
 	kubeutil "github.com/argoproj/argo-cd/util/kube"
+	settings_util "github.com/argoproj/argo-cd/util/settings"
 )
 
 const (
@@ -34,6 +38,21 @@ type AppStateManager interface {
 	CompareAppState(app *v1alpha1.Application, revision string, overrides []v1alpha1.ComponentParameter) (
 		*v1alpha1.ComparisonResult, *repository.ManifestResponse, []v1alpha1.ResourceState, []v1alpha1.ApplicationCondition, error)
 	SyncAppState(app *v1alpha1.Application, state *v1alpha1.OperationState)
+	// ResolveDestinations returns a copy of the application, in which the destinations which select
+	// clusters by their labels are resolved into a destination in each selected cluster
+	ResolveDestinations(app *v1alpha1.Application) (*v1alpha1.Application, error)
+	// UpdateSettings updates the settings used by the comparisons and syncs, which are otherwise got
+	// from the API server. Returns whether the settings which affect the comparisons changed.
+	UpdateSettings(settings *settings_util.ArgoCDSettings) bool
+	// GetNormalizer returns the normalizer of the fields which are ignored when diffing the resources of
+	// the application
+	GetNormalizer(app *v1alpha1.Application) (diff.Normalizer, error)
+	// DiffResources diffs the live objects of the application against its target objects, the same way
+	// as the comparisons do, so resources which use server-side diffs are diffed against a dry-run
+	DiffResources(app *v1alpha1.Application, targetObjs, liveObjs []*unstructured.Unstructured) (*diff.DiffResultList, error)
+	// GetLiveStateSnapshotExpiration returns when the live state rehydrated from the snapshots of the
+	// clusters of the application expires, or the zero time if no snapshot was rehydrated
+	GetLiveStateSnapshotExpiration(app *v1alpha1.Application) time.Time
 }
 
 // appStateManager allows to compare application using KSonnet CLI
@@ -43,6 +62,22 @@ type appStateManager struct {
 	kubectl       kubeutil.Kubectl
 	repoClientset reposerver.Clientset
 	namespace     string
+	liveState     *liveStateBatcher
+	// clusterCache serves the live resources from memory instead of the batcher, unless it is nil
+	clusterCache  *clusterCache
+	diffCache     *diffCache
+	syncArtifacts cache_util.Cache
+	settingsMgr   *settings_util.SettingsManager
+	applyLimiter  concurrencyLimiter
+	// historyRetention controls which deployments are kept in the history
+	historyRetention HistoryRetention
+	// scriptNormalizer runs the normalizer scripts of the resource customizations. It is compiled
+	// again only when the scripts, whose JSON is the scriptNormalizerKey, change.
+	scriptNormalizer     diff.Normalizer
+	scriptNormalizerKey  string
+	scriptNormalizerLock sync.Mutex
+	// settings are the settings last updated by the settings notifier of the controller
+	settings settingsCache
 }
 
 // groupLiveObjects deduplicate list of kubernetes resources and choose correct version of resource: if resource has corresponding expected application resource then method pick
@@ -85,11 +120,12 @@ func groupLiveObjects(liveObjs []*unstructured.Unstructured, targetObjs []*unstr
 	return liveByFullName
 }
 
-func (s *appStateManager) getTargetObjs(app *v1alpha1.Application, revision string, overrides []v1alpha1.ComponentParameter) ([]*unstructured.Unstructured, *repository.ManifestResponse, error) {
+// generateManifests generates the manifests of the application at the given revision
+func (s *appStateManager) generateManifests(ctx context.Context, app *v1alpha1.Application, revision string, overrides []v1alpha1.ComponentParameter) (*repository.ManifestResponse, error) {
 	repo := s.getRepo(app.Spec.Source.RepoURL)
 	conn, repoClient, err := s.repoClientset.NewRepositoryClient()
 	if err != nil {
-		return nil, nil, err
+		return nil, err
 	}
 	defer util.Close(conn)
 
@@ -115,33 +151,197 @@ func (s *appStateManager) getTargetObjs(app *v1alpha1.Application, revision stri
 		}
 	}
 
-	manifestInfo, err := repoClient.GenerateManifest(context.Background(), &repository.ManifestRequest{
+	var apiVersions []string
+	if app.Spec.SyncPolicy.HasSyncOption(common.SyncOptionRewriteDeprecatedAPIs) {
+		apiVersions, err = s.getServerAPIVersions(ctx, app.Spec.Destination.Server)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// only the credentials of the repository of the application are used to fetch remote bases, rather
+	// than getting every repository on each comparison
+	return repoClient.GenerateManifest(ctx, &repository.ManifestRequest{
 		Repo:                        repo,
 		Revision:                    revision,
 		ComponentParameterOverrides: mfReqOverrides,
 		AppLabel:                    app.Name,
 		Namespace:                   app.Spec.Destination.Namespace,
 		ApplicationSource:           &app.Spec.Source,
+		Repos:                       []*v1alpha1.Repository{repo},
+		ApiVersions:                 apiVersions,
+		NoCache:                     hardRefreshRequested(app),
 	})
-	if err != nil {
-		return nil, nil, err
-	}
+}
 
+// getTargetObjs returns the objects of the manifests which are compared with the live state of the
+// application
+func getTargetObjs(app *v1alpha1.Application, manifestInfo *repository.ManifestResponse) ([]*unstructured.Unstructured, error) {
 	targetObjs := make([]*unstructured.Unstructured, 0)
 	for _, manifest := range manifestInfo.Manifests {
 		obj, err := v1alpha1.UnmarshalToUnstructured(manifest)
 		if err != nil {
-			return nil, nil, err
+			return nil, err
 		}
-		if isHook(obj) {
+		// hooks and resources with generated names are created by the syncs, rather than compared
+		if isHook(obj) || hasGeneratedName(obj) {
 			continue
 		}
+		if instanceID := appInstanceID(app); instanceID != "" {
+			err = kubeutil.SetLabel(obj, common.LabelKeyApplicationControllerInstanceID, instanceID)
+			if err != nil {
+				return nil, err
+			}
+		}
 		targetObjs = append(targetObjs, obj)
 	}
-	return targetObjs, manifestInfo, nil
+	return targetObjs, nil
+}
+
+// getServerAPIVersions returns the API versions served by the cluster
+func (s *appStateManager) getServerAPIVersions(ctx context.Context, server string) ([]string, error) {
+	clst, err := s.db.GetCluster(ctx, server)
+	if err != nil {
+		return nil, err
+	}
+	restConfig := kubeutil.ClusterRESTConfig(clst)
+	disco, err := discovery.NewDiscoveryClientForConfig(restConfig)
+	if err != nil {
+		return nil, err
+	}
+	resList, err := kubeutil.GetCachedServerResources(restConfig.Host, disco)
+	if err != nil {
+		return nil, err
+	}
+	apiVersions := make([]string, len(resList))
+	for i, res := range resList {
+		apiVersions[i] = res.GroupVersion
+	}
+	return apiVersions, nil
+}
+
+// GetNormalizer returns the normalizer of the fields which are ignored when diffing the resources of the
+// application
+func (s *appStateManager) GetNormalizer(app *v1alpha1.Application) (diff.Normalizer, error) {
+	return s.getNormalizer(context.Background(), app)
+}
+
+// GetLiveStateSnapshotExpiration returns when the live state rehydrated from the snapshots of the
+// clusters of the application expires. Snapshots are not used along with the cluster cache.
+func (s *appStateManager) GetLiveStateSnapshotExpiration(app *v1alpha1.Application) time.Time {
+	var expiresAt time.Time
+	if s.clusterCache != nil {
+		return expiresAt
+	}
+	for _, dest := range app.Spec.GetDestinations() {
+		if destExpiresAt := s.liveState.getSnapshotExpiration(dest.Server); destExpiresAt.After(expiresAt) {
+			expiresAt = destExpiresAt
+		}
+	}
+	return expiresAt
+}
+
+// DiffResources diffs the live objects of the application against its target objects. Unlike the
+// comparisons, the diffs are not cached.
+func (s *appStateManager) DiffResources(app *v1alpha1.Application, targetObjs, liveObjs []*unstructured.Unstructured) (*diff.DiffResultList, error) {
+	normalizer, err := s.getNormalizer(context.Background(), app)
+	if err != nil {
+		return nil, err
+	}
+	diffResults, err := diff.DiffArray(targetObjs, liveObjs, normalizer)
+	if err != nil {
+		return nil, err
+	}
+	// resources whose dry-run fails keep the diffs against their target state
+	if _, err := s.serverSideDiff(app, targetObjs, liveObjs, normalizer, "", nil, diffResults); err != nil {
+		return nil, err
+	}
+	return diffResults, nil
+}
+
+// getNormalizer returns the normalizer of the fields ignored by the normalizer profiles of the cluster
+// of the application, by the resource customizations of the settings, and by the ignored differences
+// of the application
+func (s *appStateManager) getNormalizer(ctx context.Context, app *v1alpha1.Application) (diff.Normalizer, error) {
+	normalizer, _, err := s.getNormalizerWithKey(ctx, app)
+	return normalizer, err
 }
 
-func (s *appStateManager) getLiveObjs(app *v1alpha1.Application, targetObjs []*unstructured.Unstructured) (
+// getNormalizerWithKey returns the normalizer of the application, and a key which identifies the
+// configuration of the normalizer. The normalizer scripts of the resource customizations run before
+// the ignored fields are removed.
+func (s *appStateManager) getNormalizerWithKey(ctx context.Context, app *v1alpha1.Application) (diff.Normalizer, string, error) {
+	clst, err := s.db.GetCluster(ctx, app.Spec.Destination.Server)
+	if err != nil {
+		return nil, "", err
+	}
+	ignoreDifferences, scripts, err := s.resourceCustomizations()
+	if err != nil {
+		return nil, "", err
+	}
+	ignoreDifferences = append(ignoreDifferences, app.Spec.IgnoreDifferences...)
+	normalizer, err := diff.NewNormalizer(clst.NormalizerProfiles, ignoreDifferences)
+	if err != nil {
+		return nil, "", err
+	}
+	scriptNormalizer, err := s.getScriptNormalizer(scripts)
+	if err != nil {
+		return nil, "", err
+	}
+	config, err := json.Marshal([]interface{}{clst.NormalizerProfiles, ignoreDifferences, scripts})
+	if err != nil {
+		return nil, "", err
+	}
+	return diff.ComposeNormalizers(scriptNormalizer, normalizer), string(config), nil
+}
+
+// getScriptNormalizer returns the normalizer of the scripts, which is reused until the scripts change
+func (s *appStateManager) getScriptNormalizer(scripts []diff.NormalizerScript) (diff.Normalizer, error) {
+	key, err := json.Marshal(scripts)
+	if err != nil {
+		return nil, err
+	}
+	s.scriptNormalizerLock.Lock()
+	defer s.scriptNormalizerLock.Unlock()
+	if s.scriptNormalizer != nil && s.scriptNormalizerKey == string(key) {
+		return s.scriptNormalizer, nil
+	}
+	normalizer, err := diff.NewScriptNormalizer(scripts)
+	if err != nil {
+		return nil, err
+	}
+	s.scriptNormalizer = normalizer
+	s.scriptNormalizerKey = string(key)
+	return normalizer, nil
+}
+
+// resourceCustomizations returns the fields of resources which are ignored when diffing the resources
+// of all applications, and the scripts which normalize them, as configured in the resource
+// customizations of the settings
+func (s *appStateManager) resourceCustomizations() ([]v1alpha1.ResourceIgnoreDifferences, []diff.NormalizerScript, error) {
+	if s.settingsMgr == nil {
+		return nil, nil, nil
+	}
+	settings, err := s.getSettings()
+	if settings == nil {
+		if apierr.IsNotFound(err) {
+			return nil, nil, nil
+		}
+		return nil, nil, err
+	}
+	// the customizations are set in argocd-cm, so errors reading argocd-secret do not matter
+	ignoreDifferences, err := settings.GetResourceIgnoreDifferences()
+	if err != nil {
+		return nil, nil, err
+	}
+	scripts, err := settings.GetResourceNormalizerScripts()
+	if err != nil {
+		return nil, nil, err
+	}
+	return ignoreDifferences, scripts, nil
+}
+
+func (s *appStateManager) getLiveObjs(app *v1alpha1.Application, targetObjs []*unstructured.Unstructured, exclusions resourceFilter) (
 	[]*unstructured.Unstructured, map[string]*unstructured.Unstructured, error) {
 
 	// Get the REST config for the cluster corresponding to the environment
@@ -149,16 +349,22 @@ func (s *appStateManager) getLiveObjs(app *v1alpha1.Application, targetObjs []*u
 	if err != nil {
 		return nil, nil, err
 	}
-	restConfig := clst.RESTConfig()
+	restConfig := kubeutil.ClusterRESTConfig(clst)
 
 	// Retrieve the live versions of the objects. exclude any hook objects
-	labeledObjs, err := kubeutil.GetResourcesWithLabel(restConfig, app.Spec.Destination.Namespace, common.LabelApplicationName, app.Name)
+	var labeledObjs []*unstructured.Unstructured
+	if s.clusterCache != nil {
+		labeledObjs, err = s.clusterCache.getAppLiveObjs(clst, targetKinds(targetObjs), appNamespaces(app, targetObjs), app.Name, appInstanceID(app))
+	} else {
+		labeledObjs, err = s.liveState.getAppLiveObjs(app.Spec.Destination.Server, restConfig, appNamespaces(app, targetObjs), app.Name, appInstanceID(app))
+	}
 	if err != nil {
 		return nil, nil, err
 	}
 	liveObjs := make([]*unstructured.Unstructured, 0)
 	for _, obj := range labeledObjs {
-		if isHook(obj) {
+		// excluded resources are not pruned either
+		if isHook(obj) || exclusions.excludes(obj.GroupVersionKind()) {
 			continue
 		}
 		liveObjs = append(liveObjs, obj)
@@ -169,18 +375,29 @@ func (s *appStateManager) getLiveObjs(app *v1alpha1.Application, targetObjs []*u
 	controlledLiveObj := make([]*unstructured.Unstructured, len(targetObjs))
 
 	// Move live resources which have corresponding target object to controlledLiveObj
-	dynamicIf, err := dynamic.NewForConfig(restConfig)
-	if err != nil {
-		return nil, nil, err
-	}
-	disco, err := discovery.NewDiscoveryClientForConfig(restConfig)
-	if err != nil {
-		return nil, nil, err
+	var dynamicIf dynamic.Interface
+	var disco discovery.DiscoveryInterface
+	if s.clusterCache == nil {
+		dynamicIf, err = dynamic.NewForConfig(restConfig)
+		if err != nil {
+			return nil, nil, err
+		}
+		disco, err = discovery.NewDiscoveryClientForConfig(restConfig)
+		if err != nil {
+			return nil, nil, err
+		}
 	}
 	for i, targetObj := range targetObjs {
 		fullName := getResourceFullName(targetObj)
 		liveObj := liveObjByFullName[fullName]
-		if liveObj == nil && targetObj.GetName() != "" {
+		if liveObj == nil && targetObj.GetName() != "" && s.clusterCache != nil {
+			// the cluster cache watches the resources of the kinds of the target objects regardless of
+			// their labels
+			liveObj, err = s.clusterCache.getLiveObj(clst, targetObj, app.Spec.Destination.Namespace)
+			if err != nil {
+				return nil, nil, err
+			}
+		} else if liveObj == nil && targetObj.GetName() != "" {
 			// If we get here, it indicates we did not find the live resource when querying using
 			// our app label. However, it is possible that the resource was created/modified outside
 			// of Argo CD. In order to determine that it is truly missing, we fall back to perform a
@@ -193,7 +410,11 @@ func (s *appStateManager) getLiveObjs(app *v1alpha1.Application, targetObjs []*u
 				}
 				// If we get here, the app is comprised of a custom resource which has yet to be registered
 			} else {
-				liveObj, err = kubeutil.GetLiveResource(dynamicIf, targetObj, apiResource, app.Spec.Destination.Namespace)
+				namespace := targetObj.GetNamespace()
+				if namespace == "" {
+					namespace = app.Spec.Destination.Namespace
+				}
+				liveObj, err = kubeutil.GetLiveResource(dynamicIf, targetObj, apiResource, namespace)
 				if err != nil {
 					return nil, nil, err
 				}
@@ -205,22 +426,89 @@ func (s *appStateManager) getLiveObjs(app *v1alpha1.Application, targetObjs []*u
 	return controlledLiveObj, liveObjByFullName, nil
 }
 
+// appNamespaces returns the namespaces of the application resources: the destination namespace, the
+// namespaces declared by the target resources, and the namespaces of the resources found by the
+// previous comparison, so that the resources which were removed from the target namespaces are still
+// found, and pruned
+func appNamespaces(app *v1alpha1.Application, targetObjs []*unstructured.Unstructured) []string {
+	namespaces := []string{app.Spec.Destination.Namespace}
+	seen := map[string]bool{app.Spec.Destination.Namespace: true}
+	add := func(namespace string) {
+		if namespace != "" && !seen[namespace] {
+			seen[namespace] = true
+			namespaces = append(namespaces, namespace)
+		}
+	}
+	for _, obj := range targetObjs {
+		add(obj.GetNamespace())
+	}
+	for _, res := range app.Status.ComparisonResult.Resources {
+		add(res.Namespace)
+	}
+	return namespaces
+}
+
 // CompareAppState compares application git state to the live app state, using the specified
 // revision and supplied overrides. If revision or overrides are empty, then compares against
 // revision and overrides in the app spec.
 func (s *appStateManager) CompareAppState(app *v1alpha1.Application, revision string, overrides []v1alpha1.ComponentParameter) (
 	*v1alpha1.ComparisonResult, *repository.ManifestResponse, []v1alpha1.ResourceState, []v1alpha1.ApplicationCondition, error) {
+	// the state is compared in the primary destination of the application, which is the first
+	// selected cluster if the destination selects clusters
+	app, err := s.resolveDestinations(app)
+	if err != nil {
+		return nil, nil, nil, nil, err
+	}
+	return s.compareAppState(context.Background(), app, revision, overrides)
+}
+
+func (s *appStateManager) ResolveDestinations(app *v1alpha1.Application) (*v1alpha1.Application, error) {
+	return s.resolveDestinations(app)
+}
+
+// compareAppState compares the application state. The context is used for the calls to the repo
+// server, and carries the correlation ID of the operation which requested the comparison, if any.
+func (s *appStateManager) compareAppState(ctx context.Context, app *v1alpha1.Application, revision string, overrides []v1alpha1.ComponentParameter) (
+	*v1alpha1.ComparisonResult, *repository.ManifestResponse, []v1alpha1.ResourceState, []v1alpha1.ApplicationCondition, error) {
+	manifestInfo, err := s.generateManifests(ctx, app, revision, overrides)
+	return s.compareAppStateWithManifests(ctx, app, manifestInfo, err)
+}
+
+// compareAppStateWithManifests compares the application state with manifests which were already
+// generated, or reports manifestErr, the error which prevented generating them.
+func (s *appStateManager) compareAppStateWithManifests(ctx context.Context, app *v1alpha1.Application, manifestInfo *repository.ManifestResponse, manifestErr error) (
+	*v1alpha1.ComparisonResult, *repository.ManifestResponse, []v1alpha1.ResourceState, []v1alpha1.ApplicationCondition, error) {
 
 	failedToLoadObjs := false
 	conditions := make([]v1alpha1.ApplicationCondition, 0)
-	targetObjs, manifestInfo, err := s.getTargetObjs(app, revision, overrides)
+	var targetObjs []*unstructured.Unstructured
+	err := manifestErr
+	if err == nil {
+		targetObjs, err = getTargetObjs(app, manifestInfo)
+	}
+	if err != nil {
+		targetObjs = make([]*unstructured.Unstructured, 0)
+		manifestInfo = nil
+		if reposerver.IsUnavailable(err) {
+			conditions = append(conditions, argo.RepoServerUnavailableCondition(err))
+		} else {
+			conditions = append(conditions, v1alpha1.ApplicationCondition{Type: v1alpha1.ApplicationConditionComparisonError, Message: err.Error()})
+		}
+		failedToLoadObjs = true
+	}
+	exclusions, err := resourceExclusions(s.settingsMgr, &s.settings, app.Spec.Destination.Server)
 	if err != nil {
 		targetObjs = make([]*unstructured.Unstructured, 0)
 		conditions = append(conditions, v1alpha1.ApplicationCondition{Type: v1alpha1.ApplicationConditionComparisonError, Message: err.Error()})
 		failedToLoadObjs = true
 	}
+	targetObjs, excludedCondition := filterExcludedObjs(targetObjs, exclusions)
+	if excludedCondition != nil {
+		conditions = append(conditions, *excludedCondition)
+	}
+	conditions = append(conditions, duplicateResourceConditions(app, targetObjs)...)
 
-	controlledLiveObj, liveObjByFullName, err := s.getLiveObjs(app, targetObjs)
+	controlledLiveObj, liveObjByFullName, err := s.getLiveObjs(app, targetObjs, exclusions)
 	if err != nil {
 		controlledLiveObj = make([]*unstructured.Unstructured, len(targetObjs))
 		liveObjByFullName = make(map[string]*unstructured.Unstructured)
@@ -242,7 +530,8 @@ func (s *appStateManager) CompareAppState(app *v1alpha1.Application, revision st
 	// Move root level live resources to controlledLiveObj and add nil to targetObjs to indicate that target object is missing
 	for fullName := range liveObjByFullName {
 		liveObj := liveObjByFullName[fullName]
-		if !hasParent(liveObj) {
+		// the resources created from manifests with generated names are neither diffed nor pruned
+		if !hasParent(liveObj) && liveObj.GetGenerateName() == "" {
 			targetObjs = append(targetObjs, nil)
 			controlledLiveObj = append(controlledLiveObj, liveObj)
 		}
@@ -250,11 +539,28 @@ func (s *appStateManager) CompareAppState(app *v1alpha1.Application, revision st
 
 	log.Infof("Comparing app %s state in cluster %s (namespace: %s)", app.ObjectMeta.Name, app.Spec.Destination.Server, app.Spec.Destination.Namespace)
 
-	// Do the actual comparison
-	diffResults, err := diff.DiffArray(targetObjs, controlledLiveObj)
+	normalizer, normalizerKey, err := s.getNormalizerWithKey(ctx, app)
+	if err != nil {
+		conditions = append(conditions, v1alpha1.ApplicationCondition{Type: v1alpha1.ApplicationConditionComparisonError, Message: err.Error()})
+	}
+
+	// Do the actual comparison. The diffs of the resources which did not change since the previous
+	// comparison are reused, unless a hard refresh was requested. The comparison only needs to know
+	// which resources are modified, so the diffs persisted by a previous controller are reused too.
+	diffCache := s.diffCache
+	if hardRefreshRequested(app) {
+		diffCache = nil
+	}
+	diffResults, err := diffCache.diffArray(targetObjs, controlledLiveObj, normalizer, normalizerKey)
 	if err != nil {
 		return nil, nil, nil, nil, err
 	}
+	serverSideDiffCondition, err := s.serverSideDiff(app, targetObjs, controlledLiveObj, normalizer, normalizerKey, diffCache, diffResults)
+	if err != nil {
+		conditions = append(conditions, v1alpha1.ApplicationCondition{Type: v1alpha1.ApplicationConditionComparisonError, Message: err.Error()})
+	} else if serverSideDiffCondition != nil {
+		conditions = append(conditions, *serverSideDiffCondition)
+	}
 
 	comparisonStatus := v1alpha1.ComparisonStatusSynced
 
@@ -332,12 +638,13 @@ func (s *appStateManager) CompareAppState(app *v1alpha1.Application, revision st
 		}
 		gkv := obj.GroupVersionKind()
 		resourceSummaries[i] = v1alpha1.ResourceSummary{
-			Name:    obj.GetName(),
-			Kind:    gkv.Kind,
-			Version: gkv.Version,
-			Group:   gkv.Group,
-			Status:  resources[i].Status,
-			Health:  resources[i].Health,
+			Name:      obj.GetName(),
+			Namespace: obj.GetNamespace(),
+			Kind:      gkv.Kind,
+			Version:   gkv.Version,
+			Group:     gkv.Group,
+			Status:    resources[i].Status,
+			Health:    resources[i].Health,
 		}
 	}
 	compResult := v1alpha1.ComparisonResult{
@@ -358,6 +665,13 @@ func hasParent(obj *unstructured.Unstructured) bool {
 	return obj.GetKind() == kubeutil.EndpointsKind || metav1.GetControllerOf(obj) != nil
 }
 
+// hasGeneratedName returns whether the name of the object is generated by the API server when it is
+// created, from its metadata.generateName. Such objects cannot be applied, and every sync creates a new
+// object rather than updating the object created by the previous sync.
+func hasGeneratedName(obj *unstructured.Unstructured) bool {
+	return obj.GetName() == "" && obj.GetGenerateName() != ""
+}
+
 func isControlledBy(obj *unstructured.Unstructured, parent *unstructured.Unstructured) bool {
 	// TODO: remove special case after Service and Endpoint get explicit relationship ( https://github.com/kubernetes/kubernetes/issues/28483 )
 	if obj.GetKind() == kubeutil.EndpointsKind && parent.GetKind() == kubeutil.ServiceKind {
@@ -388,6 +702,44 @@ func getChildren(parent *unstructured.Unstructured, liveObjByFullName map[string
 	return children, nil
 }
 
+// duplicateResourceConditions returns an error condition for each resource which is declared more than
+// once by the target objects, since each declaration would overwrite the previous one when syncing.
+// Resources without a namespace are in the destination namespace of the application.
+func duplicateResourceConditions(app *v1alpha1.Application, targetObjs []*unstructured.Unstructured) []v1alpha1.ApplicationCondition {
+	counts := make(map[string]int)
+	var keys []string
+	names := make(map[string]string)
+	for _, obj := range targetObjs {
+		if obj.GetName() == "" {
+			continue
+		}
+		namespace := obj.GetNamespace()
+		if namespace == "" {
+			namespace = app.Spec.Destination.Namespace
+		}
+		gk := obj.GroupVersionKind().GroupKind()
+		key := fmt.Sprintf("%s/%s/%s/%s", gk.Group, gk.Kind, namespace, obj.GetName())
+		if counts[key] == 0 {
+			keys = append(keys, key)
+			names[key] = fmt.Sprintf("%s %s", gk, obj.GetName())
+			if obj.GetNamespace() != "" {
+				names[key] = fmt.Sprintf("%s %s/%s", gk, obj.GetNamespace(), obj.GetName())
+			}
+		}
+		counts[key]++
+	}
+	conditions := make([]v1alpha1.ApplicationCondition, 0)
+	for _, key := range keys {
+		if counts[key] > 1 {
+			conditions = append(conditions, v1alpha1.ApplicationCondition{
+				Type:    v1alpha1.ApplicationConditionDuplicateResourceError,
+				Message: fmt.Sprintf("Resource %s is declared %d times by the manifests", names[key], counts[key]),
+			})
+		}
+	}
+	return conditions
+}
+
 func getResourceFullName(obj *unstructured.Unstructured) string {
 	return fmt.Sprintf("%s:%s", obj.GetKind(), obj.GetName())
 }
@@ -402,7 +754,7 @@ func (s *appStateManager) getRepo(repoURL string) *v1alpha1.Repository {
 }
 
 func (s *appStateManager) persistDeploymentInfo(
-	app *v1alpha1.Application, revision string, envParams []*v1alpha1.ComponentParameter, overrides *[]v1alpha1.ComponentParameter) error {
+	app *v1alpha1.Application, revision string, envParams []*v1alpha1.ComponentParameter, manifests []string, syncOp *v1alpha1.SyncOperation) error {
 
 	params := make([]v1alpha1.ComponentParameter, len(envParams))
 	for i := range envParams {
@@ -413,15 +765,35 @@ func (s *appStateManager) persistDeploymentInfo(
 	if len(app.Status.History) > 0 {
 		nextID = app.Status.History[len(app.Status.History)-1].ID + 1
 	}
+	// the overrides of the sync are recorded, unless it used the overrides of the application
+	overrides := app.Spec.Source.ComponentParameterOverrides
+	preset := ""
+	if syncOp != nil {
+		if syncOp.ParameterOverrides != nil {
+			overrides = syncOp.ParameterOverrides
+		}
+		preset = syncOp.Preset
+	}
+	destination := app.Spec.Destination
+	idempotencyKey := ""
+	if app.Operation != nil {
+		idempotencyKey = app.Operation.IdempotencyKey
+	}
+	now := time.Now().UTC()
 	history := append(app.Status.History, v1alpha1.DeploymentInfo{
-		ComponentParameterOverrides: app.Spec.Source.ComponentParameterOverrides,
+		ComponentParameterOverrides: overrides,
 		Revision:                    revision,
-		DeployedAt:                  metav1.NewTime(time.Now().UTC()),
+		DeployedAt:                  metav1.NewTime(now),
 		ID:                          nextID,
+		ManifestsRef:                s.saveSyncArtifacts(app.Name, nextID, manifests),
+		Preset:                      preset,
+		Destination:                 &destination,
+		IdempotencyKey:              idempotencyKey,
 	})
 
-	if len(history) > maxHistoryCnt {
-		history = history[1 : maxHistoryCnt+1]
+	history, removed := s.historyRetention.trimHistory(history, now)
+	for i := range removed {
+		s.deleteSyncArtifacts(removed[i])
 	}
 
 	patch, err := json.Marshal(map[string]map[string][]v1alpha1.DeploymentInfo{
@@ -436,19 +808,71 @@ func (s *appStateManager) persistDeploymentInfo(
 	return err
 }
 
-// NewAppStateManager creates new instance of Ksonnet app comparator
+// refreshRequested returns whether a normal or hard refresh of the application was requested since its
+// last comparison
+func refreshRequested(app *v1alpha1.Application) bool {
+	return requestedSinceComparison(app, common.AnnotationKeyRefresh) || hardRefreshRequested(app)
+}
+
+// hardRefreshRequested returns whether a hard refresh of the application was requested since its
+// last comparison
+func hardRefreshRequested(app *v1alpha1.Application) bool {
+	return requestedSinceComparison(app, common.AnnotationKeyHardRefresh)
+}
+
+// requestedSinceComparison returns whether the timestamp of the annotation of the application is after
+// its last attempted comparison. Failed comparisons count as attempts, so that a refresh is not repeated
+// until the comparison succeeds.
+func requestedSinceComparison(app *v1alpha1.Application, annotation string) bool {
+	requestedAt, err := time.Parse(time.RFC3339, app.Annotations[annotation])
+	if err != nil {
+		return false
+	}
+	attemptedAt := app.Status.ComparisonResult.ComparedAt
+	if app.Status.ReconciledAt != nil {
+		attemptedAt = *app.Status.ReconciledAt
+	}
+	return attemptedAt.IsZero() || attemptedAt.Time.Before(requestedAt)
+}
+
+// NewAppStateManager creates new instance of Ksonnet app comparator. Live resources of a cluster
+// are shared between comparisons within liveStateBatchWindow, unless it is zero, and are rehydrated
+// from liveStateSnapshots after restarts. If liveStateCache is true, live resources are instead
+// watched and kept in memory, and the batch window and snapshots are not used. The order in which resource kinds are synced is read from
+// the settings, unless settingsMgr is nil. The number of resources pruned or applied in parallel by
+// all syncs is limited to applyConcurrency, unless it is zero. Deployments are kept in the history
+// according to historyRetention.
 func NewAppStateManager(
 	db db.ArgoDB,
 	appclientset appclientset.Interface,
 	repoClientset reposerver.Clientset,
 	namespace string,
 	kubectl kubeutil.Kubectl,
+	liveStateBatchWindow time.Duration,
+	liveStateSnapshots LiveStateSnapshots,
+	liveStateCache bool,
+	syncArtifacts cache_util.Cache,
+	settingsMgr *settings_util.SettingsManager,
+	applyConcurrency int64,
+	historyRetention HistoryRetention,
+	diffStore cache_util.Cache,
 ) AppStateManager {
-	return &appStateManager{
-		db:            db,
-		appclientset:  appclientset,
-		kubectl:       kubectl,
-		repoClientset: repoClientset,
-		namespace:     namespace,
-	}
+	mgr := &appStateManager{
+		db:               db,
+		appclientset:     appclientset,
+		kubectl:          kubectl,
+		repoClientset:    repoClientset,
+		namespace:        namespace,
+		liveState:        newLiveStateBatcher(liveStateBatchWindow, liveStateSnapshots),
+		diffCache:        newDiffCache(diffStore),
+		syncArtifacts:    syncArtifacts,
+		settingsMgr:      settingsMgr,
+		applyLimiter:     newConcurrencyLimiter(applyConcurrency),
+		historyRetention: historyRetention,
+	}
+	if liveStateCache {
+		mgr.clusterCache = newClusterCache()
+		mgr.clusterCache.isExcluded = excludedResourceFilter(settingsMgr, &mgr.settings)
+	}
+	return mgr
 }
@@ -2,10 +2,16 @@ package controller
 
 import (
 	"testing"
+	"time"
 
 	"github.com/ghodss/yaml"
 	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/argoproj/argo-cd/common"
+	"github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/util/diff"
 )
 
 var podManifest = []byte(`
@@ -53,3 +59,118 @@ func TestIsHook(t *testing.T) {
 	pod.SetAnnotations(map[string]string{"argocd.argoproj.io/hook": "Unknown"})
 	assert.False(t, isHook(pod))
 }
+
+func TestHardRefreshRequested(t *testing.T) {
+	requestedAt := time.Date(2019, 1, 1, 10, 0, 0, 0, time.UTC)
+	app := &v1alpha1.Application{}
+	assert.False(t, hardRefreshRequested(app))
+
+	app.Annotations = map[string]string{common.AnnotationKeyHardRefresh: requestedAt.Format(time.RFC3339)}
+	assert.True(t, hardRefreshRequested(app))
+
+	app.Status.ComparisonResult.ComparedAt = metav1.NewTime(requestedAt.Add(-time.Minute))
+	assert.True(t, hardRefreshRequested(app))
+
+	// the comparison following the refresh regenerated the manifests
+	app.Status.ComparisonResult.ComparedAt = metav1.NewTime(requestedAt)
+	assert.False(t, hardRefreshRequested(app))
+}
+
+func TestHardRefreshNotRepeatedAfterFailedComparison(t *testing.T) {
+	requestedAt := time.Date(2019, 1, 1, 10, 0, 0, 0, time.UTC)
+	app := &v1alpha1.Application{}
+	app.Annotations = map[string]string{common.AnnotationKeyHardRefresh: requestedAt.Format(time.RFC3339)}
+	assert.True(t, hardRefreshRequested(app))
+
+	// the comparison failed, so the application was never compared, but the refresh was attempted
+	reconciledAt := metav1.NewTime(requestedAt.Add(time.Second))
+	app.Status.ReconciledAt = &reconciledAt
+	assert.False(t, hardRefreshRequested(app))
+	assert.False(t, refreshRequested(app))
+}
+
+func TestRefreshRequested(t *testing.T) {
+	requestedAt := time.Date(2019, 1, 1, 10, 0, 0, 0, time.UTC)
+	app := &v1alpha1.Application{}
+	app.Status.ComparisonResult.ComparedAt = metav1.NewTime(requestedAt.Add(-time.Minute))
+	assert.False(t, refreshRequested(app))
+
+	app.Annotations = map[string]string{common.AnnotationKeyRefresh: requestedAt.Format(time.RFC3339)}
+	assert.True(t, refreshRequested(app))
+	assert.False(t, hardRefreshRequested(app))
+
+	app.Annotations = map[string]string{common.AnnotationKeyHardRefresh: requestedAt.Format(time.RFC3339)}
+	assert.True(t, refreshRequested(app))
+
+	app.Annotations = map[string]string{common.AnnotationKeyRefresh: "not a timestamp"}
+	assert.False(t, refreshRequested(app))
+}
+
+func TestPersistDeploymentInfoRecordsPreset(t *testing.T) {
+	app := newFakeApp()
+	ctrl := newFakeController(app)
+
+	syncOp := &v1alpha1.SyncOperation{
+		ParameterOverrides: v1alpha1.ParameterOverrides{{Component: "guestbook", Name: "image", Value: "guestbook:v2"}},
+		Preset:             "canary",
+	}
+	err := ctrl.appStateManager.(*appStateManager).persistDeploymentInfo(app, "abc123", nil, []string{"{}"}, syncOp)
+	assert.NoError(t, err)
+
+	app, err = ctrl.applicationClientset.ArgoprojV1alpha1().Applications("argocd").Get("my-app", metav1.GetOptions{})
+	assert.NoError(t, err)
+	assert.Len(t, app.Status.History, 1)
+	assert.Equal(t, "canary", app.Status.History[0].Preset)
+	assert.Equal(t, []v1alpha1.ComponentParameter(syncOp.ParameterOverrides), app.Status.History[0].ComponentParameterOverrides)
+	assert.Equal(t, &app.Spec.Destination, app.Status.History[0].Destination)
+}
+
+func TestDuplicateResourceConditions(t *testing.T) {
+	app := newFakeApp()
+	app.Spec.Destination.Namespace = "default"
+	namespacedPod := newPod()
+	namespacedPod.SetNamespace("default")
+	otherPod := newPod()
+	otherPod.SetNamespace("other")
+	assert.Empty(t, duplicateResourceConditions(app, []*unstructured.Unstructured{newPod(), otherPod}))
+
+	conditions := duplicateResourceConditions(app, []*unstructured.Unstructured{newPod(), otherPod, namespacedPod, newPod()})
+	assert.Equal(t, []v1alpha1.ApplicationCondition{{
+		Type:    v1alpha1.ApplicationConditionDuplicateResourceError,
+		Message: "Resource Pod my-pod is declared 3 times by the manifests",
+	}}, conditions)
+}
+
+func TestAppNamespaces(t *testing.T) {
+	app := newFakeApp()
+	app.Spec.Destination.Namespace = "default"
+	otherPod := newPod()
+	otherPod.SetNamespace("other")
+	// the resources removed from the manifests are still looked up in their namespaces
+	app.Status.ComparisonResult.Resources = []v1alpha1.ResourceSummary{
+		{Kind: "Pod", Name: "my-pod", Namespace: "other"},
+		{Kind: "Pod", Name: "removed-pod", Namespace: "removed"},
+		{Kind: "ClusterRole", Name: "my-role"},
+	}
+	assert.Equal(t, []string{"default", "other", "removed"}, appNamespaces(app, []*unstructured.Unstructured{newPod(), otherPod}))
+}
+
+func TestGetScriptNormalizer(t *testing.T) {
+	mgr := &appStateManager{}
+	scripts := []diff.NormalizerScript{{Kind: "Route", Script: "return obj"}}
+	normalizer, err := mgr.getScriptNormalizer(scripts)
+	assert.NoError(t, err)
+	assert.NotNil(t, normalizer)
+
+	// the compiled scripts are reused until the scripts change
+	reused, err := mgr.getScriptNormalizer([]diff.NormalizerScript{{Kind: "Route", Script: "return obj"}})
+	assert.NoError(t, err)
+	assert.True(t, normalizer == reused)
+
+	changed, err := mgr.getScriptNormalizer([]diff.NormalizerScript{{Kind: "Gateway", Script: "return obj"}})
+	assert.NoError(t, err)
+	assert.False(t, normalizer == changed)
+
+	_, err = mgr.getScriptNormalizer([]diff.NormalizerScript{{Kind: "Route", Script: "obj.spec ="}})
+	assert.Error(t, err)
+}
@@ -3,8 +3,11 @@ package controller
 import (
 	"context"
 	"fmt"
+	"path/filepath"
 	"sort"
+	"strings"
 	"sync"
+	"time"
 
 	log "github.com/sirupsen/logrus"
 	apierr "k8s.io/apimachinery/pkg/api/errors"
@@ -15,10 +18,15 @@ import (
 	"k8s.io/client-go/dynamic"
 	"k8s.io/client-go/rest"
 
+	"github.com/argoproj/argo-cd/common"
 	appv1 "github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1"
 	"github.com/argoproj/argo-cd/reposerver/repository"
 	"github.com/argoproj/argo-cd/util/argo"
+	"github.com/argoproj/argo-cd/util/diff"
+	grpc_util "github.com/argoproj/argo-cd/util/grpc"
 	"github.com/argoproj/argo-cd/util/kube"
+	"github.com/argoproj/argo-cd/util/policy"
+	"github.com/argoproj/argo-cd/util/redact"
 )
 
 type syncContext struct {
@@ -30,18 +38,117 @@ type syncContext struct {
 	dynamicIf     dynamic.Interface
 	disco         discovery.DiscoveryInterface
 	kubectl       kube.Kubectl
+	server        string
 	namespace     string
 	syncOp        *appv1.SyncOperation
+	syncPolicy    *appv1.SyncPolicy
 	syncRes       *appv1.SyncOperationResult
 	syncResources []appv1.SyncOperationResource
 	opState       *appv1.OperationState
 	manifestInfo  *repository.ManifestResponse
 	log           *log.Entry
+	// resourceOrder is the order in which resource kinds are applied. Defaults to the built-in order
+	resourceOrder sortOrder
+	// applyTimeouts are the timeouts of the kubectl calls which apply, replace or delete resources of
+	// specific kinds. The first timeout matching the kind of a resource applies.
+	applyTimeouts []applyTimeout
+	// requestTimeout is the request timeout of the network config of the cluster. Used by the kubectl
+	// calls of resources without an apply timeout
+	requestTimeout time.Duration
+	// applyLimiter limits the number of resources pruned or applied in parallel by all syncs of the
+	// controller
+	applyLimiter concurrencyLimiter
+	// moveSource is the previous destination of a moved application, whose resources are pruned
+	// once the sync completed, or nil
+	moveSource *moveSource
+	// policy is the policy service which is consulted before the sync, or nil
+	policy policy.Checker
+	// policyFailOpen allows the sync if the policy service cannot be consulted
+	policyFailOpen bool
+	// instanceID is the ID of the controller instance which manages the application. Hooks are
+	// labeled with it, so that they are tracked by the same instance.
+	instanceID string
+	// progressingDeadline fails the sync once the resources it waits for have been Progressing for
+	// longer than the progressing deadline of the application
+	progressingDeadline progressingDeadline
+	// normalizer and redactor are applied to the diffs previewed by dry-run syncs
+	normalizer diff.Normalizer
+	redactor   *redact.Redactor
 	// lock to protect concurrent updates of the result list
 	lock sync.Mutex
 }
 
 func (s *appStateManager) SyncAppState(app *appv1.Application, state *appv1.OperationState) {
+	if !s.syncWindowsPermit(app, state, time.Now()) {
+		return
+	}
+	resolved, err := s.resolveDestinations(app)
+	if err != nil {
+		state.Phase = appv1.OperationError
+		state.Message = fmt.Sprintf("Failed to resolve the destinations of the application: %v", err)
+		return
+	}
+	if resolved.Spec.HasAdditionalDestinations() {
+		s.syncAppDestinations(app, resolved.Spec.GetDestinations(), state)
+		return
+	}
+	// the deployment history records the destination of the application, rather than the cluster
+	// its selector resolved to
+	manifestInfo := s.syncAppDestination(resolved, state, nil)
+	if manifestInfo != nil {
+		s.persistSync(app, state, manifestInfo)
+	}
+}
+
+// syncWindowsPermit returns whether the sync windows of the project of the application permit starting
+// the sync operation at the given time, and fails the operation if not. Operations which already
+// started, dry runs and operations which override the sync windows are always permitted.
+func (s *appStateManager) syncWindowsPermit(app *appv1.Application, state *appv1.OperationState, now time.Time) bool {
+	syncOp := state.Operation.Sync
+	if syncOp == nil || syncOp.DryRun || syncOp.OverrideSyncWindows || state.SyncResult != nil || state.Phase != appv1.OperationRunning {
+		return true
+	}
+	proj, err := argo.GetAppProject(&app.Spec, s.appclientset, s.namespace)
+	if err != nil {
+		state.Phase = appv1.OperationError
+		state.Message = fmt.Sprintf("Failed to load application project: %v", err)
+		return false
+	}
+	if ok, reason := proj.IsSyncPermitted(app.Name, now); !ok {
+		state.Phase = appv1.OperationFailed
+		state.Message = fmt.Sprintf("Sync is not permitted by the sync windows: %s. Override the sync windows to sync anyway in an emergency", reason)
+		return false
+	}
+	return true
+}
+
+// repoServerUnavailable returns whether all the error conditions report that no repo server is available
+func repoServerUnavailable(errConditions []appv1.ApplicationCondition) bool {
+	for _, condition := range errConditions {
+		if condition.Type != appv1.ApplicationConditionRepoServerUnavailableError {
+			return false
+		}
+	}
+	return true
+}
+
+// isWaitingForRepoServer returns whether a running operation, or the operation in a destination, waits
+// for a repo server to become available
+func isWaitingForRepoServer(phase appv1.OperationPhase, message string) bool {
+	return phase == appv1.OperationRunning && strings.HasPrefix(message, waitingForRepoServerMessage)
+}
+
+// renderedManifests are the manifests generated ahead of a sync, or the error which prevented
+// generating them
+type renderedManifests struct {
+	manifestInfo *repository.ManifestResponse
+	err          error
+}
+
+// syncAppDestination syncs the application to its destination, and returns the manifests of the
+// sync, or nil if the manifests could not be generated. The manifests are generated for the sync,
+// unless they were already rendered.
+func (s *appStateManager) syncAppDestination(app *appv1.Application, state *appv1.OperationState, rendered *renderedManifests) *repository.ManifestResponse {
 	// Sync requests might be requested with ambiguous revisions (e.g. master, HEAD, v1.2.3).
 	// This can change meaning when resuming operations (e.g a hook sync). After calculating a
 	// concrete git commit SHA, the SHA is remembered in the status.operationState.syncResult and
@@ -67,7 +174,7 @@ func (s *appStateManager) SyncAppState(app *appv1.Application, state *appv1.Oper
 	} else {
 		state.Phase = appv1.OperationFailed
 		state.Message = "Invalid operation request: no operation specified"
-		return
+		return nil
 	}
 
 	if revision == "" {
@@ -77,11 +184,25 @@ func (s *appStateManager) SyncAppState(app *appv1.Application, state *appv1.Oper
 		revision = syncOp.Revision
 	}
 
-	comparison, manifestInfo, resources, conditions, err := s.CompareAppState(app, revision, overrides)
+	// syncs must act on the current live state, and leave fresh live state to subsequent comparisons
+	s.liveState.invalidate(app.Spec.Destination.Server)
+	defer s.liveState.invalidate(app.Spec.Destination.Server)
+
+	ctx := grpc_util.ContextWithCorrelationID(context.Background(), state.Operation.CorrelationID)
+	var comparison *appv1.ComparisonResult
+	var manifestInfo *repository.ManifestResponse
+	var resources []appv1.ResourceState
+	var conditions []appv1.ApplicationCondition
+	var err error
+	if rendered != nil {
+		comparison, manifestInfo, resources, conditions, err = s.compareAppStateWithManifests(ctx, app, rendered.manifestInfo, rendered.err)
+	} else {
+		comparison, manifestInfo, resources, conditions, err = s.compareAppState(ctx, app, revision, overrides)
+	}
 	if err != nil {
 		state.Phase = appv1.OperationError
 		state.Message = err.Error()
-		return
+		return nil
 	}
 	errConditions := make([]appv1.ApplicationCondition, 0)
 	for i := range conditions {
@@ -90,9 +211,14 @@ func (s *appStateManager) SyncAppState(app *appv1.Application, state *appv1.Oper
 		}
 	}
 	if len(errConditions) > 0 {
+		if repoServerUnavailable(errConditions) && time.Since(state.StartedAt.Time) < repoServerWaitTimeout {
+			// wait for the repo server, instead of failing the operation the moment a repo server restarts
+			state.Message = fmt.Sprintf("%s: %s", waitingForRepoServerMessage, argo.FormatAppConditions(errConditions))
+			return nil
+		}
 		state.Phase = appv1.OperationError
 		state.Message = argo.FormatAppConditions(errConditions)
-		return
+		return nil
 	}
 	// We now have a concrete commit SHA. Set this in the sync result revision so that we remember
 	// what we should be syncing to when resuming operations.
@@ -102,46 +228,104 @@ func (s *appStateManager) SyncAppState(app *appv1.Application, state *appv1.Oper
 	if err != nil {
 		state.Phase = appv1.OperationError
 		state.Message = err.Error()
-		return
+		return nil
 	}
 
-	restConfig := clst.RESTConfig()
+	restConfig := kube.ClusterRESTConfig(clst)
 	dynamicIf, err := dynamic.NewForConfig(restConfig)
 	if err != nil {
 		state.Phase = appv1.OperationError
 		state.Message = fmt.Sprintf("Failed to initialize dynamic client: %v", err)
-		return
+		return nil
 	}
 	disco, err := discovery.NewDiscoveryClientForConfig(restConfig)
 	if err != nil {
 		state.Phase = appv1.OperationError
 		state.Message = fmt.Sprintf("Failed to initialize discovery client: %v", err)
-		return
+		return nil
 	}
 
 	proj, err := argo.GetAppProject(&app.Spec, s.appclientset, s.namespace)
 	if err != nil {
 		state.Phase = appv1.OperationError
 		state.Message = fmt.Sprintf("Failed to load application project: %v", err)
-		return
+		return nil
+	}
+
+	order, err := s.resourceOrder()
+	if err != nil {
+		state.Phase = appv1.OperationError
+		state.Message = fmt.Sprintf("Failed to load resource order: %v", err)
+		return nil
+	}
+
+	timeouts, err := s.applyTimeouts()
+	if err != nil {
+		state.Phase = appv1.OperationError
+		state.Message = fmt.Sprintf("Failed to load resource apply timeouts: %v", err)
+		return nil
 	}
 
 	syncCtx := syncContext{
-		appName:       app.Name,
-		proj:          proj,
-		comparison:    comparison,
-		config:        restConfig,
-		dynamicIf:     dynamicIf,
-		disco:         disco,
-		kubectl:       s.kubectl,
-		namespace:     app.Spec.Destination.Namespace,
-		syncOp:        &syncOp,
-		syncRes:       syncRes,
-		syncResources: syncResources,
-		opState:       state,
-		manifestInfo:  manifestInfo,
-		log:           log.WithFields(log.Fields{"application": app.Name}),
-		resources:     resources,
+		appName:             app.Name,
+		proj:                proj,
+		comparison:          comparison,
+		config:              restConfig,
+		dynamicIf:           dynamicIf,
+		disco:               disco,
+		kubectl:             s.kubectl,
+		server:              app.Spec.Destination.Server,
+		namespace:           app.Spec.Destination.Namespace,
+		syncOp:              &syncOp,
+		syncPolicy:          app.Spec.SyncPolicy,
+		syncRes:             syncRes,
+		syncResources:       syncResources,
+		opState:             state,
+		manifestInfo:        manifestInfo,
+		log:                 grpc_util.LogEntry(ctx).WithField("application", app.Name),
+		resources:           resources,
+		resourceOrder:       order,
+		applyTimeouts:       timeouts,
+		applyLimiter:        s.applyLimiter,
+		instanceID:          appInstanceID(app),
+		progressingDeadline: newProgressingDeadline(app),
+	}
+
+	// resources which were already Progressing before the operation started are given the whole deadline
+	syncCtx.progressingDeadline.startedAt = state.StartedAt.Time
+	if clst.Config.NetworkConfig != nil {
+		syncCtx.requestTimeout = time.Duration(clst.Config.NetworkConfig.RequestTimeoutSeconds) * time.Second
+	}
+
+	syncCtx.policy, syncCtx.policyFailOpen, err = s.policyChecker()
+	if err != nil {
+		state.Phase = appv1.OperationError
+		state.Message = fmt.Sprintf("Failed to load policy service: %v", err)
+		return nil
+	}
+
+	if syncOp.DryRun {
+		syncCtx.normalizer, err = s.getNormalizer(ctx, app)
+		if err != nil {
+			state.Phase = appv1.OperationError
+			state.Message = fmt.Sprintf("Failed to load normalizer: %v", err)
+			return nil
+		}
+		syncCtx.redactor, err = s.redactor()
+		if err != nil {
+			state.Phase = appv1.OperationError
+			state.Message = fmt.Sprintf("Failed to load resource redactions: %v", err)
+			return nil
+		}
+	}
+
+	if syncOp.MoveFrom != nil && !syncOp.DryRun && len(syncRes.MovedResources) == 0 && state.Phase != appv1.OperationTerminating {
+		syncCtx.moveSource, err = s.getMoveSource(app, *syncOp.MoveFrom, resources)
+		if err != nil {
+			state.Phase = appv1.OperationError
+			state.Message = err.Error()
+			return nil
+		}
 	}
 
 	if state.Phase == appv1.OperationTerminating {
@@ -149,13 +333,72 @@ func (s *appStateManager) SyncAppState(app *appv1.Application, state *appv1.Oper
 	} else {
 		syncCtx.sync()
 	}
+	return manifestInfo
+}
+
+// resourceOrder returns the order in which resource kinds are applied, as configured in the cached
+// settings
+func (s *appStateManager) resourceOrder() (sortOrder, error) {
+	if s.settingsMgr == nil {
+		return resourceOrder, nil
+	}
+	settings, err := s.getSettings()
+	if settings == nil {
+		if apierr.IsNotFound(err) {
+			return resourceOrder, nil
+		}
+		return nil, err
+	}
+	// the order is set in argocd-cm, so errors reading argocd-secret do not matter
+	if len(settings.ResourceOrder) == 0 {
+		return resourceOrder, nil
+	}
+	return settings.ResourceOrder, nil
+}
+
+// applyTimeout is the timeout of the kubectl calls of the resources whose group and kind match the
+// glob patterns
+type applyTimeout struct {
+	group   string
+	kind    string
+	timeout time.Duration
+}
 
-	if !syncOp.DryRun && len(syncOp.Resources) == 0 && syncCtx.opState.Phase.Successful() {
-		err := s.persistDeploymentInfo(app, manifestInfo.Revision, manifestInfo.Params, nil)
+// applyTimeouts returns the timeouts of the kubectl calls of resources, as configured in the cached
+// settings
+func (s *appStateManager) applyTimeouts() ([]applyTimeout, error) {
+	if s.settingsMgr == nil {
+		return nil, nil
+	}
+	settings, err := s.getSettings()
+	if settings == nil {
+		if apierr.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	// the timeouts are set in argocd-cm, so errors reading argocd-secret do not matter
+	var timeouts []applyTimeout
+	for _, t := range settings.ResourceApplyTimeouts {
+		timeout, err := time.ParseDuration(t.Timeout)
 		if err != nil {
-			state.Phase = appv1.OperationError
-			state.Message = fmt.Sprintf("failed to record sync to history: %v", err)
+			return nil, fmt.Errorf("invalid timeout of kind %s: %v", t.Kind, err)
 		}
+		timeouts = append(timeouts, applyTimeout{group: t.Group, kind: t.Kind, timeout: timeout})
+	}
+	return timeouts, nil
+}
+
+// persistSync records a successful sync of the whole application to the application history
+func (s *appStateManager) persistSync(app *appv1.Application, state *appv1.OperationState, manifestInfo *repository.ManifestResponse) {
+	syncOp := state.Operation.Sync
+	if syncOp == nil || syncOp.DryRun || syncOp.IsPartial() || !state.Phase.Successful() {
+		return
+	}
+	err := s.persistDeploymentInfo(app, manifestInfo.Revision, manifestInfo.Params, manifestInfo.Manifests, syncOp)
+	if err != nil {
+		state.Phase = appv1.OperationError
+		state.Message = fmt.Sprintf("failed to record sync to history: %v", err)
 	}
 }
 
@@ -165,6 +408,10 @@ func (s *appStateManager) SyncAppState(app *appv1.Application, state *appv1.Oper
 type syncTask struct {
 	liveObj   *unstructured.Unstructured
 	targetObj *unstructured.Unstructured
+	// wave is the sync wave the task is applied in
+	wave int
+	// syncStatus is the comparison status of the resource before the sync
+	syncStatus appv1.ComparisonStatus
 }
 
 // sync has performs the actual apply or hook based sync
@@ -177,10 +424,32 @@ func (sc *syncContext) sync() {
 	// If no sync tasks were generated (e.g., in case all application manifests have been removed),
 	// set the sync operation as successful.
 	if len(syncTasks) == 0 {
+		if !sc.pruneMoveSource(syncTasks) {
+			return
+		}
 		sc.setOperationPhase(appv1.OperationSucceeded, "successfully synced (no manifests)")
 		return
 	}
 
+	// The policy service is consulted once per operation, before anything is created or applied
+	if !sc.startedPreSyncPhase() && !sc.checkPolicy(syncTasks) {
+		return
+	}
+
+	// Resource quotas are checked before anything is applied, rather than failing part way through
+	if !sc.startedPreSyncPhase() && !sc.checkQuota(syncTasks) {
+		return
+	}
+
+	// The destination namespace is created once per operation, before the dry-run, so that the
+	// resources of the application can be validated in it
+	if !sc.startedPreSyncPhase() && !sc.syncOp.DryRun && sc.syncPolicy.HasSyncOption(common.SyncOptionCreateNamespace) {
+		if err := sc.ensureNamespace(); err != nil {
+			sc.setOperationPhase(appv1.OperationError, fmt.Sprintf("failed to create namespace %s: %v", sc.namespace, err))
+			return
+		}
+	}
+
 	// Perform a `kubectl apply --dry-run` against all the manifests. This will detect most (but
 	// not all) validation issues with the user's manifests (e.g. will detect syntax issues, but
 	// will not not detect if they are mutating immutable fields). If anything fails, we will refuse
@@ -194,6 +463,9 @@ func (sc *syncContext) sync() {
 			return
 		}
 		if sc.syncOp.DryRun {
+			if !sc.planHooks() {
+				return
+			}
 			sc.setOperationPhase(appv1.OperationSucceeded, "successfully synced (dry run)")
 			return
 		}
@@ -205,14 +477,19 @@ func (sc *syncContext) sync() {
 		sc.syncOp.SyncStrategy = &appv1.SyncStrategy{Hook: &appv1.SyncStrategyHook{}}
 	}
 	if sc.syncOp.SyncStrategy.Apply != nil {
-		if !sc.startedSyncPhase() {
-			if !sc.doApplySync(syncTasks, false, sc.syncOp.SyncStrategy.Apply.Force, true) {
-				sc.setOperationPhase(appv1.OperationFailed, "one or more objects failed to apply")
-				return
-			}
+		if sc.hasPendingWaves(syncTasks) {
+			sc.applyNextWave(syncTasks, sc.syncOp.SyncStrategy.Apply.Force)
 			// If apply was successful, return here and force an app refresh. This is so the app
 			// will become requeued into the workqueue, to force a new sync/health assessment before
-			// marking the operation as completed
+			// applying the next wave or marking the operation as completed
+			return
+		}
+		if !sc.checkDeletions(syncTasks) {
+			return
+		}
+		// the resources of a moved application are pruned from its previous destination once
+		// they are healthy in the new destination
+		if !sc.pruneMoveSource(syncTasks) {
 			return
 		}
 		sc.setOperationPhase(appv1.OperationSucceeded, "successfully synced")
@@ -223,6 +500,8 @@ func (sc *syncContext) sync() {
 			return
 		}
 		sc.doHookSync(syncTasks, hooks)
+	} else if sc.syncOp.SyncStrategy.Progressive != nil {
+		sc.doProgressiveSync(syncTasks)
 	} else {
 		sc.setOperationPhase(appv1.OperationFailed, "Unknown sync strategy")
 		return
@@ -236,7 +515,11 @@ func (sc *syncContext) forceAppRefresh() {
 // generateSyncTasks() generates the list of sync tasks we will be performing during this sync.
 func (sc *syncContext) generateSyncTasks() ([]syncTask, bool) {
 	syncTasks := make([]syncTask, 0)
+	outOfSyncOnly := sc.syncPolicy.HasSyncOption(common.SyncOptionApplyOutOfSyncOnly)
 	for _, resourceState := range sc.resources {
+		if outOfSyncOnly && resourceState.Status == appv1.ComparisonStatusSynced {
+			continue
+		}
 		liveObj, err := resourceState.LiveObject()
 		if err != nil {
 			sc.setOperationPhase(appv1.OperationError, fmt.Sprintf("Failed to unmarshal live object: %v", err))
@@ -247,44 +530,117 @@ func (sc *syncContext) generateSyncTasks() ([]syncTask, bool) {
 			sc.setOperationPhase(appv1.OperationError, fmt.Sprintf("Failed to unmarshal target object: %v", err))
 			return nil, false
 		}
+		if (liveObj != nil && argo.ContainsSyncResource(liveObj.GetName(), liveObj.GroupVersionKind(), sc.syncOp.ExcludedResources)) ||
+			(targetObj != nil && argo.ContainsSyncResource(targetObj.GetName(), targetObj.GroupVersionKind(), sc.syncOp.ExcludedResources)) {
+			continue
+		}
+		if !sc.isRetriedResource(liveObj, targetObj) {
+			continue
+		}
 		if sc.syncResources == nil ||
 			(liveObj != nil && argo.ContainsSyncResource(liveObj.GetName(), liveObj.GroupVersionKind(), sc.syncResources)) ||
 			(targetObj != nil && argo.ContainsSyncResource(targetObj.GetName(), targetObj.GroupVersionKind(), sc.syncResources)) {
 
+			wave, err := syncWave(targetObj)
+			if err == nil && targetObj == nil {
+				wave, err = syncWave(liveObj)
+			}
+			if err != nil {
+				sc.setOperationPhase(appv1.OperationError, err.Error())
+				return nil, false
+			}
 			syncTask := syncTask{
-				liveObj:   liveObj,
-				targetObj: targetObj,
+				liveObj:    liveObj,
+				targetObj:  targetObj,
+				wave:       wave,
+				syncStatus: resourceState.Status,
 			}
 			syncTasks = append(syncTasks, syncTask)
 		}
 	}
+	generatedNameTasks, err := sc.generatedNameTasks()
+	if err != nil {
+		sc.setOperationPhase(appv1.OperationError, fmt.Sprintf("Failed to unmarshal target object: %v", err))
+		return nil, false
+	}
+	syncTasks = append(syncTasks, generatedNameTasks...)
 
-	sort.Sort(newKindSorter(syncTasks, resourceOrder))
+	order := sc.resourceOrder
+	if order == nil {
+		order = resourceOrder
+	}
+	sort.Sort(newKindSorter(syncTasks, order))
+	// resources are applied wave by wave, keeping the kind order within each wave
+	sort.SliceStable(syncTasks, func(i, j int) bool {
+		return syncTasks[i].wave < syncTasks[j].wave
+	})
 	return syncTasks, true
 }
 
-// startedPreSyncPhase detects if we already started the PreSync stage of a sync operation.
-// This is equal to if we have anything in our resource or hook list
-func (sc *syncContext) startedPreSyncPhase() bool {
-	if len(sc.syncRes.Resources) > 0 {
-		return true
+// generatedNameTasks returns the sync tasks of the manifests of objects with generated names, which
+// are not compared, and are created by every sync. Since they cannot be selected by name, they are not
+// synced by selective syncs.
+func (sc *syncContext) generatedNameTasks() ([]syncTask, error) {
+	if sc.manifestInfo == nil || sc.syncResources != nil {
+		return nil, nil
 	}
-	if len(sc.syncRes.Hooks) > 0 {
+	var syncTasks []syncTask
+	for _, manifest := range sc.manifestInfo.Manifests {
+		targetObj, err := appv1.UnmarshalToUnstructured(manifest)
+		if err != nil {
+			return nil, err
+		}
+		if isHook(targetObj) || !hasGeneratedName(targetObj) || !sc.isRetriedResource(nil, targetObj) {
+			continue
+		}
+		wave, err := syncWave(targetObj)
+		if err != nil {
+			return nil, err
+		}
+		syncTasks = append(syncTasks, syncTask{targetObj: targetObj, wave: wave, syncStatus: appv1.ComparisonStatusOutOfSync})
+	}
+	return syncTasks, nil
+}
+
+// isResultOf returns whether the resource details are the result of syncing the object. The results
+// of objects with generated names are identified by the generate name of the object.
+func isResultOf(res *appv1.ResourceDetails, obj *unstructured.Unstructured) bool {
+	if res.Kind != obj.GetKind() {
+		return false
+	}
+	if hasGeneratedName(obj) {
+		return res.GenerateName == obj.GetGenerateName()
+	}
+	return res.Name == obj.GetName()
+}
+
+// isRetriedResource returns whether the resource is synced by the operation, which is the case unless
+// the operation is a retry of only the resources which failed in the previous attempt
+func (sc *syncContext) isRetriedResource(liveObj, targetObj *unstructured.Unstructured) bool {
+	if len(sc.syncRes.FailedResources) == 0 {
 		return true
 	}
+	for _, obj := range []*unstructured.Unstructured{liveObj, targetObj} {
+		if obj == nil {
+			continue
+		}
+		for _, res := range sc.syncRes.FailedResources {
+			if isResultOf(res, obj) {
+				return true
+			}
+		}
+	}
 	return false
 }
 
-// startedSyncPhase detects if we have already started the Sync stage of a sync operation.
-// This is equal to if the resource list is non-empty, or we we see Sync/PostSync hooks
-func (sc *syncContext) startedSyncPhase() bool {
+// startedPreSyncPhase detects if we already started the PreSync stage of a sync operation.
+// This is equal to if we have anything in our resource or hook list
+func (sc *syncContext) startedPreSyncPhase() bool {
 	if len(sc.syncRes.Resources) > 0 {
 		return true
 	}
-	for _, hookStatus := range sc.syncRes.Hooks {
-		if hookStatus.Type == appv1.HookTypeSync || hookStatus.Type == appv1.HookTypePostSync {
-			return true
-		}
+	if len(sc.syncRes.Hooks) > 0 {
+		return true
 	}
 	return false
 }
@@ -308,14 +664,49 @@ func (sc *syncContext) setOperationPhase(phase appv1.OperationPhase, message str
 	sc.opState.Message = message
 }
 
+// kubectlConfig returns the config of the kubectl calls which apply, replace or delete the resource,
+// which times out after the first apply timeout matching the kind of the resource
+func (sc *syncContext) kubectlConfig(obj *unstructured.Unstructured) *rest.Config {
+	gvk := obj.GroupVersionKind()
+	for _, t := range sc.applyTimeouts {
+		if globMatch(t.group, gvk.Group) && globMatch(t.kind, gvk.Kind) {
+			config := rest.CopyConfig(sc.config)
+			config.Timeout = t.timeout
+			return config
+		}
+	}
+	if sc.requestTimeout > 0 {
+		config := rest.CopyConfig(sc.config)
+		config.Timeout = sc.requestTimeout
+		return config
+	}
+	return sc.config
+}
+
+// globMatch returns whether the value matches the glob pattern
+func globMatch(pattern, value string) bool {
+	ok, err := filepath.Match(pattern, value)
+	return ok && err == nil
+}
+
 // applyObject performs a `kubectl apply` of a single resource
 func (sc *syncContext) applyObject(targetObj *unstructured.Unstructured, dryRun bool, force bool) appv1.ResourceDetails {
 	resDetails := appv1.ResourceDetails{
 		Name:      targetObj.GetName(),
 		Kind:      targetObj.GetKind(),
-		Namespace: sc.namespace,
+		Namespace: sc.resourceNamespace(targetObj),
+	}
+	message, err := sc.kubectl.ApplyResource(sc.kubectlConfig(targetObj), targetObj, resDetails.Namespace, dryRun, force, sc.shouldValidate(targetObj))
+	if err != nil && !dryRun && kube.IsImmutableFieldError(err) && sc.shouldReplaceOnImmutableFieldError(targetObj) {
+		sc.log.Infof("Replacing %s/%s after apply failed: %v", targetObj.GetKind(), targetObj.GetName(), err)
+		resDetails = sc.replaceObject(targetObj)
+		if resDetails.Status.Successful() {
+			resDetails.Message = fmt.Sprintf("replaced after apply failed: %v. %s", err, resDetails.Message)
+		} else {
+			resDetails.Message = fmt.Sprintf("apply failed: %v. replace failed: %s", err, resDetails.Message)
+		}
+		return resDetails
 	}
-	message, err := sc.kubectl.ApplyResource(sc.config, targetObj, sc.namespace, dryRun, force)
 	if err != nil {
 		resDetails.Message = err.Error()
 		resDetails.Status = appv1.ResourceDetailsSyncFailed
@@ -327,6 +718,135 @@ func (sc *syncContext) applyObject(targetObj *unstructured.Unstructured, dryRun
 	return resDetails
 }
 
+// createObject creates an object whose name is generated by the API server. Such objects cannot be
+// applied, so they are created using the dynamic client, and dry runs only report them as created.
+func (sc *syncContext) createObject(targetObj *unstructured.Unstructured, serverRes *metav1.APIResource, dryRun bool) appv1.ResourceDetails {
+	resDetails := appv1.ResourceDetails{
+		Kind:         targetObj.GetKind(),
+		Namespace:    sc.resourceNamespace(targetObj),
+		GenerateName: targetObj.GetGenerateName(),
+	}
+	if dryRun {
+		resDetails.Message = fmt.Sprintf("%s/%s created (dry run)", strings.ToLower(targetObj.GetKind()), targetObj.GetGenerateName())
+		resDetails.Status = appv1.ResourceDetailsSynced
+		return resDetails
+	}
+	obj := targetObj.DeepCopy()
+	err := kube.SetLabel(obj, common.LabelApplicationName, sc.appName)
+	if err == nil && sc.instanceID != "" {
+		err = kube.SetLabel(obj, common.LabelKeyApplicationControllerInstanceID, sc.instanceID)
+	}
+	if err == nil {
+		gvk := obj.GroupVersionKind()
+		resource := kube.ToGroupVersionResource(gvk.GroupVersion().String(), serverRes)
+		obj, err = kube.ToResourceInterface(sc.dynamicIf, serverRes, resource, resDetails.Namespace).Create(obj, metav1.CreateOptions{})
+	}
+	if err != nil {
+		resDetails.Message = err.Error()
+		resDetails.Status = appv1.ResourceDetailsSyncFailed
+		return resDetails
+	}
+	resDetails.Name = obj.GetName()
+	resDetails.Message = fmt.Sprintf("%s/%s created", strings.ToLower(obj.GetKind()), obj.GetName())
+	resDetails.Status = appv1.ResourceDetailsSynced
+	return resDetails
+}
+
+// replaceObject deletes and re-creates the object, which allows changing immutable fields
+func (sc *syncContext) replaceObject(targetObj *unstructured.Unstructured) appv1.ResourceDetails {
+	resDetails := appv1.ResourceDetails{
+		Name:      targetObj.GetName(),
+		Kind:      targetObj.GetKind(),
+		Namespace: sc.resourceNamespace(targetObj),
+	}
+	message, err := sc.kubectl.ReplaceResource(sc.kubectlConfig(targetObj), targetObj, resDetails.Namespace, sc.shouldValidate(targetObj))
+	if err != nil {
+		resDetails.Message = err.Error()
+		resDetails.Status = appv1.ResourceDetailsSyncFailed
+		return resDetails
+	}
+
+	resDetails.Message = message
+	resDetails.Status = appv1.ResourceDetailsSynced
+	return resDetails
+}
+
+// shouldValidate returns whether the object is validated against its schema when it is applied. The
+// validation is disabled by the Validate=false sync option of the object or of the application.
+func (sc *syncContext) shouldValidate(obj *unstructured.Unstructured) bool {
+	return !argo.HasSyncOption(obj, common.SyncOptionDisableValidation) && !sc.syncPolicy.HasSyncOption(common.SyncOptionDisableValidation)
+}
+
+// shouldReplace returns whether the task should be synced by replacing the live resource, as
+// requested by the Replace sync option of the resource or the application. Resources which do not
+// exist yet or are in sync are applied as usual.
+func (sc *syncContext) shouldReplace(task syncTask) bool {
+	if task.liveObj == nil || task.syncStatus != appv1.ComparisonStatusOutOfSync {
+		return false
+	}
+	return argo.HasSyncOption(task.targetObj, common.SyncOptionReplace) || sc.syncPolicy.HasSyncOption(common.SyncOptionReplace)
+}
+
+// shouldReplaceOnImmutableFieldError returns whether the object is replaced when its apply fails
+// because an immutable field changed, as requested by the ReplaceOnImmutableFieldError sync option of
+// the object or the application
+func (sc *syncContext) shouldReplaceOnImmutableFieldError(obj *unstructured.Unstructured) bool {
+	return argo.HasSyncOption(obj, common.SyncOptionReplaceOnImmutableFieldError) || sc.syncPolicy.HasSyncOption(common.SyncOptionReplaceOnImmutableFieldError)
+}
+
+// resourceNamespace returns the namespace a resource is synced to: the namespace declared by the
+// resource, or the destination namespace of the application if it declares none
+func (sc *syncContext) resourceNamespace(obj *unstructured.Unstructured) string {
+	if obj.GetNamespace() != "" {
+		return obj.GetNamespace()
+	}
+	return sc.namespace
+}
+
+// verifyPermittedNamespace returns an error if a namespaced resource declares a namespace which is not
+// a permitted destination of the project. The destination namespace of the application itself is
+// verified along with the rest of the application spec.
+func (sc *syncContext) verifyPermittedNamespace(obj *unstructured.Unstructured) error {
+	namespace := sc.resourceNamespace(obj)
+	if namespace == sc.namespace {
+		return nil
+	}
+	if !sc.proj.IsDestinationPermitted(appv1.ApplicationDestination{Server: sc.server, Namespace: namespace}) {
+		return fmt.Errorf("namespace %s of %s/%s is not permitted in project %s", namespace, obj.GetKind(), obj.GetName(), sc.proj.Name)
+	}
+	return nil
+}
+
+// ensureNamespace creates the destination namespace, unless it already exists. The namespace is not
+// labeled with the application, so that it is neither tracked nor pruned as a resource of the application.
+// Namespaces are only created if the project permits them, as any other cluster-scoped resource.
+func (sc *syncContext) ensureNamespace() error {
+	if sc.namespace == "" {
+		return nil
+	}
+	nsIf := sc.dynamicIf.Resource(schema.GroupVersionResource{Version: "v1", Resource: "namespaces"})
+	_, err := nsIf.Get(sc.namespace, metav1.GetOptions{})
+	if err == nil || !apierr.IsNotFound(err) {
+		return err
+	}
+	if !sc.proj.IsResourcePermitted(metav1.GroupKind{Kind: kube.NamespaceKind}, false) {
+		return fmt.Errorf("resource :%s is not permitted in project %s", kube.NamespaceKind, sc.proj.Name)
+	}
+	ns := &unstructured.Unstructured{}
+	ns.SetAPIVersion("v1")
+	ns.SetKind(kube.NamespaceKind)
+	ns.SetName(sc.namespace)
+	_, err = nsIf.Create(ns, metav1.CreateOptions{})
+	if err != nil {
+		if apierr.IsAlreadyExists(err) {
+			return nil
+		}
+		return err
+	}
+	sc.log.Infof("Created namespace %s", sc.namespace)
+	return nil
+}
+
 // pruneObject deletes the object if both prune is true and dryRun is false. Otherwise appropriate message
 func (sc *syncContext) pruneObject(liveObj *unstructured.Unstructured, prune, dryRun bool) appv1.ResourceDetails {
 	resDetails := appv1.ResourceDetails{
@@ -334,17 +854,36 @@ func (sc *syncContext) pruneObject(liveObj *unstructured.Unstructured, prune, dr
 		Kind:      liveObj.GetKind(),
 		Namespace: liveObj.GetNamespace(),
 	}
+	if argo.HasSyncOption(liveObj, common.SyncOptionDisablePrune) {
+		resDetails.Message = "skipped (prune disabled)"
+		resDetails.Status = appv1.ResourceDetailsSynced
+		return resDetails
+	}
+	if prune && kube.IsCRD(liveObj) && !sc.syncOp.ConfirmCRDDeletion {
+		// deleting a CRD deletes all of its instances, including the ones not managed by the application
+		if err := verifyCRDDeletion(sc.dynamicIf, liveObj, sc.appName); err != nil {
+			resDetails.Message = err.Error()
+			resDetails.Status = appv1.ResourceDetailsSyncFailed
+			return resDetails
+		}
+	}
 	if prune {
 		if dryRun {
 			resDetails.Message = "pruned (dry run)"
 			resDetails.Status = appv1.ResourceDetailsSyncedAndPruned
 		} else {
-			err := sc.kubectl.DeleteResource(sc.config, liveObj, sc.namespace)
+			propagationPolicy, err := sc.syncOp.PrunePropagationPolicy.DeletionPropagation()
+			if err == nil {
+				err = sc.kubectl.DeleteResource(sc.kubectlConfig(liveObj), liveObj, sc.resourceNamespace(liveObj), metav1.DeleteOptions{PropagationPolicy: &propagationPolicy})
+			}
 			if err != nil {
 				resDetails.Message = err.Error()
 				resDetails.Status = appv1.ResourceDetailsSyncFailed
 			} else {
 				resDetails.Message = "pruned"
+				if sc.shouldWaitForDeletion(liveObj) {
+					resDetails.Message = prunePendingMessage
+				}
 				resDetails.Status = appv1.ResourceDetailsSyncedAndPruned
 			}
 		}
@@ -380,6 +919,15 @@ func hasCRDOfGroupKind(tasks []syncTask, group, kind string) bool {
 func (sc *syncContext) doApplySync(syncTasks []syncTask, dryRun, force, update bool) bool {
 	syncSuccessful := true
 
+	if !dryRun && sc.appName == common.ArgoCDSelfAppName {
+		// Argo CD components are updated one per pass of the operation, once the components updated by
+		// the previous passes are rolled out, so the tasks applied by the previous passes are skipped
+		if rolledOut, successful := sc.checkComponentRollouts(syncTasks); !rolledOut {
+			return successful
+		}
+		syncTasks = sc.pendingTasks(syncTasks)
+	}
+
 	var createTasks []syncTask
 	var pruneTasks []syncTask
 	for _, syncTask := range syncTasks {
@@ -390,37 +938,49 @@ func (sc *syncContext) doApplySync(syncTasks []syncTask, dryRun, force, update b
 		}
 	}
 
-	var wg sync.WaitGroup
-	for _, task := range pruneTasks {
-		wg.Add(1)
-		go func(t syncTask) {
-			defer wg.Done()
-			var resDetails appv1.ResourceDetails
-			resDetails = sc.pruneObject(t.liveObj, sc.syncOp.Prune, dryRun)
-			if !resDetails.Status.Successful() {
-				syncSuccessful = false
-			}
-			if update || !resDetails.Status.Successful() {
-				sc.setResourceDetails(&resDetails)
-			}
-		}(task)
-	}
-	wg.Wait()
+	sc.runParallel(pruneTasks, func(t syncTask) {
+		var resDetails appv1.ResourceDetails
+		resDetails = sc.pruneObject(t.liveObj, sc.syncOp.Prune, dryRun)
+		if sc.syncOp.DryRun && resDetails.Status.Successful() {
+			resDetails.Action = pruneAction(resDetails)
+		}
+		if !resDetails.Status.Successful() {
+			syncSuccessful = false
+		}
+		if update || !resDetails.Status.Successful() {
+			sc.setResourceDetails(&resDetails)
+		}
+	})
 
+	componentUpdated := false
 	processCreateTasks := func(tasks []syncTask, gvk schema.GroupVersionKind) {
 		serverRes, err := kube.ServerResourceForGroupVersionKind(sc.disco, gvk)
 		if err != nil {
 			// Special case for custom resources: if custom resource definition is not supported by the cluster by defined in application then
 			// skip verification using `kubectl apply --dry-run` and since CRD should be created during app synchronization.
-			if dryRun && apierr.IsNotFound(err) && hasCRDOfGroupKind(createTasks, gvk.Group, gvk.Kind) {
+			// The same applies to resources of later sync waves, since the CRD might be created by an operator of an earlier wave.
+			if dryRun && apierr.IsNotFound(err) && (hasCRDOfGroupKind(createTasks, gvk.Group, gvk.Kind) || tasks[0].wave > createTasks[0].wave) {
 				return
 			}
+			// Resources may also opt out of the verification explicitly, e.g. if the CRD is created by an operator during the sync.
+			if dryRun && apierr.IsNotFound(err) {
+				var verifiedTasks []syncTask
+				for _, task := range tasks {
+					if !argo.HasSyncOption(task.targetObj, common.SyncOptionSkipDryRunOnMissingResource) {
+						verifiedTasks = append(verifiedTasks, task)
+					}
+				}
+				if len(verifiedTasks) == 0 {
+					return
+				}
+				tasks = verifiedTasks
+			}
 			syncSuccessful = false
 			for _, task := range tasks {
 				sc.setResourceDetails(&appv1.ResourceDetails{
 					Name:      task.targetObj.GetName(),
 					Kind:      task.targetObj.GetKind(),
-					Namespace: sc.namespace,
+					Namespace: sc.resourceNamespace(task.targetObj),
 					Message:   err.Error(),
 					Status:    appv1.ResourceDetailsSyncFailed,
 				})
@@ -434,7 +994,7 @@ func (sc *syncContext) doApplySync(syncTasks []syncTask, dryRun, force, update b
 				sc.setResourceDetails(&appv1.ResourceDetails{
 					Name:      task.targetObj.GetName(),
 					Kind:      task.targetObj.GetKind(),
-					Namespace: sc.namespace,
+					Namespace: sc.resourceNamespace(task.targetObj),
 					Message:   fmt.Sprintf("Resource %s:%s is not permitted in project %s.", gvk.Group, gvk.Kind, sc.proj.Name),
 					Status:    appv1.ResourceDetailsSyncFailed,
 				})
@@ -442,35 +1002,88 @@ func (sc *syncContext) doApplySync(syncTasks []syncTask, dryRun, force, update b
 			return
 		}
 
-		var createWg sync.WaitGroup
-		for i := range tasks {
-			createWg.Add(1)
-			go func(t syncTask) {
-				defer createWg.Done()
-				if isHook(t.targetObj) {
-					return
-				}
-				resDetails := sc.applyObject(t.targetObj, dryRun, force)
-				if !resDetails.Status.Successful() {
+		if serverRes.Namespaced {
+			var permittedTasks []syncTask
+			for _, task := range tasks {
+				if err := sc.verifyPermittedNamespace(task.targetObj); err != nil {
 					syncSuccessful = false
+					sc.setResourceDetails(&appv1.ResourceDetails{
+						Name:      task.targetObj.GetName(),
+						Kind:      task.targetObj.GetKind(),
+						Namespace: sc.resourceNamespace(task.targetObj),
+						Message:   err.Error(),
+						Status:    appv1.ResourceDetailsSyncFailed,
+					})
+					continue
 				}
-				if update || !resDetails.Status.Successful() {
-					sc.setResourceDetails(&resDetails)
+				permittedTasks = append(permittedTasks, task)
+			}
+			if len(permittedTasks) == 0 {
+				return
+			}
+			tasks = permittedTasks
+		}
+
+		applyTask := func(t syncTask) bool {
+			if isHook(t.targetObj) {
+				return true
+			}
+			var resDetails appv1.ResourceDetails
+			if hasGeneratedName(t.targetObj) {
+				resDetails = sc.createObject(t.targetObj, serverRes, dryRun)
+			} else if !dryRun && sc.shouldReplace(t) {
+				resDetails = sc.replaceObject(t.targetObj)
+			} else {
+				resDetails = sc.applyObject(t.targetObj, dryRun, force)
+			}
+			if sc.syncOp.DryRun && resDetails.Status.Successful() {
+				// dry-run syncs preview the changes the sync would make
+				resDetails.Action, resDetails.Diff = sc.dryRunChange(t)
+			}
+			if !resDetails.Status.Successful() {
+				syncSuccessful = false
+			}
+			if update || !resDetails.Status.Successful() {
+				sc.setResourceDetails(&resDetails)
+			}
+			return resDetails.Status.Successful()
+		}
+
+		if sc.isSelfUpgrade(gvk) {
+			// Argo CD components are updated one at a time, in upgrade order. The operation is resumed
+			// by a later pass once the updated component is rolled out, rather than waiting for it.
+			sortArgoCDComponents(tasks)
+			for i := range tasks {
+				if !applyTask(tasks[i]) {
+					return
+				}
+				if !dryRun && isArgoCDComponent(tasks[i].targetObj.GetName()) {
+					componentUpdated = true
+					sc.setOperationPhase(appv1.OperationRunning, componentRolloutMessage(tasks[i].targetObj))
+					return
 				}
-			}(tasks[i])
+			}
+			return
 		}
-		createWg.Wait()
+
+		sc.runParallel(tasks, func(t syncTask) {
+			applyTask(t)
+		})
 	}
 
 	var tasksGroup []syncTask
 	for _, task := range createTasks {
 		//Only wait if the type of the next task is different than the previous type
-		if len(tasksGroup) > 0 && tasksGroup[0].targetObj.GetKind() != task.targetObj.GetKind() {
+		if len(tasksGroup) > 0 && (tasksGroup[0].targetObj.GetKind() != task.targetObj.GetKind() || tasksGroup[0].wave != task.wave) {
 			processCreateTasks(tasksGroup, tasksGroup[0].targetObj.GroupVersionKind())
 			tasksGroup = []syncTask{task}
 		} else {
 			tasksGroup = append(tasksGroup, task)
 		}
+		// the remaining tasks are applied once the updated Argo CD component is rolled out
+		if componentUpdated {
+			return syncSuccessful
+		}
 	}
 	if len(tasksGroup) > 0 {
 		processCreateTasks(tasksGroup, tasksGroup[0].targetObj.GroupVersionKind())
@@ -478,12 +1091,56 @@ func (sc *syncContext) doApplySync(syncTasks []syncTask, dryRun, force, update b
 	return syncSuccessful
 }
 
+// concurrencyLimiter limits the number of tasks which run in parallel. A nil limiter is unlimited.
+type concurrencyLimiter chan struct{}
+
+func newConcurrencyLimiter(limit int64) concurrencyLimiter {
+	if limit <= 0 {
+		return nil
+	}
+	return make(concurrencyLimiter, limit)
+}
+
+// acquire blocks until a task can run
+func (l concurrencyLimiter) acquire() {
+	if l != nil {
+		l <- struct{}{}
+	}
+}
+
+// release marks a running task as done
+func (l concurrencyLimiter) release() {
+	if l != nil {
+		<-l
+	}
+}
+
+// runParallel runs fn for each of the tasks in parallel, and waits until all of them are done. The
+// number of tasks running at the same time is limited by the apply concurrency of the operation, and
+// by the apply concurrency of the controller, which is shared by all syncs.
+func (sc *syncContext) runParallel(tasks []syncTask, fn func(t syncTask)) {
+	opLimiter := newConcurrencyLimiter(sc.syncOp.ApplyConcurrency)
+	var wg sync.WaitGroup
+	for i := range tasks {
+		opLimiter.acquire()
+		wg.Add(1)
+		go func(t syncTask) {
+			defer wg.Done()
+			defer opLimiter.release()
+			sc.applyLimiter.acquire()
+			defer sc.applyLimiter.release()
+			fn(t)
+		}(tasks[i])
+	}
+	wg.Wait()
+}
+
 // setResourceDetails sets a resource details in the SyncResult.Resources list
 func (sc *syncContext) setResourceDetails(details *appv1.ResourceDetails) {
 	sc.lock.Lock()
 	defer sc.lock.Unlock()
 	for i, res := range sc.syncRes.Resources {
-		if res.Kind == details.Kind && res.Name == details.Name {
+		if res.Kind == details.Kind && res.Name == details.Name && res.GenerateName == details.GenerateName {
 			// update existing value
 			if res.Status != details.Status {
 				sc.log.Infof("updated resource %s/%s status: %s -> %s", res.Kind, res.Name, res.Status, details.Status)
@@ -504,7 +1161,8 @@ func (sc *syncContext) setResourceDetails(details *appv1.ResourceDetails) {
 // sortOrder is an ordering of Kinds.
 type sortOrder []string
 
-// resourceOrder represents the correct order of Kubernetes resources within a manifest
+// resourceOrder represents the correct order of Kubernetes resources within a manifest. It can be
+// replaced with the resource.order setting in argocd-cm.
 var resourceOrder sortOrder = []string{
 	"Namespace",
 	"ResourceQuota",
@@ -0,0 +1,79 @@
+package controller
+
+import (
+	"fmt"
+	"time"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/argoproj/argo-cd/common"
+	appv1 "github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/util/argo"
+)
+
+const (
+	// deletionTimeout is the time the sync waits for a pruned object to be deleted, before the
+	// object is reported as failed to prune
+	deletionTimeout = 5 * time.Minute
+	// prunePendingMessage is the message of pruned resources, whose deletion has yet to complete
+	prunePendingMessage = "pruned (waiting for deletion)"
+)
+
+// shouldWaitForDeletion returns whether the sync waits for the pruned object to be deleted, as
+// requested by the WaitForDeletion sync option of the object or of the application
+func (sc *syncContext) shouldWaitForDeletion(liveObj *unstructured.Unstructured) bool {
+	return argo.HasSyncOption(liveObj, common.SyncOptionWaitForDeletion) || sc.syncPolicy.HasSyncOption(common.SyncOptionWaitForDeletion)
+}
+
+// findPruneTask returns the live object of the prune task of the resource, or nil if the object
+// no longer exists
+func findPruneTask(syncTasks []syncTask, res *appv1.ResourceDetails) *unstructured.Unstructured {
+	for _, task := range syncTasks {
+		if task.targetObj == nil && task.liveObj != nil && task.liveObj.GetKind() == res.Kind && task.liveObj.GetName() == res.Name {
+			return task.liveObj
+		}
+	}
+	return nil
+}
+
+// checkDeletions checks whether the pruned objects, which the sync waits for, were deleted. Objects
+// which were deleted are reported as pruned, and objects whose deletion did not complete within the
+// timeout (e.g. due to finalizers) as failed. Returns true if no deletions are pending, and false
+// if the sync has to wait or failed.
+func (sc *syncContext) checkDeletions(syncTasks []syncTask) bool {
+	pending := false
+	failed := false
+	for _, res := range sc.syncRes.Resources {
+		if res.Status != appv1.ResourceDetailsSyncedAndPruned || res.Message != prunePendingMessage {
+			continue
+		}
+		details := *res
+		liveObj := findPruneTask(syncTasks, res)
+		if liveObj == nil {
+			details.Message = "pruned"
+			sc.setResourceDetails(&details)
+			continue
+		}
+		requestedAt := sc.opState.StartedAt.Time
+		if deletionTimestamp := liveObj.GetDeletionTimestamp(); deletionTimestamp != nil {
+			requestedAt = deletionTimestamp.Time
+		}
+		if time.Since(requestedAt) > deletionTimeout {
+			details.Status = appv1.ResourceDetailsSyncFailed
+			details.Message = fmt.Sprintf("timed out after %v waiting for deletion", deletionTimeout)
+			sc.setResourceDetails(&details)
+			failed = true
+			continue
+		}
+		pending = true
+	}
+	if failed {
+		sc.setOperationPhase(appv1.OperationFailed, "one or more objects failed to be deleted")
+		return false
+	}
+	if pending {
+		sc.setOperationPhase(appv1.OperationRunning, "waiting for pruned resources to be deleted")
+		return false
+	}
+	return true
+}
@@ -2,10 +2,18 @@ package controller
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
+	apiv1 "k8s.io/api/core/v1"
+	apierr "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	fakedynamic "k8s.io/client-go/dynamic/fake"
 
+	"github.com/argoproj/argo-cd/common"
 	"github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1"
 	"github.com/argoproj/argo-cd/reposerver/repository"
 )
@@ -68,3 +76,347 @@ func TestSyncHookProjectPermissions(t *testing.T) {
 	assert.Len(t, syncCtx.syncRes.Resources, 1)
 	assert.Equal(t, v1alpha1.ResourceDetailsSynced, syncCtx.syncRes.Resources[0].Status)
 }
+
+func TestClusterScopedHook(t *testing.T) {
+	syncCtx := newTestSyncCtx(&v1.APIResourceList{
+		GroupVersion: "rbac.authorization.k8s.io/v1",
+		APIResources: []v1.APIResource{
+			{Name: "clusterroles", Namespaced: false, Kind: "ClusterRole", Group: "rbac.authorization.k8s.io"},
+		},
+	})
+	syncCtx.kubectl = mockKubectlCmd{}
+	syncCtx.syncRes.Revision = "aaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa"
+	hook, err := v1alpha1.UnmarshalToUnstructured(clusterRoleHook)
+	assert.NoError(t, err)
+	hook.SetAnnotations(map[string]string{
+		common.AnnotationHook:             string(v1alpha1.HookTypePostSync),
+		common.AnnotationHookDeletePolicy: string(v1alpha1.HookDeletePolicyHookSucceeded),
+	})
+	syncCtx.dynamicIf = fakedynamic.NewSimpleDynamicClient(runtime.NewScheme(), hook.DeepCopy())
+
+	// the namespace of cluster-scoped hooks is ignored
+	hook.SetNamespace("test-namespace")
+	assert.Equal(t, "", syncCtx.hookNamespace(hook))
+
+	updated, err := syncCtx.runHook(hook, v1alpha1.HookTypePostSync)
+	assert.NoError(t, err)
+	assert.True(t, updated)
+	assert.Len(t, syncCtx.syncRes.Hooks, 1)
+	assert.Equal(t, "", syncCtx.syncRes.Hooks[0].Namespace)
+	assert.Equal(t, v1alpha1.OperationSucceeded, syncCtx.syncRes.Hooks[0].Status)
+
+	// hook is deleted according to its delete policy
+	_, err = syncCtx.dynamicIf.Resource(schema.GroupVersionResource{Group: "rbac.authorization.k8s.io", Version: "v1", Resource: "clusterroles"}).Get("cluster-role-hook", v1.GetOptions{})
+	assert.True(t, apierr.IsNotFound(err))
+}
+
+func TestBeforeHookCreationDeletePolicy(t *testing.T) {
+	syncCtx := newTestSyncCtx(&v1.APIResourceList{
+		GroupVersion: "rbac.authorization.k8s.io/v1",
+		APIResources: []v1.APIResource{
+			{Name: "clusterroles", Namespaced: false, Kind: "ClusterRole", Group: "rbac.authorization.k8s.io"},
+		},
+	})
+	syncCtx.kubectl = mockKubectlCmd{}
+	syncCtx.opState.StartedAt = v1.Now()
+	hook, err := v1alpha1.UnmarshalToUnstructured(clusterRoleHook)
+	assert.NoError(t, err)
+	hook.SetAnnotations(map[string]string{
+		common.AnnotationHook:             string(v1alpha1.HookTypePostSync),
+		common.AnnotationHookDeletePolicy: string(v1alpha1.HookDeletePolicyBeforeHookCreation),
+	})
+	// the hook was created by a previous sync
+	previous := hook.DeepCopy()
+	previous.SetCreationTimestamp(v1.NewTime(syncCtx.opState.StartedAt.Add(-time.Hour)))
+	syncCtx.dynamicIf = fakedynamic.NewSimpleDynamicClient(runtime.NewScheme(), previous)
+
+	updated, err := syncCtx.runHook(hook, v1alpha1.HookTypePostSync)
+	assert.NoError(t, err)
+	assert.True(t, updated)
+	assert.Len(t, syncCtx.syncRes.Hooks, 1)
+	assert.Equal(t, v1alpha1.OperationRunning, syncCtx.syncRes.Hooks[0].Status)
+	assert.Equal(t, "waiting for the previous hook to be deleted", syncCtx.syncRes.Hooks[0].Message)
+
+	_, err = syncCtx.dynamicIf.Resource(schema.GroupVersionResource{Group: "rbac.authorization.k8s.io", Version: "v1", Resource: "clusterroles"}).Get("cluster-role-hook", v1.GetOptions{})
+	assert.True(t, apierr.IsNotFound(err))
+}
+
+func TestEnforceHookDeletePolicy(t *testing.T) {
+	hook, err := v1alpha1.UnmarshalToUnstructured(testPod)
+	assert.NoError(t, err)
+	assert.False(t, enforceHookDeletePolicy(hook, v1alpha1.OperationSucceeded))
+
+	hook.SetAnnotations(map[string]string{common.AnnotationHookDeletePolicy: "HookFailed, BeforeHookCreation"})
+	assert.False(t, enforceHookDeletePolicy(hook, v1alpha1.OperationSucceeded))
+	assert.True(t, enforceHookDeletePolicy(hook, v1alpha1.OperationFailed))
+	assert.True(t, hasHookDeletePolicy(hook, v1alpha1.HookDeletePolicyBeforeHookCreation))
+}
+
+func TestRunHooksInWeightOrder(t *testing.T) {
+	syncCtx := newTestSyncCtx(&v1.APIResourceList{
+		GroupVersion: "rbac.authorization.k8s.io/v1",
+		APIResources: []v1.APIResource{
+			{Name: "clusterroles", Namespaced: false, Kind: "ClusterRole", Group: "rbac.authorization.k8s.io"},
+		},
+	})
+	syncCtx.kubectl = mockKubectlCmd{}
+	newHook := func(name string, weight string) *unstructured.Unstructured {
+		hook, err := v1alpha1.UnmarshalToUnstructured(clusterRoleHook)
+		assert.NoError(t, err)
+		hook.SetName(name)
+		hook.SetAnnotations(map[string]string{
+			common.AnnotationHook:       string(v1alpha1.HookTypePostSync),
+			common.AnnotationHookWeight: weight,
+		})
+		return hook
+	}
+	hooks := []*unstructured.Unstructured{newHook("second", "1"), newHook("first", "-1"), newHook("also-second", "1")}
+	syncCtx.dynamicIf = fakedynamic.NewSimpleDynamicClient(runtime.NewScheme(), hooks[0].DeepCopy(), hooks[1].DeepCopy(), hooks[2].DeepCopy())
+
+	// the hooks of the lowest weight run first
+	assert.False(t, syncCtx.runHooks(hooks, v1alpha1.HookTypePostSync))
+	assert.Len(t, syncCtx.syncRes.Hooks, 1)
+	assert.Equal(t, "first", syncCtx.syncRes.Hooks[0].Name)
+
+	// the hooks of the next weight run once the previous ones completed
+	assert.False(t, syncCtx.runHooks(hooks, v1alpha1.HookTypePostSync))
+	assert.Len(t, syncCtx.syncRes.Hooks, 3)
+	assert.Equal(t, "second", syncCtx.syncRes.Hooks[1].Name)
+	assert.Equal(t, "also-second", syncCtx.syncRes.Hooks[2].Name)
+
+	assert.True(t, syncCtx.runHooks(hooks, v1alpha1.HookTypePostSync))
+}
+
+func TestDryRunSyncPlansHooks(t *testing.T) {
+	syncCtx := newTestSyncCtx(&v1.APIResourceList{
+		GroupVersion: "v1",
+		APIResources: []v1.APIResource{
+			{Name: "pods", Namespaced: true, Kind: "Pod"},
+		},
+	}, &v1.APIResourceList{
+		GroupVersion: "rbac.authorization.k8s.io/v1",
+		APIResources: []v1.APIResource{
+			{Name: "clusterroles", Namespaced: false, Kind: "ClusterRole", Group: "rbac.authorization.k8s.io"},
+		},
+	})
+	applied := make(map[string]string)
+	syncCtx.kubectl = mockKubectlCmd{applied: applied}
+	syncCtx.syncRes.Revision = "aaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa"
+	syncCtx.syncOp.DryRun = true
+	syncCtx.syncOp.SyncStrategy = nil
+	preSyncHook := `{"apiVersion":"v1","kind":"Pod","metadata":{"generateName":"migrate-","annotations":{"argocd.argoproj.io/hook":"PreSync"}}}`
+	syncCtx.manifestInfo = &repository.ManifestResponse{
+		Manifests: []string{clusterRoleHook, preSyncHook},
+	}
+	syncCtx.resources = []v1alpha1.ResourceState{{
+		TargetState: testPod,
+	}}
+	syncCtx.sync()
+	assert.Equal(t, v1alpha1.OperationSucceeded, syncCtx.opState.Phase)
+	assert.Len(t, syncCtx.syncRes.Hooks, 2)
+	assert.Equal(t, v1alpha1.HookTypePreSync, syncCtx.syncRes.Hooks[0].Type)
+	assert.Contains(t, syncCtx.syncRes.Hooks[0].Name, "migrate-aaaaaaa-presync-")
+	assert.Equal(t, "test-namespace", syncCtx.syncRes.Hooks[0].Namespace)
+	assert.Equal(t, v1alpha1.HookTypePostSync, syncCtx.syncRes.Hooks[1].Type)
+	assert.Equal(t, "cluster-role-hook", syncCtx.syncRes.Hooks[1].Name)
+	assert.Equal(t, "", syncCtx.syncRes.Hooks[1].Namespace)
+	for _, hook := range syncCtx.syncRes.Hooks {
+		assert.Equal(t, "planned (dry run)", hook.Message)
+		assert.Empty(t, string(hook.Status))
+	}
+	// hooks are not created by dry-run syncs
+	assert.Len(t, applied, 1)
+	assert.Contains(t, applied, "foo")
+}
+
+func TestRunHooksInvalidWeight(t *testing.T) {
+	syncCtx := newTestSyncCtx()
+	hook, err := v1alpha1.UnmarshalToUnstructured(clusterRoleHook)
+	assert.NoError(t, err)
+	hook.SetAnnotations(map[string]string{
+		common.AnnotationHook:       string(v1alpha1.HookTypePostSync),
+		common.AnnotationHookWeight: "first",
+	})
+	assert.False(t, syncCtx.runHooks([]*unstructured.Unstructured{hook}, v1alpha1.HookTypePostSync))
+	assert.Equal(t, v1alpha1.OperationError, syncCtx.opState.Phase)
+}
+
+func newTerminateTestSyncCtx(pod *unstructured.Unstructured) *syncContext {
+	syncCtx := newTestSyncCtx(&v1.APIResourceList{
+		GroupVersion: "v1",
+		APIResources: []v1.APIResource{
+			{Name: "pods", Namespaced: true, Kind: "Pod"},
+		},
+	})
+	syncCtx.dynamicIf = fakedynamic.NewSimpleDynamicClient(runtime.NewScheme(), pod)
+	syncCtx.opState.Phase = v1alpha1.OperationTerminating
+	syncCtx.syncRes.Hooks = []*v1alpha1.HookStatus{{
+		Name:       pod.GetName(),
+		Kind:       "Pod",
+		APIVersion: "v1",
+		Namespace:  pod.GetNamespace(),
+		Type:       v1alpha1.HookTypeSync,
+		Status:     v1alpha1.OperationRunning,
+	}}
+	return syncCtx
+}
+
+func TestTerminateWaitsForHookDeletion(t *testing.T) {
+	pod, err := v1alpha1.UnmarshalToUnstructured(testPod)
+	assert.NoError(t, err)
+	pod.SetNamespace("test-namespace")
+	syncCtx := newTerminateTestSyncCtx(pod)
+	gracePeriod := int64(10)
+	syncCtx.syncOp.TerminationGracePeriodSeconds = &gracePeriod
+
+	// the deletion of the running hook is requested, and the operation keeps terminating
+	syncCtx.terminate()
+	assert.Equal(t, string(v1alpha1.OperationTerminating), string(syncCtx.opState.Phase))
+	assert.Equal(t, string(v1alpha1.OperationRunning), string(syncCtx.syncRes.Hooks[0].Status))
+	_, err = syncCtx.dynamicIf.Resource(schema.GroupVersionResource{Version: "v1", Resource: "pods"}).Namespace("test-namespace").Get("foo", v1.GetOptions{})
+	assert.True(t, apierr.IsNotFound(err))
+
+	// the operation is terminated once the deletion is confirmed
+	syncCtx.terminate()
+	assert.Equal(t, string(v1alpha1.OperationFailed), string(syncCtx.opState.Phase))
+	assert.Equal(t, "Operation terminated", syncCtx.opState.Message)
+	assert.Equal(t, string(v1alpha1.OperationFailed), string(syncCtx.syncRes.Hooks[0].Status))
+	assert.Equal(t, "Deleted Sync hook Pod/foo", syncCtx.syncRes.Hooks[0].Message)
+}
+
+func TestTerminateHookDeletionTimeout(t *testing.T) {
+	pod, err := v1alpha1.UnmarshalToUnstructured(testPod)
+	assert.NoError(t, err)
+	pod.SetNamespace("test-namespace")
+	// the hook pod is stuck in deletion
+	deletionTimestamp := v1.NewTime(time.Now().Add(-deletionTimeout - time.Minute))
+	pod.SetDeletionTimestamp(&deletionTimestamp)
+	syncCtx := newTerminateTestSyncCtx(pod)
+
+	syncCtx.terminate()
+	assert.Equal(t, string(v1alpha1.OperationError), string(syncCtx.opState.Phase))
+	assert.Equal(t, string(v1alpha1.OperationFailed), string(syncCtx.syncRes.Hooks[0].Status))
+	assert.Contains(t, syncCtx.syncRes.Hooks[0].Message, "timed out")
+}
+
+var failedHookPod = `
+{
+  "apiVersion": "v1",
+  "kind": "Pod",
+  "metadata": {
+    "name": "db-migration",
+    "namespace": "test-namespace",
+    "annotations": {
+      "argocd.argoproj.io/hook": "PreSync"
+    }
+  },
+  "status": {
+    "phase": "Failed",
+    "containerStatuses": [{
+      "name": "migrate",
+      "state": {"terminated": {"exitCode": 1}}
+    }, {
+      "name": "sidecar",
+      "state": {"terminated": {"exitCode": 0}}
+    }]
+  }
+}`
+
+func TestFailedHookLogs(t *testing.T) {
+	syncCtx := newTestSyncCtx(&v1.APIResourceList{
+		GroupVersion: "v1",
+		APIResources: []v1.APIResource{
+			{Name: "pods", Namespaced: true, Kind: "Pod"},
+		},
+	})
+	syncCtx.kubectl = mockKubectlCmd{logs: map[string]string{
+		"db-migration/migrate": "applying migration 42\nERROR: relation \"users\" already exists\n",
+	}}
+	hook, err := v1alpha1.UnmarshalToUnstructured(failedHookPod)
+	assert.NoError(t, err)
+	syncCtx.dynamicIf = fakedynamic.NewSimpleDynamicClient(runtime.NewScheme(), hook)
+
+	updated, err := syncCtx.runHook(hook, v1alpha1.HookTypePreSync)
+	assert.NoError(t, err)
+	assert.True(t, updated)
+	assert.Len(t, syncCtx.syncRes.Hooks, 1)
+	assert.Equal(t, v1alpha1.OperationFailed, syncCtx.syncRes.Hooks[0].Status)
+	assert.Equal(t, "container \"migrate\" failed with exit code 1\n"+
+		"logs of pod db-migration, container migrate:\napplying migration 42\nERROR: relation \"users\" already exists",
+		syncCtx.syncRes.Hooks[0].Message)
+}
+
+func TestFailedJobHookLogs(t *testing.T) {
+	syncCtx := newTestSyncCtx()
+	syncCtx.kubectl = mockKubectlCmd{logs: map[string]string{
+		"db-migration-xyz/migrate": "second attempt failed",
+	}}
+	job := &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "batch/v1",
+		"kind":       "Job",
+		"metadata":   map[string]interface{}{"name": "db-migration", "namespace": "test-namespace", "uid": "1234"},
+	}}
+	newJobPod := func(name string, created time.Time, phase apiv1.PodPhase) *unstructured.Unstructured {
+		pod, err := v1alpha1.UnmarshalToUnstructured(failedHookPod)
+		assert.NoError(t, err)
+		pod.SetName(name)
+		pod.SetLabels(map[string]string{"controller-uid": "1234"})
+		pod.SetCreationTimestamp(v1.NewTime(created))
+		assert.NoError(t, unstructured.SetNestedField(pod.Object, string(phase), "status", "phase"))
+		return pod
+	}
+	now := time.Now()
+	syncCtx.dynamicIf = fakedynamic.NewSimpleDynamicClient(runtime.NewScheme(),
+		newJobPod("db-migration-abc", now.Add(-time.Minute), apiv1.PodFailed),
+		newJobPod("db-migration-xyz", now, apiv1.PodFailed),
+		newJobPod("db-migration-running", now.Add(time.Minute), apiv1.PodRunning))
+
+	assert.Equal(t, "logs of pod db-migration-xyz, container migrate:\nsecond attempt failed", syncCtx.failedHookLogs(job))
+
+	// logs which are not available are omitted
+	syncCtx.kubectl = mockKubectlCmd{}
+	assert.Equal(t, "", syncCtx.failedHookLogs(job))
+}
+
+func TestHealthWaitExpired(t *testing.T) {
+	syncCtx := newTestSyncCtx()
+	syncCtx.syncOp.SyncStrategy = &v1alpha1.SyncStrategy{Hook: &v1alpha1.SyncStrategyHook{}}
+	_, expired, err := syncCtx.healthWaitExpired()
+	assert.NoError(t, err)
+	assert.False(t, expired)
+	assert.NotNil(t, syncCtx.syncRes.HealthWaitStartedAt)
+
+	syncCtx.syncOp.SyncStrategy.Hook.HealthTimeout = "5m"
+	startedAt := v1.NewTime(time.Now().Add(-time.Minute))
+	syncCtx.syncRes.HealthWaitStartedAt = &startedAt
+	_, expired, err = syncCtx.healthWaitExpired()
+	assert.NoError(t, err)
+	assert.False(t, expired)
+
+	startedAt = v1.NewTime(time.Now().Add(-6 * time.Minute))
+	timeout, expired, err := syncCtx.healthWaitExpired()
+	assert.NoError(t, err)
+	assert.True(t, expired)
+	assert.Equal(t, 5*time.Minute, timeout)
+
+	syncCtx.syncOp.SyncStrategy.Hook.HealthTimeout = "soon"
+	_, _, err = syncCtx.healthWaitExpired()
+	assert.Error(t, err)
+}
+
+func TestHealthWaitTimeoutDelay(t *testing.T) {
+	startedAt := v1.NewTime(time.Now().Add(-time.Minute))
+	state := &v1alpha1.OperationState{
+		Operation: v1alpha1.Operation{Sync: &v1alpha1.SyncOperation{
+			SyncStrategy: &v1alpha1.SyncStrategy{Hook: &v1alpha1.SyncStrategyHook{HealthTimeout: "5m"}},
+		}},
+		Phase:      v1alpha1.OperationRunning,
+		SyncResult: &v1alpha1.SyncOperationResult{HealthWaitStartedAt: &startedAt},
+	}
+	delay := healthWaitTimeoutDelay(state)
+	assert.True(t, delay > 3*time.Minute && delay <= 4*time.Minute)
+
+	// syncs which do not wait for the application health are not requeued
+	state.SyncResult.HealthWaitStartedAt = nil
+	assert.Equal(t, time.Duration(0), healthWaitTimeoutDelay(state))
+}
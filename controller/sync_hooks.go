@@ -4,7 +4,10 @@ import (
 	"encoding/json"
 	"fmt"
 	"reflect"
+	"sort"
+	"strconv"
 	"strings"
+	"time"
 
 	wfv1 "github.com/argoproj/argo/pkg/apis/workflow/v1alpha1"
 	apiv1 "k8s.io/api/core/v1"
@@ -13,6 +16,7 @@ import (
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/dynamic"
 	"k8s.io/kubernetes/pkg/apis/batch"
 
 	"github.com/argoproj/argo-cd/common"
@@ -36,10 +40,14 @@ func (sc *syncContext) doHookSync(syncTasks []syncTask, hooks []*unstructured.Un
 	// 2. Run Sync hooks (e.g. blue-green sync workflow)
 	// Before performing Sync hooks, apply any normal manifests which aren't annotated with a hook.
 	// We only want to do this once per operation.
+	// Non-hook manifests are applied wave by wave. Sync hooks are only run once all waves were applied.
 	shouldContinue := true
-	if !sc.startedSyncPhase() {
-		if !sc.syncNonHookTasks(syncTasks) {
-			sc.setOperationPhase(appv1.OperationFailed, "one or more objects failed to apply")
+	nonHookTasks := getNonHookTasks(syncTasks)
+	if sc.hasPendingWaves(nonHookTasks) {
+		if !sc.applyNextWave(nonHookTasks, sc.syncOp.SyncStrategy.Hook.Force) {
+			return
+		}
+		if sc.hasPendingWaves(nonHookTasks) {
 			return
 		}
 		shouldContinue = false
@@ -50,19 +58,41 @@ func (sc *syncContext) doHookSync(syncTasks []syncTask, hooks []*unstructured.Un
 	if !shouldContinue {
 		return
 	}
+	// PostSync hooks run once the objects pruned by the sync were deleted
+	if !sc.checkDeletions(nonHookTasks) {
+		return
+	}
+	// the resources of a moved application are pruned from its previous destination before the
+	// PostSync hooks run
+	if !sc.pruneMoveSource(nonHookTasks) {
+		return
+	}
 
 	// 3. Run PostSync hooks
 	// Before running PostSync hooks, we want to make rollout is complete (app is healthy). If we
 	// already started the post-sync phase, then we do not need to perform the health check.
 	postSyncHooks, _ := sc.getHooks(appv1.HookTypePostSync)
 	if len(postSyncHooks) > 0 && !sc.startedPostSyncPhase() {
-		healthState, err := setApplicationHealth(sc.kubectl, sc.comparison, sc.resources)
+		healthState, err := setApplicationHealth(sc.kubectl, &sc.progressingDeadline, sc.comparison, sc.resources)
 		sc.log.Infof("PostSync application health check: %s", healthState.Status)
 		if err != nil {
 			sc.setOperationPhase(appv1.OperationError, fmt.Sprintf("failed to check application health: %v", err))
 			return
 		}
 		if healthState.Status != appv1.HealthStatusHealthy {
+			if res := expiredProgressingDeadlineResource(sc.comparison); res != nil {
+				sc.setOperationPhase(appv1.OperationFailed, fmt.Sprintf("%s hooks were not run: %s", appv1.HookTypePostSync, progressingDeadlineMessage(res.Kind, res.Name, sc.progressingDeadline.deadline)))
+				return
+			}
+			timeout, expired, err := sc.healthWaitExpired()
+			if err != nil {
+				sc.setOperationPhase(appv1.OperationError, err.Error())
+				return
+			}
+			if expired {
+				sc.setOperationPhase(appv1.OperationFailed, fmt.Sprintf("%s hooks were not run: application did not become %s within the health timeout of %s (current health: %s)", appv1.HookTypePostSync, appv1.HealthStatusHealthy, timeout, healthState.Status))
+				return
+			}
 			sc.setOperationPhase(appv1.OperationRunning, fmt.Sprintf("waiting for %s state to run %s hooks (current health: %s)", appv1.HealthStatusHealthy, appv1.HookTypePostSync, healthState.Status))
 			return
 		}
@@ -75,6 +105,41 @@ func (sc *syncContext) doHookSync(syncTasks []syncTask, hooks []*unstructured.Un
 	sc.setOperationPhase(appv1.OperationSucceeded, "successfully synced")
 }
 
+// healthWaitExpired records when the sync started waiting for the application to become healthy before
+// running the PostSync hooks, and returns whether it waited for longer than the health timeout of the
+// hook strategy
+func (sc *syncContext) healthWaitExpired() (time.Duration, bool, error) {
+	if sc.syncRes.HealthWaitStartedAt == nil {
+		now := metav1.Now()
+		sc.syncRes.HealthWaitStartedAt = &now
+	}
+	timeout, err := sc.syncOp.SyncStrategy.Hook.HealthTimeoutDuration()
+	if err != nil || timeout == 0 {
+		return 0, false, err
+	}
+	return timeout, time.Since(sc.syncRes.HealthWaitStartedAt.Time) >= timeout, nil
+}
+
+// healthWaitTimeoutDelay returns how long a running sync can still wait for the application to become
+// healthy before running its PostSync hooks, or 0 if it is not waiting or waits indefinitely
+func healthWaitTimeoutDelay(state *appv1.OperationState) time.Duration {
+	if state.Phase != appv1.OperationRunning || state.SyncResult == nil || state.SyncResult.HealthWaitStartedAt == nil {
+		return 0
+	}
+	if state.Operation.Sync == nil || state.Operation.Sync.SyncStrategy == nil {
+		return 0
+	}
+	timeout, err := state.Operation.Sync.SyncStrategy.Hook.HealthTimeoutDuration()
+	if err != nil || timeout == 0 {
+		return 0
+	}
+	delay := time.Until(state.SyncResult.HealthWaitStartedAt.Add(timeout))
+	if delay < 0 {
+		return 0
+	}
+	return delay
+}
+
 // verifyPermittedHooks verifies all hooks are permitted in the project
 func (sc *syncContext) verifyPermittedHooks(hooks []*unstructured.Unstructured) bool {
 	for _, hook := range hooks {
@@ -88,6 +153,12 @@ func (sc *syncContext) verifyPermittedHooks(hooks []*unstructured.Unstructured)
 			sc.setOperationPhase(appv1.OperationFailed, fmt.Sprintf("Hook resource %s:%s is not permitted in project %s", gvk.Group, gvk.Kind, sc.proj.Name))
 			return false
 		}
+		if serverRes.Namespaced {
+			if err := sc.verifyPermittedNamespace(hook); err != nil {
+				sc.setOperationPhase(appv1.OperationFailed, fmt.Sprintf("Hook %s", err.Error()))
+				return false
+			}
+		}
 	}
 	return true
 }
@@ -126,8 +197,11 @@ func (sc *syncContext) getHooks(hookTypes ...appv1.HookType) ([]*unstructured.Un
 // runHooks iterates & filters the target manifests for resources of the specified hook type, then
 // creates the resource. Updates the sc.opRes.hooks with the current status. Returns whether or not
 // we should continue to the next hook phase.
+//
+// Hooks are run in the order of their weights: the hooks of a weight are only created once all hooks
+// of lower weights completed successfully.
 func (sc *syncContext) runHooks(hooks []*unstructured.Unstructured, hookType appv1.HookType) bool {
-	shouldContinue := true
+	var typedHooks []*unstructured.Unstructured
 	for _, hook := range hooks {
 		if hookType == appv1.HookTypeSync && isHookType(hook, appv1.HookTypeSkip) {
 			// If we get here, we are invoking all sync hooks and reached a resource that is
@@ -136,7 +210,7 @@ func (sc *syncContext) runHooks(hooks []*unstructured.Unstructured, hookType app
 			sc.setResourceDetails(&appv1.ResourceDetails{
 				Name:      hook.GetName(),
 				Kind:      hook.GetKind(),
-				Namespace: sc.namespace,
+				Namespace: sc.hookNamespace(hook),
 				Message:   "Skipped",
 			})
 			continue
@@ -144,40 +218,152 @@ func (sc *syncContext) runHooks(hooks []*unstructured.Unstructured, hookType app
 		if !isHookType(hook, hookType) {
 			continue
 		}
-		updated, err := sc.runHook(hook, hookType)
-		if err != nil {
-			sc.setOperationPhase(appv1.OperationError, fmt.Sprintf("%s hook error: %v", hookType, err))
+		typedHooks = append(typedHooks, sc.namedHook(hook, hookType))
+	}
+	weights, err := groupHookWeights(typedHooks)
+	if err != nil {
+		sc.setOperationPhase(appv1.OperationError, fmt.Sprintf("%s hook error: %v", hookType, err))
+		return false
+	}
+	for _, weight := range weights {
+		shouldContinue := true
+		for _, hook := range weight {
+			updated, err := sc.runHook(hook, hookType)
+			if err != nil {
+				sc.setOperationPhase(appv1.OperationError, fmt.Sprintf("%s hook error: %v", hookType, err))
+				return false
+			}
+			if updated {
+				// If the result of running a hook, caused us to modify hook resource state, we should
+				// not proceed to the next hook phase. This is because before proceeding to the next
+				// phase, we want a full health assessment to happen. By returning early, we allow
+				// the application to get requeued into the controller workqueue, and on the next
+				// process iteration, a new CompareAppState() will be performed to get the most
+				// up-to-date live state. This enables us to accurately wait for an application to
+				// become Healthy before proceeding to run PostSync tasks.
+				shouldContinue = false
+			}
+		}
+		if !shouldContinue {
+			sc.log.Infof("Stopping after %s phase due to modifications to hook resource state", hookType)
 			return false
 		}
-		if updated {
-			// If the result of running a hook, caused us to modify hook resource state, we should
-			// not proceed to the next hook phase. This is because before proceeding to the next
-			// phase, we want a full health assessment to happen. By returning early, we allow
-			// the application to get requeued into the controller workqueue, and on the next
-			// process iteration, a new CompareAppState() will be performed to get the most
-			// up-to-date live state. This enables us to accurately wait for an application to
-			// become Healthy before proceeding to run PostSync tasks.
-			shouldContinue = false
+		var hookStatuses []*appv1.HookStatus
+		for _, hook := range weight {
+			hookStatus := sc.getHookStatus(hook, hookType)
+			if hookStatus == nil {
+				return false
+			}
+			hookStatuses = append(hookStatuses, hookStatus)
+		}
+		completed, successful := areHooksCompletedSuccessful(hookType, hookStatuses)
+		if !completed {
+			return false
+		}
+		if !successful {
+			sc.setOperationPhase(appv1.OperationFailed, fmt.Sprintf("%s hook failed", hookType))
+			return false
 		}
 	}
-	if !shouldContinue {
-		sc.log.Infof("Stopping after %s phase due to modifications to hook resource state", hookType)
-		return false
+	return true
+}
+
+// planHooks records the hooks a dry-run sync would run, in the order they would run, without
+// creating them. Returns whether or not the hooks could be planned.
+func (sc *syncContext) planHooks() bool {
+	if sc.syncOp.SyncStrategy != nil && sc.syncOp.SyncStrategy.Hook == nil {
+		return true
 	}
-	completed, successful := areHooksCompletedSuccessful(hookType, sc.syncRes.Hooks)
-	if !completed {
+	hooks, err := sc.getHooks()
+	if err != nil {
+		sc.setOperationPhase(appv1.OperationError, fmt.Sprintf("failed to generate hooks resources: %v", err))
 		return false
 	}
-	if !successful {
-		sc.setOperationPhase(appv1.OperationFailed, fmt.Sprintf("%s hook failed", hookType))
+	if !sc.verifyPermittedHooks(hooks) {
 		return false
 	}
+	for _, hookType := range []appv1.HookType{appv1.HookTypePreSync, appv1.HookTypeSync, appv1.HookTypePostSync} {
+		var typedHooks []*unstructured.Unstructured
+		for _, hook := range hooks {
+			if isHookType(hook, hookType) {
+				typedHooks = append(typedHooks, sc.namedHook(hook, hookType))
+			}
+		}
+		weights, err := groupHookWeights(typedHooks)
+		if err != nil {
+			sc.setOperationPhase(appv1.OperationError, fmt.Sprintf("%s hook error: %v", hookType, err))
+			return false
+		}
+		for _, weight := range weights {
+			for _, hook := range weight {
+				sc.updateHookStatus(appv1.HookStatus{
+					Name:       hook.GetName(),
+					Kind:       hook.GetKind(),
+					APIVersion: hook.GetAPIVersion(),
+					Namespace:  sc.hookNamespace(hook),
+					Type:       hookType,
+					Message:    "planned (dry run)",
+				})
+			}
+		}
+	}
 	return true
 }
 
-// syncNonHookTasks syncs or prunes the objects that are not handled by hooks using an apply sync.
-// returns true if the sync was successful
-func (sc *syncContext) syncNonHookTasks(syncTasks []syncTask) bool {
+// hookWeight returns the weight of a hook, as specified by the hook-weight annotation. Hooks
+// without the annotation have weight 0.
+func hookWeight(hook *unstructured.Unstructured) (int, error) {
+	annotations := hook.GetAnnotations()
+	if annotations == nil || annotations[common.AnnotationHookWeight] == "" {
+		return 0, nil
+	}
+	weight, err := strconv.Atoi(annotations[common.AnnotationHookWeight])
+	if err != nil {
+		return 0, fmt.Errorf("invalid %s annotation of %s '%s': %v", common.AnnotationHookWeight, hook.GetKind(), hook.GetName(), err)
+	}
+	return weight, nil
+}
+
+// groupHookWeights splits the hooks into groups of the same weight, ordered by ascending weight.
+// Hooks of the same weight keep their order.
+func groupHookWeights(hooks []*unstructured.Unstructured) ([][]*unstructured.Unstructured, error) {
+	byWeight := make(map[int][]*unstructured.Unstructured)
+	var weights []int
+	for _, hook := range hooks {
+		weight, err := hookWeight(hook)
+		if err != nil {
+			return nil, err
+		}
+		if _, ok := byWeight[weight]; !ok {
+			weights = append(weights, weight)
+		}
+		byWeight[weight] = append(byWeight[weight], hook)
+	}
+	sort.Ints(weights)
+	groups := make([][]*unstructured.Unstructured, len(weights))
+	for i, weight := range weights {
+		groups[i] = byWeight[weight]
+	}
+	return groups, nil
+}
+
+// namedHook returns the hook with a deterministic name. Hook resources names are deterministic,
+// whether they are defined by the user (metadata.name), or formulated at the time of the operation
+// (metadata.generateName). If user specifies metadata.generateName, then we will generate a
+// formulated metadata.name before submission.
+func (sc *syncContext) namedHook(hook *unstructured.Unstructured, hookType appv1.HookType) *unstructured.Unstructured {
+	if hook.GetName() != "" {
+		return hook
+	}
+	postfix := strings.ToLower(fmt.Sprintf("%s-%s-%d", sc.syncRes.Revision[0:7], hookType, sc.opState.StartedAt.UTC().Unix()))
+	hook = hook.DeepCopy()
+	hook.SetName(fmt.Sprintf("%s%s", hook.GetGenerateName(), postfix))
+	return hook
+}
+
+// getNonHookTasks returns the sync tasks of the objects that are not handled by hooks, which are
+// synced or pruned using an apply sync
+func getNonHookTasks(syncTasks []syncTask) []syncTask {
 	var nonHookTasks []syncTask
 	for _, task := range syncTasks {
 		if task.targetObj == nil {
@@ -193,21 +379,13 @@ func (sc *syncContext) syncNonHookTasks(syncTasks []syncTask) bool {
 			nonHookTasks = append(nonHookTasks, task)
 		}
 	}
-	return sc.doApplySync(nonHookTasks, false, sc.syncOp.SyncStrategy.Hook.Force, true)
+	return nonHookTasks
 }
 
 // runHook runs the supplied hook and updates the hook status. Returns true if the result of
 // invoking this method resulted in changes to any hook status
 func (sc *syncContext) runHook(hook *unstructured.Unstructured, hookType appv1.HookType) (bool, error) {
-	// Hook resources names are deterministic, whether they are defined by the user (metadata.name),
-	// or formulated at the time of the operation (metadata.generateName). If user specifies
-	// metadata.generateName, then we will generate a formulated metadata.name before submission.
-	if hook.GetName() == "" {
-		postfix := strings.ToLower(fmt.Sprintf("%s-%s-%d", sc.syncRes.Revision[0:7], hookType, sc.opState.StartedAt.UTC().Unix()))
-		generatedName := hook.GetGenerateName()
-		hook = hook.DeepCopy()
-		hook.SetName(fmt.Sprintf("%s%s", generatedName, postfix))
-	}
+	hook = sc.namedHook(hook, hookType)
 	// Check our hook statuses to see if we already completed this hook.
 	// If so, this method is a noop
 	prevStatus := sc.getHookStatus(hook, hookType)
@@ -221,7 +399,13 @@ func (sc *syncContext) runHook(hook *unstructured.Unstructured, hookType appv1.H
 		return false, err
 	}
 	resource := kube.ToGroupVersionResource(gvk.GroupVersion().String(), apiResource)
-	resIf := kube.ToResourceInterface(sc.dynamicIf, apiResource, resource, sc.namespace)
+	namespace := sc.resourceNamespace(hook)
+	resIf := kube.ToResourceInterface(sc.dynamicIf, apiResource, resource, namespace)
+	if !apiResource.Namespaced && hook.GetNamespace() != "" {
+		// cluster-scoped hooks are tracked without a namespace
+		hook = hook.DeepCopy()
+		hook.SetNamespace("")
+	}
 
 	var liveObj *unstructured.Unstructured
 	existing, err := resIf.Get(hook.GetName(), metav1.GetOptions{})
@@ -234,7 +418,13 @@ func (sc *syncContext) runHook(hook *unstructured.Unstructured, hookType appv1.H
 		if err != nil {
 			sc.log.Warnf("Failed to set application label on hook %v: %v", hook, err)
 		}
-		_, err := sc.kubectl.ApplyResource(sc.config, hook, sc.namespace, false, false)
+		if sc.instanceID != "" {
+			err = kube.SetLabel(hook, common.LabelKeyApplicationControllerInstanceID, sc.instanceID)
+			if err != nil {
+				sc.log.Warnf("Failed to set instance label on hook %v: %v", hook, err)
+			}
+		}
+		_, err := sc.kubectl.ApplyResource(sc.kubectlConfig(hook), hook, namespace, false, false, sc.shouldValidate(hook))
 		if err != nil {
 			return false, fmt.Errorf("Failed to create %s hook %s '%s': %v", hookType, gvk, hook.GetName(), err)
 		}
@@ -245,16 +435,38 @@ func (sc *syncContext) runHook(hook *unstructured.Unstructured, hookType appv1.H
 		sc.log.Infof("%s hook %s '%s' created", hookType, gvk, created.GetName())
 		sc.setOperationPhase(appv1.OperationRunning, fmt.Sprintf("running %s hooks", hookType))
 		liveObj = created
+	} else if existing.GetCreationTimestamp().Time.Before(sc.opState.StartedAt.Time) && hasHookDeletePolicy(hook, appv1.HookDeletePolicyBeforeHookCreation) {
+		// the hook was left over by a previous sync. It is deleted, and created again once the
+		// deletion completes
+		if existing.GetDeletionTimestamp() == nil {
+			err = sc.deleteHook(existing.GetName(), existing.GetKind(), existing.GetAPIVersion(), existing.GetNamespace())
+			if err != nil {
+				return false, fmt.Errorf("Failed to delete previous %s hook %s '%s': %v", hookType, gvk, hook.GetName(), err)
+			}
+			sc.log.Infof("Previous %s hook %s '%s' deleted", hookType, gvk, hook.GetName())
+		}
+		hookStatus := newHookStatus(existing, hookType)
+		hookStatus.Status = appv1.OperationRunning
+		hookStatus.Message = "waiting for the previous hook to be deleted"
+		return sc.updateHookStatus(hookStatus), nil
 	} else {
 		liveObj = existing
 	}
 	hookStatus := newHookStatus(liveObj, hookType)
+	if hookStatus.Status == appv1.OperationFailed {
+		// the logs are captured before the hook is deleted by its deletion policy
+		if logs := sc.failedHookLogs(liveObj); logs != "" && hookStatus.Message != "" {
+			hookStatus.Message = fmt.Sprintf("%s\n%s", hookStatus.Message, logs)
+		} else if logs != "" {
+			hookStatus.Message = logs
+		}
+	}
 	if hookStatus.Status.Completed() {
 		if enforceHookDeletePolicy(hook, hookStatus.Status) {
-			err = sc.deleteHook(hook.GetName(), hook.GetKind(), hook.GetAPIVersion())
+			err = sc.deleteHook(hookStatus.Name, hookStatus.Kind, hookStatus.APIVersion, hookStatus.Namespace)
 			if err != nil {
-				hookStatus.Status = appv1.OperationFailed
 				hookStatus.Message = fmt.Sprintf("failed to delete %s hook: %v", hookStatus.Status, err)
+				hookStatus.Status = appv1.OperationFailed
 			}
 		}
 	}
@@ -263,17 +475,19 @@ func (sc *syncContext) runHook(hook *unstructured.Unstructured, hookType appv1.H
 
 // enforceHookDeletePolicy examines the hook deletion policy of a object and deletes it based on the status
 func enforceHookDeletePolicy(hook *unstructured.Unstructured, phase appv1.OperationPhase) bool {
-	annotations := hook.GetAnnotations()
-	if annotations == nil {
-		return false
+	switch phase {
+	case appv1.OperationSucceeded:
+		return hasHookDeletePolicy(hook, appv1.HookDeletePolicyHookSucceeded)
+	case appv1.OperationFailed:
+		return hasHookDeletePolicy(hook, appv1.HookDeletePolicyHookFailed)
 	}
-	deletePolicies := strings.Split(annotations[common.AnnotationHookDeletePolicy], ",")
-	for _, dp := range deletePolicies {
-		policy := appv1.HookDeletePolicy(strings.TrimSpace(dp))
-		if policy == appv1.HookDeletePolicyHookSucceeded && phase == appv1.OperationSucceeded {
-			return true
-		}
-		if policy == appv1.HookDeletePolicyHookFailed && phase == appv1.OperationFailed {
+	return false
+}
+
+// hasHookDeletePolicy returns whether the hook deletion policy annotation of the hook contains the given policy
+func hasHookDeletePolicy(hook *unstructured.Unstructured, policy appv1.HookDeletePolicy) bool {
+	for _, dp := range strings.Split(hook.GetAnnotations()[common.AnnotationHookDeletePolicy], ",") {
+		if appv1.HookDeletePolicy(strings.TrimSpace(dp)) == policy {
 			return true
 		}
 	}
@@ -345,6 +559,7 @@ func newHookStatus(hook *unstructured.Unstructured, hookType appv1.HookType) app
 		Name:       hook.GetName(),
 		Kind:       hook.GetKind(),
 		APIVersion: hook.GetAPIVersion(),
+		Namespace:  hook.GetNamespace(),
 		Type:       hookType,
 	}
 	gvk := schema.FromAPIVersionAndKind(hookStatus.APIVersion, hookStatus.Kind)
@@ -531,40 +746,105 @@ func areHooksCompletedSuccessful(hookType appv1.HookType, hookStatuses []*appv1.
 	return true, isSuccessful
 }
 
-// terminate looks for any running jobs/workflow hooks and deletes the resource
+// terminate looks for any running jobs/workflow hooks and deletes the resource, using the
+// termination grace period of the operation. The operation stays terminating until the deletion of
+// all hooks was confirmed, so that no hook pods keep running once the operation is terminated.
 func (sc *syncContext) terminate() {
 	terminateSuccessful := true
+	pending := false
 	for _, hookStatus := range sc.syncRes.Hooks {
-		if hookStatus.Status.Completed() {
+		if hookStatus.Status.Completed() || !isRunnable(hookStatus) {
 			continue
 		}
-		if isRunnable(hookStatus) {
+		deleted, err := sc.terminateHook(hookStatus)
+		switch {
+		case err != nil:
 			hookStatus.Status = appv1.OperationFailed
-			err := sc.deleteHook(hookStatus.Name, hookStatus.Kind, hookStatus.APIVersion)
-			if err != nil {
-				hookStatus.Message = fmt.Sprintf("Failed to delete %s hook %s/%s: %v", hookStatus.Type, hookStatus.Kind, hookStatus.Name, err)
-				terminateSuccessful = false
-			} else {
-				hookStatus.Message = fmt.Sprintf("Deleted %s hook %s/%s", hookStatus.Type, hookStatus.Kind, hookStatus.Name)
-			}
-			sc.updateHookStatus(*hookStatus)
+			hookStatus.Message = fmt.Sprintf("Failed to delete %s hook %s/%s: %v", hookStatus.Type, hookStatus.Kind, hookStatus.Name, err)
+			terminateSuccessful = false
+		case deleted:
+			hookStatus.Status = appv1.OperationFailed
+			hookStatus.Message = fmt.Sprintf("Deleted %s hook %s/%s", hookStatus.Type, hookStatus.Kind, hookStatus.Name)
+		default:
+			hookStatus.Message = fmt.Sprintf("Deleting %s hook %s/%s", hookStatus.Type, hookStatus.Kind, hookStatus.Name)
+			pending = true
 		}
+		sc.updateHookStatus(*hookStatus)
 	}
-	if terminateSuccessful {
+	if pending {
+		sc.setOperationPhase(appv1.OperationTerminating, "waiting for hooks to be deleted")
+	} else if terminateSuccessful {
 		sc.setOperationPhase(appv1.OperationFailed, "Operation terminated")
 	} else {
 		sc.setOperationPhase(appv1.OperationError, "Operation termination had errors")
 	}
 }
 
-func (sc *syncContext) deleteHook(name, kind, apiVersion string) error {
-	gvk := schema.FromAPIVersionAndKind(apiVersion, kind)
-	apiResource, err := kube.ServerResourceForGroupVersionKind(sc.disco, gvk)
+// terminateHook requests the deletion of a running hook, and returns whether the deletion was
+// confirmed. Returns an error if the hook was not deleted within the grace period and timeout.
+func (sc *syncContext) terminateHook(hookStatus *appv1.HookStatus) (bool, error) {
+	resIf, err := sc.hookResourceInterface(hookStatus.Kind, hookStatus.APIVersion, hookStatus.Namespace)
+	if err != nil {
+		return false, err
+	}
+	liveObj, err := resIf.Get(hookStatus.Name, metav1.GetOptions{})
+	if err != nil {
+		if apierr.IsNotFound(err) {
+			return true, nil
+		}
+		return false, err
+	}
+	gracePeriod := sc.syncOp.TerminationGracePeriodSeconds
+	deletionTimestamp := liveObj.GetDeletionTimestamp()
+	if deletionTimestamp == nil {
+		propagationPolicy := metav1.DeletePropagationForeground
+		err = resIf.Delete(hookStatus.Name, &metav1.DeleteOptions{PropagationPolicy: &propagationPolicy, GracePeriodSeconds: gracePeriod})
+		if apierr.IsNotFound(err) {
+			return true, nil
+		}
+		return false, err
+	}
+	timeout := deletionTimeout
+	if gracePeriod != nil {
+		timeout += time.Duration(*gracePeriod) * time.Second
+	}
+	if time.Since(deletionTimestamp.Time) > timeout {
+		return false, fmt.Errorf("timed out after %v waiting for deletion", timeout)
+	}
+	return false, nil
+}
+
+// deleteHook deletes the hook resource
+func (sc *syncContext) deleteHook(name, kind, apiVersion, namespace string) error {
+	resIf, err := sc.hookResourceInterface(kind, apiVersion, namespace)
 	if err != nil {
 		return err
 	}
-	resource := kube.ToGroupVersionResource(gvk.GroupVersion().String(), apiResource)
-	resIf := kube.ToResourceInterface(sc.dynamicIf, apiResource, resource, sc.namespace)
 	propagationPolicy := metav1.DeletePropagationForeground
 	return resIf.Delete(name, &metav1.DeleteOptions{PropagationPolicy: &propagationPolicy})
 }
+
+// hookResourceInterface returns the client of the hook resources of the kind. The namespace is
+// ignored for cluster-scoped hooks, and defaults to the application namespace for hooks which were
+// recorded without a namespace.
+func (sc *syncContext) hookResourceInterface(kind, apiVersion, namespace string) (dynamic.ResourceInterface, error) {
+	gvk := schema.FromAPIVersionAndKind(apiVersion, kind)
+	apiResource, err := kube.ServerResourceForGroupVersionKind(sc.disco, gvk)
+	if err != nil {
+		return nil, err
+	}
+	if namespace == "" {
+		namespace = sc.namespace
+	}
+	resource := kube.ToGroupVersionResource(gvk.GroupVersion().String(), apiResource)
+	return kube.ToResourceInterface(sc.dynamicIf, apiResource, resource, namespace), nil
+}
+
+// hookNamespace returns the namespace the hook is created in, which is empty for cluster-scoped hooks
+func (sc *syncContext) hookNamespace(hook *unstructured.Unstructured) string {
+	apiResource, err := kube.ServerResourceForGroupVersionKind(sc.disco, hook.GroupVersionKind())
+	if err == nil && !apiResource.Namespaced {
+		return ""
+	}
+	return sc.resourceNamespace(hook)
+}
@@ -0,0 +1,74 @@
+package controller
+
+import (
+	"strings"
+
+	"github.com/yudai/gojsondiff/formatter"
+	apierr "k8s.io/apimachinery/pkg/api/errors"
+
+	appv1 "github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/util/diff"
+	"github.com/argoproj/argo-cd/util/redact"
+	"github.com/argoproj/argo-cd/util/settings"
+)
+
+const (
+	// diffPreviewMaxBytes limits the size of the diff previewed for each resource by a dry-run sync,
+	// since the operation state is stored in the application resource
+	diffPreviewMaxBytes = 4096
+)
+
+// redactor returns the redactor of the diffs previewed by dry-run syncs. Resources are redacted
+// according to the redactions of the cached settings, or the default ones if Argo CD is not configured.
+func (s *appStateManager) redactor() (*redact.Redactor, error) {
+	if s.settingsMgr == nil {
+		return redact.NewRedactor((&settings.ArgoCDSettings{}).GetResourceRedactions()), nil
+	}
+	argoSettings, err := s.getSettings()
+	if argoSettings == nil {
+		if apierr.IsNotFound(err) {
+			return redact.NewRedactor((&settings.ArgoCDSettings{}).GetResourceRedactions()), nil
+		}
+		return nil, err
+	}
+	// redactions are set in argocd-cm, so errors reading argocd-secret do not matter
+	return redact.NewRedactor(argoSettings.GetResourceRedactions()), nil
+}
+
+// dryRunChange returns the change a dry-run sync would make to the object of a task it applied, and
+// the diff of its live and target state for updated objects. The resources are redacted, since the
+// diff is visible to all users who can see the application.
+func (sc *syncContext) dryRunChange(task syncTask) (appv1.ResourceSyncAction, string) {
+	if task.liveObj == nil {
+		return appv1.ResourceSyncActionCreate, ""
+	}
+	liveObj := task.liveObj.DeepCopy()
+	targetObj := task.targetObj.DeepCopy()
+	sc.redactor.RedactObjects(liveObj, targetObj)
+	res := diff.Diff(targetObj, liveObj, sc.normalizer)
+	if !res.Modified {
+		return appv1.ResourceSyncActionUnchanged, ""
+	}
+	out, err := res.ASCIIFormat(liveObj, formatter.AsciiFormatterConfig{})
+	if err != nil {
+		sc.log.Warnf("Failed to format diff of %s/%s: %v", targetObj.GetKind(), targetObj.GetName(), err)
+		return appv1.ResourceSyncActionUpdate, ""
+	}
+	out = strings.TrimRight(out, "\n")
+	if len(out) > diffPreviewMaxBytes {
+		out = out[:diffPreviewMaxBytes] + "\n... (truncated)"
+	}
+	return appv1.ResourceSyncActionUpdate, out
+}
+
+// pruneAction returns the change a dry-run sync would make to an object it pruned, which is left
+// untouched if pruning is not enabled
+func pruneAction(details appv1.ResourceDetails) appv1.ResourceSyncAction {
+	switch details.Status {
+	case appv1.ResourceDetailsSyncedAndPruned:
+		return appv1.ResourceSyncActionPrune
+	case appv1.ResourceDetailsPruningRequired:
+		return appv1.ResourceSyncActionPruneRequired
+	}
+	return appv1.ResourceSyncActionUnchanged
+}
@@ -0,0 +1,96 @@
+package controller
+
+import (
+	"fmt"
+	"time"
+
+	appv1 "github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/util/health"
+)
+
+// progressiveBatches splits the sync tasks, which are expected to be sorted by wave, into batches of
+// at most batchSize tasks. A batch never spans multiple sync waves.
+func progressiveBatches(syncTasks []syncTask, batchSize int) [][]syncTask {
+	var batches [][]syncTask
+	for _, wave := range groupSyncWaves(syncTasks) {
+		for start := 0; start < len(wave); start += batchSize {
+			end := start + batchSize
+			if end > len(wave) {
+				end = len(wave)
+			}
+			batches = append(batches, wave[start:end])
+		}
+	}
+	return batches
+}
+
+// doProgressiveSync applies the first batch of sync tasks which has yet to be applied, provided that
+// all resources of the preceding batches are healthy. The sync succeeds once the resources of all
+// batches are healthy, and fails as soon as a resource of an applied batch is degraded.
+func (sc *syncContext) doProgressiveSync(syncTasks []syncTask) {
+	strategy := sc.syncOp.SyncStrategy.Progressive
+	batches := progressiveBatches(syncTasks, strategy.GetBatchSize())
+	for i, batch := range batches {
+		if !sc.hasPendingWaves(batch) {
+			continue
+		}
+		if i > 0 && !sc.checkBatchHealth(batches[:i], len(batches)) {
+			return
+		}
+		sc.setOperationPhase(appv1.OperationRunning, fmt.Sprintf("applying batch %d of %d", i+1, len(batches)))
+		if !sc.doApplySync(batch, false, strategy.Force, true) {
+			sc.setOperationPhase(appv1.OperationFailed, fmt.Sprintf("one or more objects of batch %d of %d failed to apply", i+1, len(batches)))
+		}
+		return
+	}
+	if !sc.checkBatchHealth(batches, len(batches)) {
+		return
+	}
+	if !sc.checkDeletions(syncTasks) {
+		return
+	}
+	if !sc.pruneMoveSource(syncTasks) {
+		return
+	}
+	sc.setOperationPhase(appv1.OperationSucceeded, "successfully synced")
+}
+
+// checkBatchHealth returns whether the resources of the applied batches are healthy. The sync waits
+// while a resource is missing or progressing, and fails if a resource is degraded or has been
+// progressing for longer than the progressing deadline of the application.
+func (sc *syncContext) checkBatchHealth(batches [][]syncTask, total int) bool {
+	for i, batch := range batches {
+		for _, task := range batch {
+			if task.targetObj == nil || isHook(task.targetObj) {
+				continue
+			}
+			healthStatus := appv1.HealthStatusMissing
+			details := ""
+			if task.liveObj != nil {
+				healthState, err := health.GetAppHealth(sc.kubectl, task.liveObj)
+				if err != nil {
+					sc.setOperationPhase(appv1.OperationError, fmt.Sprintf("failed to check health of batch %d of %d: %v", i+1, total, err))
+					return false
+				}
+				sc.progressingDeadline.apply(task.liveObj.GroupVersionKind().Group, task.liveObj.GetKind(), task.liveObj.GetNamespace(), task.liveObj.GetName(), healthState, time.Now())
+				healthStatus = healthState.Status
+				details = healthState.StatusDetails
+			}
+			switch healthStatus {
+			case appv1.HealthStatusHealthy:
+				continue
+			case appv1.HealthStatusDegraded:
+				message := fmt.Sprintf("batch %d of %d is %s: %s %s", i+1, total, healthStatus, task.targetObj.GetKind(), task.targetObj.GetName())
+				if details != "" {
+					message = fmt.Sprintf("%s: %s", message, details)
+				}
+				sc.setOperationPhase(appv1.OperationFailed, message)
+			default:
+				sc.setOperationPhase(appv1.OperationRunning, fmt.Sprintf("waiting for batch %d of %d to become %s (%s %s is %s)",
+					i+1, total, appv1.HealthStatusHealthy, task.targetObj.GetKind(), task.targetObj.GetName(), healthStatus))
+			}
+			return false
+		}
+	}
+	return true
+}
@@ -4,21 +4,35 @@ import (
 	"context"
 	"fmt"
 	"sort"
+	"sync"
 	"testing"
+	"time"
 
 	log "github.com/sirupsen/logrus"
 	"github.com/stretchr/testify/assert"
 	apiv1 "k8s.io/api/core/v1"
+	apierr "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
 	"k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/watch"
 	fakedisco "k8s.io/client-go/discovery/fake"
+	fakedynamic "k8s.io/client-go/dynamic/fake"
+	"k8s.io/client-go/kubernetes/fake"
 	"k8s.io/client-go/rest"
 	testcore "k8s.io/client-go/testing"
 
+	"github.com/argoproj/argo-cd/common"
 	"github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1"
+	appclientset "github.com/argoproj/argo-cd/pkg/client/clientset/versioned/fake"
+	"github.com/argoproj/argo-cd/reposerver/repository"
 	"github.com/argoproj/argo-cd/util/kube"
+	"github.com/argoproj/argo-cd/util/policy"
+	"github.com/argoproj/argo-cd/util/redact"
+	"github.com/argoproj/argo-cd/util/settings"
 )
 
 type kubectlOutput struct {
@@ -29,6 +43,24 @@ type kubectlOutput struct {
 type mockKubectlCmd struct {
 	commands map[string]kubectlOutput
 	events   chan watch.Event
+	// replaced records the names of replaced resources, if not nil
+	replaced map[string]bool
+	// unvalidated records the names of resources applied without validation, if not nil
+	unvalidated map[string]bool
+	// unvalidatedReplaces records the names of resources replaced without validation, if not nil
+	unvalidatedReplaces map[string]bool
+	// deleted records the names and namespaces of deleted resources, if not nil
+	deleted map[string]string
+	// applied records the names and namespaces of applied resources, if not nil
+	applied map[string]string
+	// logs holds the logs of pod containers, keyed by <pod>/<container>
+	logs map[string]string
+	// applyErrs holds the errors of applying resources, keyed by name, which are not returned by dry runs
+	applyErrs map[string]error
+	// timeouts records the timeouts of the applies and deletes of resources, if not nil
+	timeouts map[string]time.Duration
+	// predicted holds the objects returned by server-side dry-runs, keyed by name
+	predicted map[string]*unstructured.Unstructured
 }
 
 func (k mockKubectlCmd) WatchResources(
@@ -37,7 +69,13 @@ func (k mockKubectlCmd) WatchResources(
 	return k.events, nil
 }
 
-func (k mockKubectlCmd) DeleteResource(config *rest.Config, obj *unstructured.Unstructured, namespace string) error {
+func (k mockKubectlCmd) DeleteResource(config *rest.Config, obj *unstructured.Unstructured, namespace string, deleteOptions v1.DeleteOptions) error {
+	if k.timeouts != nil {
+		k.timeouts[obj.GetName()] = config.Timeout
+	}
+	if k.deleted != nil {
+		k.deleted[obj.GetName()] = namespace
+	}
 	command, ok := k.commands[obj.GetName()]
 	if !ok {
 		return nil
@@ -45,7 +83,60 @@ func (k mockKubectlCmd) DeleteResource(config *rest.Config, obj *unstructured.Un
 	return command.err
 }
 
-func (k mockKubectlCmd) ApplyResource(config *rest.Config, obj *unstructured.Unstructured, namespace string, dryRun, force bool) (string, error) {
+func (k mockKubectlCmd) PatchResource(config *rest.Config, obj *unstructured.Unstructured, namespace string, patchType types.PatchType, patch []byte) (*unstructured.Unstructured, error) {
+	command, ok := k.commands[obj.GetName()]
+	if !ok {
+		return obj, nil
+	}
+	return obj, command.err
+}
+
+func (k mockKubectlCmd) GetPodLogs(config *rest.Config, namespace, podName, container string, tailLines int64) (string, error) {
+	logs, ok := k.logs[podName+"/"+container]
+	if !ok {
+		return "", fmt.Errorf("container %s of pod %s not found", container, podName)
+	}
+	return logs, nil
+}
+
+func (k mockKubectlCmd) ApplyResource(config *rest.Config, obj *unstructured.Unstructured, namespace string, dryRun, force, validate bool) (string, error) {
+	if k.unvalidated != nil && !validate {
+		k.unvalidated[obj.GetName()] = true
+	}
+	if k.applied != nil {
+		k.applied[obj.GetName()] = namespace
+	}
+	if k.timeouts != nil {
+		k.timeouts[obj.GetName()] = config.Timeout
+	}
+	if err, ok := k.applyErrs[obj.GetName()]; ok && !dryRun {
+		return "", err
+	}
+	command, ok := k.commands[obj.GetName()]
+	if !ok {
+		return "", nil
+	}
+	return command.output, command.err
+}
+
+func (k mockKubectlCmd) DryRunApplyResource(config *rest.Config, obj *unstructured.Unstructured, namespace string) (*unstructured.Unstructured, error) {
+	command, ok := k.commands[obj.GetName()]
+	if ok && command.err != nil {
+		return nil, command.err
+	}
+	if predicted, ok := k.predicted[obj.GetName()]; ok {
+		return predicted, nil
+	}
+	return obj.DeepCopy(), nil
+}
+
+func (k mockKubectlCmd) ReplaceResource(config *rest.Config, obj *unstructured.Unstructured, namespace string, validate bool) (string, error) {
+	if k.replaced != nil {
+		k.replaced[obj.GetName()] = true
+	}
+	if k.unvalidatedReplaces != nil && !validate {
+		k.unvalidatedReplaces[obj.GetName()] = true
+	}
 	command, ok := k.commands[obj.GetName()]
 	if !ok {
 		return "", nil
@@ -89,9 +180,10 @@ func newTestSyncCtx(resources ...*v1.APIResourceList) *syncContext {
 				},
 			},
 		},
-		opState: &v1alpha1.OperationState{},
-		disco:   fakeDisco,
-		log:     log.WithFields(log.Fields{"application": "fake-app"}),
+		opState:   &v1alpha1.OperationState{},
+		disco:     fakeDisco,
+		dynamicIf: fakedynamic.NewSimpleDynamicClient(runtime.NewScheme()),
+		log:       log.WithFields(log.Fields{"application": "fake-app"}),
 	}
 }
 
@@ -380,3 +472,896 @@ func TestSortManifestHandleNil(t *testing.T) {
 	assert.Nil(t, manifest[1].targetObj)
 
 }
+
+func TestPolicyCheckerFromSettings(t *testing.T) {
+	// argocd-cm does not exist
+	mgr := &appStateManager{settingsMgr: settings.NewSettingsManager(fake.NewSimpleClientset(), "argocd")}
+	checker, _, err := mgr.policyChecker()
+	assert.NoError(t, err)
+	assert.Nil(t, checker)
+
+	// the settings updated by the notifier are used instead of argocd-cm
+	mgr.UpdateSettings(&settings.ArgoCDSettings{Policy: &settings.PolicyConfig{URL: "http://opa:8181/v1/data/argocd/deny", FailOpen: true}})
+	checker, failOpen, err := mgr.policyChecker()
+	assert.NoError(t, err)
+	assert.NotNil(t, checker)
+	assert.True(t, failOpen)
+}
+
+func TestRedactorFromSettings(t *testing.T) {
+	configMap := `{"apiVersion":"v1","kind":"ConfigMap","data":{"password":"secret"}}`
+	// argocd-cm does not exist, so only secrets are redacted
+	mgr := &appStateManager{settingsMgr: settings.NewSettingsManager(fake.NewSimpleClientset(), "argocd")}
+	redactor, err := mgr.redactor()
+	assert.NoError(t, err)
+	assert.Equal(t, configMap, redactor.RedactState(configMap))
+
+	// the settings updated by the notifier are used instead of argocd-cm
+	mgr.UpdateSettings(&settings.ArgoCDSettings{ResourceRedactions: []settings.ResourceRedaction{{Kind: "ConfigMap", Fields: []string{"data"}}}})
+	redactor, err = mgr.redactor()
+	assert.NoError(t, err)
+	assert.NotContains(t, redactor.RedactState(configMap), "secret")
+}
+
+func TestResourceOrderFromSettings(t *testing.T) {
+	kubeClientset := fake.NewSimpleClientset(&apiv1.ConfigMap{
+		ObjectMeta: v1.ObjectMeta{Name: common.ArgoCDConfigMapName, Namespace: "argocd"},
+		Data:       map[string]string{"resource.order": "- Namespace\n- Issuer\n- Certificate\n"},
+	}, &apiv1.Secret{
+		ObjectMeta: v1.ObjectMeta{Name: common.ArgoCDSecretName, Namespace: "argocd"},
+	})
+	mgr := &appStateManager{settingsMgr: settings.NewSettingsManager(kubeClientset, "argocd")}
+	order, err := mgr.resourceOrder()
+	assert.NoError(t, err)
+	assert.Equal(t, sortOrder{"Namespace", "Issuer", "Certificate"}, order)
+
+	newTask := func(kind string) syncTask {
+		return syncTask{targetObj: &unstructured.Unstructured{Object: map[string]interface{}{"kind": kind}}}
+	}
+	tasks := []syncTask{newTask("Service"), newTask("Certificate"), newTask("Issuer")}
+	sort.Sort(newKindSorter(tasks, order))
+	// kinds missing from the order are applied last
+	assert.Equal(t, []syncTask{newTask("Issuer"), newTask("Certificate"), newTask("Service")}, tasks)
+
+	// the settings updated by the notifier are used instead of argocd-cm
+	mgr.UpdateSettings(&settings.ArgoCDSettings{ResourceOrder: []string{"Namespace", "Certificate"}})
+	order, err = mgr.resourceOrder()
+	assert.NoError(t, err)
+	assert.Equal(t, sortOrder{"Namespace", "Certificate"}, order)
+
+	mgr = &appStateManager{}
+	order, err = mgr.resourceOrder()
+	assert.NoError(t, err)
+	assert.Equal(t, resourceOrder, order)
+}
+
+func TestSkipDryRunOnMissingResource(t *testing.T) {
+	syncCtx := newTestSyncCtx(&v1.APIResourceList{GroupVersion: "example.com/v1"})
+	syncCtx.kubectl = mockKubectlCmd{}
+	cr, err := v1alpha1.UnmarshalToUnstructured(`{"apiVersion":"example.com/v1","kind":"Foo","metadata":{"name":"my-foo"}}`)
+	assert.NoError(t, err)
+	tasks := []syncTask{{targetObj: cr}}
+
+	assert.False(t, syncCtx.doApplySync(tasks, true, false, false))
+
+	syncCtx.syncRes.Resources = nil
+	cr.SetAnnotations(map[string]string{common.AnnotationSyncOptions: common.SyncOptionSkipDryRunOnMissingResource})
+	assert.True(t, syncCtx.doApplySync(tasks, true, false, false))
+	assert.Len(t, syncCtx.syncRes.Resources, 0)
+}
+
+func TestSyncPrunePropagationPolicy(t *testing.T) {
+	syncCtx := newTestSyncCtx()
+	syncCtx.kubectl = mockKubectlCmd{}
+	liveObj, err := v1alpha1.UnmarshalToUnstructured(`{"kind":"pod","metadata":{"name":"foo"}}`)
+	assert.NoError(t, err)
+
+	syncCtx.syncOp.PrunePropagationPolicy = v1alpha1.PropagationPolicyOrphan
+	resDetails := syncCtx.pruneObject(liveObj, true, false)
+	assert.Equal(t, v1alpha1.ResourceDetailsSyncedAndPruned, resDetails.Status)
+
+	syncCtx.syncOp.PrunePropagationPolicy = "cascade"
+	resDetails = syncCtx.pruneObject(liveObj, true, false)
+	assert.Equal(t, v1alpha1.ResourceDetailsSyncFailed, resDetails.Status)
+	assert.Contains(t, resDetails.Message, "unknown propagation policy")
+}
+
+func TestSyncPruneDisabled(t *testing.T) {
+	syncCtx := newTestSyncCtx()
+	syncCtx.kubectl = mockKubectlCmd{}
+	liveObj, err := v1alpha1.UnmarshalToUnstructured(fmt.Sprintf(`{"kind":"pod","metadata":{"name":"foo","annotations":{%q:%q}}}`,
+		common.AnnotationSyncOptions, common.SyncOptionDisablePrune))
+	assert.NoError(t, err)
+
+	for _, prune := range []bool{true, false} {
+		resDetails := syncCtx.pruneObject(liveObj, prune, false)
+		assert.Equal(t, v1alpha1.ResourceDetailsSynced, resDetails.Status)
+		assert.Equal(t, "skipped (prune disabled)", resDetails.Message)
+	}
+}
+
+func TestSyncCreateNamespace(t *testing.T) {
+	syncCtx := newTestSyncCtx()
+	syncCtx.kubectl = mockKubectlCmd{}
+	syncCtx.dynamicIf = fakedynamic.NewSimpleDynamicClient(runtime.NewScheme())
+	syncCtx.syncPolicy = &v1alpha1.SyncPolicy{SyncOptions: []string{common.SyncOptionCreateNamespace}}
+	syncCtx.resources = []v1alpha1.ResourceState{{
+		TargetState: `{"kind":"pod","metadata":{"name":"my-pod"}}`,
+	}}
+	syncCtx.sync()
+	ns, err := syncCtx.dynamicIf.Resource(schema.GroupVersionResource{Version: "v1", Resource: "namespaces"}).Get("test-namespace", v1.GetOptions{})
+	assert.NoError(t, err)
+	// the namespace is not tracked as a resource of the application
+	assert.Empty(t, ns.GetLabels())
+	assert.Len(t, syncCtx.syncRes.Resources, 1)
+
+	// an existing namespace is kept
+	assert.NoError(t, syncCtx.ensureNamespace())
+}
+
+func TestSyncCreateNamespaceNotPermitted(t *testing.T) {
+	syncCtx := newTestSyncCtx()
+	syncCtx.kubectl = mockKubectlCmd{}
+	syncCtx.dynamicIf = fakedynamic.NewSimpleDynamicClient(runtime.NewScheme())
+	syncCtx.syncPolicy = &v1alpha1.SyncPolicy{SyncOptions: []string{common.SyncOptionCreateNamespace}}
+	syncCtx.proj.Spec.ClusterResourceWhitelist = nil
+	syncCtx.resources = []v1alpha1.ResourceState{{
+		TargetState: `{"kind":"pod","metadata":{"name":"my-pod"}}`,
+	}}
+	syncCtx.sync()
+	assert.Equal(t, v1alpha1.OperationError, syncCtx.opState.Phase)
+	assert.Contains(t, syncCtx.opState.Message, "is not permitted in project")
+	_, err := syncCtx.dynamicIf.Resource(schema.GroupVersionResource{Version: "v1", Resource: "namespaces"}).Get("test-namespace", v1.GetOptions{})
+	assert.True(t, apierr.IsNotFound(err))
+}
+
+func TestSyncWaitForDeletion(t *testing.T) {
+	syncCtx := newTestSyncCtx()
+	syncCtx.kubectl = mockKubectlCmd{}
+	syncCtx.syncPolicy = &v1alpha1.SyncPolicy{SyncOptions: []string{common.SyncOptionWaitForDeletion}}
+	syncCtx.opState.StartedAt = v1.Now()
+	kept := v1alpha1.ResourceState{
+		LiveState:   `{"kind":"pod","metadata":{"name":"kept"}}`,
+		TargetState: `{"kind":"pod","metadata":{"name":"kept"}}`,
+	}
+	pruned := v1alpha1.ResourceState{
+		LiveState: `{"kind":"pod","metadata":{"name":"pruned"}}`,
+	}
+	syncCtx.resources = []v1alpha1.ResourceState{kept, pruned}
+	syncCtx.sync()
+	assert.Len(t, syncCtx.syncRes.Resources, 2)
+
+	// the sync waits while the pruned object exists
+	syncCtx.sync()
+	assert.Equal(t, string(v1alpha1.OperationRunning), string(syncCtx.opState.Phase))
+	for _, res := range syncCtx.syncRes.Resources {
+		if res.Name == "pruned" {
+			assert.Equal(t, prunePendingMessage, res.Message)
+		}
+	}
+
+	// the sync completes once the object is deleted
+	syncCtx.resources = []v1alpha1.ResourceState{kept}
+	syncCtx.sync()
+	assert.Equal(t, string(v1alpha1.OperationSucceeded), string(syncCtx.opState.Phase))
+	for _, res := range syncCtx.syncRes.Resources {
+		if res.Name == "pruned" {
+			assert.Equal(t, v1alpha1.ResourceDetailsSyncedAndPruned, res.Status)
+			assert.Equal(t, "pruned", res.Message)
+		}
+	}
+}
+
+func TestSyncWaitForDeletionTimeout(t *testing.T) {
+	syncCtx := newTestSyncCtx()
+	syncCtx.kubectl = mockKubectlCmd{}
+	syncCtx.opState.StartedAt = v1.Now()
+	deletedAt := time.Now().Add(-deletionTimeout - time.Minute).UTC().Format(time.RFC3339)
+	syncCtx.resources = []v1alpha1.ResourceState{{
+		LiveState: fmt.Sprintf(`{"kind":"pod","metadata":{"name":"pruned","deletionTimestamp":%q,"annotations":{%q:%q}}}`,
+			deletedAt, common.AnnotationSyncOptions, common.SyncOptionWaitForDeletion),
+	}}
+	syncCtx.sync()
+	syncCtx.sync()
+	assert.Equal(t, string(v1alpha1.OperationFailed), string(syncCtx.opState.Phase))
+	assert.Len(t, syncCtx.syncRes.Resources, 1)
+	assert.Equal(t, v1alpha1.ResourceDetailsSyncFailed, syncCtx.syncRes.Resources[0].Status)
+}
+
+func TestSyncMovePrunesPreviousDestination(t *testing.T) {
+	syncCtx := newTestSyncCtx()
+	deleted := make(map[string]string)
+	syncCtx.kubectl = mockKubectlCmd{deleted: deleted}
+	prev := v1alpha1.ApplicationDestination{Server: "https://prev", Namespace: "prev-namespace"}
+	syncCtx.moveSource = &moveSource{
+		destination: prev,
+		config:      &rest.Config{},
+		liveObjs: []*unstructured.Unstructured{
+			kube.MustToUnstructured(&apiv1.Pod{
+				TypeMeta:   v1.TypeMeta{Kind: "Pod", APIVersion: "v1"},
+				ObjectMeta: v1.ObjectMeta{Name: "moved", Namespace: "prev-namespace"},
+			}),
+			kube.MustToUnstructured(&apiv1.Pod{
+				TypeMeta: v1.TypeMeta{Kind: "Pod", APIVersion: "v1"},
+				ObjectMeta: v1.ObjectMeta{Name: "kept", Namespace: "prev-namespace", Annotations: map[string]string{
+					common.AnnotationSyncOptions: common.SyncOptionDisablePrune,
+				}},
+			}),
+		},
+	}
+	syncCtx.resources = []v1alpha1.ResourceState{{
+		TargetState: `{"kind":"pod","metadata":{"name":"moved"}}`,
+	}}
+	syncCtx.sync()
+	assert.Len(t, syncCtx.syncRes.Resources, 1)
+	assert.Len(t, deleted, 0)
+
+	// the previous destination is pruned only once the resources exist in the new destination
+	syncCtx.sync()
+	assert.Equal(t, string(v1alpha1.OperationRunning), string(syncCtx.opState.Phase))
+	assert.Len(t, deleted, 0)
+
+	syncCtx.resources[0].LiveState = `{"kind":"pod","metadata":{"name":"moved"}}`
+	syncCtx.sync()
+	assert.Equal(t, string(v1alpha1.OperationSucceeded), string(syncCtx.opState.Phase))
+	assert.Equal(t, map[string]string{"moved": "prev-namespace"}, deleted)
+	assert.Len(t, syncCtx.syncRes.MovedResources, 2)
+	for _, res := range syncCtx.syncRes.MovedResources {
+		if res.Name == "moved" {
+			assert.Equal(t, v1alpha1.ResourceDetailsSyncedAndPruned, res.Status)
+		} else {
+			assert.Equal(t, "skipped (prune disabled)", res.Message)
+		}
+	}
+}
+
+func TestSyncReplace(t *testing.T) {
+	syncCtx := newTestSyncCtx()
+	replaced := make(map[string]bool)
+	syncCtx.kubectl = mockKubectlCmd{replaced: replaced}
+	replaceAnnotation := fmt.Sprintf(`"annotations":{%q:%q}`, common.AnnotationSyncOptions, common.SyncOptionReplace)
+	syncCtx.resources = []v1alpha1.ResourceState{{
+		LiveState:   `{"kind":"pod","metadata":{"name":"out-of-sync"}}`,
+		TargetState: `{"kind":"pod","metadata":{"name":"out-of-sync",` + replaceAnnotation + `}}`,
+		Status:      v1alpha1.ComparisonStatusOutOfSync,
+	}, {
+		LiveState:   `{"kind":"pod","metadata":{"name":"synced",` + replaceAnnotation + `}}`,
+		TargetState: `{"kind":"pod","metadata":{"name":"synced",` + replaceAnnotation + `}}`,
+		Status:      v1alpha1.ComparisonStatusSynced,
+	}, {
+		TargetState: `{"kind":"pod","metadata":{"name":"missing",` + replaceAnnotation + `}}`,
+		Status:      v1alpha1.ComparisonStatusOutOfSync,
+	}, {
+		LiveState:   `{"kind":"service","metadata":{"name":"not-annotated"}}`,
+		TargetState: `{"kind":"service","metadata":{"name":"not-annotated"}}`,
+		Status:      v1alpha1.ComparisonStatusOutOfSync,
+	}}
+	syncCtx.sync()
+	assert.Len(t, syncCtx.syncRes.Resources, 4)
+	assert.Equal(t, map[string]bool{"out-of-sync": true}, replaced)
+
+	syncCtx = newTestSyncCtx()
+	replaced = make(map[string]bool)
+	syncCtx.kubectl = mockKubectlCmd{replaced: replaced}
+	syncCtx.syncPolicy = &v1alpha1.SyncPolicy{SyncOptions: []string{common.SyncOptionReplace}}
+	syncCtx.resources = []v1alpha1.ResourceState{{
+		LiveState:   `{"kind":"service","metadata":{"name":"not-annotated"}}`,
+		TargetState: `{"kind":"service","metadata":{"name":"not-annotated"}}`,
+		Status:      v1alpha1.ComparisonStatusOutOfSync,
+	}}
+	syncCtx.sync()
+	assert.Equal(t, map[string]bool{"not-annotated": true}, replaced)
+}
+
+func TestSyncReplaceOnImmutableFieldError(t *testing.T) {
+	syncCtx := newTestSyncCtx()
+	replaced := make(map[string]bool)
+	immutableErr := fmt.Errorf(`The Job "my-job" is invalid: spec.template: Invalid value: core.PodTemplateSpec{}: field is immutable`)
+	syncCtx.kubectl = mockKubectlCmd{
+		replaced: replaced,
+		applyErrs: map[string]error{
+			"annotated":     immutableErr,
+			"not-annotated": immutableErr,
+			"other-error":   fmt.Errorf("connection refused"),
+		},
+	}
+	replaceAnnotation := fmt.Sprintf(`"annotations":{%q:%q}`, common.AnnotationSyncOptions, common.SyncOptionReplaceOnImmutableFieldError)
+	syncCtx.resources = []v1alpha1.ResourceState{{
+		LiveState:   `{"kind":"pod","metadata":{"name":"annotated"}}`,
+		TargetState: `{"kind":"pod","metadata":{"name":"annotated",` + replaceAnnotation + `}}`,
+		Status:      v1alpha1.ComparisonStatusOutOfSync,
+	}, {
+		LiveState:   `{"kind":"pod","metadata":{"name":"not-annotated"}}`,
+		TargetState: `{"kind":"pod","metadata":{"name":"not-annotated"}}`,
+		Status:      v1alpha1.ComparisonStatusOutOfSync,
+	}, {
+		LiveState:   `{"kind":"pod","metadata":{"name":"other-error"}}`,
+		TargetState: `{"kind":"pod","metadata":{"name":"other-error",` + replaceAnnotation + `}}`,
+		Status:      v1alpha1.ComparisonStatusOutOfSync,
+	}}
+	syncCtx.sync()
+	assert.Equal(t, map[string]bool{"annotated": true}, replaced)
+	assert.Equal(t, string(v1alpha1.OperationFailed), string(syncCtx.opState.Phase))
+	resources := make(map[string]v1alpha1.ResourceDetails)
+	for _, res := range syncCtx.syncRes.Resources {
+		resources[res.Name] = *res
+	}
+	assert.Equal(t, v1alpha1.ResourceDetailsSynced, resources["annotated"].Status)
+	assert.Contains(t, resources["annotated"].Message, "replaced after apply failed")
+	assert.Equal(t, v1alpha1.ResourceDetailsSyncFailed, resources["not-annotated"].Status)
+	assert.Equal(t, v1alpha1.ResourceDetailsSyncFailed, resources["other-error"].Status)
+}
+
+func TestSyncDisableValidation(t *testing.T) {
+	syncCtx := newTestSyncCtx()
+	unvalidated := make(map[string]bool)
+	syncCtx.kubectl = mockKubectlCmd{unvalidated: unvalidated}
+	syncCtx.resources = []v1alpha1.ResourceState{{
+		TargetState: fmt.Sprintf(`{"kind":"pod","metadata":{"name":"annotated","annotations":{%q:%q}}}`, common.AnnotationSyncOptions, common.SyncOptionDisableValidation),
+	}, {
+		TargetState: `{"kind":"pod","metadata":{"name":"not-annotated"}}`,
+	}}
+	syncCtx.sync()
+	assert.Len(t, syncCtx.syncRes.Resources, 2)
+	assert.Equal(t, map[string]bool{"annotated": true}, unvalidated)
+
+	syncCtx = newTestSyncCtx()
+	unvalidated = make(map[string]bool)
+	syncCtx.kubectl = mockKubectlCmd{unvalidated: unvalidated}
+	syncCtx.syncPolicy = &v1alpha1.SyncPolicy{SyncOptions: []string{common.SyncOptionDisableValidation}}
+	syncCtx.resources = []v1alpha1.ResourceState{{
+		TargetState: `{"kind":"pod","metadata":{"name":"not-annotated"}}`,
+	}}
+	syncCtx.sync()
+	assert.Equal(t, map[string]bool{"not-annotated": true}, unvalidated)
+
+	// replaced resources are not validated either
+	syncCtx = newTestSyncCtx()
+	unvalidatedReplaces := make(map[string]bool)
+	syncCtx.kubectl = mockKubectlCmd{unvalidatedReplaces: unvalidatedReplaces}
+	syncCtx.syncPolicy = &v1alpha1.SyncPolicy{SyncOptions: []string{common.SyncOptionReplace, common.SyncOptionDisableValidation}}
+	syncCtx.resources = []v1alpha1.ResourceState{{
+		LiveState:   `{"kind":"pod","metadata":{"name":"out-of-sync"}}`,
+		TargetState: `{"kind":"pod","metadata":{"name":"out-of-sync","labels":{"foo":"bar"}}}`,
+		Status:      v1alpha1.ComparisonStatusOutOfSync,
+	}}
+	syncCtx.sync()
+	assert.Equal(t, map[string]bool{"out-of-sync": true}, unvalidatedReplaces)
+}
+
+func TestSyncApplyOutOfSyncOnly(t *testing.T) {
+	syncCtx := newTestSyncCtx()
+	syncCtx.kubectl = mockKubectlCmd{}
+	syncCtx.syncPolicy = &v1alpha1.SyncPolicy{SyncOptions: []string{common.SyncOptionApplyOutOfSyncOnly}}
+	syncCtx.resources = []v1alpha1.ResourceState{{
+		LiveState:   `{"kind":"pod","metadata":{"name":"synced"}}`,
+		TargetState: `{"kind":"pod","metadata":{"name":"synced"}}`,
+		Status:      v1alpha1.ComparisonStatusSynced,
+	}, {
+		LiveState:   `{"kind":"pod","metadata":{"name":"out-of-sync"}}`,
+		TargetState: `{"kind":"pod","metadata":{"name":"out-of-sync","labels":{"foo":"bar"}}}`,
+		Status:      v1alpha1.ComparisonStatusOutOfSync,
+	}, {
+		LiveState: `{"kind":"service","metadata":{"name":"extraneous"}}`,
+		Status:    v1alpha1.ComparisonStatusOutOfSync,
+	}}
+	syncCtx.sync()
+	assert.Len(t, syncCtx.syncRes.Resources, 2)
+	for _, res := range syncCtx.syncRes.Resources {
+		assert.NotEqual(t, "synced", res.Name)
+	}
+
+	syncCtx.syncRes.Resources = nil
+	syncCtx.syncPolicy = nil
+	tasks, successful := syncCtx.generateSyncTasks()
+	assert.True(t, successful)
+	assert.Len(t, tasks, 3)
+}
+
+func TestSyncExcludedResources(t *testing.T) {
+	syncCtx := newTestSyncCtx()
+	syncCtx.kubectl = mockKubectlCmd{}
+	syncCtx.resources = []v1alpha1.ResourceState{{
+		LiveState:   `{"kind":"pod","metadata":{"name":"included"}}`,
+		TargetState: `{"kind":"pod","metadata":{"name":"included"}}`,
+	}, {
+		LiveState:   `{"kind":"pod","metadata":{"name":"excluded"}}`,
+		TargetState: `{"kind":"pod","metadata":{"name":"excluded"}}`,
+	}, {
+		LiveState: `{"kind":"service","metadata":{"name":"excluded"}}`,
+	}}
+	syncCtx.syncOp.ExcludedResources = []v1alpha1.SyncOperationResource{
+		{Kind: "pod", Name: "excluded"},
+		{Kind: "service", Name: "excluded"},
+	}
+	tasks, successful := syncCtx.generateSyncTasks()
+	assert.True(t, successful)
+	if assert.Len(t, tasks, 1) {
+		assert.Equal(t, "included", tasks[0].targetObj.GetName())
+	}
+
+	// exclusions apply to the included resources as well
+	syncCtx.syncResources = []v1alpha1.SyncOperationResource{{Kind: "pod", Name: "excluded"}}
+	tasks, successful = syncCtx.generateSyncTasks()
+	assert.True(t, successful)
+	assert.Len(t, tasks, 0)
+}
+
+func TestRunParallelLimitsConcurrency(t *testing.T) {
+	tasks := make([]syncTask, 20)
+	runMaxParallel := func(syncCtx *syncContext) int {
+		var lock sync.Mutex
+		running, maxRunning := 0, 0
+		syncCtx.runParallel(tasks, func(t syncTask) {
+			lock.Lock()
+			running++
+			if running > maxRunning {
+				maxRunning = running
+			}
+			lock.Unlock()
+			time.Sleep(10 * time.Millisecond)
+			lock.Lock()
+			running--
+			lock.Unlock()
+		})
+		return maxRunning
+	}
+
+	syncCtx := newTestSyncCtx()
+	assert.True(t, runMaxParallel(syncCtx) > 3)
+
+	syncCtx.syncOp.ApplyConcurrency = 3
+	assert.Equal(t, 3, runMaxParallel(syncCtx))
+
+	// the limit of the controller applies as well
+	syncCtx.applyLimiter = newConcurrencyLimiter(2)
+	assert.Equal(t, 2, runMaxParallel(syncCtx))
+}
+
+type fakePolicyChecker struct {
+	decision *policy.Decision
+	err      error
+	inputs   []policy.Input
+}
+
+func (c *fakePolicyChecker) Check(ctx context.Context, input policy.Input) (*policy.Decision, error) {
+	c.inputs = append(c.inputs, input)
+	return c.decision, c.err
+}
+
+func TestSyncDeniedByPolicy(t *testing.T) {
+	checker := fakePolicyChecker{decision: &policy.Decision{Reasons: []string{"pods must set resource limits", "pods must not run as root"}}}
+	syncCtx := newTestSyncCtx()
+	syncCtx.kubectl = mockKubectlCmd{}
+	syncCtx.policy = &checker
+	syncCtx.resources = []v1alpha1.ResourceState{{
+		TargetState: `{"kind":"pod","metadata":{"name":"my-pod"}}`,
+	}}
+	syncCtx.sync()
+	assert.Equal(t, string(v1alpha1.OperationFailed), string(syncCtx.opState.Phase))
+	assert.Equal(t, "sync denied by policy: pods must set resource limits; pods must not run as root", syncCtx.opState.Message)
+	assert.Equal(t, checker.decision.Reasons, syncCtx.syncRes.DeniedReasons)
+	assert.Len(t, syncCtx.syncRes.Resources, 0)
+	assert.Len(t, checker.inputs, 1)
+	assert.Len(t, checker.inputs[0].Manifests, 1)
+	assert.Equal(t, "my-pod", checker.inputs[0].Manifests[0].GetName())
+}
+
+func TestSyncAllowedByPolicy(t *testing.T) {
+	checker := fakePolicyChecker{decision: &policy.Decision{Allowed: true}}
+	syncCtx := newTestSyncCtx()
+	syncCtx.kubectl = mockKubectlCmd{}
+	syncCtx.policy = &checker
+	syncCtx.resources = []v1alpha1.ResourceState{{
+		TargetState: `{"kind":"pod","metadata":{"name":"my-pod"}}`,
+	}}
+	syncCtx.sync()
+	syncCtx.sync()
+	assert.Equal(t, string(v1alpha1.OperationSucceeded), string(syncCtx.opState.Phase))
+	assert.Empty(t, syncCtx.syncRes.DeniedReasons)
+	// the policy service is consulted once per operation
+	assert.Len(t, checker.inputs, 1)
+}
+
+func TestSyncPolicyServiceError(t *testing.T) {
+	checker := fakePolicyChecker{err: fmt.Errorf("connection refused")}
+	syncCtx := newTestSyncCtx()
+	syncCtx.kubectl = mockKubectlCmd{}
+	syncCtx.policy = &checker
+	syncCtx.resources = []v1alpha1.ResourceState{{
+		TargetState: `{"kind":"pod","metadata":{"name":"my-pod"}}`,
+	}}
+	syncCtx.sync()
+	assert.Equal(t, string(v1alpha1.OperationError), string(syncCtx.opState.Phase))
+	assert.Len(t, syncCtx.syncRes.Resources, 0)
+
+	syncCtx = newTestSyncCtx()
+	syncCtx.kubectl = mockKubectlCmd{}
+	syncCtx.policy = &checker
+	syncCtx.policyFailOpen = true
+	syncCtx.resources = []v1alpha1.ResourceState{{
+		TargetState: `{"kind":"pod","metadata":{"name":"my-pod"}}`,
+	}}
+	syncCtx.sync()
+	syncCtx.sync()
+	assert.Equal(t, string(v1alpha1.OperationSucceeded), string(syncCtx.opState.Phase))
+}
+
+func TestSyncRetryFailedResourcesOnly(t *testing.T) {
+	syncCtx := newTestSyncCtx()
+	syncCtx.kubectl = mockKubectlCmd{}
+	syncCtx.syncRes.FailedResources = []*v1alpha1.ResourceDetails{{Kind: "service", Name: "my-service", Status: v1alpha1.ResourceDetailsSyncFailed}}
+	syncCtx.resources = []v1alpha1.ResourceState{{
+		TargetState: `{"kind":"pod","metadata":{"name":"my-pod"}}`,
+	}, {
+		TargetState: `{"kind":"service","metadata":{"name":"my-service"}}`,
+	}}
+	syncCtx.sync()
+	assert.Len(t, syncCtx.syncRes.Resources, 1)
+	assert.Equal(t, "my-service", syncCtx.syncRes.Resources[0].Name)
+	syncCtx.sync()
+	assert.Equal(t, string(v1alpha1.OperationSucceeded), string(syncCtx.opState.Phase))
+}
+
+func TestDryRunSyncDiffPreview(t *testing.T) {
+	syncCtx := newTestSyncCtx(&v1.APIResourceList{
+		GroupVersion: "v1",
+		APIResources: []v1.APIResource{
+			{Name: "configmaps", Namespaced: true, Kind: "ConfigMap"},
+			{Name: "secrets", Namespaced: true, Kind: "Secret"},
+		},
+	})
+	syncCtx.kubectl = mockKubectlCmd{commands: map[string]kubectlOutput{
+		"my-config":   {output: "configmap/my-config configured (dry run)"},
+		"my-secret":   {output: "secret/my-secret configured (dry run)"},
+		"new-config":  {output: "configmap/new-config created (dry run)"},
+		"same-config": {output: "configmap/same-config unchanged (dry run)"},
+	}}
+	syncCtx.syncOp.DryRun = true
+	syncCtx.redactor = redact.NewRedactor((&settings.ArgoCDSettings{}).GetResourceRedactions())
+	syncCtx.resources = []v1alpha1.ResourceState{{
+		LiveState:   `{"apiVersion":"v1","kind":"ConfigMap","metadata":{"name":"my-config"},"data":{"replicas":"1"}}`,
+		TargetState: `{"apiVersion":"v1","kind":"ConfigMap","metadata":{"name":"my-config"},"data":{"replicas":"2"}}`,
+	}, {
+		LiveState:   `{"apiVersion":"v1","kind":"Secret","metadata":{"name":"my-secret"},"data":{"password":"cGFzcw=="}}`,
+		TargetState: `{"apiVersion":"v1","kind":"Secret","metadata":{"name":"my-secret"},"data":{"password":"bmV3"}}`,
+	}, {
+		TargetState: `{"apiVersion":"v1","kind":"ConfigMap","metadata":{"name":"new-config"}}`,
+	}, {
+		LiveState:   `{"apiVersion":"v1","kind":"ConfigMap","metadata":{"name":"same-config"},"data":{"replicas":"1"}}`,
+		TargetState: `{"apiVersion":"v1","kind":"ConfigMap","metadata":{"name":"same-config"},"data":{"replicas":"1"}}`,
+	}, {
+		LiveState: `{"apiVersion":"v1","kind":"ConfigMap","metadata":{"name":"old-config"}}`,
+	}}
+	syncCtx.sync()
+	assert.Equal(t, string(v1alpha1.OperationSucceeded), string(syncCtx.opState.Phase))
+	assert.Len(t, syncCtx.syncRes.Resources, 5)
+	results := make(map[string]*v1alpha1.ResourceDetails)
+	for _, res := range syncCtx.syncRes.Resources {
+		results[res.Name] = res
+	}
+	assert.Equal(t, "configmap/my-config configured (dry run)", results["my-config"].Message)
+	assert.Equal(t, v1alpha1.ResourceSyncActionUpdate, results["my-config"].Action)
+	assert.Contains(t, results["my-config"].Diff, `"replicas": "1"`)
+	assert.Contains(t, results["my-config"].Diff, `"replicas": "2"`)
+	assert.Equal(t, v1alpha1.ResourceSyncActionUpdate, results["my-secret"].Action)
+	assert.Contains(t, results["my-secret"].Diff, "*********")
+	assert.NotContains(t, results["my-secret"].Diff, "cGFzcw==")
+	assert.NotContains(t, results["my-secret"].Diff, "bmV3")
+	assert.Equal(t, "configmap/new-config created (dry run)", results["new-config"].Message)
+	assert.Equal(t, v1alpha1.ResourceSyncActionCreate, results["new-config"].Action)
+	assert.Empty(t, results["new-config"].Diff)
+	assert.Equal(t, v1alpha1.ResourceSyncActionUnchanged, results["same-config"].Action)
+	assert.Empty(t, results["same-config"].Diff)
+	assert.Equal(t, v1alpha1.ResourceSyncActionPrune, results["old-config"].Action)
+	assert.Equal(t, v1alpha1.ResourceDetailsSyncedAndPruned, results["old-config"].Status)
+}
+
+func TestDryRunSyncPruneRequired(t *testing.T) {
+	syncCtx := newTestSyncCtx()
+	syncCtx.syncOp.DryRun = true
+	syncCtx.syncOp.Prune = false
+	syncCtx.resources = []v1alpha1.ResourceState{{
+		LiveState: `{"apiVersion":"v1","kind":"ConfigMap","metadata":{"name":"old-config"}}`,
+	}}
+	syncCtx.sync()
+	assert.Len(t, syncCtx.syncRes.Resources, 1)
+	// the resource is not pruned, so it is reported as requiring pruning rather than unchanged
+	assert.Equal(t, v1alpha1.ResourceDetailsPruningRequired, syncCtx.syncRes.Resources[0].Status)
+	assert.Equal(t, v1alpha1.ResourceSyncActionPruneRequired, syncCtx.syncRes.Resources[0].Action)
+}
+
+func newTestQuotaSyncCtx(t *testing.T, liveReplicas int64) *syncContext {
+	syncCtx := newTestSyncCtx(&v1.APIResourceList{
+		GroupVersion: "apps/v1",
+		APIResources: []v1.APIResource{
+			{Name: "deployments", Namespaced: true, Kind: "Deployment"},
+		},
+	})
+	quota, err := runtime.DefaultUnstructuredConverter.ToUnstructured(&apiv1.ResourceQuota{
+		TypeMeta:   v1.TypeMeta{APIVersion: "v1", Kind: "ResourceQuota"},
+		ObjectMeta: v1.ObjectMeta{Name: "compute", Namespace: syncCtx.namespace},
+		Spec: apiv1.ResourceQuotaSpec{Hard: apiv1.ResourceList{
+			apiv1.ResourceRequestsCPU: resource.MustParse("2"),
+			apiv1.ResourcePods:        resource.MustParse("10"),
+		}},
+		Status: apiv1.ResourceQuotaStatus{Used: apiv1.ResourceList{
+			apiv1.ResourceRequestsCPU: resource.MustParse("1"),
+			apiv1.ResourcePods:        resource.MustParse("2"),
+		}},
+	})
+	assert.NoError(t, err)
+	syncCtx.dynamicIf = fakedynamic.NewSimpleDynamicClient(runtime.NewScheme(), &unstructured.Unstructured{Object: quota})
+	syncCtx.kubectl = mockKubectlCmd{}
+	deployment := func(replicas int64) string {
+		return fmt.Sprintf(`{"apiVersion":"apps/v1","kind":"Deployment","metadata":{"name":"my-deploy"},"spec":{"replicas":%d,"template":{"spec":{"containers":[{"name":"app","resources":{"requests":{"cpu":"500m"}}}]}}}}`, replicas)
+	}
+	syncCtx.resources = []v1alpha1.ResourceState{{
+		LiveState:   deployment(liveReplicas),
+		TargetState: deployment(4),
+	}}
+	return syncCtx
+}
+
+func TestSyncExceedsQuota(t *testing.T) {
+	syncCtx := newTestQuotaSyncCtx(t, 1)
+	syncCtx.sync()
+	assert.Equal(t, string(v1alpha1.OperationFailed), string(syncCtx.opState.Phase))
+	assert.Equal(t, "sync exceeds resource quota: test-namespace/compute: requests.cpu used 1 + requested 1500m > hard 2", syncCtx.opState.Message)
+	assert.Len(t, syncCtx.syncRes.Resources, 0)
+
+	syncCtx = newTestQuotaSyncCtx(t, 1)
+	syncCtx.syncPolicy = &v1alpha1.SyncPolicy{SyncOptions: []string{common.SyncOptionDisableQuotaCheck}}
+	syncCtx.sync()
+	assert.Empty(t, string(syncCtx.opState.Phase))
+	assert.Len(t, syncCtx.syncRes.Resources, 1)
+}
+
+func TestSyncWithinQuota(t *testing.T) {
+	// the live replicas are already accounted in the quota usage
+	syncCtx := newTestQuotaSyncCtx(t, 2)
+	syncCtx.sync()
+	assert.Empty(t, string(syncCtx.opState.Phase))
+	assert.Len(t, syncCtx.syncRes.Resources, 1)
+}
+
+func TestPodComputeUsage(t *testing.T) {
+	usage := podComputeUsage(&apiv1.PodSpec{
+		InitContainers: []apiv1.Container{{Resources: apiv1.ResourceRequirements{
+			Requests: apiv1.ResourceList{apiv1.ResourceCPU: resource.MustParse("2")},
+		}}},
+		Containers: []apiv1.Container{{Resources: apiv1.ResourceRequirements{
+			Requests: apiv1.ResourceList{apiv1.ResourceCPU: resource.MustParse("500m"), apiv1.ResourceMemory: resource.MustParse("1Gi")},
+			Limits:   apiv1.ResourceList{apiv1.ResourceMemory: resource.MustParse("2Gi")},
+		}}, {Resources: apiv1.ResourceRequirements{
+			Requests: apiv1.ResourceList{apiv1.ResourceMemory: resource.MustParse("1Gi")},
+		}}},
+	})
+	cpu := usage[apiv1.ResourceRequestsCPU]
+	memory := usage[apiv1.ResourceRequestsMemory]
+	memoryLimit := usage[apiv1.ResourceLimitsMemory]
+	assert.Equal(t, "2", cpu.String())
+	assert.Equal(t, "2Gi", memory.String())
+	assert.Equal(t, "2Gi", memoryLimit.String())
+}
+
+func TestAddResources(t *testing.T) {
+	resources := apiv1.ResourceList{apiv1.ResourceRequestsCPU: resource.MustParse("500m"), apiv1.ResourceRequestsMemory: resource.MustParse("1Gi")}
+	list := apiv1.ResourceList{}
+	addResources(list, resources, 1000)
+	cpu := list[apiv1.ResourceRequestsCPU]
+	memory := list[apiv1.ResourceRequestsMemory]
+	assert.Equal(t, "500", cpu.String())
+	assert.Equal(t, "1000Gi", memory.String())
+
+	addResources(list, resources, -2)
+	cpu = list[apiv1.ResourceRequestsCPU]
+	assert.Equal(t, "499", cpu.String())
+	// the added resources are not modified
+	cpu = resources[apiv1.ResourceRequestsCPU]
+	assert.Equal(t, "500m", cpu.String())
+}
+
+func TestSyncResourceNamespaces(t *testing.T) {
+	syncCtx := newTestSyncCtx()
+	kubectl := mockKubectlCmd{applied: map[string]string{}, deleted: map[string]string{}}
+	syncCtx.kubectl = kubectl
+	syncCtx.proj.Spec.Destinations = []v1alpha1.ApplicationDestination{{Server: "*", Namespace: "other-namespace"}}
+	syncCtx.resources = []v1alpha1.ResourceState{{
+		TargetState: `{"kind":"pod","metadata":{"name":"my-pod"}}`,
+	}, {
+		TargetState: `{"kind":"service","metadata":{"name":"my-service","namespace":"other-namespace"}}`,
+	}, {
+		LiveState: `{"kind":"service","metadata":{"name":"old-service","namespace":"other-namespace"}}`,
+	}}
+	syncCtx.sync()
+	assert.Equal(t, map[string]string{"my-pod": "test-namespace", "my-service": "other-namespace"}, kubectl.applied)
+	assert.Equal(t, map[string]string{"old-service": "other-namespace"}, kubectl.deleted)
+	namespaces := make(map[string]string)
+	for _, res := range syncCtx.syncRes.Resources {
+		namespaces[res.Name] = res.Namespace
+	}
+	assert.Equal(t, map[string]string{"my-pod": "test-namespace", "my-service": "other-namespace", "old-service": "other-namespace"}, namespaces)
+}
+
+func TestSyncResourceNamespaceNotPermitted(t *testing.T) {
+	syncCtx := newTestSyncCtx()
+	kubectl := mockKubectlCmd{applied: map[string]string{}}
+	syncCtx.kubectl = kubectl
+	syncCtx.proj.Spec.Destinations = []v1alpha1.ApplicationDestination{{Server: "*", Namespace: "test-namespace"}}
+	syncCtx.resources = []v1alpha1.ResourceState{{
+		TargetState: `{"kind":"pod","metadata":{"name":"my-pod"}}`,
+	}, {
+		TargetState: `{"kind":"service","metadata":{"name":"my-service","namespace":"kube-system"}}`,
+	}}
+	syncCtx.sync()
+	assert.Equal(t, string(v1alpha1.OperationFailed), string(syncCtx.opState.Phase))
+	assert.Len(t, syncCtx.syncRes.Resources, 1)
+	assert.Equal(t, "namespace kube-system of service/my-service is not permitted in project test", syncCtx.syncRes.Resources[0].Message)
+	// only the dry run of the permitted resource was performed
+	assert.Equal(t, map[string]string{"my-pod": "test-namespace"}, kubectl.applied)
+}
+
+func TestSyncWindowsPermit(t *testing.T) {
+	// 2019-03-04 22:30 UTC is a monday
+	now := time.Date(2019, 3, 4, 22, 30, 0, 0, time.UTC)
+	proj := &v1alpha1.AppProject{
+		ObjectMeta: v1.ObjectMeta{Name: "test", Namespace: "argocd"},
+		Spec: v1alpha1.AppProjectSpec{
+			SyncWindows: []v1alpha1.SyncWindow{{
+				Kind:         v1alpha1.SyncWindowKindDeny,
+				Schedule:     "0 22 * * 1-5",
+				Duration:     "1h",
+				Applications: []string{"prod-*"},
+			}},
+		},
+	}
+	mgr := &appStateManager{appclientset: appclientset.NewSimpleClientset(proj), namespace: "argocd"}
+	newState := func(syncOp v1alpha1.SyncOperation) *v1alpha1.OperationState {
+		return &v1alpha1.OperationState{Phase: v1alpha1.OperationRunning, Operation: v1alpha1.Operation{Sync: &syncOp}}
+	}
+	newApp := func(name string) *v1alpha1.Application {
+		return &v1alpha1.Application{ObjectMeta: v1.ObjectMeta{Name: name}, Spec: v1alpha1.ApplicationSpec{Project: "test"}}
+	}
+
+	state := newState(v1alpha1.SyncOperation{})
+	assert.False(t, mgr.syncWindowsPermit(newApp("prod-app"), state, now))
+	assert.Equal(t, v1alpha1.OperationFailed, state.Phase)
+	assert.Contains(t, state.Message, "deny window '0 22 * * 1-5' (1h) of project 'test' is open")
+
+	// the window does not apply to the application
+	assert.True(t, mgr.syncWindowsPermit(newApp("staging-app"), newState(v1alpha1.SyncOperation{}), now))
+	// the window is closed
+	assert.True(t, mgr.syncWindowsPermit(newApp("prod-app"), newState(v1alpha1.SyncOperation{}), now.Add(time.Hour)))
+	// dry runs and overrides are permitted
+	assert.True(t, mgr.syncWindowsPermit(newApp("prod-app"), newState(v1alpha1.SyncOperation{DryRun: true}), now))
+	assert.True(t, mgr.syncWindowsPermit(newApp("prod-app"), newState(v1alpha1.SyncOperation{OverrideSyncWindows: true}), now))
+	// operations which already started are not interrupted
+	state = newState(v1alpha1.SyncOperation{})
+	state.SyncResult = &v1alpha1.SyncOperationResult{}
+	assert.True(t, mgr.syncWindowsPermit(newApp("prod-app"), state, now))
+
+	// outside of the allow windows of the application
+	proj.Spec.SyncWindows = []v1alpha1.SyncWindow{{Kind: v1alpha1.SyncWindowKindAllow, Schedule: "0 6 * * *", Duration: "2h"}}
+	mgr.appclientset = appclientset.NewSimpleClientset(proj)
+	state = newState(v1alpha1.SyncOperation{})
+	assert.False(t, mgr.syncWindowsPermit(newApp("staging-app"), state, now))
+	assert.Contains(t, state.Message, "no allow window of project 'test' is open")
+	assert.True(t, mgr.syncWindowsPermit(newApp("staging-app"), newState(v1alpha1.SyncOperation{}), time.Date(2019, 3, 4, 7, 59, 0, 0, time.UTC)))
+}
+
+func TestSyncApplyTimeouts(t *testing.T) {
+	syncCtx := newTestSyncCtx()
+	kubectl := mockKubectlCmd{timeouts: map[string]time.Duration{}}
+	syncCtx.kubectl = kubectl
+	syncCtx.applyTimeouts = []applyTimeout{
+		{group: "", kind: "pod", timeout: 30 * time.Second},
+		{group: "*", kind: "*", timeout: time.Minute},
+	}
+	syncCtx.resources = []v1alpha1.ResourceState{{
+		TargetState: `{"kind":"pod","metadata":{"name":"my-pod"}}`,
+	}, {
+		TargetState: `{"kind":"deployment","metadata":{"name":"my-deployment"}}`,
+	}, {
+		LiveState: `{"kind":"service","metadata":{"name":"old-service"}}`,
+	}}
+	syncCtx.sync()
+	assert.Equal(t, map[string]time.Duration{"my-pod": 30 * time.Second, "my-deployment": time.Minute, "old-service": time.Minute}, kubectl.timeouts)
+	// the config of the sync is left untouched
+	assert.Equal(t, time.Duration(0), syncCtx.config.Timeout)
+}
+
+func TestSyncRequestTimeout(t *testing.T) {
+	syncCtx := newTestSyncCtx()
+	kubectl := mockKubectlCmd{timeouts: map[string]time.Duration{}}
+	syncCtx.kubectl = kubectl
+	syncCtx.applyTimeouts = []applyTimeout{{group: "", kind: "pod", timeout: 30 * time.Second}}
+	syncCtx.requestTimeout = 10 * time.Second
+	syncCtx.resources = []v1alpha1.ResourceState{{
+		TargetState: `{"kind":"pod","metadata":{"name":"my-pod"}}`,
+	}, {
+		TargetState: `{"kind":"deployment","metadata":{"name":"my-deployment"}}`,
+	}}
+	syncCtx.sync()
+	// the request timeout of the cluster applies to the kinds without an apply timeout
+	assert.Equal(t, map[string]time.Duration{"my-pod": 30 * time.Second, "my-deployment": 10 * time.Second}, kubectl.timeouts)
+}
+
+func TestRepoServerUnavailable(t *testing.T) {
+	unavailable := v1alpha1.ApplicationCondition{Type: v1alpha1.ApplicationConditionRepoServerUnavailableError, Message: "connection refused"}
+	invalid := v1alpha1.ApplicationCondition{Type: v1alpha1.ApplicationConditionInvalidSpecError, Message: "invalid"}
+	assert.True(t, repoServerUnavailable([]v1alpha1.ApplicationCondition{unavailable}))
+	assert.False(t, repoServerUnavailable([]v1alpha1.ApplicationCondition{unavailable, invalid}))
+
+	message := fmt.Sprintf("%s: %s", waitingForRepoServerMessage, "connection refused")
+	assert.True(t, isWaitingForRepoServer(v1alpha1.OperationRunning, message))
+	assert.False(t, isWaitingForRepoServer(v1alpha1.OperationError, message))
+	assert.False(t, isWaitingForRepoServer(v1alpha1.OperationRunning, "one or more tasks are running"))
+}
+
+func TestSyncGeneratedName(t *testing.T) {
+	syncCtx := newTestSyncCtx(&v1.APIResourceList{
+		GroupVersion: "v1",
+		APIResources: []v1.APIResource{{Name: "pods", Namespaced: true, Kind: "Pod"}},
+	}, &v1.APIResourceList{
+		GroupVersion: "batch/v1",
+		APIResources: []v1.APIResource{{Name: "jobs", Namespaced: true, Kind: "Job", Group: "batch"}},
+	})
+	applied := make(map[string]string)
+	syncCtx.kubectl = mockKubectlCmd{applied: applied}
+	syncCtx.appName = "my-app"
+	dynamicIf := fakedynamic.NewSimpleDynamicClient(runtime.NewScheme())
+	var created []*unstructured.Unstructured
+	dynamicIf.PrependReactor("create", "jobs", func(action testcore.Action) (bool, runtime.Object, error) {
+		obj := action.(testcore.CreateAction).GetObject().(*unstructured.Unstructured).DeepCopy()
+		obj.SetName(obj.GetGenerateName() + "x7k2p")
+		created = append(created, obj)
+		return true, obj, nil
+	})
+	syncCtx.dynamicIf = dynamicIf
+	job := `{"apiVersion":"batch/v1","kind":"Job","metadata":{"generateName":"migrate-"}}`
+	syncCtx.manifestInfo = &repository.ManifestResponse{Manifests: []string{testPod, job}}
+	syncCtx.resources = []v1alpha1.ResourceState{{TargetState: testPod}}
+
+	syncCtx.sync()
+	// the next sync of the operation completes it, without creating the job again
+	syncCtx.sync()
+	assert.Equal(t, v1alpha1.OperationSucceeded, syncCtx.opState.Phase)
+	// the job is created rather than applied
+	assert.Len(t, applied, 1)
+	assert.Contains(t, applied, "foo")
+	if assert.Len(t, created, 1) {
+		assert.Equal(t, "test-namespace", created[0].GetNamespace())
+		assert.Equal(t, "my-app", created[0].GetLabels()[common.LabelApplicationName])
+	}
+	assert.Len(t, syncCtx.syncRes.Resources, 2)
+	for _, res := range syncCtx.syncRes.Resources {
+		if res.Kind == "Job" {
+			assert.Equal(t, "migrate-x7k2p", res.Name)
+			assert.Equal(t, "migrate-", res.GenerateName)
+			assert.Equal(t, "job/migrate-x7k2p created", res.Message)
+			assert.Equal(t, v1alpha1.ResourceDetailsSynced, res.Status)
+		}
+	}
+
+	// selective syncs do not create the objects with generated names
+	syncCtx.syncRes.Resources = nil
+	syncCtx.syncResources = []v1alpha1.SyncOperationResource{{Kind: "Pod", Name: "foo"}}
+	syncCtx.sync()
+	assert.Len(t, created, 1)
+	assert.Len(t, syncCtx.syncRes.Resources, 1)
+}
+
+func TestGeneratedNameResultsOfRetries(t *testing.T) {
+	job, err := v1alpha1.UnmarshalToUnstructured(`{"apiVersion":"batch/v1","kind":"Job","metadata":{"generateName":"migrate-"}}`)
+	assert.NoError(t, err)
+	assert.True(t, hasGeneratedName(job))
+	assert.True(t, isResultOf(&v1alpha1.ResourceDetails{Kind: "Job", Name: "migrate-x7k2p", GenerateName: "migrate-"}, job))
+	assert.False(t, isResultOf(&v1alpha1.ResourceDetails{Kind: "Job", Name: "migrate-x7k2p"}, job))
+
+	syncCtx := newTestSyncCtx()
+	syncCtx.syncRes.FailedResources = []*v1alpha1.ResourceDetails{{Kind: "Job", GenerateName: "migrate-", Status: v1alpha1.ResourceDetailsSyncFailed}}
+	assert.True(t, syncCtx.isRetriedResource(nil, job))
+	job.SetGenerateName("seed-")
+	assert.False(t, syncCtx.isRetriedResource(nil, job))
+}
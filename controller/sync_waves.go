@@ -0,0 +1,145 @@
+package controller
+
+import (
+	"fmt"
+	"strconv"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/argoproj/argo-cd/common"
+	appv1 "github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/util/health"
+)
+
+// syncWave returns the sync wave of a resource, as specified by the sync-wave annotation.
+// Resources without the annotation belong to wave 0.
+func syncWave(obj *unstructured.Unstructured) (int, error) {
+	if obj == nil {
+		return 0, nil
+	}
+	annotations := obj.GetAnnotations()
+	if annotations == nil || annotations[common.AnnotationSyncWave] == "" {
+		return 0, nil
+	}
+	wave, err := strconv.Atoi(annotations[common.AnnotationSyncWave])
+	if err != nil {
+		return 0, fmt.Errorf("invalid %s annotation of %s '%s': %v", common.AnnotationSyncWave, obj.GetKind(), obj.GetName(), err)
+	}
+	return wave, nil
+}
+
+// groupSyncWaves splits the sync tasks, which are expected to be sorted by wave, into waves
+func groupSyncWaves(syncTasks []syncTask) [][]syncTask {
+	var waves [][]syncTask
+	for i, task := range syncTasks {
+		if i == 0 || syncTasks[i-1].wave != task.wave {
+			waves = append(waves, nil)
+		}
+		waves[len(waves)-1] = append(waves[len(waves)-1], task)
+	}
+	return waves
+}
+
+// isTaskSynced returns whether the sync task was already applied or pruned during this operation
+func (sc *syncContext) isTaskSynced(task syncTask) bool {
+	obj := task.targetObj
+	if obj == nil {
+		obj = task.liveObj
+	}
+	for _, res := range sc.syncRes.Resources {
+		if isResultOf(res, obj) {
+			return true
+		}
+	}
+	return false
+}
+
+// hasPendingWaves returns whether any of the sync tasks have yet to be applied
+func (sc *syncContext) hasPendingWaves(syncTasks []syncTask) bool {
+	for _, task := range syncTasks {
+		if !sc.isTaskSynced(task) {
+			return true
+		}
+	}
+	return false
+}
+
+// pendingTasks returns the sync tasks which have yet to be applied
+func (sc *syncContext) pendingTasks(syncTasks []syncTask) []syncTask {
+	var pending []syncTask
+	for _, task := range syncTasks {
+		if !sc.isTaskSynced(task) {
+			pending = append(pending, task)
+		}
+	}
+	return pending
+}
+
+// isWaveHealthy returns whether all applied resources of the sync tasks are healthy
+func (sc *syncContext) isWaveHealthy(syncTasks []syncTask) (bool, error) {
+	for _, task := range syncTasks {
+		// the objects created with generated names are not tracked
+		if task.targetObj == nil || isHook(task.targetObj) || hasGeneratedName(task.targetObj) {
+			continue
+		}
+		if task.liveObj == nil {
+			return false, nil
+		}
+		healthState, err := health.GetAppHealth(sc.kubectl, task.liveObj)
+		if err != nil {
+			return false, err
+		}
+		if healthState.Status != appv1.HealthStatusHealthy {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+// applyNextWave applies the first wave of sync tasks which has yet to be applied, provided that
+// all resources of the preceding waves are healthy. Returns false if the wave failed to apply.
+func (sc *syncContext) applyNextWave(syncTasks []syncTask, force bool) bool {
+	waves := groupSyncWaves(syncTasks)
+	for i, wave := range waves {
+		if !sc.hasPendingWaves(wave) {
+			continue
+		}
+		if i > 0 {
+			var applied []syncTask
+			for _, prev := range waves[:i] {
+				applied = append(applied, prev...)
+			}
+			healthy, err := sc.isWaveHealthy(applied)
+			if err != nil {
+				sc.setOperationPhase(appv1.OperationError, fmt.Sprintf("failed to check health of sync wave %d: %v", waves[i-1][0].wave, err))
+				return false
+			}
+			if !healthy {
+				message, err := sc.expiredProgressingDeadline(applied)
+				if err != nil {
+					sc.setOperationPhase(appv1.OperationError, fmt.Sprintf("failed to check health of sync wave %d: %v", waves[i-1][0].wave, err))
+					return false
+				}
+				if message != "" {
+					sc.setOperationPhase(appv1.OperationFailed, fmt.Sprintf("sync wave %d did not become %s: %s", waves[i-1][0].wave, appv1.HealthStatusHealthy, message))
+					return false
+				}
+				sc.setOperationPhase(appv1.OperationRunning, fmt.Sprintf("waiting for sync wave %d to become %s", waves[i-1][0].wave, appv1.HealthStatusHealthy))
+				return true
+			}
+			// the objects pruned by the preceding waves are deleted before the next wave is applied
+			if !sc.checkDeletions(applied) {
+				return sc.opState.Phase != appv1.OperationFailed
+			}
+		}
+		if len(waves) > 1 {
+			sc.setOperationPhase(appv1.OperationRunning, fmt.Sprintf("applying sync wave %d", wave[0].wave))
+		}
+		if !sc.doApplySync(wave, false, force, true) {
+			sc.setOperationPhase(appv1.OperationFailed, "one or more objects failed to apply")
+			return false
+		}
+		return true
+	}
+	return true
+}
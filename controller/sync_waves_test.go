@@ -0,0 +1,140 @@
+package controller
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1"
+)
+
+func TestSyncWave(t *testing.T) {
+	obj, err := v1alpha1.UnmarshalToUnstructured(`{"kind":"pod","metadata":{"name":"foo","annotations":{"argocd.argoproj.io/sync-wave":"-1"}}}`)
+	assert.NoError(t, err)
+	wave, err := syncWave(obj)
+	assert.NoError(t, err)
+	assert.Equal(t, -1, wave)
+
+	obj, err = v1alpha1.UnmarshalToUnstructured(`{"kind":"pod","metadata":{"name":"foo"}}`)
+	assert.NoError(t, err)
+	wave, err = syncWave(obj)
+	assert.NoError(t, err)
+	assert.Equal(t, 0, wave)
+
+	obj, err = v1alpha1.UnmarshalToUnstructured(`{"kind":"pod","metadata":{"name":"foo","annotations":{"argocd.argoproj.io/sync-wave":"first"}}}`)
+	assert.NoError(t, err)
+	_, err = syncWave(obj)
+	assert.Error(t, err)
+}
+
+func TestSyncInWaves(t *testing.T) {
+	syncCtx := newTestSyncCtx()
+	syncCtx.kubectl = mockKubectlCmd{}
+	operator := `{"kind":"pod","metadata":{"name":"operator"}}`
+	syncCtx.resources = []v1alpha1.ResourceState{{
+		LiveState:   "",
+		TargetState: `{"kind":"service","metadata":{"name":"custom-resource","annotations":{"argocd.argoproj.io/sync-wave":"1"}}}`,
+	}, {
+		LiveState:   "",
+		TargetState: operator,
+	}}
+
+	// first wave is applied
+	syncCtx.sync()
+	assert.Len(t, syncCtx.syncRes.Resources, 1)
+	assert.Equal(t, "operator", syncCtx.syncRes.Resources[0].Name)
+
+	// second wave waits until the resources of the first wave exist and are healthy
+	syncCtx.sync()
+	assert.Len(t, syncCtx.syncRes.Resources, 1)
+	assert.Equal(t, v1alpha1.OperationRunning, syncCtx.opState.Phase)
+	assert.Contains(t, syncCtx.opState.Message, "waiting for sync wave 0")
+
+	syncCtx.resources[1].LiveState = operator
+	syncCtx.sync()
+	assert.Len(t, syncCtx.syncRes.Resources, 2)
+	assert.Equal(t, "custom-resource", syncCtx.syncRes.Resources[1].Name)
+
+	syncCtx.sync()
+	assert.Equal(t, v1alpha1.OperationSucceeded, syncCtx.opState.Phase)
+}
+
+func TestSyncInvalidWave(t *testing.T) {
+	syncCtx := newTestSyncCtx()
+	syncCtx.kubectl = mockKubectlCmd{}
+	syncCtx.resources = []v1alpha1.ResourceState{{
+		LiveState:   "",
+		TargetState: `{"kind":"pod","metadata":{"name":"foo","annotations":{"argocd.argoproj.io/sync-wave":"first"}}}`,
+	}}
+	syncCtx.sync()
+	assert.Equal(t, v1alpha1.OperationError, syncCtx.opState.Phase)
+	assert.Len(t, syncCtx.syncRes.Resources, 0)
+}
+
+func TestProgressiveBatches(t *testing.T) {
+	tasks := []syncTask{{wave: 0}, {wave: 0}, {wave: 0}, {wave: 1}}
+	batches := progressiveBatches(tasks, 2)
+	assert.Len(t, batches, 3)
+	assert.Len(t, batches[0], 2)
+	assert.Len(t, batches[1], 1)
+	assert.Len(t, batches[2], 1)
+	assert.Equal(t, 1, batches[2][0].wave)
+}
+
+// progressiveAPIResources are the API resources of the progressive sync tests
+var progressiveAPIResources = []*v1.APIResourceList{{
+	GroupVersion: "v1",
+	APIResources: []v1.APIResource{{Kind: "Service", Namespaced: true}},
+}, {
+	GroupVersion: "apps/v1",
+	APIResources: []v1.APIResource{{Kind: "Deployment", Namespaced: true}},
+}}
+
+func TestSyncProgressive(t *testing.T) {
+	syncCtx := newTestSyncCtx(progressiveAPIResources...)
+	syncCtx.kubectl = mockKubectlCmd{}
+	syncCtx.syncOp.SyncStrategy = &v1alpha1.SyncStrategy{Progressive: &v1alpha1.SyncStrategyProgressive{BatchSize: 1}}
+	first := `{"apiVersion":"v1","kind":"Service","metadata":{"name":"first"}}`
+	second := `{"apiVersion":"v1","kind":"Service","metadata":{"name":"second"}}`
+	syncCtx.resources = []v1alpha1.ResourceState{{TargetState: first}, {TargetState: second}}
+
+	syncCtx.sync()
+	assert.Len(t, syncCtx.syncRes.Resources, 1)
+	assert.Equal(t, "applying batch 1 of 2", syncCtx.opState.Message)
+
+	// the next batch waits until the resources of the first batch exist and are healthy
+	syncCtx.sync()
+	assert.Len(t, syncCtx.syncRes.Resources, 1)
+	assert.Equal(t, string(v1alpha1.OperationRunning), string(syncCtx.opState.Phase))
+	assert.Contains(t, syncCtx.opState.Message, "waiting for batch 1 of 2")
+
+	syncCtx.resources[0].LiveState = first
+	syncCtx.sync()
+	assert.Len(t, syncCtx.syncRes.Resources, 2)
+	assert.Equal(t, "applying batch 2 of 2", syncCtx.opState.Message)
+
+	syncCtx.resources[1].LiveState = second
+	syncCtx.sync()
+	assert.Equal(t, string(v1alpha1.OperationSucceeded), string(syncCtx.opState.Phase))
+}
+
+func TestSyncProgressiveAbortsOnDegradedBatch(t *testing.T) {
+	syncCtx := newTestSyncCtx(progressiveAPIResources...)
+	syncCtx.kubectl = mockKubectlCmd{}
+	syncCtx.syncOp.SyncStrategy = &v1alpha1.SyncStrategy{Progressive: &v1alpha1.SyncStrategyProgressive{}}
+	syncCtx.resources = []v1alpha1.ResourceState{{
+		TargetState: `{"apiVersion":"apps/v1","kind":"Deployment","metadata":{"name":"canary"}}`,
+	}, {
+		TargetState: `{"apiVersion":"apps/v1","kind":"Deployment","metadata":{"name":"rest"}}`,
+	}}
+	syncCtx.sync()
+	assert.Len(t, syncCtx.syncRes.Resources, 1)
+
+	syncCtx.resources[0].LiveState = `{"apiVersion":"apps/v1","kind":"Deployment","metadata":{"name":"canary"},
+		"status":{"conditions":[{"type":"Progressing","status":"False","reason":"ProgressDeadlineExceeded"}]}}`
+	syncCtx.sync()
+	assert.Equal(t, string(v1alpha1.OperationFailed), string(syncCtx.opState.Phase))
+	assert.Contains(t, syncCtx.opState.Message, "batch 1 of 2 is Degraded: Deployment canary")
+	assert.Len(t, syncCtx.syncRes.Resources, 1)
+}
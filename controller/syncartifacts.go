@@ -0,0 +1,82 @@
+package controller
+
+import (
+	"context"
+	"fmt"
+
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/argoproj/argo-cd/controller/services"
+	appv1 "github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1"
+	cache_util "github.com/argoproj/argo-cd/util/cache"
+)
+
+// syncArtifactsKey returns the cache key of the manifests applied by the given deployment
+func syncArtifactsKey(appName string, id int64) string {
+	return fmt.Sprintf("sync-artifacts|%s|%d", appName, id)
+}
+
+// saveSyncArtifacts stores the rendered manifests applied by a sync and returns the reference to
+// them. Returns an empty reference if sync artifacts are disabled or could not be stored.
+func (s *appStateManager) saveSyncArtifacts(appName string, id int64, manifests []string) string {
+	if s.syncArtifacts == nil {
+		return ""
+	}
+	key := syncArtifactsKey(appName, id)
+	err := s.syncArtifacts.Set(&cache_util.Item{Key: key, Object: manifests})
+	if err != nil {
+		log.Warnf("Unable to save sync artifacts of application '%s': %v", appName, err)
+		return ""
+	}
+	return key
+}
+
+// deleteSyncArtifacts removes the stored manifests of a deployment which dropped out of history
+func (s *appStateManager) deleteSyncArtifacts(info appv1.DeploymentInfo) {
+	if s.syncArtifacts == nil || info.ManifestsRef == "" {
+		return
+	}
+	err := s.syncArtifacts.Delete(info.ManifestsRef)
+	if err != nil && err != cache_util.ErrCacheMiss {
+		log.Warnf("Unable to delete sync artifacts '%s': %v", info.ManifestsRef, err)
+	}
+}
+
+// SyncedManifests returns the rendered manifests which were applied by the given deployment
+func (ctrl *ApplicationController) SyncedManifests(ctx context.Context, q *services.SyncedManifestsQuery) (*services.SyncedManifestsResponse, error) {
+	if q.ApplicationName == nil || q.Id == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "application name and deployment id must be specified")
+	}
+	if ctrl.syncArtifacts == nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "sync artifacts are not enabled")
+	}
+	app, err := ctrl.applicationClientset.ArgoprojV1alpha1().Applications(ctrl.namespace).Get(*q.ApplicationName, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+	var deployment *appv1.DeploymentInfo
+	for i := range app.Status.History {
+		if app.Status.History[i].ID == *q.Id {
+			deployment = &app.Status.History[i]
+			break
+		}
+	}
+	if deployment == nil {
+		return nil, status.Errorf(codes.NotFound, "application %s has no deployment with id %d", app.Name, *q.Id)
+	}
+	if deployment.ManifestsRef == "" {
+		return nil, status.Errorf(codes.NotFound, "manifests of deployment %d were not stored", deployment.ID)
+	}
+	var manifests []string
+	err = ctrl.syncArtifacts.Get(deployment.ManifestsRef, &manifests)
+	if err != nil {
+		if err == cache_util.ErrCacheMiss {
+			return nil, status.Errorf(codes.NotFound, "manifests of deployment %d have expired", deployment.ID)
+		}
+		return nil, err
+	}
+	return &services.SyncedManifestsResponse{Manifests: manifests, Revision: &deployment.Revision}, nil
+}
@@ -0,0 +1,60 @@
+package controller
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/argoproj/argo-cd/controller/services"
+	cache_util "github.com/argoproj/argo-cd/util/cache"
+)
+
+func TestSyncedManifests(t *testing.T) {
+	app := newFakeApp()
+	ctrl := newFakeController(app)
+	ctrl.syncArtifacts = cache_util.NewInMemoryCache(time.Hour)
+	ctrl.appStateManager.(*appStateManager).syncArtifacts = ctrl.syncArtifacts
+
+	manifests := []string{
+		`{"apiVersion":"v1","kind":"ConfigMap","metadata":{"name":"my-config"}}`,
+		`{"apiVersion":"v1","kind":"Secret","metadata":{"name":"my-secret"},"data":{"password":"cGFzc3dvcmQ="}}`,
+	}
+	err := ctrl.appStateManager.(*appStateManager).persistDeploymentInfo(app, "abc123", nil, manifests, nil)
+	assert.NoError(t, err)
+
+	app, err = ctrl.applicationClientset.ArgoprojV1alpha1().Applications("argocd").Get("my-app", metav1.GetOptions{})
+	assert.NoError(t, err)
+	assert.Len(t, app.Status.History, 1)
+	assert.Equal(t, syncArtifactsKey("my-app", 0), app.Status.History[0].ManifestsRef)
+
+	id := app.Status.History[0].ID
+	res, err := ctrl.SyncedManifests(context.Background(), &services.SyncedManifestsQuery{ApplicationName: &app.Name, Id: &id})
+	assert.NoError(t, err)
+	assert.Equal(t, "abc123", res.GetRevision())
+	assert.Len(t, res.Manifests, 2)
+	// manifests are redacted by the API server
+	assert.Equal(t, manifests, res.Manifests)
+
+	missingID := id + 1
+	_, err = ctrl.SyncedManifests(context.Background(), &services.SyncedManifestsQuery{ApplicationName: &app.Name, Id: &missingID})
+	assert.Error(t, err)
+}
+
+func TestSyncedManifestsDisabled(t *testing.T) {
+	app := newFakeApp()
+	ctrl := newFakeController(app)
+
+	err := ctrl.appStateManager.(*appStateManager).persistDeploymentInfo(app, "abc123", nil, []string{"{}"}, nil)
+	assert.NoError(t, err)
+	app, err = ctrl.applicationClientset.ArgoprojV1alpha1().Applications("argocd").Get("my-app", metav1.GetOptions{})
+	assert.NoError(t, err)
+	assert.Len(t, app.Status.History, 1)
+	assert.Empty(t, app.Status.History[0].ManifestsRef)
+
+	var id int64
+	_, err = ctrl.SyncedManifests(context.Background(), &services.SyncedManifestsQuery{ApplicationName: &app.Name, Id: &id})
+	assert.Error(t, err)
+}
@@ -54,6 +54,7 @@ type Client interface {
 	NewConn() (*grpc.ClientConn, error)
 	HTTPClient() (*http.Client, error)
 	OIDCConfig(context.Context, *settings.Settings) (*oauth2.Config, *oidc.Provider, error)
+	Login(ctx context.Context, username, password string) error
 	NewRepoClient() (*grpc.ClientConn, repository.RepositoryServiceClient, error)
 	NewRepoClientOrDie() (*grpc.ClientConn, repository.RepositoryServiceClient)
 	NewClusterClient() (*grpc.ClientConn, cluster.ClusterServiceClient, error)
@@ -81,6 +82,8 @@ type ClientOptions struct {
 	AuthToken  string
 	ConfigPath string
 	Context    string
+	// RetryLimit is the number of times a call is retried while the server is unavailable
+	RetryLimit int
 }
 
 type client struct {
@@ -90,6 +93,7 @@ type client struct {
 	CertPEMData  []byte
 	AuthToken    string
 	RefreshToken string
+	RetryLimit   int
 }
 
 // NewClient creates a new API client from a set of config options.
@@ -157,6 +161,7 @@ func NewClient(opts *ClientOptions) (Client, error) {
 	if opts.Insecure {
 		c.Insecure = true
 	}
+	c.RetryLimit = opts.RetryLimit
 	if localCfg != nil {
 		err = c.refreshAuthToken(localCfg, ctxName, opts.ConfigPath)
 		if err != nil {
@@ -326,8 +331,14 @@ func (c *client) NewConn() (*grpc.ClientConn, error) {
 	endpointCredentials := jwtCredentials{
 		Token: c.AuthToken,
 	}
-	return grpc_util.BlockingDial(context.Background(), "tcp", c.ServerAddr, creds,
-		grpc.WithPerRPCCredentials(endpointCredentials), grpc.WithDefaultCallOptions(grpc.MaxCallRecvMsgSize(MaxGRPCMessageSize)))
+	dialOpts := []grpc.DialOption{
+		grpc.WithPerRPCCredentials(endpointCredentials),
+		grpc.WithDefaultCallOptions(grpc.MaxCallRecvMsgSize(MaxGRPCMessageSize)),
+	}
+	if c.RetryLimit > 0 {
+		dialOpts = append(dialOpts, grpc.WithUnaryInterceptor(retryUnaryClientInterceptor(c.RetryLimit)))
+	}
+	return grpc_util.BlockingDial(context.Background(), "tcp", c.ServerAddr, creds, dialOpts...)
 }
 
 func (c *client) tlsConfig() (*tls.Config, error) {
@@ -351,6 +362,7 @@ func (c *client) ClientOptions() ClientOptions {
 		PlainText:  c.PlainText,
 		Insecure:   c.Insecure,
 		AuthToken:  c.AuthToken,
+		RetryLimit: c.RetryLimit,
 	}
 }
 
@@ -0,0 +1,39 @@
+package apiclient
+
+import (
+	"time"
+
+	"github.com/grpc-ecosystem/go-grpc-middleware/retry"
+	"github.com/grpc-ecosystem/go-grpc-middleware/util/backoffutils"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+)
+
+const (
+	// retryBackoffDuration is the delay before the first retry of a failed call
+	retryBackoffDuration = 100 * time.Millisecond
+	// retryBackoffMaxDuration is the maximum delay between retries of a failed call
+	retryBackoffMaxDuration = 5 * time.Second
+)
+
+// retryBackoff doubles the delay between retries of a failed call, up to the maximum delay
+func retryBackoff(attempt uint) time.Duration {
+	backoff := retryBackoffMaxDuration
+	if attempt > 0 && attempt < 32 {
+		if d := retryBackoffDuration << (attempt - 1); d < retryBackoffMaxDuration {
+			backoff = d
+		}
+	}
+	return backoffutils.JitterUp(backoff, 0.1)
+}
+
+// retryUnaryClientInterceptor returns an interceptor which retries calls failing since the server
+// is unavailable, up to the given number of times
+func retryUnaryClientInterceptor(limit int) grpc.UnaryClientInterceptor {
+	return grpc_retry.UnaryClientInterceptor(
+		// the maximum includes the first attempt
+		grpc_retry.WithMax(uint(limit)+1),
+		grpc_retry.WithBackoff(retryBackoff),
+		grpc_retry.WithCodes(codes.Unavailable),
+	)
+}
@@ -0,0 +1,48 @@
+package apiclient
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestRetryBackoff(t *testing.T) {
+	assert.InDelta(t, float64(retryBackoffDuration), float64(retryBackoff(1)), float64(retryBackoffDuration)/10)
+	assert.InDelta(t, float64(2*retryBackoffDuration), float64(retryBackoff(2)), float64(retryBackoffDuration)/5)
+	assert.InDelta(t, float64(retryBackoffMaxDuration), float64(retryBackoff(100)), float64(retryBackoffMaxDuration)/10)
+}
+
+// invokeFailing invokes the interceptor with a call which fails the given number of times
+func invokeFailing(limit int, failures int, code codes.Code) (int, error) {
+	calls := 0
+	err := retryUnaryClientInterceptor(limit)(context.Background(), "/test", nil, nil, nil, func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, opts ...grpc.CallOption) error {
+		calls++
+		if calls <= failures {
+			return status.Error(code, "failed")
+		}
+		return nil
+	})
+	return calls, err
+}
+
+func TestRetryUnaryClientInterceptor(t *testing.T) {
+	start := time.Now()
+	calls, err := invokeFailing(2, 2, codes.Unavailable)
+	assert.NoError(t, err)
+	assert.Equal(t, 3, calls)
+	assert.True(t, time.Since(start) >= 250*time.Millisecond)
+
+	calls, err = invokeFailing(1, 2, codes.Unavailable)
+	assert.Equal(t, codes.Unavailable, status.Code(err))
+	assert.Equal(t, 2, calls)
+
+	// only calls failing since the server is unavailable are retried
+	calls, err = invokeFailing(2, 2, codes.InvalidArgument)
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	assert.Equal(t, 1, calls)
+}
@@ -0,0 +1,28 @@
+package apiclient
+
+import (
+	"context"
+
+	"github.com/argoproj/argo-cd/server/session"
+)
+
+// Login creates a session with the given credentials, and authenticates the connections created
+// by the client afterwards with the token of the session
+func (c *client) Login(ctx context.Context, username, password string) error {
+	conn, sessionIf, err := c.NewSessionClient()
+	if err != nil {
+		return err
+	}
+	defer func() { _ = conn.Close() }()
+	createdSession, err := sessionIf.Create(ctx, &session.SessionCreateRequest{
+		Username: username,
+		Password: password,
+	})
+	if err != nil {
+		return err
+	}
+	c.AuthToken = createdSession.Token
+	// the session is not refreshed with a refresh token of a previous login
+	c.RefreshToken = ""
+	return nil
+}
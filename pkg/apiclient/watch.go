@@ -0,0 +1,86 @@
+package apiclient
+
+import (
+	"context"
+	"io"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	argoappv1 "github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/server/application"
+)
+
+// watchRetryInterval is the delay before an interrupted watch is restarted
+const watchRetryInterval = 1 * time.Second
+
+func isCanceledContextErr(err error) bool {
+	if err == context.Canceled {
+		return true
+	}
+	if stat, ok := status.FromError(err); ok {
+		if stat.Code() == codes.Canceled {
+			return true
+		}
+	}
+	return false
+}
+
+// WatchApplication returns a channel of watch events for an app, retrying the watch upon errors.
+// Closes the returned channel when the context is discovered to be canceled.
+func WatchApplication(ctx context.Context, appIf application.ApplicationServiceClient, appName string) chan *argoappv1.ApplicationWatchEvent {
+	appEventsCh := make(chan *argoappv1.ApplicationWatchEvent)
+	go func() {
+		defer close(appEventsCh)
+		for {
+			wc, err := appIf.Watch(ctx, &application.ApplicationQuery{
+				Name: &appName,
+			})
+			if err != nil {
+				if isCanceledContextErr(err) {
+					return
+				}
+				if err != io.EOF {
+					log.Warnf("watch err: %v", err)
+				}
+				time.Sleep(watchRetryInterval)
+				continue
+			}
+			for {
+				appEvent, err := wc.Recv()
+				if err != nil {
+					if isCanceledContextErr(err) {
+						return
+					}
+					if err != io.EOF {
+						log.Warnf("recv err: %v", err)
+					}
+					time.Sleep(watchRetryInterval)
+					break
+				}
+				select {
+				case appEventsCh <- appEvent:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}
+
+	}()
+	return appEventsCh
+}
+
+// WaitForApplication watches an app until the condition is met, and returns the app which met it.
+// Fails if the context is canceled before the condition is met.
+func WaitForApplication(ctx context.Context, appIf application.ApplicationServiceClient, appName string, condition func(app *argoappv1.Application) bool) (*argoappv1.Application, error) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	for appEvent := range WatchApplication(ctx, appIf, appName) {
+		if condition(&appEvent.Application) {
+			return &appEvent.Application, nil
+		}
+	}
+	return nil, ctx.Err()
+}
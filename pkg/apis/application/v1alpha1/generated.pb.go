@@ -13,6 +13,7 @@ import k8s_io_apimachinery_pkg_watch "k8s.io/apimachinery/pkg/watch"
 
 import strings "strings"
 import reflect "reflect"
+import github_com_gogo_protobuf_sortkeys "github.com/gogo/protobuf/sortkeys"
 
 import io "io"
 
@@ -30,7 +31,7 @@ const _ = proto.GoGoProtoPackageIsVersion2 // please upgrade the proto package
 func (m *AWSAuthConfig) Reset()      { *m = AWSAuthConfig{} }
 func (*AWSAuthConfig) ProtoMessage() {}
 func (*AWSAuthConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_generated_cc6f080d95e71262, []int{0}
+	return fileDescriptor_generated_65b6213fe49d8a81, []int{0}
 }
 func (m *AWSAuthConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -58,7 +59,7 @@ var xxx_messageInfo_AWSAuthConfig proto.InternalMessageInfo
 func (m *AppProject) Reset()      { *m = AppProject{} }
 func (*AppProject) ProtoMessage() {}
 func (*AppProject) Descriptor() ([]byte, []int) {
-	return fileDescriptor_generated_cc6f080d95e71262, []int{1}
+	return fileDescriptor_generated_65b6213fe49d8a81, []int{1}
 }
 func (m *AppProject) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -86,7 +87,7 @@ var xxx_messageInfo_AppProject proto.InternalMessageInfo
 func (m *AppProjectList) Reset()      { *m = AppProjectList{} }
 func (*AppProjectList) ProtoMessage() {}
 func (*AppProjectList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_generated_cc6f080d95e71262, []int{2}
+	return fileDescriptor_generated_65b6213fe49d8a81, []int{2}
 }
 func (m *AppProjectList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -114,7 +115,7 @@ var xxx_messageInfo_AppProjectList proto.InternalMessageInfo
 func (m *AppProjectSpec) Reset()      { *m = AppProjectSpec{} }
 func (*AppProjectSpec) ProtoMessage() {}
 func (*AppProjectSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_generated_cc6f080d95e71262, []int{3}
+	return fileDescriptor_generated_65b6213fe49d8a81, []int{3}
 }
 func (m *AppProjectSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -142,7 +143,7 @@ var xxx_messageInfo_AppProjectSpec proto.InternalMessageInfo
 func (m *Application) Reset()      { *m = Application{} }
 func (*Application) ProtoMessage() {}
 func (*Application) Descriptor() ([]byte, []int) {
-	return fileDescriptor_generated_cc6f080d95e71262, []int{4}
+	return fileDescriptor_generated_65b6213fe49d8a81, []int{4}
 }
 func (m *Application) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -170,7 +171,7 @@ var xxx_messageInfo_Application proto.InternalMessageInfo
 func (m *ApplicationCondition) Reset()      { *m = ApplicationCondition{} }
 func (*ApplicationCondition) ProtoMessage() {}
 func (*ApplicationCondition) Descriptor() ([]byte, []int) {
-	return fileDescriptor_generated_cc6f080d95e71262, []int{5}
+	return fileDescriptor_generated_65b6213fe49d8a81, []int{5}
 }
 func (m *ApplicationCondition) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -198,7 +199,7 @@ var xxx_messageInfo_ApplicationCondition proto.InternalMessageInfo
 func (m *ApplicationDestination) Reset()      { *m = ApplicationDestination{} }
 func (*ApplicationDestination) ProtoMessage() {}
 func (*ApplicationDestination) Descriptor() ([]byte, []int) {
-	return fileDescriptor_generated_cc6f080d95e71262, []int{6}
+	return fileDescriptor_generated_65b6213fe49d8a81, []int{6}
 }
 func (m *ApplicationDestination) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -226,7 +227,7 @@ var xxx_messageInfo_ApplicationDestination proto.InternalMessageInfo
 func (m *ApplicationList) Reset()      { *m = ApplicationList{} }
 func (*ApplicationList) ProtoMessage() {}
 func (*ApplicationList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_generated_cc6f080d95e71262, []int{7}
+	return fileDescriptor_generated_65b6213fe49d8a81, []int{7}
 }
 func (m *ApplicationList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -254,7 +255,7 @@ var xxx_messageInfo_ApplicationList proto.InternalMessageInfo
 func (m *ApplicationSource) Reset()      { *m = ApplicationSource{} }
 func (*ApplicationSource) ProtoMessage() {}
 func (*ApplicationSource) Descriptor() ([]byte, []int) {
-	return fileDescriptor_generated_cc6f080d95e71262, []int{8}
+	return fileDescriptor_generated_65b6213fe49d8a81, []int{8}
 }
 func (m *ApplicationSource) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -282,7 +283,7 @@ var xxx_messageInfo_ApplicationSource proto.InternalMessageInfo
 func (m *ApplicationSourceHelm) Reset()      { *m = ApplicationSourceHelm{} }
 func (*ApplicationSourceHelm) ProtoMessage() {}
 func (*ApplicationSourceHelm) Descriptor() ([]byte, []int) {
-	return fileDescriptor_generated_cc6f080d95e71262, []int{9}
+	return fileDescriptor_generated_65b6213fe49d8a81, []int{9}
 }
 func (m *ApplicationSourceHelm) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -310,7 +311,7 @@ var xxx_messageInfo_ApplicationSourceHelm proto.InternalMessageInfo
 func (m *ApplicationSourceKsonnet) Reset()      { *m = ApplicationSourceKsonnet{} }
 func (*ApplicationSourceKsonnet) ProtoMessage() {}
 func (*ApplicationSourceKsonnet) Descriptor() ([]byte, []int) {
-	return fileDescriptor_generated_cc6f080d95e71262, []int{10}
+	return fileDescriptor_generated_65b6213fe49d8a81, []int{10}
 }
 func (m *ApplicationSourceKsonnet) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -338,7 +339,7 @@ var xxx_messageInfo_ApplicationSourceKsonnet proto.InternalMessageInfo
 func (m *ApplicationSourceKustomize) Reset()      { *m = ApplicationSourceKustomize{} }
 func (*ApplicationSourceKustomize) ProtoMessage() {}
 func (*ApplicationSourceKustomize) Descriptor() ([]byte, []int) {
-	return fileDescriptor_generated_cc6f080d95e71262, []int{11}
+	return fileDescriptor_generated_65b6213fe49d8a81, []int{11}
 }
 func (m *ApplicationSourceKustomize) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -366,7 +367,7 @@ var xxx_messageInfo_ApplicationSourceKustomize proto.InternalMessageInfo
 func (m *ApplicationSpec) Reset()      { *m = ApplicationSpec{} }
 func (*ApplicationSpec) ProtoMessage() {}
 func (*ApplicationSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_generated_cc6f080d95e71262, []int{12}
+	return fileDescriptor_generated_65b6213fe49d8a81, []int{12}
 }
 func (m *ApplicationSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -394,7 +395,7 @@ var xxx_messageInfo_ApplicationSpec proto.InternalMessageInfo
 func (m *ApplicationStatus) Reset()      { *m = ApplicationStatus{} }
 func (*ApplicationStatus) ProtoMessage() {}
 func (*ApplicationStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_generated_cc6f080d95e71262, []int{13}
+	return fileDescriptor_generated_65b6213fe49d8a81, []int{13}
 }
 func (m *ApplicationStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -419,10 +420,38 @@ func (m *ApplicationStatus) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ApplicationStatus proto.InternalMessageInfo
 
+func (m *ApplicationTree) Reset()      { *m = ApplicationTree{} }
+func (*ApplicationTree) ProtoMessage() {}
+func (*ApplicationTree) Descriptor() ([]byte, []int) {
+	return fileDescriptor_generated_65b6213fe49d8a81, []int{14}
+}
+func (m *ApplicationTree) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ApplicationTree) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalTo(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (dst *ApplicationTree) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ApplicationTree.Merge(dst, src)
+}
+func (m *ApplicationTree) XXX_Size() int {
+	return m.Size()
+}
+func (m *ApplicationTree) XXX_DiscardUnknown() {
+	xxx_messageInfo_ApplicationTree.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ApplicationTree proto.InternalMessageInfo
+
 func (m *ApplicationWatchEvent) Reset()      { *m = ApplicationWatchEvent{} }
 func (*ApplicationWatchEvent) ProtoMessage() {}
 func (*ApplicationWatchEvent) Descriptor() ([]byte, []int) {
-	return fileDescriptor_generated_cc6f080d95e71262, []int{14}
+	return fileDescriptor_generated_65b6213fe49d8a81, []int{15}
 }
 func (m *ApplicationWatchEvent) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -447,10 +476,38 @@ func (m *ApplicationWatchEvent) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ApplicationWatchEvent proto.InternalMessageInfo
 
+func (m *Backoff) Reset()      { *m = Backoff{} }
+func (*Backoff) ProtoMessage() {}
+func (*Backoff) Descriptor() ([]byte, []int) {
+	return fileDescriptor_generated_65b6213fe49d8a81, []int{16}
+}
+func (m *Backoff) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Backoff) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalTo(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (dst *Backoff) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Backoff.Merge(dst, src)
+}
+func (m *Backoff) XXX_Size() int {
+	return m.Size()
+}
+func (m *Backoff) XXX_DiscardUnknown() {
+	xxx_messageInfo_Backoff.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Backoff proto.InternalMessageInfo
+
 func (m *Cluster) Reset()      { *m = Cluster{} }
 func (*Cluster) ProtoMessage() {}
 func (*Cluster) Descriptor() ([]byte, []int) {
-	return fileDescriptor_generated_cc6f080d95e71262, []int{15}
+	return fileDescriptor_generated_65b6213fe49d8a81, []int{17}
 }
 func (m *Cluster) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -478,7 +535,7 @@ var xxx_messageInfo_Cluster proto.InternalMessageInfo
 func (m *ClusterConfig) Reset()      { *m = ClusterConfig{} }
 func (*ClusterConfig) ProtoMessage() {}
 func (*ClusterConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_generated_cc6f080d95e71262, []int{16}
+	return fileDescriptor_generated_65b6213fe49d8a81, []int{18}
 }
 func (m *ClusterConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -506,7 +563,7 @@ var xxx_messageInfo_ClusterConfig proto.InternalMessageInfo
 func (m *ClusterList) Reset()      { *m = ClusterList{} }
 func (*ClusterList) ProtoMessage() {}
 func (*ClusterList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_generated_cc6f080d95e71262, []int{17}
+	return fileDescriptor_generated_65b6213fe49d8a81, []int{19}
 }
 func (m *ClusterList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -531,10 +588,38 @@ func (m *ClusterList) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ClusterList proto.InternalMessageInfo
 
+func (m *ClusterNetworkConfig) Reset()      { *m = ClusterNetworkConfig{} }
+func (*ClusterNetworkConfig) ProtoMessage() {}
+func (*ClusterNetworkConfig) Descriptor() ([]byte, []int) {
+	return fileDescriptor_generated_65b6213fe49d8a81, []int{20}
+}
+func (m *ClusterNetworkConfig) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ClusterNetworkConfig) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalTo(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (dst *ClusterNetworkConfig) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ClusterNetworkConfig.Merge(dst, src)
+}
+func (m *ClusterNetworkConfig) XXX_Size() int {
+	return m.Size()
+}
+func (m *ClusterNetworkConfig) XXX_DiscardUnknown() {
+	xxx_messageInfo_ClusterNetworkConfig.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ClusterNetworkConfig proto.InternalMessageInfo
+
 func (m *ComparisonResult) Reset()      { *m = ComparisonResult{} }
 func (*ComparisonResult) ProtoMessage() {}
 func (*ComparisonResult) Descriptor() ([]byte, []int) {
-	return fileDescriptor_generated_cc6f080d95e71262, []int{18}
+	return fileDescriptor_generated_65b6213fe49d8a81, []int{21}
 }
 func (m *ComparisonResult) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -562,7 +647,7 @@ var xxx_messageInfo_ComparisonResult proto.InternalMessageInfo
 func (m *ComponentParameter) Reset()      { *m = ComponentParameter{} }
 func (*ComponentParameter) ProtoMessage() {}
 func (*ComponentParameter) Descriptor() ([]byte, []int) {
-	return fileDescriptor_generated_cc6f080d95e71262, []int{19}
+	return fileDescriptor_generated_65b6213fe49d8a81, []int{22}
 }
 func (m *ComponentParameter) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -590,7 +675,7 @@ var xxx_messageInfo_ComponentParameter proto.InternalMessageInfo
 func (m *ConnectionState) Reset()      { *m = ConnectionState{} }
 func (*ConnectionState) ProtoMessage() {}
 func (*ConnectionState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_generated_cc6f080d95e71262, []int{20}
+	return fileDescriptor_generated_65b6213fe49d8a81, []int{23}
 }
 func (m *ConnectionState) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -618,7 +703,7 @@ var xxx_messageInfo_ConnectionState proto.InternalMessageInfo
 func (m *DeploymentInfo) Reset()      { *m = DeploymentInfo{} }
 func (*DeploymentInfo) ProtoMessage() {}
 func (*DeploymentInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_generated_cc6f080d95e71262, []int{21}
+	return fileDescriptor_generated_65b6213fe49d8a81, []int{24}
 }
 func (m *DeploymentInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -643,10 +728,66 @@ func (m *DeploymentInfo) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_DeploymentInfo proto.InternalMessageInfo
 
+func (m *DestinationOperationResult) Reset()      { *m = DestinationOperationResult{} }
+func (*DestinationOperationResult) ProtoMessage() {}
+func (*DestinationOperationResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_generated_65b6213fe49d8a81, []int{25}
+}
+func (m *DestinationOperationResult) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DestinationOperationResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalTo(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (dst *DestinationOperationResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DestinationOperationResult.Merge(dst, src)
+}
+func (m *DestinationOperationResult) XXX_Size() int {
+	return m.Size()
+}
+func (m *DestinationOperationResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_DestinationOperationResult.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DestinationOperationResult proto.InternalMessageInfo
+
+func (m *DestinationStatus) Reset()      { *m = DestinationStatus{} }
+func (*DestinationStatus) ProtoMessage() {}
+func (*DestinationStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_generated_65b6213fe49d8a81, []int{26}
+}
+func (m *DestinationStatus) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DestinationStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalTo(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (dst *DestinationStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DestinationStatus.Merge(dst, src)
+}
+func (m *DestinationStatus) XXX_Size() int {
+	return m.Size()
+}
+func (m *DestinationStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_DestinationStatus.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DestinationStatus proto.InternalMessageInfo
+
 func (m *HealthStatus) Reset()      { *m = HealthStatus{} }
 func (*HealthStatus) ProtoMessage() {}
 func (*HealthStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_generated_cc6f080d95e71262, []int{22}
+	return fileDescriptor_generated_65b6213fe49d8a81, []int{27}
 }
 func (m *HealthStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -674,7 +815,7 @@ var xxx_messageInfo_HealthStatus proto.InternalMessageInfo
 func (m *HookStatus) Reset()      { *m = HookStatus{} }
 func (*HookStatus) ProtoMessage() {}
 func (*HookStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_generated_cc6f080d95e71262, []int{23}
+	return fileDescriptor_generated_65b6213fe49d8a81, []int{28}
 }
 func (m *HookStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -702,7 +843,7 @@ var xxx_messageInfo_HookStatus proto.InternalMessageInfo
 func (m *JWTToken) Reset()      { *m = JWTToken{} }
 func (*JWTToken) ProtoMessage() {}
 func (*JWTToken) Descriptor() ([]byte, []int) {
-	return fileDescriptor_generated_cc6f080d95e71262, []int{24}
+	return fileDescriptor_generated_65b6213fe49d8a81, []int{29}
 }
 func (m *JWTToken) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -730,7 +871,7 @@ var xxx_messageInfo_JWTToken proto.InternalMessageInfo
 func (m *Operation) Reset()      { *m = Operation{} }
 func (*Operation) ProtoMessage() {}
 func (*Operation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_generated_cc6f080d95e71262, []int{25}
+	return fileDescriptor_generated_65b6213fe49d8a81, []int{30}
 }
 func (m *Operation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -755,10 +896,38 @@ func (m *Operation) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Operation proto.InternalMessageInfo
 
+func (m *OperationAttempt) Reset()      { *m = OperationAttempt{} }
+func (*OperationAttempt) ProtoMessage() {}
+func (*OperationAttempt) Descriptor() ([]byte, []int) {
+	return fileDescriptor_generated_65b6213fe49d8a81, []int{31}
+}
+func (m *OperationAttempt) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OperationAttempt) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalTo(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (dst *OperationAttempt) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OperationAttempt.Merge(dst, src)
+}
+func (m *OperationAttempt) XXX_Size() int {
+	return m.Size()
+}
+func (m *OperationAttempt) XXX_DiscardUnknown() {
+	xxx_messageInfo_OperationAttempt.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OperationAttempt proto.InternalMessageInfo
+
 func (m *OperationState) Reset()      { *m = OperationState{} }
 func (*OperationState) ProtoMessage() {}
 func (*OperationState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_generated_cc6f080d95e71262, []int{26}
+	return fileDescriptor_generated_65b6213fe49d8a81, []int{32}
 }
 func (m *OperationState) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -783,10 +952,66 @@ func (m *OperationState) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_OperationState proto.InternalMessageInfo
 
+func (m *OrphanedResource) Reset()      { *m = OrphanedResource{} }
+func (*OrphanedResource) ProtoMessage() {}
+func (*OrphanedResource) Descriptor() ([]byte, []int) {
+	return fileDescriptor_generated_65b6213fe49d8a81, []int{33}
+}
+func (m *OrphanedResource) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OrphanedResource) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalTo(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (dst *OrphanedResource) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OrphanedResource.Merge(dst, src)
+}
+func (m *OrphanedResource) XXX_Size() int {
+	return m.Size()
+}
+func (m *OrphanedResource) XXX_DiscardUnknown() {
+	xxx_messageInfo_OrphanedResource.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OrphanedResource proto.InternalMessageInfo
+
+func (m *OrphanedResourcesMonitorSettings) Reset()      { *m = OrphanedResourcesMonitorSettings{} }
+func (*OrphanedResourcesMonitorSettings) ProtoMessage() {}
+func (*OrphanedResourcesMonitorSettings) Descriptor() ([]byte, []int) {
+	return fileDescriptor_generated_65b6213fe49d8a81, []int{34}
+}
+func (m *OrphanedResourcesMonitorSettings) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OrphanedResourcesMonitorSettings) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalTo(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (dst *OrphanedResourcesMonitorSettings) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OrphanedResourcesMonitorSettings.Merge(dst, src)
+}
+func (m *OrphanedResourcesMonitorSettings) XXX_Size() int {
+	return m.Size()
+}
+func (m *OrphanedResourcesMonitorSettings) XXX_DiscardUnknown() {
+	xxx_messageInfo_OrphanedResourcesMonitorSettings.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OrphanedResourcesMonitorSettings proto.InternalMessageInfo
+
 func (m *ParameterOverrides) Reset()      { *m = ParameterOverrides{} }
 func (*ParameterOverrides) ProtoMessage() {}
 func (*ParameterOverrides) Descriptor() ([]byte, []int) {
-	return fileDescriptor_generated_cc6f080d95e71262, []int{27}
+	return fileDescriptor_generated_65b6213fe49d8a81, []int{35}
 }
 func (m *ParameterOverrides) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -811,10 +1036,38 @@ func (m *ParameterOverrides) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ParameterOverrides proto.InternalMessageInfo
 
+func (m *ParameterPreset) Reset()      { *m = ParameterPreset{} }
+func (*ParameterPreset) ProtoMessage() {}
+func (*ParameterPreset) Descriptor() ([]byte, []int) {
+	return fileDescriptor_generated_65b6213fe49d8a81, []int{36}
+}
+func (m *ParameterPreset) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ParameterPreset) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalTo(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (dst *ParameterPreset) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ParameterPreset.Merge(dst, src)
+}
+func (m *ParameterPreset) XXX_Size() int {
+	return m.Size()
+}
+func (m *ParameterPreset) XXX_DiscardUnknown() {
+	xxx_messageInfo_ParameterPreset.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ParameterPreset proto.InternalMessageInfo
+
 func (m *ProjectRole) Reset()      { *m = ProjectRole{} }
 func (*ProjectRole) ProtoMessage() {}
 func (*ProjectRole) Descriptor() ([]byte, []int) {
-	return fileDescriptor_generated_cc6f080d95e71262, []int{28}
+	return fileDescriptor_generated_65b6213fe49d8a81, []int{37}
 }
 func (m *ProjectRole) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -842,7 +1095,7 @@ var xxx_messageInfo_ProjectRole proto.InternalMessageInfo
 func (m *Repository) Reset()      { *m = Repository{} }
 func (*Repository) ProtoMessage() {}
 func (*Repository) Descriptor() ([]byte, []int) {
-	return fileDescriptor_generated_cc6f080d95e71262, []int{29}
+	return fileDescriptor_generated_65b6213fe49d8a81, []int{38}
 }
 func (m *Repository) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -870,7 +1123,7 @@ var xxx_messageInfo_Repository proto.InternalMessageInfo
 func (m *RepositoryList) Reset()      { *m = RepositoryList{} }
 func (*RepositoryList) ProtoMessage() {}
 func (*RepositoryList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_generated_cc6f080d95e71262, []int{30}
+	return fileDescriptor_generated_65b6213fe49d8a81, []int{39}
 }
 func (m *RepositoryList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -898,7 +1151,7 @@ var xxx_messageInfo_RepositoryList proto.InternalMessageInfo
 func (m *ResourceDetails) Reset()      { *m = ResourceDetails{} }
 func (*ResourceDetails) ProtoMessage() {}
 func (*ResourceDetails) Descriptor() ([]byte, []int) {
-	return fileDescriptor_generated_cc6f080d95e71262, []int{31}
+	return fileDescriptor_generated_65b6213fe49d8a81, []int{40}
 }
 func (m *ResourceDetails) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -923,10 +1176,38 @@ func (m *ResourceDetails) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ResourceDetails proto.InternalMessageInfo
 
+func (m *ResourceIgnoreDifferences) Reset()      { *m = ResourceIgnoreDifferences{} }
+func (*ResourceIgnoreDifferences) ProtoMessage() {}
+func (*ResourceIgnoreDifferences) Descriptor() ([]byte, []int) {
+	return fileDescriptor_generated_65b6213fe49d8a81, []int{41}
+}
+func (m *ResourceIgnoreDifferences) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ResourceIgnoreDifferences) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalTo(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (dst *ResourceIgnoreDifferences) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ResourceIgnoreDifferences.Merge(dst, src)
+}
+func (m *ResourceIgnoreDifferences) XXX_Size() int {
+	return m.Size()
+}
+func (m *ResourceIgnoreDifferences) XXX_DiscardUnknown() {
+	xxx_messageInfo_ResourceIgnoreDifferences.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ResourceIgnoreDifferences proto.InternalMessageInfo
+
 func (m *ResourceNode) Reset()      { *m = ResourceNode{} }
 func (*ResourceNode) ProtoMessage() {}
 func (*ResourceNode) Descriptor() ([]byte, []int) {
-	return fileDescriptor_generated_cc6f080d95e71262, []int{32}
+	return fileDescriptor_generated_65b6213fe49d8a81, []int{42}
 }
 func (m *ResourceNode) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -951,10 +1232,38 @@ func (m *ResourceNode) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ResourceNode proto.InternalMessageInfo
 
+func (m *ResourceRef) Reset()      { *m = ResourceRef{} }
+func (*ResourceRef) ProtoMessage() {}
+func (*ResourceRef) Descriptor() ([]byte, []int) {
+	return fileDescriptor_generated_65b6213fe49d8a81, []int{43}
+}
+func (m *ResourceRef) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ResourceRef) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalTo(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (dst *ResourceRef) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ResourceRef.Merge(dst, src)
+}
+func (m *ResourceRef) XXX_Size() int {
+	return m.Size()
+}
+func (m *ResourceRef) XXX_DiscardUnknown() {
+	xxx_messageInfo_ResourceRef.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ResourceRef proto.InternalMessageInfo
+
 func (m *ResourceState) Reset()      { *m = ResourceState{} }
 func (*ResourceState) ProtoMessage() {}
 func (*ResourceState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_generated_cc6f080d95e71262, []int{33}
+	return fileDescriptor_generated_65b6213fe49d8a81, []int{44}
 }
 func (m *ResourceState) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -982,7 +1291,7 @@ var xxx_messageInfo_ResourceState proto.InternalMessageInfo
 func (m *ResourceSummary) Reset()      { *m = ResourceSummary{} }
 func (*ResourceSummary) ProtoMessage() {}
 func (*ResourceSummary) Descriptor() ([]byte, []int) {
-	return fileDescriptor_generated_cc6f080d95e71262, []int{34}
+	return fileDescriptor_generated_65b6213fe49d8a81, []int{45}
 }
 func (m *ResourceSummary) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1007,10 +1316,66 @@ func (m *ResourceSummary) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ResourceSummary proto.InternalMessageInfo
 
+func (m *ResourceTreeNode) Reset()      { *m = ResourceTreeNode{} }
+func (*ResourceTreeNode) ProtoMessage() {}
+func (*ResourceTreeNode) Descriptor() ([]byte, []int) {
+	return fileDescriptor_generated_65b6213fe49d8a81, []int{46}
+}
+func (m *ResourceTreeNode) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ResourceTreeNode) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalTo(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (dst *ResourceTreeNode) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ResourceTreeNode.Merge(dst, src)
+}
+func (m *ResourceTreeNode) XXX_Size() int {
+	return m.Size()
+}
+func (m *ResourceTreeNode) XXX_DiscardUnknown() {
+	xxx_messageInfo_ResourceTreeNode.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ResourceTreeNode proto.InternalMessageInfo
+
+func (m *RetryStrategy) Reset()      { *m = RetryStrategy{} }
+func (*RetryStrategy) ProtoMessage() {}
+func (*RetryStrategy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_generated_65b6213fe49d8a81, []int{47}
+}
+func (m *RetryStrategy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RetryStrategy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalTo(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (dst *RetryStrategy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RetryStrategy.Merge(dst, src)
+}
+func (m *RetryStrategy) XXX_Size() int {
+	return m.Size()
+}
+func (m *RetryStrategy) XXX_DiscardUnknown() {
+	xxx_messageInfo_RetryStrategy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RetryStrategy proto.InternalMessageInfo
+
 func (m *SyncOperation) Reset()      { *m = SyncOperation{} }
 func (*SyncOperation) ProtoMessage() {}
 func (*SyncOperation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_generated_cc6f080d95e71262, []int{35}
+	return fileDescriptor_generated_65b6213fe49d8a81, []int{48}
 }
 func (m *SyncOperation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1038,7 +1403,7 @@ var xxx_messageInfo_SyncOperation proto.InternalMessageInfo
 func (m *SyncOperationResource) Reset()      { *m = SyncOperationResource{} }
 func (*SyncOperationResource) ProtoMessage() {}
 func (*SyncOperationResource) Descriptor() ([]byte, []int) {
-	return fileDescriptor_generated_cc6f080d95e71262, []int{36}
+	return fileDescriptor_generated_65b6213fe49d8a81, []int{49}
 }
 func (m *SyncOperationResource) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1066,7 +1431,7 @@ var xxx_messageInfo_SyncOperationResource proto.InternalMessageInfo
 func (m *SyncOperationResult) Reset()      { *m = SyncOperationResult{} }
 func (*SyncOperationResult) ProtoMessage() {}
 func (*SyncOperationResult) Descriptor() ([]byte, []int) {
-	return fileDescriptor_generated_cc6f080d95e71262, []int{37}
+	return fileDescriptor_generated_65b6213fe49d8a81, []int{50}
 }
 func (m *SyncOperationResult) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1094,7 +1459,7 @@ var xxx_messageInfo_SyncOperationResult proto.InternalMessageInfo
 func (m *SyncPolicy) Reset()      { *m = SyncPolicy{} }
 func (*SyncPolicy) ProtoMessage() {}
 func (*SyncPolicy) Descriptor() ([]byte, []int) {
-	return fileDescriptor_generated_cc6f080d95e71262, []int{38}
+	return fileDescriptor_generated_65b6213fe49d8a81, []int{51}
 }
 func (m *SyncPolicy) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1122,7 +1487,7 @@ var xxx_messageInfo_SyncPolicy proto.InternalMessageInfo
 func (m *SyncPolicyAutomated) Reset()      { *m = SyncPolicyAutomated{} }
 func (*SyncPolicyAutomated) ProtoMessage() {}
 func (*SyncPolicyAutomated) Descriptor() ([]byte, []int) {
-	return fileDescriptor_generated_cc6f080d95e71262, []int{39}
+	return fileDescriptor_generated_65b6213fe49d8a81, []int{52}
 }
 func (m *SyncPolicyAutomated) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1150,7 +1515,7 @@ var xxx_messageInfo_SyncPolicyAutomated proto.InternalMessageInfo
 func (m *SyncStrategy) Reset()      { *m = SyncStrategy{} }
 func (*SyncStrategy) ProtoMessage() {}
 func (*SyncStrategy) Descriptor() ([]byte, []int) {
-	return fileDescriptor_generated_cc6f080d95e71262, []int{40}
+	return fileDescriptor_generated_65b6213fe49d8a81, []int{53}
 }
 func (m *SyncStrategy) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1178,7 +1543,7 @@ var xxx_messageInfo_SyncStrategy proto.InternalMessageInfo
 func (m *SyncStrategyApply) Reset()      { *m = SyncStrategyApply{} }
 func (*SyncStrategyApply) ProtoMessage() {}
 func (*SyncStrategyApply) Descriptor() ([]byte, []int) {
-	return fileDescriptor_generated_cc6f080d95e71262, []int{41}
+	return fileDescriptor_generated_65b6213fe49d8a81, []int{54}
 }
 func (m *SyncStrategyApply) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1206,7 +1571,7 @@ var xxx_messageInfo_SyncStrategyApply proto.InternalMessageInfo
 func (m *SyncStrategyHook) Reset()      { *m = SyncStrategyHook{} }
 func (*SyncStrategyHook) ProtoMessage() {}
 func (*SyncStrategyHook) Descriptor() ([]byte, []int) {
-	return fileDescriptor_generated_cc6f080d95e71262, []int{42}
+	return fileDescriptor_generated_65b6213fe49d8a81, []int{55}
 }
 func (m *SyncStrategyHook) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1231,10 +1596,66 @@ func (m *SyncStrategyHook) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_SyncStrategyHook proto.InternalMessageInfo
 
+func (m *SyncStrategyProgressive) Reset()      { *m = SyncStrategyProgressive{} }
+func (*SyncStrategyProgressive) ProtoMessage() {}
+func (*SyncStrategyProgressive) Descriptor() ([]byte, []int) {
+	return fileDescriptor_generated_65b6213fe49d8a81, []int{56}
+}
+func (m *SyncStrategyProgressive) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SyncStrategyProgressive) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalTo(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (dst *SyncStrategyProgressive) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SyncStrategyProgressive.Merge(dst, src)
+}
+func (m *SyncStrategyProgressive) XXX_Size() int {
+	return m.Size()
+}
+func (m *SyncStrategyProgressive) XXX_DiscardUnknown() {
+	xxx_messageInfo_SyncStrategyProgressive.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SyncStrategyProgressive proto.InternalMessageInfo
+
+func (m *SyncWindow) Reset()      { *m = SyncWindow{} }
+func (*SyncWindow) ProtoMessage() {}
+func (*SyncWindow) Descriptor() ([]byte, []int) {
+	return fileDescriptor_generated_65b6213fe49d8a81, []int{57}
+}
+func (m *SyncWindow) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SyncWindow) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalTo(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (dst *SyncWindow) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SyncWindow.Merge(dst, src)
+}
+func (m *SyncWindow) XXX_Size() int {
+	return m.Size()
+}
+func (m *SyncWindow) XXX_DiscardUnknown() {
+	xxx_messageInfo_SyncWindow.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SyncWindow proto.InternalMessageInfo
+
 func (m *TLSClientConfig) Reset()      { *m = TLSClientConfig{} }
 func (*TLSClientConfig) ProtoMessage() {}
 func (*TLSClientConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_generated_cc6f080d95e71262, []int{43}
+	return fileDescriptor_generated_65b6213fe49d8a81, []int{58}
 }
 func (m *TLSClientConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1274,27 +1695,41 @@ func init() {
 	proto.RegisterType((*ApplicationSourceKustomize)(nil), "github.com.argoproj.argo_cd.pkg.apis.application.v1alpha1.ApplicationSourceKustomize")
 	proto.RegisterType((*ApplicationSpec)(nil), "github.com.argoproj.argo_cd.pkg.apis.application.v1alpha1.ApplicationSpec")
 	proto.RegisterType((*ApplicationStatus)(nil), "github.com.argoproj.argo_cd.pkg.apis.application.v1alpha1.ApplicationStatus")
+	proto.RegisterType((*ApplicationTree)(nil), "github.com.argoproj.argo_cd.pkg.apis.application.v1alpha1.ApplicationTree")
 	proto.RegisterType((*ApplicationWatchEvent)(nil), "github.com.argoproj.argo_cd.pkg.apis.application.v1alpha1.ApplicationWatchEvent")
+	proto.RegisterType((*Backoff)(nil), "github.com.argoproj.argo_cd.pkg.apis.application.v1alpha1.Backoff")
 	proto.RegisterType((*Cluster)(nil), "github.com.argoproj.argo_cd.pkg.apis.application.v1alpha1.Cluster")
+	proto.RegisterMapType((map[string]string)(nil), "github.com.argoproj.argo_cd.pkg.apis.application.v1alpha1.Cluster.LabelsEntry")
 	proto.RegisterType((*ClusterConfig)(nil), "github.com.argoproj.argo_cd.pkg.apis.application.v1alpha1.ClusterConfig")
 	proto.RegisterType((*ClusterList)(nil), "github.com.argoproj.argo_cd.pkg.apis.application.v1alpha1.ClusterList")
+	proto.RegisterType((*ClusterNetworkConfig)(nil), "github.com.argoproj.argo_cd.pkg.apis.application.v1alpha1.ClusterNetworkConfig")
 	proto.RegisterType((*ComparisonResult)(nil), "github.com.argoproj.argo_cd.pkg.apis.application.v1alpha1.ComparisonResult")
 	proto.RegisterType((*ComponentParameter)(nil), "github.com.argoproj.argo_cd.pkg.apis.application.v1alpha1.ComponentParameter")
 	proto.RegisterType((*ConnectionState)(nil), "github.com.argoproj.argo_cd.pkg.apis.application.v1alpha1.ConnectionState")
 	proto.RegisterType((*DeploymentInfo)(nil), "github.com.argoproj.argo_cd.pkg.apis.application.v1alpha1.DeploymentInfo")
+	proto.RegisterType((*DestinationOperationResult)(nil), "github.com.argoproj.argo_cd.pkg.apis.application.v1alpha1.DestinationOperationResult")
+	proto.RegisterType((*DestinationStatus)(nil), "github.com.argoproj.argo_cd.pkg.apis.application.v1alpha1.DestinationStatus")
 	proto.RegisterType((*HealthStatus)(nil), "github.com.argoproj.argo_cd.pkg.apis.application.v1alpha1.HealthStatus")
 	proto.RegisterType((*HookStatus)(nil), "github.com.argoproj.argo_cd.pkg.apis.application.v1alpha1.HookStatus")
 	proto.RegisterType((*JWTToken)(nil), "github.com.argoproj.argo_cd.pkg.apis.application.v1alpha1.JWTToken")
 	proto.RegisterType((*Operation)(nil), "github.com.argoproj.argo_cd.pkg.apis.application.v1alpha1.Operation")
+	proto.RegisterType((*OperationAttempt)(nil), "github.com.argoproj.argo_cd.pkg.apis.application.v1alpha1.OperationAttempt")
 	proto.RegisterType((*OperationState)(nil), "github.com.argoproj.argo_cd.pkg.apis.application.v1alpha1.OperationState")
+	proto.RegisterType((*OrphanedResource)(nil), "github.com.argoproj.argo_cd.pkg.apis.application.v1alpha1.OrphanedResource")
+	proto.RegisterType((*OrphanedResourcesMonitorSettings)(nil), "github.com.argoproj.argo_cd.pkg.apis.application.v1alpha1.OrphanedResourcesMonitorSettings")
 	proto.RegisterType((*ParameterOverrides)(nil), "github.com.argoproj.argo_cd.pkg.apis.application.v1alpha1.ParameterOverrides")
+	proto.RegisterType((*ParameterPreset)(nil), "github.com.argoproj.argo_cd.pkg.apis.application.v1alpha1.ParameterPreset")
 	proto.RegisterType((*ProjectRole)(nil), "github.com.argoproj.argo_cd.pkg.apis.application.v1alpha1.ProjectRole")
 	proto.RegisterType((*Repository)(nil), "github.com.argoproj.argo_cd.pkg.apis.application.v1alpha1.Repository")
 	proto.RegisterType((*RepositoryList)(nil), "github.com.argoproj.argo_cd.pkg.apis.application.v1alpha1.RepositoryList")
 	proto.RegisterType((*ResourceDetails)(nil), "github.com.argoproj.argo_cd.pkg.apis.application.v1alpha1.ResourceDetails")
+	proto.RegisterType((*ResourceIgnoreDifferences)(nil), "github.com.argoproj.argo_cd.pkg.apis.application.v1alpha1.ResourceIgnoreDifferences")
 	proto.RegisterType((*ResourceNode)(nil), "github.com.argoproj.argo_cd.pkg.apis.application.v1alpha1.ResourceNode")
+	proto.RegisterType((*ResourceRef)(nil), "github.com.argoproj.argo_cd.pkg.apis.application.v1alpha1.ResourceRef")
 	proto.RegisterType((*ResourceState)(nil), "github.com.argoproj.argo_cd.pkg.apis.application.v1alpha1.ResourceState")
 	proto.RegisterType((*ResourceSummary)(nil), "github.com.argoproj.argo_cd.pkg.apis.application.v1alpha1.ResourceSummary")
+	proto.RegisterType((*ResourceTreeNode)(nil), "github.com.argoproj.argo_cd.pkg.apis.application.v1alpha1.ResourceTreeNode")
+	proto.RegisterType((*RetryStrategy)(nil), "github.com.argoproj.argo_cd.pkg.apis.application.v1alpha1.RetryStrategy")
 	proto.RegisterType((*SyncOperation)(nil), "github.com.argoproj.argo_cd.pkg.apis.application.v1alpha1.SyncOperation")
 	proto.RegisterType((*SyncOperationResource)(nil), "github.com.argoproj.argo_cd.pkg.apis.application.v1alpha1.SyncOperationResource")
 	proto.RegisterType((*SyncOperationResult)(nil), "github.com.argoproj.argo_cd.pkg.apis.application.v1alpha1.SyncOperationResult")
@@ -1303,6 +1738,8 @@ func init() {
 	proto.RegisterType((*SyncStrategy)(nil), "github.com.argoproj.argo_cd.pkg.apis.application.v1alpha1.SyncStrategy")
 	proto.RegisterType((*SyncStrategyApply)(nil), "github.com.argoproj.argo_cd.pkg.apis.application.v1alpha1.SyncStrategyApply")
 	proto.RegisterType((*SyncStrategyHook)(nil), "github.com.argoproj.argo_cd.pkg.apis.application.v1alpha1.SyncStrategyHook")
+	proto.RegisterType((*SyncStrategyProgressive)(nil), "github.com.argoproj.argo_cd.pkg.apis.application.v1alpha1.SyncStrategyProgressive")
+	proto.RegisterType((*SyncWindow)(nil), "github.com.argoproj.argo_cd.pkg.apis.application.v1alpha1.SyncWindow")
 	proto.RegisterType((*TLSClientConfig)(nil), "github.com.argoproj.argo_cd.pkg.apis.application.v1alpha1.TLSClientConfig")
 }
 func (m *AWSAuthConfig) Marshal() (dAtA []byte, err error) {
@@ -1485,22 +1922,56 @@ func (m *AppProjectSpec) MarshalTo(dAtA []byte) (int, error) {
 			i += n
 		}
 	}
-	return i, nil
-}
-
-func (m *Application) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
-
-func (m *Application) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
+	if len(m.ParameterPresets) > 0 {
+		for _, msg := range m.ParameterPresets {
+			dAtA[i] = 0x3a
+			i++
+			i = encodeVarintGenerated(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if len(m.SyncWindows) > 0 {
+		for _, msg := range m.SyncWindows {
+			dAtA[i] = 0x42
+			i++
+			i = encodeVarintGenerated(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if m.OrphanedResources != nil {
+		dAtA[i] = 0x4a
+		i++
+		i = encodeVarintGenerated(dAtA, i, uint64(m.OrphanedResources.Size()))
+		n56, err := m.OrphanedResources.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n56
+	}
+	return i, nil
+}
+
+func (m *Application) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Application) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
 	dAtA[i] = 0xa
@@ -1589,6 +2060,10 @@ func (m *ApplicationDestination) MarshalTo(dAtA []byte) (int, error) {
 	i++
 	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Namespace)))
 	i += copy(dAtA[i:], m.Namespace)
+	dAtA[i] = 0x1a
+	i++
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Selector)))
+	i += copy(dAtA[i:], m.Selector)
 	return i, nil
 }
 
@@ -1718,6 +2193,10 @@ func (m *ApplicationSource) MarshalTo(dAtA []byte) (int, error) {
 		}
 		i += n11
 	}
+	dAtA[i] = 0x52
+	i++
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Chart)))
+	i += copy(dAtA[i:], m.Chart)
 	return i, nil
 }
 
@@ -1755,6 +2234,10 @@ func (m *ApplicationSourceHelm) MarshalTo(dAtA []byte) (int, error) {
 			i += copy(dAtA[i:], s)
 		}
 	}
+	dAtA[i] = 0x1a
+	i++
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.ValuesSchemaFile)))
+	i += copy(dAtA[i:], m.ValuesSchemaFile)
 	return i, nil
 }
 
@@ -1847,6 +2330,46 @@ func (m *ApplicationSpec) MarshalTo(dAtA []byte) (int, error) {
 		}
 		i += n14
 	}
+	if len(m.AdditionalDestinations) > 0 {
+		for _, msg := range m.AdditionalDestinations {
+			dAtA[i] = 0x2a
+			i++
+			i = encodeVarintGenerated(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if len(m.ParameterPresets) > 0 {
+		for _, msg := range m.ParameterPresets {
+			dAtA[i] = 0x32
+			i++
+			i = encodeVarintGenerated(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if len(m.IgnoreDifferences) > 0 {
+		for _, msg := range m.IgnoreDifferences {
+			dAtA[i] = 0x3a
+			i++
+			i = encodeVarintGenerated(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	dAtA[i] = 0x42
+	i++
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.ProgressingDeadline)))
+	i += copy(dAtA[i:], m.ProgressingDeadline)
 	return i, nil
 }
 
@@ -1927,6 +2450,82 @@ func (m *ApplicationStatus) MarshalTo(dAtA []byte) (int, error) {
 			i += n
 		}
 	}
+	if len(m.Destinations) > 0 {
+		for _, msg := range m.Destinations {
+			dAtA[i] = 0x3a
+			i++
+			i = encodeVarintGenerated(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if len(m.OrphanedResources) > 0 {
+		for _, msg := range m.OrphanedResources {
+			dAtA[i] = 0x42
+			i++
+			i = encodeVarintGenerated(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if len(m.OperationHistory) > 0 {
+		for _, msg := range m.OperationHistory {
+			dAtA[i] = 0x4a
+			i++
+			i = encodeVarintGenerated(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if m.ReconciledAt != nil {
+		dAtA[i] = 0x52
+		i++
+		i = encodeVarintGenerated(dAtA, i, uint64(m.ReconciledAt.Size()))
+		n62, err := m.ReconciledAt.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n62
+	}
+	return i, nil
+}
+
+func (m *ApplicationTree) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ApplicationTree) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Nodes) > 0 {
+		for _, msg := range m.Nodes {
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintGenerated(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
 	return i, nil
 }
 
@@ -1960,6 +2559,37 @@ func (m *ApplicationWatchEvent) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
+func (m *Backoff) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Backoff) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	dAtA[i] = 0xa
+	i++
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Duration)))
+	i += copy(dAtA[i:], m.Duration)
+	if m.Factor != nil {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintGenerated(dAtA, i, uint64(*m.Factor))
+	}
+	dAtA[i] = 0x1a
+	i++
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.MaxDuration)))
+	i += copy(dAtA[i:], m.MaxDuration)
+	return i, nil
+}
+
 func (m *Cluster) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1999,6 +2629,43 @@ func (m *Cluster) MarshalTo(dAtA []byte) (int, error) {
 		return 0, err
 	}
 	i += n20
+	if len(m.NormalizerProfiles) > 0 {
+		for _, s := range m.NormalizerProfiles {
+			dAtA[i] = 0x2a
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	if len(m.Labels) > 0 {
+		keysForLabels := make([]string, 0, len(m.Labels))
+		for k := range m.Labels {
+			keysForLabels = append(keysForLabels, string(k))
+		}
+		github_com_gogo_protobuf_sortkeys.Strings(keysForLabels)
+		for _, k := range keysForLabels {
+			dAtA[i] = 0x32
+			i++
+			v := m.Labels[string(k)]
+			mapSize := 1 + len(k) + sovGenerated(uint64(len(k))) + 1 + len(v) + sovGenerated(uint64(len(v)))
+			i = encodeVarintGenerated(dAtA, i, uint64(mapSize))
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintGenerated(dAtA, i, uint64(len(k)))
+			i += copy(dAtA[i:], k)
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintGenerated(dAtA, i, uint64(len(v)))
+			i += copy(dAtA[i:], v)
+		}
+	}
 	return i, nil
 }
 
@@ -2047,6 +2714,16 @@ func (m *ClusterConfig) MarshalTo(dAtA []byte) (int, error) {
 		}
 		i += n22
 	}
+	if m.NetworkConfig != nil {
+		dAtA[i] = 0x32
+		i++
+		i = encodeVarintGenerated(dAtA, i, uint64(m.NetworkConfig.Size()))
+		n23, err := m.NetworkConfig.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n23
+	}
 	return i, nil
 }
 
@@ -2068,11 +2745,11 @@ func (m *ClusterList) MarshalTo(dAtA []byte) (int, error) {
 	dAtA[i] = 0xa
 	i++
 	i = encodeVarintGenerated(dAtA, i, uint64(m.ListMeta.Size()))
-	n23, err := m.ListMeta.MarshalTo(dAtA[i:])
+	n24, err := m.ListMeta.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n23
+	i += n24
 	if len(m.Items) > 0 {
 		for _, msg := range m.Items {
 			dAtA[i] = 0x12
@@ -2088,6 +2765,36 @@ func (m *ClusterList) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
+func (m *ClusterNetworkConfig) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ClusterNetworkConfig) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	dAtA[i] = 0x8
+	i++
+	i = encodeVarintGenerated(dAtA, i, uint64(m.DialTimeoutSeconds))
+	dAtA[i] = 0x10
+	i++
+	i = encodeVarintGenerated(dAtA, i, uint64(m.RequestTimeoutSeconds))
+	dAtA[i] = 0x18
+	i++
+	i = encodeVarintGenerated(dAtA, i, uint64(m.RetryLimit))
+	dAtA[i] = 0x20
+	i++
+	i = encodeVarintGenerated(dAtA, i, uint64(m.RetryBackoffSeconds))
+	return i, nil
+}
+
 func (m *ComparisonResult) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -2106,19 +2813,19 @@ func (m *ComparisonResult) MarshalTo(dAtA []byte) (int, error) {
 	dAtA[i] = 0xa
 	i++
 	i = encodeVarintGenerated(dAtA, i, uint64(m.ComparedAt.Size()))
-	n24, err := m.ComparedAt.MarshalTo(dAtA[i:])
+	n25, err := m.ComparedAt.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n24
+	i += n25
 	dAtA[i] = 0x12
 	i++
 	i = encodeVarintGenerated(dAtA, i, uint64(m.ComparedTo.Size()))
-	n25, err := m.ComparedTo.MarshalTo(dAtA[i:])
+	n26, err := m.ComparedTo.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n25
+	i += n26
 	dAtA[i] = 0x2a
 	i++
 	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Status)))
@@ -2139,6 +2846,14 @@ func (m *ComparisonResult) MarshalTo(dAtA []byte) (int, error) {
 			i += n
 		}
 	}
+	dAtA[i] = 0x4a
+	i++
+	i = encodeVarintGenerated(dAtA, i, uint64(m.ComparedToDestination.Size()))
+	n63, err := m.ComparedToDestination.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n63
 	return i, nil
 }
 
@@ -2199,11 +2914,11 @@ func (m *ConnectionState) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x1a
 		i++
 		i = encodeVarintGenerated(dAtA, i, uint64(m.ModifiedAt.Size()))
-		n26, err := m.ModifiedAt.MarshalTo(dAtA[i:])
+		n27, err := m.ModifiedAt.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n26
+		i += n27
 	}
 	return i, nil
 }
@@ -2242,18 +2957,40 @@ func (m *DeploymentInfo) MarshalTo(dAtA []byte) (int, error) {
 	dAtA[i] = 0x22
 	i++
 	i = encodeVarintGenerated(dAtA, i, uint64(m.DeployedAt.Size()))
-	n27, err := m.DeployedAt.MarshalTo(dAtA[i:])
+	n28, err := m.DeployedAt.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n27
+	i += n28
 	dAtA[i] = 0x28
 	i++
 	i = encodeVarintGenerated(dAtA, i, uint64(m.ID))
+	dAtA[i] = 0x32
+	i++
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.ManifestsRef)))
+	i += copy(dAtA[i:], m.ManifestsRef)
+	dAtA[i] = 0x3a
+	i++
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Preset)))
+	i += copy(dAtA[i:], m.Preset)
+	if m.Destination != nil {
+		dAtA[i] = 0x42
+		i++
+		i = encodeVarintGenerated(dAtA, i, uint64(m.Destination.Size()))
+		n29, err := m.Destination.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n29
+	}
+	dAtA[i] = 0x4a
+	i++
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.IdempotencyKey)))
+	i += copy(dAtA[i:], m.IdempotencyKey)
 	return i, nil
 }
 
-func (m *HealthStatus) Marshal() (dAtA []byte, err error) {
+func (m *DestinationOperationResult) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -2263,23 +3000,41 @@ func (m *HealthStatus) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *HealthStatus) MarshalTo(dAtA []byte) (int, error) {
+func (m *DestinationOperationResult) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
 	dAtA[i] = 0xa
 	i++
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Status)))
-	i += copy(dAtA[i:], m.Status)
+	i = encodeVarintGenerated(dAtA, i, uint64(m.Destination.Size()))
+	n30, err := m.Destination.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n30
 	dAtA[i] = 0x12
 	i++
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.StatusDetails)))
-	i += copy(dAtA[i:], m.StatusDetails)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Phase)))
+	i += copy(dAtA[i:], m.Phase)
+	dAtA[i] = 0x1a
+	i++
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Message)))
+	i += copy(dAtA[i:], m.Message)
+	if m.SyncResult != nil {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintGenerated(dAtA, i, uint64(m.SyncResult.Size()))
+		n31, err := m.SyncResult.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n31
+	}
 	return i, nil
 }
 
-func (m *HookStatus) Marshal() (dAtA []byte, err error) {
+func (m *DestinationStatus) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -2289,15 +3044,93 @@ func (m *HookStatus) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *HookStatus) MarshalTo(dAtA []byte) (int, error) {
+func (m *DestinationStatus) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
 	dAtA[i] = 0xa
 	i++
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Name)))
-	i += copy(dAtA[i:], m.Name)
+	i = encodeVarintGenerated(dAtA, i, uint64(m.Destination.Size()))
+	n32, err := m.Destination.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n32
+	dAtA[i] = 0x12
+	i++
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Status)))
+	i += copy(dAtA[i:], m.Status)
+	dAtA[i] = 0x1a
+	i++
+	i = encodeVarintGenerated(dAtA, i, uint64(m.Health.Size()))
+	n33, err := m.Health.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n33
+	dAtA[i] = 0x22
+	i++
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Message)))
+	i += copy(dAtA[i:], m.Message)
+	return i, nil
+}
+
+func (m *HealthStatus) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HealthStatus) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	dAtA[i] = 0xa
+	i++
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Status)))
+	i += copy(dAtA[i:], m.Status)
+	dAtA[i] = 0x12
+	i++
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.StatusDetails)))
+	i += copy(dAtA[i:], m.StatusDetails)
+	if m.ProgressingSince != nil {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintGenerated(dAtA, i, uint64(m.ProgressingSince.Size()))
+		n55, err := m.ProgressingSince.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n55
+	}
+	return i, nil
+}
+
+func (m *HookStatus) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HookStatus) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	dAtA[i] = 0xa
+	i++
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Name)))
+	i += copy(dAtA[i:], m.Name)
 	dAtA[i] = 0x12
 	i++
 	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Kind)))
@@ -2318,6 +3151,10 @@ func (m *HookStatus) MarshalTo(dAtA []byte) (int, error) {
 	i++
 	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Message)))
 	i += copy(dAtA[i:], m.Message)
+	dAtA[i] = 0x3a
+	i++
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Namespace)))
+	i += copy(dAtA[i:], m.Namespace)
 	return i, nil
 }
 
@@ -2364,12 +3201,58 @@ func (m *Operation) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintGenerated(dAtA, i, uint64(m.Sync.Size()))
-		n28, err := m.Sync.MarshalTo(dAtA[i:])
+		n34, err := m.Sync.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n28
+		i += n34
+	}
+	dAtA[i] = 0x12
+	i++
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.CorrelationID)))
+	i += copy(dAtA[i:], m.CorrelationID)
+	dAtA[i] = 0x1a
+	i++
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Timeout)))
+	i += copy(dAtA[i:], m.Timeout)
+	dAtA[i] = 0x22
+	i++
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.IdempotencyKey)))
+	i += copy(dAtA[i:], m.IdempotencyKey)
+	return i, nil
+}
+
+func (m *OperationAttempt) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OperationAttempt) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	dAtA[i] = 0xa
+	i++
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Phase)))
+	i += copy(dAtA[i:], m.Phase)
+	dAtA[i] = 0x12
+	i++
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Message)))
+	i += copy(dAtA[i:], m.Message)
+	dAtA[i] = 0x1a
+	i++
+	i = encodeVarintGenerated(dAtA, i, uint64(m.FinishedAt.Size()))
+	n35, err := m.FinishedAt.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
 	}
+	i += n35
 	return i, nil
 }
 
@@ -2391,11 +3274,11 @@ func (m *OperationState) MarshalTo(dAtA []byte) (int, error) {
 	dAtA[i] = 0xa
 	i++
 	i = encodeVarintGenerated(dAtA, i, uint64(m.Operation.Size()))
-	n29, err := m.Operation.MarshalTo(dAtA[i:])
+	n36, err := m.Operation.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n29
+	i += n36
 	dAtA[i] = 0x12
 	i++
 	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Phase)))
@@ -2408,29 +3291,133 @@ func (m *OperationState) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x22
 		i++
 		i = encodeVarintGenerated(dAtA, i, uint64(m.SyncResult.Size()))
-		n30, err := m.SyncResult.MarshalTo(dAtA[i:])
+		n37, err := m.SyncResult.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n30
+		i += n37
 	}
 	dAtA[i] = 0x32
 	i++
 	i = encodeVarintGenerated(dAtA, i, uint64(m.StartedAt.Size()))
-	n31, err := m.StartedAt.MarshalTo(dAtA[i:])
+	n38, err := m.StartedAt.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n31
+	i += n38
 	if m.FinishedAt != nil {
 		dAtA[i] = 0x3a
 		i++
 		i = encodeVarintGenerated(dAtA, i, uint64(m.FinishedAt.Size()))
-		n32, err := m.FinishedAt.MarshalTo(dAtA[i:])
+		n39, err := m.FinishedAt.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n39
+	}
+	if len(m.Attempts) > 0 {
+		for _, msg := range m.Attempts {
+			dAtA[i] = 0x42
+			i++
+			i = encodeVarintGenerated(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if len(m.DestinationResults) > 0 {
+		for _, msg := range m.DestinationResults {
+			dAtA[i] = 0x4a
+			i++
+			i = encodeVarintGenerated(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if m.StateChangedAt != nil {
+		dAtA[i] = 0x52
+		i++
+		i = encodeVarintGenerated(dAtA, i, uint64(m.StateChangedAt.Size()))
+		n61, err := m.StateChangedAt.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n32
+		i += n61
+	}
+	dAtA[i] = 0x58
+	i++
+	if m.Terminated {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i++
+	return i, nil
+}
+
+func (m *OrphanedResource) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OrphanedResource) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	dAtA[i] = 0xa
+	i++
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Group)))
+	i += copy(dAtA[i:], m.Group)
+	dAtA[i] = 0x12
+	i++
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Kind)))
+	i += copy(dAtA[i:], m.Kind)
+	dAtA[i] = 0x1a
+	i++
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Namespace)))
+	i += copy(dAtA[i:], m.Namespace)
+	dAtA[i] = 0x22
+	i++
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Name)))
+	i += copy(dAtA[i:], m.Name)
+	return i, nil
+}
+
+func (m *OrphanedResourcesMonitorSettings) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OrphanedResourcesMonitorSettings) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Warn != nil {
+		dAtA[i] = 0x8
+		i++
+		if *m.Warn {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
 	}
 	return i, nil
 }
@@ -2465,6 +3452,40 @@ func (m ParameterOverrides) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
+func (m *ParameterPreset) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ParameterPreset) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	dAtA[i] = 0xa
+	i++
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Name)))
+	i += copy(dAtA[i:], m.Name)
+	if len(m.Parameters) > 0 {
+		for _, msg := range m.Parameters {
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintGenerated(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	return i, nil
+}
+
 func (m *ProjectRole) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -2567,11 +3588,15 @@ func (m *Repository) MarshalTo(dAtA []byte) (int, error) {
 	dAtA[i] = 0x2a
 	i++
 	i = encodeVarintGenerated(dAtA, i, uint64(m.ConnectionState.Size()))
-	n33, err := m.ConnectionState.MarshalTo(dAtA[i:])
+	n40, err := m.ConnectionState.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n33
+	i += n40
+	dAtA[i] = 0x32
+	i++
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Type)))
+	i += copy(dAtA[i:], m.Type)
 	return i, nil
 }
 
@@ -2593,11 +3618,11 @@ func (m *RepositoryList) MarshalTo(dAtA []byte) (int, error) {
 	dAtA[i] = 0xa
 	i++
 	i = encodeVarintGenerated(dAtA, i, uint64(m.ListMeta.Size()))
-	n34, err := m.ListMeta.MarshalTo(dAtA[i:])
+	n41, err := m.ListMeta.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n34
+	i += n41
 	if len(m.Items) > 0 {
 		for _, msg := range m.Items {
 			dAtA[i] = 0x12
@@ -2648,10 +3673,22 @@ func (m *ResourceDetails) MarshalTo(dAtA []byte) (int, error) {
 	i++
 	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Status)))
 	i += copy(dAtA[i:], m.Status)
+	dAtA[i] = 0x32
+	i++
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Action)))
+	i += copy(dAtA[i:], m.Action)
+	dAtA[i] = 0x3a
+	i++
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Diff)))
+	i += copy(dAtA[i:], m.Diff)
+	dAtA[i] = 0x42
+	i++
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.GenerateName)))
+	i += copy(dAtA[i:], m.GenerateName)
 	return i, nil
 }
 
-func (m *ResourceNode) Marshal() (dAtA []byte, err error) {
+func (m *ResourceIgnoreDifferences) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -2661,27 +3698,114 @@ func (m *ResourceNode) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ResourceNode) MarshalTo(dAtA []byte) (int, error) {
+func (m *ResourceIgnoreDifferences) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
 	dAtA[i] = 0xa
 	i++
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.State)))
-	i += copy(dAtA[i:], m.State)
-	if len(m.Children) > 0 {
-		for _, msg := range m.Children {
-			dAtA[i] = 0x12
-			i++
-			i = encodeVarintGenerated(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
-			}
-			i += n
-		}
-	}
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Group)))
+	i += copy(dAtA[i:], m.Group)
+	dAtA[i] = 0x12
+	i++
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Kind)))
+	i += copy(dAtA[i:], m.Kind)
+	dAtA[i] = 0x1a
+	i++
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Name)))
+	i += copy(dAtA[i:], m.Name)
+	if len(m.JSONPointers) > 0 {
+		for _, s := range m.JSONPointers {
+			dAtA[i] = 0x22
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	return i, nil
+}
+
+func (m *ResourceNode) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ResourceNode) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	dAtA[i] = 0xa
+	i++
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.State)))
+	i += copy(dAtA[i:], m.State)
+	if len(m.Children) > 0 {
+		for _, msg := range m.Children {
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintGenerated(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	return i, nil
+}
+
+func (m *ResourceRef) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ResourceRef) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	dAtA[i] = 0xa
+	i++
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Group)))
+	i += copy(dAtA[i:], m.Group)
+	dAtA[i] = 0x12
+	i++
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Version)))
+	i += copy(dAtA[i:], m.Version)
+	dAtA[i] = 0x1a
+	i++
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Kind)))
+	i += copy(dAtA[i:], m.Kind)
+	dAtA[i] = 0x22
+	i++
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Namespace)))
+	i += copy(dAtA[i:], m.Namespace)
+	dAtA[i] = 0x2a
+	i++
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Name)))
+	i += copy(dAtA[i:], m.Name)
+	dAtA[i] = 0x32
+	i++
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.UID)))
+	i += copy(dAtA[i:], m.UID)
 	return i, nil
 }
 
@@ -2727,11 +3851,11 @@ func (m *ResourceState) MarshalTo(dAtA []byte) (int, error) {
 	dAtA[i] = 0x2a
 	i++
 	i = encodeVarintGenerated(dAtA, i, uint64(m.Health.Size()))
-	n35, err := m.Health.MarshalTo(dAtA[i:])
+	n42, err := m.Health.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n35
+	i += n42
 	return i, nil
 }
 
@@ -2773,11 +3897,106 @@ func (m *ResourceSummary) MarshalTo(dAtA []byte) (int, error) {
 	dAtA[i] = 0x32
 	i++
 	i = encodeVarintGenerated(dAtA, i, uint64(m.Health.Size()))
-	n36, err := m.Health.MarshalTo(dAtA[i:])
+	n43, err := m.Health.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n36
+	i += n43
+	dAtA[i] = 0x3a
+	i++
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Namespace)))
+	i += copy(dAtA[i:], m.Namespace)
+	return i, nil
+}
+
+func (m *ResourceTreeNode) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ResourceTreeNode) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	dAtA[i] = 0xa
+	i++
+	i = encodeVarintGenerated(dAtA, i, uint64(m.ResourceRef.Size()))
+	n57, err := m.ResourceRef.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n57
+	if len(m.ParentRefs) > 0 {
+		for _, msg := range m.ParentRefs {
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintGenerated(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	dAtA[i] = 0x1a
+	i++
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.ResourceVersion)))
+	i += copy(dAtA[i:], m.ResourceVersion)
+	if m.Health != nil {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintGenerated(dAtA, i, uint64(m.Health.Size()))
+		n58, err := m.Health.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n58
+	}
+	return i, nil
+}
+
+func (m *RetryStrategy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RetryStrategy) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	dAtA[i] = 0x8
+	i++
+	i = encodeVarintGenerated(dAtA, i, uint64(m.Limit))
+	if m.Backoff != nil {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintGenerated(dAtA, i, uint64(m.Backoff.Size()))
+		n44, err := m.Backoff.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n44
+	}
+	dAtA[i] = 0x18
+	i++
+	if m.FailedOnly {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i++
 	return i, nil
 }
 
@@ -2820,21 +4039,21 @@ func (m *SyncOperation) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x22
 		i++
 		i = encodeVarintGenerated(dAtA, i, uint64(m.SyncStrategy.Size()))
-		n37, err := m.SyncStrategy.MarshalTo(dAtA[i:])
+		n45, err := m.SyncStrategy.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n37
+		i += n45
 	}
 	if m.ParameterOverrides != nil {
 		dAtA[i] = 0x2a
 		i++
 		i = encodeVarintGenerated(dAtA, i, uint64(m.ParameterOverrides.Size()))
-		n38, err := m.ParameterOverrides.MarshalTo(dAtA[i:])
+		n46, err := m.ParameterOverrides.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n38
+		i += n46
 	}
 	if len(m.Resources) > 0 {
 		for _, msg := range m.Resources {
@@ -2848,6 +4067,75 @@ func (m *SyncOperation) MarshalTo(dAtA []byte) (int, error) {
 			i += n
 		}
 	}
+	dAtA[i] = 0x38
+	i++
+	if m.ConfirmCRDDeletion {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i++
+	dAtA[i] = 0x42
+	i++
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.PrunePropagationPolicy)))
+	i += copy(dAtA[i:], m.PrunePropagationPolicy)
+	if m.Retry != nil {
+		dAtA[i] = 0x4a
+		i++
+		i = encodeVarintGenerated(dAtA, i, uint64(m.Retry.Size()))
+		n47, err := m.Retry.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n47
+	}
+	dAtA[i] = 0x50
+	i++
+	i = encodeVarintGenerated(dAtA, i, uint64(m.ApplyConcurrency))
+	if len(m.ExcludedResources) > 0 {
+		for _, msg := range m.ExcludedResources {
+			dAtA[i] = 0x5a
+			i++
+			i = encodeVarintGenerated(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	dAtA[i] = 0x62
+	i++
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Preset)))
+	i += copy(dAtA[i:], m.Preset)
+	if m.MoveFrom != nil {
+		dAtA[i] = 0x6a
+		i++
+		i = encodeVarintGenerated(dAtA, i, uint64(m.MoveFrom.Size()))
+		n48, err := m.MoveFrom.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n48
+	}
+	if m.TerminationGracePeriodSeconds != nil {
+		dAtA[i] = 0x70
+		i++
+		i = encodeVarintGenerated(dAtA, i, uint64(*m.TerminationGracePeriodSeconds))
+	}
+	dAtA[i] = 0x78
+	i++
+	if m.OverrideSyncWindows {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i++
+	dAtA[i] = 0x80
+	i++
+	dAtA[i] = 0x1
+	i++
+	i = encodeVarintGenerated(dAtA, i, uint64(m.SelfHealAttemptsCount))
 	return i, nil
 }
 
@@ -2924,6 +4212,59 @@ func (m *SyncOperationResult) MarshalTo(dAtA []byte) (int, error) {
 			i += n
 		}
 	}
+	dAtA[i] = 0x22
+	i++
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Summary)))
+	i += copy(dAtA[i:], m.Summary)
+	if len(m.MovedResources) > 0 {
+		for _, msg := range m.MovedResources {
+			dAtA[i] = 0x2a
+			i++
+			i = encodeVarintGenerated(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if len(m.DeniedReasons) > 0 {
+		for _, s := range m.DeniedReasons {
+			dAtA[i] = 0x32
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	if len(m.FailedResources) > 0 {
+		for _, msg := range m.FailedResources {
+			dAtA[i] = 0x3a
+			i++
+			i = encodeVarintGenerated(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if m.HealthWaitStartedAt != nil {
+		dAtA[i] = 0x42
+		i++
+		i = encodeVarintGenerated(dAtA, i, uint64(m.HealthWaitStartedAt.Size()))
+		n60, err := m.HealthWaitStartedAt.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n60
+	}
 	return i, nil
 }
 
@@ -2946,15 +4287,40 @@ func (m *SyncPolicy) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintGenerated(dAtA, i, uint64(m.Automated.Size()))
-		n39, err := m.Automated.MarshalTo(dAtA[i:])
+		n49, err := m.Automated.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n39
+		i += n49
 	}
-	return i, nil
-}
-
+	if len(m.SyncOptions) > 0 {
+		for _, s := range m.SyncOptions {
+			dAtA[i] = 0x12
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	if m.Retry != nil {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintGenerated(dAtA, i, uint64(m.Retry.Size()))
+		n59, err := m.Retry.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n59
+	}
+	return i, nil
+}
+
 func (m *SyncPolicyAutomated) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -2978,6 +4344,14 @@ func (m *SyncPolicyAutomated) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0
 	}
 	i++
+	dAtA[i] = 0x10
+	i++
+	if m.SelfHeal {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i++
 	return i, nil
 }
 
@@ -3000,21 +4374,31 @@ func (m *SyncStrategy) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintGenerated(dAtA, i, uint64(m.Apply.Size()))
-		n40, err := m.Apply.MarshalTo(dAtA[i:])
+		n50, err := m.Apply.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n40
+		i += n50
 	}
 	if m.Hook != nil {
 		dAtA[i] = 0x12
 		i++
 		i = encodeVarintGenerated(dAtA, i, uint64(m.Hook.Size()))
-		n41, err := m.Hook.MarshalTo(dAtA[i:])
+		n51, err := m.Hook.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n51
+	}
+	if m.Progressive != nil {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintGenerated(dAtA, i, uint64(m.Progressive.Size()))
+		n52, err := m.Progressive.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n41
+		i += n52
 	}
 	return i, nil
 }
@@ -3063,11 +4447,89 @@ func (m *SyncStrategyHook) MarshalTo(dAtA []byte) (int, error) {
 	dAtA[i] = 0xa
 	i++
 	i = encodeVarintGenerated(dAtA, i, uint64(m.SyncStrategyApply.Size()))
-	n42, err := m.SyncStrategyApply.MarshalTo(dAtA[i:])
+	n53, err := m.SyncStrategyApply.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n42
+	i += n53
+	dAtA[i] = 0x12
+	i++
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.HealthTimeout)))
+	i += copy(dAtA[i:], m.HealthTimeout)
+	return i, nil
+}
+
+func (m *SyncStrategyProgressive) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SyncStrategyProgressive) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	dAtA[i] = 0xa
+	i++
+	i = encodeVarintGenerated(dAtA, i, uint64(m.SyncStrategyApply.Size()))
+	n54, err := m.SyncStrategyApply.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n54
+	dAtA[i] = 0x10
+	i++
+	i = encodeVarintGenerated(dAtA, i, uint64(m.BatchSize))
+	return i, nil
+}
+
+func (m *SyncWindow) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SyncWindow) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	dAtA[i] = 0xa
+	i++
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Kind)))
+	i += copy(dAtA[i:], m.Kind)
+	dAtA[i] = 0x12
+	i++
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Schedule)))
+	i += copy(dAtA[i:], m.Schedule)
+	dAtA[i] = 0x1a
+	i++
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Duration)))
+	i += copy(dAtA[i:], m.Duration)
+	if len(m.Applications) > 0 {
+		for _, s := range m.Applications {
+			dAtA[i] = 0x22
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
 	return i, nil
 }
 
@@ -3197,6 +4659,22 @@ func (m *AppProjectSpec) Size() (n int) {
 			n += 1 + l + sovGenerated(uint64(l))
 		}
 	}
+	if len(m.ParameterPresets) > 0 {
+		for _, e := range m.ParameterPresets {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	if len(m.SyncWindows) > 0 {
+		for _, e := range m.SyncWindows {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	if m.OrphanedResources != nil {
+		l = m.OrphanedResources.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
@@ -3233,6 +4711,8 @@ func (m *ApplicationDestination) Size() (n int) {
 	n += 1 + l + sovGenerated(uint64(l))
 	l = len(m.Namespace)
 	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Selector)
+	n += 1 + l + sovGenerated(uint64(l))
 	return n
 }
 
@@ -3285,6 +4765,8 @@ func (m *ApplicationSource) Size() (n int) {
 		l = m.Ksonnet.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
+	l = len(m.Chart)
+	n += 1 + l + sovGenerated(uint64(l))
 	return n
 }
 
@@ -3299,6 +4781,8 @@ func (m *ApplicationSourceHelm) Size() (n int) {
 			n += 1 + l + sovGenerated(uint64(l))
 		}
 	}
+	l = len(m.ValuesSchemaFile)
+	n += 1 + l + sovGenerated(uint64(l))
 	return n
 }
 
@@ -3331,6 +4815,26 @@ func (m *ApplicationSpec) Size() (n int) {
 		l = m.SyncPolicy.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
+	if len(m.AdditionalDestinations) > 0 {
+		for _, e := range m.AdditionalDestinations {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	if len(m.ParameterPresets) > 0 {
+		for _, e := range m.ParameterPresets {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	if len(m.IgnoreDifferences) > 0 {
+		for _, e := range m.IgnoreDifferences {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	l = len(m.ProgressingDeadline)
+	n += 1 + l + sovGenerated(uint64(l))
 	return n
 }
 
@@ -3363,6 +4867,40 @@ func (m *ApplicationStatus) Size() (n int) {
 			n += 1 + l + sovGenerated(uint64(l))
 		}
 	}
+	if len(m.Destinations) > 0 {
+		for _, e := range m.Destinations {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	if len(m.OrphanedResources) > 0 {
+		for _, e := range m.OrphanedResources {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	if len(m.OperationHistory) > 0 {
+		for _, e := range m.OperationHistory {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	if m.ReconciledAt != nil {
+		l = m.ReconciledAt.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	return n
+}
+
+func (m *ApplicationTree) Size() (n int) {
+	var l int
+	_ = l
+	if len(m.Nodes) > 0 {
+		for _, e := range m.Nodes {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -3376,6 +4914,19 @@ func (m *ApplicationWatchEvent) Size() (n int) {
 	return n
 }
 
+func (m *Backoff) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Duration)
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.Factor != nil {
+		n += 1 + sovGenerated(uint64(*m.Factor))
+	}
+	l = len(m.MaxDuration)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
 func (m *Cluster) Size() (n int) {
 	var l int
 	_ = l
@@ -3387,6 +4938,20 @@ func (m *Cluster) Size() (n int) {
 	n += 1 + l + sovGenerated(uint64(l))
 	l = m.ConnectionState.Size()
 	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.NormalizerProfiles) > 0 {
+		for _, s := range m.NormalizerProfiles {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	if len(m.Labels) > 0 {
+		for k, v := range m.Labels {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovGenerated(uint64(len(k))) + 1 + len(v) + sovGenerated(uint64(len(v)))
+			n += mapEntrySize + 1 + sovGenerated(uint64(mapEntrySize))
+		}
+	}
 	return n
 }
 
@@ -3405,6 +4970,10 @@ func (m *ClusterConfig) Size() (n int) {
 		l = m.AWSAuthConfig.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
+	if m.NetworkConfig != nil {
+		l = m.NetworkConfig.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
@@ -3422,6 +4991,16 @@ func (m *ClusterList) Size() (n int) {
 	return n
 }
 
+func (m *ClusterNetworkConfig) Size() (n int) {
+	var l int
+	_ = l
+	n += 1 + sovGenerated(uint64(m.DialTimeoutSeconds))
+	n += 1 + sovGenerated(uint64(m.RequestTimeoutSeconds))
+	n += 1 + sovGenerated(uint64(m.RetryLimit))
+	n += 1 + sovGenerated(uint64(m.RetryBackoffSeconds))
+	return n
+}
+
 func (m *ComparisonResult) Size() (n int) {
 	var l int
 	_ = l
@@ -3439,6 +5018,8 @@ func (m *ComparisonResult) Size() (n int) {
 			n += 1 + l + sovGenerated(uint64(l))
 		}
 	}
+	l = m.ComparedToDestination.Size()
+	n += 1 + l + sovGenerated(uint64(l))
 	return n
 }
 
@@ -3482,6 +5063,46 @@ func (m *DeploymentInfo) Size() (n int) {
 	l = m.DeployedAt.Size()
 	n += 1 + l + sovGenerated(uint64(l))
 	n += 1 + sovGenerated(uint64(m.ID))
+	l = len(m.ManifestsRef)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Preset)
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.Destination != nil {
+		l = m.Destination.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	l = len(m.IdempotencyKey)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
+func (m *DestinationOperationResult) Size() (n int) {
+	var l int
+	_ = l
+	l = m.Destination.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Phase)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Message)
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.SyncResult != nil {
+		l = m.SyncResult.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	return n
+}
+
+func (m *DestinationStatus) Size() (n int) {
+	var l int
+	_ = l
+	l = m.Destination.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Status)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.Health.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Message)
+	n += 1 + l + sovGenerated(uint64(l))
 	return n
 }
 
@@ -3492,6 +5113,10 @@ func (m *HealthStatus) Size() (n int) {
 	n += 1 + l + sovGenerated(uint64(l))
 	l = len(m.StatusDetails)
 	n += 1 + l + sovGenerated(uint64(l))
+	if m.ProgressingSince != nil {
+		l = m.ProgressingSince.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
@@ -3510,6 +5135,8 @@ func (m *HookStatus) Size() (n int) {
 	n += 1 + l + sovGenerated(uint64(l))
 	l = len(m.Message)
 	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Namespace)
+	n += 1 + l + sovGenerated(uint64(l))
 	return n
 }
 
@@ -3528,6 +5155,24 @@ func (m *Operation) Size() (n int) {
 		l = m.Sync.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
+	l = len(m.CorrelationID)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Timeout)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.IdempotencyKey)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
+func (m *OperationAttempt) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Phase)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Message)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.FinishedAt.Size()
+	n += 1 + l + sovGenerated(uint64(l))
 	return n
 }
 
@@ -3550,6 +5195,46 @@ func (m *OperationState) Size() (n int) {
 		l = m.FinishedAt.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
+	if len(m.Attempts) > 0 {
+		for _, e := range m.Attempts {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	if len(m.DestinationResults) > 0 {
+		for _, e := range m.DestinationResults {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	if m.StateChangedAt != nil {
+		l = m.StateChangedAt.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	n += 2
+	return n
+}
+
+func (m *OrphanedResource) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Group)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Kind)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Namespace)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Name)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
+func (m *OrphanedResourcesMonitorSettings) Size() (n int) {
+	var l int
+	_ = l
+	if m.Warn != nil {
+		n += 2
+	}
 	return n
 }
 
@@ -3565,14 +5250,28 @@ func (m ParameterOverrides) Size() (n int) {
 	return n
 }
 
-func (m *ProjectRole) Size() (n int) {
+func (m *ParameterPreset) Size() (n int) {
 	var l int
 	_ = l
 	l = len(m.Name)
 	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.Description)
-	n += 1 + l + sovGenerated(uint64(l))
-	if len(m.Policies) > 0 {
+	if len(m.Parameters) > 0 {
+		for _, e := range m.Parameters {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ProjectRole) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Name)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Description)
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Policies) > 0 {
 		for _, s := range m.Policies {
 			l = len(s)
 			n += 1 + l + sovGenerated(uint64(l))
@@ -3606,6 +5305,8 @@ func (m *Repository) Size() (n int) {
 	n += 1 + l + sovGenerated(uint64(l))
 	l = m.ConnectionState.Size()
 	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Type)
+	n += 1 + l + sovGenerated(uint64(l))
 	return n
 }
 
@@ -3636,6 +5337,30 @@ func (m *ResourceDetails) Size() (n int) {
 	n += 1 + l + sovGenerated(uint64(l))
 	l = len(m.Status)
 	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Action)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Diff)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.GenerateName)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
+func (m *ResourceIgnoreDifferences) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Group)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Kind)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Name)
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.JSONPointers) > 0 {
+		for _, s := range m.JSONPointers {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -3653,6 +5378,24 @@ func (m *ResourceNode) Size() (n int) {
 	return n
 }
 
+func (m *ResourceRef) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Group)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Version)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Kind)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Namespace)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Name)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.UID)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
 func (m *ResourceState) Size() (n int) {
 	var l int
 	_ = l
@@ -3688,6 +5431,40 @@ func (m *ResourceSummary) Size() (n int) {
 	n += 1 + l + sovGenerated(uint64(l))
 	l = m.Health.Size()
 	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Namespace)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
+func (m *ResourceTreeNode) Size() (n int) {
+	var l int
+	_ = l
+	l = m.ResourceRef.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.ParentRefs) > 0 {
+		for _, e := range m.ParentRefs {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	l = len(m.ResourceVersion)
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.Health != nil {
+		l = m.Health.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	return n
+}
+
+func (m *RetryStrategy) Size() (n int) {
+	var l int
+	_ = l
+	n += 1 + sovGenerated(uint64(m.Limit))
+	if m.Backoff != nil {
+		l = m.Backoff.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	n += 2
 	return n
 }
 
@@ -3712,6 +5489,31 @@ func (m *SyncOperation) Size() (n int) {
 			n += 1 + l + sovGenerated(uint64(l))
 		}
 	}
+	n += 2
+	l = len(m.PrunePropagationPolicy)
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.Retry != nil {
+		l = m.Retry.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	n += 1 + sovGenerated(uint64(m.ApplyConcurrency))
+	if len(m.ExcludedResources) > 0 {
+		for _, e := range m.ExcludedResources {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	l = len(m.Preset)
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.MoveFrom != nil {
+		l = m.MoveFrom.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.TerminationGracePeriodSeconds != nil {
+		n += 1 + sovGenerated(uint64(*m.TerminationGracePeriodSeconds))
+	}
+	n += 2
+	n += 2 + sovGenerated(uint64(m.SelfHealAttemptsCount))
 	return n
 }
 
@@ -3744,6 +5546,30 @@ func (m *SyncOperationResult) Size() (n int) {
 			n += 1 + l + sovGenerated(uint64(l))
 		}
 	}
+	l = len(m.Summary)
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.MovedResources) > 0 {
+		for _, e := range m.MovedResources {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	if len(m.DeniedReasons) > 0 {
+		for _, s := range m.DeniedReasons {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	if len(m.FailedResources) > 0 {
+		for _, e := range m.FailedResources {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	if m.HealthWaitStartedAt != nil {
+		l = m.HealthWaitStartedAt.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
@@ -3754,6 +5580,16 @@ func (m *SyncPolicy) Size() (n int) {
 		l = m.Automated.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
+	if len(m.SyncOptions) > 0 {
+		for _, s := range m.SyncOptions {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	if m.Retry != nil {
+		l = m.Retry.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
@@ -3761,6 +5597,7 @@ func (m *SyncPolicyAutomated) Size() (n int) {
 	var l int
 	_ = l
 	n += 2
+	n += 2
 	return n
 }
 
@@ -3775,6 +5612,10 @@ func (m *SyncStrategy) Size() (n int) {
 		l = m.Hook.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
+	if m.Progressive != nil {
+		l = m.Progressive.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
@@ -3790,6 +5631,35 @@ func (m *SyncStrategyHook) Size() (n int) {
 	_ = l
 	l = m.SyncStrategyApply.Size()
 	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.HealthTimeout)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
+func (m *SyncStrategyProgressive) Size() (n int) {
+	var l int
+	_ = l
+	l = m.SyncStrategyApply.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	n += 1 + sovGenerated(uint64(m.BatchSize))
+	return n
+}
+
+func (m *SyncWindow) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Kind)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Schedule)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Duration)
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Applications) > 0 {
+		for _, s := range m.Applications {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -3871,6 +5741,9 @@ func (this *AppProjectSpec) String() string {
 		`Roles:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Roles), "ProjectRole", "ProjectRole", 1), `&`, ``, 1) + `,`,
 		`ClusterResourceWhitelist:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ClusterResourceWhitelist), "GroupKind", "v1.GroupKind", 1), `&`, ``, 1) + `,`,
 		`NamespaceResourceBlacklist:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.NamespaceResourceBlacklist), "GroupKind", "v1.GroupKind", 1), `&`, ``, 1) + `,`,
+		`ParameterPresets:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ParameterPresets), "ParameterPreset", "ParameterPreset", 1), `&`, ``, 1) + `,`,
+		`SyncWindows:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.SyncWindows), "SyncWindow", "SyncWindow", 1), `&`, ``, 1) + `,`,
+		`OrphanedResources:` + strings.Replace(fmt.Sprintf("%v", this.OrphanedResources), "OrphanedResourcesMonitorSettings", "OrphanedResourcesMonitorSettings", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -3906,6 +5779,7 @@ func (this *ApplicationDestination) String() string {
 	s := strings.Join([]string{`&ApplicationDestination{`,
 		`Server:` + fmt.Sprintf("%v", this.Server) + `,`,
 		`Namespace:` + fmt.Sprintf("%v", this.Namespace) + `,`,
+		`Selector:` + fmt.Sprintf("%v", this.Selector) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -3935,6 +5809,7 @@ func (this *ApplicationSource) String() string {
 		`Helm:` + strings.Replace(fmt.Sprintf("%v", this.Helm), "ApplicationSourceHelm", "ApplicationSourceHelm", 1) + `,`,
 		`Kustomize:` + strings.Replace(fmt.Sprintf("%v", this.Kustomize), "ApplicationSourceKustomize", "ApplicationSourceKustomize", 1) + `,`,
 		`Ksonnet:` + strings.Replace(fmt.Sprintf("%v", this.Ksonnet), "ApplicationSourceKsonnet", "ApplicationSourceKsonnet", 1) + `,`,
+		`Chart:` + fmt.Sprintf("%v", this.Chart) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -3946,6 +5821,7 @@ func (this *ApplicationSourceHelm) String() string {
 	s := strings.Join([]string{`&ApplicationSourceHelm{`,
 		`ReleaseName:` + fmt.Sprintf("%v", this.ReleaseName) + `,`,
 		`ValueFiles:` + fmt.Sprintf("%v", this.ValueFiles) + `,`,
+		`ValuesSchemaFile:` + fmt.Sprintf("%v", this.ValuesSchemaFile) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -3979,6 +5855,10 @@ func (this *ApplicationSpec) String() string {
 		`Destination:` + strings.Replace(strings.Replace(this.Destination.String(), "ApplicationDestination", "ApplicationDestination", 1), `&`, ``, 1) + `,`,
 		`Project:` + fmt.Sprintf("%v", this.Project) + `,`,
 		`SyncPolicy:` + strings.Replace(fmt.Sprintf("%v", this.SyncPolicy), "SyncPolicy", "SyncPolicy", 1) + `,`,
+		`AdditionalDestinations:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.AdditionalDestinations), "ApplicationDestination", "ApplicationDestination", 1), `&`, ``, 1) + `,`,
+		`ParameterPresets:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ParameterPresets), "ParameterPreset", "ParameterPreset", 1), `&`, ``, 1) + `,`,
+		`IgnoreDifferences:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.IgnoreDifferences), "ResourceIgnoreDifferences", "ResourceIgnoreDifferences", 1), `&`, ``, 1) + `,`,
+		`ProgressingDeadline:` + fmt.Sprintf("%v", this.ProgressingDeadline) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -3994,6 +5874,20 @@ func (this *ApplicationStatus) String() string {
 		`Health:` + strings.Replace(strings.Replace(this.Health.String(), "HealthStatus", "HealthStatus", 1), `&`, ``, 1) + `,`,
 		`OperationState:` + strings.Replace(fmt.Sprintf("%v", this.OperationState), "OperationState", "OperationState", 1) + `,`,
 		`Conditions:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Conditions), "ApplicationCondition", "ApplicationCondition", 1), `&`, ``, 1) + `,`,
+		`Destinations:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Destinations), "DestinationStatus", "DestinationStatus", 1), `&`, ``, 1) + `,`,
+		`OrphanedResources:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.OrphanedResources), "OrphanedResource", "OrphanedResource", 1), `&`, ``, 1) + `,`,
+		`OperationHistory:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.OperationHistory), "OperationState", "OperationState", 1), `&`, ``, 1) + `,`,
+		`ReconciledAt:` + strings.Replace(fmt.Sprintf("%v", this.ReconciledAt), "Time", "v1.Time", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ApplicationTree) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ApplicationTree{`,
+		`Nodes:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Nodes), "ResourceTreeNode", "ResourceTreeNode", 1), `&`, ``, 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -4009,15 +5903,39 @@ func (this *ApplicationWatchEvent) String() string {
 	}, "")
 	return s
 }
+func (this *Backoff) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&Backoff{`,
+		`Duration:` + fmt.Sprintf("%v", this.Duration) + `,`,
+		`Factor:` + valueToStringGenerated(this.Factor) + `,`,
+		`MaxDuration:` + fmt.Sprintf("%v", this.MaxDuration) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *Cluster) String() string {
 	if this == nil {
 		return "nil"
 	}
+	keysForLabels := make([]string, 0, len(this.Labels))
+	for k := range this.Labels {
+		keysForLabels = append(keysForLabels, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForLabels)
+	mapStringForLabels := "map[string]string{"
+	for _, k := range keysForLabels {
+		mapStringForLabels += fmt.Sprintf("%v: %v,", k, this.Labels[k])
+	}
+	mapStringForLabels += "}"
 	s := strings.Join([]string{`&Cluster{`,
 		`Server:` + fmt.Sprintf("%v", this.Server) + `,`,
 		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
 		`Config:` + strings.Replace(strings.Replace(this.Config.String(), "ClusterConfig", "ClusterConfig", 1), `&`, ``, 1) + `,`,
 		`ConnectionState:` + strings.Replace(strings.Replace(this.ConnectionState.String(), "ConnectionState", "ConnectionState", 1), `&`, ``, 1) + `,`,
+		`NormalizerProfiles:` + fmt.Sprintf("%v", this.NormalizerProfiles) + `,`,
+		`Labels:` + mapStringForLabels + `,`,
 		`}`,
 	}, "")
 	return s
@@ -4032,6 +5950,7 @@ func (this *ClusterConfig) String() string {
 		`BearerToken:` + fmt.Sprintf("%v", this.BearerToken) + `,`,
 		`TLSClientConfig:` + strings.Replace(strings.Replace(this.TLSClientConfig.String(), "TLSClientConfig", "TLSClientConfig", 1), `&`, ``, 1) + `,`,
 		`AWSAuthConfig:` + strings.Replace(fmt.Sprintf("%v", this.AWSAuthConfig), "AWSAuthConfig", "AWSAuthConfig", 1) + `,`,
+		`NetworkConfig:` + strings.Replace(fmt.Sprintf("%v", this.NetworkConfig), "ClusterNetworkConfig", "ClusterNetworkConfig", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -4047,6 +5966,19 @@ func (this *ClusterList) String() string {
 	}, "")
 	return s
 }
+func (this *ClusterNetworkConfig) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ClusterNetworkConfig{`,
+		`DialTimeoutSeconds:` + fmt.Sprintf("%v", this.DialTimeoutSeconds) + `,`,
+		`RequestTimeoutSeconds:` + fmt.Sprintf("%v", this.RequestTimeoutSeconds) + `,`,
+		`RetryLimit:` + fmt.Sprintf("%v", this.RetryLimit) + `,`,
+		`RetryBackoffSeconds:` + fmt.Sprintf("%v", this.RetryBackoffSeconds) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *ComparisonResult) String() string {
 	if this == nil {
 		return "nil"
@@ -4057,6 +5989,7 @@ func (this *ComparisonResult) String() string {
 		`Status:` + fmt.Sprintf("%v", this.Status) + `,`,
 		`Revision:` + fmt.Sprintf("%v", this.Revision) + `,`,
 		`Resources:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Resources), "ResourceSummary", "ResourceSummary", 1), `&`, ``, 1) + `,`,
+		`ComparedToDestination:` + strings.Replace(strings.Replace(this.ComparedToDestination.String(), "ApplicationDestination", "ApplicationDestination", 1), `&`, ``, 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -4094,6 +6027,36 @@ func (this *DeploymentInfo) String() string {
 		`ComponentParameterOverrides:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ComponentParameterOverrides), "ComponentParameter", "ComponentParameter", 1), `&`, ``, 1) + `,`,
 		`DeployedAt:` + strings.Replace(strings.Replace(this.DeployedAt.String(), "Time", "v1.Time", 1), `&`, ``, 1) + `,`,
 		`ID:` + fmt.Sprintf("%v", this.ID) + `,`,
+		`ManifestsRef:` + fmt.Sprintf("%v", this.ManifestsRef) + `,`,
+		`Preset:` + fmt.Sprintf("%v", this.Preset) + `,`,
+		`Destination:` + strings.Replace(fmt.Sprintf("%v", this.Destination), "ApplicationDestination", "ApplicationDestination", 1) + `,`,
+		`IdempotencyKey:` + fmt.Sprintf("%v", this.IdempotencyKey) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *DestinationOperationResult) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&DestinationOperationResult{`,
+		`Destination:` + strings.Replace(strings.Replace(this.Destination.String(), "ApplicationDestination", "ApplicationDestination", 1), `&`, ``, 1) + `,`,
+		`Phase:` + fmt.Sprintf("%v", this.Phase) + `,`,
+		`Message:` + fmt.Sprintf("%v", this.Message) + `,`,
+		`SyncResult:` + strings.Replace(fmt.Sprintf("%v", this.SyncResult), "SyncOperationResult", "SyncOperationResult", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *DestinationStatus) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&DestinationStatus{`,
+		`Destination:` + strings.Replace(strings.Replace(this.Destination.String(), "ApplicationDestination", "ApplicationDestination", 1), `&`, ``, 1) + `,`,
+		`Status:` + fmt.Sprintf("%v", this.Status) + `,`,
+		`Health:` + strings.Replace(strings.Replace(this.Health.String(), "HealthStatus", "HealthStatus", 1), `&`, ``, 1) + `,`,
+		`Message:` + fmt.Sprintf("%v", this.Message) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -4105,6 +6068,7 @@ func (this *HealthStatus) String() string {
 	s := strings.Join([]string{`&HealthStatus{`,
 		`Status:` + fmt.Sprintf("%v", this.Status) + `,`,
 		`StatusDetails:` + fmt.Sprintf("%v", this.StatusDetails) + `,`,
+		`ProgressingSince:` + strings.Replace(fmt.Sprintf("%v", this.ProgressingSince), "Time", "v1.Time", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -4120,6 +6084,7 @@ func (this *HookStatus) String() string {
 		`Type:` + fmt.Sprintf("%v", this.Type) + `,`,
 		`Status:` + fmt.Sprintf("%v", this.Status) + `,`,
 		`Message:` + fmt.Sprintf("%v", this.Message) + `,`,
+		`Namespace:` + fmt.Sprintf("%v", this.Namespace) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -4141,6 +6106,21 @@ func (this *Operation) String() string {
 	}
 	s := strings.Join([]string{`&Operation{`,
 		`Sync:` + strings.Replace(fmt.Sprintf("%v", this.Sync), "SyncOperation", "SyncOperation", 1) + `,`,
+		`CorrelationID:` + fmt.Sprintf("%v", this.CorrelationID) + `,`,
+		`Timeout:` + fmt.Sprintf("%v", this.Timeout) + `,`,
+		`IdempotencyKey:` + fmt.Sprintf("%v", this.IdempotencyKey) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *OperationAttempt) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&OperationAttempt{`,
+		`Phase:` + fmt.Sprintf("%v", this.Phase) + `,`,
+		`Message:` + fmt.Sprintf("%v", this.Message) + `,`,
+		`FinishedAt:` + strings.Replace(strings.Replace(this.FinishedAt.String(), "Time", "v1.Time", 1), `&`, ``, 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -4156,6 +6136,44 @@ func (this *OperationState) String() string {
 		`SyncResult:` + strings.Replace(fmt.Sprintf("%v", this.SyncResult), "SyncOperationResult", "SyncOperationResult", 1) + `,`,
 		`StartedAt:` + strings.Replace(strings.Replace(this.StartedAt.String(), "Time", "v1.Time", 1), `&`, ``, 1) + `,`,
 		`FinishedAt:` + strings.Replace(fmt.Sprintf("%v", this.FinishedAt), "Time", "v1.Time", 1) + `,`,
+		`Attempts:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Attempts), "OperationAttempt", "OperationAttempt", 1), `&`, ``, 1) + `,`,
+		`DestinationResults:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.DestinationResults), "DestinationOperationResult", "DestinationOperationResult", 1), `&`, ``, 1) + `,`,
+		`StateChangedAt:` + strings.Replace(fmt.Sprintf("%v", this.StateChangedAt), "Time", "v1.Time", 1) + `,`,
+		`Terminated:` + fmt.Sprintf("%v", this.Terminated) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *OrphanedResource) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&OrphanedResource{`,
+		`Group:` + fmt.Sprintf("%v", this.Group) + `,`,
+		`Kind:` + fmt.Sprintf("%v", this.Kind) + `,`,
+		`Namespace:` + fmt.Sprintf("%v", this.Namespace) + `,`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *OrphanedResourcesMonitorSettings) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&OrphanedResourcesMonitorSettings{`,
+		`Warn:` + valueToStringGenerated(this.Warn) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ParameterPreset) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ParameterPreset{`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`Parameters:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Parameters), "ComponentParameter", "ComponentParameter", 1), `&`, ``, 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -4184,6 +6202,7 @@ func (this *Repository) String() string {
 		`Password:` + fmt.Sprintf("%v", this.Password) + `,`,
 		`SSHPrivateKey:` + fmt.Sprintf("%v", this.SSHPrivateKey) + `,`,
 		`ConnectionState:` + strings.Replace(strings.Replace(this.ConnectionState.String(), "ConnectionState", "ConnectionState", 1), `&`, ``, 1) + `,`,
+		`Type:` + fmt.Sprintf("%v", this.Type) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -4209,6 +6228,21 @@ func (this *ResourceDetails) String() string {
 		`Namespace:` + fmt.Sprintf("%v", this.Namespace) + `,`,
 		`Message:` + fmt.Sprintf("%v", this.Message) + `,`,
 		`Status:` + fmt.Sprintf("%v", this.Status) + `,`,
+		`Action:` + fmt.Sprintf("%v", this.Action) + `,`,
+		`Diff:` + fmt.Sprintf("%v", this.Diff) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ResourceIgnoreDifferences) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ResourceIgnoreDifferences{`,
+		`Group:` + fmt.Sprintf("%v", this.Group) + `,`,
+		`Kind:` + fmt.Sprintf("%v", this.Kind) + `,`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`JSONPointers:` + fmt.Sprintf("%v", this.JSONPointers) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -4224,6 +6258,21 @@ func (this *ResourceNode) String() string {
 	}, "")
 	return s
 }
+func (this *ResourceRef) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ResourceRef{`,
+		`Group:` + fmt.Sprintf("%v", this.Group) + `,`,
+		`Version:` + fmt.Sprintf("%v", this.Version) + `,`,
+		`Kind:` + fmt.Sprintf("%v", this.Kind) + `,`,
+		`Namespace:` + fmt.Sprintf("%v", this.Namespace) + `,`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`UID:` + fmt.Sprintf("%v", this.UID) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *ResourceState) String() string {
 	if this == nil {
 		return "nil"
@@ -4249,6 +6298,32 @@ func (this *ResourceSummary) String() string {
 		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
 		`Status:` + fmt.Sprintf("%v", this.Status) + `,`,
 		`Health:` + strings.Replace(strings.Replace(this.Health.String(), "HealthStatus", "HealthStatus", 1), `&`, ``, 1) + `,`,
+		`Namespace:` + fmt.Sprintf("%v", this.Namespace) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ResourceTreeNode) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ResourceTreeNode{`,
+		`ResourceRef:` + strings.Replace(strings.Replace(this.ResourceRef.String(), "ResourceRef", "ResourceRef", 1), `&`, ``, 1) + `,`,
+		`ParentRefs:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ParentRefs), "ResourceRef", "ResourceRef", 1), `&`, ``, 1) + `,`,
+		`ResourceVersion:` + fmt.Sprintf("%v", this.ResourceVersion) + `,`,
+		`Health:` + strings.Replace(fmt.Sprintf("%v", this.Health), "HealthStatus", "HealthStatus", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *RetryStrategy) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&RetryStrategy{`,
+		`Limit:` + fmt.Sprintf("%v", this.Limit) + `,`,
+		`Backoff:` + strings.Replace(fmt.Sprintf("%v", this.Backoff), "Backoff", "Backoff", 1) + `,`,
+		`FailedOnly:` + fmt.Sprintf("%v", this.FailedOnly) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -4264,6 +6339,16 @@ func (this *SyncOperation) String() string {
 		`SyncStrategy:` + strings.Replace(fmt.Sprintf("%v", this.SyncStrategy), "SyncStrategy", "SyncStrategy", 1) + `,`,
 		`ParameterOverrides:` + strings.Replace(fmt.Sprintf("%v", this.ParameterOverrides), "ParameterOverrides", "ParameterOverrides", 1) + `,`,
 		`Resources:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Resources), "SyncOperationResource", "SyncOperationResource", 1), `&`, ``, 1) + `,`,
+		`ConfirmCRDDeletion:` + fmt.Sprintf("%v", this.ConfirmCRDDeletion) + `,`,
+		`PrunePropagationPolicy:` + fmt.Sprintf("%v", this.PrunePropagationPolicy) + `,`,
+		`Retry:` + strings.Replace(fmt.Sprintf("%v", this.Retry), "RetryStrategy", "RetryStrategy", 1) + `,`,
+		`ApplyConcurrency:` + fmt.Sprintf("%v", this.ApplyConcurrency) + `,`,
+		`ExcludedResources:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ExcludedResources), "SyncOperationResource", "SyncOperationResource", 1), `&`, ``, 1) + `,`,
+		`Preset:` + fmt.Sprintf("%v", this.Preset) + `,`,
+		`MoveFrom:` + strings.Replace(fmt.Sprintf("%v", this.MoveFrom), "ApplicationDestination", "ApplicationDestination", 1) + `,`,
+		`TerminationGracePeriodSeconds:` + valueToStringGenerated(this.TerminationGracePeriodSeconds) + `,`,
+		`OverrideSyncWindows:` + fmt.Sprintf("%v", this.OverrideSyncWindows) + `,`,
+		`SelfHealAttemptsCount:` + fmt.Sprintf("%v", this.SelfHealAttemptsCount) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -4288,6 +6373,11 @@ func (this *SyncOperationResult) String() string {
 		`Resources:` + strings.Replace(fmt.Sprintf("%v", this.Resources), "ResourceDetails", "ResourceDetails", 1) + `,`,
 		`Revision:` + fmt.Sprintf("%v", this.Revision) + `,`,
 		`Hooks:` + strings.Replace(fmt.Sprintf("%v", this.Hooks), "HookStatus", "HookStatus", 1) + `,`,
+		`Summary:` + fmt.Sprintf("%v", this.Summary) + `,`,
+		`MovedResources:` + strings.Replace(fmt.Sprintf("%v", this.MovedResources), "ResourceDetails", "ResourceDetails", 1) + `,`,
+		`DeniedReasons:` + fmt.Sprintf("%v", this.DeniedReasons) + `,`,
+		`FailedResources:` + strings.Replace(fmt.Sprintf("%v", this.FailedResources), "ResourceDetails", "ResourceDetails", 1) + `,`,
+		`HealthWaitStartedAt:` + strings.Replace(fmt.Sprintf("%v", this.HealthWaitStartedAt), "Time", "Time", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -4298,6 +6388,8 @@ func (this *SyncPolicy) String() string {
 	}
 	s := strings.Join([]string{`&SyncPolicy{`,
 		`Automated:` + strings.Replace(fmt.Sprintf("%v", this.Automated), "SyncPolicyAutomated", "SyncPolicyAutomated", 1) + `,`,
+		`SyncOptions:` + fmt.Sprintf("%v", this.SyncOptions) + `,`,
+		`Retry:` + strings.Replace(fmt.Sprintf("%v", this.Retry), "RetryStrategy", "RetryStrategy", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -4308,6 +6400,7 @@ func (this *SyncPolicyAutomated) String() string {
 	}
 	s := strings.Join([]string{`&SyncPolicyAutomated{`,
 		`Prune:` + fmt.Sprintf("%v", this.Prune) + `,`,
+		`SelfHeal:` + fmt.Sprintf("%v", this.SelfHeal) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -4319,6 +6412,7 @@ func (this *SyncStrategy) String() string {
 	s := strings.Join([]string{`&SyncStrategy{`,
 		`Apply:` + strings.Replace(fmt.Sprintf("%v", this.Apply), "SyncStrategyApply", "SyncStrategyApply", 1) + `,`,
 		`Hook:` + strings.Replace(fmt.Sprintf("%v", this.Hook), "SyncStrategyHook", "SyncStrategyHook", 1) + `,`,
+		`Progressive:` + strings.Replace(fmt.Sprintf("%v", this.Progressive), "SyncStrategyProgressive", "SyncStrategyProgressive", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -4339,15 +6433,40 @@ func (this *SyncStrategyHook) String() string {
 	}
 	s := strings.Join([]string{`&SyncStrategyHook{`,
 		`SyncStrategyApply:` + strings.Replace(strings.Replace(this.SyncStrategyApply.String(), "SyncStrategyApply", "SyncStrategyApply", 1), `&`, ``, 1) + `,`,
+		`HealthTimeout:` + fmt.Sprintf("%v", this.HealthTimeout) + `,`,
 		`}`,
 	}, "")
 	return s
 }
-func (this *TLSClientConfig) String() string {
+func (this *SyncStrategyProgressive) String() string {
 	if this == nil {
 		return "nil"
 	}
-	s := strings.Join([]string{`&TLSClientConfig{`,
+	s := strings.Join([]string{`&SyncStrategyProgressive{`,
+		`SyncStrategyApply:` + strings.Replace(strings.Replace(this.SyncStrategyApply.String(), "SyncStrategyApply", "SyncStrategyApply", 1), `&`, ``, 1) + `,`,
+		`BatchSize:` + fmt.Sprintf("%v", this.BatchSize) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *SyncWindow) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&SyncWindow{`,
+		`Kind:` + fmt.Sprintf("%v", this.Kind) + `,`,
+		`Schedule:` + fmt.Sprintf("%v", this.Schedule) + `,`,
+		`Duration:` + fmt.Sprintf("%v", this.Duration) + `,`,
+		`Applications:` + fmt.Sprintf("%v", this.Applications) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *TLSClientConfig) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&TLSClientConfig{`,
 		`Insecure:` + fmt.Sprintf("%v", this.Insecure) + `,`,
 		`ServerName:` + fmt.Sprintf("%v", this.ServerName) + `,`,
 		`CertData:` + valueToStringGenerated(this.CertData) + `,`,
@@ -4905,6 +7024,101 @@ func (m *AppProjectSpec) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ParameterPresets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ParameterPresets = append(m.ParameterPresets, ParameterPreset{})
+			if err := m.ParameterPresets[len(m.ParameterPresets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SyncWindows", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SyncWindows = append(m.SyncWindows, SyncWindow{})
+			if err := m.SyncWindows[len(m.SyncWindows)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrphanedResources", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.OrphanedResources == nil {
+				m.OrphanedResources = &OrphanedResourcesMonitorSettings{}
+			}
+			if err := m.OrphanedResources.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -5294,6 +7508,35 @@ func (m *ApplicationDestination) Unmarshal(dAtA []byte) error {
 			}
 			m.Namespace = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Selector", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Selector = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -5730,6 +7973,35 @@ func (m *ApplicationSource) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Chart", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Chart = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -5838,6 +8110,35 @@ func (m *ApplicationSourceHelm) Unmarshal(dAtA []byte) error {
 			}
 			m.ValueFiles = append(m.ValueFiles, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValuesSchemaFile", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValuesSchemaFile = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -6030,25 +8331,3850 @@ func (m *ApplicationSpec) Unmarshal(dAtA []byte) error {
 			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ApplicationSpec: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ApplicationSpec: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Source.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Destination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Destination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Project", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Project = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SyncPolicy", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SyncPolicy == nil {
+				m.SyncPolicy = &SyncPolicy{}
+			}
+			if err := m.SyncPolicy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AdditionalDestinations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AdditionalDestinations = append(m.AdditionalDestinations, ApplicationDestination{})
+			if err := m.AdditionalDestinations[len(m.AdditionalDestinations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ParameterPresets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ParameterPresets = append(m.ParameterPresets, ParameterPreset{})
+			if err := m.ParameterPresets[len(m.ParameterPresets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IgnoreDifferences", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IgnoreDifferences = append(m.IgnoreDifferences, ResourceIgnoreDifferences{})
+			if err := m.IgnoreDifferences[len(m.IgnoreDifferences)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProgressingDeadline", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProgressingDeadline = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ApplicationStatus) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ApplicationStatus: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ApplicationStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ComparisonResult", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ComparisonResult.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field History", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.History = append(m.History, DeploymentInfo{})
+			if err := m.History[len(m.History)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Parameters", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Parameters = append(m.Parameters, ComponentParameter{})
+			if err := m.Parameters[len(m.Parameters)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Health", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Health.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OperationState", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.OperationState == nil {
+				m.OperationState = &OperationState{}
+			}
+			if err := m.OperationState.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Conditions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Conditions = append(m.Conditions, ApplicationCondition{})
+			if err := m.Conditions[len(m.Conditions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Destinations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Destinations = append(m.Destinations, DestinationStatus{})
+			if err := m.Destinations[len(m.Destinations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrphanedResources", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OrphanedResources = append(m.OrphanedResources, OrphanedResource{})
+			if err := m.OrphanedResources[len(m.OrphanedResources)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OperationHistory", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OperationHistory = append(m.OperationHistory, OperationState{})
+			if err := m.OperationHistory[len(m.OperationHistory)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReconciledAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ReconciledAt == nil {
+				m.ReconciledAt = &k8s_io_apimachinery_pkg_apis_meta_v1.Time{}
+			}
+			if err := m.ReconciledAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ApplicationTree) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ApplicationTree: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ApplicationTree: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nodes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Nodes = append(m.Nodes, ResourceTreeNode{})
+			if err := m.Nodes[len(m.Nodes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ApplicationWatchEvent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ApplicationWatchEvent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ApplicationWatchEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Type = k8s_io_apimachinery_pkg_watch.EventType(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Application", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Application.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Backoff) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Backoff: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Backoff: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Duration = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Factor", wireType)
+			}
+			var v int64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Factor = &v
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxDuration", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MaxDuration = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Cluster) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Cluster: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Cluster: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Server", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Server = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Config", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Config.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionState", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ConnectionState.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NormalizerProfiles", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NormalizerProfiles = append(m.NormalizerProfiles, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Labels", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Labels == nil {
+				m.Labels = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= (uint64(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= (uint64(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Labels[mapkey] = mapvalue
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ClusterConfig) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ClusterConfig: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ClusterConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Username", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Username = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Password", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Password = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BearerToken", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BearerToken = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TLSClientConfig", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TLSClientConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AWSAuthConfig", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.AWSAuthConfig == nil {
+				m.AWSAuthConfig = &AWSAuthConfig{}
+			}
+			if err := m.AWSAuthConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NetworkConfig", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.NetworkConfig == nil {
+				m.NetworkConfig = &ClusterNetworkConfig{}
+			}
+			if err := m.NetworkConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ClusterList) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ClusterList: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ClusterList: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ListMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Items = append(m.Items, Cluster{})
+			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ClusterNetworkConfig) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ClusterNetworkConfig: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ClusterNetworkConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DialTimeoutSeconds", wireType)
+			}
+			m.DialTimeoutSeconds = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DialTimeoutSeconds |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestTimeoutSeconds", wireType)
+			}
+			m.RequestTimeoutSeconds = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RequestTimeoutSeconds |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RetryLimit", wireType)
+			}
+			m.RetryLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RetryLimit |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RetryBackoffSeconds", wireType)
+			}
+			m.RetryBackoffSeconds = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RetryBackoffSeconds |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ComparisonResult) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ComparisonResult: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ComparisonResult: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ComparedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ComparedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ComparedTo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ComparedTo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Status = ComparisonStatus(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Revision", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Revision = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Resources", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Resources = append(m.Resources, ResourceSummary{})
+			if err := m.Resources[len(m.Resources)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ComparedToDestination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ComparedToDestination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ComponentParameter) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ComponentParameter: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ComponentParameter: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Component", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Component = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ConnectionState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ConnectionState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ConnectionState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Status = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Message = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ModifiedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ModifiedAt == nil {
+				m.ModifiedAt = &v1.Time{}
+			}
+			if err := m.ModifiedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DeploymentInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DeploymentInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DeploymentInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Revision", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Revision = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ComponentParameterOverrides", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ComponentParameterOverrides = append(m.ComponentParameterOverrides, ComponentParameter{})
+			if err := m.ComponentParameterOverrides[len(m.ComponentParameterOverrides)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeployedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DeployedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			m.ID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ID |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ManifestsRef", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ManifestsRef = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Preset", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Preset = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Destination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Destination == nil {
+				m.Destination = &ApplicationDestination{}
+			}
+			if err := m.Destination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IdempotencyKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IdempotencyKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DestinationOperationResult) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DestinationOperationResult: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DestinationOperationResult: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Destination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Destination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Phase", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Phase = OperationPhase(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Message = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SyncResult", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SyncResult == nil {
+				m.SyncResult = &SyncOperationResult{}
+			}
+			if err := m.SyncResult.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DestinationStatus) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DestinationStatus: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DestinationStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Destination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Destination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Status = ComparisonStatus(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Health", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Health.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Message = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *HealthStatus) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HealthStatus: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HealthStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Status = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StatusDetails", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StatusDetails = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProgressingSince", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ProgressingSince == nil {
+				m.ProgressingSince = &k8s_io_apimachinery_pkg_apis_meta_v1.Time{}
+			}
+			if err := m.ProgressingSince.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *HookStatus) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HookStatus: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HookStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Kind = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field APIVersion", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.APIVersion = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Type = HookType(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Status = OperationPhase(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Message = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Namespace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *JWTToken) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: JWTToken: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: JWTToken: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IssuedAt", wireType)
+			}
+			m.IssuedAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.IssuedAt |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiresAt", wireType)
+			}
+			m.ExpiresAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpiresAt |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Operation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Operation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Operation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sync", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Sync == nil {
+				m.Sync = &SyncOperation{}
+			}
+			if err := m.Sync.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CorrelationID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CorrelationID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timeout", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Timeout = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IdempotencyKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IdempotencyKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OperationAttempt) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OperationAttempt: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OperationAttempt: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Phase", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Phase = OperationPhase(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Message = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FinishedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FinishedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OperationState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OperationState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OperationState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Operation", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Operation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Phase", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Phase = OperationPhase(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Message = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SyncResult", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SyncResult == nil {
+				m.SyncResult = &SyncOperationResult{}
+			}
+			if err := m.SyncResult.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.StartedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ApplicationSpec: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ApplicationSpec: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FinishedAt", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6072,13 +12198,16 @@ func (m *ApplicationSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Source.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.FinishedAt == nil {
+				m.FinishedAt = &v1.Time{}
+			}
+			if err := m.FinishedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Destination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Attempts", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6102,15 +12231,16 @@ func (m *ApplicationSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Destination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Attempts = append(m.Attempts, OperationAttempt{})
+			if err := m.Attempts[len(m.Attempts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Project", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DestinationResults", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -6120,24 +12250,26 @@ func (m *ApplicationSpec) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Project = string(dAtA[iNdEx:postIndex])
+			m.DestinationResults = append(m.DestinationResults, DestinationOperationResult{})
+			if err := m.DestinationResults[len(m.DestinationResults)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 4:
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SyncPolicy", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StateChangedAt", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6161,13 +12293,33 @@ func (m *ApplicationSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.SyncPolicy == nil {
-				m.SyncPolicy = &SyncPolicy{}
+			if m.StateChangedAt == nil {
+				m.StateChangedAt = &k8s_io_apimachinery_pkg_apis_meta_v1.Time{}
 			}
-			if err := m.SyncPolicy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.StateChangedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Terminated", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Terminated = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -6189,7 +12341,7 @@ func (m *ApplicationSpec) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ApplicationStatus) Unmarshal(dAtA []byte) error {
+func (m *OrphanedResource) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6212,17 +12364,17 @@ func (m *ApplicationStatus) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ApplicationStatus: wiretype end group for non-group")
+			return fmt.Errorf("proto: OrphanedResource: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ApplicationStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: OrphanedResource: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ComparisonResult", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Group", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -6232,27 +12384,26 @@ func (m *ApplicationStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ComparisonResult.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Group = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field History", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -6262,28 +12413,26 @@ func (m *ApplicationStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.History = append(m.History, DeploymentInfo{})
-			if err := m.History[len(m.History)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Kind = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Parameters", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -6293,91 +12442,26 @@ func (m *ApplicationStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Parameters = append(m.Parameters, ComponentParameter{})
-			if err := m.Parameters[len(m.Parameters)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Namespace = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Health", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Health.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OperationState", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.OperationState == nil {
-				m.OperationState = &OperationState{}
-			}
-			if err := m.OperationState.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Conditions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -6387,22 +12471,20 @@ func (m *ApplicationStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Conditions = append(m.Conditions, ApplicationCondition{})
-			if err := m.Conditions[len(m.Conditions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -6425,7 +12507,7 @@ func (m *ApplicationStatus) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ApplicationWatchEvent) Unmarshal(dAtA []byte) error {
+func (m *OrphanedResourcesMonitorSettings) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6448,17 +12530,17 @@ func (m *ApplicationWatchEvent) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ApplicationWatchEvent: wiretype end group for non-group")
+			return fmt.Errorf("proto: OrphanedResourcesMonitorSettings: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ApplicationWatchEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: OrphanedResourcesMonitorSettings: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Warn", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -6468,24 +12550,66 @@ func (m *ApplicationWatchEvent) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				v |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			b := bool(v != 0)
+			m.Warn = &b
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Type = k8s_io_apimachinery_pkg_watch.EventType(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ParameterOverrides) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ParameterOverrides: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ParameterOverrides: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Application", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6509,7 +12633,8 @@ func (m *ApplicationWatchEvent) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Application.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			*m = append(*m, ComponentParameter{})
+			if err := (*m)[len(*m)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -6534,7 +12659,7 @@ func (m *ApplicationWatchEvent) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Cluster) Unmarshal(dAtA []byte) error {
+func (m *ParameterPreset) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6557,42 +12682,13 @@ func (m *Cluster) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Cluster: wiretype end group for non-group")
+			return fmt.Errorf("proto: ParameterPreset: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Cluster: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ParameterPreset: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Server", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Server = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
@@ -6621,39 +12717,9 @@ func (m *Cluster) Unmarshal(dAtA []byte) error {
 			}
 			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Config", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Config.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 4:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionState", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Parameters", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6677,7 +12743,8 @@ func (m *Cluster) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ConnectionState.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Parameters = append(m.Parameters, ComponentParameter{})
+			if err := m.Parameters[len(m.Parameters)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -6702,7 +12769,7 @@ func (m *Cluster) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ClusterConfig) Unmarshal(dAtA []byte) error {
+func (m *ProjectRole) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6725,15 +12792,15 @@ func (m *ClusterConfig) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ClusterConfig: wiretype end group for non-group")
+			return fmt.Errorf("proto: ProjectRole: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ClusterConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ProjectRole: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Username", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6758,11 +12825,11 @@ func (m *ClusterConfig) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Username = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Password", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6787,11 +12854,11 @@ func (m *ClusterConfig) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Password = string(dAtA[iNdEx:postIndex])
+			m.Description = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BearerToken", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Policies", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6816,11 +12883,11 @@ func (m *ClusterConfig) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BearerToken = string(dAtA[iNdEx:postIndex])
+			m.Policies = append(m.Policies, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TLSClientConfig", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field JWTTokens", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6844,15 +12911,16 @@ func (m *ClusterConfig) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TLSClientConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.JWTTokens = append(m.JWTTokens, JWTToken{})
+			if err := m.JWTTokens[len(m.JWTTokens)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AWSAuthConfig", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Groups", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -6862,24 +12930,20 @@ func (m *ClusterConfig) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.AWSAuthConfig == nil {
-				m.AWSAuthConfig = &AWSAuthConfig{}
-			}
-			if err := m.AWSAuthConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Groups = append(m.Groups, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -6902,7 +12966,7 @@ func (m *ClusterConfig) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ClusterList) Unmarshal(dAtA []byte) error {
+func (m *Repository) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6925,17 +12989,17 @@ func (m *ClusterList) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ClusterList: wiretype end group for non-group")
+			return fmt.Errorf("proto: Repository: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ClusterList: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Repository: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Repo", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -6945,108 +13009,26 @@ func (m *ClusterList) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ListMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Repo = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Items = append(m.Items, Cluster{})
-			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ComparisonResult) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ComparisonResult: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ComparisonResult: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ComparedAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Username", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -7056,27 +13038,26 @@ func (m *ComparisonResult) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ComparedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Username = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ComparedTo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Password", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -7086,25 +13067,24 @@ func (m *ComparisonResult) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ComparedTo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Password = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SSHPrivateKey", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7129,13 +13109,13 @@ func (m *ComparisonResult) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Status = ComparisonStatus(dAtA[iNdEx:postIndex])
+			m.SSHPrivateKey = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Revision", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionState", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -7145,26 +13125,27 @@ func (m *ComparisonResult) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Revision = string(dAtA[iNdEx:postIndex])
+			if err := m.ConnectionState.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 8:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Resources", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -7174,22 +13155,20 @@ func (m *ComparisonResult) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Resources = append(m.Resources, ResourceSummary{})
-			if err := m.Resources[len(m.Resources)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Type = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -7212,7 +13191,7 @@ func (m *ComparisonResult) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ComponentParameter) Unmarshal(dAtA []byte) error {
+func (m *RepositoryList) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7235,17 +13214,17 @@ func (m *ComponentParameter) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ComponentParameter: wiretype end group for non-group")
+			return fmt.Errorf("proto: RepositoryList: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ComponentParameter: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RepositoryList: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Component", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -7255,26 +13234,27 @@ func (m *ComponentParameter) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Component = string(dAtA[iNdEx:postIndex])
+			if err := m.ListMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -7284,49 +13264,22 @@ func (m *ComponentParameter) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.Items = append(m.Items, Repository{})
+			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.Value = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -7349,7 +13302,7 @@ func (m *ComponentParameter) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ConnectionState) Unmarshal(dAtA []byte) error {
+func (m *ResourceDetails) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7372,15 +13325,15 @@ func (m *ConnectionState) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ConnectionState: wiretype end group for non-group")
+			return fmt.Errorf("proto: ResourceDetails: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ConnectionState: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ResourceDetails: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7405,11 +13358,11 @@ func (m *ConnectionState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Status = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7434,13 +13387,13 @@ func (m *ConnectionState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Message = string(dAtA[iNdEx:postIndex])
+			m.Kind = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ModifiedAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -7450,78 +13403,24 @@ func (m *ConnectionState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ModifiedAt == nil {
-				m.ModifiedAt = &v1.Time{}
-			}
-			if err := m.ModifiedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Namespace = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *DeploymentInfo) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: DeploymentInfo: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DeploymentInfo: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 2:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Revision", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7546,13 +13445,13 @@ func (m *DeploymentInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Revision = string(dAtA[iNdEx:postIndex])
+			m.Message = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ComponentParameterOverrides", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -7562,58 +13461,26 @@ func (m *DeploymentInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ComponentParameterOverrides = append(m.ComponentParameterOverrides, ComponentParameter{})
-			if err := m.ComponentParameterOverrides[len(m.ComponentParameterOverrides)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Status = ResourceSyncStatus(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DeployedAt", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.DeployedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Action", wireType)
 			}
-			m.ID = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -7622,65 +13489,25 @@ func (m *DeploymentInfo) Unmarshal(dAtA []byte) error {
 					return io.ErrUnexpectedEOF
 				}
 				b := dAtA[iNdEx]
-				iNdEx++
-				m.ID |= (int64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if skippy < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *HealthStatus) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: HealthStatus: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: HealthStatus: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.Action = ResourceSyncAction(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Diff", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7705,11 +13532,11 @@ func (m *HealthStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Status = string(dAtA[iNdEx:postIndex])
+			m.Diff = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StatusDetails", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GenerateName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7734,7 +13561,7 @@ func (m *HealthStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.StatusDetails = string(dAtA[iNdEx:postIndex])
+			m.GenerateName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -7757,7 +13584,7 @@ func (m *HealthStatus) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *HookStatus) Unmarshal(dAtA []byte) error {
+func (m *ResourceIgnoreDifferences) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7780,15 +13607,15 @@ func (m *HookStatus) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: HookStatus: wiretype end group for non-group")
+			return fmt.Errorf("proto: ResourceIgnoreDifferences: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: HookStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ResourceIgnoreDifferences: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Group", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7813,7 +13640,7 @@ func (m *HookStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Group = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
@@ -7846,7 +13673,7 @@ func (m *HookStatus) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field APIVersion", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7871,11 +13698,11 @@ func (m *HookStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.APIVersion = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field JSONPointers", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7900,11 +13727,61 @@ func (m *HookStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Type = HookType(dAtA[iNdEx:postIndex])
+			m.JSONPointers = append(m.JSONPointers, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 5:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ResourceNode) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ResourceNode: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ResourceNode: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7929,13 +13806,13 @@ func (m *HookStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Status = OperationPhase(dAtA[iNdEx:postIndex])
+			m.State = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Children", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -7945,20 +13822,22 @@ func (m *HookStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Message = string(dAtA[iNdEx:postIndex])
+			m.Children = append(m.Children, ResourceNode{})
+			if err := m.Children[len(m.Children)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -7981,7 +13860,7 @@ func (m *HookStatus) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *JWTToken) Unmarshal(dAtA []byte) error {
+func (m *ResourceRef) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8004,17 +13883,17 @@ func (m *JWTToken) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: JWTToken: wiretype end group for non-group")
+			return fmt.Errorf("proto: ResourceRef: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: JWTToken: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ResourceRef: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IssuedAt", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Group", wireType)
 			}
-			m.IssuedAt = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -8024,16 +13903,26 @@ func (m *JWTToken) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.IssuedAt |= (int64(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Group = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExpiresAt", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
 			}
-			m.ExpiresAt = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -8043,66 +13932,84 @@ func (m *JWTToken) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ExpiresAt |= (int64(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Operation) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
+			m.Version = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
 			}
-			if iNdEx >= l {
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
+			m.Kind = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Operation: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Operation: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.Namespace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sync", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -8112,24 +14019,49 @@ func (m *Operation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Sync == nil {
-				m.Sync = &SyncOperation{}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UID", wireType)
 			}
-			if err := m.Sync.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			m.UID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -8152,7 +14084,7 @@ func (m *Operation) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *OperationState) Unmarshal(dAtA []byte) error {
+func (m *ResourceState) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8175,17 +14107,17 @@ func (m *OperationState) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: OperationState: wiretype end group for non-group")
+			return fmt.Errorf("proto: ResourceState: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: OperationState: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ResourceState: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Operation", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetState", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -8195,25 +14127,24 @@ func (m *OperationState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Operation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.TargetState = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Phase", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LiveState", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8238,11 +14169,11 @@ func (m *OperationState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Phase = OperationPhase(dAtA[iNdEx:postIndex])
+			m.LiveState = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8267,44 +14198,11 @@ func (m *OperationState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Message = string(dAtA[iNdEx:postIndex])
+			m.Status = ComparisonStatus(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SyncResult", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.SyncResult == nil {
-				m.SyncResult = &SyncOperationResult{}
-			}
-			if err := m.SyncResult.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StartedAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChildLiveResources", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8328,13 +14226,14 @@ func (m *OperationState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.StartedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.ChildLiveResources = append(m.ChildLiveResources, ResourceNode{})
+			if err := m.ChildLiveResources[len(m.ChildLiveResources)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 7:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FinishedAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Health", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8358,10 +14257,7 @@ func (m *OperationState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.FinishedAt == nil {
-				m.FinishedAt = &v1.Time{}
-			}
-			if err := m.FinishedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Health.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -8386,7 +14282,7 @@ func (m *OperationState) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ParameterOverrides) Unmarshal(dAtA []byte) error {
+func (m *ResourceSummary) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8409,17 +14305,17 @@ func (m *ParameterOverrides) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ParameterOverrides: wiretype end group for non-group")
+			return fmt.Errorf("proto: ResourceSummary: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ParameterOverrides: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ResourceSummary: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Group", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -8429,76 +14325,53 @@ func (m *ParameterOverrides) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			*m = append(*m, ComponentParameter{})
-			if err := (*m)[len(*m)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Group = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthGenerated
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ProjectRole) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			if iNdEx >= l {
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ProjectRole: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ProjectRole: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.Version = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8523,11 +14396,11 @@ func (m *ProjectRole) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Kind = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8552,11 +14425,11 @@ func (m *ProjectRole) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Description = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Policies", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8581,11 +14454,11 @@ func (m *ProjectRole) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Policies = append(m.Policies, string(dAtA[iNdEx:postIndex]))
+			m.Status = ComparisonStatus(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JWTTokens", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Health", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8609,14 +14482,13 @@ func (m *ProjectRole) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.JWTTokens = append(m.JWTTokens, JWTToken{})
-			if err := m.JWTTokens[len(m.JWTTokens)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Health.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Groups", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8641,7 +14513,7 @@ func (m *ProjectRole) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Groups = append(m.Groups, string(dAtA[iNdEx:postIndex]))
+			m.Namespace = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -8664,7 +14536,7 @@ func (m *ProjectRole) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Repository) Unmarshal(dAtA []byte) error {
+func (m *ResourceTreeNode) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8687,17 +14559,17 @@ func (m *Repository) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Repository: wiretype end group for non-group")
+			return fmt.Errorf("proto: ResourceTreeNode: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Repository: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ResourceTreeNode: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Repo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ResourceRef", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -8707,26 +14579,27 @@ func (m *Repository) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Repo = string(dAtA[iNdEx:postIndex])
+			if err := m.ResourceRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Username", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ParentRefs", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -8736,24 +14609,26 @@ func (m *Repository) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Username = string(dAtA[iNdEx:postIndex])
+			m.ParentRefs = append(m.ParentRefs, ResourceRef{})
+			if err := m.ParentRefs[len(m.ParentRefs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Password", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ResourceVersion", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8778,40 +14653,11 @@ func (m *Repository) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Password = string(dAtA[iNdEx:postIndex])
+			m.ResourceVersion = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SSHPrivateKey", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.SSHPrivateKey = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionState", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Health", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8835,7 +14681,10 @@ func (m *Repository) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ConnectionState.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Health == nil {
+				m.Health = &HealthStatus{}
+			}
+			if err := m.Health.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -8860,7 +14709,7 @@ func (m *Repository) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RepositoryList) Unmarshal(dAtA []byte) error {
+func (m *RetryStrategy) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8883,17 +14732,17 @@ func (m *RepositoryList) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RepositoryList: wiretype end group for non-group")
+			return fmt.Errorf("proto: RetryStrategy: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RepositoryList: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RetryStrategy: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
 			}
-			var msglen int
+			m.Limit = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -8903,25 +14752,14 @@ func (m *RepositoryList) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				m.Limit |= (int64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.ListMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Backoff", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8945,11 +14783,33 @@ func (m *RepositoryList) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Items = append(m.Items, Repository{})
-			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Backoff == nil {
+				m.Backoff = &Backoff{}
+			}
+			if err := m.Backoff.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FailedOnly", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.FailedOnly = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -8971,7 +14831,7 @@ func (m *RepositoryList) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ResourceDetails) Unmarshal(dAtA []byte) error {
+func (m *SyncOperation) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8994,15 +14854,15 @@ func (m *ResourceDetails) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ResourceDetails: wiretype end group for non-group")
+			return fmt.Errorf("proto: SyncOperation: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ResourceDetails: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SyncOperation: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Revision", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9027,13 +14887,13 @@ func (m *ResourceDetails) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Revision = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Prune", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -9043,26 +14903,37 @@ func (m *ResourceDetails) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				v |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
+			m.Prune = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DryRun", wireType)
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			m.Kind = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
+			m.DryRun = bool(v != 0)
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SyncStrategy", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -9072,26 +14943,30 @@ func (m *ResourceDetails) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Namespace = string(dAtA[iNdEx:postIndex])
+			if m.SyncStrategy == nil {
+				m.SyncStrategy = &SyncStrategy{}
+			}
+			if err := m.SyncStrategy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 4:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ParameterOverrides", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -9101,26 +14976,30 @@ func (m *ResourceDetails) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Message = string(dAtA[iNdEx:postIndex])
+			if m.ParameterOverrides == nil {
+				m.ParameterOverrides = ParameterOverrides{}
+			}
+			if err := m.ParameterOverrides.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 5:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Resources", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -9130,74 +15009,46 @@ func (m *ResourceDetails) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Status = ResourceSyncStatus(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
+			m.Resources = append(m.Resources, SyncOperationResource{})
+			if err := m.Resources[len(m.Resources)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ResourceNode) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConfirmCRDDeletion", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ResourceNode: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ResourceNode: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.ConfirmCRDDeletion = bool(v != 0)
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PrunePropagationPolicy", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9222,11 +15073,11 @@ func (m *ResourceNode) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.State = string(dAtA[iNdEx:postIndex])
+			m.PrunePropagationPolicy = PropagationPolicy(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Children", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Retry", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9250,66 +15101,18 @@ func (m *ResourceNode) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Children = append(m.Children, ResourceNode{})
-			if err := m.Children[len(m.Children)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if m.Retry == nil {
+				m.Retry = &RetryStrategy{}
 			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
+			if err := m.Retry.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ResourceState) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ResourceState: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ResourceState: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TargetState", wireType)
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApplyConcurrency", wireType)
 			}
-			var stringLen uint64
+			m.ApplyConcurrency = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -9319,26 +15122,16 @@ func (m *ResourceState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				m.ApplyConcurrency |= (int64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.TargetState = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
+		case 11:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LiveState", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExcludedResources", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -9348,24 +15141,26 @@ func (m *ResourceState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.LiveState = string(dAtA[iNdEx:postIndex])
+			m.ExcludedResources = append(m.ExcludedResources, SyncOperationResource{})
+			if err := m.ExcludedResources[len(m.ExcludedResources)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
+		case 12:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Preset", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9390,11 +15185,11 @@ func (m *ResourceState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Status = ComparisonStatus(dAtA[iNdEx:postIndex])
+			m.Preset = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 13:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChildLiveResources", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MoveFrom", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9418,16 +15213,18 @@ func (m *ResourceState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ChildLiveResources = append(m.ChildLiveResources, ResourceNode{})
-			if err := m.ChildLiveResources[len(m.ChildLiveResources)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.MoveFrom == nil {
+				m.MoveFrom = &ApplicationDestination{}
+			}
+			if err := m.MoveFrom.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Health", wireType)
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TerminationGracePeriodSeconds", wireType)
 			}
-			var msglen int
+			var v int64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -9437,22 +15234,51 @@ func (m *ResourceState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				v |= (int64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
+			m.TerminationGracePeriodSeconds = &v
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OverrideSyncWindows", wireType)
 			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if err := m.Health.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.OverrideSyncWindows = bool(v != 0)
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SelfHealAttemptsCount", wireType)
+			}
+			m.SelfHealAttemptsCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SelfHealAttemptsCount |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -9474,7 +15300,7 @@ func (m *ResourceState) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ResourceSummary) Unmarshal(dAtA []byte) error {
+func (m *SyncOperationResource) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9497,10 +15323,10 @@ func (m *ResourceSummary) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ResourceSummary: wiretype end group for non-group")
+			return fmt.Errorf("proto: SyncOperationResource: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ResourceSummary: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SyncOperationResource: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -9533,35 +15359,6 @@ func (m *ResourceSummary) Unmarshal(dAtA []byte) error {
 			m.Group = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Version = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
 			}
@@ -9590,7 +15387,7 @@ func (m *ResourceSummary) Unmarshal(dAtA []byte) error {
 			}
 			m.Kind = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 3:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
@@ -9604,79 +15401,20 @@ func (m *ResourceSummary) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Name = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Status = ComparisonStatus(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Health", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Health.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -9699,7 +15437,7 @@ func (m *ResourceSummary) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SyncOperation) Unmarshal(dAtA []byte) error {
+func (m *SyncOperationResult) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9722,13 +15460,44 @@ func (m *SyncOperation) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SyncOperation: wiretype end group for non-group")
+			return fmt.Errorf("proto: SyncOperationResult: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SyncOperation: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SyncOperationResult: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Resources", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Resources = append(m.Resources, &ResourceDetails{})
+			if err := m.Resources[len(m.Resources)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Revision", wireType)
 			}
@@ -9757,11 +15526,11 @@ func (m *SyncOperation) Unmarshal(dAtA []byte) error {
 			}
 			m.Revision = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Prune", wireType)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hooks", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -9771,17 +15540,28 @@ func (m *SyncOperation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= (int(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Prune = bool(v != 0)
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DryRun", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			var v int
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hooks = append(m.Hooks, &HookStatus{})
+			if err := m.Hooks[len(m.Hooks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Summary", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -9791,15 +15571,24 @@ func (m *SyncOperation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.DryRun = bool(v != 0)
-		case 4:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Summary = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SyncStrategy", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MovedResources", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9823,16 +15612,43 @@ func (m *SyncOperation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.SyncStrategy == nil {
-				m.SyncStrategy = &SyncStrategy{}
-			}
-			if err := m.SyncStrategy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.MovedResources = append(m.MovedResources, &ResourceDetails{})
+			if err := m.MovedResources[len(m.MovedResources)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ParameterOverrides", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DeniedReasons", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DeniedReasons = append(m.DeniedReasons, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FailedResources", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9856,16 +15672,14 @@ func (m *SyncOperation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ParameterOverrides == nil {
-				m.ParameterOverrides = ParameterOverrides{}
-			}
-			if err := m.ParameterOverrides.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.FailedResources = append(m.FailedResources, &ResourceDetails{})
+			if err := m.FailedResources[len(m.FailedResources)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Resources", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field HealthWaitStartedAt", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9889,8 +15703,10 @@ func (m *SyncOperation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Resources = append(m.Resources, SyncOperationResource{})
-			if err := m.Resources[len(m.Resources)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.HealthWaitStartedAt == nil {
+				m.HealthWaitStartedAt = &k8s_io_apimachinery_pkg_apis_meta_v1.Time{}
+			}
+			if err := m.HealthWaitStartedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -9915,7 +15731,7 @@ func (m *SyncOperation) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SyncOperationResource) Unmarshal(dAtA []byte) error {
+func (m *SyncPolicy) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9938,17 +15754,17 @@ func (m *SyncOperationResource) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SyncOperationResource: wiretype end group for non-group")
+			return fmt.Errorf("proto: SyncPolicy: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SyncOperationResource: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SyncPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Group", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Automated", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -9958,24 +15774,28 @@ func (m *SyncOperationResource) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Group = string(dAtA[iNdEx:postIndex])
+			if m.Automated == nil {
+				m.Automated = &SyncPolicyAutomated{}
+			}
+			if err := m.Automated.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SyncOptions", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10000,13 +15820,13 @@ func (m *SyncOperationResource) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Kind = string(dAtA[iNdEx:postIndex])
+			m.SyncOptions = append(m.SyncOptions, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Retry", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -10016,20 +15836,24 @@ func (m *SyncOperationResource) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			if m.Retry == nil {
+				m.Retry = &RetryStrategy{}
+			}
+			if err := m.Retry.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -10052,7 +15876,7 @@ func (m *SyncOperationResource) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SyncOperationResult) Unmarshal(dAtA []byte) error {
+func (m *SyncPolicyAutomated) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10075,17 +15899,17 @@ func (m *SyncOperationResult) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SyncOperationResult: wiretype end group for non-group")
+			return fmt.Errorf("proto: SyncPolicyAutomated: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SyncOperationResult: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SyncPolicyAutomated: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Resources", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Prune", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -10095,83 +15919,32 @@ func (m *SyncOperationResult) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				v |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Resources = append(m.Resources, &ResourceDetails{})
-			if err := m.Resources[len(m.Resources)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
+			m.Prune = bool(v != 0)
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Revision", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Revision = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Hooks", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SelfHeal", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
 				}
 				if iNdEx >= l {
 					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Hooks = append(m.Hooks, &HookStatus{})
-			if err := m.Hooks[len(m.Hooks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
+			m.SelfHeal = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -10193,7 +15966,7 @@ func (m *SyncOperationResult) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SyncPolicy) Unmarshal(dAtA []byte) error {
+func (m *SyncStrategy) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10216,15 +15989,15 @@ func (m *SyncPolicy) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SyncPolicy: wiretype end group for non-group")
+			return fmt.Errorf("proto: SyncStrategy: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SyncPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SyncStrategy: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Automated", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Apply", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10248,10 +16021,76 @@ func (m *SyncPolicy) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Automated == nil {
-				m.Automated = &SyncPolicyAutomated{}
+			if m.Apply == nil {
+				m.Apply = &SyncStrategyApply{}
 			}
-			if err := m.Automated.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Apply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hook", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Hook == nil {
+				m.Hook = &SyncStrategyHook{}
+			}
+			if err := m.Hook.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Progressive", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Progressive == nil {
+				m.Progressive = &SyncStrategyProgressive{}
+			}
+			if err := m.Progressive.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -10276,7 +16115,7 @@ func (m *SyncPolicy) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SyncPolicyAutomated) Unmarshal(dAtA []byte) error {
+func (m *SyncStrategyApply) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10299,15 +16138,15 @@ func (m *SyncPolicyAutomated) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SyncPolicyAutomated: wiretype end group for non-group")
+			return fmt.Errorf("proto: SyncStrategyApply: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SyncPolicyAutomated: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SyncStrategyApply: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Prune", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Force", wireType)
 			}
 			var v int
 			for shift := uint(0); ; shift += 7 {
@@ -10324,7 +16163,7 @@ func (m *SyncPolicyAutomated) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			m.Prune = bool(v != 0)
+			m.Force = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -10346,7 +16185,7 @@ func (m *SyncPolicyAutomated) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SyncStrategy) Unmarshal(dAtA []byte) error {
+func (m *SyncStrategyHook) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10369,15 +16208,15 @@ func (m *SyncStrategy) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SyncStrategy: wiretype end group for non-group")
+			return fmt.Errorf("proto: SyncStrategyHook: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SyncStrategy: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SyncStrategyHook: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Apply", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SyncStrategyApply", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10401,18 +16240,15 @@ func (m *SyncStrategy) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Apply == nil {
-				m.Apply = &SyncStrategyApply{}
-			}
-			if err := m.Apply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.SyncStrategyApply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Hook", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field HealthTimeout", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -10422,24 +16258,20 @@ func (m *SyncStrategy) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Hook == nil {
-				m.Hook = &SyncStrategyHook{}
-			}
-			if err := m.Hook.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.HealthTimeout = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -10462,7 +16294,7 @@ func (m *SyncStrategy) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SyncStrategyApply) Unmarshal(dAtA []byte) error {
+func (m *SyncStrategyProgressive) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10485,17 +16317,47 @@ func (m *SyncStrategyApply) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SyncStrategyApply: wiretype end group for non-group")
+			return fmt.Errorf("proto: SyncStrategyProgressive: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SyncStrategyApply: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SyncStrategyProgressive: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SyncStrategyApply", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SyncStrategyApply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Force", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BatchSize", wireType)
 			}
-			var v int
+			m.BatchSize = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -10505,12 +16367,11 @@ func (m *SyncStrategyApply) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= (int(b) & 0x7F) << shift
+				m.BatchSize |= (int64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Force = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -10532,7 +16393,7 @@ func (m *SyncStrategyApply) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SyncStrategyHook) Unmarshal(dAtA []byte) error {
+func (m *SyncWindow) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10555,17 +16416,17 @@ func (m *SyncStrategyHook) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SyncStrategyHook: wiretype end group for non-group")
+			return fmt.Errorf("proto: SyncWindow: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SyncStrategyHook: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SyncWindow: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SyncStrategyApply", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -10575,21 +16436,107 @@ func (m *SyncStrategyHook) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.SyncStrategyApply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Kind = SyncWindowKind(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Schedule", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Schedule = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Duration = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Applications", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			m.Applications = append(m.Applications, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -10910,197 +16857,311 @@ var (
 )
 
 func init() {
-	proto.RegisterFile("github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1/generated.proto", fileDescriptor_generated_cc6f080d95e71262)
-}
-
-var fileDescriptor_generated_cc6f080d95e71262 = []byte{
-	// 3008 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x3a, 0x5d, 0x6c, 0x23, 0x57,
-	0xd5, 0x19, 0xff, 0x24, 0xf6, 0x71, 0x92, 0xdd, 0xbd, 0xfd, 0xf9, 0xfc, 0xa5, 0x52, 0x12, 0xcd,
-	0x7e, 0x3f, 0x05, 0xb5, 0x0e, 0xbb, 0xa2, 0x50, 0x0a, 0x42, 0xca, 0x38, 0xbb, 0x4d, 0x9a, 0xdd,
-	0xac, 0x7b, 0x9d, 0x76, 0xa5, 0x52, 0x15, 0x66, 0xc7, 0x37, 0xf6, 0xac, 0xed, 0x99, 0xe9, 0xdc,
-	0x71, 0x76, 0xdd, 0xaa, 0xd5, 0x16, 0x84, 0x04, 0x02, 0x24, 0xa0, 0x42, 0x20, 0xf1, 0x52, 0x21,
-	0x78, 0xe9, 0x23, 0xaa, 0x78, 0xe0, 0x91, 0x07, 0xd4, 0xc7, 0x0a, 0x81, 0xa8, 0x4a, 0xb5, 0xa2,
-	0xe9, 0x0b, 0x6f, 0x88, 0xd7, 0x3e, 0xa1, 0xfb, 0x33, 0x73, 0xef, 0x8c, 0xed, 0x26, 0xbb, 0xf6,
-	0x2e, 0xf0, 0xe6, 0xb9, 0xe7, 0xcc, 0x39, 0x67, 0xce, 0xff, 0x39, 0xd7, 0xb0, 0xd3, 0x76, 0xa3,
-	0xce, 0xe0, 0x5a, 0xcd, 0xf1, 0xfb, 0x1b, 0x76, 0xd8, 0xf6, 0x83, 0xd0, 0xbf, 0xce, 0x7f, 0x3c,
-	0xee, 0xb4, 0x36, 0x82, 0x6e, 0x7b, 0xc3, 0x0e, 0x5c, 0xba, 0x61, 0x07, 0x41, 0xcf, 0x75, 0xec,
-	0xc8, 0xf5, 0xbd, 0x8d, 0xc3, 0x73, 0x76, 0x2f, 0xe8, 0xd8, 0xe7, 0x36, 0xda, 0xc4, 0x23, 0xa1,
-	0x1d, 0x91, 0x56, 0x2d, 0x08, 0xfd, 0xc8, 0x47, 0x5f, 0x52, 0xa4, 0x6a, 0x31, 0x29, 0xfe, 0xe3,
-	0xeb, 0x4e, 0xab, 0x16, 0x74, 0xdb, 0x35, 0x46, 0xaa, 0xa6, 0x91, 0xaa, 0xc5, 0xa4, 0x56, 0x1e,
-	0xd7, 0xa4, 0x68, 0xfb, 0x6d, 0x7f, 0x83, 0x53, 0xbc, 0x36, 0x38, 0xe0, 0x4f, 0xfc, 0x81, 0xff,
-	0x12, 0x9c, 0x56, 0x3e, 0xdf, 0x7d, 0x92, 0xd6, 0x5c, 0x9f, 0xc9, 0xd6, 0xb7, 0x9d, 0x8e, 0xeb,
-	0x91, 0x70, 0xa8, 0x84, 0xed, 0x93, 0xc8, 0xde, 0x38, 0x1c, 0x91, 0x6f, 0x65, 0x63, 0xd2, 0x5b,
-	0xe1, 0xc0, 0x8b, 0xdc, 0x3e, 0x19, 0x79, 0xe1, 0x0b, 0xc7, 0xbd, 0x40, 0x9d, 0x0e, 0xe9, 0xdb,
-	0xd9, 0xf7, 0xcc, 0x97, 0x61, 0x69, 0xf3, 0x6a, 0x73, 0x73, 0x10, 0x75, 0xea, 0xbe, 0x77, 0xe0,
-	0xb6, 0xd1, 0x13, 0x50, 0x71, 0x7a, 0x03, 0x1a, 0x91, 0x70, 0xcf, 0xee, 0x93, 0xaa, 0xb1, 0x6e,
-	0x3c, 0x5a, 0xb6, 0x1e, 0x78, 0xf7, 0xf6, 0xda, 0xdc, 0xd1, 0xed, 0xb5, 0x4a, 0x5d, 0x81, 0xb0,
-	0x8e, 0x87, 0x3e, 0x03, 0x0b, 0xa1, 0xdf, 0x23, 0x9b, 0x78, 0xaf, 0x9a, 0xe3, 0xaf, 0x9c, 0x92,
-	0xaf, 0x2c, 0x60, 0x71, 0x8c, 0x63, 0xb8, 0xf9, 0x17, 0x03, 0x60, 0x33, 0x08, 0x1a, 0xa1, 0x7f,
-	0x9d, 0x38, 0x11, 0xfa, 0x06, 0x94, 0x98, 0x16, 0x5a, 0x76, 0x64, 0x73, 0x6e, 0x95, 0xf3, 0x9f,
-	0xab, 0x89, 0x8f, 0xa9, 0xe9, 0x1f, 0xa3, 0xac, 0xc2, 0xb0, 0x6b, 0x87, 0xe7, 0x6a, 0x57, 0xae,
-	0xb1, 0xf7, 0x2f, 0x93, 0xc8, 0xb6, 0x90, 0x64, 0x06, 0xea, 0x0c, 0x27, 0x54, 0x51, 0x17, 0x0a,
-	0x34, 0x20, 0x0e, 0x17, 0xac, 0x72, 0x7e, 0xa7, 0x76, 0xd7, 0xb6, 0xaf, 0x29, 0xb1, 0x9b, 0x01,
-	0x71, 0xac, 0x45, 0xc9, 0xb6, 0xc0, 0x9e, 0x30, 0x67, 0x62, 0x7e, 0x60, 0xc0, 0xb2, 0x42, 0xbb,
-	0xe4, 0xd2, 0x08, 0xbd, 0x38, 0xf2, 0x85, 0xb5, 0x93, 0x7d, 0x21, 0x7b, 0x9b, 0x7f, 0xdf, 0x69,
-	0xc9, 0xa8, 0x14, 0x9f, 0x68, 0x5f, 0x77, 0x1d, 0x8a, 0x6e, 0x44, 0xfa, 0xb4, 0x9a, 0x5b, 0xcf,
-	0x3f, 0x5a, 0x39, 0x7f, 0x61, 0x26, 0x9f, 0x67, 0x2d, 0x49, 0x8e, 0xc5, 0x1d, 0x46, 0x1b, 0x0b,
-	0x16, 0xe6, 0xcf, 0x8b, 0xfa, 0xc7, 0xb1, 0xaf, 0x46, 0xe7, 0xa0, 0x42, 0xfd, 0x41, 0xe8, 0x10,
-	0x4c, 0x02, 0x9f, 0x56, 0x8d, 0xf5, 0x3c, 0x33, 0x3e, 0xf3, 0x95, 0xa6, 0x3a, 0xc6, 0x3a, 0x0e,
-	0xfa, 0x9e, 0x01, 0x8b, 0x2d, 0x42, 0x23, 0xd7, 0xe3, 0xfc, 0x63, 0xc9, 0x9f, 0x9d, 0x4e, 0xf2,
-	0xf8, 0x70, 0x4b, 0x51, 0xb6, 0x1e, 0x94, 0x5f, 0xb1, 0xa8, 0x1d, 0x52, 0x9c, 0x62, 0xce, 0x1c,
-	0xbe, 0x45, 0xa8, 0x13, 0xba, 0x01, 0x7b, 0xae, 0xe6, 0xd3, 0x0e, 0xbf, 0xa5, 0x40, 0x58, 0xc7,
-	0x43, 0x5d, 0x28, 0x32, 0x87, 0xa6, 0xd5, 0x02, 0x17, 0xfe, 0xe2, 0x14, 0xc2, 0x4b, 0x75, 0xb2,
-	0x40, 0x51, 0x7a, 0x67, 0x4f, 0x14, 0x0b, 0x1e, 0xe8, 0x07, 0x06, 0x54, 0x65, 0xb4, 0x61, 0x22,
-	0x54, 0x79, 0xb5, 0xe3, 0x46, 0xa4, 0xe7, 0xd2, 0xa8, 0x5a, 0xe4, 0x02, 0x6c, 0x9c, 0xcc, 0xa5,
-	0x9e, 0x0e, 0xfd, 0x41, 0xb0, 0xeb, 0x7a, 0x2d, 0x6b, 0x5d, 0x72, 0xaa, 0xd6, 0x27, 0x10, 0xc6,
-	0x13, 0x59, 0xa2, 0x37, 0x0d, 0x58, 0xf1, 0xec, 0x3e, 0xa1, 0x81, 0xcd, 0x8c, 0x2a, 0xc0, 0x56,
-	0xcf, 0x76, 0xba, 0x5c, 0xa2, 0xf9, 0xbb, 0x93, 0xc8, 0x94, 0x12, 0xad, 0xec, 0x4d, 0x24, 0x8d,
-	0x3f, 0x85, 0xad, 0xf9, 0xfb, 0x3c, 0x54, 0x34, 0x47, 0xb8, 0x0f, 0x99, 0xa5, 0x97, 0xca, 0x2c,
-	0xcf, 0xcc, 0xc6, 0x81, 0x27, 0xa5, 0x16, 0x14, 0xc1, 0x3c, 0x8d, 0xec, 0x68, 0x40, 0xb9, 0x93,
-	0x56, 0xce, 0x5f, 0x9a, 0x11, 0x3f, 0x4e, 0xd3, 0x5a, 0x96, 0x1c, 0xe7, 0xc5, 0x33, 0x96, 0xbc,
-	0xd0, 0xcb, 0x50, 0xf6, 0x03, 0x56, 0x33, 0x58, 0x74, 0x14, 0x38, 0xe3, 0xad, 0x29, 0x18, 0x5f,
-	0x89, 0x69, 0x59, 0x4b, 0x47, 0xb7, 0xd7, 0xca, 0xc9, 0x23, 0x56, 0x5c, 0x4c, 0x07, 0x1e, 0xd4,
-	0xe4, 0xab, 0xfb, 0x5e, 0xcb, 0xe5, 0x06, 0x5d, 0x87, 0x42, 0x34, 0x0c, 0xe2, 0xa2, 0x94, 0xa8,
-	0x68, 0x7f, 0x18, 0x10, 0xcc, 0x21, 0xac, 0x0c, 0xf5, 0x09, 0xa5, 0x76, 0x9b, 0x64, 0xcb, 0xd0,
-	0x65, 0x71, 0x8c, 0x63, 0xb8, 0xf9, 0x32, 0x3c, 0x3c, 0x3e, 0x6b, 0xa0, 0xff, 0x83, 0x79, 0x4a,
-	0xc2, 0x43, 0x12, 0x4a, 0x46, 0x4a, 0x33, 0xfc, 0x14, 0x4b, 0x28, 0xda, 0x80, 0x72, 0xe2, 0x8d,
-	0x92, 0xdd, 0x19, 0x89, 0x5a, 0x56, 0x2e, 0xac, 0x70, 0xcc, 0x0f, 0x0d, 0x38, 0xa5, 0xf1, 0xbc,
-	0x0f, 0xc5, 0xa1, 0x9b, 0x2e, 0x0e, 0x17, 0x67, 0xe3, 0x31, 0x13, 0xaa, 0xc3, 0xaf, 0xe7, 0xe1,
-	0x8c, 0xee, 0x57, 0x3c, 0x3c, 0x79, 0x67, 0x40, 0x02, 0xff, 0x39, 0x7c, 0x49, 0xaa, 0x53, 0x75,
-	0x06, 0xe2, 0x18, 0xc7, 0x70, 0x66, 0xdf, 0xc0, 0x8e, 0x3a, 0x52, 0x97, 0x89, 0x7d, 0x1b, 0x76,
-	0xd4, 0xc1, 0x1c, 0xc2, 0x92, 0x35, 0xf1, 0x0e, 0xdd, 0xd0, 0xf7, 0xfa, 0xc4, 0x8b, 0xb2, 0xc9,
-	0xfa, 0x82, 0x02, 0x61, 0x1d, 0x0f, 0x7d, 0x15, 0x96, 0x23, 0x3b, 0x6c, 0x93, 0x08, 0x93, 0x43,
-	0x97, 0xc6, 0x8e, 0x5c, 0xb6, 0x1e, 0x96, 0x6f, 0x2e, 0xef, 0xa7, 0xa0, 0x38, 0x83, 0x8d, 0xde,
-	0x31, 0xe0, 0x11, 0xc7, 0xef, 0x07, 0xbe, 0x47, 0xbc, 0xa8, 0x61, 0x87, 0x76, 0x9f, 0x44, 0x24,
-	0xbc, 0x72, 0x48, 0xc2, 0xd0, 0x6d, 0x11, 0x2a, 0x53, 0xf0, 0xe5, 0x29, 0xb4, 0x5b, 0x1f, 0xa1,
-	0x6e, 0x9d, 0x95, 0xc2, 0x3d, 0x52, 0x9f, 0xcc, 0x19, 0x7f, 0x9a, 0x58, 0xac, 0x36, 0x1f, 0xda,
-	0xbd, 0x01, 0xa1, 0x17, 0x5d, 0x56, 0xa9, 0xe6, 0x55, 0x6d, 0x7e, 0x5e, 0x1d, 0x63, 0x1d, 0x07,
-	0x79, 0x50, 0xe8, 0x90, 0x5e, 0xbf, 0xba, 0xc0, 0x5d, 0xb1, 0x31, 0xa3, 0x0c, 0xc3, 0x3d, 0x61,
-	0x9b, 0xf4, 0xfa, 0x56, 0x89, 0x19, 0x94, 0xfd, 0xc2, 0x9c, 0x0f, 0xfa, 0xa6, 0x01, 0xe5, 0xee,
-	0x80, 0x46, 0x7e, 0xdf, 0x7d, 0x85, 0x54, 0x4b, 0x9c, 0xeb, 0x73, 0xb3, 0xe4, 0xba, 0x1b, 0x13,
-	0x17, 0xf9, 0x26, 0x79, 0xc4, 0x8a, 0x2d, 0x7a, 0x05, 0x16, 0xba, 0xd4, 0xf7, 0x3c, 0x12, 0x55,
-	0xcb, 0x5c, 0x82, 0xe6, 0x4c, 0x25, 0x10, 0xa4, 0xad, 0x0a, 0xf3, 0x79, 0xf9, 0x80, 0x63, 0x86,
-	0xe6, 0xeb, 0xf0, 0xd0, 0x58, 0x4d, 0x31, 0x57, 0x0f, 0x49, 0x8f, 0xd8, 0x94, 0x8c, 0x6b, 0xc4,
-	0xb1, 0x02, 0x61, 0x1d, 0x0f, 0xd5, 0x00, 0xb8, 0x3d, 0x85, 0xc9, 0x73, 0xdc, 0xe4, 0xcb, 0xac,
-	0x80, 0x3d, 0x9f, 0x9c, 0x62, 0x0d, 0xc3, 0x7c, 0x16, 0xaa, 0x93, 0x24, 0xce, 0x46, 0x9b, 0x71,
-	0xb2, 0x68, 0x33, 0x1b, 0xb0, 0x32, 0xd9, 0x0c, 0xe8, 0x3c, 0x00, 0xcb, 0x88, 0x8d, 0x90, 0x1c,
-	0xb8, 0x37, 0x25, 0xcd, 0xa4, 0xca, 0xee, 0x25, 0x10, 0xac, 0x61, 0x99, 0xef, 0xe4, 0x53, 0x89,
-	0xb3, 0x19, 0x57, 0x43, 0x4e, 0x5a, 0xa6, 0xcd, 0x4b, 0xb3, 0xb4, 0x99, 0x96, 0xf3, 0x45, 0xe3,
-	0x2a, 0x79, 0xa1, 0xef, 0x18, 0xbc, 0x5d, 0x8c, 0x6b, 0x85, 0xac, 0xfc, 0xf7, 0xa0, 0x75, 0xd5,
-	0x3b, 0xd0, 0xf8, 0x10, 0xeb, 0xac, 0x59, 0x62, 0x0d, 0x44, 0xe7, 0x28, 0xf3, 0x60, 0x92, 0x58,
-	0xe3, 0x86, 0x32, 0x86, 0xa3, 0x01, 0x00, 0x1d, 0x7a, 0x4e, 0xc3, 0xef, 0xb9, 0xce, 0x50, 0x16,
-	0xf1, 0x69, 0x06, 0x85, 0x66, 0x42, 0x4c, 0xf8, 0x96, 0x7a, 0xc6, 0x1a, 0x23, 0xf3, 0xad, 0x4c,
-	0x41, 0x10, 0x0d, 0xc5, 0x8f, 0x0c, 0x38, 0xcd, 0xb2, 0x96, 0x1d, 0xba, 0xd4, 0xf7, 0x30, 0xa1,
-	0x83, 0x5e, 0x24, 0x6d, 0xb8, 0x3b, 0x65, 0x06, 0xd5, 0x49, 0x5a, 0x55, 0xa9, 0x8e, 0xd3, 0x59,
-	0x08, 0x1e, 0x61, 0x8f, 0x22, 0x58, 0xe8, 0xb8, 0x34, 0xf2, 0xc3, 0xa1, 0xac, 0x94, 0xd3, 0x4c,
-	0x89, 0x5b, 0x24, 0xe8, 0xf9, 0x43, 0x16, 0x0a, 0x3b, 0xde, 0x81, 0xaf, 0xcc, 0xb2, 0x2d, 0x38,
-	0xe0, 0x98, 0x15, 0x7a, 0xc3, 0x00, 0x08, 0xe2, 0xb4, 0xcd, 0xba, 0xba, 0x7b, 0x50, 0x45, 0x92,
-	0xd0, 0x4a, 0x8e, 0x28, 0xd6, 0x98, 0x22, 0x1f, 0xe6, 0x3b, 0xc4, 0xee, 0x45, 0x1d, 0xe9, 0x16,
-	0x4f, 0x4f, 0xc1, 0x7e, 0x9b, 0x13, 0xca, 0xf6, 0x93, 0xe2, 0x14, 0x4b, 0x36, 0xe8, 0xdb, 0x06,
-	0x2c, 0x27, 0xad, 0x1e, 0xc3, 0x25, 0xd5, 0xe2, 0xd4, 0x83, 0xf9, 0x95, 0x14, 0x41, 0x0b, 0xb1,
-	0x9a, 0x9e, 0x3e, 0xc3, 0x19, 0xa6, 0xe8, 0x5b, 0x06, 0x80, 0x13, 0xb7, 0x96, 0x54, 0xce, 0x2c,
-	0x57, 0x66, 0x13, 0xc8, 0x49, 0xcb, 0xaa, 0xd4, 0x9f, 0x1c, 0x51, 0xac, 0xb1, 0x35, 0x3f, 0x36,
-	0x52, 0xf9, 0xff, 0xaa, 0x1d, 0x39, 0x9d, 0x0b, 0x87, 0xac, 0x67, 0xd9, 0x4d, 0x35, 0xbb, 0x5f,
-	0xd4, 0x9b, 0xdd, 0x4f, 0x6e, 0xaf, 0xfd, 0xff, 0xa4, 0x7d, 0xcf, 0x0d, 0x46, 0xa1, 0xc6, 0x49,
-	0x68, 0x7d, 0xf1, 0x6b, 0x50, 0xd1, 0x64, 0x96, 0x59, 0x6b, 0x56, 0xdd, 0x60, 0x92, 0xaa, 0xb4,
-	0x43, 0xac, 0xf3, 0x33, 0xff, 0x94, 0x83, 0x05, 0x39, 0x66, 0x9e, 0xb8, 0xbb, 0x5e, 0x87, 0x02,
-	0xab, 0x00, 0xd9, 0x66, 0x90, 0x57, 0x3c, 0x0e, 0x41, 0x01, 0xcc, 0x3b, 0x7c, 0x69, 0x25, 0xe7,
-	0xa1, 0xed, 0x69, 0x22, 0x47, 0x48, 0x27, 0x96, 0x60, 0x4a, 0x26, 0xf1, 0x8c, 0x25, 0x1f, 0x36,
-	0x87, 0x9f, 0x72, 0x58, 0x6d, 0x74, 0x94, 0xf3, 0x16, 0xa6, 0x9e, 0xfd, 0xea, 0x69, 0x8a, 0xd6,
-	0x7f, 0x49, 0xee, 0xa7, 0x32, 0x00, 0x9c, 0xe5, 0x6d, 0xfe, 0x26, 0x0f, 0x4b, 0x29, 0xc9, 0xd1,
-	0x63, 0x50, 0x1a, 0x50, 0x12, 0x7a, 0xaa, 0x65, 0x48, 0xc6, 0x83, 0xe7, 0xe4, 0x39, 0x4e, 0x30,
-	0x18, 0x76, 0x60, 0x53, 0x7a, 0xc3, 0x0f, 0x5b, 0x52, 0xcf, 0x09, 0x76, 0x43, 0x9e, 0xe3, 0x04,
-	0x83, 0xb5, 0x03, 0xd7, 0x88, 0x1d, 0x92, 0x70, 0xdf, 0xef, 0x92, 0x91, 0x4d, 0x89, 0xa5, 0x40,
-	0x58, 0xc7, 0xe3, 0x4a, 0x8b, 0x7a, 0xb4, 0xde, 0x73, 0x89, 0x17, 0x09, 0x31, 0x67, 0xa0, 0xb4,
-	0xfd, 0x4b, 0x4d, 0x9d, 0xa2, 0x52, 0x5a, 0x06, 0x80, 0xb3, 0xbc, 0x59, 0xd6, 0x5d, 0xb2, 0x6f,
-	0x50, 0xb5, 0xf3, 0x94, 0xf9, 0x67, 0x1a, 0xf7, 0x49, 0xed, 0x50, 0xad, 0x33, 0x47, 0xb7, 0xd7,
-	0xd2, 0x6b, 0x55, 0x9c, 0xe6, 0x68, 0xfe, 0xd1, 0x80, 0x78, 0x97, 0x7a, 0x1f, 0xa6, 0xc0, 0x76,
-	0x7a, 0x0a, 0xb4, 0xa6, 0x8f, 0x93, 0x09, 0x13, 0xe0, 0x87, 0x79, 0x18, 0xa9, 0xb6, 0xe8, 0x25,
-	0x96, 0x67, 0xd9, 0x19, 0x69, 0x6d, 0xc6, 0x85, 0xfe, 0xb3, 0x27, 0xfb, 0xba, 0x7d, 0xb7, 0x4f,
-	0xf4, 0x14, 0x1a, 0x53, 0xc1, 0x1a, 0x45, 0x74, 0xcb, 0x50, 0x0c, 0xf6, 0x7d, 0x99, 0xdb, 0x66,
-	0xdb, 0x0d, 0x8e, 0x88, 0xb0, 0xef, 0x63, 0x8d, 0x27, 0x7a, 0x2a, 0xd9, 0xcc, 0x14, 0x79, 0x50,
-	0x98, 0xe9, 0x5d, 0xca, 0x27, 0xa9, 0x26, 0x24, 0xb3, 0x5f, 0x79, 0x0c, 0x4a, 0x61, 0x3c, 0x95,
-	0x2e, 0xa4, 0x63, 0x30, 0x99, 0x47, 0x13, 0x0c, 0xf4, 0x2a, 0x94, 0x43, 0xb9, 0xf8, 0xa2, 0xd5,
-	0x12, 0x37, 0xe7, 0x34, 0x51, 0x14, 0x2f, 0xd1, 0x9a, 0x83, 0x7e, 0xdf, 0x0e, 0x87, 0x6a, 0x7f,
-	0x11, 0x03, 0x28, 0x56, 0xfc, 0xcc, 0xef, 0x1b, 0x80, 0x46, 0x5b, 0x0c, 0xb4, 0x01, 0xe5, 0x64,
-	0x0a, 0x95, 0x49, 0x27, 0xa1, 0x93, 0xa0, 0x63, 0x85, 0x73, 0x82, 0xd4, 0x7e, 0x16, 0x8a, 0x7c,
-	0x46, 0x91, 0x49, 0x26, 0xf1, 0x36, 0x3e, 0xc4, 0x60, 0x01, 0x33, 0x7f, 0x67, 0x40, 0x36, 0x45,
-	0xf2, 0xea, 0x22, 0x2c, 0x91, 0xad, 0x2e, 0x69, 0xad, 0x9f, 0x7c, 0x51, 0x84, 0x5e, 0x84, 0x8a,
-	0x1d, 0x45, 0xa4, 0x1f, 0x44, 0xdc, 0x81, 0xf3, 0x77, 0xec, 0xc0, 0xbc, 0x45, 0xbe, 0xec, 0xb7,
-	0xdc, 0x03, 0x97, 0x3b, 0xaf, 0x4e, 0xce, 0xfc, 0x47, 0x0e, 0x96, 0xd3, 0x0d, 0x63, 0xca, 0x23,
-	0x72, 0xc7, 0x7a, 0xc4, 0x71, 0xbb, 0x89, 0xfc, 0xbf, 0xe7, 0x6e, 0xe2, 0x25, 0x80, 0x16, 0xff,
-	0x6c, 0xae, 0xd4, 0xc2, 0xdd, 0x67, 0x85, 0xad, 0x84, 0x0a, 0xd6, 0x28, 0xa2, 0x15, 0xc8, 0xb9,
-	0x2d, 0x1e, 0x8e, 0x79, 0x0b, 0x24, 0x6e, 0x6e, 0x67, 0x0b, 0xe7, 0xdc, 0x96, 0x49, 0x61, 0x51,
-	0x6f, 0x55, 0x4f, 0xec, 0x34, 0x5f, 0x86, 0x25, 0xf1, 0x6b, 0x8b, 0x44, 0xb6, 0xdb, 0xa3, 0xd2,
-	0x3a, 0x0f, 0x49, 0xf4, 0xa5, 0xa6, 0x0e, 0xc4, 0x69, 0x5c, 0xf3, 0xa7, 0x39, 0x80, 0x6d, 0xdf,
-	0xef, 0x4a, 0x9e, 0x71, 0x0c, 0x18, 0x13, 0x63, 0x60, 0x1d, 0x0a, 0x5d, 0xd7, 0x6b, 0x65, 0xa3,
-	0x64, 0xd7, 0xf5, 0x5a, 0x98, 0x43, 0xd8, 0x28, 0x6d, 0x07, 0xee, 0xf3, 0x24, 0xa4, 0xea, 0xe6,
-	0x22, 0xd1, 0xcb, 0x66, 0x63, 0x47, 0x42, 0xb0, 0x86, 0x85, 0x1e, 0x93, 0x6d, 0xa5, 0x58, 0x80,
-	0x55, 0x33, 0x6d, 0x65, 0x89, 0x49, 0xa8, 0xf5, 0x8d, 0x4f, 0x66, 0x12, 0xdb, 0xfa, 0x48, 0x62,
-	0x53, 0x6d, 0x76, 0xa3, 0x63, 0x53, 0x32, 0x2e, 0xc0, 0xe6, 0x8f, 0xd9, 0xc4, 0x36, 0xa1, 0xf4,
-	0xcc, 0xd5, 0x7d, 0xd1, 0x2c, 0x98, 0x90, 0x77, 0x6d, 0x91, 0x45, 0xf2, 0xca, 0xed, 0x77, 0x28,
-	0x1d, 0x70, 0x0b, 0x33, 0x20, 0x3a, 0x0b, 0x79, 0x72, 0x33, 0xe0, 0x7a, 0xc9, 0xab, 0x4c, 0x73,
-	0xe1, 0x66, 0xe0, 0x86, 0x84, 0x32, 0x24, 0x72, 0x33, 0x30, 0x29, 0xa8, 0xdd, 0x32, 0x3a, 0x80,
-	0x02, 0x1b, 0x4b, 0x65, 0xf1, 0xd9, 0x9e, 0x72, 0xf2, 0x55, 0x2b, 0x6c, 0xbe, 0xcd, 0x62, 0x47,
-	0x98, 0xd3, 0x37, 0x7f, 0x51, 0x80, 0xcc, 0xd8, 0x81, 0x06, 0xfa, 0xfa, 0xdc, 0x98, 0xe1, 0xfa,
-	0x3c, 0xf9, 0xf0, 0x71, 0x2b, 0x74, 0xf4, 0x04, 0x14, 0x03, 0x66, 0x0f, 0xe9, 0x3d, 0x6b, 0x71,
-	0x02, 0xe5, 0x46, 0x1a, 0x63, 0x36, 0x81, 0xad, 0x5b, 0x2d, 0x7f, 0x4c, 0x5a, 0x7c, 0x5d, 0xec,
-	0x14, 0xe4, 0xfc, 0x2e, 0x02, 0x78, 0x6f, 0x56, 0x9a, 0x95, 0x23, 0x7c, 0xb2, 0x5c, 0x90, 0x83,
-	0xbb, 0xc6, 0x11, 0x7d, 0x0d, 0xca, 0x34, 0xb2, 0x43, 0x91, 0x94, 0xe7, 0xef, 0x38, 0x7f, 0x24,
-	0xea, 0x6b, 0xc6, 0x44, 0xb0, 0xa2, 0x87, 0x5e, 0x00, 0x38, 0x70, 0x3d, 0x97, 0x76, 0x38, 0xf5,
-	0x85, 0xbb, 0x4b, 0xf9, 0x17, 0x13, 0x0a, 0x58, 0xa3, 0x66, 0xfe, 0xd8, 0x00, 0x34, 0x26, 0x21,
-	0x86, 0x71, 0x93, 0x66, 0xdc, 0x8b, 0x84, 0x3d, 0xb6, 0x5f, 0x7b, 0xaa, 0xf4, 0xb3, 0xb7, 0xd6,
-	0xe6, 0x6e, 0x7d, 0xb8, 0x3e, 0x67, 0xbe, 0x9d, 0x83, 0x8a, 0x76, 0x0f, 0x79, 0x82, 0xf4, 0x94,
-	0xb9, 0x37, 0xcd, 0x9d, 0xf0, 0xde, 0xf4, 0x51, 0x28, 0x05, 0x7e, 0xcf, 0x75, 0x5c, 0x59, 0x9a,
-	0xca, 0xd6, 0x22, 0x1f, 0x37, 0xe4, 0x19, 0x4e, 0xa0, 0x28, 0x82, 0xf2, 0xf5, 0x1b, 0x11, 0x4f,
-	0x0b, 0xf1, 0x2d, 0x6b, 0x7d, 0x0a, 0xa5, 0xc4, 0x29, 0x46, 0x59, 0x3e, 0x3e, 0xa1, 0x58, 0x31,
-	0x42, 0x26, 0xcc, 0xb7, 0x43, 0x7f, 0x10, 0x88, 0xa5, 0x7e, 0xd9, 0x02, 0x96, 0xed, 0xf8, 0x1d,
-	0x25, 0xc5, 0x12, 0x62, 0xfe, 0x39, 0x07, 0xc0, 0xaf, 0xb2, 0x5d, 0xbe, 0xc6, 0x59, 0x87, 0x42,
-	0x48, 0x02, 0x3f, 0xab, 0x2b, 0x86, 0x81, 0x39, 0x24, 0x35, 0x95, 0xe5, 0xee, 0x68, 0x2a, 0xcb,
-	0x1f, 0x3b, 0x95, 0xb1, 0xa2, 0x44, 0x3b, 0x8d, 0xd0, 0x3d, 0xb4, 0x23, 0xb2, 0x4b, 0x86, 0x32,
-	0xb3, 0xab, 0xa2, 0xd4, 0xdc, 0x56, 0x40, 0x9c, 0xc6, 0x1d, 0x3b, 0xd0, 0x16, 0xff, 0x85, 0x03,
-	0xed, 0x07, 0x06, 0x2c, 0x2b, 0xcd, 0xfe, 0x67, 0xfd, 0x7b, 0x42, 0xc9, 0x3d, 0x61, 0x3a, 0xfa,
-	0xbb, 0x01, 0xa7, 0xe2, 0xbe, 0x5a, 0x76, 0x05, 0x33, 0x69, 0x03, 0x52, 0xf7, 0x90, 0xf9, 0xe3,
-	0xef, 0x21, 0xf5, 0x2c, 0x5f, 0x38, 0x26, 0xcb, 0x7f, 0x25, 0xd3, 0x00, 0xfc, 0xcf, 0x48, 0x03,
-	0x80, 0x92, 0x19, 0x62, 0xe8, 0x39, 0xe9, 0x86, 0xc9, 0x7c, 0xdb, 0x80, 0xc5, 0x18, 0xbc, 0xe7,
-	0xb7, 0x78, 0x5f, 0x4f, 0xb9, 0x93, 0x19, 0xe9, 0xbe, 0x5e, 0xb8, 0x83, 0x80, 0xa1, 0x01, 0x94,
-	0x9c, 0x8e, 0xdb, 0x6b, 0x85, 0xc4, 0x93, 0x66, 0x79, 0x7a, 0x06, 0x23, 0x0e, 0xe3, 0xaf, 0x5c,
-	0xa1, 0x2e, 0x19, 0xe0, 0x84, 0x95, 0xf9, 0xdb, 0x3c, 0x2c, 0x25, 0xdf, 0xc2, 0x05, 0x79, 0x02,
-	0x2a, 0xe2, 0x22, 0xb0, 0xa9, 0xc9, 0x9c, 0xa4, 0xb8, 0x7d, 0x05, 0xc2, 0x3a, 0x1e, 0xb3, 0x47,
-	0xcf, 0x3d, 0x14, 0x34, 0xb2, 0xf7, 0xc2, 0x97, 0x62, 0x00, 0x56, 0x38, 0xda, 0xf8, 0x98, 0xbf,
-	0xe3, 0xf1, 0xf1, 0x4d, 0x03, 0x10, 0xff, 0x04, 0x46, 0x39, 0x99, 0xda, 0x64, 0xbe, 0x9c, 0x99,
-	0xde, 0x56, 0xa4, 0x44, 0xa8, 0x3e, 0xc2, 0x0a, 0x8f, 0x61, 0xaf, 0x6d, 0x95, 0x8b, 0xf7, 0x65,
-	0xab, 0x6c, 0xfe, 0x21, 0xa7, 0x62, 0x4b, 0x0e, 0xb3, 0xcc, 0xd9, 0x78, 0xc2, 0xce, 0x3a, 0x1b,
-	0xcf, 0xe6, 0x58, 0xc0, 0x58, 0x2c, 0x1c, 0xca, 0x06, 0x3a, 0x33, 0x08, 0xc6, 0xdd, 0x73, 0x0c,
-	0x4f, 0x22, 0x31, 0x3f, 0x31, 0x12, 0xe3, 0x68, 0x2e, 0x4c, 0x8c, 0xe6, 0x69, 0x36, 0x05, 0x4a,
-	0xa9, 0xf3, 0xf7, 0x47, 0xa9, 0xbf, 0x2a, 0xc0, 0x52, 0xaa, 0x2d, 0x4b, 0x8d, 0xa6, 0xc6, 0xb1,
-	0xa3, 0xe9, 0x59, 0x28, 0x06, 0xe1, 0xc0, 0x13, 0x41, 0x50, 0x52, 0x06, 0x68, 0xb0, 0x43, 0x2c,
-	0x60, 0x6c, 0xf8, 0x6a, 0x85, 0x43, 0x3c, 0x10, 0x03, 0x4c, 0x49, 0x09, 0xb3, 0xc5, 0x4f, 0xb1,
-	0x84, 0xa2, 0xd7, 0x60, 0x91, 0xf2, 0x0c, 0x13, 0xda, 0x11, 0x69, 0x0f, 0x67, 0x70, 0x5d, 0xd1,
-	0xd4, 0xc8, 0x59, 0xa7, 0x8f, 0x6e, 0xaf, 0x2d, 0xea, 0x27, 0x38, 0xc5, 0x0e, 0xfd, 0xc4, 0x00,
-	0x14, 0x8c, 0xbb, 0xf9, 0x37, 0xa6, 0x6c, 0xd6, 0x46, 0x5b, 0x41, 0xeb, 0x61, 0x16, 0x69, 0x63,
-	0x06, 0xea, 0x31, 0x02, 0xa0, 0x37, 0x0c, 0x7d, 0x23, 0x24, 0x6e, 0x31, 0x1a, 0x33, 0x6c, 0xc3,
-	0xc5, 0x02, 0xec, 0xd3, 0xf7, 0x42, 0xb7, 0x0c, 0x78, 0x68, 0xec, 0x7b, 0x27, 0x0b, 0xc1, 0xe3,
-	0x2b, 0x5c, 0x1c, 0x57, 0xf9, 0x49, 0x71, 0x65, 0xfe, 0x32, 0x07, 0x0f, 0x8c, 0x99, 0x20, 0xd0,
-	0x0d, 0x5d, 0x3b, 0xc6, 0xcc, 0xf6, 0x65, 0xb2, 0x7c, 0x8b, 0xff, 0x14, 0x8c, 0xd3, 0xc9, 0x1d,
-	0x2e, 0x71, 0x0e, 0xa0, 0xd8, 0xf1, 0xfd, 0x6e, 0xbc, 0xad, 0x99, 0xa6, 0x0d, 0x51, 0x3b, 0x06,
-	0xab, 0xcc, 0x54, 0xcd, 0x9e, 0x29, 0x16, 0xe4, 0xcd, 0xef, 0x1a, 0xa0, 0x5d, 0xd6, 0xa2, 0x57,
-	0xa1, 0x6c, 0x0f, 0x22, 0xbf, 0x6f, 0x47, 0xa4, 0x25, 0x9b, 0xab, 0xbd, 0x99, 0x5c, 0x0b, 0x6f,
-	0xc6, 0x54, 0x85, 0x86, 0x92, 0x47, 0xac, 0xf8, 0x99, 0x4f, 0x09, 0x8b, 0x65, 0x5e, 0x50, 0x49,
-	0xc3, 0x98, 0x9c, 0x34, 0xcc, 0xbf, 0x19, 0x90, 0x0a, 0x56, 0xd4, 0x87, 0x22, 0x13, 0x69, 0x38,
-	0x83, 0x3f, 0x03, 0xe8, 0x74, 0x37, 0x19, 0x4d, 0xa1, 0x47, 0xfe, 0x13, 0x0b, 0x2e, 0xc8, 0x85,
-	0x02, 0x53, 0xa8, 0x5c, 0x36, 0xef, 0xce, 0x88, 0x1b, 0x33, 0x95, 0xfc, 0x87, 0x8c, 0xef, 0x77,
-	0x31, 0x67, 0x61, 0x3e, 0x09, 0x67, 0x46, 0x24, 0x62, 0x4a, 0x3a, 0xf0, 0xe3, 0xff, 0x3e, 0x68,
-	0x4a, 0xba, 0xc8, 0x0e, 0xb1, 0x80, 0xb1, 0xee, 0xeb, 0x74, 0x96, 0x3c, 0xcb, 0x63, 0x67, 0x68,
-	0x96, 0xde, 0x3d, 0xd1, 0xda, 0x7f, 0x4b, 0xa1, 0x46, 0xc5, 0xc7, 0xa3, 0x12, 0x30, 0x8b, 0x66,
-	0xaf, 0x6e, 0x58, 0x0c, 0xb9, 0x1e, 0x25, 0xce, 0x20, 0x8c, 0x3f, 0x54, 0x6d, 0x84, 0xe4, 0x39,
-	0x4e, 0x30, 0xd0, 0x79, 0x00, 0x71, 0x75, 0xb8, 0xa7, 0xc6, 0xac, 0x64, 0x1b, 0xd6, 0x4c, 0x20,
-	0x58, 0xc3, 0x62, 0xd3, 0xa8, 0x43, 0xc2, 0x68, 0x8b, 0x0d, 0x17, 0x2c, 0xb9, 0x2c, 0x8a, 0x69,
-	0xb4, 0x2e, 0xcf, 0x70, 0x02, 0x45, 0xff, 0x0b, 0x0b, 0x5d, 0x32, 0xe4, 0x88, 0x05, 0x8e, 0x28,
-	0xfe, 0xce, 0x23, 0x8e, 0x70, 0x0c, 0x63, 0xe3, 0xa3, 0x63, 0x73, 0xac, 0x22, 0xc7, 0xe2, 0xe3,
-	0x63, 0x7d, 0x93, 0x23, 0x49, 0x88, 0x55, 0x7b, 0xf7, 0xa3, 0xd5, 0xb9, 0xf7, 0x3e, 0x5a, 0x9d,
-	0x7b, 0xff, 0xa3, 0xd5, 0xb9, 0x5b, 0x47, 0xab, 0xc6, 0xbb, 0x47, 0xab, 0xc6, 0x7b, 0x47, 0xab,
-	0xc6, 0xfb, 0x47, 0xab, 0xc6, 0x5f, 0x8f, 0x56, 0x8d, 0x1f, 0x7e, 0xbc, 0x3a, 0xf7, 0x42, 0x29,
-	0x56, 0xed, 0x3f, 0x03, 0x00, 0x00, 0xff, 0xff, 0x06, 0xb2, 0x2d, 0xa7, 0xf8, 0x30, 0x00, 0x00,
+	proto.RegisterFile("github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1/generated.proto", fileDescriptor_generated_65b6213fe49d8a81)
+}
+
+var fileDescriptor_generated_65b6213fe49d8a81 = []byte{
+	// 4831 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x3c, 0x5b, 0x8c, 0x24, 0xd7,
+	0x55, 0x5b, 0xfd, 0x98, 0xe9, 0x39, 0x3d, 0x33, 0x3b, 0x73, 0xd7, 0xbb, 0xae, 0xac, 0xf1, 0xcc,
+	0x50, 0xe6, 0xe1, 0x20, 0x67, 0x06, 0x2f, 0x36, 0x71, 0x4c, 0x14, 0x31, 0x3d, 0xb3, 0xeb, 0x9d,
+	0x7d, 0xcc, 0x4e, 0x4e, 0x8f, 0xbd, 0x52, 0x12, 0x99, 0xd4, 0x56, 0xdf, 0xee, 0x2e, 0x77, 0x77,
+	0x55, 0xb9, 0xaa, 0x7a, 0x76, 0xdb, 0x21, 0xc8, 0x60, 0x82, 0xb0, 0x00, 0x11, 0x48, 0x90, 0x78,
+	0x08, 0x11, 0x7e, 0x40, 0x44, 0x7c, 0x46, 0x42, 0xb2, 0xc4, 0x07, 0x08, 0x21, 0x7f, 0x20, 0x11,
+	0x41, 0x24, 0x22, 0x48, 0x56, 0x78, 0xf2, 0x01, 0x9f, 0xf0, 0x83, 0x84, 0xbf, 0xd0, 0x7d, 0x54,
+	0xdd, 0x5b, 0xd5, 0xdd, 0x3b, 0x33, 0xdb, 0x3d, 0xbb, 0x26, 0x7f, 0x5d, 0xf7, 0x9c, 0x3a, 0xe7,
+	0xd6, 0xbd, 0xe7, 0x9e, 0xf7, 0x6d, 0xd8, 0x69, 0xb9, 0x71, 0xbb, 0x7f, 0x67, 0xdd, 0xf1, 0x7b,
+	0x1b, 0x76, 0xd8, 0xf2, 0x83, 0xd0, 0x7f, 0x83, 0xff, 0xf8, 0x84, 0xd3, 0xd8, 0x08, 0x3a, 0xad,
+	0x0d, 0x3b, 0x70, 0xa3, 0x0d, 0x3b, 0x08, 0xba, 0xae, 0x63, 0xc7, 0xae, 0xef, 0x6d, 0x1c, 0x3c,
+	0x6f, 0x77, 0x83, 0xb6, 0xfd, 0xfc, 0x46, 0x8b, 0x7a, 0x34, 0xb4, 0x63, 0xda, 0x58, 0x0f, 0x42,
+	0x3f, 0xf6, 0xc9, 0xa7, 0x14, 0xa9, 0xf5, 0x84, 0x14, 0xff, 0xf1, 0x0b, 0x4e, 0x63, 0x3d, 0xe8,
+	0xb4, 0xd6, 0x19, 0xa9, 0x75, 0x8d, 0xd4, 0x7a, 0x42, 0xea, 0xe2, 0x27, 0xb4, 0x59, 0xb4, 0xfc,
+	0x96, 0xbf, 0xc1, 0x29, 0xde, 0xe9, 0x37, 0xf9, 0x13, 0x7f, 0xe0, 0xbf, 0x04, 0xa7, 0x8b, 0x2f,
+	0x74, 0x5e, 0x8a, 0xd6, 0x5d, 0x9f, 0xcd, 0xad, 0x67, 0x3b, 0x6d, 0xd7, 0xa3, 0xe1, 0x40, 0x4d,
+	0xb6, 0x47, 0x63, 0x7b, 0xe3, 0x60, 0x68, 0x7e, 0x17, 0x37, 0xc6, 0xbd, 0x15, 0xf6, 0xbd, 0xd8,
+	0xed, 0xd1, 0xa1, 0x17, 0x7e, 0xf6, 0xa8, 0x17, 0x22, 0xa7, 0x4d, 0x7b, 0x76, 0xfe, 0x3d, 0xeb,
+	0x4d, 0x58, 0xd8, 0xbc, 0x5d, 0xdf, 0xec, 0xc7, 0xed, 0x2d, 0xdf, 0x6b, 0xba, 0x2d, 0xf2, 0x22,
+	0x54, 0x9d, 0x6e, 0x3f, 0x8a, 0x69, 0xb8, 0x6b, 0xf7, 0xa8, 0x69, 0xac, 0x19, 0xcf, 0xce, 0xd5,
+	0xce, 0xbd, 0x7f, 0x7f, 0xf5, 0xcc, 0xe1, 0xfd, 0xd5, 0xea, 0x96, 0x02, 0xa1, 0x8e, 0x47, 0x3e,
+	0x0e, 0xb3, 0xa1, 0xdf, 0xa5, 0x9b, 0xb8, 0x6b, 0x16, 0xf8, 0x2b, 0x67, 0xe5, 0x2b, 0xb3, 0x28,
+	0x86, 0x31, 0x81, 0x5b, 0xff, 0x66, 0x00, 0x6c, 0x06, 0xc1, 0x5e, 0xe8, 0xbf, 0x41, 0x9d, 0x98,
+	0x7c, 0x11, 0x2a, 0x6c, 0x15, 0x1a, 0x76, 0x6c, 0x73, 0x6e, 0xd5, 0x4b, 0x3f, 0xbd, 0x2e, 0x3e,
+	0x66, 0x5d, 0xff, 0x18, 0xb5, 0x2b, 0x0c, 0x7b, 0xfd, 0xe0, 0xf9, 0xf5, 0x5b, 0x77, 0xd8, 0xfb,
+	0x37, 0x69, 0x6c, 0xd7, 0x88, 0x64, 0x06, 0x6a, 0x0c, 0x53, 0xaa, 0xa4, 0x03, 0xa5, 0x28, 0xa0,
+	0x0e, 0x9f, 0x58, 0xf5, 0xd2, 0xce, 0xfa, 0x43, 0xef, 0xfd, 0xba, 0x9a, 0x76, 0x3d, 0xa0, 0x4e,
+	0x6d, 0x5e, 0xb2, 0x2d, 0xb1, 0x27, 0xe4, 0x4c, 0xac, 0x7f, 0x35, 0x60, 0x51, 0xa1, 0xdd, 0x70,
+	0xa3, 0x98, 0x7c, 0x61, 0xe8, 0x0b, 0xd7, 0x8f, 0xf7, 0x85, 0xec, 0x6d, 0xfe, 0x7d, 0x4b, 0x92,
+	0x51, 0x25, 0x19, 0xd1, 0xbe, 0xee, 0x0d, 0x28, 0xbb, 0x31, 0xed, 0x45, 0x66, 0x61, 0xad, 0xf8,
+	0x6c, 0xf5, 0xd2, 0xe5, 0xa9, 0x7c, 0x5e, 0x6d, 0x41, 0x72, 0x2c, 0xef, 0x30, 0xda, 0x28, 0x58,
+	0x58, 0xff, 0x58, 0xd1, 0x3f, 0x8e, 0x7d, 0x35, 0x79, 0x1e, 0xaa, 0x91, 0xdf, 0x0f, 0x1d, 0x8a,
+	0x34, 0xf0, 0x23, 0xd3, 0x58, 0x2b, 0xb2, 0xcd, 0x67, 0xb2, 0x52, 0x57, 0xc3, 0xa8, 0xe3, 0x90,
+	0xdf, 0x30, 0x60, 0xbe, 0x41, 0xa3, 0xd8, 0xf5, 0x38, 0xff, 0x64, 0xe6, 0x9f, 0x9d, 0x6c, 0xe6,
+	0xc9, 0xe0, 0xb6, 0xa2, 0x5c, 0x7b, 0x42, 0x7e, 0xc5, 0xbc, 0x36, 0x18, 0x61, 0x86, 0x39, 0x13,
+	0xf8, 0x06, 0x8d, 0x9c, 0xd0, 0x0d, 0xd8, 0xb3, 0x59, 0xcc, 0x0a, 0xfc, 0xb6, 0x02, 0xa1, 0x8e,
+	0x47, 0x3a, 0x50, 0x66, 0x02, 0x1d, 0x99, 0x25, 0x3e, 0xf9, 0x2b, 0x13, 0x4c, 0x5e, 0x2e, 0x27,
+	0x3b, 0x28, 0x6a, 0xdd, 0xd9, 0x53, 0x84, 0x82, 0x07, 0xf9, 0x2d, 0x03, 0x4c, 0x79, 0xda, 0x90,
+	0x8a, 0xa5, 0xbc, 0xdd, 0x76, 0x63, 0xda, 0x75, 0xa3, 0xd8, 0x2c, 0xf3, 0x09, 0x6c, 0x1c, 0x4f,
+	0xa4, 0x5e, 0x09, 0xfd, 0x7e, 0x70, 0xdd, 0xf5, 0x1a, 0xb5, 0x35, 0xc9, 0xc9, 0xdc, 0x1a, 0x43,
+	0x18, 0xc7, 0xb2, 0x24, 0x5f, 0x33, 0xe0, 0xa2, 0x67, 0xf7, 0x68, 0x14, 0xd8, 0x0e, 0x4d, 0xc0,
+	0xb5, 0xae, 0xed, 0x74, 0xf8, 0x8c, 0x66, 0x1e, 0x6e, 0x46, 0x96, 0x9c, 0xd1, 0xc5, 0xdd, 0xb1,
+	0xa4, 0xf1, 0x01, 0x6c, 0xc9, 0x57, 0x0d, 0x58, 0x0a, 0xec, 0xd0, 0xee, 0xd1, 0x98, 0x86, 0x7b,
+	0x21, 0x8d, 0x68, 0x1c, 0x99, 0xb3, 0x7c, 0x2e, 0xd7, 0x26, 0xd9, 0x9e, 0x2c, 0xc9, 0x9a, 0x29,
+	0xa7, 0xb9, 0x94, 0x03, 0x44, 0x38, 0xc4, 0x9d, 0xfc, 0x22, 0x54, 0xa3, 0x81, 0xe7, 0xdc, 0x76,
+	0xbd, 0x86, 0x7f, 0x37, 0x32, 0x2b, 0x13, 0x1f, 0xd1, 0x7a, 0x4a, 0x4d, 0xc9, 0xa8, 0x1a, 0x63,
+	0x07, 0x4d, 0x3d, 0x90, 0x6f, 0x18, 0xb0, 0xec, 0x87, 0x41, 0xdb, 0xf6, 0x68, 0x23, 0x59, 0xae,
+	0xc8, 0x9c, 0xe3, 0x2a, 0xe8, 0xf3, 0x13, 0x4c, 0xe2, 0x56, 0x9e, 0xe6, 0x4d, 0xdf, 0x73, 0x63,
+	0x3f, 0xac, 0xd3, 0x38, 0x76, 0xbd, 0x56, 0x54, 0x3b, 0x7f, 0x78, 0x7f, 0x75, 0x79, 0x08, 0x0b,
+	0x87, 0x27, 0x63, 0xfd, 0x7d, 0x11, 0xaa, 0xda, 0xe1, 0x7d, 0x04, 0xd6, 0xa0, 0x9b, 0xb1, 0x06,
+	0xd7, 0xa6, 0xa3, 0x74, 0xc6, 0x99, 0x03, 0x12, 0xc3, 0x4c, 0x14, 0xdb, 0x71, 0x3f, 0xe2, 0x8a,
+	0xa5, 0x7a, 0xe9, 0xc6, 0x94, 0xf8, 0x71, 0x9a, 0xb5, 0x45, 0xc9, 0x71, 0x46, 0x3c, 0xa3, 0xe4,
+	0x45, 0xde, 0x84, 0x39, 0x3f, 0x60, 0x76, 0x9e, 0x69, 0xb4, 0x12, 0x67, 0xbc, 0x3d, 0xc9, 0x7e,
+	0x27, 0xb4, 0x6a, 0x0b, 0x87, 0xf7, 0x57, 0xe7, 0xd2, 0x47, 0x54, 0x5c, 0x2c, 0x07, 0x9e, 0xd0,
+	0xe6, 0xb7, 0xe5, 0x7b, 0x0d, 0x97, 0x6f, 0xe8, 0x1a, 0x94, 0xe2, 0x41, 0x90, 0x38, 0x12, 0xe9,
+	0x12, 0xed, 0x0f, 0x02, 0x8a, 0x1c, 0xc2, 0x5c, 0x87, 0x1e, 0x8d, 0x22, 0xbb, 0x45, 0xf3, 0xae,
+	0xc3, 0x4d, 0x31, 0x8c, 0x09, 0xdc, 0xfa, 0x13, 0x03, 0x2e, 0x8c, 0x56, 0xf5, 0xe4, 0x27, 0x60,
+	0x26, 0xa2, 0xe1, 0x01, 0x0d, 0x25, 0x27, 0xb5, 0x34, 0x7c, 0x14, 0x25, 0x94, 0x6c, 0xc0, 0x5c,
+	0xaa, 0x42, 0x24, 0xbf, 0x65, 0x89, 0x3a, 0xa7, 0xf4, 0x8e, 0xc2, 0x21, 0xcf, 0x41, 0x25, 0xa2,
+	0x5d, 0xea, 0xc4, 0x7e, 0x28, 0x8d, 0x43, 0x6a, 0x8d, 0xeb, 0x72, 0x1c, 0x53, 0x0c, 0xeb, 0x7b,
+	0x06, 0x9c, 0xd5, 0x66, 0xf8, 0x08, 0xec, 0x7f, 0x27, 0x6b, 0xff, 0xaf, 0x4c, 0x47, 0xc0, 0xc6,
+	0x38, 0x00, 0xff, 0x32, 0x03, 0xcb, 0xba, 0x18, 0xf2, 0x53, 0xcc, 0x9d, 0x3f, 0x1a, 0xf8, 0xaf,
+	0xe2, 0x0d, 0xd3, 0xc8, 0xee, 0x20, 0x8a, 0x61, 0x4c, 0xe0, 0x4c, 0x1c, 0x02, 0x3b, 0x6e, 0x9b,
+	0x85, 0xac, 0x38, 0xec, 0xd9, 0x71, 0x1b, 0x39, 0x84, 0xd9, 0x63, 0xea, 0x1d, 0xb8, 0xa1, 0xef,
+	0xf5, 0xa8, 0x17, 0xe7, 0xed, 0xf1, 0x65, 0x05, 0x42, 0x1d, 0x8f, 0x7c, 0x06, 0x16, 0x63, 0x3b,
+	0x6c, 0xd1, 0x18, 0xe9, 0x81, 0x1b, 0x25, 0x72, 0x3f, 0x57, 0xbb, 0x20, 0xdf, 0x5c, 0xdc, 0xcf,
+	0x40, 0x31, 0x87, 0x4d, 0xbe, 0x65, 0xc0, 0x53, 0x8e, 0xdf, 0x0b, 0x7c, 0x8f, 0x7a, 0x71, 0xaa,
+	0xd9, 0x6f, 0x1d, 0xd0, 0x30, 0x74, 0x1b, 0x34, 0x92, 0x56, 0xf6, 0xe6, 0x04, 0xab, 0xbb, 0x35,
+	0x44, 0xbd, 0xf6, 0x8c, 0x9c, 0xdc, 0x53, 0x5b, 0xe3, 0x39, 0xe3, 0x83, 0xa6, 0xc5, 0xdc, 0xaf,
+	0x03, 0xbb, 0xdb, 0xa7, 0xd1, 0x15, 0x97, 0x39, 0x23, 0x33, 0xca, 0xfd, 0x7a, 0x4d, 0x0d, 0xa3,
+	0x8e, 0x43, 0x3c, 0x28, 0xb5, 0x69, 0xb7, 0x67, 0xce, 0x72, 0x51, 0xdc, 0x9b, 0x92, 0x42, 0xe2,
+	0x92, 0x70, 0x95, 0x76, 0x7b, 0xb5, 0x0a, 0xdb, 0x50, 0xf6, 0x0b, 0x39, 0x1f, 0xf2, 0x2b, 0x06,
+	0xcc, 0x75, 0xfa, 0x51, 0xec, 0xf7, 0xdc, 0xb7, 0xa8, 0x59, 0xe1, 0x5c, 0x5f, 0x9d, 0x26, 0xd7,
+	0xeb, 0x09, 0x71, 0xa1, 0x9e, 0xd2, 0x47, 0x54, 0x6c, 0xc9, 0x5b, 0x30, 0xdb, 0x89, 0x7c, 0xcf,
+	0xa3, 0xb1, 0xb4, 0x7f, 0xf5, 0xa9, 0xce, 0x40, 0x90, 0xae, 0x55, 0x99, 0xcc, 0xcb, 0x07, 0x4c,
+	0x18, 0x92, 0x67, 0xa0, 0xec, 0xb4, 0xed, 0x30, 0x36, 0x81, 0x4b, 0x64, 0x7a, 0xb2, 0xb6, 0xd8,
+	0x20, 0x0a, 0x98, 0xf5, 0x77, 0x06, 0x9c, 0x1f, 0xb9, 0x9e, 0xec, 0x40, 0x84, 0xb4, 0x4b, 0xed,
+	0x88, 0x8e, 0x8a, 0xc8, 0x50, 0x81, 0x50, 0xc7, 0x23, 0xeb, 0x00, 0x7c, 0xd7, 0x85, 0x60, 0x14,
+	0xb8, 0x60, 0x2c, 0x32, 0xab, 0xf8, 0x5a, 0x3a, 0x8a, 0x1a, 0x06, 0xd9, 0x86, 0x25, 0xfe, 0x14,
+	0xd5, 0x79, 0xa4, 0xc8, 0x06, 0xe5, 0xe1, 0x4b, 0x1d, 0x9e, 0xd7, 0x72, 0x70, 0x1c, 0x7a, 0xc3,
+	0xfa, 0x2c, 0x98, 0xe3, 0x56, 0x27, 0x7f, 0xb2, 0x8d, 0xe3, 0x9d, 0x6c, 0x6b, 0x0f, 0x2e, 0x8e,
+	0xdf, 0x72, 0x72, 0x09, 0x80, 0xe9, 0xea, 0xbd, 0x90, 0x36, 0xdd, 0x7b, 0x92, 0x66, 0xea, 0x00,
+	0xec, 0xa6, 0x10, 0xd4, 0xb0, 0xac, 0xc3, 0xd9, 0x8c, 0x92, 0xae, 0x27, 0x86, 0x9a, 0x93, 0x36,
+	0x8d, 0xa9, 0x1a, 0x6a, 0xe1, 0xa3, 0x2a, 0x6b, 0xc4, 0x9f, 0x51, 0xf2, 0x22, 0xbf, 0x6e, 0xf0,
+	0xe8, 0x23, 0xb1, 0x62, 0xd2, 0x29, 0x39, 0x85, 0x48, 0x48, 0x0f, 0x68, 0x92, 0x41, 0xd4, 0x59,
+	0x33, 0x25, 0x1e, 0x88, 0x40, 0xc4, 0x2c, 0x66, 0x95, 0x78, 0x12, 0x9f, 0x24, 0x70, 0xd2, 0x07,
+	0x60, 0x6e, 0xe6, 0x9e, 0xdf, 0x75, 0x9d, 0x81, 0xf4, 0x2f, 0x26, 0x75, 0x6a, 0x05, 0x31, 0x21,
+	0xa1, 0xea, 0x19, 0x35, 0x46, 0xe4, 0x2f, 0x0c, 0xb8, 0x60, 0x37, 0x84, 0x5f, 0x61, 0x77, 0xf5,
+	0x90, 0x4e, 0x6a, 0xe7, 0x53, 0x58, 0xb7, 0x15, 0xb9, 0x08, 0x17, 0x36, 0x47, 0x32, 0xc6, 0x31,
+	0x13, 0x1a, 0x1d, 0x8b, 0xcc, 0x3c, 0xd6, 0x58, 0xe4, 0x8f, 0x0d, 0x58, 0x76, 0x5b, 0x9e, 0x1f,
+	0xd2, 0x6d, 0xb7, 0xd9, 0xa4, 0x21, 0xf5, 0x1c, 0x9a, 0xc4, 0x47, 0xfb, 0x13, 0xcc, 0x29, 0x71,
+	0xe6, 0x77, 0xf2, 0xb4, 0x6b, 0x1f, 0x93, 0xb3, 0x5b, 0x1e, 0x02, 0xe1, 0xf0, 0x4c, 0xc8, 0x4d,
+	0x38, 0x17, 0x84, 0x7e, 0x2b, 0xa4, 0x51, 0xe4, 0x7a, 0xad, 0x6d, 0x6a, 0x37, 0xba, 0xae, 0x27,
+	0x0c, 0xc6, 0x5c, 0xed, 0x29, 0x49, 0xea, 0xdc, 0xde, 0x30, 0x0a, 0x8e, 0x7a, 0xcf, 0xfa, 0x16,
+	0x64, 0x5d, 0x15, 0xe1, 0x19, 0xff, 0x8e, 0x01, 0x4b, 0xcc, 0x9e, 0xda, 0xa1, 0x1b, 0xf9, 0x1e,
+	0xd2, 0xa8, 0xdf, 0x8d, 0xe5, 0x89, 0xbf, 0x3e, 0xa1, 0x6d, 0xd7, 0x49, 0xaa, 0x8d, 0xc9, 0x43,
+	0x70, 0x88, 0x3d, 0x89, 0x61, 0xb6, 0xed, 0x46, 0xb1, 0x1f, 0x0e, 0xa4, 0x0f, 0x37, 0x49, 0x8a,
+	0x6a, 0x9b, 0x06, 0x5d, 0x7f, 0xc0, 0x14, 0xe7, 0x8e, 0xd7, 0xf4, 0xd5, 0x21, 0xbe, 0x2a, 0x38,
+	0x60, 0xc2, 0x8a, 0xfc, 0xb2, 0x01, 0x90, 0xca, 0x08, 0x0b, 0x4f, 0x4e, 0xc1, 0xbf, 0x49, 0x15,
+	0x71, 0x3a, 0x14, 0xa1, 0xc6, 0x94, 0xf8, 0x30, 0xd3, 0xa6, 0x76, 0x37, 0x6e, 0x4b, 0x25, 0xf2,
+	0xca, 0x04, 0xec, 0xaf, 0x72, 0x42, 0xf9, 0xc0, 0x48, 0x8c, 0xa2, 0x64, 0x43, 0xbe, 0x62, 0xc0,
+	0x62, 0x1a, 0xb3, 0x30, 0x5c, 0x6a, 0x96, 0x27, 0xce, 0x0a, 0xde, 0xca, 0x10, 0xac, 0x11, 0xe6,
+	0x6d, 0x66, 0xc7, 0x30, 0xc7, 0x94, 0xbc, 0x63, 0x00, 0x38, 0x49, 0x8c, 0x94, 0x28, 0x86, 0x5b,
+	0xd3, 0x51, 0x5f, 0x69, 0xec, 0xa5, 0x96, 0x3f, 0x1d, 0x8a, 0x50, 0x63, 0x4b, 0x7e, 0x2d, 0x9f,
+	0x88, 0x13, 0xca, 0xe0, 0xc6, 0x44, 0xe2, 0x97, 0x92, 0x93, 0x5b, 0x71, 0x9c, 0x1c, 0xdc, 0xd7,
+	0x47, 0x26, 0x2a, 0x44, 0xb6, 0xe4, 0xfa, 0x14, 0x13, 0x15, 0x4a, 0x23, 0x1d, 0x27, 0x39, 0x41,
+	0x7e, 0xdb, 0x80, 0xa5, 0x74, 0xe3, 0xe4, 0x01, 0x32, 0xe7, 0x26, 0x3e, 0xa2, 0x39, 0x79, 0x49,
+	0x55, 0xc5, 0xad, 0x1c, 0x2b, 0x1c, 0x62, 0x4e, 0xbe, 0x08, 0xf3, 0x21, 0x75, 0x7c, 0xcf, 0x71,
+	0xbb, 0xb4, 0xb1, 0x29, 0x3c, 0xca, 0xea, 0xa5, 0x9f, 0x3a, 0x5e, 0x38, 0xb9, 0xef, 0xf6, 0x68,
+	0x6d, 0x89, 0x6d, 0x05, 0x6a, 0x34, 0x30, 0x43, 0xd1, 0x7a, 0x27, 0x1b, 0xc0, 0xee, 0x87, 0x94,
+	0x92, 0x00, 0xca, 0x9e, 0xdf, 0xa0, 0x22, 0xbb, 0x3b, 0xd9, 0x8e, 0x24, 0x8b, 0xcb, 0xe8, 0xee,
+	0xfa, 0x0d, 0x2d, 0xe1, 0xc9, 0x9e, 0x22, 0x14, 0x8c, 0xac, 0x1f, 0x64, 0xbd, 0xe1, 0xdb, 0x76,
+	0xec, 0xb4, 0x2f, 0x1f, 0xb0, 0x38, 0xef, 0x7a, 0x26, 0x9f, 0xf0, 0x49, 0x3d, 0x9f, 0xf0, 0xe1,
+	0xfd, 0xd5, 0x9f, 0x1c, 0x57, 0x06, 0xb9, 0xcb, 0x28, 0xac, 0x73, 0x12, 0x5a, 0xea, 0xe1, 0xcb,
+	0x50, 0xd5, 0x66, 0x29, 0xbd, 0xaf, 0x69, 0x45, 0xd0, 0xa9, 0xcb, 0xa5, 0x0d, 0xa2, 0xce, 0xcf,
+	0xfa, 0x5d, 0x03, 0x66, 0x6b, 0xb6, 0xd3, 0xf1, 0x9b, 0x4d, 0x96, 0x66, 0x68, 0xf4, 0x65, 0xc6,
+	0xc6, 0xc8, 0xa6, 0x19, 0xb6, 0xe5, 0x38, 0xa6, 0x18, 0xc4, 0x82, 0x99, 0xa6, 0xcd, 0x53, 0x12,
+	0x6c, 0xce, 0xc5, 0x1a, 0x30, 0x5d, 0x77, 0x85, 0x8f, 0xa0, 0x84, 0x30, 0x77, 0xbb, 0x67, 0xdf,
+	0x4b, 0x5e, 0xce, 0x07, 0xd2, 0x37, 0x15, 0x08, 0x75, 0x3c, 0xeb, 0x1f, 0x4a, 0x30, 0x2b, 0x53,
+	0xc2, 0xc7, 0x4e, 0xaa, 0xac, 0x41, 0x89, 0xb9, 0xd7, 0xf9, 0xa8, 0x9e, 0x07, 0x25, 0x1c, 0x42,
+	0x02, 0x98, 0x71, 0x78, 0x81, 0x49, 0xe6, 0xc1, 0xae, 0x4e, 0x62, 0x68, 0xc4, 0xec, 0x44, 0xc1,
+	0x4a, 0xcd, 0x49, 0x3c, 0xa3, 0xe4, 0xc3, 0x72, 0xe6, 0x67, 0x1d, 0x16, 0x78, 0x38, 0x4a, 0xd7,
+	0x97, 0x26, 0xce, 0xf9, 0x6d, 0x65, 0x29, 0xd6, 0x9e, 0x94, 0xdc, 0xcf, 0xe6, 0x00, 0x98, 0xe7,
+	0x4d, 0xae, 0x00, 0xf1, 0xfc, 0xb0, 0x67, 0x77, 0xdd, 0xb7, 0x98, 0x4f, 0xe6, 0x37, 0x79, 0x5c,
+	0x56, 0xe6, 0x71, 0xd9, 0x85, 0xc3, 0xfb, 0xab, 0x64, 0x77, 0x08, 0x8a, 0x23, 0xde, 0x20, 0x21,
+	0xcc, 0x74, 0xed, 0x3b, 0xb4, 0x9b, 0x58, 0x8d, 0xdd, 0xc9, 0x57, 0x72, 0xfd, 0x06, 0x27, 0x78,
+	0xd9, 0x8b, 0xc3, 0x81, 0x10, 0x25, 0x31, 0x80, 0x92, 0xd3, 0xc5, 0x4f, 0x41, 0x55, 0x43, 0x21,
+	0x4b, 0x50, 0xec, 0xd0, 0x81, 0x90, 0x09, 0x64, 0x3f, 0xc9, 0x13, 0x50, 0xe6, 0xa1, 0xa0, 0x90,
+	0x00, 0x14, 0x0f, 0x2f, 0x17, 0x5e, 0x32, 0xac, 0xbf, 0x29, 0xc1, 0x42, 0x66, 0xc3, 0x98, 0xa4,
+	0xf7, 0x23, 0x1a, 0x7a, 0x2a, 0x98, 0x4d, 0x25, 0xfd, 0x55, 0x39, 0x8e, 0x29, 0x06, 0xc3, 0x0e,
+	0xec, 0x28, 0xba, 0xeb, 0x87, 0x0d, 0xb3, 0x90, 0xc5, 0xde, 0x93, 0xe3, 0x98, 0x62, 0x30, 0x99,
+	0xbf, 0x43, 0xed, 0x90, 0x86, 0xfb, 0x7e, 0x87, 0x0e, 0xc9, 0x7c, 0x4d, 0x81, 0x50, 0xc7, 0xe3,
+	0xb2, 0x12, 0x77, 0xa3, 0xad, 0xae, 0x4b, 0xbd, 0x58, 0x4c, 0x73, 0x0a, 0xb2, 0xb2, 0x7f, 0xa3,
+	0xae, 0x53, 0x54, 0xb2, 0x92, 0x03, 0x60, 0x9e, 0x37, 0xf3, 0xcd, 0x16, 0xec, 0xbb, 0x91, 0x2a,
+	0xcb, 0x9a, 0xe5, 0x89, 0x4f, 0x4d, 0xa6, 0xcc, 0x5b, 0x5b, 0x3e, 0xbc, 0xbf, 0x9a, 0xad, 0xfc,
+	0x62, 0x96, 0x23, 0x0b, 0x4d, 0x17, 0x3c, 0x1a, 0xdf, 0xf5, 0xc3, 0x8e, 0x9c, 0xc3, 0xcc, 0x9a,
+	0x31, 0xa1, 0x97, 0x92, 0x94, 0x8f, 0x75, 0xb2, 0x62, 0x2a, 0x99, 0x21, 0xcc, 0x32, 0xb6, 0xbe,
+	0x63, 0x40, 0x52, 0x79, 0x7e, 0x04, 0x09, 0xd5, 0x56, 0x36, 0xa1, 0x5a, 0x9b, 0xfc, 0x7b, 0xc7,
+	0x24, 0x53, 0xdf, 0x2b, 0xc0, 0x13, 0xa3, 0x56, 0x84, 0x5c, 0x03, 0xd2, 0x70, 0xed, 0x2e, 0xb3,
+	0xd7, 0x7e, 0x3f, 0xae, 0x33, 0xf3, 0xdc, 0x88, 0xf8, 0x97, 0x16, 0x6b, 0x17, 0x25, 0x29, 0xb2,
+	0x3d, 0x84, 0x81, 0x23, 0xde, 0x22, 0x75, 0x38, 0x1f, 0xd2, 0x37, 0xfb, 0x34, 0x8a, 0x73, 0xe4,
+	0x84, 0xe1, 0x78, 0x5a, 0x92, 0x3b, 0x8f, 0xa3, 0x90, 0x70, 0xf4, 0xbb, 0x2c, 0xe9, 0x12, 0xd2,
+	0x38, 0x1c, 0xdc, 0x70, 0x7b, 0xae, 0x48, 0x17, 0x14, 0x95, 0xb3, 0x89, 0x29, 0x04, 0x35, 0x2c,
+	0x16, 0xde, 0xf1, 0x27, 0x69, 0xf0, 0x92, 0x69, 0x94, 0xf8, 0xcb, 0x69, 0x78, 0x87, 0xc3, 0x28,
+	0x38, 0xea, 0x3d, 0xeb, 0x2b, 0x65, 0x18, 0x8a, 0xad, 0xc8, 0xeb, 0xcc, 0xab, 0x66, 0x63, 0xdc,
+	0x39, 0x32, 0x4e, 0xec, 0x1c, 0x69, 0x0e, 0x73, 0x42, 0x05, 0x35, 0x8a, 0xe4, 0x6d, 0x43, 0x31,
+	0xd8, 0xf7, 0xa5, 0xbf, 0x30, 0xdd, 0x4c, 0xd1, 0xd0, 0x14, 0xf6, 0x7d, 0xd4, 0x78, 0x92, 0x97,
+	0xd3, 0x82, 0x52, 0x99, 0x2b, 0x37, 0x2b, 0x5b, 0x02, 0xfa, 0x30, 0x13, 0x72, 0xe6, 0xca, 0x42,
+	0xcf, 0x41, 0x25, 0x4c, 0xb2, 0xe3, 0xb3, 0x59, 0x5d, 0x9a, 0xe6, 0xc5, 0x53, 0x0c, 0xf2, 0x25,
+	0x98, 0x0b, 0x73, 0xbe, 0xf8, 0xb5, 0x29, 0x78, 0x7e, 0xf5, 0x7e, 0xaf, 0x67, 0x87, 0x03, 0x55,
+	0x75, 0x51, 0x2e, 0xb8, 0xe2, 0x47, 0xfe, 0xcc, 0x80, 0xf3, 0xea, 0xab, 0xb5, 0xd0, 0x41, 0xa6,
+	0x6f, 0x4f, 0x21, 0xd5, 0x93, 0x1e, 0x85, 0xad, 0x51, 0x7c, 0x71, 0xf4, 0x74, 0xac, 0xdf, 0x34,
+	0x80, 0x0c, 0x47, 0xbe, 0xac, 0xcc, 0x94, 0xa6, 0xed, 0xa5, 0x95, 0x4b, 0x3f, 0x38, 0x45, 0x47,
+	0x85, 0x73, 0x0c, 0x17, 0xea, 0x99, 0xc4, 0xc6, 0x16, 0xb3, 0x69, 0x64, 0x9e, 0x95, 0x95, 0x26,
+	0xd7, 0xfa, 0x5b, 0x03, 0xf2, 0xae, 0x08, 0xf7, 0xe2, 0x84, 0xc8, 0xe4, 0xbd, 0xb8, 0xac, 0x78,
+	0x1c, 0xbf, 0x10, 0x47, 0xbe, 0x00, 0x55, 0x3b, 0x8e, 0x69, 0x2f, 0x88, 0xf9, 0x49, 0x2b, 0x9e,
+	0xf8, 0xa4, 0xf1, 0x3c, 0xdf, 0x4d, 0xbf, 0xe1, 0x36, 0x5d, 0x7e, 0xca, 0x74, 0x72, 0xd6, 0x1f,
+	0x96, 0x61, 0x31, 0x9b, 0xc7, 0xc8, 0x88, 0x6e, 0xe1, 0x48, 0xd1, 0x3d, 0xaa, 0x98, 0x53, 0xfc,
+	0x68, 0x16, 0x73, 0x5e, 0x07, 0x68, 0xf0, 0xcf, 0xe6, 0x8b, 0x5a, 0x7a, 0x78, 0xf5, 0xb5, 0x9d,
+	0x52, 0x41, 0x8d, 0x22, 0xb9, 0x08, 0x05, 0xb7, 0xc1, 0xf5, 0x46, 0xb1, 0x06, 0x12, 0xb7, 0xb0,
+	0xb3, 0x8d, 0x05, 0xb7, 0x41, 0x5e, 0x82, 0xf9, 0x9e, 0xed, 0xb9, 0x4d, 0x1a, 0xc5, 0x11, 0xd2,
+	0x26, 0x37, 0xf6, 0x73, 0x2a, 0x78, 0xbf, 0xa9, 0xc1, 0x30, 0x83, 0xc9, 0xc4, 0x2b, 0xe0, 0x29,
+	0x46, 0x73, 0x36, 0x2b, 0x5e, 0x22, 0xf1, 0x88, 0x12, 0x4a, 0x7e, 0x35, 0x97, 0xeb, 0xae, 0x9c,
+	0xd6, 0x41, 0x3e, 0xfb, 0xc0, 0x3c, 0xf7, 0x67, 0x60, 0xd1, 0x6d, 0xd0, 0x5e, 0xe0, 0xc7, 0xd4,
+	0x73, 0x06, 0xd7, 0xe9, 0xc0, 0x9c, 0xcb, 0x16, 0x0a, 0x77, 0x32, 0x50, 0xcc, 0x61, 0x5b, 0xef,
+	0x16, 0xe1, 0xa2, 0x46, 0x5c, 0x15, 0xc3, 0x85, 0x09, 0xca, 0x67, 0xf4, 0x8d, 0xc7, 0x97, 0xd1,
+	0x7f, 0x11, 0xca, 0x41, 0xdb, 0x8e, 0x92, 0xd3, 0xbc, 0x9a, 0x28, 0x8c, 0x3d, 0x36, 0xf8, 0xa1,
+	0x9e, 0xa4, 0xe2, 0x23, 0x28, 0xb0, 0x75, 0x35, 0x50, 0x3c, 0x42, 0x0d, 0xfc, 0x92, 0x28, 0x04,
+	0xc8, 0x34, 0xaa, 0x10, 0xd8, 0xdd, 0x09, 0x0b, 0x01, 0xb9, 0x05, 0x55, 0x15, 0x01, 0xf1, 0x8c,
+	0x1a, 0x47, 0xeb, 0x7f, 0x0a, 0xb0, 0x3c, 0x94, 0x71, 0xfa, 0x28, 0x6d, 0x81, 0xb2, 0xd6, 0x85,
+	0x13, 0x5b, 0x6b, 0x95, 0x1c, 0x2d, 0x3e, 0x9a, 0xe4, 0xa8, 0xb6, 0xf1, 0xa5, 0x23, 0x1a, 0x31,
+	0x3e, 0x30, 0x60, 0x5e, 0xa7, 0x79, 0x6c, 0x1b, 0xf3, 0x73, 0xb0, 0x20, 0x7e, 0x6d, 0xd3, 0xd8,
+	0x76, 0xbb, 0xc9, 0xba, 0x9c, 0x97, 0xe8, 0x0b, 0x75, 0x1d, 0x88, 0x59, 0x5c, 0xd2, 0x85, 0x25,
+	0x2d, 0xd3, 0x5f, 0x77, 0x3d, 0x87, 0x3e, 0x84, 0xe9, 0x79, 0x82, 0xd7, 0x4b, 0x72, 0x74, 0x70,
+	0x88, 0xb2, 0xf5, 0x7e, 0x01, 0xe0, 0xaa, 0xef, 0x77, 0xe4, 0x17, 0x26, 0x06, 0xda, 0x18, 0x6b,
+	0xa0, 0xd7, 0xa0, 0xd4, 0x71, 0xbd, 0x46, 0xde, 0x84, 0xb3, 0xde, 0x36, 0xe4, 0x10, 0xe6, 0x37,
+	0xdb, 0x81, 0xfb, 0x1a, 0x0d, 0x23, 0x95, 0x91, 0x49, 0x95, 0xf6, 0xe6, 0xde, 0x8e, 0x84, 0xa0,
+	0x86, 0x45, 0x9e, 0x93, 0x09, 0xaf, 0x52, 0xa6, 0x16, 0x9b, 0x24, 0xbc, 0x2a, 0x6c, 0x86, 0x5a,
+	0x46, 0xeb, 0xa5, 0x9c, 0x7b, 0xb8, 0x36, 0x24, 0x70, 0xf9, 0x53, 0x3f, 0xc2, 0xfa, 0xcf, 0x1c,
+	0x71, 0xec, 0x33, 0x3d, 0x34, 0xb3, 0x47, 0xf7, 0xd0, 0x58, 0x75, 0xa8, 0x5c, 0xbb, 0xbd, 0x2f,
+	0x62, 0x6d, 0x0b, 0x8a, 0xae, 0x1d, 0xcb, 0x68, 0x26, 0x35, 0xe2, 0x3b, 0x51, 0xd4, 0xe7, 0xf6,
+	0x8a, 0x01, 0xc9, 0x33, 0x50, 0xa4, 0xf7, 0x02, 0x19, 0xa2, 0xa4, 0xa4, 0x2f, 0xdf, 0x0b, 0xdc,
+	0x90, 0x46, 0x0c, 0x89, 0xde, 0x0b, 0xac, 0x3f, 0x2a, 0x80, 0x6a, 0x45, 0x22, 0x4d, 0x28, 0x31,
+	0xc5, 0x60, 0x1a, 0x13, 0x07, 0xca, 0x19, 0x25, 0x24, 0xba, 0x19, 0xd8, 0x10, 0x72, 0xfa, 0x4c,
+	0x80, 0x1d, 0x3f, 0x0c, 0x69, 0x97, 0x83, 0x77, 0xb6, 0xf3, 0x02, 0xbc, 0xa5, 0x03, 0x31, 0x8b,
+	0xcb, 0xd6, 0x38, 0x16, 0x91, 0x54, 0x5e, 0xb5, 0xca, 0x00, 0x0b, 0x13, 0xf8, 0x08, 0x33, 0x55,
+	0x3a, 0x91, 0x99, 0xfa, 0x8e, 0x01, 0x2a, 0xa1, 0xbc, 0x29, 0x9c, 0x2b, 0x65, 0x11, 0x8c, 0x87,
+	0xb5, 0x08, 0x47, 0x39, 0x86, 0xaf, 0x03, 0x34, 0x5d, 0xcf, 0x8d, 0xda, 0x0f, 0xe9, 0x17, 0xa6,
+	0xa7, 0xe1, 0x4a, 0x4a, 0x05, 0x35, 0x8a, 0xd6, 0xf7, 0x67, 0x21, 0x57, 0x5b, 0x21, 0x7d, 0xbd,
+	0xd9, 0xcd, 0x98, 0x62, 0xb3, 0x5b, 0x2a, 0x78, 0xa3, 0x1a, 0xde, 0x7e, 0xf8, 0xad, 0x2b, 0xf9,
+	0x3c, 0xcc, 0x45, 0xb1, 0x1d, 0x0a, 0x17, 0x7f, 0xe6, 0xc4, 0x5b, 0x99, 0x2e, 0x5f, 0x3d, 0x21,
+	0x82, 0x8a, 0x1e, 0xf9, 0x5c, 0x46, 0x50, 0x66, 0x1f, 0x2e, 0x80, 0x18, 0x2d, 0x24, 0x64, 0x00,
+	0x15, 0x19, 0x4e, 0x4c, 0xa5, 0x88, 0x94, 0x3b, 0x45, 0x4a, 0x69, 0xc9, 0x81, 0x08, 0x53, 0x76,
+	0xe4, 0x4f, 0x0d, 0x20, 0x9a, 0x03, 0x20, 0x56, 0x32, 0x92, 0x45, 0xa3, 0x57, 0xa7, 0x53, 0x58,
+	0xcb, 0xef, 0xa1, 0x4a, 0x09, 0x0d, 0x31, 0xc6, 0x11, 0x93, 0x21, 0x4d, 0x58, 0x64, 0x3a, 0x9f,
+	0x6e, 0xb5, 0x6d, 0xaf, 0xf5, 0x90, 0x65, 0x24, 0x5e, 0xe4, 0xac, 0x67, 0xa8, 0x60, 0x8e, 0x2a,
+	0xb3, 0x76, 0x31, 0x0d, 0x7b, 0x8c, 0x3b, 0x6d, 0x98, 0xd5, 0x35, 0xe3, 0xd9, 0x8a, 0x3a, 0xdf,
+	0xfb, 0x29, 0x04, 0x35, 0x2c, 0xeb, 0x2f, 0x99, 0xda, 0xca, 0x15, 0xe2, 0x58, 0xe4, 0xdb, 0x62,
+	0x5d, 0xe2, 0xa6, 0x91, 0x8d, 0x7c, 0x79, 0xeb, 0x38, 0x0a, 0xd8, 0x31, 0xac, 0x6f, 0xc6, 0x6c,
+	0x15, 0x8f, 0xd1, 0xfa, 0x99, 0x98, 0xfc, 0xd2, 0x38, 0x93, 0x6f, 0xfd, 0x3c, 0xac, 0x1d, 0xd5,
+	0x0c, 0x4d, 0x7e, 0x04, 0x4a, 0x77, 0xed, 0x50, 0xa8, 0xa6, 0x8a, 0xb0, 0x27, 0xb7, 0xed, 0xd0,
+	0x43, 0x3e, 0xca, 0x6a, 0x40, 0x64, 0x44, 0x28, 0x18, 0x26, 0x49, 0x48, 0xe3, 0x34, 0x42, 0xd5,
+	0x91, 0xf9, 0xc8, 0x97, 0x2b, 0xbf, 0xff, 0x8d, 0xd5, 0x33, 0x6f, 0x7f, 0x6f, 0xed, 0x8c, 0xf5,
+	0x57, 0x06, 0x9c, 0xcd, 0x75, 0x94, 0x1c, 0xc3, 0xff, 0xc9, 0x75, 0x14, 0x14, 0x1e, 0x43, 0x47,
+	0x81, 0xf5, 0xcd, 0x02, 0x54, 0xb5, 0xfb, 0x14, 0xc7, 0x98, 0x75, 0xee, 0xfe, 0x47, 0xe1, 0x98,
+	0xf7, 0x3f, 0x9e, 0x85, 0x4a, 0xe0, 0x77, 0x5d, 0xc7, 0x95, 0xe9, 0x84, 0xb9, 0xda, 0x3c, 0xaf,
+	0x49, 0xc8, 0x31, 0x4c, 0xa1, 0x24, 0x86, 0xb9, 0x37, 0xee, 0xc6, 0xdc, 0xf9, 0x49, 0x6e, 0x8b,
+	0x6c, 0x4d, 0xb0, 0x28, 0x89, 0x23, 0xa5, 0x64, 0x37, 0x19, 0x89, 0x50, 0x31, 0x62, 0x15, 0x42,
+	0x7e, 0x2e, 0x92, 0x12, 0x13, 0x2f, 0xeb, 0xf0, 0x03, 0x13, 0xa1, 0x84, 0x58, 0xff, 0x5b, 0x00,
+	0xe0, 0x57, 0x72, 0x5c, 0x5e, 0x5c, 0x5e, 0x83, 0x52, 0x48, 0x03, 0x3f, 0xbf, 0x56, 0x0c, 0x03,
+	0x39, 0x24, 0x53, 0xba, 0x29, 0x9c, 0xa8, 0x74, 0x53, 0x3c, 0xb2, 0x74, 0xc3, 0x22, 0x83, 0xa8,
+	0xbd, 0x17, 0xba, 0x07, 0x76, 0x4c, 0x95, 0xbf, 0xa3, 0x22, 0x83, 0xfa, 0x55, 0x05, 0xc4, 0x2c,
+	0xee, 0xc8, 0x62, 0x5f, 0xf9, 0x31, 0x16, 0xfb, 0x92, 0xae, 0xf7, 0x99, 0x71, 0x5d, 0xef, 0xfc,
+	0x9e, 0x98, 0x5a, 0xfb, 0xff, 0x5f, 0xf7, 0xc4, 0xd4, 0xbc, 0xc7, 0x54, 0x36, 0xde, 0x2d, 0xc2,
+	0xd9, 0x44, 0x1f, 0x26, 0xc1, 0xdb, 0x34, 0xe2, 0xa7, 0x13, 0x6b, 0xf0, 0xe3, 0x87, 0xb4, 0xe4,
+	0xd3, 0xb9, 0xc8, 0xe9, 0xc7, 0x86, 0x22, 0x27, 0x92, 0xa6, 0xb0, 0x07, 0x9e, 0x93, 0x8b, 0x6b,
+	0x3f, 0x0d, 0x33, 0x36, 0xdf, 0x7f, 0x73, 0x26, 0xfb, 0xf6, 0x26, 0x1f, 0xcd, 0xbf, 0x2d, 0x46,
+	0x51, 0xbe, 0xc3, 0xbe, 0xbc, 0xe1, 0x36, 0x9b, 0xe6, 0x6c, 0xf6, 0xcb, 0x59, 0x77, 0x1c, 0x72,
+	0x08, 0x4b, 0xcf, 0x25, 0x57, 0x37, 0xd9, 0x87, 0x9a, 0x95, 0x6c, 0x7a, 0xee, 0x15, 0x0d, 0x86,
+	0x19, 0x4c, 0xeb, 0x3d, 0x03, 0x3e, 0x36, 0xb6, 0x45, 0x6f, 0x5a, 0xa6, 0x35, 0xd9, 0xdc, 0xe2,
+	0xd8, 0xcd, 0x7d, 0x01, 0xe6, 0xdf, 0x88, 0x7c, 0x6f, 0xcf, 0x77, 0x3d, 0x6e, 0x1d, 0x4a, 0x5c,
+	0x2b, 0xf1, 0x6e, 0x94, 0x6b, 0xf5, 0x5b, 0xbb, 0xc9, 0x38, 0x66, 0xb0, 0xac, 0x6f, 0x1a, 0x30,
+	0x9f, 0x4c, 0x9e, 0x35, 0x88, 0xb0, 0xf9, 0x72, 0x2f, 0x23, 0x3f, 0x5f, 0x71, 0x0e, 0x05, 0x8c,
+	0xf4, 0xa1, 0xe2, 0xb4, 0xdd, 0x6e, 0x23, 0xa4, 0x9e, 0x94, 0xf6, 0x57, 0xa6, 0x50, 0xb8, 0x60,
+	0xfc, 0xd5, 0x09, 0xdb, 0x92, 0x0c, 0x30, 0x65, 0x65, 0xfd, 0xb7, 0x01, 0xd5, 0x04, 0x99, 0x25,
+	0x46, 0x8f, 0xb5, 0xb6, 0x1f, 0x87, 0xd9, 0x03, 0x99, 0x0f, 0xc8, 0xc5, 0x56, 0x49, 0x32, 0x20,
+	0x81, 0xa7, 0xdb, 0x50, 0x3c, 0xde, 0xf9, 0x28, 0x9d, 0xc0, 0xc3, 0x29, 0x8f, 0xdd, 0xb7, 0xa7,
+	0xa1, 0xd8, 0x77, 0x1b, 0x52, 0xaa, 0xab, 0x12, 0xa1, 0xf8, 0xea, 0xce, 0x36, 0xb2, 0x71, 0xeb,
+	0xbd, 0x22, 0x2c, 0xa4, 0x82, 0xcd, 0x17, 0xff, 0x45, 0xa8, 0x8a, 0xab, 0x15, 0x75, 0x6d, 0x9f,
+	0x52, 0x7b, 0xba, 0xaf, 0x40, 0xa8, 0xe3, 0xb1, 0xa9, 0x77, 0xdd, 0x03, 0x41, 0x23, 0x7f, 0x2f,
+	0xe7, 0x46, 0x02, 0x40, 0x85, 0xa3, 0xa5, 0xd6, 0x8a, 0x27, 0x4e, 0xad, 0x7d, 0xcd, 0x00, 0xc2,
+	0xb7, 0x8d, 0x51, 0x56, 0x0d, 0x67, 0xa5, 0xe9, 0xca, 0x4a, 0xea, 0x97, 0x6f, 0x0d, 0xb1, 0xc2,
+	0x11, 0xec, 0xb5, 0x84, 0x5f, 0xf9, 0x91, 0x24, 0xfc, 0xac, 0x77, 0x34, 0x35, 0x2d, 0xcb, 0x72,
+	0x8f, 0x41, 0x68, 0x8f, 0xf4, 0xb2, 0x27, 0xaa, 0x79, 0xaa, 0x45, 0x9d, 0x79, 0x34, 0x59, 0xd4,
+	0x13, 0x27, 0xc7, 0xfe, 0xba, 0x08, 0x4b, 0xf9, 0xb6, 0x38, 0xd6, 0x99, 0x16, 0x2a, 0x55, 0x62,
+	0x1a, 0x13, 0x77, 0xa6, 0x69, 0x8a, 0x49, 0xbf, 0x3c, 0x92, 0x0e, 0xa2, 0xce, 0x8f, 0xbc, 0xc5,
+	0x3d, 0x79, 0x56, 0xa2, 0xa4, 0xcd, 0x69, 0xdc, 0x2c, 0xd3, 0xb9, 0xeb, 0x2e, 0xbc, 0xe4, 0x80,
+	0x1a, 0x37, 0xb2, 0x09, 0x67, 0x93, 0xa9, 0x64, 0x33, 0xa5, 0xa9, 0xfb, 0x85, 0x59, 0x30, 0xe6,
+	0xf1, 0x49, 0xe7, 0xb4, 0xfa, 0x8a, 0x61, 0xc4, 0x29, 0xfa, 0x67, 0x83, 0xa9, 0xc0, 0x38, 0x1c,
+	0xd4, 0x63, 0x66, 0x74, 0x5b, 0xfc, 0x0c, 0x75, 0x79, 0x67, 0x84, 0x48, 0x72, 0xa6, 0x67, 0x48,
+	0x34, 0x45, 0x08, 0x18, 0x71, 0x61, 0xf6, 0x8e, 0x68, 0x69, 0x90, 0x7d, 0x04, 0x93, 0x34, 0x9a,
+	0xc8, 0xe6, 0x08, 0x71, 0x01, 0x49, 0x3e, 0x60, 0x42, 0x9f, 0x05, 0xe2, 0x4d, 0x9b, 0xf5, 0x77,
+	0xde, 0xf2, 0xba, 0x03, 0xb3, 0x98, 0x0d, 0xc4, 0xaf, 0xa4, 0x10, 0xd4, 0xb0, 0xac, 0xef, 0x57,
+	0x61, 0x21, 0x93, 0x30, 0xca, 0x94, 0x60, 0x8d, 0x23, 0x4b, 0xb0, 0xcf, 0x40, 0x39, 0x08, 0xfb,
+	0x9e, 0xd0, 0xe5, 0x15, 0xb5, 0x06, 0x7b, 0x6c, 0x10, 0x05, 0x8c, 0x55, 0x0d, 0x1a, 0xe1, 0x00,
+	0xfb, 0x9e, 0x9c, 0x54, 0x7a, 0xa6, 0xb6, 0xf9, 0x28, 0x4a, 0x28, 0xf9, 0x32, 0xcc, 0x47, 0xdc,
+	0xe7, 0x12, 0x0b, 0x3c, 0x85, 0x5d, 0xad, 0x6b, 0xe4, 0x84, 0x17, 0xa2, 0x8f, 0x60, 0x86, 0x1d,
+	0xf9, 0x3d, 0x03, 0x48, 0x30, 0xea, 0x4a, 0xa0, 0x31, 0x61, 0x80, 0x3b, 0x1c, 0xf8, 0x8b, 0x56,
+	0xc0, 0xe1, 0x71, 0x1c, 0x31, 0x01, 0x16, 0x70, 0x6b, 0x2d, 0x1a, 0xa2, 0x1d, 0x70, 0x6f, 0x8a,
+	0x09, 0x42, 0x4e, 0xf8, 0x88, 0x46, 0x8d, 0x6b, 0x40, 0x78, 0xc3, 0x65, 0xd8, 0xdb, 0xc2, 0xed,
+	0x6d, 0xda, 0xa5, 0x71, 0xd2, 0x5d, 0x52, 0xd1, 0x0c, 0xe0, 0x10, 0x06, 0x8e, 0x78, 0x8b, 0x74,
+	0xe0, 0x02, 0x97, 0x8b, 0xbd, 0xd0, 0x0f, 0xec, 0x96, 0xc8, 0x9d, 0x8a, 0x3b, 0x46, 0xc2, 0xdd,
+	0xfd, 0x99, 0xe4, 0x32, 0xce, 0xde, 0x48, 0xac, 0x0f, 0xef, 0xaf, 0x2e, 0x0f, 0x0d, 0xe2, 0x18,
+	0x92, 0xc4, 0x85, 0x32, 0xef, 0x2b, 0x32, 0xe7, 0x26, 0xae, 0x18, 0x64, 0x4e, 0x7f, 0x6d, 0x8e,
+	0xff, 0x7d, 0x03, 0x1b, 0x42, 0xc1, 0x81, 0x5d, 0xad, 0x63, 0xef, 0x0d, 0xb6, 0x7c, 0xcf, 0xe9,
+	0x87, 0xcc, 0xf3, 0x1e, 0xf0, 0x94, 0x5b, 0x51, 0xf5, 0x7e, 0x6f, 0xe6, 0xe0, 0x38, 0xf4, 0x06,
+	0xf9, 0x03, 0x03, 0x96, 0xe9, 0x3d, 0xa7, 0xdb, 0x6f, 0xe8, 0x4d, 0xf2, 0xd5, 0x53, 0xda, 0xf5,
+	0xb4, 0x53, 0xfe, 0x72, 0x9e, 0x25, 0x0e, 0xcf, 0x42, 0xeb, 0x01, 0x98, 0x7f, 0x60, 0x0f, 0xc0,
+	0x97, 0xa0, 0xd2, 0xf3, 0x0f, 0xe8, 0x95, 0xd0, 0xef, 0x99, 0x0b, 0xa7, 0x55, 0x96, 0xe5, 0x89,
+	0x98, 0x9b, 0x92, 0x0d, 0xa6, 0x0c, 0x49, 0x0b, 0x9e, 0x4e, 0x32, 0x8d, 0xae, 0xef, 0xbd, 0x12,
+	0xda, 0x0e, 0xdd, 0xa3, 0xa1, 0xeb, 0x37, 0x92, 0x5e, 0xb4, 0x45, 0xbe, 0x27, 0x3f, 0x7a, 0x78,
+	0x7f, 0xf5, 0xe9, 0xfd, 0x07, 0x21, 0xe2, 0x83, 0xe9, 0xb0, 0x56, 0x37, 0x5f, 0x1e, 0x52, 0xed,
+	0xbf, 0x19, 0xcc, 0xb3, 0xfc, 0x50, 0xa4, 0xad, 0x6e, 0xb7, 0x86, 0x51, 0x70, 0xd4, 0x7b, 0xac,
+	0x85, 0x2f, 0xa2, 0xdd, 0x26, 0x33, 0x3b, 0x49, 0xc6, 0x79, 0xcb, 0xef, 0x7b, 0xb1, 0xb9, 0x94,
+	0x6d, 0xe1, 0xab, 0x8f, 0x42, 0xc2, 0xd1, 0xef, 0x5a, 0x6f, 0x1b, 0x70, 0x7e, 0xe4, 0xce, 0x3f,
+	0xb2, 0x90, 0xd0, 0xfa, 0xfa, 0x0c, 0x9c, 0x1b, 0x51, 0x93, 0x20, 0x77, 0x75, 0xad, 0x66, 0x4c,
+	0xad, 0xf1, 0x4c, 0x26, 0x22, 0xc4, 0x25, 0xe1, 0x91, 0xba, 0xec, 0x64, 0x4d, 0x46, 0x4d, 0x28,
+	0xb7, 0x7d, 0xbf, 0x93, 0x74, 0x13, 0x4d, 0x92, 0x50, 0x51, 0x65, 0x66, 0xa1, 0x3d, 0xd8, 0x73,
+	0x84, 0x82, 0x3c, 0x73, 0xb6, 0x23, 0xe1, 0x9c, 0xe7, 0x73, 0x18, 0xd2, 0x67, 0xc7, 0x04, 0xce,
+	0x2e, 0xf4, 0x2c, 0x32, 0x71, 0xd7, 0xf4, 0x43, 0x79, 0xea, 0xeb, 0xc7, 0x53, 0xff, 0x37, 0x33,
+	0x5c, 0x30, 0xc7, 0x95, 0x7c, 0x12, 0x16, 0x1a, 0xd4, 0x73, 0xd9, 0x90, 0x1d, 0x25, 0x37, 0x9c,
+	0xe6, 0x44, 0xab, 0xef, 0xb6, 0x0e, 0xc0, 0x2c, 0x1e, 0x79, 0xd7, 0x80, 0xb3, 0xc2, 0x0b, 0x51,
+	0x9f, 0x30, 0x3b, 0xf5, 0x4f, 0x38, 0xc7, 0xbc, 0xc8, 0x2b, 0x59, 0x36, 0x98, 0xe7, 0x4b, 0xfa,
+	0x70, 0x4e, 0xb8, 0x78, 0xb7, 0x6d, 0x37, 0x4e, 0x8b, 0x58, 0x66, 0xe5, 0xc4, 0xc5, 0x92, 0x27,
+	0xd9, 0x71, 0xbf, 0x3a, 0x4c, 0x0a, 0x47, 0xd1, 0xb7, 0xfe, 0xbc, 0x00, 0xda, 0x0d, 0x58, 0xd6,
+	0x86, 0x69, 0xf7, 0x63, 0xbf, 0xc7, 0x8b, 0x28, 0xc6, 0x54, 0x8a, 0x80, 0x82, 0xf2, 0x66, 0x42,
+	0x55, 0x9c, 0x88, 0xf4, 0x11, 0x15, 0x3f, 0xfe, 0xef, 0x4e, 0xfc, 0x84, 0xaa, 0x3f, 0x6a, 0x4a,
+	0xfe, 0xdd, 0x49, 0x0d, 0xa3, 0x8e, 0xa3, 0xec, 0x6a, 0xf1, 0xb4, 0xed, 0xaa, 0xd5, 0x86, 0x73,
+	0x23, 0x3e, 0x47, 0xb9, 0x9e, 0xc6, 0x03, 0x5c, 0x4f, 0xf1, 0xb7, 0x1e, 0x5c, 0x31, 0x4a, 0x17,
+	0x55, 0xff, 0x5b, 0x0f, 0x3e, 0x8e, 0x29, 0x86, 0xf5, 0x5f, 0x05, 0xc8, 0x38, 0x88, 0xa4, 0x07,
+	0x65, 0x6e, 0xa0, 0xa7, 0x70, 0x5b, 0x5c, 0xa7, 0xcb, 0xdd, 0x00, 0xf1, 0xa5, 0xfc, 0x27, 0x0a,
+	0x2e, 0xc4, 0x85, 0x12, 0x53, 0x06, 0x32, 0x52, 0xb8, 0x3e, 0x25, 0x6e, 0x4c, 0xcd, 0xc8, 0xbf,
+	0x6b, 0xf0, 0xfd, 0x0e, 0x72, 0x16, 0xec, 0x8a, 0x64, 0x35, 0xed, 0x85, 0x39, 0x48, 0x1a, 0x6c,
+	0x70, 0x4a, 0x2c, 0xf7, 0x14, 0x65, 0x21, 0x47, 0xda, 0x00, 0xea, 0x7c, 0xad, 0x97, 0x60, 0x79,
+	0x68, 0x65, 0xd8, 0xd6, 0x36, 0xfd, 0xd0, 0x19, 0xda, 0xda, 0x2b, 0x6c, 0x10, 0x05, 0xcc, 0xfa,
+	0x0f, 0x03, 0x96, 0xf2, 0x9f, 0xc9, 0x7c, 0xf8, 0xe5, 0x28, 0x4f, 0xef, 0x54, 0x76, 0x2f, 0xf5,
+	0x9c, 0x86, 0x40, 0x38, 0x3c, 0x03, 0x56, 0xf6, 0x10, 0x4a, 0x40, 0x76, 0x80, 0xe4, 0xfb, 0x49,
+	0xae, 0xea, 0x40, 0xcc, 0xe2, 0x5a, 0x87, 0x06, 0x3c, 0x39, 0x66, 0x75, 0x3f, 0xb2, 0x1f, 0xbc,
+	0x01, 0x73, 0x77, 0xec, 0xd8, 0x69, 0xd7, 0xd9, 0xbf, 0x81, 0xe4, 0x3a, 0x7c, 0x6a, 0x09, 0x00,
+	0x15, 0x8e, 0xf5, 0x4f, 0x06, 0x80, 0x72, 0x87, 0xc8, 0x25, 0xe9, 0x79, 0x08, 0xef, 0x64, 0x45,
+	0xf7, 0x3c, 0x58, 0xd7, 0x85, 0xc2, 0xd4, 0x7c, 0x11, 0x76, 0xd8, 0x9d, 0x36, 0x6d, 0xf4, 0xbb,
+	0x43, 0x75, 0xab, 0xba, 0x1c, 0xc7, 0x14, 0x23, 0x73, 0x15, 0xaf, 0x78, 0xe4, 0x55, 0xbc, 0x17,
+	0x60, 0x5e, 0x5b, 0xa7, 0x4c, 0x62, 0x5b, 0xf3, 0x4f, 0x23, 0xcc, 0x60, 0x59, 0xff, 0x69, 0x40,
+	0xfe, 0x1a, 0x10, 0xe3, 0xeb, 0x7a, 0x11, 0x75, 0xfa, 0x61, 0x22, 0xdf, 0xaa, 0x3d, 0x4a, 0x8e,
+	0x63, 0x8a, 0xc1, 0x82, 0x7a, 0x71, 0xfb, 0x6e, 0x57, 0x55, 0xe3, 0xd2, 0xa0, 0xbe, 0x9e, 0x42,
+	0x50, 0xc3, 0x62, 0x45, 0x4b, 0x87, 0x86, 0xf1, 0xb6, 0x1d, 0xdb, 0xfc, 0xcb, 0xe6, 0x85, 0xaf,
+	0xbc, 0x25, 0xc7, 0x30, 0x85, 0x92, 0x1f, 0x87, 0xd9, 0x0e, 0x1d, 0x70, 0xc4, 0x12, 0x47, 0x14,
+	0x7f, 0x6d, 0x22, 0x86, 0x30, 0x81, 0xb1, 0x2a, 0xa3, 0x63, 0x73, 0xac, 0x32, 0xc7, 0xe2, 0xf9,
+	0x91, 0xad, 0x4d, 0x8e, 0x24, 0x21, 0xb5, 0xf5, 0xf7, 0x3f, 0x58, 0x39, 0xf3, 0xed, 0x0f, 0x56,
+	0xce, 0x7c, 0xf7, 0x83, 0x95, 0x33, 0x6f, 0x1f, 0xae, 0x18, 0xef, 0x1f, 0xae, 0x18, 0xdf, 0x3e,
+	0x5c, 0x31, 0xbe, 0x7b, 0xb8, 0x62, 0xfc, 0xfb, 0xe1, 0x8a, 0xf1, 0xd5, 0x1f, 0xac, 0x9c, 0xf9,
+	0x5c, 0x25, 0x11, 0xb0, 0xff, 0x1b, 0x00, 0x6e, 0x4d, 0x84, 0x54, 0xe7, 0x53, 0x00, 0x00,
 }
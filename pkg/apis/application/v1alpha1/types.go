@@ -6,6 +6,7 @@ import (
 	"path/filepath"
 	"reflect"
 	"strings"
+	"time"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
@@ -15,6 +16,7 @@ import (
 	"k8s.io/client-go/tools/clientcmd/api"
 
 	"github.com/argoproj/argo-cd/common"
+	"github.com/argoproj/argo-cd/util/cron"
 	"github.com/argoproj/argo-cd/util/git"
 )
 
@@ -40,11 +42,72 @@ type ApplicationSpec struct {
 	Project string `json:"project" protobuf:"bytes,3,name=project"`
 	// SyncPolicy controls when a sync will be performed
 	SyncPolicy *SyncPolicy `json:"syncPolicy,omitempty" protobuf:"bytes,4,name=syncPolicy"`
+	// AdditionalDestinations are further clusters and namespaces which the application is deployed
+	// to, in addition to Destination. The sync status, health and operation results of each
+	// destination are tracked independently.
+	AdditionalDestinations []ApplicationDestination `json:"additionalDestinations,omitempty" protobuf:"bytes,5,rep,name=additionalDestinations"`
+	// ParameterPresets are named sets of parameter overrides, which can be selected when syncing the application
+	ParameterPresets []ParameterPreset `json:"parameterPresets,omitempty" protobuf:"bytes,6,rep,name=parameterPresets"`
+	// IgnoreDifferences lists the fields of resources which are ignored when comparing the live state
+	// with the target state, e.g. because they are set by a mutating webhook
+	IgnoreDifferences []ResourceIgnoreDifferences `json:"ignoreDifferences,omitempty" protobuf:"bytes,7,rep,name=ignoreDifferences"`
+	// ProgressingDeadline is the duration (e.g. 10m) after which resources which are still Progressing
+	// are considered Degraded. If omitted, resources may be Progressing indefinitely
+	ProgressingDeadline string `json:"progressingDeadline,omitempty" protobuf:"bytes,8,opt,name=progressingDeadline"`
+}
+
+// ProgressingDeadlineDuration returns the duration after which Progressing resources are Degraded, or
+// 0 if they may be Progressing indefinitely
+func (spec *ApplicationSpec) ProgressingDeadlineDuration() (time.Duration, error) {
+	if spec.ProgressingDeadline == "" {
+		return 0, nil
+	}
+	deadline, err := time.ParseDuration(spec.ProgressingDeadline)
+	if err != nil {
+		return 0, fmt.Errorf("invalid progressing deadline '%s': %v", spec.ProgressingDeadline, err)
+	}
+	if deadline <= 0 {
+		return 0, fmt.Errorf("progressing deadline must be positive")
+	}
+	return deadline, nil
+}
+
+// ResourceIgnoreDifferences ignores the differences of fields of the resources of a group and kind
+type ResourceIgnoreDifferences struct {
+	// Group is the API group of the resources, or empty for the core group
+	Group string `json:"group,omitempty" protobuf:"bytes,1,opt,name=group"`
+	Kind  string `json:"kind" protobuf:"bytes,2,opt,name=kind"`
+	// Name is the name of the resources, or a pattern of their names (e.g. "*-webhook"). Every
+	// resource of the group and kind matches if empty.
+	Name string `json:"name,omitempty" protobuf:"bytes,3,opt,name=name"`
+	// JSONPointers are the JSON pointers (RFC 6901) of the ignored fields. A "*" token matches every
+	// item of a list.
+	JSONPointers []string `json:"jsonPointers" protobuf:"bytes,4,rep,name=jsonPointers"`
+}
+
+// ParameterPreset is a named set of parameter overrides, which is applied on top of the parameter
+// overrides of a sync when it is selected
+type ParameterPreset struct {
+	// Name is the name the preset is selected by
+	Name string `json:"name" protobuf:"bytes,1,opt,name=name"`
+	// Parameters are the parameter overrides of the preset
+	Parameters []ComponentParameter `json:"parameters,omitempty" protobuf:"bytes,2,rep,name=parameters"`
+}
+
+// FindParameterPreset returns the preset with the given name, or nil if there is none
+func FindParameterPreset(presets []ParameterPreset, name string) *ParameterPreset {
+	for i := range presets {
+		if presets[i].Name == name {
+			return &presets[i]
+		}
+	}
+	return nil
 }
 
 // ApplicationSource contains information about github repository, path within repository and target application environment.
 type ApplicationSource struct {
-	// RepoURL is the git repository URL of the application manifests
+	// RepoURL is the git repository URL of the application manifests, or the URL of the helm
+	// repository of the chart
 	RepoURL string `json:"repoURL" protobuf:"bytes,1,opt,name=repoURL"`
 	// Path is a directory path within the repository containing a
 	Path string `json:"path" protobuf:"bytes,2,opt,name=path"`
@@ -52,7 +115,7 @@ type ApplicationSource struct {
 	// DEPRECATED: specify environment in ksonnet.environment instead
 	Environment string `json:"environment,omitempty" protobuf:"bytes,3,opt,name=environment"`
 	// TargetRevision defines the commit, tag, or branch in which to sync the application to.
-	// If omitted, will sync to HEAD
+	// If omitted, will sync to HEAD. For charts of helm repositories, it is the version of the chart
 	TargetRevision string `json:"targetRevision,omitempty" protobuf:"bytes,4,opt,name=targetRevision"`
 	// ComponentParameterOverrides are a list of parameter override values
 	ComponentParameterOverrides []ComponentParameter `json:"componentParameterOverrides,omitempty" protobuf:"bytes,5,opt,name=componentParameterOverrides"`
@@ -65,6 +128,9 @@ type ApplicationSource struct {
 	Kustomize *ApplicationSourceKustomize `json:"kustomize,omitempty" protobuf:"bytes,8,opt,name=kustomize"`
 	// Ksonnet holds ksonnet specific options
 	Ksonnet *ApplicationSourceKsonnet `json:"ksonnet,omitempty" protobuf:"bytes,9,opt,name=ksonnet"`
+	// Chart is the name of the chart of the helm repository at RepoURL. If set, the chart is deployed
+	// instead of the manifests of a git repository path
+	Chart string `json:"chart,omitempty" protobuf:"bytes,10,opt,name=chart"`
 }
 
 type ApplicationSourceType string
@@ -82,6 +148,9 @@ type ApplicationSourceHelm struct {
 	ReleaseName string `json:"releaseName,omitempty" protobuf:"bytes,1,opt,name=releaseName"`
 	// ValuesFiles is a list of Helm value files to use when generating a template
 	ValueFiles []string `json:"valueFiles,omitempty" protobuf:"bytes,2,opt,name=valueFiles"`
+	// ValuesSchemaFile is the path of a JSON schema, relative to the chart, used to validate the values.
+	// If omitted, the values.schema.json bundled with the chart is used, if any
+	ValuesSchemaFile string `json:"valuesSchemaFile,omitempty" protobuf:"bytes,3,opt,name=valuesSchemaFile"`
 }
 
 // ApplicationSourceKustomize holds kustomize specific options
@@ -102,6 +171,9 @@ type ApplicationDestination struct {
 	Server string `json:"server,omitempty" protobuf:"bytes,1,opt,name=server"`
 	// Namespace overrides the environment namespace value in the ksonnet app.yaml
 	Namespace string `json:"namespace,omitempty" protobuf:"bytes,2,opt,name=namespace"`
+	// Selector selects the clusters by their labels (e.g. env=prod,region!=eu), instead of the server.
+	// The application is deployed to the namespace in every selected cluster
+	Selector string `json:"selector,omitempty" protobuf:"bytes,3,opt,name=selector"`
 }
 
 // ApplicationStatus contains information about application status in target environment.
@@ -112,11 +184,65 @@ type ApplicationStatus struct {
 	Health           HealthStatus           `json:"health,omitempty" protobuf:"bytes,4,opt,name=health"`
 	OperationState   *OperationState        `json:"operationState,omitempty" protobuf:"bytes,5,opt,name=operationState"`
 	Conditions       []ApplicationCondition `json:"conditions,omitempty" protobuf:"bytes,6,opt,name=conditions"`
+	// Destinations holds the status of each destination of an application with additional destinations
+	Destinations []DestinationStatus `json:"destinations,omitempty" protobuf:"bytes,7,rep,name=destinations"`
+	// OrphanedResources are the resources of the destination namespaces which are not managed by any
+	// application. Only monitored if the project of the application enables it
+	OrphanedResources []OrphanedResource `json:"orphanedResources,omitempty" protobuf:"bytes,8,rep,name=orphanedResources"`
+	// OperationHistory holds the completed operations, oldest first, up to the operation history limit
+	// of the controller. The results of their resources are compacted to a summary
+	OperationHistory []OperationState `json:"operationHistory,omitempty" protobuf:"bytes,9,rep,name=operationHistory"`
+	// ReconciledAt is the time of the last attempt to compare the application, whether or not it succeeded
+	ReconciledAt *metav1.Time `json:"reconciledAt,omitempty" protobuf:"bytes,10,opt,name=reconciledAt"`
+}
+
+// OrphanedResource is a resource of the destination namespace of an application which is not managed
+// by any application
+type OrphanedResource struct {
+	Group     string `json:"group,omitempty" protobuf:"bytes,1,opt,name=group"`
+	Kind      string `json:"kind" protobuf:"bytes,2,opt,name=kind"`
+	Namespace string `json:"namespace" protobuf:"bytes,3,opt,name=namespace"`
+	Name      string `json:"name" protobuf:"bytes,4,opt,name=name"`
+}
+
+// DestinationStatus is the status of an application in one of its destinations
+type DestinationStatus struct {
+	Destination ApplicationDestination `json:"destination" protobuf:"bytes,1,opt,name=destination"`
+	// Status is the sync status of the resources in the destination
+	Status ComparisonStatus `json:"status" protobuf:"bytes,2,opt,name=status"`
+	// Health is the health of the resources in the destination
+	Health HealthStatus `json:"health" protobuf:"bytes,3,opt,name=health"`
+	// Message holds the reason why the status of the destination is unknown
+	Message string `json:"message,omitempty" protobuf:"bytes,4,opt,name=message"`
 }
 
 // Operation contains requested operation parameters.
 type Operation struct {
 	Sync *SyncOperation `json:"sync,omitempty" protobuf:"bytes,1,opt,name=sync"`
+	// CorrelationID identifies the request which initiated the operation in the logs of all components
+	CorrelationID string `json:"correlationID,omitempty" protobuf:"bytes,2,opt,name=correlationID"`
+	// Timeout is the duration (e.g. 10m) after which a running operation is terminated and fails.
+	// If omitted, the operation does not time out
+	Timeout string `json:"timeout,omitempty" protobuf:"bytes,3,opt,name=timeout"`
+	// IdempotencyKey is supplied by the client which requested the operation. Requests with the key of
+	// an operation which was already started return the existing operation instead of starting a new one
+	IdempotencyKey string `json:"idempotencyKey,omitempty" protobuf:"bytes,4,opt,name=idempotencyKey"`
+}
+
+// TimeoutDuration returns the duration after which the operation times out, or 0 if the operation
+// does not time out
+func (o *Operation) TimeoutDuration() (time.Duration, error) {
+	if o.Timeout == "" {
+		return 0, nil
+	}
+	timeout, err := time.ParseDuration(o.Timeout)
+	if err != nil {
+		return 0, fmt.Errorf("invalid operation timeout '%s': %v", o.Timeout, err)
+	}
+	if timeout <= 0 {
+		return 0, fmt.Errorf("operation timeout must be positive")
+	}
+	return timeout, nil
 }
 
 // SyncOperationResource contains resources to sync.
@@ -151,6 +277,157 @@ type SyncOperation struct {
 	ParameterOverrides ParameterOverrides `json:"parameterOverrides" protobuf:"bytes,5,opt,name=parameterOverrides"`
 	// Resources describes which resources to sync
 	Resources []SyncOperationResource `json:"resources,omitempty" protobuf:"bytes,6,opt,name=resources"`
+	// ConfirmCRDDeletion allows pruning custom resource definitions which have instances outside of the application
+	ConfirmCRDDeletion bool `json:"confirmCRDDeletion,omitempty" protobuf:"bytes,7,opt,name=confirmCRDDeletion"`
+	// PrunePropagationPolicy is the deletion propagation policy of pruned resources (foreground, background or orphan).
+	// Defaults to foreground
+	PrunePropagationPolicy PropagationPolicy `json:"prunePropagationPolicy,omitempty" protobuf:"bytes,8,opt,name=prunePropagationPolicy,casttype=PropagationPolicy"`
+	// Retry controls the automatic retries of the sync when it fails. If omitted, the sync is not retried
+	Retry *RetryStrategy `json:"retry,omitempty" protobuf:"bytes,9,opt,name=retry"`
+	// ApplyConcurrency is the max number of resources which are pruned or applied in parallel.
+	// Unlimited if omitted
+	ApplyConcurrency int64 `json:"applyConcurrency,omitempty" protobuf:"varint,10,opt,name=applyConcurrency"`
+	// ExcludedResources describes which resources not to sync. Applies in addition to Resources
+	ExcludedResources []SyncOperationResource `json:"excludedResources,omitempty" protobuf:"bytes,11,opt,name=excludedResources"`
+	// Preset is the name of the parameter preset applied to the parameter overrides of the sync, if any
+	Preset string `json:"preset,omitempty" protobuf:"bytes,12,opt,name=preset"`
+	// MoveFrom is the previous destination of an application which is moved to a new destination.
+	// The resources of the application are pruned from it once they are healthy in the new destination
+	MoveFrom *ApplicationDestination `json:"moveFrom,omitempty" protobuf:"bytes,13,opt,name=moveFrom"`
+	// TerminationGracePeriodSeconds is the grace period of the running hooks which are deleted when the
+	// operation is terminated. Defaults to the grace period of the hook pods
+	TerminationGracePeriodSeconds *int64 `json:"terminationGracePeriodSeconds,omitempty" protobuf:"varint,14,opt,name=terminationGracePeriodSeconds"`
+	// OverrideSyncWindows allows the sync even if the sync windows of the project do not permit it.
+	// Intended for emergencies
+	OverrideSyncWindows bool `json:"overrideSyncWindows,omitempty" protobuf:"bytes,15,opt,name=overrideSyncWindows"`
+	// SelfHealAttemptsCount is the number of consecutive automated syncs which reverted the drift of the
+	// live state from the revision which was already synced
+	SelfHealAttemptsCount int64 `json:"selfHealAttemptsCount,omitempty" protobuf:"varint,16,opt,name=selfHealAttemptsCount"`
+}
+
+// IsPartial returns whether the sync operation syncs only some of the resources of the application
+func (o *SyncOperation) IsPartial() bool {
+	return len(o.Resources) > 0 || len(o.ExcludedResources) > 0
+}
+
+const (
+	defaultRetryDuration    = 5 * time.Second
+	defaultRetryMaxDuration = 3 * time.Minute
+	defaultRetryFactor      = int64(2)
+)
+
+// RetryStrategy controls the automatic retries of a failed sync
+type RetryStrategy struct {
+	// Limit is the maximum number of retries
+	Limit int64 `json:"limit,omitempty" protobuf:"varint,1,opt,name=limit"`
+	// Backoff controls the delay between the retries
+	Backoff *Backoff `json:"backoff,omitempty" protobuf:"bytes,2,opt,name=backoff"`
+	// FailedOnly retries only the resources which failed to sync in the previous attempt, instead of all
+	// resources. All resources are retried if the attempt failed for another reason (e.g. a failed hook)
+	FailedOnly bool `json:"failedOnly,omitempty" protobuf:"varint,3,opt,name=failedOnly"`
+}
+
+// Backoff is the exponential backoff of retries
+type Backoff struct {
+	// Duration is the delay before the first retry (e.g. "5s", "2m"). Defaults to 5s
+	Duration string `json:"duration,omitempty" protobuf:"bytes,1,opt,name=duration"`
+	// Factor multiplies the delay after each retry. Defaults to 2
+	Factor *int64 `json:"factor,omitempty" protobuf:"varint,2,opt,name=factor"`
+	// MaxDuration is the maximum delay between retries (e.g. "3m"). Defaults to 3m
+	MaxDuration string `json:"maxDuration,omitempty" protobuf:"bytes,3,opt,name=maxDuration"`
+}
+
+// Validate returns an error if the retry strategy is invalid
+func (r *RetryStrategy) Validate() error {
+	if r == nil {
+		return nil
+	}
+	if r.Limit < 0 {
+		return fmt.Errorf("retry limit must not be negative")
+	}
+	_, err := r.NextRetryAt(time.Time{}, 0)
+	return err
+}
+
+// NextRetryAt returns the time of the next retry of a sync which failed at lastAttempt, and was
+// already retried retryCount times
+func (r *RetryStrategy) NextRetryAt(lastAttempt time.Time, retryCount int) (time.Time, error) {
+	duration := defaultRetryDuration
+	maxDuration := defaultRetryMaxDuration
+	factor := defaultRetryFactor
+	if r != nil && r.Backoff != nil {
+		var err error
+		if r.Backoff.Duration != "" {
+			if duration, err = time.ParseDuration(r.Backoff.Duration); err != nil {
+				return time.Time{}, fmt.Errorf("invalid backoff duration '%s': %v", r.Backoff.Duration, err)
+			}
+		}
+		if r.Backoff.MaxDuration != "" {
+			if maxDuration, err = time.ParseDuration(r.Backoff.MaxDuration); err != nil {
+				return time.Time{}, fmt.Errorf("invalid backoff max duration '%s': %v", r.Backoff.MaxDuration, err)
+			}
+		}
+		if r.Backoff.Factor != nil {
+			if factor = *r.Backoff.Factor; factor < 1 {
+				return time.Time{}, fmt.Errorf("backoff factor must be at least 1")
+			}
+		}
+	}
+	for i := 0; i < retryCount && duration < maxDuration; i++ {
+		duration *= time.Duration(factor)
+	}
+	if duration > maxDuration {
+		duration = maxDuration
+	}
+	return lastAttempt.Add(duration), nil
+}
+
+// PropagationPolicy is the policy of deleting the dependents of a resource
+type PropagationPolicy string
+
+const (
+	PropagationPolicyForeground PropagationPolicy = "foreground"
+	PropagationPolicyBackground PropagationPolicy = "background"
+	PropagationPolicyOrphan     PropagationPolicy = "orphan"
+)
+
+// DeletionPropagation returns the kubernetes deletion propagation of the policy
+func (p PropagationPolicy) DeletionPropagation() (metav1.DeletionPropagation, error) {
+	switch PropagationPolicy(strings.ToLower(string(p))) {
+	case "", PropagationPolicyForeground:
+		return metav1.DeletePropagationForeground, nil
+	case PropagationPolicyBackground:
+		return metav1.DeletePropagationBackground, nil
+	case PropagationPolicyOrphan:
+		return metav1.DeletePropagationOrphan, nil
+	}
+	return "", fmt.Errorf("unknown propagation policy '%s'. Must be one of: %s, %s, %s", p, PropagationPolicyForeground, PropagationPolicyBackground, PropagationPolicyOrphan)
+}
+
+// RefreshType is the type of refresh of an application requested by a user
+type RefreshType string
+
+const (
+	// RefreshTypeNone returns the last comparison of the application
+	RefreshTypeNone RefreshType = "none"
+	// RefreshTypeNormal compares the application with the manifests of its source
+	RefreshTypeNormal RefreshType = "normal"
+	// RefreshTypeHard compares the application with manifests which are regenerated, ignoring the
+	// manifests cached by the repo server
+	RefreshTypeHard RefreshType = "hard"
+)
+
+// ParseRefreshType parses the refresh type of an application
+func ParseRefreshType(s string) (RefreshType, error) {
+	switch RefreshType(strings.ToLower(s)) {
+	case "", RefreshTypeNone:
+		return RefreshTypeNone, nil
+	case RefreshTypeNormal:
+		return RefreshTypeNormal, nil
+	case RefreshTypeHard:
+		return RefreshTypeHard, nil
+	}
+	return "", fmt.Errorf("unknown refresh type '%s'. Must be one of: %s, %s, %s", s, RefreshTypeNone, RefreshTypeNormal, RefreshTypeHard)
 }
 
 // ParameterOverrides masks the value so protobuf can generate
@@ -198,18 +475,70 @@ type OperationState struct {
 	StartedAt metav1.Time `json:"startedAt" protobuf:"bytes,6,opt,name=startedAt"`
 	// FinishedAt contains time of operation completion
 	FinishedAt *metav1.Time `json:"finishedAt" protobuf:"bytes,7,opt,name=finishedAt"`
+	// Attempts holds the previous attempts of the operation, which failed and were retried
+	Attempts []OperationAttempt `json:"attempts,omitempty" protobuf:"bytes,8,rep,name=attempts"`
+	// DestinationResults holds the results of the operation in each destination of an application
+	// with additional destinations
+	DestinationResults []DestinationOperationResult `json:"destinationResults,omitempty" protobuf:"bytes,9,rep,name=destinationResults"`
+	// StateChangedAt is the time the state of the operation last changed
+	StateChangedAt *metav1.Time `json:"stateChangedAt,omitempty" protobuf:"bytes,10,opt,name=stateChangedAt"`
+	// Terminated is set if the operation completed since it was terminated, by a user or since it timed
+	// out, rather than since it failed. The results of its resources are the ones before termination
+	Terminated bool `json:"terminated,omitempty" protobuf:"varint,11,opt,name=terminated"`
+}
+
+// DestinationOperationResult is the result of an operation in one of the destinations of an application
+type DestinationOperationResult struct {
+	Destination ApplicationDestination `json:"destination" protobuf:"bytes,1,opt,name=destination"`
+	// Phase is the phase of the operation in the destination
+	Phase OperationPhase `json:"phase" protobuf:"bytes,2,opt,name=phase"`
+	// Message holds any pertinent messages of the operation in the destination
+	Message string `json:"message,omitempty" protobuf:"bytes,3,opt,name=message"`
+	// SyncResult is the result of the sync in the destination
+	SyncResult *SyncOperationResult `json:"syncResult,omitempty" protobuf:"bytes,4,opt,name=syncResult"`
+}
+
+// OperationAttempt describes a failed attempt of an operation
+type OperationAttempt struct {
+	// Phase is the phase the attempt completed with
+	Phase OperationPhase `json:"phase" protobuf:"bytes,1,opt,name=phase"`
+	// Message holds the reason of the failure
+	Message string `json:"message,omitempty" protobuf:"bytes,2,opt,name=message"`
+	// FinishedAt contains time of the attempt completion
+	FinishedAt metav1.Time `json:"finishedAt" protobuf:"bytes,3,opt,name=finishedAt"`
 }
 
 // SyncPolicy controls when a sync will be performed in response to updates in git
 type SyncPolicy struct {
 	// Automated will keep an application synced to the target revision
 	Automated *SyncPolicyAutomated `json:"automated,omitempty" protobuf:"bytes,1,opt,name=automated"`
+	// SyncOptions are sync options which apply to all resources of the application (e.g. Replace=true)
+	SyncOptions []string `json:"syncOptions,omitempty" protobuf:"bytes,2,rep,name=syncOptions"`
+	// Retry controls the automatic retries of the automated syncs when they fail. If omitted, a failed
+	// automated sync is not retried until the target revision changes
+	Retry *RetryStrategy `json:"retry,omitempty" protobuf:"bytes,3,opt,name=retry"`
+}
+
+// HasSyncOption returns whether the sync policy contains the given sync option
+func (p *SyncPolicy) HasSyncOption(option string) bool {
+	if p == nil {
+		return false
+	}
+	for _, item := range p.SyncOptions {
+		if item == option {
+			return true
+		}
+	}
+	return false
 }
 
 // SyncPolicyAutomated controls the behavior of an automated sync
 type SyncPolicyAutomated struct {
 	// Prune will prune resources automatically as part of automated sync (default: false)
 	Prune bool `json:"prune,omitempty" protobuf:"bytes,1,opt,name=prune"`
+	// SelfHeal syncs the application again when its live state drifts from the revision which was already
+	// synced (default: false)
+	SelfHeal bool `json:"selfHeal,omitempty" protobuf:"bytes,2,opt,name=selfHeal"`
 }
 
 // SyncStrategy controls the manner in which a sync is performed
@@ -218,6 +547,9 @@ type SyncStrategy struct {
 	Apply *SyncStrategyApply `json:"apply,omitempty" protobuf:"bytes,1,opt,name=apply"`
 	// Hook will submit any referenced resources to perform the sync. This is the default strategy
 	Hook *SyncStrategyHook `json:"hook,omitempty" protobuf:"bytes,2,opt,name=hook"`
+	// Progressive applies the resources in batches, and applies each batch only once the resources of
+	// the previous batches are healthy
+	Progressive *SyncStrategyProgressive `json:"progressive,omitempty" protobuf:"bytes,3,opt,name=progressive"`
 }
 
 // SyncStrategyApply uses `kubectl apply` to perform the apply
@@ -233,6 +565,43 @@ type SyncStrategyApply struct {
 type SyncStrategyHook struct {
 	// Embed SyncStrategyApply type to inherit any `apply` options
 	SyncStrategyApply `protobuf:"bytes,1,opt,name=syncStrategyApply"`
+	// HealthTimeout is the maximum duration (e.g. 5m) the PostSync hooks wait for the application to become
+	// healthy once its resources are applied. The sync fails without running the PostSync hooks if the
+	// application is not healthy by then. If omitted, the PostSync hooks wait until the application is healthy
+	HealthTimeout string `json:"healthTimeout,omitempty" protobuf:"bytes,2,opt,name=healthTimeout"`
+}
+
+// HealthTimeoutDuration returns the maximum duration the PostSync hooks wait for the application to
+// become healthy, or 0 if they wait indefinitely
+func (h *SyncStrategyHook) HealthTimeoutDuration() (time.Duration, error) {
+	if h == nil || h.HealthTimeout == "" {
+		return 0, nil
+	}
+	timeout, err := time.ParseDuration(h.HealthTimeout)
+	if err != nil {
+		return 0, fmt.Errorf("invalid health timeout '%s': %v", h.HealthTimeout, err)
+	}
+	if timeout <= 0 {
+		return 0, fmt.Errorf("health timeout must be positive")
+	}
+	return timeout, nil
+}
+
+// SyncStrategyProgressive applies the resources in batches, gated by the health of the previous
+// batches. The sync fails on the first batch which becomes degraded.
+type SyncStrategyProgressive struct {
+	// Embed SyncStrategyApply type to inherit any `apply` options
+	SyncStrategyApply `protobuf:"bytes,1,opt,name=syncStrategyApply"`
+	// BatchSize is the max number of resources applied in each batch. Defaults to 1
+	BatchSize int64 `json:"batchSize,omitempty" protobuf:"varint,2,opt,name=batchSize"`
+}
+
+// GetBatchSize returns the max number of resources applied in each batch
+func (p *SyncStrategyProgressive) GetBatchSize() int {
+	if p.BatchSize <= 0 {
+		return 1
+	}
+	return int(p.BatchSize)
 }
 
 type HookType string
@@ -254,6 +623,8 @@ type HookDeletePolicy string
 const (
 	HookDeletePolicyHookSucceeded HookDeletePolicy = "HookSucceeded"
 	HookDeletePolicyHookFailed    HookDeletePolicy = "HookFailed"
+	// HookDeletePolicyBeforeHookCreation deletes a hook left over by a previous sync before the hook is created again
+	HookDeletePolicyBeforeHookCreation HookDeletePolicy = "BeforeHookCreation"
 )
 
 // HookStatus contains status about a hook invocation
@@ -270,6 +641,8 @@ type HookStatus struct {
 	Status OperationPhase `json:"status" protobuf:"bytes,5,opt,name=status"`
 	// A human readable message indicating details about why the resource is in this condition.
 	Message string `json:"message,omitempty" protobuf:"bytes,6,opt,name=message"`
+	// Namespace is the resource namespace. Empty for cluster-scoped resources
+	Namespace string `json:"namespace,omitempty" protobuf:"bytes,7,opt,name=namespace"`
 }
 
 // SyncOperationResult represent result of sync operation
@@ -280,6 +653,18 @@ type SyncOperationResult struct {
 	Revision string `json:"revision" protobuf:"bytes,2,opt,name=revision"`
 	// Hooks contains list of hook resource statuses associated with this operation
 	Hooks []*HookStatus `json:"hooks,omitempty" protobuf:"bytes,3,opt,name=hooks"`
+	// Summary summarizes the results of the resources and hooks, once they were compacted
+	Summary string `json:"summary,omitempty" protobuf:"bytes,4,opt,name=summary"`
+	// MovedResources holds the prune results of the resources in the previous destination of a moved application
+	MovedResources []*ResourceDetails `json:"movedResources,omitempty" protobuf:"bytes,5,opt,name=movedResources"`
+	// DeniedReasons holds the reasons of the policy service for denying the sync
+	DeniedReasons []string `json:"deniedReasons,omitempty" protobuf:"bytes,6,rep,name=deniedReasons"`
+	// FailedResources holds the resources which failed to sync in the previous attempt of the operation.
+	// If set, the retry of the operation syncs only these resources
+	FailedResources []*ResourceDetails `json:"failedResources,omitempty" protobuf:"bytes,7,rep,name=failedResources"`
+	// HealthWaitStartedAt is the time the sync started waiting for the application to become healthy
+	// before running its PostSync hooks
+	HealthWaitStartedAt *metav1.Time `json:"healthWaitStartedAt,omitempty" protobuf:"bytes,8,opt,name=healthWaitStartedAt"`
 }
 
 type ResourceSyncStatus string
@@ -295,12 +680,30 @@ func (s ResourceSyncStatus) Successful() bool {
 	return s != ResourceDetailsSyncFailed
 }
 
+// ResourceSyncAction is the change a dry-run sync would make to a resource
+type ResourceSyncAction string
+
+const (
+	ResourceSyncActionCreate        ResourceSyncAction = "create"
+	ResourceSyncActionUpdate        ResourceSyncAction = "update"
+	ResourceSyncActionPrune         ResourceSyncAction = "prune"
+	ResourceSyncActionPruneRequired ResourceSyncAction = "prune-required"
+	ResourceSyncActionUnchanged     ResourceSyncAction = "unchanged"
+)
+
 type ResourceDetails struct {
 	Name      string             `json:"name" protobuf:"bytes,1,opt,name=name"`
 	Kind      string             `json:"kind" protobuf:"bytes,2,opt,name=kind"`
 	Namespace string             `json:"namespace" protobuf:"bytes,3,opt,name=namespace"`
 	Message   string             `json:"message,omitempty" protobuf:"bytes,4,opt,name=message"`
 	Status    ResourceSyncStatus `json:"status,omitempty" protobuf:"bytes,5,opt,name=status"`
+	// Action is the change a dry-run sync would make to the resource
+	Action ResourceSyncAction `json:"action,omitempty" protobuf:"bytes,6,opt,name=action,casttype=ResourceSyncAction"`
+	// Diff is the diff of the live and target state of a resource which a dry-run sync would update
+	Diff string `json:"diff,omitempty" protobuf:"bytes,7,opt,name=diff"`
+	// GenerateName is the metadata.generateName of a resource which was created with a name generated
+	// by the API server. The generated name is the name of the resource.
+	GenerateName string `json:"generateName,omitempty" protobuf:"bytes,8,opt,name=generateName"`
 }
 
 // DeploymentInfo contains information relevant to an application deployment
@@ -309,6 +712,14 @@ type DeploymentInfo struct {
 	ComponentParameterOverrides []ComponentParameter `json:"componentParameterOverrides,omitempty" protobuf:"bytes,3,opt,name=componentParameterOverrides"`
 	DeployedAt                  metav1.Time          `json:"deployedAt" protobuf:"bytes,4,opt,name=deployedAt"`
 	ID                          int64                `json:"id" protobuf:"bytes,5,opt,name=id"`
+	// ManifestsRef references the rendered manifests applied by the sync, if sync artifacts are enabled
+	ManifestsRef string `json:"manifestsRef,omitempty" protobuf:"bytes,6,opt,name=manifestsRef"`
+	// Preset is the name of the parameter preset the deployment was synced with, if any
+	Preset string `json:"preset,omitempty" protobuf:"bytes,7,opt,name=preset"`
+	// Destination is the destination the deployment was synced to
+	Destination *ApplicationDestination `json:"destination,omitempty" protobuf:"bytes,8,opt,name=destination"`
+	// IdempotencyKey is the idempotency key of the operation which synced the deployment, if any
+	IdempotencyKey string `json:"idempotencyKey,omitempty" protobuf:"bytes,9,opt,name=idempotencyKey"`
 }
 
 // ApplicationWatchEvent contains information about application change.
@@ -367,6 +778,18 @@ const (
 	ApplicationConditionUnknownError = "UnknownError"
 	// ApplicationConditionSharedResourceWarning indicates that controller detected resources which belongs to more than one application
 	ApplicationConditionSharedResourceWarning = "SharedResourceWarning"
+	// ApplicationConditionCredentialsExpiryWarning indicates that credentials used by the application, such as the bearer token of its cluster, expire soon
+	ApplicationConditionCredentialsExpiryWarning = "CredentialsExpiryWarning"
+	// ApplicationConditionRepoServerUnavailableError indicates that the manifests of the application cannot be generated since no repo server is available
+	ApplicationConditionRepoServerUnavailableError = "RepoServerUnavailableError"
+	// ApplicationConditionDuplicateResourceError indicates that the manifests of the application contain more than one resource with the same group, kind, namespace and name
+	ApplicationConditionDuplicateResourceError = "DuplicateResourceError"
+	// ApplicationConditionOrphanedResourceWarning indicates that the destination namespaces of the application contain resources which are not managed by any application
+	ApplicationConditionOrphanedResourceWarning = "OrphanedResourceWarning"
+	// ApplicationConditionExcludedResourceWarning indicates that the manifests of the application contain resources which are excluded from the comparisons by the settings
+	ApplicationConditionExcludedResourceWarning = "ExcludedResourceWarning"
+	// ApplicationConditionServerSideDiffWarning indicates that the server-side dry-runs of resources which use server-side diffs failed, so that they are diffed against their target state
+	ApplicationConditionServerSideDiffWarning = "ServerSideDiffWarning"
 )
 
 // ApplicationCondition contains details about current application condition
@@ -384,11 +807,16 @@ type ComparisonResult struct {
 	Status     ComparisonStatus  `json:"status" protobuf:"bytes,5,opt,name=status,casttype=ComparisonStatus"`
 	Revision   string            `json:"revision" protobuf:"bytes,7,opt,name=revision"`
 	Resources  []ResourceSummary `json:"resources,omitempty" protobuf:"bytes,8,opt,name=resources"`
+	// ComparedToDestination is the destination of the application when it was compared
+	ComparedToDestination ApplicationDestination `json:"comparedToDestination,omitempty" protobuf:"bytes,9,opt,name=comparedToDestination"`
 }
 
 type HealthStatus struct {
 	Status        HealthStatusCode `json:"status,omitempty" protobuf:"bytes,1,opt,name=status"`
 	StatusDetails string           `json:"statusDetails,omitempty" protobuf:"bytes,2,opt,name=statusDetails"`
+	// ProgressingSince is the time the resource became Progressing, while it is Progressing or Degraded
+	// by the progressing deadline of its application
+	ProgressingSince *metav1.Time `json:"progressingSince,omitempty" protobuf:"bytes,3,opt,name=progressingSince"`
 }
 
 type HealthStatusCode = string
@@ -407,6 +835,32 @@ type ResourceNode struct {
 	Children []ResourceNode `json:"children,omitempty" protobuf:"bytes,2,opt,name=children"`
 }
 
+// ResourceRef identifies a live resource
+type ResourceRef struct {
+	Group     string `json:"group,omitempty" protobuf:"bytes,1,opt,name=group"`
+	Version   string `json:"version" protobuf:"bytes,2,opt,name=version"`
+	Kind      string `json:"kind" protobuf:"bytes,3,opt,name=kind"`
+	Namespace string `json:"namespace,omitempty" protobuf:"bytes,4,opt,name=namespace"`
+	Name      string `json:"name" protobuf:"bytes,5,opt,name=name"`
+	UID       string `json:"uid,omitempty" protobuf:"bytes,6,opt,name=uid"`
+}
+
+// ResourceTreeNode is a live resource of the resource tree of an application
+type ResourceTreeNode struct {
+	ResourceRef `json:",inline" protobuf:"bytes,1,opt,name=resourceRef"`
+	// ParentRefs are the resources which control the resource. Empty for the resources of the application
+	ParentRefs      []ResourceRef `json:"parentRefs,omitempty" protobuf:"bytes,2,rep,name=parentRefs"`
+	ResourceVersion string        `json:"resourceVersion,omitempty" protobuf:"bytes,3,opt,name=resourceVersion"`
+	// Health is only set for the resources of the application
+	Health *HealthStatus `json:"health,omitempty" protobuf:"bytes,4,opt,name=health"`
+}
+
+// ApplicationTree holds the live resources of an application, and the resources they control, e.g. the
+// replica sets and pods of deployments, or the endpoints of services
+type ApplicationTree struct {
+	Nodes []ResourceTreeNode `json:"nodes,omitempty" protobuf:"bytes,1,rep,name=nodes"`
+}
+
 // ResourceSummary holds the resource metadata and aggregated statuses
 type ResourceSummary struct {
 	Group   string           `json:"group,omitempty" protobuf:"bytes,1,opt,name=group"`
@@ -415,6 +869,8 @@ type ResourceSummary struct {
 	Name    string           `json:"name,omitempty" protobuf:"bytes,4,opt,name=name"`
 	Status  ComparisonStatus `json:"status,omitempty" protobuf:"bytes,5,opt,name=status"`
 	Health  HealthStatus     `json:"health,omitempty" protobuf:"bytes,6,opt,name=health"`
+	// Namespace is the namespace of the resource, if it is namespaced
+	Namespace string `json:"namespace,omitempty" protobuf:"bytes,7,opt,name=namespace"`
 }
 
 func (r *ResourceSummary) GroupVersionKind() schema.GroupVersionKind {
@@ -455,6 +911,12 @@ type Cluster struct {
 	Config ClusterConfig `json:"config" protobuf:"bytes,3,opt,name=config"`
 	// ConnectionState contains information about cluster connection state
 	ConnectionState ConnectionState `json:"connectionState,omitempty" protobuf:"bytes,4,opt,name=connectionState"`
+	// NormalizerProfiles lists the built-in profiles of fields set by operators (e.g. istio), which are
+	// ignored when diffing the resources of the cluster
+	NormalizerProfiles []string `json:"normalizerProfiles,omitempty" protobuf:"bytes,5,rep,name=normalizerProfiles"`
+	// Labels are the labels of the cluster, which are selected by the destination selectors of
+	// applications
+	Labels map[string]string `json:"labels,omitempty" protobuf:"bytes,6,rep,name=labels"`
 }
 
 // ClusterList is a collection of Clusters.
@@ -489,6 +951,23 @@ type ClusterConfig struct {
 
 	// AWSAuthConfig contains IAM authentication configuration
 	AWSAuthConfig *AWSAuthConfig `json:"awsAuthConfig,omitempty" protobuf:"bytes,5,opt,name=awsAuthConfig"`
+
+	// NetworkConfig contains network timeout and retry settings used to access the cluster
+	NetworkConfig *ClusterNetworkConfig `json:"networkConfig,omitempty" protobuf:"bytes,6,opt,name=networkConfig"`
+}
+
+// ClusterNetworkConfig contains network timeout and retry settings used to access a cluster.
+// Useful for clusters which are reachable only through high latency networks.
+type ClusterNetworkConfig struct {
+	// DialTimeoutSeconds is the maximum time to wait for a connection to the cluster to be established
+	DialTimeoutSeconds int64 `json:"dialTimeoutSeconds,omitempty" protobuf:"varint,1,opt,name=dialTimeoutSeconds"`
+	// RequestTimeoutSeconds is the maximum time to wait for the response of a single request.
+	// Does not apply to watch requests
+	RequestTimeoutSeconds int64 `json:"requestTimeoutSeconds,omitempty" protobuf:"varint,2,opt,name=requestTimeoutSeconds"`
+	// RetryLimit is the number of times failed read requests are retried
+	RetryLimit int64 `json:"retryLimit,omitempty" protobuf:"varint,3,opt,name=retryLimit"`
+	// RetryBackoffSeconds is the initial time to wait between retries. Doubles after every retry
+	RetryBackoffSeconds int64 `json:"retryBackoffSeconds,omitempty" protobuf:"varint,4,opt,name=retryBackoffSeconds"`
 }
 
 // TLSClientConfig contains settings to enable transport layer security
@@ -510,13 +989,27 @@ type TLSClientConfig struct {
 	CAData []byte `json:"caData,omitempty" protobuf:"bytes,5,opt,name=caData"`
 }
 
-// Repository is a Git repository holding application configurations
+const (
+	// RepositoryTypeGit is the type of git repositories
+	RepositoryTypeGit = "git"
+	// RepositoryTypeHelm is the type of helm chart repositories
+	RepositoryTypeHelm = "helm"
+)
+
+// Repository is a Git or Helm repository holding application configurations
 type Repository struct {
 	Repo            string          `json:"repo" protobuf:"bytes,1,opt,name=repo"`
 	Username        string          `json:"username,omitempty" protobuf:"bytes,2,opt,name=username"`
 	Password        string          `json:"password,omitempty" protobuf:"bytes,3,opt,name=password"`
 	SSHPrivateKey   string          `json:"sshPrivateKey,omitempty" protobuf:"bytes,4,opt,name=sshPrivateKey"`
 	ConnectionState ConnectionState `json:"connectionState,omitempty" protobuf:"bytes,5,opt,name=connectionState"`
+	// Type is the type of the repository, either git (the default) or helm
+	Type string `json:"type,omitempty" protobuf:"bytes,6,opt,name=type"`
+}
+
+// IsHelm returns whether the repository is a helm chart repository
+func (repo *Repository) IsHelm() bool {
+	return repo.Type == RepositoryTypeHelm
 }
 
 // RepositoryList is a collection of Repositories.
@@ -562,6 +1055,49 @@ type AppProjectSpec struct {
 	ClusterResourceWhitelist []metav1.GroupKind `json:"clusterResourceWhitelist,omitempty" protobuf:"bytes,5,opt,name=clusterResourceWhitelist"`
 	// NamespaceResourceBlacklist contains list of blacklisted namespace level resources
 	NamespaceResourceBlacklist []metav1.GroupKind `json:"namespaceResourceBlacklist,omitempty" protobuf:"bytes,6,opt,name=namespaceResourceBlacklist"`
+	// ParameterPresets are named sets of parameter overrides, which can be selected when syncing the
+	// applications of the project. Presets of an application take precedence over presets of the same name of its project
+	ParameterPresets []ParameterPreset `json:"parameterPresets,omitempty" protobuf:"bytes,7,rep,name=parameterPresets"`
+	// SyncWindows are recurring time windows which control when the applications of the project can be synced
+	SyncWindows []SyncWindow `json:"syncWindows,omitempty" protobuf:"bytes,8,rep,name=syncWindows"`
+	// OrphanedResources enables the monitoring of the resources of the destination namespaces of the
+	// applications which are not managed by any application
+	OrphanedResources *OrphanedResourcesMonitorSettings `json:"orphanedResources,omitempty" protobuf:"bytes,9,opt,name=orphanedResources"`
+}
+
+// OrphanedResourcesMonitorSettings controls the monitoring of orphaned resources
+type OrphanedResourcesMonitorSettings struct {
+	// Warn adds a warning condition to the applications with orphaned resources (default: true)
+	Warn *bool `json:"warn,omitempty" protobuf:"bytes,1,opt,name=warn"`
+}
+
+// IsWarn returns whether applications with orphaned resources get a warning condition
+func (s *OrphanedResourcesMonitorSettings) IsWarn() bool {
+	return s.Warn == nil || *s.Warn
+}
+
+// SyncWindowKind is whether syncs are allowed or denied during a sync window
+type SyncWindowKind string
+
+const (
+	// SyncWindowKindAllow windows are the only times during which syncs are allowed
+	SyncWindowKindAllow SyncWindowKind = "allow"
+	// SyncWindowKindDeny windows are times during which syncs are denied
+	SyncWindowKindDeny SyncWindowKind = "deny"
+)
+
+// SyncWindow is a recurring time window during which syncs of the applications of a project are
+// allowed or denied
+type SyncWindow struct {
+	// Kind is whether syncs are allowed or denied during the window (allow or deny)
+	Kind SyncWindowKind `json:"kind" protobuf:"bytes,1,opt,name=kind,casttype=SyncWindowKind"`
+	// Schedule is the cron schedule at which the window opens, e.g. '0 22 * * 1-5'. Evaluated in UTC
+	Schedule string `json:"schedule" protobuf:"bytes,2,opt,name=schedule"`
+	// Duration is how long the window stays open, e.g. 1h30m
+	Duration string `json:"duration" protobuf:"bytes,3,opt,name=duration"`
+	// Applications are glob patterns of the names of the applications the window applies to.
+	// Applies to all the applications of the project if empty
+	Applications []string `json:"applications,omitempty" protobuf:"bytes,4,rep,name=applications"`
 }
 
 // ProjectRole represents a role that has access to a project
@@ -642,11 +1178,36 @@ func (condition *ApplicationCondition) IsError() bool {
 	return strings.HasSuffix(condition.Type, "Error")
 }
 
+// IsHelmChart returns whether the source is a chart of a helm repository
+func (source ApplicationSource) IsHelmChart() bool {
+	return source.Chart != ""
+}
+
 // Equals compares two instances of ApplicationSource and return true if instances are equal.
 func (source ApplicationSource) Equals(other ApplicationSource) bool {
 	return reflect.DeepEqual(source, other)
 }
 
+// GetDestinations returns all the destinations of the application
+func (spec ApplicationSpec) GetDestinations() []ApplicationDestination {
+	return append([]ApplicationDestination{spec.Destination}, spec.AdditionalDestinations...)
+}
+
+// SelectsClusters returns whether the destination selects clusters by their labels instead of a server
+func (dest ApplicationDestination) SelectsClusters() bool {
+	return dest.Selector != ""
+}
+
+// IsMissing returns whether the cluster or namespace of the destination is missing
+func (dest ApplicationDestination) IsMissing() bool {
+	return (dest.Server == "" && !dest.SelectsClusters()) || dest.Namespace == ""
+}
+
+// HasAdditionalDestinations returns whether the application is deployed to more than one destination
+func (spec ApplicationSpec) HasAdditionalDestinations() bool {
+	return len(spec.AdditionalDestinations) > 0
+}
+
 func (spec ApplicationSpec) BelongsToDefaultProject() bool {
 	return spec.GetProject() == common.DefaultAppProjectName
 }
@@ -695,8 +1256,9 @@ func (proj AppProject) IsSourcePermitted(src ApplicationSource) bool {
 
 // IsDestinationPermitted validates if the provided application's destination is one of the allowed destinations for the project
 func (proj AppProject) IsDestinationPermitted(dst ApplicationDestination) bool {
+	server := NormalizeServer(dst.Server)
 	for _, item := range proj.Spec.Destinations {
-		if item.Server == dst.Server || item.Server == "*" {
+		if NormalizeServer(item.Server) == server || item.Server == "*" {
 			if item.Namespace == dst.Namespace || item.Namespace == "*" {
 				return true
 			}
@@ -705,45 +1267,146 @@ func (proj AppProject) IsDestinationPermitted(dst ApplicationDestination) bool {
 	return false
 }
 
-// RESTConfig returns a go-client REST config from cluster
+// Validate returns an error if the kind, schedule or duration of the window is invalid
+func (w *SyncWindow) Validate() error {
+	if w.Kind != SyncWindowKindAllow && w.Kind != SyncWindowKindDeny {
+		return fmt.Errorf("kind '%s' should be '%s' or '%s'", w.Kind, SyncWindowKindAllow, SyncWindowKindDeny)
+	}
+	if _, err := cron.Parse(w.Schedule); err != nil {
+		return err
+	}
+	duration, err := time.ParseDuration(w.Duration)
+	if err != nil {
+		return err
+	}
+	if duration <= 0 {
+		return fmt.Errorf("duration '%s' should be positive", w.Duration)
+	}
+	for _, pattern := range w.Applications {
+		if _, err := filepath.Match(pattern, ""); err != nil {
+			return fmt.Errorf("invalid application pattern '%s': %v", pattern, err)
+		}
+	}
+	return nil
+}
+
+// Matches returns whether the window applies to the application with the given name
+func (w *SyncWindow) Matches(appName string) bool {
+	if len(w.Applications) == 0 {
+		return true
+	}
+	for _, pattern := range w.Applications {
+		if ok, err := filepath.Match(pattern, appName); ok && err == nil {
+			return true
+		}
+	}
+	return false
+}
+
+// Active returns whether the window is open at the given time
+func (w *SyncWindow) Active(t time.Time) (bool, error) {
+	if err := w.Validate(); err != nil {
+		return false, err
+	}
+	schedule, _ := cron.Parse(w.Schedule)
+	duration, _ := time.ParseDuration(w.Duration)
+	_, ok := schedule.Previous(t.UTC(), duration)
+	return ok, nil
+}
+
+// IsSyncPermitted returns whether the sync windows of the project permit syncing the application
+// at the given time: no deny window of the application is open, and one of its allow windows is open,
+// if it has any. If the sync is not permitted, returns the reason why. Invalid windows deny syncs.
+func (proj AppProject) IsSyncPermitted(appName string, t time.Time) (bool, string) {
+	hasAllowWindows := false
+	allowed := false
+	for i := range proj.Spec.SyncWindows {
+		window := proj.Spec.SyncWindows[i]
+		if !window.Matches(appName) {
+			continue
+		}
+		active, err := window.Active(t)
+		if err != nil {
+			return false, fmt.Sprintf("sync window %d of project '%s' is invalid: %v", i, proj.Name, err)
+		}
+		switch window.Kind {
+		case SyncWindowKindDeny:
+			if active {
+				return false, fmt.Sprintf("deny window '%s' (%s) of project '%s' is open", window.Schedule, window.Duration, proj.Name)
+			}
+		case SyncWindowKindAllow:
+			hasAllowWindows = true
+			allowed = allowed || active
+		}
+	}
+	if hasAllowWindows && !allowed {
+		return false, fmt.Sprintf("no allow window of project '%s' is open", proj.Name)
+	}
+	return true, ""
+}
+
+// NormalizeServer resolves the in-cluster name to the address of the cluster Argo CD runs in, so that
+// destinations referring to the cluster by either of them are considered the same
+func NormalizeServer(server string) string {
+	if server == common.InClusterName {
+		return common.KubernetesInternalAPIServerAddr
+	}
+	return server
+}
+
+// IsInCluster returns whether the cluster is the cluster Argo CD runs in, and is accessed using the
+// service accounts mounted into the Argo CD pods rather than credentials of its own
+func (c *Cluster) IsInCluster() bool {
+	if c.Server != common.KubernetesInternalAPIServerAddr && c.Server != common.InClusterName {
+		return false
+	}
+	return c.Config.Username == "" && c.Config.Password == "" && c.Config.BearerToken == "" &&
+		c.Config.AWSAuthConfig == nil && len(c.Config.TLSClientConfig.CertData) == 0
+}
+
+// RESTConfig returns a go-client REST config from cluster. The network settings of the cluster are
+// not applied, use kube.ClusterRESTConfig to get a config which honours them
 func (c *Cluster) RESTConfig() *rest.Config {
-	if c.Server == common.KubernetesInternalAPIServerAddr && c.Config.Username == "" && c.Config.Password == "" && c.Config.BearerToken == "" {
-		config, err := rest.InClusterConfig()
+	var config *rest.Config
+	if c.IsInCluster() {
+		var err error
+		config, err = rest.InClusterConfig()
 		if err != nil {
 			panic("Unable to create in-cluster config")
 		}
-		return config
-	}
-	tlsClientConfig := rest.TLSClientConfig{
-		Insecure:   c.Config.TLSClientConfig.Insecure,
-		ServerName: c.Config.TLSClientConfig.ServerName,
-		CertData:   c.Config.TLSClientConfig.CertData,
-		KeyData:    c.Config.TLSClientConfig.KeyData,
-		CAData:     c.Config.TLSClientConfig.CAData,
-	}
-	if c.Config.AWSAuthConfig != nil {
-		args := []string{"token", "-i", c.Config.AWSAuthConfig.ClusterName}
-		if c.Config.AWSAuthConfig.RoleARN != "" {
-			args = append(args, "-r", c.Config.AWSAuthConfig.RoleARN)
+	} else {
+		tlsClientConfig := rest.TLSClientConfig{
+			Insecure:   c.Config.TLSClientConfig.Insecure,
+			ServerName: c.Config.TLSClientConfig.ServerName,
+			CertData:   c.Config.TLSClientConfig.CertData,
+			KeyData:    c.Config.TLSClientConfig.KeyData,
+			CAData:     c.Config.TLSClientConfig.CAData,
 		}
-		return &rest.Config{
-			Host:            c.Server,
-			TLSClientConfig: tlsClientConfig,
-			ExecProvider: &api.ExecConfig{
-				APIVersion: "client.authentication.k8s.io/v1alpha1",
-				Command:    "aws-iam-authenticator",
-				Args:       args,
-			},
+		if c.Config.AWSAuthConfig != nil {
+			args := []string{"token", "-i", c.Config.AWSAuthConfig.ClusterName}
+			if c.Config.AWSAuthConfig.RoleARN != "" {
+				args = append(args, "-r", c.Config.AWSAuthConfig.RoleARN)
+			}
+			config = &rest.Config{
+				Host:            c.Server,
+				TLSClientConfig: tlsClientConfig,
+				ExecProvider: &api.ExecConfig{
+					APIVersion: "client.authentication.k8s.io/v1alpha1",
+					Command:    "aws-iam-authenticator",
+					Args:       args,
+				},
+			}
+		} else {
+			config = &rest.Config{
+				Host:            c.Server,
+				Username:        c.Config.Username,
+				Password:        c.Config.Password,
+				BearerToken:     c.Config.BearerToken,
+				TLSClientConfig: tlsClientConfig,
+			}
 		}
 	}
-
-	return &rest.Config{
-		Host:            c.Server,
-		Username:        c.Config.Username,
-		Password:        c.Config.Password,
-		BearerToken:     c.Config.BearerToken,
-		TLSClientConfig: tlsClientConfig,
-	}
+	return config
 }
 
 func UnmarshalToUnstructured(resource string) (*unstructured.Unstructured, error) {
@@ -115,6 +115,29 @@ func (in *AppProjectSpec) DeepCopyInto(out *AppProjectSpec) {
 		*out = make([]v1.GroupKind, len(*in))
 		copy(*out, *in)
 	}
+	if in.ParameterPresets != nil {
+		in, out := &in.ParameterPresets, &out.ParameterPresets
+		*out = make([]ParameterPreset, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.SyncWindows != nil {
+		in, out := &in.SyncWindows, &out.SyncWindows
+		*out = make([]SyncWindow, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.OrphanedResources != nil {
+		in, out := &in.OrphanedResources, &out.OrphanedResources
+		if *in == nil {
+			*out = nil
+		} else {
+			*out = new(OrphanedResourcesMonitorSettings)
+			(*in).DeepCopyInto(*out)
+		}
+	}
 	return
 }
 
@@ -350,6 +373,25 @@ func (in *ApplicationSpec) DeepCopyInto(out *ApplicationSpec) {
 			(*in).DeepCopyInto(*out)
 		}
 	}
+	if in.AdditionalDestinations != nil {
+		in, out := &in.AdditionalDestinations, &out.AdditionalDestinations
+		*out = make([]ApplicationDestination, len(*in))
+		copy(*out, *in)
+	}
+	if in.ParameterPresets != nil {
+		in, out := &in.ParameterPresets, &out.ParameterPresets
+		*out = make([]ParameterPreset, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.IgnoreDifferences != nil {
+		in, out := &in.IgnoreDifferences, &out.IgnoreDifferences
+		*out = make([]ResourceIgnoreDifferences, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
@@ -379,7 +421,7 @@ func (in *ApplicationStatus) DeepCopyInto(out *ApplicationStatus) {
 		*out = make([]ComponentParameter, len(*in))
 		copy(*out, *in)
 	}
-	out.Health = in.Health
+	in.Health.DeepCopyInto(&out.Health)
 	if in.OperationState != nil {
 		in, out := &in.OperationState, &out.OperationState
 		if *in == nil {
@@ -394,6 +436,34 @@ func (in *ApplicationStatus) DeepCopyInto(out *ApplicationStatus) {
 		*out = make([]ApplicationCondition, len(*in))
 		copy(*out, *in)
 	}
+	if in.Destinations != nil {
+		in, out := &in.Destinations, &out.Destinations
+		*out = make([]DestinationStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.OrphanedResources != nil {
+		in, out := &in.OrphanedResources, &out.OrphanedResources
+		*out = make([]OrphanedResource, len(*in))
+		copy(*out, *in)
+	}
+	if in.OperationHistory != nil {
+		in, out := &in.OperationHistory, &out.OperationHistory
+		*out = make([]OperationState, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.ReconciledAt != nil {
+		in, out := &in.ReconciledAt, &out.ReconciledAt
+		if *in == nil {
+			*out = nil
+		} else {
+			*out = new(v1.Time)
+			(*in).DeepCopyInto(*out)
+		}
+	}
 	return
 }
 
@@ -407,6 +477,29 @@ func (in *ApplicationStatus) DeepCopy() *ApplicationStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ApplicationTree) DeepCopyInto(out *ApplicationTree) {
+	*out = *in
+	if in.Nodes != nil {
+		in, out := &in.Nodes, &out.Nodes
+		*out = make([]ResourceTreeNode, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ApplicationTree.
+func (in *ApplicationTree) DeepCopy() *ApplicationTree {
+	if in == nil {
+		return nil
+	}
+	out := new(ApplicationTree)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ApplicationWatchEvent) DeepCopyInto(out *ApplicationWatchEvent) {
 	*out = *in
@@ -424,11 +517,48 @@ func (in *ApplicationWatchEvent) DeepCopy() *ApplicationWatchEvent {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Backoff) DeepCopyInto(out *Backoff) {
+	*out = *in
+	if in.Factor != nil {
+		in, out := &in.Factor, &out.Factor
+		if *in == nil {
+			*out = nil
+		} else {
+			*out = new(int64)
+			**out = **in
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Backoff.
+func (in *Backoff) DeepCopy() *Backoff {
+	if in == nil {
+		return nil
+	}
+	out := new(Backoff)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Cluster) DeepCopyInto(out *Cluster) {
 	*out = *in
 	in.Config.DeepCopyInto(&out.Config)
 	in.ConnectionState.DeepCopyInto(&out.ConnectionState)
+	if in.NormalizerProfiles != nil {
+		in, out := &in.NormalizerProfiles, &out.NormalizerProfiles
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Labels != nil {
+		in, out := &in.Labels, &out.Labels
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
 	return
 }
 
@@ -455,6 +585,15 @@ func (in *ClusterConfig) DeepCopyInto(out *ClusterConfig) {
 			**out = **in
 		}
 	}
+	if in.NetworkConfig != nil {
+		in, out := &in.NetworkConfig, &out.NetworkConfig
+		if *in == nil {
+			*out = nil
+		} else {
+			*out = new(ClusterNetworkConfig)
+			**out = **in
+		}
+	}
 	return
 }
 
@@ -492,6 +631,22 @@ func (in *ClusterList) DeepCopy() *ClusterList {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterNetworkConfig) DeepCopyInto(out *ClusterNetworkConfig) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterNetworkConfig.
+func (in *ClusterNetworkConfig) DeepCopy() *ClusterNetworkConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterNetworkConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ComparisonResult) DeepCopyInto(out *ComparisonResult) {
 	*out = *in
@@ -500,8 +655,11 @@ func (in *ComparisonResult) DeepCopyInto(out *ComparisonResult) {
 	if in.Resources != nil {
 		in, out := &in.Resources, &out.Resources
 		*out = make([]ResourceSummary, len(*in))
-		copy(*out, *in)
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
+	out.ComparedToDestination = in.ComparedToDestination
 	return
 }
 
@@ -565,6 +723,15 @@ func (in *DeploymentInfo) DeepCopyInto(out *DeploymentInfo) {
 		copy(*out, *in)
 	}
 	in.DeployedAt.DeepCopyInto(&out.DeployedAt)
+	if in.Destination != nil {
+		in, out := &in.Destination, &out.Destination
+		if *in == nil {
+			*out = nil
+		} else {
+			*out = new(ApplicationDestination)
+			**out = **in
+		}
+	}
 	return
 }
 
@@ -578,9 +745,62 @@ func (in *DeploymentInfo) DeepCopy() *DeploymentInfo {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DestinationOperationResult) DeepCopyInto(out *DestinationOperationResult) {
+	*out = *in
+	out.Destination = in.Destination
+	if in.SyncResult != nil {
+		in, out := &in.SyncResult, &out.SyncResult
+		if *in == nil {
+			*out = nil
+		} else {
+			*out = new(SyncOperationResult)
+			(*in).DeepCopyInto(*out)
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DestinationOperationResult.
+func (in *DestinationOperationResult) DeepCopy() *DestinationOperationResult {
+	if in == nil {
+		return nil
+	}
+	out := new(DestinationOperationResult)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DestinationStatus) DeepCopyInto(out *DestinationStatus) {
+	*out = *in
+	out.Destination = in.Destination
+	in.Health.DeepCopyInto(&out.Health)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DestinationStatus.
+func (in *DestinationStatus) DeepCopy() *DestinationStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(DestinationStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *HealthStatus) DeepCopyInto(out *HealthStatus) {
 	*out = *in
+	if in.ProgressingSince != nil {
+		in, out := &in.ProgressingSince, &out.ProgressingSince
+		if *in == nil {
+			*out = nil
+		} else {
+			*out = new(v1.Time)
+			(*in).DeepCopyInto(*out)
+		}
+	}
 	return
 }
 
@@ -651,6 +871,23 @@ func (in *Operation) DeepCopy() *Operation {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OperationAttempt) DeepCopyInto(out *OperationAttempt) {
+	*out = *in
+	in.FinishedAt.DeepCopyInto(&out.FinishedAt)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OperationAttempt.
+func (in *OperationAttempt) DeepCopy() *OperationAttempt {
+	if in == nil {
+		return nil
+	}
+	out := new(OperationAttempt)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *OperationState) DeepCopyInto(out *OperationState) {
 	*out = *in
@@ -674,6 +911,29 @@ func (in *OperationState) DeepCopyInto(out *OperationState) {
 			(*in).DeepCopyInto(*out)
 		}
 	}
+	if in.Attempts != nil {
+		in, out := &in.Attempts, &out.Attempts
+		*out = make([]OperationAttempt, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.DestinationResults != nil {
+		in, out := &in.DestinationResults, &out.DestinationResults
+		*out = make([]DestinationOperationResult, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.StateChangedAt != nil {
+		in, out := &in.StateChangedAt, &out.StateChangedAt
+		if *in == nil {
+			*out = nil
+		} else {
+			*out = new(v1.Time)
+			(*in).DeepCopyInto(*out)
+		}
+	}
 	return
 }
 
@@ -687,6 +947,68 @@ func (in *OperationState) DeepCopy() *OperationState {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OrphanedResource) DeepCopyInto(out *OrphanedResource) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OrphanedResource.
+func (in *OrphanedResource) DeepCopy() *OrphanedResource {
+	if in == nil {
+		return nil
+	}
+	out := new(OrphanedResource)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OrphanedResourcesMonitorSettings) DeepCopyInto(out *OrphanedResourcesMonitorSettings) {
+	*out = *in
+	if in.Warn != nil {
+		in, out := &in.Warn, &out.Warn
+		if *in == nil {
+			*out = nil
+		} else {
+			*out = new(bool)
+			**out = **in
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OrphanedResourcesMonitorSettings.
+func (in *OrphanedResourcesMonitorSettings) DeepCopy() *OrphanedResourcesMonitorSettings {
+	if in == nil {
+		return nil
+	}
+	out := new(OrphanedResourcesMonitorSettings)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ParameterPreset) DeepCopyInto(out *ParameterPreset) {
+	*out = *in
+	if in.Parameters != nil {
+		in, out := &in.Parameters, &out.Parameters
+		*out = make([]ComponentParameter, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ParameterPreset.
+func (in *ParameterPreset) DeepCopy() *ParameterPreset {
+	if in == nil {
+		return nil
+	}
+	out := new(ParameterPreset)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ProjectRole) DeepCopyInto(out *ProjectRole) {
 	*out = *in
@@ -775,6 +1097,27 @@ func (in *ResourceDetails) DeepCopy() *ResourceDetails {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResourceIgnoreDifferences) DeepCopyInto(out *ResourceIgnoreDifferences) {
+	*out = *in
+	if in.JSONPointers != nil {
+		in, out := &in.JSONPointers, &out.JSONPointers
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourceIgnoreDifferences.
+func (in *ResourceIgnoreDifferences) DeepCopy() *ResourceIgnoreDifferences {
+	if in == nil {
+		return nil
+	}
+	out := new(ResourceIgnoreDifferences)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ResourceNode) DeepCopyInto(out *ResourceNode) {
 	*out = *in
@@ -798,6 +1141,22 @@ func (in *ResourceNode) DeepCopy() *ResourceNode {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResourceRef) DeepCopyInto(out *ResourceRef) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourceRef.
+func (in *ResourceRef) DeepCopy() *ResourceRef {
+	if in == nil {
+		return nil
+	}
+	out := new(ResourceRef)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ResourceState) DeepCopyInto(out *ResourceState) {
 	*out = *in
@@ -808,7 +1167,7 @@ func (in *ResourceState) DeepCopyInto(out *ResourceState) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
-	out.Health = in.Health
+	in.Health.DeepCopyInto(&out.Health)
 	return
 }
 
@@ -825,7 +1184,7 @@ func (in *ResourceState) DeepCopy() *ResourceState {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ResourceSummary) DeepCopyInto(out *ResourceSummary) {
 	*out = *in
-	out.Health = in.Health
+	in.Health.DeepCopyInto(&out.Health)
 	return
 }
 
@@ -839,6 +1198,62 @@ func (in *ResourceSummary) DeepCopy() *ResourceSummary {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResourceTreeNode) DeepCopyInto(out *ResourceTreeNode) {
+	*out = *in
+	out.ResourceRef = in.ResourceRef
+	if in.ParentRefs != nil {
+		in, out := &in.ParentRefs, &out.ParentRefs
+		*out = make([]ResourceRef, len(*in))
+		copy(*out, *in)
+	}
+	if in.Health != nil {
+		in, out := &in.Health, &out.Health
+		if *in == nil {
+			*out = nil
+		} else {
+			*out = new(HealthStatus)
+			(*in).DeepCopyInto(*out)
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourceTreeNode.
+func (in *ResourceTreeNode) DeepCopy() *ResourceTreeNode {
+	if in == nil {
+		return nil
+	}
+	out := new(ResourceTreeNode)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RetryStrategy) DeepCopyInto(out *RetryStrategy) {
+	*out = *in
+	if in.Backoff != nil {
+		in, out := &in.Backoff, &out.Backoff
+		if *in == nil {
+			*out = nil
+		} else {
+			*out = new(Backoff)
+			(*in).DeepCopyInto(*out)
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RetryStrategy.
+func (in *RetryStrategy) DeepCopy() *RetryStrategy {
+	if in == nil {
+		return nil
+	}
+	out := new(RetryStrategy)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SyncOperation) DeepCopyInto(out *SyncOperation) {
 	*out = *in
@@ -861,6 +1276,38 @@ func (in *SyncOperation) DeepCopyInto(out *SyncOperation) {
 		*out = make([]SyncOperationResource, len(*in))
 		copy(*out, *in)
 	}
+	if in.Retry != nil {
+		in, out := &in.Retry, &out.Retry
+		if *in == nil {
+			*out = nil
+		} else {
+			*out = new(RetryStrategy)
+			(*in).DeepCopyInto(*out)
+		}
+	}
+	if in.ExcludedResources != nil {
+		in, out := &in.ExcludedResources, &out.ExcludedResources
+		*out = make([]SyncOperationResource, len(*in))
+		copy(*out, *in)
+	}
+	if in.MoveFrom != nil {
+		in, out := &in.MoveFrom, &out.MoveFrom
+		if *in == nil {
+			*out = nil
+		} else {
+			*out = new(ApplicationDestination)
+			**out = **in
+		}
+	}
+	if in.TerminationGracePeriodSeconds != nil {
+		in, out := &in.TerminationGracePeriodSeconds, &out.TerminationGracePeriodSeconds
+		if *in == nil {
+			*out = nil
+		} else {
+			*out = new(int64)
+			**out = **in
+		}
+	}
 	return
 }
 
@@ -917,6 +1364,44 @@ func (in *SyncOperationResult) DeepCopyInto(out *SyncOperationResult) {
 			}
 		}
 	}
+	if in.MovedResources != nil {
+		in, out := &in.MovedResources, &out.MovedResources
+		*out = make([]*ResourceDetails, len(*in))
+		for i := range *in {
+			if (*in)[i] == nil {
+				(*out)[i] = nil
+			} else {
+				(*out)[i] = new(ResourceDetails)
+				(*in)[i].DeepCopyInto((*out)[i])
+			}
+		}
+	}
+	if in.DeniedReasons != nil {
+		in, out := &in.DeniedReasons, &out.DeniedReasons
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.FailedResources != nil {
+		in, out := &in.FailedResources, &out.FailedResources
+		*out = make([]*ResourceDetails, len(*in))
+		for i := range *in {
+			if (*in)[i] == nil {
+				(*out)[i] = nil
+			} else {
+				(*out)[i] = new(ResourceDetails)
+				(*in)[i].DeepCopyInto((*out)[i])
+			}
+		}
+	}
+	if in.HealthWaitStartedAt != nil {
+		in, out := &in.HealthWaitStartedAt, &out.HealthWaitStartedAt
+		if *in == nil {
+			*out = nil
+		} else {
+			*out = new(v1.Time)
+			(*in).DeepCopyInto(*out)
+		}
+	}
 	return
 }
 
@@ -942,6 +1427,20 @@ func (in *SyncPolicy) DeepCopyInto(out *SyncPolicy) {
 			**out = **in
 		}
 	}
+	if in.SyncOptions != nil {
+		in, out := &in.SyncOptions, &out.SyncOptions
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Retry != nil {
+		in, out := &in.Retry, &out.Retry
+		if *in == nil {
+			*out = nil
+		} else {
+			*out = new(RetryStrategy)
+			(*in).DeepCopyInto(*out)
+		}
+	}
 	return
 }
 
@@ -992,6 +1491,15 @@ func (in *SyncStrategy) DeepCopyInto(out *SyncStrategy) {
 			**out = **in
 		}
 	}
+	if in.Progressive != nil {
+		in, out := &in.Progressive, &out.Progressive
+		if *in == nil {
+			*out = nil
+		} else {
+			*out = new(SyncStrategyProgressive)
+			**out = **in
+		}
+	}
 	return
 }
 
@@ -1038,6 +1546,44 @@ func (in *SyncStrategyHook) DeepCopy() *SyncStrategyHook {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SyncStrategyProgressive) DeepCopyInto(out *SyncStrategyProgressive) {
+	*out = *in
+	out.SyncStrategyApply = in.SyncStrategyApply
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SyncStrategyProgressive.
+func (in *SyncStrategyProgressive) DeepCopy() *SyncStrategyProgressive {
+	if in == nil {
+		return nil
+	}
+	out := new(SyncStrategyProgressive)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SyncWindow) DeepCopyInto(out *SyncWindow) {
+	*out = *in
+	if in.Applications != nil {
+		in, out := &in.Applications, &out.Applications
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SyncWindow.
+func (in *SyncWindow) DeepCopy() *SyncWindow {
+	if in == nil {
+		return nil
+	}
+	out := new(SyncWindow)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *TLSClientConfig) DeepCopyInto(out *TLSClientConfig) {
 	*out = *in
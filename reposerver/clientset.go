@@ -2,12 +2,51 @@ package reposerver
 
 import (
 	"crypto/tls"
+	"fmt"
+	"sync"
+	"time"
 
-	"github.com/argoproj/argo-cd/reposerver/repository"
-	"github.com/argoproj/argo-cd/util"
+	"github.com/grpc-ecosystem/go-grpc-middleware/retry"
+	"github.com/grpc-ecosystem/go-grpc-middleware/util/backoffutils"
 	log "github.com/sirupsen/logrus"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/balancer/roundrobin"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/keepalive"
+	"google.golang.org/grpc/status"
+	"k8s.io/client-go/kubernetes"
+
+	"github.com/argoproj/argo-cd/reposerver/repository"
+	"github.com/argoproj/argo-cd/util"
+)
+
+// Resolvers of the address of the repo server
+const (
+	// ResolverDNS resolves the address of the repo server with DNS. The calls are balanced across all
+	// the resolved addresses, e.g. the pods of a headless service.
+	ResolverDNS = "dns"
+	// ResolverEndpoints resolves the address of the repo server, the name and port of a service in the
+	// namespace of Argo CD, to the ready endpoints of the service listed by the Kubernetes API, so that
+	// the calls are balanced across the replicas of the repo server without depending on the cluster DNS
+	ResolverEndpoints = "endpoints"
+)
+
+const (
+	// retryLimit is the number of times a call is retried while the repo server is unavailable
+	retryLimit = 5
+	// retryBackoffDuration is the delay before the first retry of a call
+	retryBackoffDuration = time.Second
+	// retryBackoffMaxDuration is the maximum delay between retries of a call. With the retry limit, the
+	// calls outlast the restart of a repo server pod.
+	retryBackoffMaxDuration = 10 * time.Second
+	// reconnectBackoffMaxDelay is the maximum delay between the attempts to reconnect to a repo server
+	reconnectBackoffMaxDelay = 5 * time.Second
+	// keepaliveTime is how long a connection to a repo server is idle before it is pinged, to detect
+	// repo servers which went away without closing their connections
+	keepaliveTime = 30 * time.Second
+	// keepaliveTimeout is how long a ping waits for its ack before the connection is closed
+	keepaliveTimeout = 10 * time.Second
 )
 
 // Clientset represets repository server api clients
@@ -15,20 +54,110 @@ type Clientset interface {
 	NewRepositoryClient() (util.Closer, repository.RepositoryServiceClient, error)
 }
 
+// clientSet shares a single connection to the repo servers between all the clients. The connection
+// reconnects by itself, and balances the calls across the repo servers which are ready.
 type clientSet struct {
-	address string
+	target   string
+	dialOpts []grpc.DialOption
+
+	lock sync.Mutex
+	conn *grpc.ClientConn
+}
+
+// nopCloser is the closer of the clients of the shared connection, which stays open
+type nopCloser struct{}
+
+func (nopCloser) Close() error {
+	return nil
 }
 
 func (c *clientSet) NewRepositoryClient() (util.Closer, repository.RepositoryServiceClient, error) {
-	conn, err := grpc.Dial(c.address, grpc.WithTransportCredentials(credentials.NewTLS(&tls.Config{InsecureSkipVerify: true})))
+	conn, err := c.getConn()
 	if err != nil {
-		log.Errorf("Unable to connect to repository service with address %s", c.address)
+		log.Errorf("Unable to connect to repository service with address %s", c.target)
 		return nil, nil, err
 	}
-	return conn, repository.NewRepositoryServiceClient(conn), nil
+	return nopCloser{}, repository.NewRepositoryServiceClient(conn), nil
+}
+
+// getConn returns the shared connection, which is dialed by the first client. Dialing does not wait
+// for the repo servers to be ready, so it only fails on invalid options.
+func (c *clientSet) getConn() (*grpc.ClientConn, error) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	if c.conn == nil {
+		conn, err := grpc.Dial(c.target, c.dialOpts...)
+		if err != nil {
+			return nil, err
+		}
+		c.conn = conn
+	}
+	return c.conn, nil
 }
 
-// NewRepositoryServerClientset creates new instance of repo server Clientset
+// NewRepositoryServerClientset creates new instance of repo server Clientset, which resolves the address
+// of the repo server with DNS
 func NewRepositoryServerClientset(address string) Clientset {
-	return &clientSet{address: address}
+	return &clientSet{
+		target:   fmt.Sprintf("%s:///%s", ResolverDNS, address),
+		dialOpts: dialOptions(),
+	}
+}
+
+// NewRepositoryServerClientsetWithResolver creates new instance of repo server Clientset, which resolves
+// the address of the repo server with the given resolver
+func NewRepositoryServerClientsetWithResolver(address string, resolver string, kubeClientset kubernetes.Interface, namespace string) (Clientset, error) {
+	switch resolver {
+	case ResolverDNS, "":
+		return NewRepositoryServerClientset(address), nil
+	case ResolverEndpoints:
+		builder := newEndpointsResolverBuilder(kubeClientset, namespace)
+		return &clientSet{
+			target:   fmt.Sprintf("%s:///%s", builder.Scheme(), address),
+			dialOpts: dialOptions(),
+		}, nil
+	}
+	return nil, fmt.Errorf("unknown repo server resolver '%s': expected %s or %s", resolver, ResolverDNS, ResolverEndpoints)
+}
+
+// dialOptions returns the options of the connection to the repo servers
+func dialOptions() []grpc.DialOption {
+	return []grpc.DialOption{
+		grpc.WithTransportCredentials(credentials.NewTLS(&tls.Config{InsecureSkipVerify: true})),
+		grpc.WithBalancerName(roundrobin.Name),
+		grpc.WithBackoffMaxDelay(reconnectBackoffMaxDelay),
+		grpc.WithKeepaliveParams(keepalive.ClientParameters{
+			Time:                keepaliveTime,
+			Timeout:             keepaliveTimeout,
+			PermitWithoutStream: true,
+		}),
+		grpc.WithUnaryInterceptor(retryUnaryClientInterceptor(retryLimit)),
+	}
+}
+
+// retryBackoff doubles the delay between retries of a call, up to the maximum delay
+func retryBackoff(attempt uint) time.Duration {
+	backoff := retryBackoffMaxDuration
+	if attempt > 0 && attempt < 32 {
+		if d := retryBackoffDuration << (attempt - 1); d < retryBackoffMaxDuration {
+			backoff = d
+		}
+	}
+	return backoffutils.JitterUp(backoff, 0.1)
+}
+
+// retryUnaryClientInterceptor returns an interceptor which retries calls failing since no repo server
+// is available, up to the given number of times
+func retryUnaryClientInterceptor(limit int) grpc.UnaryClientInterceptor {
+	return grpc_retry.UnaryClientInterceptor(
+		// the maximum includes the first attempt
+		grpc_retry.WithMax(uint(limit)+1),
+		grpc_retry.WithBackoff(retryBackoff),
+		grpc_retry.WithCodes(codes.Unavailable),
+	)
+}
+
+// IsUnavailable returns whether a call to the repo server failed since no repo server is available
+func IsUnavailable(err error) bool {
+	return status.Code(err) == codes.Unavailable
 }
@@ -0,0 +1,31 @@
+package reposerver
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+
+	"github.com/argoproj/argo-cd/util/git"
+)
+
+const (
+	// MetricsPath is the endpoint to collect repo server metrics
+	MetricsPath = "/metrics"
+)
+
+// NewMetricsServer returns a new prometheus server which exposes the metrics of the git operations
+// of the repo server
+func NewMetricsServer(port int) (*http.Server, error) {
+	registry := prometheus.NewRegistry()
+	if err := git.RegisterMetrics(registry); err != nil {
+		return nil, err
+	}
+	mux := http.NewServeMux()
+	mux.Handle(MetricsPath, promhttp.HandlerFor(registry, promhttp.HandlerOpts{}))
+	return &http.Server{
+		Addr:    fmt.Sprintf("0.0.0.0:%d", port),
+		Handler: mux,
+	}, nil
+}
@@ -0,0 +1,79 @@
+package repository
+
+import (
+	"fmt"
+
+	log "github.com/sirupsen/logrus"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+// apiVersionRewrite describes how resources of a kind are rewritten from deprecated API versions
+// to a newer API version with the same schema
+type apiVersionRewrite struct {
+	kind string
+	from []string
+	to   string
+	// fix adjusts the resource to the newer API version, e.g. sets fields which became required.
+	// Resources which cannot be adjusted safely are not rewritten.
+	fix func(obj *unstructured.Unstructured) error
+}
+
+// apiVersionRewrites lists the deprecated API versions which can be safely rewritten
+var apiVersionRewrites = []apiVersionRewrite{
+	{kind: "Deployment", from: []string{"extensions/v1beta1", "apps/v1beta1", "apps/v1beta2"}, to: "apps/v1", fix: setSelectorFromTemplate},
+	{kind: "DaemonSet", from: []string{"extensions/v1beta1", "apps/v1beta2"}, to: "apps/v1", fix: setSelectorFromTemplate},
+	{kind: "ReplicaSet", from: []string{"extensions/v1beta1", "apps/v1beta1", "apps/v1beta2"}, to: "apps/v1", fix: setSelectorFromTemplate},
+	{kind: "StatefulSet", from: []string{"apps/v1beta1", "apps/v1beta2"}, to: "apps/v1", fix: setSelectorFromTemplate},
+	{kind: "NetworkPolicy", from: []string{"extensions/v1beta1"}, to: "networking.k8s.io/v1"},
+	{kind: "PodSecurityPolicy", from: []string{"extensions/v1beta1"}, to: "policy/v1beta1"},
+	{kind: "Ingress", from: []string{"extensions/v1beta1"}, to: "networking.k8s.io/v1beta1"},
+	{kind: "ClusterRole", from: []string{"rbac.authorization.k8s.io/v1alpha1", "rbac.authorization.k8s.io/v1beta1"}, to: "rbac.authorization.k8s.io/v1"},
+	{kind: "ClusterRoleBinding", from: []string{"rbac.authorization.k8s.io/v1alpha1", "rbac.authorization.k8s.io/v1beta1"}, to: "rbac.authorization.k8s.io/v1"},
+	{kind: "Role", from: []string{"rbac.authorization.k8s.io/v1alpha1", "rbac.authorization.k8s.io/v1beta1"}, to: "rbac.authorization.k8s.io/v1"},
+	{kind: "RoleBinding", from: []string{"rbac.authorization.k8s.io/v1alpha1", "rbac.authorization.k8s.io/v1beta1"}, to: "rbac.authorization.k8s.io/v1"},
+}
+
+// setSelectorFromTemplate sets the selector of a workload, which became required in apps/v1, to the
+// labels of its pod template. Deprecated API versions defaulted the selector the same way.
+func setSelectorFromTemplate(obj *unstructured.Unstructured) error {
+	if _, ok, _ := unstructured.NestedFieldNoCopy(obj.Object, "spec", "selector"); ok {
+		return nil
+	}
+	labels, ok, err := unstructured.NestedStringMap(obj.Object, "spec", "template", "metadata", "labels")
+	if err != nil || !ok || len(labels) == 0 {
+		return fmt.Errorf("pod template has no labels to default the selector to")
+	}
+	matchLabels := make(map[string]interface{}, len(labels))
+	for k, v := range labels {
+		matchLabels[k] = v
+	}
+	return unstructured.SetNestedMap(obj.Object, matchLabels, "spec", "selector", "matchLabels")
+}
+
+// rewriteDeprecatedAPIVersion rewrites a resource of a deprecated API version to the newer API
+// version, if the newer version is served by the cluster and the resource can be safely rewritten.
+// Returns the rewritten resource, or the resource itself if it is not rewritten.
+func rewriteDeprecatedAPIVersion(obj *unstructured.Unstructured, apiVersions map[string]bool, logCtx *log.Entry) *unstructured.Unstructured {
+	apiVersion := obj.GetAPIVersion()
+	for _, rewrite := range apiVersionRewrites {
+		if rewrite.kind != obj.GetKind() || !apiVersions[rewrite.to] {
+			continue
+		}
+		for _, from := range rewrite.from {
+			if from != apiVersion {
+				continue
+			}
+			rewritten := obj.DeepCopy()
+			rewritten.SetAPIVersion(rewrite.to)
+			if rewrite.fix != nil {
+				if err := rewrite.fix(rewritten); err != nil {
+					logCtx.Warnf("Not rewriting %s %s from deprecated %s to %s: %v", obj.GetKind(), obj.GetName(), apiVersion, rewrite.to, err)
+					return obj
+				}
+			}
+			logCtx.Infof("Rewrote %s %s from deprecated %s to %s", obj.GetKind(), obj.GetName(), apiVersion, rewrite.to)
+			return rewritten
+		}
+	}
+	return obj
+}
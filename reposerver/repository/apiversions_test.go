@@ -0,0 +1,52 @@
+package repository
+
+import (
+	"testing"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	argoappv1 "github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1"
+)
+
+const deprecatedDeployment = `{"apiVersion":"extensions/v1beta1","kind":"Deployment","metadata":{"name":"guestbook"},"spec":{"template":{"metadata":{"labels":{"app":"guestbook"}}}}}`
+
+func unmarshalObj(t *testing.T, manifest string) *unstructured.Unstructured {
+	obj, err := argoappv1.UnmarshalToUnstructured(manifest)
+	assert.NoError(t, err)
+	return obj
+}
+
+func TestRewriteDeprecatedAPIVersion(t *testing.T) {
+	logCtx := log.WithField("application", "guestbook")
+	obj := unmarshalObj(t, deprecatedDeployment)
+
+	rewritten := rewriteDeprecatedAPIVersion(obj, map[string]bool{"apps/v1": true, "extensions/v1beta1": true}, logCtx)
+	assert.Equal(t, "apps/v1", rewritten.GetAPIVersion())
+	// the selector required by apps/v1 defaults to the labels of the pod template
+	selector, _, _ := unstructured.NestedStringMap(rewritten.Object, "spec", "selector", "matchLabels")
+	assert.Equal(t, map[string]string{"app": "guestbook"}, selector)
+	// the original resource is not modified
+	assert.Equal(t, "extensions/v1beta1", obj.GetAPIVersion())
+
+	// resources are not rewritten to versions which the cluster does not serve
+	rewritten = rewriteDeprecatedAPIVersion(obj, map[string]bool{"extensions/v1beta1": true}, logCtx)
+	assert.Equal(t, obj, rewritten)
+
+	// resources which cannot be rewritten safely are left as is
+	obj = unmarshalObj(t, `{"apiVersion":"extensions/v1beta1","kind":"Deployment","metadata":{"name":"guestbook"},"spec":{}}`)
+	rewritten = rewriteDeprecatedAPIVersion(obj, map[string]bool{"apps/v1": true}, logCtx)
+	assert.Equal(t, "extensions/v1beta1", rewritten.GetAPIVersion())
+
+	obj = unmarshalObj(t, `{"apiVersion":"rbac.authorization.k8s.io/v1beta1","kind":"Role","metadata":{"name":"guestbook"}}`)
+	rewritten = rewriteDeprecatedAPIVersion(obj, map[string]bool{"rbac.authorization.k8s.io/v1": true}, logCtx)
+	assert.Equal(t, "rbac.authorization.k8s.io/v1", rewritten.GetAPIVersion())
+}
+
+func TestManifestCacheKeyIncludesAPIVersions(t *testing.T) {
+	q := ManifestRequest{ApplicationSource: &argoappv1.ApplicationSource{}}
+	key := manifestCacheKey("abc123", &q)
+	q.ApiVersions = []string{"apps/v1"}
+	assert.NotEqual(t, key, manifestCacheKey("abc123", &q))
+}
@@ -0,0 +1,218 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/ghodss/yaml"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/argoproj/argo-cd/util/ksonnet"
+)
+
+const (
+	// KsonnetConversionFormatDirectory converts each environment to a directory of plain manifests
+	KsonnetConversionFormatDirectory = "directory"
+	// KsonnetConversionFormatKustomize converts the environments to kustomize overlays of a base
+	// holding the resources which are identical in all environments
+	KsonnetConversionFormatKustomize = "kustomize"
+)
+
+// kustomization is the kustomization.yaml of a converted ksonnet application
+type kustomization struct {
+	Bases     []string `json:"bases,omitempty"`
+	Resources []string `json:"resources,omitempty"`
+}
+
+// ConvertKsonnetApp renders the environments of a ksonnet application and returns an equivalent
+// plain directory or kustomize structure
+func (s *Service) ConvertKsonnetApp(ctx context.Context, q *KsonnetConversionRequest) (*KsonnetConversionResponse, error) {
+	format := q.Format
+	if format == "" {
+		format = KsonnetConversionFormatDirectory
+	}
+	if format != KsonnetConversionFormatDirectory && format != KsonnetConversionFormatKustomize {
+		return nil, status.Errorf(codes.InvalidArgument, "unknown conversion format '%s'", format)
+	}
+	gitClient, commitSHA, err := s.newClientResolveRevision(q.Repo, q.Revision)
+	if err != nil {
+		return nil, err
+	}
+
+	s.repoLock.Lock(gitClient.Root())
+	defer s.repoLock.Unlock(gitClient.Root())
+	commitSHA, err = checkoutRevision(gitClient, commitSHA)
+	if err != nil {
+		return nil, err
+	}
+	appPath := filepath.Join(gitClient.Root(), q.Path)
+	ksApp, err := ksonnet.NewKsonnetApp(appPath)
+	if err != nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "unable to load application from %s: %v", q.Path, err)
+	}
+	envs := q.Environments
+	if len(envs) == 0 {
+		envs, err = ksApp.Environments()
+		if err != nil {
+			return nil, status.Errorf(codes.FailedPrecondition, "unable to list environments of %s: %v", q.Path, err)
+		}
+	}
+	envObjs := make(map[string][]*unstructured.Unstructured)
+	for _, env := range envs {
+		objs, err := ksApp.Show(env)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "unable to render environment '%s': %v", env, err)
+		}
+		envObjs[env] = objs
+	}
+	files, err := convertKsonnetEnvs(envs, envObjs, format)
+	if err != nil {
+		return nil, err
+	}
+	return &KsonnetConversionResponse{Revision: commitSHA, Files: files}, nil
+}
+
+// convertKsonnetEnvs converts the rendered resources of the environments to files of the given format
+func convertKsonnetEnvs(envs []string, envObjs map[string][]*unstructured.Unstructured, format string) ([]*ConvertedFile, error) {
+	var files []*ConvertedFile
+	if format == KsonnetConversionFormatDirectory {
+		for _, env := range envs {
+			envFiles, _, err := manifestFiles(env, envObjs[env])
+			if err != nil {
+				return nil, err
+			}
+			files = append(files, envFiles...)
+		}
+		return files, nil
+	}
+
+	common := commonObjs(envs, envObjs)
+	var baseObjs []*unstructured.Unstructured
+	if len(envs) > 0 {
+		for _, obj := range envObjs[envs[0]] {
+			if common[resourceKey(obj)] {
+				baseObjs = append(baseObjs, obj)
+			}
+		}
+	}
+	var bases []string
+	if len(baseObjs) > 0 {
+		baseFiles, baseResources, err := manifestFiles("base", baseObjs)
+		if err != nil {
+			return nil, err
+		}
+		kustomizationFile, err := kustomizationFile("base", kustomization{Resources: baseResources})
+		if err != nil {
+			return nil, err
+		}
+		files = append(files, kustomizationFile)
+		files = append(files, baseFiles...)
+		bases = []string{"../../base"}
+	}
+	for _, env := range envs {
+		var overlayObjs []*unstructured.Unstructured
+		for _, obj := range envObjs[env] {
+			if !common[resourceKey(obj)] {
+				overlayObjs = append(overlayObjs, obj)
+			}
+		}
+		dir := path.Join("overlays", env)
+		overlayFiles, overlayResources, err := manifestFiles(dir, overlayObjs)
+		if err != nil {
+			return nil, err
+		}
+		kustomizationFile, err := kustomizationFile(dir, kustomization{Bases: bases, Resources: overlayResources})
+		if err != nil {
+			return nil, err
+		}
+		files = append(files, kustomizationFile)
+		files = append(files, overlayFiles...)
+	}
+	return files, nil
+}
+
+// commonObjs returns the keys of the resources which are identical in all environments
+func commonObjs(envs []string, envObjs map[string][]*unstructured.Unstructured) map[string]bool {
+	common := make(map[string]bool)
+	if len(envs) == 0 {
+		return common
+	}
+	for _, obj := range envObjs[envs[0]] {
+		common[resourceKey(obj)] = true
+	}
+	for _, env := range envs[1:] {
+		objByKey := make(map[string]*unstructured.Unstructured)
+		for _, obj := range envObjs[env] {
+			objByKey[resourceKey(obj)] = obj
+		}
+		for _, obj := range envObjs[envs[0]] {
+			key := resourceKey(obj)
+			if other, ok := objByKey[key]; !ok || !reflect.DeepEqual(obj.Object, other.Object) {
+				delete(common, key)
+			}
+		}
+	}
+	return common
+}
+
+// manifestFiles returns a YAML file in the directory for each resource, and the names of the files
+func manifestFiles(dir string, objs []*unstructured.Unstructured) ([]*ConvertedFile, []string, error) {
+	objs = append([]*unstructured.Unstructured{}, objs...)
+	sort.Slice(objs, func(i, j int) bool {
+		return resourceKey(objs[i]) < resourceKey(objs[j])
+	})
+	var files []*ConvertedFile
+	var names []string
+	taken := make(map[string]bool)
+	for _, obj := range objs {
+		name := manifestFileName(obj, taken)
+		taken[name] = true
+		data, err := yaml.Marshal(obj.Object)
+		if err != nil {
+			return nil, nil, err
+		}
+		files = append(files, &ConvertedFile{Path: path.Join(dir, name), Data: data})
+		names = append(names, name)
+	}
+	return files, names, nil
+}
+
+// manifestFileName returns the file name of a resource, which includes its namespace if another
+// resource of the same kind has the same name
+func manifestFileName(obj *unstructured.Unstructured, taken map[string]bool) string {
+	parts := []string{obj.GetKind(), obj.GetName()}
+	if name := fileName(parts); !taken[name] {
+		return name
+	}
+	parts = []string{obj.GetKind(), obj.GetNamespace(), obj.GetName()}
+	name := fileName(parts)
+	for i := 2; taken[name]; i++ {
+		name = fileName(append(parts, fmt.Sprintf("%d", i)))
+	}
+	return name
+}
+
+func fileName(parts []string) string {
+	return strings.ToLower(strings.Join(parts, "-")) + ".yaml"
+}
+
+func kustomizationFile(dir string, k kustomization) (*ConvertedFile, error) {
+	data, err := yaml.Marshal(k)
+	if err != nil {
+		return nil, err
+	}
+	return &ConvertedFile{Path: path.Join(dir, "kustomization.yaml"), Data: data}, nil
+}
+
+// resourceKey identifies a resource within an environment
+func resourceKey(obj *unstructured.Unstructured) string {
+	gvk := obj.GroupVersionKind()
+	return fmt.Sprintf("%s/%s/%s/%s", gvk.Group, gvk.Kind, obj.GetNamespace(), obj.GetName())
+}
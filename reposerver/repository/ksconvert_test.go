@@ -0,0 +1,68 @@
+package repository
+
+import (
+	"testing"
+
+	"github.com/ghodss/yaml"
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1"
+)
+
+func testEnvObjs(t *testing.T) map[string][]*unstructured.Unstructured {
+	objs := func(replicas int) []*unstructured.Unstructured {
+		svc, err := v1alpha1.UnmarshalToUnstructured(`{"apiVersion":"v1","kind":"Service","metadata":{"name":"guestbook-ui"}}`)
+		assert.NoError(t, err)
+		deploy, err := v1alpha1.UnmarshalToUnstructured(`{"apiVersion":"apps/v1","kind":"Deployment","metadata":{"name":"guestbook-ui"},"spec":{}}`)
+		assert.NoError(t, err)
+		assert.NoError(t, unstructured.SetNestedField(deploy.Object, int64(replicas), "spec", "replicas"))
+		return []*unstructured.Unstructured{svc, deploy}
+	}
+	return map[string][]*unstructured.Unstructured{"dev": objs(1), "prod": objs(3)}
+}
+
+func filesByPath(files []*ConvertedFile) map[string]string {
+	res := make(map[string]string)
+	for _, f := range files {
+		res[f.Path] = string(f.Data)
+	}
+	return res
+}
+
+func TestConvertKsonnetEnvsDirectory(t *testing.T) {
+	files, err := convertKsonnetEnvs([]string{"dev", "prod"}, testEnvObjs(t), KsonnetConversionFormatDirectory)
+	assert.NoError(t, err)
+	byPath := filesByPath(files)
+	assert.Len(t, byPath, 4)
+	assert.Contains(t, byPath["dev/deployment-guestbook-ui.yaml"], "replicas: 1")
+	assert.Contains(t, byPath["prod/deployment-guestbook-ui.yaml"], "replicas: 3")
+	assert.Contains(t, byPath, "dev/service-guestbook-ui.yaml")
+	assert.Contains(t, byPath, "prod/service-guestbook-ui.yaml")
+}
+
+func TestConvertKsonnetEnvsKustomize(t *testing.T) {
+	files, err := convertKsonnetEnvs([]string{"dev", "prod"}, testEnvObjs(t), KsonnetConversionFormatKustomize)
+	assert.NoError(t, err)
+	byPath := filesByPath(files)
+	assert.Len(t, byPath, 6)
+	assert.Contains(t, byPath, "base/service-guestbook-ui.yaml")
+	assert.Contains(t, byPath["overlays/dev/deployment-guestbook-ui.yaml"], "replicas: 1")
+	assert.Contains(t, byPath["overlays/prod/deployment-guestbook-ui.yaml"], "replicas: 3")
+
+	var k kustomization
+	assert.NoError(t, yaml.Unmarshal([]byte(byPath["base/kustomization.yaml"]), &k))
+	assert.Equal(t, kustomization{Resources: []string{"service-guestbook-ui.yaml"}}, k)
+	assert.NoError(t, yaml.Unmarshal([]byte(byPath["overlays/prod/kustomization.yaml"]), &k))
+	assert.Equal(t, kustomization{Bases: []string{"../../base"}, Resources: []string{"deployment-guestbook-ui.yaml"}}, k)
+}
+
+func TestManifestFileNames(t *testing.T) {
+	cm1, err := v1alpha1.UnmarshalToUnstructured(`{"apiVersion":"v1","kind":"ConfigMap","metadata":{"name":"config","namespace":"a"}}`)
+	assert.NoError(t, err)
+	cm2, err := v1alpha1.UnmarshalToUnstructured(`{"apiVersion":"v1","kind":"ConfigMap","metadata":{"name":"config","namespace":"b"}}`)
+	assert.NoError(t, err)
+	_, names, err := manifestFiles("", []*unstructured.Unstructured{cm2, cm1})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"configmap-config.yaml", "configmap-b-config.yaml"}, names)
+}
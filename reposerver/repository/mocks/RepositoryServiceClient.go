@@ -11,6 +11,36 @@ type RepositoryServiceClient struct {
 	mock.Mock
 }
 
+// ConvertKsonnetApp provides a mock function with given fields: ctx, in, opts
+func (_m *RepositoryServiceClient) ConvertKsonnetApp(ctx context.Context, in *repository.KsonnetConversionRequest, opts ...grpc.CallOption) (*repository.KsonnetConversionResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *repository.KsonnetConversionResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *repository.KsonnetConversionRequest, ...grpc.CallOption) *repository.KsonnetConversionResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*repository.KsonnetConversionResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *repository.KsonnetConversionRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GenerateManifest provides a mock function with given fields: ctx, in, opts
 func (_m *RepositoryServiceClient) GenerateManifest(ctx context.Context, in *repository.ManifestRequest, opts ...grpc.CallOption) (*repository.ManifestResponse, error) {
 	_va := make([]interface{}, len(opts))
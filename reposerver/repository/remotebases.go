@@ -0,0 +1,161 @@
+package repository
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	log "github.com/sirupsen/logrus"
+
+	"github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/util/git"
+	"github.com/argoproj/argo-cd/util/kustomize"
+)
+
+const (
+	// maxRemoteBaseDepth is the maximum nesting of kustomize remote bases
+	maxRemoteBaseDepth = 5
+)
+
+// remoteBasesDir is the directory where remote kustomize bases are cached
+var remoteBasesDir = filepath.Join(os.TempDir(), "kustomize-bases")
+
+// resolveRemoteBases replaces kustomize remote bases, which are hosted in registered repositories,
+// with local copies of the bases. Bases of repositories which are not registered are left for
+// kustomize to fetch. Copies are cached per repository and commit SHA.
+func (s *Service) resolveRemoteBases(appPath string, repos []*v1alpha1.Repository, depth int) error {
+	if depth > maxRemoteBaseDepth {
+		return fmt.Errorf("remote bases are nested more than %d levels deep", maxRemoteBaseDepth)
+	}
+	bases, err := kustomize.GetBases(appPath)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil
+		}
+		return err
+	}
+	replacements := make(map[string]string)
+	for _, base := range bases {
+		remote, ok := kustomize.ParseRemoteBase(base)
+		if !ok {
+			continue
+		}
+		repo := findRepository(repos, remote.RepoURL)
+		if repo == nil {
+			continue
+		}
+		baseRoot, err := s.checkoutRemoteBase(repo, remote.Ref)
+		if err != nil {
+			return fmt.Errorf("failed to fetch remote base %s: %v", base, err)
+		}
+		basePath := filepath.Join(baseRoot, remote.Path)
+		err = s.resolveRemoteBases(basePath, repos, depth+1)
+		if err != nil {
+			return err
+		}
+		relPath, err := filepath.Rel(appPath, basePath)
+		if err != nil {
+			return err
+		}
+		log.Infof("Using cached copy %s of remote base %s", basePath, base)
+		replacements[base] = relPath
+	}
+	if len(replacements) == 0 {
+		return nil
+	}
+	return kustomize.ReplaceBases(appPath, replacements)
+}
+
+// checkoutRemoteBase returns the path of a copy of the repository at the given revision
+func (s *Service) checkoutRemoteBase(repo *v1alpha1.Repository, revision string) (string, error) {
+	repoDir := filepath.Join(remoteBasesDir, strings.Replace(repo.Repo, "/", "_", -1))
+	gitClient, err := s.gitFactory.NewClient(repo.Repo, filepath.Join(repoDir, "repo"), repo.Username, repo.Password, repo.SSHPrivateKey)
+	if err != nil {
+		return "", err
+	}
+	commitSHA, err := gitClient.LsRemote(revision)
+	if err != nil {
+		return "", err
+	}
+	baseRoot := filepath.Join(repoDir, commitSHA)
+
+	s.repoLock.Lock(gitClient.Root())
+	defer s.repoLock.Unlock(gitClient.Root())
+	if _, err := os.Stat(baseRoot); err == nil {
+		return baseRoot, nil
+	}
+	_, err = checkoutRevision(gitClient, commitSHA)
+	if err != nil {
+		return "", err
+	}
+	tmpRoot := baseRoot + ".tmp"
+	_ = os.RemoveAll(tmpRoot)
+	err = copyDir(gitClient.Root(), tmpRoot)
+	if err != nil {
+		return "", err
+	}
+	return baseRoot, os.Rename(tmpRoot, baseRoot)
+}
+
+// findRepository returns the registered repository with the given URL
+func findRepository(repos []*v1alpha1.Repository, repoURL string) *v1alpha1.Repository {
+	normalized := git.NormalizeGitURL(repoURL)
+	if normalized == "" {
+		return nil
+	}
+	for _, repo := range repos {
+		if git.NormalizeGitURL(repo.Repo) == normalized {
+			return repo
+		}
+	}
+	return nil
+}
+
+// copyDir copies the working tree of a repository, excluding the .git directory
+func copyDir(src, dst string) error {
+	return filepath.Walk(src, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		relPath, err := filepath.Rel(src, path)
+		if err != nil {
+			return err
+		}
+		if info.IsDir() && info.Name() == ".git" {
+			return filepath.SkipDir
+		}
+		target := filepath.Join(dst, relPath)
+		switch {
+		case info.IsDir():
+			return os.MkdirAll(target, info.Mode())
+		case info.Mode()&os.ModeSymlink != 0:
+			link, err := os.Readlink(path)
+			if err != nil {
+				return err
+			}
+			return os.Symlink(link, target)
+		default:
+			return copyFile(path, target, info.Mode())
+		}
+	})
+}
+
+func copyFile(src, dst string, mode os.FileMode) error {
+	in, err := os.Open(src)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = in.Close() }()
+	out, err := os.OpenFile(dst, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, mode)
+	if err != nil {
+		return err
+	}
+	_, err = io.Copy(out, in)
+	if err != nil {
+		_ = out.Close()
+		return err
+	}
+	return out.Close()
+}
@@ -23,6 +23,7 @@ import (
 	"github.com/argoproj/argo-cd/util"
 	"github.com/argoproj/argo-cd/util/cache"
 	"github.com/argoproj/argo-cd/util/git"
+	grpc_util "github.com/argoproj/argo-cd/util/grpc"
 	"github.com/argoproj/argo-cd/util/hash"
 	"github.com/argoproj/argo-cd/util/helm"
 	"github.com/argoproj/argo-cd/util/ksonnet"
@@ -129,21 +130,17 @@ func (s *Service) GetFile(ctx context.Context, q *GetFileRequest) (*GetFileRespo
 }
 
 func (s *Service) GenerateManifest(c context.Context, q *ManifestRequest) (*ManifestResponse, error) {
+	if q.ApplicationSource.IsHelmChart() {
+		return s.generateChartManifest(c, q)
+	}
 	gitClient, commitSHA, err := s.newClientResolveRevision(q.Repo, q.Revision)
 	if err != nil {
 		return nil, err
 	}
+	logCtx := grpc_util.LogEntry(c)
 	cacheKey := manifestCacheKey(commitSHA, q)
-	var res ManifestResponse
-	err = s.cache.Get(cacheKey, &res)
-	if err == nil {
-		log.Infof("manifest cache hit: %s", cacheKey)
-		return &res, nil
-	}
-	if err != cache.ErrCacheMiss {
-		log.Warnf("manifest cache error %s: %v", cacheKey, err)
-	} else {
-		log.Infof("manifest cache miss: %s", cacheKey)
+	if res := s.getCachedManifest(logCtx, cacheKey, q.NoCache); res != nil {
+		return res, nil
 	}
 
 	s.repoLock.Lock(gitClient.Root())
@@ -154,21 +151,94 @@ func (s *Service) GenerateManifest(c context.Context, q *ManifestRequest) (*Mani
 	}
 	appPath := filepath.Join(gitClient.Root(), q.ApplicationSource.Path)
 
-	genRes, err := generateManifests(appPath, q)
+	if IdentifyAppSourceTypeByAppDir(appPath) == v1alpha1.ApplicationSourceTypeKustomize {
+		err = s.resolveRemoteBases(appPath, q.Repos, 0)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	res, err := generateManifests(appPath, q)
 	if err != nil {
 		return nil, err
 	}
-	res = *genRes
 	res.Revision = commitSHA
-	err = s.cache.Set(&cache.Item{
-		Key:        manifestCacheKey(commitSHA, q),
-		Object:     res,
+	s.setCachedManifest(logCtx, manifestCacheKey(commitSHA, q), res)
+	return res, nil
+}
+
+// generateChartManifest generates the manifests of a chart of a helm repository. The version of the
+// chart is its revision: the released versions of charts are not changed, so their manifests are
+// cached like the manifests of commits.
+func (s *Service) generateChartManifest(c context.Context, q *ManifestRequest) (*ManifestResponse, error) {
+	chart := q.ApplicationSource.Chart
+	version := q.Revision
+	if version == "" {
+		version = q.ApplicationSource.TargetRevision
+	}
+	if version == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "the version of chart %s is required", chart)
+	}
+	logCtx := grpc_util.LogEntry(c)
+	cacheKey := manifestCacheKey(version, q)
+	if res := s.getCachedManifest(logCtx, cacheKey, q.NoCache); res != nil {
+		return res, nil
+	}
+
+	tmpDir, err := ioutil.TempDir("", "helm-chart")
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = os.RemoveAll(tmpDir)
+	}()
+	var username, password string
+	if q.Repo != nil {
+		username, password = q.Repo.Username, q.Repo.Password
+	}
+	appPath, err := helm.FetchChart(q.ApplicationSource.RepoURL, chart, version, username, password, tmpDir)
+	if err != nil {
+		return nil, err
+	}
+	res, err := generateManifests(appPath, q)
+	if err != nil {
+		return nil, err
+	}
+	res.Revision = version
+	s.setCachedManifest(logCtx, cacheKey, res)
+	return res, nil
+}
+
+// getCachedManifest returns the cached manifests of the key, or nil if they are not cached or the cache
+// is bypassed
+func (s *Service) getCachedManifest(logCtx *log.Entry, cacheKey string, noCache bool) *ManifestResponse {
+	if noCache {
+		logCtx.Infof("manifest cache bypassed: %s", cacheKey)
+		return nil
+	}
+	var res ManifestResponse
+	err := s.cache.Get(cacheKey, &res)
+	if err == nil {
+		logCtx.Infof("manifest cache hit: %s", cacheKey)
+		return &res
+	}
+	if err != cache.ErrCacheMiss {
+		logCtx.Warnf("manifest cache error %s: %v", cacheKey, err)
+	} else {
+		logCtx.Infof("manifest cache miss: %s", cacheKey)
+	}
+	return nil
+}
+
+func (s *Service) setCachedManifest(logCtx *log.Entry, cacheKey string, res *ManifestResponse) {
+	err := s.cache.Set(&cache.Item{
+		Key:        cacheKey,
+		Object:     *res,
 		Expiration: DefaultRepoCacheExpiration,
 	})
 	if err != nil {
-		log.Warnf("manifest cache set error %s: %v", cacheKey, err)
+		logCtx.Warnf("manifest cache set error %s: %v", cacheKey, err)
 	}
-	return &res, nil
 }
 
 // helper to formulate helm template options from a manifest request
@@ -184,6 +254,14 @@ func helmOpts(q *ManifestRequest) helm.HelmTemplateOpts {
 	return opts
 }
 
+// helmValuesSchemaFile returns the values schema file configured for the application, if any
+func helmValuesSchemaFile(q *ManifestRequest) string {
+	if q.ApplicationSource.Helm != nil {
+		return q.ApplicationSource.Helm.ValuesSchemaFile
+	}
+	return ""
+}
+
 func kustomizeOpts(q *ManifestRequest) kustomize.KustomizeBuildOpts {
 	opts := kustomize.KustomizeBuildOpts{
 		Namespace: q.Namespace,
@@ -213,6 +291,10 @@ func generateManifests(appPath string, q *ManifestRequest) (*ManifestResponse, e
 			return nil, err
 		}
 		opts := helmOpts(q)
+		err = helm.ValidateValues(appPath, helmValuesSchemaFile(q), opts.ValueFiles, q.ComponentParameterOverrides)
+		if err != nil {
+			return nil, err
+		}
 		targetObjs, err = h.Template(q.AppLabel, opts, q.ComponentParameterOverrides)
 		if err != nil {
 			return nil, err
@@ -232,6 +314,16 @@ func generateManifests(appPath string, q *ManifestRequest) (*ManifestResponse, e
 		return nil, err
 	}
 
+	// the API versions served by the destination cluster, if deprecated API versions are rewritten
+	var apiVersions map[string]bool
+	if len(q.ApiVersions) > 0 {
+		apiVersions = make(map[string]bool, len(q.ApiVersions))
+		for _, apiVersion := range q.ApiVersions {
+			apiVersions[apiVersion] = true
+		}
+	}
+	logCtx := log.WithField("application", q.AppLabel)
+
 	manifests := make([]string, 0)
 	for _, obj := range targetObjs {
 		var targets []*unstructured.Unstructured
@@ -253,6 +345,9 @@ func generateManifests(appPath string, q *ManifestRequest) (*ManifestResponse, e
 		}
 
 		for _, target := range targets {
+			if apiVersions != nil {
+				target = rewriteDeprecatedAPIVersion(target, apiVersions, logCtx)
+			}
 			if q.AppLabel != "" && !kube.IsCRD(target) {
 				err = kube.SetLabel(target, common.LabelApplicationName, q.AppLabel)
 				if err != nil {
@@ -331,11 +426,15 @@ func checkoutRevision(gitClient git.Client, commitSHA string) (string, error) {
 
 func manifestCacheKey(commitSHA string, q *ManifestRequest) string {
 	appSrc := q.ApplicationSource.DeepCopy()
-	appSrc.RepoURL = ""        // superceded by commitSHA
-	appSrc.TargetRevision = "" // superceded by commitSHA
+	if !appSrc.IsHelmChart() {
+		appSrc.RepoURL = "" // superceded by commitSHA
+	}
+	appSrc.TargetRevision = "" // superceded by commitSHA, or the version of the chart
 	appSrcStr, _ := json.Marshal(appSrc)
 	pStr, _ := json.Marshal(q.ComponentParameterOverrides)
-	fnva := hash.FNVa(string(appSrcStr) + string(pStr))
+	// manifests are rewritten according to the API versions of the destination cluster
+	apiVersionsStr, _ := json.Marshal(q.ApiVersions)
+	fnva := hash.FNVa(string(appSrcStr) + string(pStr) + string(apiVersionsStr))
 	return fmt.Sprintf("mfst|%s|%s|%s|%d", q.AppLabel, commitSHA, q.Namespace, fnva)
 }
 
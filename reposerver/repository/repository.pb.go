@@ -35,16 +35,23 @@ type ManifestRequest struct {
 	ComponentParameterOverrides []*v1alpha1.ComponentParameter `protobuf:"bytes,6,rep,name=componentParameterOverrides" json:"componentParameterOverrides,omitempty"`
 	Namespace                   string                         `protobuf:"bytes,8,opt,name=namespace,proto3" json:"namespace,omitempty"`
 	ApplicationSource           *v1alpha1.ApplicationSource    `protobuf:"bytes,10,opt,name=applicationSource" json:"applicationSource,omitempty"`
-	XXX_NoUnkeyedLiteral        struct{}                       `json:"-"`
-	XXX_unrecognized            []byte                         `json:"-"`
-	XXX_sizecache               int32                          `json:"-"`
+	// repos holds the registered repositories, used to fetch kustomize remote bases
+	Repos []*v1alpha1.Repository `protobuf:"bytes,11,rep,name=repos" json:"repos,omitempty"`
+	// apiVersions holds the API versions served by the destination cluster. If set, resources of
+	// deprecated API versions are rewritten to the versions served by the cluster
+	ApiVersions []string `protobuf:"bytes,12,rep,name=apiVersions" json:"apiVersions,omitempty"`
+	// noCache regenerates the manifests instead of returning the cached manifests
+	NoCache              bool     `protobuf:"varint,13,opt,name=noCache,proto3" json:"noCache,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *ManifestRequest) Reset()         { *m = ManifestRequest{} }
 func (m *ManifestRequest) String() string { return proto.CompactTextString(m) }
 func (*ManifestRequest) ProtoMessage()    {}
 func (*ManifestRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_repository_a8022d4991c5d6a5, []int{0}
+	return fileDescriptor_repository_109fb384ca3633bd, []int{0}
 }
 func (m *ManifestRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -115,6 +122,27 @@ func (m *ManifestRequest) GetApplicationSource() *v1alpha1.ApplicationSource {
 	return nil
 }
 
+func (m *ManifestRequest) GetRepos() []*v1alpha1.Repository {
+	if m != nil {
+		return m.Repos
+	}
+	return nil
+}
+
+func (m *ManifestRequest) GetApiVersions() []string {
+	if m != nil {
+		return m.ApiVersions
+	}
+	return nil
+}
+
+func (m *ManifestRequest) GetNoCache() bool {
+	if m != nil {
+		return m.NoCache
+	}
+	return false
+}
+
 type ManifestResponse struct {
 	Manifests            []string                       `protobuf:"bytes,1,rep,name=manifests" json:"manifests,omitempty"`
 	Namespace            string                         `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`
@@ -130,7 +158,7 @@ func (m *ManifestResponse) Reset()         { *m = ManifestResponse{} }
 func (m *ManifestResponse) String() string { return proto.CompactTextString(m) }
 func (*ManifestResponse) ProtoMessage()    {}
 func (*ManifestResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_repository_a8022d4991c5d6a5, []int{1}
+	return fileDescriptor_repository_109fb384ca3633bd, []int{1}
 }
 func (m *ManifestResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -208,7 +236,7 @@ func (m *ListDirRequest) Reset()         { *m = ListDirRequest{} }
 func (m *ListDirRequest) String() string { return proto.CompactTextString(m) }
 func (*ListDirRequest) ProtoMessage()    {}
 func (*ListDirRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_repository_a8022d4991c5d6a5, []int{2}
+	return fileDescriptor_repository_109fb384ca3633bd, []int{2}
 }
 func (m *ListDirRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -270,7 +298,7 @@ func (m *FileList) Reset()         { *m = FileList{} }
 func (m *FileList) String() string { return proto.CompactTextString(m) }
 func (*FileList) ProtoMessage()    {}
 func (*FileList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_repository_a8022d4991c5d6a5, []int{3}
+	return fileDescriptor_repository_109fb384ca3633bd, []int{3}
 }
 func (m *FileList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -320,7 +348,7 @@ func (m *GetFileRequest) Reset()         { *m = GetFileRequest{} }
 func (m *GetFileRequest) String() string { return proto.CompactTextString(m) }
 func (*GetFileRequest) ProtoMessage()    {}
 func (*GetFileRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_repository_a8022d4991c5d6a5, []int{4}
+	return fileDescriptor_repository_109fb384ca3633bd, []int{4}
 }
 func (m *GetFileRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -382,7 +410,7 @@ func (m *GetFileResponse) Reset()         { *m = GetFileResponse{} }
 func (m *GetFileResponse) String() string { return proto.CompactTextString(m) }
 func (*GetFileResponse) ProtoMessage()    {}
 func (*GetFileResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_repository_a8022d4991c5d6a5, []int{5}
+	return fileDescriptor_repository_109fb384ca3633bd, []int{5}
 }
 func (m *GetFileResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -418,6 +446,202 @@ func (m *GetFileResponse) GetData() []byte {
 	return nil
 }
 
+// KsonnetConversionRequest requests the conversion of a ksonnet application to plain manifests
+type KsonnetConversionRequest struct {
+	Repo     *v1alpha1.Repository `protobuf:"bytes,1,opt,name=repo" json:"repo,omitempty"`
+	Revision string               `protobuf:"bytes,2,opt,name=revision,proto3" json:"revision,omitempty"`
+	// path is the directory of the ksonnet application in the repository
+	Path string `protobuf:"bytes,3,opt,name=path,proto3" json:"path,omitempty"`
+	// format is the structure of the converted application: directory (default) or kustomize
+	Format string `protobuf:"bytes,4,opt,name=format,proto3" json:"format,omitempty"`
+	// environments are the converted environments. All environments are converted if empty
+	Environments         []string `protobuf:"bytes,5,rep,name=environments" json:"environments,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *KsonnetConversionRequest) Reset()         { *m = KsonnetConversionRequest{} }
+func (m *KsonnetConversionRequest) String() string { return proto.CompactTextString(m) }
+func (*KsonnetConversionRequest) ProtoMessage()    {}
+func (*KsonnetConversionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_repository_109fb384ca3633bd, []int{6}
+}
+func (m *KsonnetConversionRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *KsonnetConversionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_KsonnetConversionRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (dst *KsonnetConversionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_KsonnetConversionRequest.Merge(dst, src)
+}
+func (m *KsonnetConversionRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *KsonnetConversionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_KsonnetConversionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_KsonnetConversionRequest proto.InternalMessageInfo
+
+func (m *KsonnetConversionRequest) GetRepo() *v1alpha1.Repository {
+	if m != nil {
+		return m.Repo
+	}
+	return nil
+}
+
+func (m *KsonnetConversionRequest) GetRevision() string {
+	if m != nil {
+		return m.Revision
+	}
+	return ""
+}
+
+func (m *KsonnetConversionRequest) GetPath() string {
+	if m != nil {
+		return m.Path
+	}
+	return ""
+}
+
+func (m *KsonnetConversionRequest) GetFormat() string {
+	if m != nil {
+		return m.Format
+	}
+	return ""
+}
+
+func (m *KsonnetConversionRequest) GetEnvironments() []string {
+	if m != nil {
+		return m.Environments
+	}
+	return nil
+}
+
+// ConvertedFile is a file of a converted application
+type ConvertedFile struct {
+	// path is relative to the root of the converted application
+	Path                 string   `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	Data                 []byte   `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ConvertedFile) Reset()         { *m = ConvertedFile{} }
+func (m *ConvertedFile) String() string { return proto.CompactTextString(m) }
+func (*ConvertedFile) ProtoMessage()    {}
+func (*ConvertedFile) Descriptor() ([]byte, []int) {
+	return fileDescriptor_repository_109fb384ca3633bd, []int{7}
+}
+func (m *ConvertedFile) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ConvertedFile) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ConvertedFile.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (dst *ConvertedFile) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConvertedFile.Merge(dst, src)
+}
+func (m *ConvertedFile) XXX_Size() int {
+	return m.Size()
+}
+func (m *ConvertedFile) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConvertedFile.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ConvertedFile proto.InternalMessageInfo
+
+func (m *ConvertedFile) GetPath() string {
+	if m != nil {
+		return m.Path
+	}
+	return ""
+}
+
+func (m *ConvertedFile) GetData() []byte {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+// KsonnetConversionResponse returns the files of a converted ksonnet application
+type KsonnetConversionResponse struct {
+	Revision             string           `protobuf:"bytes,1,opt,name=revision,proto3" json:"revision,omitempty"`
+	Files                []*ConvertedFile `protobuf:"bytes,2,rep,name=files" json:"files,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *KsonnetConversionResponse) Reset()         { *m = KsonnetConversionResponse{} }
+func (m *KsonnetConversionResponse) String() string { return proto.CompactTextString(m) }
+func (*KsonnetConversionResponse) ProtoMessage()    {}
+func (*KsonnetConversionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_repository_109fb384ca3633bd, []int{8}
+}
+func (m *KsonnetConversionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *KsonnetConversionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_KsonnetConversionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (dst *KsonnetConversionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_KsonnetConversionResponse.Merge(dst, src)
+}
+func (m *KsonnetConversionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *KsonnetConversionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_KsonnetConversionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_KsonnetConversionResponse proto.InternalMessageInfo
+
+func (m *KsonnetConversionResponse) GetRevision() string {
+	if m != nil {
+		return m.Revision
+	}
+	return ""
+}
+
+func (m *KsonnetConversionResponse) GetFiles() []*ConvertedFile {
+	if m != nil {
+		return m.Files
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*ManifestRequest)(nil), "repository.ManifestRequest")
 	proto.RegisterType((*ManifestResponse)(nil), "repository.ManifestResponse")
@@ -425,6 +649,9 @@ func init() {
 	proto.RegisterType((*FileList)(nil), "repository.FileList")
 	proto.RegisterType((*GetFileRequest)(nil), "repository.GetFileRequest")
 	proto.RegisterType((*GetFileResponse)(nil), "repository.GetFileResponse")
+	proto.RegisterType((*KsonnetConversionRequest)(nil), "repository.KsonnetConversionRequest")
+	proto.RegisterType((*ConvertedFile)(nil), "repository.ConvertedFile")
+	proto.RegisterType((*KsonnetConversionResponse)(nil), "repository.KsonnetConversionResponse")
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -444,6 +671,9 @@ type RepositoryServiceClient interface {
 	ListDir(ctx context.Context, in *ListDirRequest, opts ...grpc.CallOption) (*FileList, error)
 	// GetFile returns the file contents at the specified repo and path
 	GetFile(ctx context.Context, in *GetFileRequest, opts ...grpc.CallOption) (*GetFileResponse, error)
+	// ConvertKsonnetApp renders the environments of a ksonnet application at the specified revision
+	// and returns an equivalent plain directory or kustomize structure
+	ConvertKsonnetApp(ctx context.Context, in *KsonnetConversionRequest, opts ...grpc.CallOption) (*KsonnetConversionResponse, error)
 }
 
 type repositoryServiceClient struct {
@@ -481,6 +711,15 @@ func (c *repositoryServiceClient) GetFile(ctx context.Context, in *GetFileReques
 	return out, nil
 }
 
+func (c *repositoryServiceClient) ConvertKsonnetApp(ctx context.Context, in *KsonnetConversionRequest, opts ...grpc.CallOption) (*KsonnetConversionResponse, error) {
+	out := new(KsonnetConversionResponse)
+	err := c.cc.Invoke(ctx, "/repository.RepositoryService/ConvertKsonnetApp", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // Server API for RepositoryService service
 
 type RepositoryServiceServer interface {
@@ -490,6 +729,9 @@ type RepositoryServiceServer interface {
 	ListDir(context.Context, *ListDirRequest) (*FileList, error)
 	// GetFile returns the file contents at the specified repo and path
 	GetFile(context.Context, *GetFileRequest) (*GetFileResponse, error)
+	// ConvertKsonnetApp renders the environments of a ksonnet application at the specified revision
+	// and returns an equivalent plain directory or kustomize structure
+	ConvertKsonnetApp(context.Context, *KsonnetConversionRequest) (*KsonnetConversionResponse, error)
 }
 
 func RegisterRepositoryServiceServer(s *grpc.Server, srv RepositoryServiceServer) {
@@ -550,6 +792,24 @@ func _RepositoryService_GetFile_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _RepositoryService_ConvertKsonnetApp_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(KsonnetConversionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RepositoryServiceServer).ConvertKsonnetApp(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/repository.RepositoryService/ConvertKsonnetApp",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RepositoryServiceServer).ConvertKsonnetApp(ctx, req.(*KsonnetConversionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _RepositoryService_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "repository.RepositoryService",
 	HandlerType: (*RepositoryServiceServer)(nil),
@@ -566,6 +826,10 @@ var _RepositoryService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetFile",
 			Handler:    _RepositoryService_GetFile_Handler,
 		},
+		{
+			MethodName: "ConvertKsonnetApp",
+			Handler:    _RepositoryService_ConvertKsonnetApp_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "reposerver/repository/repository.proto",
@@ -636,6 +900,43 @@ func (m *ManifestRequest) MarshalTo(dAtA []byte) (int, error) {
 		}
 		i += n2
 	}
+	if len(m.Repos) > 0 {
+		for _, msg := range m.Repos {
+			dAtA[i] = 0x5a
+			i++
+			i = encodeVarintRepository(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if len(m.ApiVersions) > 0 {
+		for _, s := range m.ApiVersions {
+			dAtA[i] = 0x62
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	if m.NoCache {
+		dAtA[i] = 0x68
+		i++
+		if m.NoCache {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
@@ -857,52 +1158,203 @@ func (m *GetFileResponse) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
-func encodeVarintRepository(dAtA []byte, offset int, v uint64) int {
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *KsonnetConversionRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return offset + 1
+	return dAtA[:n], nil
 }
-func (m *ManifestRequest) Size() (n int) {
+
+func (m *KsonnetConversionRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
 	if m.Repo != nil {
-		l = m.Repo.Size()
-		n += 1 + l + sovRepository(uint64(l))
-	}
-	l = len(m.Revision)
-	if l > 0 {
-		n += 1 + l + sovRepository(uint64(l))
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintRepository(dAtA, i, uint64(m.Repo.Size()))
+		n5, err := m.Repo.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n5
 	}
-	l = len(m.AppLabel)
-	if l > 0 {
-		n += 1 + l + sovRepository(uint64(l))
+	if len(m.Revision) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintRepository(dAtA, i, uint64(len(m.Revision)))
+		i += copy(dAtA[i:], m.Revision)
 	}
-	if len(m.ComponentParameterOverrides) > 0 {
-		for _, e := range m.ComponentParameterOverrides {
-			l = e.Size()
-			n += 1 + l + sovRepository(uint64(l))
-		}
+	if len(m.Path) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintRepository(dAtA, i, uint64(len(m.Path)))
+		i += copy(dAtA[i:], m.Path)
 	}
-	l = len(m.Namespace)
-	if l > 0 {
-		n += 1 + l + sovRepository(uint64(l))
+	if len(m.Format) > 0 {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintRepository(dAtA, i, uint64(len(m.Format)))
+		i += copy(dAtA[i:], m.Format)
 	}
-	if m.ApplicationSource != nil {
-		l = m.ApplicationSource.Size()
-		n += 1 + l + sovRepository(uint64(l))
+	if len(m.Environments) > 0 {
+		for _, s := range m.Environments {
+			dAtA[i] = 0x2a
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
 	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	return i, nil
 }
 
-func (m *ManifestResponse) Size() (n int) {
-	var l int
+func (m *ConvertedFile) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ConvertedFile) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Path) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintRepository(dAtA, i, uint64(len(m.Path)))
+		i += copy(dAtA[i:], m.Path)
+	}
+	if len(m.Data) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintRepository(dAtA, i, uint64(len(m.Data)))
+		i += copy(dAtA[i:], m.Data)
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *KsonnetConversionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *KsonnetConversionResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Revision) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintRepository(dAtA, i, uint64(len(m.Revision)))
+		i += copy(dAtA[i:], m.Revision)
+	}
+	if len(m.Files) > 0 {
+		for _, msg := range m.Files {
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintRepository(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func encodeVarintRepository(dAtA []byte, offset int, v uint64) int {
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return offset + 1
+}
+func (m *ManifestRequest) Size() (n int) {
+	var l int
+	_ = l
+	if m.Repo != nil {
+		l = m.Repo.Size()
+		n += 1 + l + sovRepository(uint64(l))
+	}
+	l = len(m.Revision)
+	if l > 0 {
+		n += 1 + l + sovRepository(uint64(l))
+	}
+	l = len(m.AppLabel)
+	if l > 0 {
+		n += 1 + l + sovRepository(uint64(l))
+	}
+	if len(m.ComponentParameterOverrides) > 0 {
+		for _, e := range m.ComponentParameterOverrides {
+			l = e.Size()
+			n += 1 + l + sovRepository(uint64(l))
+		}
+	}
+	l = len(m.Namespace)
+	if l > 0 {
+		n += 1 + l + sovRepository(uint64(l))
+	}
+	if m.ApplicationSource != nil {
+		l = m.ApplicationSource.Size()
+		n += 1 + l + sovRepository(uint64(l))
+	}
+	if len(m.Repos) > 0 {
+		for _, e := range m.Repos {
+			l = e.Size()
+			n += 1 + l + sovRepository(uint64(l))
+		}
+	}
+	if len(m.ApiVersions) > 0 {
+		for _, s := range m.ApiVersions {
+			l = len(s)
+			n += 1 + l + sovRepository(uint64(l))
+		}
+	}
+	if m.NoCache {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ManifestResponse) Size() (n int) {
+	var l int
 	_ = l
 	if len(m.Manifests) > 0 {
 		for _, s := range m.Manifests {
@@ -1004,6 +1456,73 @@ func (m *GetFileResponse) Size() (n int) {
 	return n
 }
 
+func (m *KsonnetConversionRequest) Size() (n int) {
+	var l int
+	_ = l
+	if m.Repo != nil {
+		l = m.Repo.Size()
+		n += 1 + l + sovRepository(uint64(l))
+	}
+	l = len(m.Revision)
+	if l > 0 {
+		n += 1 + l + sovRepository(uint64(l))
+	}
+	l = len(m.Path)
+	if l > 0 {
+		n += 1 + l + sovRepository(uint64(l))
+	}
+	l = len(m.Format)
+	if l > 0 {
+		n += 1 + l + sovRepository(uint64(l))
+	}
+	if len(m.Environments) > 0 {
+		for _, s := range m.Environments {
+			l = len(s)
+			n += 1 + l + sovRepository(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ConvertedFile) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Path)
+	if l > 0 {
+		n += 1 + l + sovRepository(uint64(l))
+	}
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovRepository(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *KsonnetConversionResponse) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Revision)
+	if l > 0 {
+		n += 1 + l + sovRepository(uint64(l))
+	}
+	if len(m.Files) > 0 {
+		for _, e := range m.Files {
+			l = e.Size()
+			n += 1 + l + sovRepository(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
 func sovRepository(x uint64) (n int) {
 	for {
 		n++
@@ -1230,6 +1749,86 @@ func (m *ManifestRequest) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Repos", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRepository
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Repos = append(m.Repos, &v1alpha1.Repository{})
+			if err := m.Repos[len(m.Repos)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApiVersions", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRepository
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ApiVersions = append(m.ApiVersions, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NoCache", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.NoCache = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRepository(dAtA[iNdEx:])
@@ -1896,6 +2495,428 @@ func (m *GetFileResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *KsonnetConversionRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRepository
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: KsonnetConversionRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: KsonnetConversionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Repo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRepository
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Repo == nil {
+				m.Repo = &v1alpha1.Repository{}
+			}
+			if err := m.Repo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Revision", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRepository
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Revision = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRepository
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Path = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Format", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRepository
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Format = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Environments", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRepository
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Environments = append(m.Environments, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRepository(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRepository
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ConvertedFile) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRepository
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ConvertedFile: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ConvertedFile: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRepository
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Path = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthRepository
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRepository(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRepository
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *KsonnetConversionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRepository
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: KsonnetConversionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: KsonnetConversionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Revision", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRepository
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Revision = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Files", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRepository
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Files = append(m.Files, &ConvertedFile{})
+			if err := m.Files[len(m.Files)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRepository(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRepository
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipRepository(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -2002,45 +3023,56 @@ var (
 )
 
 func init() {
-	proto.RegisterFile("reposerver/repository/repository.proto", fileDescriptor_repository_a8022d4991c5d6a5)
-}
-
-var fileDescriptor_repository_a8022d4991c5d6a5 = []byte{
-	// 576 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x55, 0xcf, 0x6e, 0xd3, 0x4e,
-	0x10, 0xae, 0x9b, 0x34, 0x4d, 0xa7, 0x3f, 0xfd, 0xda, 0xae, 0x22, 0x64, 0x39, 0x51, 0x14, 0x59,
-	0x02, 0xe5, 0x82, 0xad, 0x84, 0x0b, 0x17, 0x84, 0x80, 0x42, 0x85, 0x94, 0xaa, 0xc8, 0x3d, 0xc1,
-	0x05, 0x6d, 0x9c, 0xc1, 0x59, 0x12, 0x7b, 0x97, 0xdd, 0x8d, 0x25, 0x78, 0x09, 0x1e, 0x80, 0x17,
-	0xe2, 0xd8, 0x47, 0x40, 0xb9, 0xf5, 0x2d, 0x90, 0x37, 0x76, 0xe2, 0xfc, 0x51, 0x2f, 0x15, 0x82,
-	0xdb, 0xec, 0x7c, 0xe3, 0xf9, 0xbe, 0xf9, 0xa3, 0x31, 0x3c, 0x92, 0x28, 0xb8, 0x42, 0x99, 0xa2,
-	0xf4, 0x8d, 0xc9, 0x34, 0x97, 0x5f, 0x4b, 0xa6, 0x27, 0x24, 0xd7, 0x9c, 0xc0, 0xca, 0xe3, 0x34,
-	0x22, 0x1e, 0x71, 0xe3, 0xf6, 0x33, 0x6b, 0x11, 0xe1, 0xb4, 0x22, 0xce, 0xa3, 0x29, 0xfa, 0x54,
-	0x30, 0x9f, 0x26, 0x09, 0xd7, 0x54, 0x33, 0x9e, 0xa8, 0x1c, 0x75, 0x27, 0x4f, 0x95, 0xc7, 0xb8,
-	0x41, 0x43, 0x2e, 0xd1, 0x4f, 0x7b, 0x7e, 0x84, 0x09, 0x4a, 0xaa, 0x71, 0x94, 0xc7, 0xbc, 0x8d,
-	0x98, 0x1e, 0xcf, 0x86, 0x5e, 0xc8, 0x63, 0x9f, 0x4a, 0x43, 0xf1, 0xd9, 0x18, 0x8f, 0xc3, 0x91,
-	0x2f, 0x26, 0x51, 0xf6, 0xb1, 0xf2, 0xa9, 0x10, 0x53, 0x16, 0x9a, 0xe4, 0x7e, 0xda, 0xa3, 0x53,
-	0x31, 0xa6, 0x5b, 0xa9, 0xdc, 0x9b, 0x0a, 0x9c, 0x5c, 0xd2, 0x84, 0x7d, 0x42, 0xa5, 0x03, 0xfc,
-	0x32, 0x43, 0xa5, 0xc9, 0x7b, 0xa8, 0x66, 0x45, 0xd8, 0x56, 0xc7, 0xea, 0x1e, 0xf7, 0x5f, 0x7b,
-	0x2b, 0x36, 0xaf, 0x60, 0x33, 0xc6, 0xc7, 0x70, 0xe4, 0x89, 0x49, 0xe4, 0x65, 0x6c, 0x5e, 0x89,
-	0xcd, 0x2b, 0xd8, 0xbc, 0x60, 0xd9, 0x8b, 0xc0, 0xa4, 0x24, 0x0e, 0xd4, 0x25, 0xa6, 0x4c, 0x31,
-	0x9e, 0xd8, 0xfb, 0x1d, 0xab, 0x7b, 0x14, 0x2c, 0xdf, 0x19, 0x46, 0x85, 0x18, 0xd0, 0x21, 0x4e,
-	0xed, 0x83, 0x05, 0x56, 0xbc, 0xc9, 0x77, 0x0b, 0x9a, 0x21, 0x8f, 0x05, 0x4f, 0x30, 0xd1, 0xef,
-	0xa8, 0xa4, 0x31, 0x6a, 0x94, 0x57, 0x29, 0x4a, 0xc9, 0x46, 0xa8, 0xec, 0x5a, 0xa7, 0xd2, 0x3d,
-	0xee, 0x5f, 0xde, 0x43, 0xea, 0xab, 0xad, 0xec, 0xc1, 0x5d, 0x8c, 0xa4, 0x05, 0x47, 0x09, 0x8d,
-	0x51, 0x09, 0x1a, 0xa2, 0x5d, 0x37, 0x72, 0x57, 0x0e, 0xf2, 0x0d, 0xce, 0x4a, 0x2c, 0xd7, 0x7c,
-	0x26, 0x43, 0xb4, 0xc1, 0xf4, 0x73, 0x70, 0x0f, 0x91, 0x2f, 0x36, 0x73, 0x06, 0xdb, 0x34, 0xee,
-	0xad, 0x05, 0xa7, 0xab, 0x91, 0x2a, 0xc1, 0x13, 0x85, 0x99, 0xdc, 0x38, 0xf7, 0x29, 0xdb, 0xea,
-	0x54, 0x32, 0xb9, 0x4b, 0xc7, 0x7a, 0x31, 0xfb, 0x9b, 0xc5, 0x3c, 0x80, 0xda, 0x62, 0xf1, 0xed,
-	0x8a, 0x81, 0xf2, 0xd7, 0xda, 0x30, 0xab, 0x1b, 0xc3, 0x44, 0xa8, 0x89, 0xac, 0x69, 0xca, 0x3e,
-	0xf8, 0x13, 0xa3, 0xc9, 0x93, 0xbb, 0x3f, 0x2c, 0xf8, 0x7f, 0xc0, 0x94, 0x3e, 0x67, 0xf2, 0x2f,
-	0x6f, 0x2f, 0x81, 0xaa, 0xa0, 0x7a, 0x9c, 0xb7, 0xc8, 0xd8, 0x6e, 0x07, 0xea, 0x6f, 0xd8, 0x14,
-	0x33, 0x81, 0xa4, 0x01, 0x07, 0x4c, 0x63, 0x5c, 0x34, 0x7f, 0xf1, 0x30, 0xfa, 0x2f, 0x50, 0x67,
-	0x51, 0xff, 0xa0, 0xfe, 0x87, 0x70, 0xb2, 0x14, 0x97, 0xef, 0x11, 0x81, 0xea, 0x88, 0x6a, 0x6a,
-	0xd4, 0xfd, 0x17, 0x18, 0xbb, 0x7f, 0x6b, 0xc1, 0xd9, 0x8a, 0xeb, 0x1a, 0x65, 0xca, 0x42, 0x24,
-	0x57, 0x70, 0x7a, 0x91, 0x1f, 0x9b, 0x62, 0x1b, 0x49, 0xd3, 0x2b, 0xdd, 0xcb, 0x8d, 0xb3, 0xe3,
-	0xb4, 0x76, 0x83, 0x0b, 0x62, 0x77, 0x8f, 0x3c, 0x83, 0xc3, 0x7c, 0xd4, 0xc4, 0x29, 0x87, 0xae,
-	0xcf, 0xdf, 0x69, 0x94, 0xb1, 0xa2, 0xfd, 0xee, 0x1e, 0x39, 0x87, 0xc3, 0xbc, 0x98, 0xf5, 0xcf,
-	0xd7, 0xdb, 0xef, 0x34, 0x77, 0x62, 0x85, 0x88, 0x97, 0xcf, 0x7f, 0xce, 0xdb, 0xd6, 0xcd, 0xbc,
-	0x6d, 0xfd, 0x9a, 0xb7, 0xad, 0x0f, 0xbd, 0xbb, 0x0e, 0xf1, 0xce, 0x1f, 0xc6, 0xb0, 0x66, 0xee,
-	0xee, 0x93, 0xdf, 0x01, 0x00, 0x00, 0xff, 0xff, 0xab, 0x6b, 0xe9, 0x55, 0x50, 0x06, 0x00, 0x00,
+	proto.RegisterFile("reposerver/repository/repository.proto", fileDescriptor_repository_109fb384ca3633bd)
+}
+
+var fileDescriptor_repository_109fb384ca3633bd = []byte{
+	// 743 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x56, 0xdd, 0x4e, 0xdb, 0x48,
+	0x14, 0xc6, 0xf9, 0x23, 0x99, 0xc0, 0x02, 0x23, 0xb4, 0x32, 0x06, 0x21, 0xcb, 0x5a, 0x56, 0xb9,
+	0x59, 0x5b, 0xb0, 0x17, 0xbb, 0x37, 0xab, 0x15, 0x0b, 0x5b, 0x54, 0x15, 0x44, 0x65, 0xa4, 0x4a,
+	0x6d, 0x2f, 0xaa, 0x89, 0x73, 0x70, 0xa6, 0xc4, 0x33, 0xd3, 0x99, 0xc1, 0x52, 0xfb, 0x12, 0x7d,
+	0x80, 0xbe, 0x50, 0x6f, 0x2a, 0xf5, 0x11, 0x5a, 0xee, 0x78, 0x8b, 0xca, 0x63, 0x3b, 0x71, 0x42,
+	0x4a, 0x2f, 0x68, 0x55, 0xee, 0xce, 0x9f, 0xcf, 0x77, 0xe6, 0x9b, 0xef, 0x64, 0x82, 0x7e, 0x97,
+	0x20, 0xb8, 0x02, 0x99, 0x82, 0x0c, 0x8c, 0x49, 0x35, 0x97, 0xaf, 0x2b, 0xa6, 0x2f, 0x24, 0xd7,
+	0x1c, 0xa3, 0x49, 0xc4, 0x59, 0x8f, 0x79, 0xcc, 0x4d, 0x38, 0xc8, 0xac, 0xbc, 0xc2, 0xd9, 0x8a,
+	0x39, 0x8f, 0x47, 0x10, 0x10, 0x41, 0x03, 0xc2, 0x18, 0xd7, 0x44, 0x53, 0xce, 0x54, 0x91, 0xf5,
+	0x2e, 0xfe, 0x56, 0x3e, 0xe5, 0x26, 0x1b, 0x71, 0x09, 0x41, 0xba, 0x1b, 0xc4, 0xc0, 0x40, 0x12,
+	0x0d, 0x83, 0xa2, 0xe6, 0x61, 0x4c, 0xf5, 0xf0, 0xb2, 0xef, 0x47, 0x3c, 0x09, 0x88, 0x34, 0x10,
+	0x2f, 0x8d, 0xf1, 0x47, 0x34, 0x08, 0xc4, 0x45, 0x9c, 0x7d, 0xac, 0x02, 0x22, 0xc4, 0x88, 0x46,
+	0xa6, 0x79, 0x90, 0xee, 0x92, 0x91, 0x18, 0x92, 0x1b, 0xad, 0xbc, 0xeb, 0x06, 0x5a, 0x39, 0x21,
+	0x8c, 0x9e, 0x83, 0xd2, 0x21, 0xbc, 0xba, 0x04, 0xa5, 0xf1, 0x53, 0xd4, 0xc8, 0x0e, 0x61, 0x5b,
+	0xae, 0xd5, 0xeb, 0xee, 0xfd, 0xef, 0x4f, 0xd0, 0xfc, 0x12, 0xcd, 0x18, 0x2f, 0xa2, 0x81, 0x2f,
+	0x2e, 0x62, 0x3f, 0x43, 0xf3, 0x2b, 0x68, 0x7e, 0x89, 0xe6, 0x87, 0x63, 0x2e, 0x42, 0xd3, 0x12,
+	0x3b, 0xa8, 0x2d, 0x21, 0xa5, 0x8a, 0x72, 0x66, 0xd7, 0x5c, 0xab, 0xd7, 0x09, 0xc7, 0x7e, 0x96,
+	0x23, 0x42, 0x1c, 0x93, 0x3e, 0x8c, 0xec, 0x66, 0x9e, 0x2b, 0x7d, 0xfc, 0xd6, 0x42, 0x9b, 0x11,
+	0x4f, 0x04, 0x67, 0xc0, 0xf4, 0x63, 0x22, 0x49, 0x02, 0x1a, 0xe4, 0x69, 0x0a, 0x52, 0xd2, 0x01,
+	0x28, 0xbb, 0xe5, 0xd6, 0x7b, 0xdd, 0xbd, 0x93, 0x3b, 0x8c, 0x7a, 0x70, 0xa3, 0x7b, 0x78, 0x1b,
+	0x22, 0xde, 0x42, 0x1d, 0x46, 0x12, 0x50, 0x82, 0x44, 0x60, 0xb7, 0xcd, 0xb8, 0x93, 0x00, 0x7e,
+	0x83, 0xd6, 0x2a, 0x28, 0x67, 0xfc, 0x52, 0x46, 0x60, 0x23, 0xc3, 0xe7, 0xf1, 0x1d, 0x86, 0xdc,
+	0x9f, 0xed, 0x19, 0xde, 0x84, 0xc1, 0xcf, 0x51, 0xd3, 0x68, 0xd0, 0xee, 0xba, 0xf5, 0xef, 0x77,
+	0x7f, 0x79, 0x4f, 0xec, 0xa2, 0x2e, 0x11, 0xf4, 0x09, 0xc8, 0xec, 0xca, 0x94, 0xbd, 0xe4, 0xd6,
+	0x7b, 0x9d, 0xb0, 0x1a, 0xc2, 0x36, 0x5a, 0x64, 0xfc, 0x80, 0x44, 0x43, 0xb0, 0x97, 0x5d, 0xab,
+	0xd7, 0x0e, 0x4b, 0xd7, 0xbb, 0xb6, 0xd0, 0xea, 0x44, 0x6b, 0x4a, 0x70, 0xa6, 0x20, 0xe3, 0x31,
+	0x29, 0x62, 0xca, 0xb6, 0x4c, 0xbb, 0x49, 0x60, 0x9a, 0xe5, 0xda, 0x2c, 0xcb, 0xbf, 0xa2, 0x56,
+	0xbe, 0x91, 0x76, 0xdd, 0xa4, 0x0a, 0x6f, 0x4a, 0x65, 0x8d, 0x19, 0x95, 0x01, 0x6a, 0x89, 0xec,
+	0x36, 0x95, 0xdd, 0xfc, 0x11, 0x9a, 0x29, 0x9a, 0x7b, 0xef, 0x2c, 0xf4, 0xcb, 0x31, 0x55, 0xfa,
+	0x90, 0xca, 0x9f, 0xbc, 0x56, 0x18, 0x35, 0x04, 0xd1, 0xc3, 0x82, 0x22, 0x63, 0x7b, 0x2e, 0x6a,
+	0x3f, 0xa0, 0x23, 0xc8, 0x06, 0xc4, 0xeb, 0xa8, 0x49, 0x35, 0x24, 0x25, 0xf9, 0xb9, 0x63, 0xe6,
+	0x3f, 0x02, 0x9d, 0x55, 0xdd, 0xc3, 0xf9, 0x77, 0xd0, 0xca, 0x78, 0xb8, 0x42, 0x47, 0x18, 0x35,
+	0x06, 0x44, 0x13, 0x33, 0xdd, 0x52, 0x68, 0x6c, 0xef, 0xb3, 0x85, 0xec, 0x47, 0x8a, 0x33, 0x06,
+	0xfa, 0x80, 0xb3, 0x34, 0x57, 0xe8, 0xfd, 0x3b, 0x4e, 0xa6, 0xe3, 0x73, 0x2e, 0x13, 0xa2, 0x0b,
+	0xb5, 0x16, 0x1e, 0xf6, 0xd0, 0x12, 0xb0, 0x94, 0x4a, 0xce, 0x12, 0x60, 0x3a, 0x57, 0x6c, 0x27,
+	0x9c, 0x8a, 0x79, 0x7f, 0xa1, 0xe5, 0xfc, 0x6c, 0x1a, 0x06, 0x19, 0x21, 0x63, 0x00, 0xab, 0x02,
+	0x50, 0x92, 0x53, 0xab, 0x90, 0x33, 0x44, 0x1b, 0x73, 0xb8, 0x29, 0xd8, 0xac, 0x9e, 0xc0, 0x9a,
+	0x39, 0x41, 0x80, 0x9a, 0xe7, 0x74, 0x04, 0xca, 0xae, 0x99, 0x05, 0xda, 0xf0, 0x2b, 0x6f, 0xe0,
+	0xd4, 0x28, 0x61, 0x5e, 0xb7, 0xf7, 0xa1, 0x86, 0xd6, 0x26, 0x1c, 0x9d, 0x81, 0x4c, 0x69, 0x04,
+	0xf8, 0x14, 0xad, 0x1e, 0x15, 0x8f, 0x51, 0xf9, 0xa3, 0x80, 0x37, 0xab, 0xbd, 0x66, 0x9e, 0x25,
+	0x67, 0x6b, 0x7e, 0x32, 0x9f, 0xd8, 0x5b, 0xc0, 0xff, 0xa0, 0xc5, 0x62, 0xe3, 0xb0, 0x53, 0x2d,
+	0x9d, 0x5e, 0x43, 0x67, 0xbd, 0x9a, 0x2b, 0xb7, 0xc0, 0x5b, 0xc0, 0x87, 0x68, 0xb1, 0xd0, 0xd4,
+	0xf4, 0xe7, 0xd3, 0x5b, 0xe0, 0x6c, 0xce, 0xcd, 0x8d, 0x87, 0xe8, 0xa3, 0xb5, 0x82, 0x83, 0x82,
+	0xdc, 0x7d, 0x21, 0xf0, 0x6f, 0xd5, 0x6f, 0xbe, 0x26, 0x48, 0x67, 0xe7, 0x1b, 0x55, 0x25, 0xc6,
+	0x7f, 0xff, 0xbe, 0xbf, 0xda, 0xb6, 0x3e, 0x5e, 0x6d, 0x5b, 0x9f, 0xae, 0xb6, 0xad, 0x67, 0xbb,
+	0xb7, 0xfd, 0x19, 0x98, 0xfb, 0xa7, 0xa5, 0xdf, 0x32, 0x6f, 0xff, 0x9f, 0x5f, 0x06, 0x00, 0x29,
+	0xc6, 0xee, 0x64, 0xd4, 0x08, 0x00, 0x00,
 }
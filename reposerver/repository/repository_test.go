@@ -10,7 +10,7 @@ import (
 
 func TestGenerateYamlManifestInDir(t *testing.T) {
 	// update this value if we add/remove manifests
-	const countOfManifests = 21
+	const countOfManifests = 22
 
 	q := ManifestRequest{
 		ApplicationSource: &argoappv1.ApplicationSource{},
@@ -33,3 +33,16 @@ func TestGenerateJsonnetManifestInDir(t *testing.T) {
 	assert.Nil(t, err)
 	assert.Equal(t, len(res1.Manifests), 2)
 }
+
+func TestManifestCacheKeyOfChart(t *testing.T) {
+	newRequest := func(repoURL string) *ManifestRequest {
+		return &ManifestRequest{
+			AppLabel:          "my-app",
+			ApplicationSource: &argoappv1.ApplicationSource{RepoURL: repoURL, Chart: "redis", TargetRevision: "1.0.0"},
+		}
+	}
+	// charts of the same name in different helm repositories are different charts
+	assert.NotEqual(t, manifestCacheKey("1.0.0", newRequest("https://charts.example.com")), manifestCacheKey("1.0.0", newRequest("https://other.example.com")))
+	assert.NotEqual(t, manifestCacheKey("1.0.0", newRequest("https://charts.example.com")), manifestCacheKey("1.0.1", newRequest("https://charts.example.com")))
+	assert.Equal(t, manifestCacheKey("1.0.0", newRequest("https://charts.example.com")), manifestCacheKey("1.0.0", newRequest("https://charts.example.com")))
+}
@@ -0,0 +1,128 @@
+package reposerver
+
+import (
+	"fmt"
+	"net"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/grpc/resolver"
+	apiv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/client-go/informers/core/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/cache"
+)
+
+// endpointsResyncPeriod is how often the endpoints of the repo server service are listed again
+const endpointsResyncPeriod = 3 * time.Minute
+
+// endpointsResolvers counts the endpoints resolver builders. gRPC resolvers are registered globally by
+// scheme, so every builder gets a scheme of its own.
+var endpointsResolvers int32
+
+// endpointsResolverBuilder builds resolvers of the addresses of the ready endpoints of services. The
+// targets are the name and port of a service, optionally qualified by its namespace:
+// <name>[.<namespace>]:<port>.
+type endpointsResolverBuilder struct {
+	scheme        string
+	kubeClientset kubernetes.Interface
+	namespace     string
+}
+
+// newEndpointsResolverBuilder returns a new endpoints resolver builder, registered with a scheme of its own
+func newEndpointsResolverBuilder(kubeClientset kubernetes.Interface, namespace string) *endpointsResolverBuilder {
+	builder := &endpointsResolverBuilder{
+		scheme:        fmt.Sprintf("%s-%d", ResolverEndpoints, atomic.AddInt32(&endpointsResolvers, 1)),
+		kubeClientset: kubeClientset,
+		namespace:     namespace,
+	}
+	resolver.Register(builder)
+	return builder
+}
+
+func (b *endpointsResolverBuilder) Scheme() string {
+	return b.scheme
+}
+
+// Build starts to watch the endpoints of the service of the target, and updates the addresses of the
+// connection whenever the ready endpoints change
+func (b *endpointsResolverBuilder) Build(target resolver.Target, cc resolver.ClientConn, opts resolver.BuildOption) (resolver.Resolver, error) {
+	host, portStr, err := net.SplitHostPort(target.Endpoint)
+	if err != nil {
+		return nil, fmt.Errorf("invalid repo server address '%s': %v", target.Endpoint, err)
+	}
+	port, err := strconv.Atoi(portStr)
+	if err != nil {
+		return nil, fmt.Errorf("invalid port of repo server address '%s': %v", target.Endpoint, err)
+	}
+	name, namespace := host, b.namespace
+	if parts := strings.Split(host, "."); len(parts) > 1 {
+		name, namespace = parts[0], parts[1]
+	}
+	r := &endpointsResolver{cc: cc, stopCh: make(chan struct{})}
+	tweakEndpoints := func(options *metav1.ListOptions) {
+		options.FieldSelector = fields.OneTermEqualSelector("metadata.name", name).String()
+	}
+	informer := v1.NewFilteredEndpointsInformer(b.kubeClientset, namespace, endpointsResyncPeriod, cache.Indexers{}, tweakEndpoints)
+	update := func(obj interface{}) {
+		if endpoints, ok := obj.(*apiv1.Endpoints); ok {
+			addresses := endpointsAddresses(endpoints, int32(port))
+			log.Debugf("Endpoints of repo server %s/%s updated: %v", namespace, name, addresses)
+			cc.NewAddress(addresses)
+		}
+	}
+	informer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc: update,
+		UpdateFunc: func(old, new interface{}) {
+			update(new)
+		},
+		DeleteFunc: func(obj interface{}) {
+			log.Warnf("Service of repo server %s/%s was deleted", namespace, name)
+			cc.NewAddress(nil)
+		},
+	})
+	go informer.Run(r.stopCh)
+	return r, nil
+}
+
+// endpointsResolver resolves the addresses of the ready endpoints of a service
+type endpointsResolver struct {
+	cc     resolver.ClientConn
+	stopCh chan struct{}
+}
+
+// ResolveNow does nothing, since the endpoints are watched
+func (r *endpointsResolver) ResolveNow(opts resolver.ResolveNowOption) {
+}
+
+// Close stops watching the endpoints
+func (r *endpointsResolver) Close() {
+	close(r.stopCh)
+}
+
+// endpointsAddresses returns the addresses of the ready endpoints of a service. The port of the
+// endpoints is the port of the service, or the only port of the endpoints if the service targets
+// another port.
+func endpointsAddresses(endpoints *apiv1.Endpoints, port int32) []resolver.Address {
+	var addresses []resolver.Address
+	for _, subset := range endpoints.Subsets {
+		subsetPort := int32(0)
+		for _, endpointPort := range subset.Ports {
+			if endpointPort.Port == port || len(subset.Ports) == 1 {
+				subsetPort = endpointPort.Port
+			}
+		}
+		if subsetPort == 0 {
+			continue
+		}
+		for _, address := range subset.Addresses {
+			addresses = append(addresses, resolver.Address{Addr: net.JoinHostPort(address.IP, strconv.Itoa(int(subsetPort)))})
+		}
+	}
+	return addresses
+}
@@ -0,0 +1,45 @@
+package reposerver
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/resolver"
+	apiv1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func TestEndpointsAddresses(t *testing.T) {
+	endpoints := &apiv1.Endpoints{Subsets: []apiv1.EndpointSubset{{
+		Addresses:         []apiv1.EndpointAddress{{IP: "10.0.0.1"}, {IP: "10.0.0.2"}},
+		NotReadyAddresses: []apiv1.EndpointAddress{{IP: "10.0.0.3"}},
+		Ports:             []apiv1.EndpointPort{{Name: "server", Port: 8081}, {Name: "metrics", Port: 8084}},
+	}, {
+		// the service targets another port
+		Addresses: []apiv1.EndpointAddress{{IP: "10.0.0.4"}},
+		Ports:     []apiv1.EndpointPort{{Port: 9081}},
+	}, {
+		Addresses: []apiv1.EndpointAddress{{IP: "10.0.0.5"}},
+		Ports:     []apiv1.EndpointPort{{Name: "other", Port: 9081}, {Name: "metrics", Port: 8084}},
+	}}}
+	assert.Equal(t, []resolver.Address{{Addr: "10.0.0.1:8081"}, {Addr: "10.0.0.2:8081"}, {Addr: "10.0.0.4:9081"}}, endpointsAddresses(endpoints, 8081))
+	assert.Empty(t, endpointsAddresses(&apiv1.Endpoints{}, 8081))
+}
+
+func TestNewRepositoryServerClientsetWithResolver(t *testing.T) {
+	clientset, err := NewRepositoryServerClientsetWithResolver("argocd-repo-server:8081", ResolverDNS, nil, "argocd")
+	assert.NoError(t, err)
+	assert.Equal(t, "dns:///argocd-repo-server:8081", clientset.(*clientSet).target)
+
+	clientset, err = NewRepositoryServerClientsetWithResolver("argocd-repo-server:8081", ResolverEndpoints, fake.NewSimpleClientset(), "argocd")
+	assert.NoError(t, err)
+	assert.Regexp(t, "^endpoints-[0-9]+:///argocd-repo-server:8081$", clientset.(*clientSet).target)
+
+	_, err = NewRepositoryServerClientsetWithResolver("argocd-repo-server:8081", "consul", nil, "argocd")
+	assert.EqualError(t, err, "unknown repo server resolver 'consul': expected dns or endpoints")
+}
+
+func TestRetryBackoff(t *testing.T) {
+	assert.InDelta(t, float64(retryBackoffDuration), float64(retryBackoff(1)), float64(retryBackoffDuration)/10)
+	assert.InDelta(t, float64(retryBackoffMaxDuration), float64(retryBackoff(100)), float64(retryBackoffMaxDuration)/10)
+}
@@ -14,6 +14,7 @@ import (
 	log "github.com/sirupsen/logrus"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/keepalive"
 	"google.golang.org/grpc/reflection"
 )
 
@@ -45,10 +46,17 @@ func NewServer(gitFactory git.ClientFactory, cache cache.Cache, tlsConfCustomize
 	tlsConfig := &tls.Config{Certificates: []tls.Certificate{*cert}}
 	tlsConfCustomizer(tlsConfig)
 
-	opts := []grpc.ServerOption{grpc.Creds(credentials.NewTLS(tlsConfig))}
+	opts := []grpc.ServerOption{
+		grpc.Creds(credentials.NewTLS(tlsConfig)),
+		// permit the keepalive pings of the clients, which detect repo servers which went away
+		grpc.KeepaliveEnforcementPolicy(keepalive.EnforcementPolicy{
+			MinTime:             keepaliveTime / 2,
+			PermitWithoutStream: true,
+		}),
+	}
 
 	return &ArgoCDRepoServer{
-		log:        log.NewEntry(log.New()),
+		log:        log.NewEntry(log.StandardLogger()),
 		gitFactory: gitFactory,
 		cache:      cache,
 		opts:       opts,
@@ -61,10 +69,12 @@ func (a *ArgoCDRepoServer) CreateGRPC() *grpc.Server {
 		append(a.opts,
 			grpc.StreamInterceptor(grpc_middleware.ChainStreamServer(
 				grpc_logrus.StreamServerInterceptor(a.log),
+				grpc_util.CorrelationIDStreamServerInterceptor(false),
 				grpc_util.PanicLoggerStreamServerInterceptor(a.log),
 			)),
 			grpc.UnaryInterceptor(grpc_middleware.ChainUnaryServer(
 				grpc_logrus.UnaryServerInterceptor(a.log),
+				grpc_util.CorrelationIDUnaryServerInterceptor(false),
 				grpc_util.PanicLoggerUnaryServerInterceptor(a.log),
 			)))...,
 	)
@@ -9,8 +9,11 @@ import (
 	"google.golang.org/grpc/status"
 
 	"github.com/argoproj/argo-cd/common"
+	"github.com/argoproj/argo-cd/server/rbacpolicy"
+	"github.com/argoproj/argo-cd/util/grpc"
 	jwtutil "github.com/argoproj/argo-cd/util/jwt"
 	"github.com/argoproj/argo-cd/util/password"
+	"github.com/argoproj/argo-cd/util/rbac"
 	"github.com/argoproj/argo-cd/util/session"
 	"github.com/argoproj/argo-cd/util/settings"
 )
@@ -19,13 +22,17 @@ import (
 type Server struct {
 	sessionMgr  *session.SessionManager
 	settingsMgr *settings.SettingsManager
+	enf         *rbac.Enforcer
+	policyEnf   *rbacpolicy.RBACPolicyEnforcer
 }
 
 // NewServer returns a new instance of the Session service
-func NewServer(sessionMgr *session.SessionManager, settingsMgr *settings.SettingsManager) *Server {
+func NewServer(sessionMgr *session.SessionManager, settingsMgr *settings.SettingsManager, enf *rbac.Enforcer, policyEnf *rbacpolicy.RBACPolicyEnforcer) *Server {
 	return &Server{
 		sessionMgr:  sessionMgr,
 		settingsMgr: settingsMgr,
+		enf:         enf,
+		policyEnf:   policyEnf,
 	}
 
 }
@@ -64,6 +71,33 @@ func (s *Server) UpdatePassword(ctx context.Context, q *UpdatePasswordRequest) (
 
 }
 
+// EvaluatePolicy evaluates the RBAC policy for the requests of a hypothetical subject, e.g. to debug
+// why a user is denied an action. Only permitted to the users which may impersonate the subject.
+func (s *Server) EvaluatePolicy(ctx context.Context, q *EvaluatePolicyRequest) (*EvaluatePolicyResponse, error) {
+	if q.Subject == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "subject is required")
+	}
+	// the policy is evaluated as if the subject were a member of the groups, so the groups need to be
+	// impersonated as well
+	for _, subject := range append([]string{q.Subject}, q.Groups...) {
+		if !s.enf.Enforce(ctx.Value("claims"), rbacpolicy.ResourceAccounts, rbacpolicy.ActionImpersonate, subject) {
+			return nil, grpc.ErrPermissionDenied
+		}
+	}
+	results := make([]*PolicyResult, len(q.Requests))
+	for i, req := range q.Requests {
+		allowed, policies := s.policyEnf.EvaluateSubject(q.Subject, q.Groups, req.Resource, req.Action, req.Object)
+		results[i] = &PolicyResult{
+			Resource: req.Resource,
+			Action:   req.Action,
+			Object:   req.Object,
+			Allowed:  allowed,
+			Policies: policies,
+		}
+	}
+	return &EvaluatePolicyResponse{Results: results}, nil
+}
+
 // getAuthenticatedUser returns the currently authenticated user (via JWT 'sub' field)
 func getAuthenticatedUser(ctx context.Context) string {
 	claimsIf := ctx.Value("claims")
@@ -37,7 +37,7 @@ func (m *UpdatePasswordRequest) Reset()         { *m = UpdatePasswordRequest{} }
 func (m *UpdatePasswordRequest) String() string { return proto.CompactTextString(m) }
 func (*UpdatePasswordRequest) ProtoMessage()    {}
 func (*UpdatePasswordRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_account_c12a236fbb4926f3, []int{0}
+	return fileDescriptor_account_54a158c50701de97, []int{0}
 }
 func (m *UpdatePasswordRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -90,7 +90,7 @@ func (m *UpdatePasswordResponse) Reset()         { *m = UpdatePasswordResponse{}
 func (m *UpdatePasswordResponse) String() string { return proto.CompactTextString(m) }
 func (*UpdatePasswordResponse) ProtoMessage()    {}
 func (*UpdatePasswordResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_account_c12a236fbb4926f3, []int{1}
+	return fileDescriptor_account_54a158c50701de97, []int{1}
 }
 func (m *UpdatePasswordResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -119,9 +119,265 @@ func (m *UpdatePasswordResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_UpdatePasswordResponse proto.InternalMessageInfo
 
+type PolicyRequest struct {
+	Resource             string   `protobuf:"bytes,1,opt,name=resource,proto3" json:"resource,omitempty"`
+	Action               string   `protobuf:"bytes,2,opt,name=action,proto3" json:"action,omitempty"`
+	Object               string   `protobuf:"bytes,3,opt,name=object,proto3" json:"object,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PolicyRequest) Reset()         { *m = PolicyRequest{} }
+func (m *PolicyRequest) String() string { return proto.CompactTextString(m) }
+func (*PolicyRequest) ProtoMessage()    {}
+func (*PolicyRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_account_54a158c50701de97, []int{2}
+}
+func (m *PolicyRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PolicyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PolicyRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (dst *PolicyRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PolicyRequest.Merge(dst, src)
+}
+func (m *PolicyRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *PolicyRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_PolicyRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PolicyRequest proto.InternalMessageInfo
+
+func (m *PolicyRequest) GetResource() string {
+	if m != nil {
+		return m.Resource
+	}
+	return ""
+}
+
+func (m *PolicyRequest) GetAction() string {
+	if m != nil {
+		return m.Action
+	}
+	return ""
+}
+
+func (m *PolicyRequest) GetObject() string {
+	if m != nil {
+		return m.Object
+	}
+	return ""
+}
+
+type EvaluatePolicyRequest struct {
+	Subject              string           `protobuf:"bytes,1,opt,name=subject,proto3" json:"subject,omitempty"`
+	Groups               []string         `protobuf:"bytes,2,rep,name=groups" json:"groups,omitempty"`
+	Requests             []*PolicyRequest `protobuf:"bytes,3,rep,name=requests" json:"requests,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *EvaluatePolicyRequest) Reset()         { *m = EvaluatePolicyRequest{} }
+func (m *EvaluatePolicyRequest) String() string { return proto.CompactTextString(m) }
+func (*EvaluatePolicyRequest) ProtoMessage()    {}
+func (*EvaluatePolicyRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_account_54a158c50701de97, []int{3}
+}
+func (m *EvaluatePolicyRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EvaluatePolicyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EvaluatePolicyRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (dst *EvaluatePolicyRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EvaluatePolicyRequest.Merge(dst, src)
+}
+func (m *EvaluatePolicyRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *EvaluatePolicyRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_EvaluatePolicyRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EvaluatePolicyRequest proto.InternalMessageInfo
+
+func (m *EvaluatePolicyRequest) GetSubject() string {
+	if m != nil {
+		return m.Subject
+	}
+	return ""
+}
+
+func (m *EvaluatePolicyRequest) GetGroups() []string {
+	if m != nil {
+		return m.Groups
+	}
+	return nil
+}
+
+func (m *EvaluatePolicyRequest) GetRequests() []*PolicyRequest {
+	if m != nil {
+		return m.Requests
+	}
+	return nil
+}
+
+type PolicyResult struct {
+	Resource             string   `protobuf:"bytes,1,opt,name=resource,proto3" json:"resource,omitempty"`
+	Action               string   `protobuf:"bytes,2,opt,name=action,proto3" json:"action,omitempty"`
+	Object               string   `protobuf:"bytes,3,opt,name=object,proto3" json:"object,omitempty"`
+	Allowed              bool     `protobuf:"varint,4,opt,name=allowed,proto3" json:"allowed,omitempty"`
+	Policies             []string `protobuf:"bytes,5,rep,name=policies" json:"policies,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PolicyResult) Reset()         { *m = PolicyResult{} }
+func (m *PolicyResult) String() string { return proto.CompactTextString(m) }
+func (*PolicyResult) ProtoMessage()    {}
+func (*PolicyResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_account_54a158c50701de97, []int{4}
+}
+func (m *PolicyResult) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PolicyResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PolicyResult.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (dst *PolicyResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PolicyResult.Merge(dst, src)
+}
+func (m *PolicyResult) XXX_Size() int {
+	return m.Size()
+}
+func (m *PolicyResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_PolicyResult.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PolicyResult proto.InternalMessageInfo
+
+func (m *PolicyResult) GetResource() string {
+	if m != nil {
+		return m.Resource
+	}
+	return ""
+}
+
+func (m *PolicyResult) GetAction() string {
+	if m != nil {
+		return m.Action
+	}
+	return ""
+}
+
+func (m *PolicyResult) GetObject() string {
+	if m != nil {
+		return m.Object
+	}
+	return ""
+}
+
+func (m *PolicyResult) GetAllowed() bool {
+	if m != nil {
+		return m.Allowed
+	}
+	return false
+}
+
+func (m *PolicyResult) GetPolicies() []string {
+	if m != nil {
+		return m.Policies
+	}
+	return nil
+}
+
+type EvaluatePolicyResponse struct {
+	Results              []*PolicyResult `protobuf:"bytes,1,rep,name=results" json:"results,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *EvaluatePolicyResponse) Reset()         { *m = EvaluatePolicyResponse{} }
+func (m *EvaluatePolicyResponse) String() string { return proto.CompactTextString(m) }
+func (*EvaluatePolicyResponse) ProtoMessage()    {}
+func (*EvaluatePolicyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_account_54a158c50701de97, []int{5}
+}
+func (m *EvaluatePolicyResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EvaluatePolicyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EvaluatePolicyResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (dst *EvaluatePolicyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EvaluatePolicyResponse.Merge(dst, src)
+}
+func (m *EvaluatePolicyResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *EvaluatePolicyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_EvaluatePolicyResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EvaluatePolicyResponse proto.InternalMessageInfo
+
+func (m *EvaluatePolicyResponse) GetResults() []*PolicyResult {
+	if m != nil {
+		return m.Results
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*UpdatePasswordRequest)(nil), "account.UpdatePasswordRequest")
 	proto.RegisterType((*UpdatePasswordResponse)(nil), "account.UpdatePasswordResponse")
+	proto.RegisterType((*PolicyRequest)(nil), "account.PolicyRequest")
+	proto.RegisterType((*EvaluatePolicyRequest)(nil), "account.EvaluatePolicyRequest")
+	proto.RegisterType((*PolicyResult)(nil), "account.PolicyResult")
+	proto.RegisterType((*EvaluatePolicyResponse)(nil), "account.EvaluatePolicyResponse")
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -137,6 +393,8 @@ const _ = grpc.SupportPackageIsVersion4
 type AccountServiceClient interface {
 	// UpdatePassword updates an account's password to a new value
 	UpdatePassword(ctx context.Context, in *UpdatePasswordRequest, opts ...grpc.CallOption) (*UpdatePasswordResponse, error)
+	// EvaluatePolicy evaluates the RBAC policy for the requests of a hypothetical subject
+	EvaluatePolicy(ctx context.Context, in *EvaluatePolicyRequest, opts ...grpc.CallOption) (*EvaluatePolicyResponse, error)
 }
 
 type accountServiceClient struct {
@@ -156,11 +414,22 @@ func (c *accountServiceClient) UpdatePassword(ctx context.Context, in *UpdatePas
 	return out, nil
 }
 
+func (c *accountServiceClient) EvaluatePolicy(ctx context.Context, in *EvaluatePolicyRequest, opts ...grpc.CallOption) (*EvaluatePolicyResponse, error) {
+	out := new(EvaluatePolicyResponse)
+	err := c.cc.Invoke(ctx, "/account.AccountService/EvaluatePolicy", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // Server API for AccountService service
 
 type AccountServiceServer interface {
 	// UpdatePassword updates an account's password to a new value
 	UpdatePassword(context.Context, *UpdatePasswordRequest) (*UpdatePasswordResponse, error)
+	// EvaluatePolicy evaluates the RBAC policy for the requests of a hypothetical subject
+	EvaluatePolicy(context.Context, *EvaluatePolicyRequest) (*EvaluatePolicyResponse, error)
 }
 
 func RegisterAccountServiceServer(s *grpc.Server, srv AccountServiceServer) {
@@ -185,6 +454,24 @@ func _AccountService_UpdatePassword_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AccountService_EvaluatePolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EvaluatePolicyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountServiceServer).EvaluatePolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/account.AccountService/EvaluatePolicy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountServiceServer).EvaluatePolicy(ctx, req.(*EvaluatePolicyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _AccountService_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "account.AccountService",
 	HandlerType: (*AccountServiceServer)(nil),
@@ -193,6 +480,10 @@ var _AccountService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "UpdatePassword",
 			Handler:    _AccountService_UpdatePassword_Handler,
 		},
+		{
+			MethodName: "EvaluatePolicy",
+			Handler:    _AccountService_EvaluatePolicy_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "server/account/account.proto",
@@ -252,55 +543,774 @@ func (m *UpdatePasswordResponse) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
-func encodeVarintAccount(dAtA []byte, offset int, v uint64) int {
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *PolicyRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return offset + 1
+	return dAtA[:n], nil
 }
-func (m *UpdatePasswordRequest) Size() (n int) {
+
+func (m *PolicyRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	l = len(m.NewPassword)
-	if l > 0 {
-		n += 1 + l + sovAccount(uint64(l))
+	if len(m.Resource) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintAccount(dAtA, i, uint64(len(m.Resource)))
+		i += copy(dAtA[i:], m.Resource)
 	}
-	l = len(m.CurrentPassword)
-	if l > 0 {
-		n += 1 + l + sovAccount(uint64(l))
+	if len(m.Action) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintAccount(dAtA, i, uint64(len(m.Action)))
+		i += copy(dAtA[i:], m.Action)
+	}
+	if len(m.Object) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintAccount(dAtA, i, uint64(len(m.Object)))
+		i += copy(dAtA[i:], m.Object)
 	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *EvaluatePolicyRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EvaluatePolicyRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Subject) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintAccount(dAtA, i, uint64(len(m.Subject)))
+		i += copy(dAtA[i:], m.Subject)
+	}
+	if len(m.Groups) > 0 {
+		for _, s := range m.Groups {
+			dAtA[i] = 0x12
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	if len(m.Requests) > 0 {
+		for _, msg := range m.Requests {
+			dAtA[i] = 0x1a
+			i++
+			i = encodeVarintAccount(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *PolicyResult) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PolicyResult) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Resource) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintAccount(dAtA, i, uint64(len(m.Resource)))
+		i += copy(dAtA[i:], m.Resource)
+	}
+	if len(m.Action) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintAccount(dAtA, i, uint64(len(m.Action)))
+		i += copy(dAtA[i:], m.Action)
+	}
+	if len(m.Object) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintAccount(dAtA, i, uint64(len(m.Object)))
+		i += copy(dAtA[i:], m.Object)
+	}
+	if m.Allowed {
+		dAtA[i] = 0x20
+		i++
+		if m.Allowed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if len(m.Policies) > 0 {
+		for _, s := range m.Policies {
+			dAtA[i] = 0x2a
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *EvaluatePolicyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EvaluatePolicyResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Results) > 0 {
+		for _, msg := range m.Results {
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintAccount(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func encodeVarintAccount(dAtA []byte, offset int, v uint64) int {
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return offset + 1
+}
+func (m *UpdatePasswordRequest) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.NewPassword)
+	if l > 0 {
+		n += 1 + l + sovAccount(uint64(l))
+	}
+	l = len(m.CurrentPassword)
+	if l > 0 {
+		n += 1 + l + sovAccount(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *UpdatePasswordResponse) Size() (n int) {
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *PolicyRequest) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Resource)
+	if l > 0 {
+		n += 1 + l + sovAccount(uint64(l))
+	}
+	l = len(m.Action)
+	if l > 0 {
+		n += 1 + l + sovAccount(uint64(l))
+	}
+	l = len(m.Object)
+	if l > 0 {
+		n += 1 + l + sovAccount(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *EvaluatePolicyRequest) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Subject)
+	if l > 0 {
+		n += 1 + l + sovAccount(uint64(l))
+	}
+	if len(m.Groups) > 0 {
+		for _, s := range m.Groups {
+			l = len(s)
+			n += 1 + l + sovAccount(uint64(l))
+		}
+	}
+	if len(m.Requests) > 0 {
+		for _, e := range m.Requests {
+			l = e.Size()
+			n += 1 + l + sovAccount(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *PolicyResult) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Resource)
+	if l > 0 {
+		n += 1 + l + sovAccount(uint64(l))
+	}
+	l = len(m.Action)
+	if l > 0 {
+		n += 1 + l + sovAccount(uint64(l))
+	}
+	l = len(m.Object)
+	if l > 0 {
+		n += 1 + l + sovAccount(uint64(l))
+	}
+	if m.Allowed {
+		n += 2
+	}
+	if len(m.Policies) > 0 {
+		for _, s := range m.Policies {
+			l = len(s)
+			n += 1 + l + sovAccount(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *EvaluatePolicyResponse) Size() (n int) {
+	var l int
+	_ = l
+	if len(m.Results) > 0 {
+		for _, e := range m.Results {
+			l = e.Size()
+			n += 1 + l + sovAccount(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func sovAccount(x uint64) (n int) {
+	for {
+		n++
+		x >>= 7
+		if x == 0 {
+			break
+		}
+	}
+	return n
+}
+func sozAccount(x uint64) (n int) {
+	return sovAccount(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *UpdatePasswordRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAccount
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UpdatePasswordRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UpdatePasswordRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewPassword", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAccount
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAccount
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NewPassword = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentPassword", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAccount
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAccount
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CurrentPassword = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAccount(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthAccount
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UpdatePasswordResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAccount
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UpdatePasswordResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UpdatePasswordResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAccount(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthAccount
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PolicyRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAccount
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PolicyRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PolicyRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Resource", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAccount
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAccount
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Resource = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Action", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAccount
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAccount
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Action = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Object", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAccount
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAccount
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Object = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAccount(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthAccount
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func (m *UpdatePasswordResponse) Size() (n int) {
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
+	return nil
 }
-
-func sovAccount(x uint64) (n int) {
-	for {
-		n++
-		x >>= 7
-		if x == 0 {
-			break
+func (m *EvaluatePolicyRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAccount
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EvaluatePolicyRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EvaluatePolicyRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Subject", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAccount
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAccount
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Subject = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Groups", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAccount
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAccount
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Groups = append(m.Groups, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Requests", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAccount
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAccount
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Requests = append(m.Requests, &PolicyRequest{})
+			if err := m.Requests[len(m.Requests)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAccount(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthAccount
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
 		}
 	}
-	return n
-}
-func sozAccount(x uint64) (n int) {
-	return sovAccount(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *UpdatePasswordRequest) Unmarshal(dAtA []byte) error {
+func (m *PolicyResult) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -323,15 +1333,15 @@ func (m *UpdatePasswordRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: UpdatePasswordRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: PolicyResult: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UpdatePasswordRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PolicyResult: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NewPassword", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Resource", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -356,11 +1366,11 @@ func (m *UpdatePasswordRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NewPassword = string(dAtA[iNdEx:postIndex])
+			m.Resource = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CurrentPassword", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Action", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -385,7 +1395,85 @@ func (m *UpdatePasswordRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CurrentPassword = string(dAtA[iNdEx:postIndex])
+			m.Action = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Object", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAccount
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAccount
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Object = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Allowed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAccount
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Allowed = bool(v != 0)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Policies", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAccount
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAccount
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Policies = append(m.Policies, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -409,7 +1497,7 @@ func (m *UpdatePasswordRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *UpdatePasswordResponse) Unmarshal(dAtA []byte) error {
+func (m *EvaluatePolicyResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -432,12 +1520,43 @@ func (m *UpdatePasswordResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: UpdatePasswordResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: EvaluatePolicyResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UpdatePasswordResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EvaluatePolicyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Results", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAccount
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAccount
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Results = append(m.Results, &PolicyResult{})
+			if err := m.Results[len(m.Results)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAccount(dAtA[iNdEx:])
@@ -566,26 +1685,39 @@ var (
 )
 
 func init() {
-	proto.RegisterFile("server/account/account.proto", fileDescriptor_account_c12a236fbb4926f3)
-}
-
-var fileDescriptor_account_c12a236fbb4926f3 = []byte{
-	// 268 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x29, 0x4e, 0x2d, 0x2a,
-	0x4b, 0x2d, 0xd2, 0x4f, 0x4c, 0x4e, 0xce, 0x2f, 0xcd, 0x2b, 0x81, 0xd1, 0x7a, 0x05, 0x45, 0xf9,
-	0x25, 0xf9, 0x42, 0xec, 0x50, 0xae, 0x94, 0x48, 0x7a, 0x7e, 0x7a, 0x3e, 0x58, 0x4c, 0x1f, 0xc4,
-	0x82, 0x48, 0x4b, 0xc9, 0xa4, 0xe7, 0xe7, 0xa7, 0xe7, 0xa4, 0xea, 0x27, 0x16, 0x64, 0xea, 0x27,
-	0xe6, 0xe5, 0xe5, 0x97, 0x24, 0x96, 0x64, 0xe6, 0xe7, 0x15, 0x43, 0x64, 0x95, 0x92, 0xb9, 0x44,
-	0x43, 0x0b, 0x52, 0x12, 0x4b, 0x52, 0x03, 0x12, 0x8b, 0x8b, 0xcb, 0xf3, 0x8b, 0x52, 0x82, 0x52,
-	0x0b, 0x4b, 0x53, 0x8b, 0x4b, 0x84, 0x14, 0xb8, 0xb8, 0xf3, 0x52, 0xcb, 0x61, 0xa2, 0x12, 0x8c,
-	0x0a, 0x8c, 0x1a, 0x9c, 0x41, 0xc8, 0x42, 0x42, 0x1a, 0x5c, 0xfc, 0xc9, 0xa5, 0x45, 0x45, 0xa9,
-	0x79, 0x25, 0x70, 0x55, 0x4c, 0x60, 0x55, 0xe8, 0xc2, 0x4a, 0x12, 0x5c, 0x62, 0xe8, 0x96, 0x14,
-	0x17, 0xe4, 0xe7, 0x15, 0xa7, 0x1a, 0x75, 0x30, 0x72, 0xf1, 0x39, 0x42, 0x9c, 0x1f, 0x9c, 0x5a,
-	0x54, 0x96, 0x99, 0x9c, 0x2a, 0x54, 0xc6, 0xc5, 0x87, 0xaa, 0x58, 0x48, 0x4e, 0x0f, 0xe6, 0x61,
-	0xac, 0x4e, 0x95, 0x92, 0xc7, 0x29, 0x0f, 0xb1, 0x45, 0x49, 0xb9, 0xe9, 0xf2, 0x93, 0xc9, 0x4c,
-	0xb2, 0x52, 0x12, 0xe0, 0x40, 0x28, 0x33, 0x84, 0x07, 0x64, 0x01, 0x54, 0xa5, 0x15, 0xa3, 0x96,
-	0x93, 0xc5, 0x89, 0x47, 0x72, 0x8c, 0x17, 0x1e, 0xc9, 0x31, 0x3e, 0x78, 0x24, 0xc7, 0x18, 0xa5,
-	0x95, 0x9e, 0x59, 0x92, 0x51, 0x9a, 0xa4, 0x97, 0x9c, 0x9f, 0xab, 0x9f, 0x58, 0x04, 0x0e, 0xd6,
-	0x2c, 0x30, 0x43, 0x37, 0x39, 0x45, 0x1f, 0x35, 0x3a, 0x92, 0xd8, 0xc0, 0x41, 0x69, 0x0c, 0x08,
-	0x00, 0x00, 0xff, 0xff, 0xd2, 0x60, 0x1a, 0x8e, 0xa7, 0x01, 0x00, 0x00,
+	proto.RegisterFile("server/account/account.proto", fileDescriptor_account_54a158c50701de97)
+}
+
+var fileDescriptor_account_54a158c50701de97 = []byte{
+	// 467 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x93, 0xbf, 0x8e, 0xd3, 0x40,
+	0x10, 0xc6, 0xb5, 0x09, 0x5c, 0x8e, 0x3d, 0x08, 0xd2, 0x8a, 0x44, 0x2b, 0xeb, 0x48, 0x2c, 0x43,
+	0x11, 0x45, 0x22, 0x16, 0xa1, 0x41, 0xd7, 0x81, 0x44, 0x41, 0x77, 0x32, 0xa2, 0x81, 0x6a, 0xb3,
+	0x5e, 0x19, 0x9f, 0x8c, 0xc7, 0xec, 0x1f, 0x47, 0x14, 0xd7, 0xd0, 0xd0, 0x22, 0xf1, 0x52, 0x94,
+	0x48, 0xbc, 0x00, 0x8a, 0x78, 0x10, 0xb4, 0xbb, 0xb6, 0x75, 0xce, 0x5d, 0xba, 0xab, 0xe2, 0x6f,
+	0xe6, 0xdb, 0xf9, 0xed, 0x64, 0x67, 0xf0, 0xa9, 0x12, 0xb2, 0x16, 0x32, 0x66, 0x9c, 0x83, 0x29,
+	0x75, 0xfb, 0xbb, 0xaa, 0x24, 0x68, 0x20, 0xa3, 0x46, 0x06, 0x8f, 0x32, 0xc8, 0xc0, 0xc5, 0x62,
+	0xfb, 0xe5, 0xd3, 0xc1, 0x69, 0x06, 0x90, 0x15, 0x22, 0x66, 0x55, 0x1e, 0xb3, 0xb2, 0x04, 0xcd,
+	0x74, 0x0e, 0xa5, 0xf2, 0xd9, 0x88, 0xe3, 0xc9, 0xfb, 0x2a, 0x65, 0x5a, 0x9c, 0x33, 0xa5, 0xb6,
+	0x20, 0xd3, 0x44, 0x7c, 0x31, 0x42, 0x69, 0x12, 0xe2, 0x93, 0x52, 0x6c, 0xdb, 0x28, 0x45, 0x21,
+	0x5a, 0xdc, 0x4b, 0xae, 0x86, 0xc8, 0x02, 0x3f, 0xe4, 0x46, 0x4a, 0x51, 0xea, 0xce, 0x35, 0x70,
+	0xae, 0xfd, 0x70, 0x44, 0xf1, 0x74, 0x1f, 0xa2, 0x2a, 0x28, 0x95, 0x88, 0x3e, 0xe2, 0x07, 0xe7,
+	0x50, 0xe4, 0xfc, 0x6b, 0x8b, 0x0d, 0xf0, 0xb1, 0x14, 0x0a, 0x8c, 0xe4, 0xa2, 0x61, 0x76, 0x9a,
+	0x4c, 0xf1, 0x11, 0xe3, 0xf6, 0xf2, 0x0d, 0xa7, 0x51, 0x36, 0x0e, 0x9b, 0x0b, 0xc1, 0x35, 0x1d,
+	0xfa, 0xb8, 0x57, 0xd1, 0x25, 0x9e, 0xbc, 0xa9, 0x59, 0x61, 0x2c, 0xb8, 0x07, 0xa1, 0x78, 0xa4,
+	0x8c, 0x3f, 0xe1, 0x19, 0xad, 0xb4, 0xa5, 0x32, 0x09, 0xa6, 0x52, 0x74, 0x10, 0x0e, 0x6d, 0x29,
+	0xaf, 0xc8, 0xda, 0x5e, 0xcb, 0x1d, 0x56, 0x74, 0x18, 0x0e, 0x17, 0x27, 0xeb, 0xe9, 0xaa, 0x7d,
+	0x85, 0x5e, 0xed, 0xa4, 0xf3, 0x45, 0x3f, 0x10, 0xbe, 0xdf, 0xe6, 0x94, 0x29, 0x6e, 0xb5, 0x37,
+	0xdb, 0x02, 0x2b, 0x0a, 0xd8, 0x8a, 0x94, 0xde, 0x09, 0xd1, 0xe2, 0x38, 0x69, 0xa5, 0xa5, 0x54,
+	0x96, 0x9a, 0x0b, 0x45, 0xef, 0xba, 0x26, 0x3a, 0x1d, 0xbd, 0xc5, 0xd3, 0xfd, 0x7f, 0xc4, 0x3f,
+	0x04, 0x89, 0xf1, 0x48, 0xba, 0x5b, 0x2a, 0x8a, 0x5c, 0x7f, 0x93, 0x6b, 0xfd, 0xd9, 0x6c, 0xd2,
+	0xba, 0xd6, 0xdf, 0x07, 0x78, 0xfc, 0xca, 0x3b, 0xde, 0x09, 0x59, 0xe7, 0x5c, 0x90, 0x1a, 0x8f,
+	0xfb, 0xcf, 0x4c, 0x66, 0x5d, 0x91, 0x1b, 0x87, 0x2c, 0x98, 0x1f, 0xcc, 0x37, 0xf3, 0xf1, 0xe4,
+	0xdb, 0x9f, 0x7f, 0x3f, 0x07, 0x8f, 0x03, 0xea, 0xc6, 0xb7, 0x7e, 0xde, 0xad, 0x40, 0xd5, 0x38,
+	0xcf, 0xd0, 0x92, 0x5c, 0xe2, 0x71, 0xbf, 0xab, 0x2b, 0xdc, 0x1b, 0x07, 0x20, 0x98, 0x1f, 0xcc,
+	0x37, 0xdc, 0xa5, 0xe3, 0x3e, 0x8d, 0xe6, 0xd7, 0xb8, 0xce, 0x17, 0x8b, 0xe6, 0xd8, 0x19, 0x5a,
+	0xbe, 0x7e, 0xf9, 0x6b, 0x37, 0x43, 0xbf, 0x77, 0x33, 0xf4, 0x77, 0x37, 0x43, 0x1f, 0x96, 0x59,
+	0xae, 0x3f, 0x99, 0xcd, 0x8a, 0xc3, 0xe7, 0x98, 0x49, 0xb7, 0x8f, 0x17, 0xee, 0xe3, 0x19, 0x4f,
+	0xe3, 0xfe, 0x1e, 0x6f, 0x8e, 0xdc, 0x0e, 0xbe, 0xf8, 0x3f, 0x00, 0x3e, 0x90, 0x3e, 0x38, 0xe0,
+	0x03, 0x00, 0x00,
 }
@@ -0,0 +1,52 @@
+package account
+
+import (
+	"context"
+	"testing"
+
+	jwt "github.com/dgrijalva/jwt-go"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"github.com/argoproj/argo-cd/common"
+	"github.com/argoproj/argo-cd/server/rbacpolicy"
+	"github.com/argoproj/argo-cd/util/rbac"
+)
+
+func TestEvaluatePolicy(t *testing.T) {
+	enf := rbac.NewEnforcer(fake.NewSimpleClientset(), "argocd", common.ArgoCDRBACConfigMapName, nil)
+	policyEnf := rbacpolicy.NewRBACPolicyEnforcer(enf, nil)
+	enf.SetClaimsEnforcerFunc(policyEnf.EnforceClaims)
+	assert.NoError(t, enf.SetUserPolicy(`
+p, role:support, accounts, impersonate, alice@example.com, allow
+p, role:support, accounts, impersonate, my-org:my-team, allow
+p, my-org:my-team, clusters, get, *, allow
+g, support@example.com, role:support
+`))
+	server := NewServer(nil, nil, enf, policyEnf)
+	ctx := context.WithValue(context.Background(), "claims", jwt.MapClaims{"sub": "support@example.com"})
+	request := func(groups ...string) *EvaluatePolicyRequest {
+		return &EvaluatePolicyRequest{
+			Subject:  "alice@example.com",
+			Groups:   groups,
+			Requests: []*PolicyRequest{{Resource: "clusters", Action: "get", Object: "https://kubernetes.default.svc"}},
+		}
+	}
+
+	res, err := server.EvaluatePolicy(ctx, request())
+	assert.NoError(t, err)
+	assert.False(t, res.Results[0].Allowed)
+
+	res, err = server.EvaluatePolicy(ctx, request("my-org:my-team"))
+	assert.NoError(t, err)
+	assert.True(t, res.Results[0].Allowed)
+
+	// the groups need to be impersonated as well as the subject
+	_, err = server.EvaluatePolicy(ctx, request("my-org:my-team", "my-org:admins"))
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	_, err = server.EvaluatePolicy(ctx, &EvaluatePolicyRequest{Subject: "bob@example.com"})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+}
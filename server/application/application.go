@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"reflect"
+	"sort"
 	"strings"
 	"time"
 
@@ -37,7 +38,9 @@ import (
 	"github.com/argoproj/argo-cd/util/grpc"
 	"github.com/argoproj/argo-cd/util/kube"
 	"github.com/argoproj/argo-cd/util/rbac"
+	"github.com/argoproj/argo-cd/util/redact"
 	"github.com/argoproj/argo-cd/util/session"
+	"github.com/argoproj/argo-cd/util/settings"
 )
 
 // Server provides a Application service
@@ -54,6 +57,7 @@ type Server struct {
 	projectLock         *util.KeyLock
 	auditLogger         *argo.AuditLogger
 	gitFactory          git.ClientFactory
+	settingsMgr         *settings.SettingsManager
 }
 
 // NewServer returns a new instance of the Application service
@@ -67,6 +71,7 @@ func NewServer(
 	db db.ArgoDB,
 	enf *rbac.Enforcer,
 	projectLock *util.KeyLock,
+	settingsMgr *settings.SettingsManager,
 ) ApplicationServiceServer {
 
 	return &Server{
@@ -77,11 +82,12 @@ func NewServer(
 		db:                  db,
 		repoClientset:       repoClientset,
 		kubectl:             kubectl,
-		appComparator:       controller.NewAppStateManager(db, appclientset, repoClientset, namespace, kubectl),
+		appComparator:       controller.NewAppStateManager(db, appclientset, repoClientset, namespace, kubectl, 0, controller.LiveStateSnapshots{}, false, nil, settingsMgr, 0, controller.HistoryRetention{}, nil),
 		enf:                 enf,
 		projectLock:         projectLock,
 		auditLogger:         argo.NewAuditLogger(namespace, kubeclientset, "argocd-server"),
 		gitFactory:          git.NewFactory(),
+		settingsMgr:         settingsMgr,
 	}
 }
 
@@ -90,6 +96,19 @@ func appRBACName(app appv1.Application) string {
 	return fmt.Sprintf("%s/%s", app.Spec.GetProject(), app.Name)
 }
 
+// getRedactor returns the redactor of the manifests returned to the user, or nil if the user is
+// permitted to see the unredacted manifests of the application
+func (s *Server) getRedactor(ctx context.Context, a *appv1.Application) (*redact.Redactor, error) {
+	if s.enf.Enforce(ctx.Value("claims"), rbacpolicy.ResourceApplications, rbacpolicy.ActionUnredact, appRBACName(*a)) {
+		return nil, nil
+	}
+	argoSettings, err := s.settingsMgr.GetSettings()
+	if err != nil {
+		return nil, err
+	}
+	return redact.NewRedactor(argoSettings.GetResourceRedactions()), nil
+}
+
 // List returns list of applications
 func (s *Server) List(ctx context.Context, q *ApplicationQuery) (*appv1.ApplicationList, error) {
 	appList, err := s.appclientset.ArgoprojV1alpha1().Applications(s.ns).List(metav1.ListOptions{})
@@ -187,11 +206,18 @@ func (s *Server) GetManifests(ctx context.Context, q *ApplicationManifestQuery)
 		AppLabel:                    a.Name,
 		Namespace:                   a.Spec.Destination.Namespace,
 		ApplicationSource:           &a.Spec.Source,
+		Repos:                       []*appv1.Repository{repo},
 	})
 	if err != nil {
 		return nil, err
 	}
-
+	redactor, err := s.getRedactor(ctx, a)
+	if err != nil {
+		return nil, err
+	}
+	for i := range manifestInfo.Manifests {
+		manifestInfo.Manifests[i] = redactor.RedactState(manifestInfo.Manifests[i])
+	}
 	return manifestInfo, nil
 }
 
@@ -205,8 +231,18 @@ func (s *Server) Get(ctx context.Context, q *ApplicationQuery) (*appv1.Applicati
 	if !s.enf.Enforce(ctx.Value("claims"), rbacpolicy.ResourceApplications, rbacpolicy.ActionGet, appRBACName(*a)) {
 		return nil, grpc.ErrPermissionDenied
 	}
+	refreshType := appv1.RefreshTypeNone
 	if q.Refresh {
-		_, err = argoutil.RefreshApp(appIf, *q.Name)
+		refreshType = appv1.RefreshTypeNormal
+	}
+	if q.RefreshType != "" {
+		refreshType, err = appv1.ParseRefreshType(q.RefreshType)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
+	if refreshType != appv1.RefreshTypeNone {
+		_, err = argoutil.RefreshApp(appIf, *q.Name, refreshType)
 		if err != nil {
 			return nil, err
 		}
@@ -227,8 +263,13 @@ func (s *Server) ListResourceEvents(ctx context.Context, q *ApplicationResourceE
 	if !s.enf.Enforce(ctx.Value("claims"), rbacpolicy.ResourceApplications, rbacpolicy.ActionGet, appRBACName(*a)) {
 		return nil, grpc.ErrPermissionDenied
 	}
+	since, until, err := parseTimeRange(q.Since, q.Until)
+	if err != nil {
+		return nil, err
+	}
 	var (
 		kubeClientset kubernetes.Interface
+		selector      map[string]string
 		fieldSelector string
 		namespace     string
 	)
@@ -238,11 +279,11 @@ func (s *Server) ListResourceEvents(ctx context.Context, q *ApplicationResourceE
 	if q.ResourceName == "" && q.ResourceUID == "" {
 		kubeClientset = s.kubeclientset
 		namespace = a.Namespace
-		fieldSelector = fields.SelectorFromSet(map[string]string{
+		selector = map[string]string{
 			"involvedObject.name":      a.Name,
 			"involvedObject.uid":       string(a.UID),
 			"involvedObject.namespace": a.Namespace,
-		}).String()
+		}
 	} else {
 		var config *rest.Config
 		config, namespace, err = s.getApplicationClusterConfig(*q.Name)
@@ -253,16 +294,150 @@ func (s *Server) ListResourceEvents(ctx context.Context, q *ApplicationResourceE
 		if err != nil {
 			return nil, err
 		}
-		fieldSelector = fields.SelectorFromSet(map[string]string{
+		selector = map[string]string{
 			"involvedObject.name":      q.ResourceName,
 			"involvedObject.uid":       q.ResourceUID,
 			"involvedObject.namespace": namespace,
-		}).String()
+		}
+	}
+	if q.Type != "" {
+		selector["type"] = q.Type
 	}
+	if q.Reason != "" {
+		selector["reason"] = q.Reason
+	}
+	fieldSelector = fields.SelectorFromSet(selector).String()
 
 	log.Infof("Querying for resource events with field selector: %s", fieldSelector)
 	opts := metav1.ListOptions{FieldSelector: fieldSelector}
-	return kubeClientset.CoreV1().Events(namespace).List(opts)
+	eventList, err := kubeClientset.CoreV1().Events(namespace).List(opts)
+	if err != nil {
+		return nil, err
+	}
+	if since.IsZero() && until.IsZero() && q.Limit == 0 && q.Offset == 0 {
+		return eventList, nil
+	}
+	// the events are filtered by the time they last occurred, and paged newest first
+	var items []v1.Event
+	for _, event := range eventList.Items {
+		if inTimeRange(eventTime(event), since, until) {
+			items = append(items, event)
+		}
+	}
+	sort.SliceStable(items, func(i, j int) bool {
+		return eventTime(items[i]).After(eventTime(items[j]))
+	})
+	start, end, err := pageBounds(len(items), q.Limit, q.Offset)
+	if err != nil {
+		return nil, err
+	}
+	eventList.Items = items[start:end]
+	return eventList, nil
+}
+
+// History returns the deployment history of an application, newest first. The deployments can be
+// filtered by the time they were deployed at, and paged. The operation history is returned instead if
+// requested, filtered by the time the operations started at and by their phases.
+func (s *Server) History(ctx context.Context, q *ApplicationHistoryQuery) (*ApplicationHistoryResponse, error) {
+	a, err := s.appclientset.ArgoprojV1alpha1().Applications(s.ns).Get(*q.Name, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+	if !s.enf.Enforce(ctx.Value("claims"), rbacpolicy.ResourceApplications, rbacpolicy.ActionGet, appRBACName(*a)) {
+		return nil, grpc.ErrPermissionDenied
+	}
+	since, until, err := parseTimeRange(q.Since, q.Until)
+	if err != nil {
+		return nil, err
+	}
+	if q.Operations || len(q.Phases) > 0 {
+		return operationHistory(a, q, since, until)
+	}
+	items := make([]appv1.DeploymentInfo, 0)
+	for i := len(a.Status.History) - 1; i >= 0; i-- {
+		if inTimeRange(a.Status.History[i].DeployedAt.Time, since, until) {
+			items = append(items, a.Status.History[i])
+		}
+	}
+	start, end, err := pageBounds(len(items), q.Limit, q.Offset)
+	if err != nil {
+		return nil, err
+	}
+	return &ApplicationHistoryResponse{Items: items[start:end], Total: int64(len(items))}, nil
+}
+
+// operationHistory returns the completed operations of an application, newest first, filtered by the time
+// they started at and by their phases
+func operationHistory(a *appv1.Application, q *ApplicationHistoryQuery, since, until time.Time) (*ApplicationHistoryResponse, error) {
+	phases := make(map[appv1.OperationPhase]bool)
+	for _, phase := range q.Phases {
+		if !appv1.OperationPhase(phase).Completed() {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid operation phase '%s': must be one of %s, %s or %s",
+				phase, appv1.OperationSucceeded, appv1.OperationFailed, appv1.OperationError)
+		}
+		phases[appv1.OperationPhase(phase)] = true
+	}
+	operations := make([]appv1.OperationState, 0)
+	for i := len(a.Status.OperationHistory) - 1; i >= 0; i-- {
+		opState := a.Status.OperationHistory[i]
+		if (len(phases) == 0 || phases[opState.Phase]) && inTimeRange(opState.StartedAt.Time, since, until) {
+			operations = append(operations, opState)
+		}
+	}
+	start, end, err := pageBounds(len(operations), q.Limit, q.Offset)
+	if err != nil {
+		return nil, err
+	}
+	return &ApplicationHistoryResponse{Operations: operations[start:end], Total: int64(len(operations))}, nil
+}
+
+// parseTimeRange parses the bounds of a time range in RFC3339 format. Blank bounds are unbounded.
+func parseTimeRange(since, until string) (time.Time, time.Time, error) {
+	var sinceTime, untilTime time.Time
+	var err error
+	if since != "" {
+		if sinceTime, err = time.Parse(time.RFC3339, since); err != nil {
+			return time.Time{}, time.Time{}, status.Errorf(codes.InvalidArgument, "invalid since time '%s': %v", since, err)
+		}
+	}
+	if until != "" {
+		if untilTime, err = time.Parse(time.RFC3339, until); err != nil {
+			return time.Time{}, time.Time{}, status.Errorf(codes.InvalidArgument, "invalid until time '%s': %v", until, err)
+		}
+	}
+	return sinceTime, untilTime, nil
+}
+
+// inTimeRange returns whether the time is at or after since, and before until. Zero bounds are unbounded.
+func inTimeRange(t, since, until time.Time) bool {
+	return (since.IsZero() || !t.Before(since)) && (until.IsZero() || t.Before(until))
+}
+
+// pageBounds returns the bounds of the page of the items at the offset. A limit of 0 is unlimited.
+func pageBounds(count int, limit, offset int64) (int, int, error) {
+	if limit < 0 || offset < 0 {
+		return 0, 0, status.Errorf(codes.InvalidArgument, "limit and offset must not be negative")
+	}
+	start := count
+	if offset < int64(count) {
+		start = int(offset)
+	}
+	end := count
+	if limit > 0 && int64(start)+limit < int64(count) {
+		end = start + int(limit)
+	}
+	return start, end, nil
+}
+
+// eventTime returns the time an event last occurred
+func eventTime(event v1.Event) time.Time {
+	if !event.LastTimestamp.IsZero() {
+		return event.LastTimestamp.Time
+	}
+	if !event.EventTime.IsZero() {
+		return event.EventTime.Time
+	}
+	return event.FirstTimestamp.Time
 }
 
 // Update updates an application
@@ -366,7 +541,8 @@ func (s *Server) Delete(ctx context.Context, q *ApplicationDeleteRequest) (*Appl
 	}
 
 	patchFinalizer := false
-	if q.Cascade == nil || *q.Cascade {
+	cascade := q.Cascade == nil || *q.Cascade
+	if cascade {
 		if !a.CascadedDeletion() {
 			a.SetCascadedDeletion(true)
 			patchFinalizer = true
@@ -378,14 +554,37 @@ func (s *Server) Delete(ctx context.Context, q *ApplicationDeleteRequest) (*Appl
 		}
 	}
 
-	if patchFinalizer {
+	confirmCRDDeletion := cascade && q.ConfirmCRDDeletion && a.Annotations[common.AnnotationConfirmCRDDeletion] != "true"
+
+	propagationPolicy := appv1.PropagationPolicy(strings.ToLower(q.PropagationPolicy))
+	if propagationPolicy == appv1.PropagationPolicyOrphan {
+		return nil, status.Errorf(codes.InvalidArgument, "the resources of a cascaded deletion cannot be orphaned, use a non-cascaded deletion instead")
+	}
+	if _, err := propagationPolicy.DeletionPropagation(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	setPropagationPolicy := cascade && propagationPolicy != "" && a.Annotations[common.AnnotationDeletionPropagationPolicy] != string(propagationPolicy)
+
+	if patchFinalizer || confirmCRDDeletion || setPropagationPolicy {
 		// Prior to v0.6, the cascaded deletion finalizer was set during app creation.
 		// For backward compatibility, we always calculate the patch to see if we need to
 		// set/unset the finalizer (in case we are dealing with an app created prior to v0.6)
+		metadata := map[string]interface{}{
+			"finalizers": a.Finalizers,
+		}
+		// the annotations are read by the controller while it deletes application resources
+		annotations := make(map[string]string)
+		if confirmCRDDeletion {
+			annotations[common.AnnotationConfirmCRDDeletion] = "true"
+		}
+		if setPropagationPolicy {
+			annotations[common.AnnotationDeletionPropagationPolicy] = string(propagationPolicy)
+		}
+		if len(annotations) > 0 {
+			metadata["annotations"] = annotations
+		}
 		patch, err := json.Marshal(map[string]interface{}{
-			"metadata": map[string]interface{}{
-				"finalizers": a.Finalizers,
-			},
+			"metadata": metadata,
 		})
 		if err != nil {
 			return nil, err
@@ -455,6 +654,11 @@ func (s *Server) validateApp(ctx context.Context, spec *appv1.ApplicationSpec) e
 	if err != nil {
 		return err
 	}
+	for _, condition := range conditions {
+		if condition.Type == appv1.ApplicationConditionRepoServerUnavailableError {
+			return status.Errorf(codes.Unavailable, "%s", condition.Message)
+		}
+	}
 	if len(conditions) > 0 {
 		return status.Errorf(codes.InvalidArgument, "application spec is invalid: %s", argo.FormatAppConditions(conditions))
 	}
@@ -470,7 +674,7 @@ func (s *Server) getApplicationClusterConfig(applicationName string) (*rest.Conf
 	if err != nil {
 		return nil, "", err
 	}
-	config := clst.RESTConfig()
+	config := kube.ClusterRESTConfig(clst)
 	return config, namespace, err
 }
 
@@ -512,7 +716,7 @@ func (s *Server) DeleteResource(ctx context.Context, q *ApplicationDeleteResourc
 		return nil, err
 	}
 
-	found := findResource(resources.Items, q)
+	found := findResource(resources.Items, q.ResourceName, q.APIVersion, q.Kind)
 	if found == nil {
 		return nil, status.Errorf(codes.InvalidArgument, "%s %s %s not found as part of application %s", q.Kind, q.APIVersion, q.ResourceName, *q.Name)
 	}
@@ -520,7 +724,8 @@ func (s *Server) DeleteResource(ctx context.Context, q *ApplicationDeleteResourc
 	if err != nil {
 		return nil, err
 	}
-	err = s.kubectl.DeleteResource(config, found, namespace)
+	propagationPolicy := metav1.DeletePropagationForeground
+	err = s.kubectl.DeleteResource(config, found, namespace, metav1.DeleteOptions{PropagationPolicy: &propagationPolicy})
 	if err != nil {
 		return nil, err
 	}
@@ -528,6 +733,57 @@ func (s *Server) DeleteResource(ctx context.Context, q *ApplicationDeleteResourc
 	return &ApplicationResponse{}, nil
 }
 
+// patchTypes maps the patch types of PatchResource requests to the patch types of the Kubernetes API
+var patchTypes = map[string]types.PatchType{
+	"json":      types.JSONPatchType,
+	"merge":     types.MergePatchType,
+	"strategic": types.StrategicMergePatchType,
+}
+
+// PatchResource patches a single live resource of the application. The change is not made in git,
+// so the resource is reported as out of sync until the change is reverted or committed.
+func (s *Server) PatchResource(ctx context.Context, q *ApplicationPatchResourceRequest) (*ApplicationResponse, error) {
+	a, err := s.appclientset.ArgoprojV1alpha1().Applications(s.ns).Get(*q.Name, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+	if !s.enf.Enforce(ctx.Value("claims"), rbacpolicy.ResourceApplications, rbacpolicy.ActionPatch, appRBACName(*a)) {
+		return nil, grpc.ErrPermissionDenied
+	}
+	patchType := types.MergePatchType
+	if q.PatchType != "" {
+		var ok bool
+		if patchType, ok = patchTypes[q.PatchType]; !ok {
+			return nil, status.Errorf(codes.InvalidArgument, "unknown patch type '%s'. Must be one of: json, merge, strategic", q.PatchType)
+		}
+	}
+	if q.Patch == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "patch must not be empty")
+	}
+
+	resources, err := s.getAppResources(ctx, &services.ResourcesQuery{ApplicationName: &a.Name})
+	if err != nil {
+		return nil, err
+	}
+
+	found := findResource(resources.Items, q.ResourceName, q.APIVersion, q.Kind)
+	if found == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "%s %s %s not found as part of application %s", q.Kind, q.APIVersion, q.ResourceName, *q.Name)
+	}
+	config, _, err := s.getApplicationClusterConfig(*q.Name)
+	if err != nil {
+		return nil, err
+	}
+	// the resource is not necessarily in the namespace of the destination of the application
+	_, err = s.kubectl.PatchResource(config, found, found.GetNamespace(), patchType, []byte(q.Patch))
+	if err != nil {
+		return nil, err
+	}
+	// the patch itself is not logged, since it might contain secret values
+	s.logEvent(a, ctx, argo.EventReasonResourceUpdated, fmt.Sprintf("patched resource %s/%s '%s' (%s)", q.APIVersion, q.Kind, q.ResourceName, patchType))
+	return &ApplicationResponse{}, nil
+}
+
 func (s *Server) Resources(ctx context.Context, q *services.ResourcesQuery) (*services.ResourcesResponse, error) {
 	a, err := s.appclientset.ArgoprojV1alpha1().Applications(s.ns).Get(*q.ApplicationName, metav1.GetOptions{})
 	if err != nil {
@@ -536,10 +792,66 @@ func (s *Server) Resources(ctx context.Context, q *services.ResourcesQuery) (*se
 	if !s.enf.Enforce(ctx.Value("claims"), rbacpolicy.ResourceApplications, rbacpolicy.ActionGet, appRBACName(*a)) {
 		return nil, grpc.ErrPermissionDenied
 	}
-	return s.getAppResources(ctx, q)
+	redactor, err := s.getRedactor(ctx, a)
+	if err != nil {
+		return nil, err
+	}
+	resources, err := s.getAppResources(ctx, q)
+	if err != nil {
+		return nil, err
+	}
+	for _, res := range resources.Items {
+		res.LiveState, res.TargetState = redactor.RedactStates(res.LiveState, res.TargetState)
+		res.ChildLiveResources = redactor.RedactNodes(res.ChildLiveResources)
+	}
+	return resources, nil
 }
 
-func findResource(resources []*appv1.ResourceState, q *ApplicationDeleteResourceRequest) *unstructured.Unstructured {
+func (s *Server) SyncedManifests(ctx context.Context, q *services.SyncedManifestsQuery) (*services.SyncedManifestsResponse, error) {
+	a, err := s.appclientset.ArgoprojV1alpha1().Applications(s.ns).Get(q.GetApplicationName(), metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+	if !s.enf.Enforce(ctx.Value("claims"), rbacpolicy.ResourceApplications, rbacpolicy.ActionGet, appRBACName(*a)) {
+		return nil, grpc.ErrPermissionDenied
+	}
+	redactor, err := s.getRedactor(ctx, a)
+	if err != nil {
+		return nil, err
+	}
+	closer, client, err := s.controllerClientset.NewApplicationServiceClient()
+	if err != nil {
+		return nil, err
+	}
+	defer util.Close(closer)
+	res, err := client.SyncedManifests(ctx, q)
+	if err != nil {
+		return nil, err
+	}
+	for i := range res.Manifests {
+		res.Manifests[i] = redactor.RedactState(res.Manifests[i])
+	}
+	return res, nil
+}
+
+// ResourceTree returns the tree of the live resources of an application, and of the resources they control
+func (s *Server) ResourceTree(ctx context.Context, q *services.ResourcesQuery) (*appv1.ApplicationTree, error) {
+	a, err := s.appclientset.ArgoprojV1alpha1().Applications(s.ns).Get(q.GetApplicationName(), metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+	if !s.enf.Enforce(ctx.Value("claims"), rbacpolicy.ResourceApplications, rbacpolicy.ActionGet, appRBACName(*a)) {
+		return nil, grpc.ErrPermissionDenied
+	}
+	closer, client, err := s.controllerClientset.NewApplicationServiceClient()
+	if err != nil {
+		return nil, err
+	}
+	defer util.Close(closer)
+	return client.ResourceTree(ctx, q)
+}
+
+func findResource(resources []*appv1.ResourceState, name, apiVersion, kind string) *unstructured.Unstructured {
 	for _, res := range resources {
 		liveObj, err := res.LiveObject()
 		if err != nil {
@@ -549,10 +861,10 @@ func findResource(resources []*appv1.ResourceState, q *ApplicationDeleteResource
 		if liveObj == nil {
 			continue
 		}
-		if q.ResourceName == liveObj.GetName() && q.APIVersion == liveObj.GetAPIVersion() && q.Kind == liveObj.GetKind() {
+		if name == liveObj.GetName() && apiVersion == liveObj.GetAPIVersion() && kind == liveObj.GetKind() {
 			return liveObj
 		}
-		liveObj = recurseResourceNode(q.ResourceName, q.APIVersion, q.Kind, res.ChildLiveResources)
+		liveObj = recurseResourceNode(name, apiVersion, kind, res.ChildLiveResources)
 		if liveObj != nil {
 			return liveObj
 		}
@@ -657,8 +969,20 @@ func (s *Server) getApplicationDestination(ctx context.Context, name string) (st
 	if err != nil {
 		return "", "", err
 	}
-	server, namespace := a.Spec.Destination.Server, a.Spec.Destination.Namespace
-	return server, namespace, nil
+	dest := a.Spec.Destination
+	if dest.SelectsClusters() {
+		// the resources are in the primary destination, which is the first selected cluster
+		proj, err := argo.GetAppProject(&a.Spec, s.appclientset, s.ns)
+		if err != nil {
+			return "", "", err
+		}
+		dests, err := argo.ResolveDestinations(ctx, s.db, a, proj)
+		if err != nil {
+			return "", "", err
+		}
+		dest = dests[0]
+	}
+	return dest.Server, dest.Namespace, nil
 }
 
 func (s *Server) getRepo(ctx context.Context, repoURL string) *appv1.Repository {
@@ -680,6 +1004,10 @@ func (s *Server) Sync(ctx context.Context, syncReq *ApplicationSyncRequest) (*ap
 	if !s.enf.Enforce(ctx.Value("claims"), rbacpolicy.ResourceApplications, rbacpolicy.ActionSync, appRBACName(*a)) {
 		return nil, grpc.ErrPermissionDenied
 	}
+	// a retried request returns the operation it already started
+	if argo.HasOperation(a, syncReq.IdempotencyKey) {
+		return a, nil
+	}
 	if a.DeletionTimestamp != nil {
 		return nil, status.Errorf(codes.FailedPrecondition, "application is deleting")
 	}
@@ -709,6 +1037,39 @@ func (s *Server) Sync(ctx context.Context, syncReq *ApplicationSyncRequest) (*ap
 		}
 	}
 
+	if syncReq.Preset != "" {
+		preset, err := s.getParameterPreset(a, syncReq.Preset)
+		if err != nil {
+			return nil, err
+		}
+		parameterOverrides = argo.ApplyParameterPreset(parameterOverrides, *preset)
+	}
+
+	prunePropagationPolicy := appv1.PropagationPolicy(syncReq.PrunePropagationPolicy)
+	if _, err := prunePropagationPolicy.DeletionPropagation(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if err := syncReq.Retry.Validate(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if _, err := (&appv1.Operation{Timeout: syncReq.Timeout}).TimeoutDuration(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if syncReq.Strategy != nil {
+		if _, err := syncReq.Strategy.Hook.HealthTimeoutDuration(); err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
+	if syncReq.Strategy != nil && syncReq.Strategy.Progressive != nil && syncReq.Strategy.Progressive.BatchSize < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "batch size must not be negative: %d", syncReq.Strategy.Progressive.BatchSize)
+	}
+	if syncReq.ApplyConcurrency < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "apply concurrency must not be negative: %d", syncReq.ApplyConcurrency)
+	}
+	if syncReq.TerminationGracePeriodSeconds != nil && *syncReq.TerminationGracePeriodSeconds < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "termination grace period must not be negative: %d", *syncReq.TerminationGracePeriodSeconds)
+	}
+
 	commitSHA, displayRevision, err := s.resolveRevision(ctx, a, syncReq)
 	if err != nil {
 		return nil, status.Errorf(codes.FailedPrecondition, err.Error())
@@ -716,18 +1077,29 @@ func (s *Server) Sync(ctx context.Context, syncReq *ApplicationSyncRequest) (*ap
 
 	op := appv1.Operation{
 		Sync: &appv1.SyncOperation{
-			Revision:           commitSHA,
-			Prune:              syncReq.Prune,
-			DryRun:             syncReq.DryRun,
-			SyncStrategy:       syncReq.Strategy,
-			ParameterOverrides: parameterOverrides,
-			Resources:          syncReq.Resources,
+			Revision:                      commitSHA,
+			Prune:                         syncReq.Prune,
+			DryRun:                        syncReq.DryRun,
+			SyncStrategy:                  syncReq.Strategy,
+			ParameterOverrides:            parameterOverrides,
+			Resources:                     syncReq.Resources,
+			ConfirmCRDDeletion:            syncReq.ConfirmCRDDeletion,
+			PrunePropagationPolicy:        prunePropagationPolicy,
+			Retry:                         syncReq.Retry,
+			ApplyConcurrency:              syncReq.ApplyConcurrency,
+			ExcludedResources:             syncReq.ExcludedResources,
+			Preset:                        syncReq.Preset,
+			TerminationGracePeriodSeconds: syncReq.TerminationGracePeriodSeconds,
+			OverrideSyncWindows:           syncReq.OverrideSyncWindows,
 		},
+		CorrelationID:  grpc.CorrelationID(ctx),
+		Timeout:        syncReq.Timeout,
+		IdempotencyKey: syncReq.IdempotencyKey,
 	}
 	a, err = argo.SetAppOperation(appIf, *syncReq.Name, &op)
 	if err == nil {
 		partial := ""
-		if len(syncReq.Resources) > 0 {
+		if op.Sync.IsPartial() {
 			partial = "partial "
 		}
 		s.logEvent(a, ctx, argo.EventReasonOperationStarted, fmt.Sprintf("initiated %ssync to %s", partial, displayRevision))
@@ -735,6 +1107,25 @@ func (s *Server) Sync(ctx context.Context, syncReq *ApplicationSyncRequest) (*ap
 	return a, err
 }
 
+// getParameterPreset returns the parameter preset of the given name, which is defined by the
+// application or by its project
+func (s *Server) getParameterPreset(a *appv1.Application, name string) (*appv1.ParameterPreset, error) {
+	if preset := appv1.FindParameterPreset(a.Spec.ParameterPresets, name); preset != nil {
+		return preset, nil
+	}
+	proj, err := argo.GetAppProject(&a.Spec, s.appclientset, s.ns)
+	if err != nil {
+		if apierr.IsNotFound(err) {
+			return nil, status.Errorf(codes.InvalidArgument, "application referencing project %s which does not exist", a.Spec.Project)
+		}
+		return nil, err
+	}
+	if preset := appv1.FindParameterPreset(proj.Spec.ParameterPresets, name); preset != nil {
+		return preset, nil
+	}
+	return nil, status.Errorf(codes.InvalidArgument, "parameter preset '%s' is not defined by application '%s' or project '%s'", name, a.Name, proj.Name)
+}
+
 func (s *Server) Rollback(ctx context.Context, rollbackReq *ApplicationRollbackRequest) (*appv1.Application, error) {
 	appIf := s.appclientset.ArgoprojV1alpha1().Applications(s.ns)
 	a, err := appIf.Get(*rollbackReq.Name, metav1.GetOptions{})
@@ -744,6 +1135,9 @@ func (s *Server) Rollback(ctx context.Context, rollbackReq *ApplicationRollbackR
 	if !s.enf.Enforce(ctx.Value("claims"), rbacpolicy.ResourceApplications, rbacpolicy.ActionSync, appRBACName(*a)) {
 		return nil, grpc.ErrPermissionDenied
 	}
+	if argo.HasOperation(a, rollbackReq.IdempotencyKey) {
+		return a, nil
+	}
 	if a.DeletionTimestamp != nil {
 		return nil, status.Errorf(codes.FailedPrecondition, "application is deleting")
 	}
@@ -770,6 +1164,8 @@ func (s *Server) Rollback(ctx context.Context, rollbackReq *ApplicationRollbackR
 			SyncStrategy:       &appv1.SyncStrategy{Apply: &appv1.SyncStrategyApply{}},
 			ParameterOverrides: deploymentInfo.ComponentParameterOverrides,
 		},
+		CorrelationID:  grpc.CorrelationID(ctx),
+		IdempotencyKey: rollbackReq.IdempotencyKey,
 	}
 	a, err = argo.SetAppOperation(appIf, *rollbackReq.Name, &op)
 	if err == nil {
@@ -778,6 +1174,203 @@ func (s *Server) Rollback(ctx context.Context, rollbackReq *ApplicationRollbackR
 	return a, err
 }
 
+const (
+	moveActionCreate = "create"
+	moveActionUpdate = "update"
+	moveActionPrune  = "prune"
+	moveActionKeep   = "keep"
+)
+
+// Move moves an application to a new destination. The response lists the resources which are
+// created or updated in the new destination, and the resources which are pruned from the current
+// destination. Unless dry run, the destination of the application is changed and a sync is started,
+// which prunes the resources from the current destination once they are healthy in the new one.
+func (s *Server) Move(ctx context.Context, q *ApplicationMoveRequest) (*ApplicationMoveResponse, error) {
+	appIf := s.appclientset.ArgoprojV1alpha1().Applications(s.ns)
+	a, err := appIf.Get(*q.Name, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+	if !s.enf.Enforce(ctx.Value("claims"), rbacpolicy.ResourceApplications, rbacpolicy.ActionUpdate, appRBACName(*a)) ||
+		!s.enf.Enforce(ctx.Value("claims"), rbacpolicy.ResourceApplications, rbacpolicy.ActionSync, appRBACName(*a)) {
+		return nil, grpc.ErrPermissionDenied
+	}
+	from := a.Spec.Destination
+	if err := validateMove(a, q.Destination); err != nil {
+		return nil, err
+	}
+	prunePropagationPolicy := appv1.PropagationPolicy(q.PrunePropagationPolicy)
+	if _, err := prunePropagationPolicy.DeletionPropagation(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	spec := a.Spec.DeepCopy()
+	spec.Destination = q.Destination
+	if err := s.validateApp(ctx, spec); err != nil {
+		return nil, err
+	}
+	res, err := s.getMovePlan(a, q.Destination)
+	if err != nil {
+		return nil, err
+	}
+	if q.DryRun {
+		return res, nil
+	}
+
+	commitSHA, displayRevision, err := s.resolveRevision(ctx, a, &ApplicationSyncRequest{Name: q.Name})
+	if err != nil {
+		return nil, status.Error(codes.FailedPrecondition, err.Error())
+	}
+	op := appv1.Operation{
+		Sync: &appv1.SyncOperation{
+			Revision:               commitSHA,
+			Prune:                  q.Prune,
+			ConfirmCRDDeletion:     q.ConfirmCRDDeletion,
+			PrunePropagationPolicy: prunePropagationPolicy,
+			MoveFrom:               &from,
+		},
+		CorrelationID: grpc.CorrelationID(ctx),
+	}
+	// the destination and the operation are updated together, so that the controller never syncs the
+	// new destination without pruning the current one
+	for i := 0; i < 10; i++ {
+		a.Spec.Destination = q.Destination
+		a.Operation = &op
+		a, err = appIf.Update(a)
+		if err == nil {
+			s.logEvent(a, ctx, argo.EventReasonOperationStarted, fmt.Sprintf("initiated move from %s/%s to %s/%s at %s",
+				from.Server, from.Namespace, q.Destination.Server, q.Destination.Namespace, displayRevision))
+			res.Application = a
+			return res, nil
+		}
+		if !apierr.IsConflict(err) {
+			return nil, err
+		}
+		log.Warnf("Failed to move app '%s' due to update conflict. Retrying again...", *q.Name)
+		time.Sleep(100 * time.Millisecond)
+		a, err = appIf.Get(*q.Name, metav1.GetOptions{})
+		if err != nil {
+			return nil, err
+		}
+		if a.Spec.Destination != from {
+			return nil, status.Errorf(codes.Aborted, "destination of application '%s' changed while moving", a.Name)
+		}
+		if err := validateMove(a, q.Destination); err != nil {
+			return nil, err
+		}
+	}
+	return nil, status.Errorf(codes.Internal, "Failed to move app. Too many conflicts")
+}
+
+// validateMove returns an error if the application cannot be moved to the destination
+func validateMove(a *appv1.Application, dest appv1.ApplicationDestination) error {
+	if a.DeletionTimestamp != nil {
+		return status.Errorf(codes.FailedPrecondition, "application is deleting")
+	}
+	if a.Spec.HasAdditionalDestinations() {
+		return status.Errorf(codes.FailedPrecondition, "applications with additional destinations cannot be moved")
+	}
+	if a.Spec.Destination.SelectsClusters() {
+		return status.Errorf(codes.FailedPrecondition, "applications whose destination selects clusters cannot be moved")
+	}
+	if a.Operation != nil {
+		return status.Errorf(codes.FailedPrecondition, "another operation is already in progress")
+	}
+	if dest.Server == "" || dest.Namespace == "" {
+		return status.Errorf(codes.InvalidArgument, "destination server and namespace are required")
+	}
+	if dest.SelectsClusters() {
+		return status.Errorf(codes.InvalidArgument, "applications cannot be moved to destinations which select clusters")
+	}
+	if dest == a.Spec.Destination {
+		return status.Errorf(codes.InvalidArgument, "application '%s' is already deployed to %s/%s", a.Name, dest.Server, dest.Namespace)
+	}
+	return nil
+}
+
+// getMovePlan renders the application against the new destination, and lists the resources which
+// are created or updated in the new destination, and the resources which are pruned from the
+// current destination
+func (s *Server) getMovePlan(a *appv1.Application, dest appv1.ApplicationDestination) (*ApplicationMoveResponse, error) {
+	movedApp := a.DeepCopy()
+	movedApp.Spec.Destination = dest
+	targetRes, err := s.compareForMove(movedApp)
+	if err != nil {
+		return nil, err
+	}
+	sourceRes, err := s.compareForMove(a)
+	if err != nil {
+		return nil, err
+	}
+	plan := ApplicationMoveResponse{Target: make([]MovePlanResource, 0), Source: make([]MovePlanResource, 0)}
+	// resources which are managed in both destinations (e.g. cluster-scoped resources of a move
+	// between namespaces of the same cluster) are kept
+	managed := make(map[types.UID]bool)
+	for _, res := range targetRes {
+		targetObj, err := res.TargetObject()
+		if err != nil {
+			return nil, err
+		}
+		liveObj, err := res.LiveObject()
+		if err != nil {
+			return nil, err
+		}
+		if targetObj == nil {
+			continue
+		}
+		action := moveActionCreate
+		if liveObj != nil {
+			action = moveActionUpdate
+			if dest.Server == a.Spec.Destination.Server {
+				managed[liveObj.GetUID()] = true
+			}
+		}
+		plan.Target = append(plan.Target, newMovePlanResource(targetObj, dest.Namespace, action))
+	}
+	for _, res := range sourceRes {
+		liveObj, err := res.LiveObject()
+		if err != nil {
+			return nil, err
+		}
+		if liveObj == nil {
+			continue
+		}
+		action := moveActionPrune
+		if managed[liveObj.GetUID()] || argo.HasSyncOption(liveObj, common.SyncOptionDisablePrune) {
+			action = moveActionKeep
+		}
+		plan.Source = append(plan.Source, newMovePlanResource(liveObj, liveObj.GetNamespace(), action))
+	}
+	return &plan, nil
+}
+
+// compareForMove compares the application state in its destination, and returns its resources
+func (s *Server) compareForMove(a *appv1.Application) ([]appv1.ResourceState, error) {
+	_, _, resources, conditions, err := s.appComparator.CompareAppState(a, "", nil)
+	if err != nil {
+		return nil, err
+	}
+	for _, condition := range conditions {
+		if condition.IsError() {
+			return nil, status.Errorf(codes.FailedPrecondition, "failed to compare application state in %s/%s: %s",
+				a.Spec.Destination.Server, a.Spec.Destination.Namespace, condition.Message)
+		}
+	}
+	return resources, nil
+}
+
+func newMovePlanResource(obj *unstructured.Unstructured, namespace string, action string) MovePlanResource {
+	if obj.GetNamespace() != "" {
+		namespace = obj.GetNamespace()
+	}
+	return MovePlanResource{
+		Group:     obj.GroupVersionKind().Group,
+		Kind:      obj.GetKind(),
+		Namespace: namespace,
+		Name:      obj.GetName(),
+		Action:    action,
+	}
+}
+
 // resolveRevision resolves the git revision specified either in the sync request, or the
 // application source, into a concrete commit SHA that will be used for a sync operation.
 func (s *Server) resolveRevision(ctx context.Context, app *appv1.Application, syncReq *ApplicationSyncRequest) (string, string, error) {
@@ -785,16 +1378,15 @@ func (s *Server) resolveRevision(ctx context.Context, app *appv1.Application, sy
 	if ambiguousRevision == "" {
 		ambiguousRevision = app.Spec.Source.TargetRevision
 	}
+	if app.Spec.Source.IsHelmChart() {
+		// the versions of charts are not resolved, since the released versions of charts are not changed
+		return ambiguousRevision, ambiguousRevision, nil
+	}
 	if git.IsCommitSHA(ambiguousRevision) {
 		// If it's already a commit SHA, then no need to look it up
 		return ambiguousRevision, ambiguousRevision, nil
 	}
-	repo, err := s.db.GetRepository(ctx, app.Spec.Source.RepoURL)
-	if err != nil {
-		// If we couldn't retrieve from the repo service, assume public repositories
-		repo = &appv1.Repository{Repo: app.Spec.Source.RepoURL}
-	}
-	gitClient, err := s.gitFactory.NewClient(repo.Repo, "", repo.Username, repo.Password, repo.SSHPrivateKey)
+	gitClient, err := s.newGitClient(ctx, app.Spec.Source.RepoURL)
 	if err != nil {
 		return "", "", err
 	}
@@ -806,6 +1398,275 @@ func (s *Server) resolveRevision(ctx context.Context, app *appv1.Application, sy
 	return commitSHA, displayRevision, nil
 }
 
+// newGitClient returns a git client of the repository, using the credentials of the repository if
+// it is configured
+func (s *Server) newGitClient(ctx context.Context, repoURL string) (git.Client, error) {
+	repo, err := s.db.GetRepository(ctx, repoURL)
+	if err != nil {
+		// If we couldn't retrieve from the repo service, assume public repositories
+		repo = &appv1.Repository{Repo: repoURL}
+	}
+	return s.gitFactory.NewClient(repo.Repo, "", repo.Username, repo.Password, repo.SSHPrivateKey)
+}
+
+const (
+	revisionTypeBranch  = "Branch"
+	revisionTypeTag     = "Tag"
+	revisionTypeCommit  = "Commit"
+	revisionTypeHEAD    = "HEAD"
+	revisionTypeUnknown = "Unknown"
+)
+
+// revisionType returns the type of a target revision from the ref it resolved to, and whether the
+// revision is mutable, i.e. can move to other commits without changing the application
+func revisionType(targetRevision string, ref string, resolveErr error) (string, bool) {
+	switch {
+	case targetRevision == "" || targetRevision == "HEAD":
+		return revisionTypeHEAD, true
+	case strings.HasPrefix(ref, "refs/heads/"):
+		return revisionTypeBranch, true
+	case strings.HasPrefix(ref, "refs/tags/"):
+		return revisionTypeTag, false
+	case resolveErr == nil:
+		return revisionTypeCommit, false
+	default:
+		return revisionTypeUnknown, false
+	}
+}
+
+// resolvedRevision is the result of resolving a target revision of a repository
+type resolvedRevision struct {
+	commitSHA string
+	ref       string
+	err       error
+}
+
+// RevisionReport returns the target revisions of the applications, the commit SHAs they currently
+// resolve to, and whether they track mutable refs (branches or HEAD) or are pinned to tags or commits
+func (s *Server) RevisionReport(ctx context.Context, q *ApplicationQuery) (*RevisionReportResponse, error) {
+	appList, err := s.List(ctx, q)
+	if err != nil {
+		return nil, err
+	}
+	// applications often share the repository and revision, which are resolved only once
+	resolved := make(map[string]resolvedRevision)
+	report := RevisionReportResponse{Items: make([]RevisionReportEntry, 0)}
+	for _, a := range appList.Items {
+		source := a.Spec.Source
+		key := fmt.Sprintf("%s@%s", git.NormalizeGitURL(source.RepoURL), source.TargetRevision)
+		res, ok := resolved[key]
+		if !ok && source.IsHelmChart() {
+			// the versions of charts are not changed once released, like tags
+			res = resolvedRevision{commitSHA: source.TargetRevision, ref: "refs/tags/" + source.TargetRevision}
+			resolved[key] = res
+		} else if !ok {
+			var gitClient git.Client
+			gitClient, res.err = s.newGitClient(ctx, source.RepoURL)
+			if res.err == nil {
+				res.commitSHA, res.ref, res.err = gitClient.LsRemoteRef(source.TargetRevision)
+			}
+			resolved[key] = res
+		}
+		entry := RevisionReportEntry{
+			Name:             a.Name,
+			Project:          a.Spec.GetProject(),
+			RepoURL:          source.RepoURL,
+			TargetRevision:   source.TargetRevision,
+			ResolvedRevision: res.commitSHA,
+		}
+		entry.RevisionType, entry.Mutable = revisionType(source.TargetRevision, res.ref, res.err)
+		if res.err != nil {
+			entry.Error = res.err.Error()
+		}
+		if n := len(a.Status.History); n > 0 {
+			entry.DeployedRevision = a.Status.History[n-1].Revision
+		}
+		report.Items = append(report.Items, entry)
+	}
+	return &report, nil
+}
+
+// defaultSummaryOperationsLimit is the number of failed and of running operations in summaries by default
+const defaultSummaryOperationsLimit = 10
+
+// Summary returns the numbers of applications by sync status, health, project and destination cluster,
+// the latest failed operations, and the longest running operations. The applications are aggregated by
+// the server, so that clients of large installations do not need to list all of them.
+func (s *Server) Summary(ctx context.Context, q *ApplicationSummaryQuery) (*ApplicationSummaryResponse, error) {
+	if q.Limit < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "limit must not be negative")
+	}
+	appList, err := s.List(ctx, &ApplicationQuery{Projects: q.Projects})
+	if err != nil {
+		return nil, err
+	}
+	limit := int(q.Limit)
+	if limit == 0 {
+		limit = defaultSummaryOperationsLimit
+	}
+	return summarize(appList.Items, limit, time.Now()), nil
+}
+
+// summarize aggregates the applications, and lists up to limit of their failed and of their running operations
+func summarize(apps []appv1.Application, limit int, now time.Time) *ApplicationSummaryResponse {
+	syncStatuses := make(map[string]int64)
+	healthStatuses := make(map[string]int64)
+	projects := make(map[string]int64)
+	clusters := make(map[string]int64)
+	failed := make([]ApplicationSummaryOperation, 0)
+	running := make([]ApplicationSummaryOperation, 0)
+	for _, a := range apps {
+		syncStatuses[valueOrUnknown(string(a.Status.ComparisonResult.Status))]++
+		healthStatuses[valueOrUnknown(a.Status.Health.Status)]++
+		projects[a.Spec.GetProject()]++
+		clusters[a.Spec.Destination.Server]++
+
+		opState := a.Status.OperationState
+		if opState == nil {
+			continue
+		}
+		op := ApplicationSummaryOperation{
+			Name:      a.Name,
+			Project:   a.Spec.GetProject(),
+			Phase:     string(opState.Phase),
+			Message:   opState.Message,
+			StartedAt: opState.StartedAt.UTC().Format(time.RFC3339),
+		}
+		finishedAt := now
+		if opState.FinishedAt != nil {
+			finishedAt = opState.FinishedAt.Time
+			op.FinishedAt = finishedAt.UTC().Format(time.RFC3339)
+		}
+		op.Duration = int64(finishedAt.Sub(opState.StartedAt.Time) / time.Second)
+		switch opState.Phase {
+		case appv1.OperationFailed, appv1.OperationError:
+			failed = append(failed, op)
+		case appv1.OperationRunning, appv1.OperationTerminating:
+			running = append(running, op)
+		}
+	}
+	// the timestamps are in RFC3339 format in UTC, which sorts chronologically
+	sort.SliceStable(failed, func(i, j int) bool {
+		return failed[i].FinishedAt > failed[j].FinishedAt
+	})
+	sort.SliceStable(running, func(i, j int) bool {
+		return running[i].Duration > running[j].Duration
+	})
+	if len(failed) > limit {
+		failed = failed[:limit]
+	}
+	if len(running) > limit {
+		running = running[:limit]
+	}
+	return &ApplicationSummaryResponse{
+		Total:             int64(len(apps)),
+		SyncStatuses:      summaryCounts(syncStatuses),
+		HealthStatuses:    summaryCounts(healthStatuses),
+		Projects:          summaryCounts(projects),
+		Clusters:          summaryCounts(clusters),
+		FailedOperations:  failed,
+		RunningOperations: running,
+	}
+}
+
+// summaryCounts returns the counts of the keys, most frequent first
+func summaryCounts(counts map[string]int64) []ApplicationSummaryCount {
+	res := make([]ApplicationSummaryCount, 0, len(counts))
+	for key, count := range counts {
+		res = append(res, ApplicationSummaryCount{Key: key, Count: count})
+	}
+	sort.Slice(res, func(i, j int) bool {
+		if res[i].Count != res[j].Count {
+			return res[i].Count > res[j].Count
+		}
+		return res[i].Key < res[j].Key
+	})
+	return res
+}
+
+// valueOrUnknown returns the value, or Unknown if it is blank
+func valueOrUnknown(value string) string {
+	if value == "" {
+		return appv1.HealthStatusUnknown
+	}
+	return value
+}
+
+// Diff returns the diffs of the resources of an application against the manifests of its target revision,
+// or of the revision of the query. Each resource diff pairs the normalized live state of the resource
+// with its live state predicted after a sync, so that clients can review the changes of a sync.
+func (s *Server) Diff(ctx context.Context, q *ApplicationDiffQuery) (*ApplicationDiffResponse, error) {
+	a, err := s.appclientset.ArgoprojV1alpha1().Applications(s.ns).Get(*q.Name, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+	if !s.enf.Enforce(ctx.Value("claims"), rbacpolicy.ResourceApplications, rbacpolicy.ActionGet, appRBACName(*a)) {
+		return nil, grpc.ErrPermissionDenied
+	}
+	_, manifestInfo, resources, conditions, err := s.appComparator.CompareAppState(a, q.Revision, nil)
+	if err != nil {
+		return nil, err
+	}
+	for _, condition := range conditions {
+		if condition.IsError() {
+			return nil, status.Errorf(codes.FailedPrecondition, "failed to compare application state: %s", condition.Message)
+		}
+	}
+	redactor, err := s.getRedactor(ctx, a)
+	if err != nil {
+		return nil, err
+	}
+	targetObjs := make([]*unstructured.Unstructured, len(resources))
+	liveObjs := make([]*unstructured.Unstructured, len(resources))
+	for i := range resources {
+		if targetObjs[i], err = resources[i].TargetObject(); err != nil {
+			return nil, err
+		}
+		if liveObjs[i], err = resources[i].LiveObject(); err != nil {
+			return nil, err
+		}
+	}
+	diffResults, err := s.appComparator.DiffResources(a, targetObjs, liveObjs)
+	if err != nil {
+		return nil, err
+	}
+	res := ApplicationDiffResponse{Items: make([]ResourceDiff, len(resources))}
+	if manifestInfo != nil {
+		res.Revision = manifestInfo.Revision
+	}
+	for i, resState := range resources {
+		normalizedLive := diffResults.Diffs[i].NormalizedLive
+		if targetObjs[i] == nil && liveObjs[i] != nil {
+			// the diff of a pruned resource drops the fields of the live object, which is removed as a whole
+			normalizedLive = liveObjs[i].Object
+		}
+		liveState, err := json.Marshal(normalizedLive)
+		if err != nil {
+			return nil, err
+		}
+		predictedLiveState, err := json.Marshal(diffResults.Diffs[i].PredictedLive)
+		if err != nil {
+			return nil, err
+		}
+		item := ResourceDiff{Status: string(resState.Status)}
+		item.LiveState, item.PredictedLiveState = redactor.RedactStates(string(liveState), string(predictedLiveState))
+		// live resources have the namespaces which the manifests may omit
+		obj := liveObjs[i]
+		if obj == nil {
+			obj = targetObjs[i]
+		}
+		if obj != nil {
+			gvk := obj.GroupVersionKind()
+			item.Group, item.Kind, item.Namespace, item.Name = gvk.Group, gvk.Kind, obj.GetNamespace(), obj.GetName()
+		}
+		if resState.Status == appv1.ComparisonStatusOutOfSync {
+			res.Modified = true
+		}
+		res.Items[i] = item
+	}
+	return &res, nil
+}
+
 func (s *Server) TerminateOperation(ctx context.Context, termOpReq *OperationTerminateRequest) (*OperationTerminateResponse, error) {
 	a, err := s.appclientset.ArgoprojV1alpha1().Applications(s.ns).Get(*termOpReq.Name, metav1.GetOptions{})
 	if err != nil {
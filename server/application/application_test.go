@@ -2,19 +2,28 @@ package application
 
 import (
 	"context"
+	"fmt"
 	"testing"
 	"time"
 
 	"github.com/ghodss/yaml"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/rest"
 	kubetesting "k8s.io/client-go/testing"
 
 	"github.com/argoproj/argo-cd/common"
+	"github.com/argoproj/argo-cd/controller"
+	"github.com/argoproj/argo-cd/controller/services"
 	"github.com/argoproj/argo-cd/errors"
 	appsv1 "github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1"
 	apps "github.com/argoproj/argo-cd/pkg/client/clientset/versioned/fake"
@@ -24,6 +33,8 @@ import (
 	"github.com/argoproj/argo-cd/test"
 	"github.com/argoproj/argo-cd/util"
 	"github.com/argoproj/argo-cd/util/db"
+	"github.com/argoproj/argo-cd/util/diff"
+	"github.com/argoproj/argo-cd/util/git"
 	"github.com/argoproj/argo-cd/util/kube"
 	"github.com/argoproj/argo-cd/util/rbac"
 	"github.com/argoproj/argo-cd/util/settings"
@@ -80,6 +91,15 @@ func fakeListDirResponse() *repository.FileList {
 	}
 }
 
+func fakeManifestResponse() *repository.ManifestResponse {
+	return &repository.ManifestResponse{
+		Manifests: []string{
+			`{"apiVersion":"v1","kind":"ConfigMap","metadata":{"name":"my-config"}}`,
+			`{"apiVersion":"v1","kind":"Secret","metadata":{"name":"my-secret"},"data":{"password":"cGFzc3dvcmQ="}}`,
+		},
+	}
+}
+
 // return an ApplicationServiceServer which returns fake data
 func newTestAppServer() *Server {
 	kubeclientset := fake.NewSimpleClientset(&v1.ConfigMap{
@@ -97,7 +117,8 @@ func newTestAppServer() *Server {
 	enforcer := rbac.NewEnforcer(kubeclientset, testNamespace, common.ArgoCDRBACConfigMapName, nil)
 	enforcer.SetBuiltinPolicy(test.BuiltinPolicy)
 	enforcer.SetDefaultRole("role:admin")
-	db := db.NewDB(testNamespace, settings.NewSettingsManager(kubeclientset, testNamespace), kubeclientset)
+	settingsMgr := settings.NewSettingsManager(kubeclientset, testNamespace)
+	db := db.NewDB(testNamespace, settingsMgr, kubeclientset)
 	ctx := context.Background()
 	_, err := db.CreateRepository(ctx, fakeRepo())
 	errors.CheckError(err)
@@ -107,6 +128,7 @@ func newTestAppServer() *Server {
 	mockRepoServiceClient := mockreposerver.RepositoryServiceClient{}
 	mockRepoServiceClient.On("GetFile", mock.Anything, mock.Anything).Return(fakeFileResponse(), nil)
 	mockRepoServiceClient.On("ListDir", mock.Anything, mock.Anything).Return(fakeListDirResponse(), nil)
+	mockRepoServiceClient.On("GenerateManifest", mock.Anything, mock.Anything).Return(fakeManifestResponse(), nil)
 
 	mockRepoClient := &mockrepo.Clientset{}
 	mockRepoClient.On("NewRepositoryClient").Return(&fakeCloser{}, &mockRepoServiceClient, nil)
@@ -129,6 +151,7 @@ func newTestAppServer() *Server {
 		db,
 		enforcer,
 		util.NewKeyLock(),
+		settingsMgr,
 	)
 	return server.(*Server)
 }
@@ -213,6 +236,36 @@ func TestDeleteApp(t *testing.T) {
 	assert.True(t, deleted)
 }
 
+func TestDeleteAppPropagationPolicy(t *testing.T) {
+	ctx := context.Background()
+	appServer := newTestAppServer()
+	app, err := appServer.Create(ctx, &ApplicationCreateRequest{Application: *newTestApp()})
+	assert.Nil(t, err)
+
+	fakeAppCs := appServer.appclientset.(*apps.Clientset)
+	fakeAppCs.ReactionChain = nil
+	var patch string
+	fakeAppCs.AddReactor("patch", "applications", func(action kubetesting.Action) (handled bool, ret runtime.Object, err error) {
+		patch = string(action.(kubetesting.PatchAction).GetPatch())
+		return true, nil, nil
+	})
+	fakeAppCs.AddReactor("delete", "applications", func(action kubetesting.Action) (handled bool, ret runtime.Object, err error) {
+		return true, nil, nil
+	})
+	fakeAppCs.AddReactor("get", "applications", func(action kubetesting.Action) (handled bool, ret runtime.Object, err error) {
+		return true, app, nil
+	})
+
+	_, err = appServer.Delete(ctx, &ApplicationDeleteRequest{Name: &app.Name, PropagationPolicy: "orphan"})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	_, err = appServer.Delete(ctx, &ApplicationDeleteRequest{Name: &app.Name, PropagationPolicy: "eventually"})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	_, err = appServer.Delete(ctx, &ApplicationDeleteRequest{Name: &app.Name, PropagationPolicy: "Background"})
+	assert.Nil(t, err)
+	assert.Contains(t, patch, `"argocd.argoproj.io/deletion-propagation-policy":"background"`)
+}
+
 func TestSyncAndTerminate(t *testing.T) {
 	ctx := context.Background()
 	appServer := newTestAppServer()
@@ -253,3 +306,540 @@ func TestSyncAndTerminate(t *testing.T) {
 	assert.NotNil(t, app)
 	assert.Equal(t, appsv1.OperationTerminating, app.Status.OperationState.Phase)
 }
+
+func TestGetManifestsRedacted(t *testing.T) {
+	ctx := context.Background()
+	appServer := newTestAppServer()
+	app, err := appServer.Create(ctx, &ApplicationCreateRequest{Application: *newTestApp()})
+	assert.Nil(t, err)
+
+	res, err := appServer.GetManifests(ctx, &ApplicationManifestQuery{Name: &app.Name})
+	assert.Nil(t, err)
+	assert.Len(t, res.Manifests, 2)
+	assert.Equal(t, fakeManifestResponse().Manifests[0], res.Manifests[0])
+	assert.NotContains(t, res.Manifests[1], "cGFzc3dvcmQ=")
+}
+
+func TestGetManifestsUnredacted(t *testing.T) {
+	ctx := context.Background()
+	appServer := newTestAppServer()
+	// roles permitted to unredact applications see the full manifests
+	err := appServer.enf.SetUserPolicy("p, role:admin, applications, unredact, */*, allow")
+	assert.Nil(t, err)
+	app, err := appServer.Create(ctx, &ApplicationCreateRequest{Application: *newTestApp()})
+	assert.Nil(t, err)
+
+	res, err := appServer.GetManifests(ctx, &ApplicationManifestQuery{Name: &app.Name})
+	assert.Nil(t, err)
+	assert.Equal(t, fakeManifestResponse().Manifests, res.Manifests)
+}
+
+func TestGetAppInvalidRefreshType(t *testing.T) {
+	ctx := context.Background()
+	appServer := newTestAppServer()
+	app, err := appServer.Create(ctx, &ApplicationCreateRequest{Application: *newTestApp()})
+	assert.Nil(t, err)
+	_, err = appServer.Get(ctx, &ApplicationQuery{Name: &app.Name, RefreshType: "soft"})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestSyncUndefinedPreset(t *testing.T) {
+	ctx := context.Background()
+	appServer := newTestAppServer()
+	testApp := newTestApp()
+	testApp.Spec.ParameterPresets = []appsv1.ParameterPreset{{Name: "canary"}}
+	app, err := appServer.Create(ctx, &ApplicationCreateRequest{Application: *testApp})
+	assert.Nil(t, err)
+	_, err = appServer.Sync(ctx, &ApplicationSyncRequest{Name: &app.Name, Preset: "blue"})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestSyncIdempotencyKey(t *testing.T) {
+	ctx := context.Background()
+	appServer := newTestAppServer()
+	testApp := newTestApp()
+	testApp.Spec.Source.TargetRevision = "a67038ae2e9cb9b9b16423702f98b41e36601001"
+	app, err := appServer.Create(ctx, &ApplicationCreateRequest{Application: *testApp})
+	assert.Nil(t, err)
+
+	app, err = appServer.Sync(ctx, &ApplicationSyncRequest{Name: &app.Name, IdempotencyKey: "ci-build-1"})
+	assert.Nil(t, err)
+	assert.Equal(t, "ci-build-1", app.Operation.IdempotencyKey)
+	correlationID := app.Operation.CorrelationID
+
+	// a retried request returns the operation in progress
+	app, err = appServer.Sync(ctx, &ApplicationSyncRequest{Name: &app.Name, IdempotencyKey: "ci-build-1", Prune: true})
+	assert.Nil(t, err)
+	assert.Equal(t, correlationID, app.Operation.CorrelationID)
+	assert.False(t, app.Operation.Sync.Prune)
+
+	_, err = appServer.Sync(ctx, &ApplicationSyncRequest{Name: &app.Name, IdempotencyKey: "ci-build-2"})
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+}
+
+type fakeGitClientFactory struct {
+	refs map[string]string
+}
+
+func (f *fakeGitClientFactory) NewClient(repoURL, path, username, password, sshPrivateKey string) (git.Client, error) {
+	return &fakeGitClient{refs: f.refs}, nil
+}
+
+// fakeGitClient resolves revisions to the refs of the factory
+type fakeGitClient struct {
+	git.Client
+	refs map[string]string
+}
+
+func (c *fakeGitClient) LsRemoteRef(revision string) (string, string, error) {
+	if git.IsCommitSHA(revision) {
+		return revision, "", nil
+	}
+	ref, ok := c.refs[revision]
+	if !ok {
+		return "", "", fmt.Errorf("Unable to resolve '%s' to a commit SHA", revision)
+	}
+	return "4e22a3cb21fa447ca362a05a505a69397c8a0d44", ref, nil
+}
+
+func TestRevisionReport(t *testing.T) {
+	ctx := context.Background()
+	appServer := newTestAppServer()
+	appServer.gitFactory = &fakeGitClientFactory{refs: map[string]string{
+		"HEAD":   "HEAD",
+		"master": "refs/heads/master",
+		"v1.0":   "refs/tags/v1.0",
+	}}
+	revisions := map[string]string{
+		"head":    "HEAD",
+		"branch":  "master",
+		"tag":     "v1.0",
+		"commit":  "a67038ae2e9cb9b9b16423702f98b41e36601001",
+		"missing": "missing",
+	}
+	for name, revision := range revisions {
+		testApp := newTestApp()
+		testApp.Name = name
+		testApp.Spec.Source.TargetRevision = revision
+		_, err := appServer.Create(ctx, &ApplicationCreateRequest{Application: *testApp})
+		assert.Nil(t, err)
+	}
+
+	report, err := appServer.RevisionReport(ctx, &ApplicationQuery{})
+	assert.Nil(t, err)
+	assert.Len(t, report.Items, len(revisions))
+	entries := make(map[string]RevisionReportEntry)
+	for _, entry := range report.Items {
+		entries[entry.Name] = entry
+	}
+
+	assert.Equal(t, revisionTypeHEAD, entries["head"].RevisionType)
+	assert.True(t, entries["head"].Mutable)
+	assert.Equal(t, "4e22a3cb21fa447ca362a05a505a69397c8a0d44", entries["head"].ResolvedRevision)
+	assert.Equal(t, revisionTypeBranch, entries["branch"].RevisionType)
+	assert.True(t, entries["branch"].Mutable)
+	assert.Equal(t, revisionTypeTag, entries["tag"].RevisionType)
+	assert.False(t, entries["tag"].Mutable)
+	assert.Equal(t, revisionTypeCommit, entries["commit"].RevisionType)
+	assert.False(t, entries["commit"].Mutable)
+	assert.Equal(t, "a67038ae2e9cb9b9b16423702f98b41e36601001", entries["commit"].ResolvedRevision)
+	assert.Equal(t, revisionTypeUnknown, entries["missing"].RevisionType)
+	assert.NotEmpty(t, entries["missing"].Error)
+}
+
+// fakeAppComparator returns the resources of the application per destination namespace
+type fakeAppComparator struct {
+	controller.AppStateManager
+	resources map[string][]appsv1.ResourceState
+}
+
+func (c *fakeAppComparator) CompareAppState(app *appsv1.Application, revision string, overrides []appsv1.ComponentParameter) (
+	*appsv1.ComparisonResult, *repository.ManifestResponse, []appsv1.ResourceState, []appsv1.ApplicationCondition, error) {
+	return &appsv1.ComparisonResult{}, &repository.ManifestResponse{Revision: revision}, c.resources[app.Spec.Destination.Namespace], nil, nil
+}
+
+func (c *fakeAppComparator) DiffResources(app *appsv1.Application, targetObjs, liveObjs []*unstructured.Unstructured) (*diff.DiffResultList, error) {
+	return diff.DiffArray(targetObjs, liveObjs, nil)
+}
+
+func TestDiff(t *testing.T) {
+	ctx := context.Background()
+	appServer := newTestAppServer()
+	appServer.appComparator = &fakeAppComparator{resources: map[string][]appsv1.ResourceState{
+		"dummy-namespace": {{
+			TargetState: `{"apiVersion":"v1","kind":"ConfigMap","metadata":{"name":"my-config"},"data":{"key":"value"}}`,
+			LiveState:   `{"apiVersion":"v1","kind":"ConfigMap","metadata":{"name":"my-config","namespace":"dummy-namespace","uid":"1"},"data":{"key":"value"}}`,
+			Status:      appsv1.ComparisonStatusSynced,
+		}, {
+			TargetState: `{"apiVersion":"v1","kind":"ConfigMap","metadata":{"name":"changed-config"},"data":{"key":"new"}}`,
+			LiveState:   `{"apiVersion":"v1","kind":"ConfigMap","metadata":{"name":"changed-config","namespace":"dummy-namespace","uid":"2"},"data":{"key":"old"}}`,
+			Status:      appsv1.ComparisonStatusOutOfSync,
+		}, {
+			TargetState: `{"apiVersion":"rbac.authorization.k8s.io/v1","kind":"ClusterRole","metadata":{"name":"reader"}}`,
+			LiveState:   "null",
+			Status:      appsv1.ComparisonStatusOutOfSync,
+		}, {
+			TargetState: "null",
+			LiveState:   `{"apiVersion":"v1","kind":"ConfigMap","metadata":{"name":"old-config","namespace":"dummy-namespace","uid":"3"},"data":{"key":"value"}}`,
+			Status:      appsv1.ComparisonStatusOutOfSync,
+		}},
+	}}
+	app, err := appServer.Create(ctx, &ApplicationCreateRequest{Application: *newTestApp()})
+	assert.Nil(t, err)
+
+	res, err := appServer.Diff(ctx, &ApplicationDiffQuery{Name: &app.Name, Revision: "v1.0.0"})
+	assert.Nil(t, err)
+	assert.Equal(t, "v1.0.0", res.Revision)
+	assert.True(t, res.Modified)
+	assert.Len(t, res.Items, 4)
+	assert.Equal(t, ResourceDiff{
+		Kind:               "ConfigMap",
+		Namespace:          "dummy-namespace",
+		Name:               "my-config",
+		Status:             appsv1.ComparisonStatusSynced,
+		LiveState:          `{"apiVersion":"v1","data":{"key":"value"},"kind":"ConfigMap","metadata":{"name":"my-config"}}`,
+		PredictedLiveState: `{"apiVersion":"v1","data":{"key":"value"},"kind":"ConfigMap","metadata":{"name":"my-config"}}`,
+	}, res.Items[0])
+	assert.Contains(t, res.Items[1].LiveState, `"key":"old"`)
+	assert.Contains(t, res.Items[1].PredictedLiveState, `"key":"new"`)
+	assert.Equal(t, "rbac.authorization.k8s.io", res.Items[2].Group)
+	assert.Equal(t, "null", res.Items[2].LiveState)
+	// the whole live state of a pruned resource is returned
+	assert.Equal(t, "old-config", res.Items[3].Name)
+	assert.Contains(t, res.Items[3].LiveState, `"key":"value"`)
+	assert.Equal(t, "null", res.Items[3].PredictedLiveState)
+}
+
+func TestMove(t *testing.T) {
+	ctx := context.Background()
+	appServer := newTestAppServer()
+	appServer.appComparator = &fakeAppComparator{resources: map[string][]appsv1.ResourceState{
+		"dummy-namespace": {{
+			TargetState: `{"apiVersion":"v1","kind":"ConfigMap","metadata":{"name":"my-config"}}`,
+			LiveState:   `{"apiVersion":"v1","kind":"ConfigMap","metadata":{"name":"my-config","namespace":"dummy-namespace","uid":"1"}}`,
+		}, {
+			TargetState: `{"apiVersion":"rbac.authorization.k8s.io/v1","kind":"ClusterRole","metadata":{"name":"reader"}}`,
+			LiveState:   `{"apiVersion":"rbac.authorization.k8s.io/v1","kind":"ClusterRole","metadata":{"name":"reader","uid":"2"}}`,
+		}},
+		"new-namespace": {{
+			TargetState: `{"apiVersion":"v1","kind":"ConfigMap","metadata":{"name":"my-config"}}`,
+		}, {
+			TargetState: `{"apiVersion":"rbac.authorization.k8s.io/v1","kind":"ClusterRole","metadata":{"name":"reader"}}`,
+			LiveState:   `{"apiVersion":"rbac.authorization.k8s.io/v1","kind":"ClusterRole","metadata":{"name":"reader","uid":"2"}}`,
+		}},
+	}}
+	testApp := newTestApp()
+	testApp.Spec.Source.TargetRevision = "a67038ae2e9cb9b9b16423702f98b41e36601001"
+	app, err := appServer.Create(ctx, &ApplicationCreateRequest{Application: *testApp})
+	assert.Nil(t, err)
+	from := app.Spec.Destination
+	dest := appsv1.ApplicationDestination{Server: from.Server, Namespace: "new-namespace"}
+
+	_, err = appServer.Move(ctx, &ApplicationMoveRequest{Name: &app.Name, Destination: from})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	plan, err := appServer.Move(ctx, &ApplicationMoveRequest{Name: &app.Name, Destination: dest, DryRun: true})
+	assert.Nil(t, err)
+	assert.Nil(t, plan.Application)
+	assert.Equal(t, []MovePlanResource{
+		{Kind: "ConfigMap", Namespace: "new-namespace", Name: "my-config", Action: moveActionCreate},
+		{Group: "rbac.authorization.k8s.io", Kind: "ClusterRole", Namespace: "new-namespace", Name: "reader", Action: moveActionUpdate},
+	}, plan.Target)
+	// the cluster role is managed in the new destination as well, and is kept
+	assert.Equal(t, []MovePlanResource{
+		{Kind: "ConfigMap", Namespace: "dummy-namespace", Name: "my-config", Action: moveActionPrune},
+		{Group: "rbac.authorization.k8s.io", Kind: "ClusterRole", Name: "reader", Action: moveActionKeep},
+	}, plan.Source)
+	app, err = appServer.Get(ctx, &ApplicationQuery{Name: &app.Name})
+	assert.Nil(t, err)
+	assert.Equal(t, from, app.Spec.Destination)
+	assert.Nil(t, app.Operation)
+
+	res, err := appServer.Move(ctx, &ApplicationMoveRequest{Name: &app.Name, Destination: dest})
+	assert.Nil(t, err)
+	assert.Equal(t, dest, res.Application.Spec.Destination)
+	assert.Equal(t, &from, res.Application.Operation.Sync.MoveFrom)
+	assert.Equal(t, "a67038ae2e9cb9b9b16423702f98b41e36601001", res.Application.Operation.Sync.Revision)
+
+	// the application cannot be moved while the move is in progress
+	_, err = appServer.Move(ctx, &ApplicationMoveRequest{Name: &app.Name, Destination: from})
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+}
+
+func TestPatchResourcePermissionDenied(t *testing.T) {
+	ctx := context.Background()
+	appServer := newTestAppServer()
+	app, err := appServer.Create(ctx, &ApplicationCreateRequest{Application: *newTestApp()})
+	assert.Nil(t, err)
+
+	// patching live resources is not granted to the built-in admin role
+	_, err = appServer.PatchResource(ctx, &ApplicationPatchResourceRequest{
+		Name:         &app.Name,
+		ResourceName: "guestbook-ui",
+		APIVersion:   "apps/v1",
+		Kind:         "Deployment",
+		Patch:        `{"spec": {"replicas": 0}}`,
+	})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	err = appServer.enf.SetUserPolicy("p, role:admin, applications, patch, */*, allow")
+	assert.Nil(t, err)
+	_, err = appServer.PatchResource(ctx, &ApplicationPatchResourceRequest{
+		Name:         &app.Name,
+		ResourceName: "guestbook-ui",
+		APIVersion:   "apps/v1",
+		Kind:         "Deployment",
+		Patch:        `{"spec": {"replicas": 0}}`,
+		PatchType:    "yaml",
+	})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+// fakeControllerClientset returns the given resources as the resources of any application
+type fakeControllerClientset struct {
+	services.ApplicationServiceClient
+	resources []*appsv1.ResourceState
+}
+
+func (c *fakeControllerClientset) NewApplicationServiceClient() (util.Closer, services.ApplicationServiceClient, error) {
+	return &fakeCloser{}, c, nil
+}
+
+func (c *fakeControllerClientset) Resources(ctx context.Context, in *services.ResourcesQuery, opts ...grpc.CallOption) (*services.ResourcesResponse, error) {
+	return &services.ResourcesResponse{Items: c.resources}, nil
+}
+
+// patchRecordingKubectl records the namespaces of the patched resources
+type patchRecordingKubectl struct {
+	kube.KubectlCmd
+	namespaces map[string]string
+}
+
+func (k patchRecordingKubectl) PatchResource(config *rest.Config, obj *unstructured.Unstructured, namespace string, patchType types.PatchType, patch []byte) (*unstructured.Unstructured, error) {
+	k.namespaces[obj.GetName()] = namespace
+	return obj, nil
+}
+
+func TestPatchResourceOutsideOfDestinationNamespace(t *testing.T) {
+	ctx := context.Background()
+	appServer := newTestAppServer()
+	app, err := appServer.Create(ctx, &ApplicationCreateRequest{Application: *newTestApp()})
+	assert.Nil(t, err)
+	err = appServer.enf.SetUserPolicy("p, role:admin, applications, patch, */*, allow")
+	assert.Nil(t, err)
+
+	kubectl := patchRecordingKubectl{namespaces: map[string]string{}}
+	appServer.kubectl = kubectl
+	appServer.controllerClientset = &fakeControllerClientset{resources: []*appsv1.ResourceState{{
+		LiveState: `{"apiVersion":"v1","kind":"ConfigMap","metadata":{"name":"my-config","namespace":"other-namespace"}}`,
+	}, {
+		LiveState: `{"apiVersion":"v1","kind":"Namespace","metadata":{"name":"my-namespace"}}`,
+	}}}
+
+	for kind, name := range map[string]string{"ConfigMap": "my-config", "Namespace": "my-namespace"} {
+		_, err = appServer.PatchResource(ctx, &ApplicationPatchResourceRequest{
+			Name:         &app.Name,
+			ResourceName: name,
+			APIVersion:   "v1",
+			Kind:         kind,
+			Patch:        `{"metadata": {"labels": {"foo": "bar"}}}`,
+		})
+		assert.Nil(t, err)
+	}
+	// the namespace of the resource is patched rather than the one of the destination of the application
+	assert.Equal(t, map[string]string{"my-config": "other-namespace", "my-namespace": ""}, kubectl.namespaces)
+}
+
+func TestHistory(t *testing.T) {
+	ctx := context.Background()
+	appServer := newTestAppServer()
+	testApp := newTestApp()
+	start := time.Date(2019, 1, 1, 0, 0, 0, 0, time.UTC)
+	for i := 0; i < 5; i++ {
+		testApp.Status.History = append(testApp.Status.History, appsv1.DeploymentInfo{
+			ID:         int64(i),
+			DeployedAt: metav1.NewTime(start.Add(time.Duration(i) * 24 * time.Hour)),
+		})
+	}
+	app, err := appServer.Create(ctx, &ApplicationCreateRequest{Application: *testApp})
+	assert.Nil(t, err)
+
+	ids := func(res *ApplicationHistoryResponse) []int64 {
+		var ids []int64
+		for _, info := range res.Items {
+			ids = append(ids, info.ID)
+		}
+		return ids
+	}
+
+	res, err := appServer.History(ctx, &ApplicationHistoryQuery{Name: &app.Name})
+	assert.Nil(t, err)
+	assert.Equal(t, []int64{4, 3, 2, 1, 0}, ids(res))
+	assert.Equal(t, int64(5), res.Total)
+
+	res, err = appServer.History(ctx, &ApplicationHistoryQuery{Name: &app.Name, Since: "2019-01-02T00:00:00Z", Until: "2019-01-05T00:00:00Z"})
+	assert.Nil(t, err)
+	assert.Equal(t, []int64{3, 2, 1}, ids(res))
+	assert.Equal(t, int64(3), res.Total)
+
+	res, err = appServer.History(ctx, &ApplicationHistoryQuery{Name: &app.Name, Limit: 2, Offset: 1})
+	assert.Nil(t, err)
+	assert.Equal(t, []int64{3, 2}, ids(res))
+	assert.Equal(t, int64(5), res.Total)
+
+	res, err = appServer.History(ctx, &ApplicationHistoryQuery{Name: &app.Name, Offset: 10})
+	assert.Nil(t, err)
+	assert.Empty(t, res.Items)
+
+	_, err = appServer.History(ctx, &ApplicationHistoryQuery{Name: &app.Name, Since: "yesterday"})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	_, err = appServer.History(ctx, &ApplicationHistoryQuery{Name: &app.Name, Limit: -1})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestOperationHistory(t *testing.T) {
+	ctx := context.Background()
+	appServer := newTestAppServer()
+	testApp := newTestApp()
+	start := time.Date(2019, 1, 1, 0, 0, 0, 0, time.UTC)
+	phases := []appsv1.OperationPhase{appsv1.OperationSucceeded, appsv1.OperationFailed, appsv1.OperationSucceeded, appsv1.OperationError, appsv1.OperationFailed}
+	for i, phase := range phases {
+		testApp.Status.OperationHistory = append(testApp.Status.OperationHistory, appsv1.OperationState{
+			Phase:     phase,
+			Message:   fmt.Sprintf("operation %d", i),
+			StartedAt: metav1.NewTime(start.Add(time.Duration(i) * 24 * time.Hour)),
+		})
+	}
+	app, err := appServer.Create(ctx, &ApplicationCreateRequest{Application: *testApp})
+	assert.Nil(t, err)
+
+	messages := func(res *ApplicationHistoryResponse) []string {
+		var messages []string
+		for _, opState := range res.Operations {
+			messages = append(messages, opState.Message)
+		}
+		return messages
+	}
+
+	res, err := appServer.History(ctx, &ApplicationHistoryQuery{Name: &app.Name, Operations: true})
+	assert.Nil(t, err)
+	assert.Empty(t, res.Items)
+	assert.Equal(t, []string{"operation 4", "operation 3", "operation 2", "operation 1", "operation 0"}, messages(res))
+	assert.Equal(t, int64(5), res.Total)
+
+	// the phases imply the operation history
+	res, err = appServer.History(ctx, &ApplicationHistoryQuery{Name: &app.Name, Phases: []string{"Failed", "Error"}})
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"operation 4", "operation 3", "operation 1"}, messages(res))
+	assert.Equal(t, int64(3), res.Total)
+
+	res, err = appServer.History(ctx, &ApplicationHistoryQuery{Name: &app.Name, Phases: []string{"Failed"}, Until: "2019-01-05T00:00:00Z"})
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"operation 1"}, messages(res))
+
+	res, err = appServer.History(ctx, &ApplicationHistoryQuery{Name: &app.Name, Operations: true, Limit: 1, Offset: 1})
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"operation 3"}, messages(res))
+	assert.Equal(t, int64(5), res.Total)
+
+	_, err = appServer.History(ctx, &ApplicationHistoryQuery{Name: &app.Name, Phases: []string{"Running"}})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestSummary(t *testing.T) {
+	ctx := context.Background()
+	appServer := newTestAppServer()
+	start := time.Now().Add(-time.Hour)
+	newApp := func(name string, status appsv1.ComparisonStatus, phase appsv1.OperationPhase, startedAt time.Time, finishedAt *time.Time) {
+		testApp := newTestApp()
+		testApp.Name = name
+		testApp.Status.ComparisonResult.Status = status
+		testApp.Status.Health.Status = appsv1.HealthStatusHealthy
+		if phase != "" {
+			testApp.Status.OperationState = &appsv1.OperationState{Phase: phase, StartedAt: metav1.NewTime(startedAt)}
+			if finishedAt != nil {
+				finished := metav1.NewTime(*finishedAt)
+				testApp.Status.OperationState.FinishedAt = &finished
+			}
+		}
+		_, err := appServer.Create(ctx, &ApplicationCreateRequest{Application: *testApp})
+		assert.Nil(t, err)
+	}
+	failedAt := start.Add(10 * time.Minute)
+	newApp("synced", appsv1.ComparisonStatusSynced, "", time.Time{}, nil)
+	newApp("failed", appsv1.ComparisonStatusOutOfSync, appsv1.OperationFailed, start, &failedAt)
+	newApp("errored", appsv1.ComparisonStatusOutOfSync, appsv1.OperationError, start, &start)
+	newApp("running", appsv1.ComparisonStatusOutOfSync, appsv1.OperationRunning, start.Add(30*time.Minute), nil)
+	newApp("long-running", "", appsv1.OperationRunning, start, nil)
+
+	summary, err := appServer.Summary(ctx, &ApplicationSummaryQuery{})
+	assert.Nil(t, err)
+	assert.Equal(t, int64(5), summary.Total)
+	assert.Equal(t, []ApplicationSummaryCount{
+		{Key: string(appsv1.ComparisonStatusOutOfSync), Count: 3},
+		{Key: string(appsv1.ComparisonStatusSynced), Count: 1},
+		{Key: string(appsv1.ComparisonStatusUnknown), Count: 1},
+	}, summary.SyncStatuses)
+	assert.Equal(t, []ApplicationSummaryCount{{Key: appsv1.HealthStatusHealthy, Count: 5}}, summary.HealthStatuses)
+	assert.Equal(t, []ApplicationSummaryCount{{Key: "default", Count: 5}}, summary.Projects)
+	assert.Equal(t, []ApplicationSummaryCount{{Key: "https://cluster-api.com", Count: 5}}, summary.Clusters)
+
+	names := func(ops []ApplicationSummaryOperation) []string {
+		var names []string
+		for _, op := range ops {
+			names = append(names, op.Name)
+		}
+		return names
+	}
+	assert.Equal(t, []string{"failed", "errored"}, names(summary.FailedOperations))
+	assert.Equal(t, int64(600), summary.FailedOperations[0].Duration)
+	assert.Equal(t, []string{"long-running", "running"}, names(summary.RunningOperations))
+	assert.Empty(t, summary.RunningOperations[0].FinishedAt)
+
+	summary, err = appServer.Summary(ctx, &ApplicationSummaryQuery{Limit: 1})
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"failed"}, names(summary.FailedOperations))
+	assert.Equal(t, []string{"long-running"}, names(summary.RunningOperations))
+
+	summary, err = appServer.Summary(ctx, &ApplicationSummaryQuery{Projects: []string{"other"}})
+	assert.Nil(t, err)
+	assert.Equal(t, int64(0), summary.Total)
+	assert.Empty(t, summary.SyncStatuses)
+
+	_, err = appServer.Summary(ctx, &ApplicationSummaryQuery{Limit: -1})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestListResourceEventsPaged(t *testing.T) {
+	ctx := context.Background()
+	appServer := newTestAppServer()
+	app, err := appServer.Create(ctx, &ApplicationCreateRequest{Application: *newTestApp()})
+	assert.Nil(t, err)
+	start := time.Date(2019, 1, 1, 0, 0, 0, 0, time.UTC)
+	for i := 0; i < 4; i++ {
+		_, err = appServer.kubeclientset.CoreV1().Events(testNamespace).Create(&v1.Event{
+			ObjectMeta:     metav1.ObjectMeta{Name: fmt.Sprintf("event-%d", i), Namespace: testNamespace},
+			InvolvedObject: v1.ObjectReference{Name: app.Name, Namespace: app.Namespace},
+			LastTimestamp:  metav1.NewTime(start.Add(time.Duration(i) * time.Hour)),
+		})
+		assert.Nil(t, err)
+	}
+	names := func(list *v1.EventList) []string {
+		var names []string
+		for _, event := range list.Items {
+			names = append(names, event.Name)
+		}
+		return names
+	}
+
+	list, err := appServer.ListResourceEvents(ctx, &ApplicationResourceEventsQuery{Name: &app.Name, Since: "2019-01-01T01:00:00Z"})
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"event-3", "event-2", "event-1"}, names(list))
+
+	list, err = appServer.ListResourceEvents(ctx, &ApplicationResourceEventsQuery{Name: &app.Name, Until: "2019-01-01T03:00:00Z", Limit: 2, Offset: 1})
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"event-1", "event-0"}, names(list))
+
+	_, err = appServer.ListResourceEvents(ctx, &ApplicationResourceEventsQuery{Name: &app.Name, Offset: -1})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
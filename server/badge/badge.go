@@ -0,0 +1,91 @@
+package badge
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1"
+	applister "github.com/argoproj/argo-cd/pkg/client/listers/application/v1alpha1"
+	"github.com/argoproj/argo-cd/util/settings"
+)
+
+const (
+	// BadgePath is the endpoint which renders the status badges of applications
+	BadgePath = "/api/badge"
+	// charWidth is the approximate width of a character of the text of a badge, in pixels
+	charWidth = 7
+	// padding is the horizontal padding of each part of a badge, in pixels
+	padding = 10
+)
+
+var (
+	// syncColors holds the colors of the sync statuses
+	syncColors = map[v1alpha1.ComparisonStatus]string{
+		v1alpha1.ComparisonStatusSynced:    "#18be52",
+		v1alpha1.ComparisonStatusOutOfSync: "#f4c030",
+		v1alpha1.ComparisonStatusUnknown:   "#9e9e9e",
+	}
+	// healthColors holds the colors of the health statuses
+	healthColors = map[v1alpha1.HealthStatusCode]string{
+		v1alpha1.HealthStatusHealthy:     "#18be52",
+		v1alpha1.HealthStatusProgressing: "#0dadea",
+		v1alpha1.HealthStatusDegraded:    "#e96d76",
+		v1alpha1.HealthStatusMissing:     "#f4c030",
+		v1alpha1.HealthStatusUnknown:     "#9e9e9e",
+	}
+)
+
+// Handler renders the health and sync status of applications as SVG badges, which can be embedded in
+// web pages without authentication. Badges are only rendered if they are enabled in the settings;
+// otherwise, as well as for unknown applications, the badge shows unknown statuses, so that the
+// existence of applications is not revealed.
+type Handler struct {
+	appLister applister.ApplicationNamespaceLister
+	settings  *settings.ArgoCDSettings
+}
+
+// NewHandler returns the handler of the badges of the applications of the namespace. The settings
+// are expected to be kept up to date by the settings manager.
+func NewHandler(appLister applister.ApplicationLister, namespace string, settings *settings.ArgoCDSettings) *Handler {
+	return &Handler{appLister: appLister.Applications(namespace), settings: settings}
+}
+
+// ServeHTTP renders the badge of the application of the 'name' query parameter
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	health := v1alpha1.HealthStatusUnknown
+	sync := v1alpha1.ComparisonStatusUnknown
+	if name := r.URL.Query().Get("name"); name != "" && h.settings.StatusBadgeEnabled {
+		if app, err := h.appLister.Get(name); err == nil {
+			health = app.Status.Health.Status
+			sync = app.Status.ComparisonResult.Status
+		}
+	}
+	w.Header().Set("Content-Type", "image/svg+xml")
+	// the status can change at any time, so badges must not be cached by the browsers or proxies
+	w.Header().Set("Cache-Control", "private, no-store")
+	_, _ = w.Write([]byte(renderBadge(health, sync)))
+}
+
+// renderBadge returns the SVG badge of the health and sync status. Unknown statuses are rendered as
+// Unknown, so that only known texts are written to the badge.
+func renderBadge(health v1alpha1.HealthStatusCode, sync v1alpha1.ComparisonStatus) string {
+	healthColor, ok := healthColors[health]
+	if !ok {
+		health, healthColor = v1alpha1.HealthStatusUnknown, healthColors[v1alpha1.HealthStatusUnknown]
+	}
+	syncColor, ok := syncColors[sync]
+	if !ok {
+		sync, syncColor = v1alpha1.ComparisonStatusUnknown, syncColors[v1alpha1.ComparisonStatusUnknown]
+	}
+	healthWidth := len(health)*charWidth + 2*padding
+	syncWidth := len(sync)*charWidth + 2*padding
+	width := healthWidth + syncWidth
+	return fmt.Sprintf(`<svg xmlns="http://www.w3.org/2000/svg" width="%[1]d" height="20" role="img" aria-label="%[2]s: %[3]s">`+
+		`<rect width="%[4]d" height="20" fill="%[5]s"/>`+
+		`<rect x="%[4]d" width="%[6]d" height="20" fill="%[7]s"/>`+
+		`<g fill="#fff" font-family="Verdana,DejaVu Sans,sans-serif" font-size="11" text-anchor="middle">`+
+		`<text x="%[8]d" y="14">%[2]s</text>`+
+		`<text x="%[9]d" y="14">%[3]s</text>`+
+		`</g></svg>`,
+		width, health, sync, healthWidth, healthColor, syncWidth, syncColor, healthWidth/2, healthWidth+syncWidth/2)
+}
@@ -0,0 +1,72 @@
+package badge
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/cache"
+
+	"github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1"
+	appclientset "github.com/argoproj/argo-cd/pkg/client/clientset/versioned/fake"
+	appinformer "github.com/argoproj/argo-cd/pkg/client/informers/externalversions"
+	"github.com/argoproj/argo-cd/util/settings"
+)
+
+func newTestHandler(t *testing.T, enabled bool) (context.CancelFunc, *Handler) {
+	app := &v1alpha1.Application{
+		ObjectMeta: metav1.ObjectMeta{Name: "guestbook", Namespace: "argocd"},
+		Status: v1alpha1.ApplicationStatus{
+			ComparisonResult: v1alpha1.ComparisonResult{Status: v1alpha1.ComparisonStatusOutOfSync},
+			Health:           v1alpha1.HealthStatus{Status: v1alpha1.HealthStatusDegraded},
+		},
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	factory := appinformer.NewSharedInformerFactory(appclientset.NewSimpleClientset(app), 0)
+	appInformer := factory.Argoproj().V1alpha1().Applications().Informer()
+	go appInformer.Run(ctx.Done())
+	if !cache.WaitForCacheSync(ctx.Done(), appInformer.HasSynced) {
+		t.Fatal("Timed out waiting for caches to sync")
+	}
+	return cancel, NewHandler(factory.Argoproj().V1alpha1().Applications().Lister(), "argocd", &settings.ArgoCDSettings{StatusBadgeEnabled: enabled})
+}
+
+func getBadge(handler *Handler, name string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(http.MethodGet, BadgePath+"?name="+name, nil)
+	rr := httptest.NewRecorder()
+	handler.ServeHTTP(rr, req)
+	return rr
+}
+
+func TestBadge(t *testing.T) {
+	cancel, handler := newTestHandler(t, true)
+	defer cancel()
+	rr := getBadge(handler, "guestbook")
+	assert.Equal(t, http.StatusOK, rr.Code)
+	assert.Equal(t, "image/svg+xml", rr.Header().Get("Content-Type"))
+	assert.Contains(t, rr.Body.String(), ">Degraded</text>")
+	assert.Contains(t, rr.Body.String(), ">OutOfSync</text>")
+	assert.Contains(t, rr.Body.String(), healthColors[v1alpha1.HealthStatusDegraded])
+}
+
+func TestBadgeUnknown(t *testing.T) {
+	cancel, handler := newTestHandler(t, true)
+	defer cancel()
+	cancelDisabled, disabledHandler := newTestHandler(t, false)
+	defer cancelDisabled()
+	// disabled badges and unknown applications are rendered alike
+	for _, rr := range []*httptest.ResponseRecorder{
+		getBadge(disabledHandler, "guestbook"),
+		getBadge(handler, "missing"),
+	} {
+		assert.Equal(t, http.StatusOK, rr.Code)
+		assert.Equal(t, renderBadge(v1alpha1.HealthStatusUnknown, v1alpha1.ComparisonStatusUnknown), rr.Body.String())
+	}
+}
+
+func TestRenderBadgeUnknownStatus(t *testing.T) {
+	assert.Equal(t, renderBadge(v1alpha1.HealthStatusUnknown, v1alpha1.ComparisonStatusSynced), renderBadge("<script>", v1alpha1.ComparisonStatusSynced))
+}
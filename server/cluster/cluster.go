@@ -17,6 +17,7 @@ import (
 	"github.com/argoproj/argo-cd/server/rbacpolicy"
 	"github.com/argoproj/argo-cd/util/cache"
 	"github.com/argoproj/argo-cd/util/db"
+	"github.com/argoproj/argo-cd/util/diff"
 	"github.com/argoproj/argo-cd/util/grpc"
 	"github.com/argoproj/argo-cd/util/kube"
 	"github.com/argoproj/argo-cd/util/rbac"
@@ -55,7 +56,7 @@ func (s *Server) getConnectionState(ctx context.Context, cluster appv1.Cluster)
 		ModifiedAt: &now,
 	}
 
-	kubeClientset, err := kubernetes.NewForConfig(cluster.RESTConfig())
+	kubeClientset, err := kubernetes.NewForConfig(kube.ClusterRESTConfig(&cluster))
 	if err == nil {
 		_, err = kubeClientset.Discovery().ServerVersion()
 	}
@@ -98,7 +99,10 @@ func (s *Server) Create(ctx context.Context, q *ClusterCreateRequest) (*appv1.Cl
 		return nil, grpc.ErrPermissionDenied
 	}
 	c := q.Cluster
-	err := kube.TestConfig(q.Cluster.RESTConfig())
+	if err := diff.ValidateNormalizerProfiles(c.NormalizerProfiles); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	err := kube.TestConfig(kube.ClusterRESTConfig(q.Cluster))
 	if err != nil {
 		return nil, err
 	}
@@ -154,7 +158,7 @@ func (s *Server) CreateFromKubeConfig(ctx context.Context, q *ClusterCreateFromK
 	}
 
 	// Temporarily install RBAC resources for managing the cluster
-	clientset, err := kubernetes.NewForConfig(c.RESTConfig())
+	clientset, err := kubernetes.NewForConfig(kube.ClusterRESTConfig(c))
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "Could not create Kubernetes clientset: %v", err)
 	}
@@ -186,7 +190,10 @@ func (s *Server) Update(ctx context.Context, q *ClusterUpdateRequest) (*appv1.Cl
 	if !s.enf.Enforce(ctx.Value("claims"), rbacpolicy.ResourceClusters, rbacpolicy.ActionUpdate, q.Cluster.Server) {
 		return nil, grpc.ErrPermissionDenied
 	}
-	err := kube.TestConfig(q.Cluster.RESTConfig())
+	if err := diff.ValidateNormalizerProfiles(q.Cluster.NormalizerProfiles); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	err := kube.TestConfig(kube.ClusterRESTConfig(q.Cluster))
 	if err != nil {
 		return nil, err
 	}
@@ -17,12 +17,16 @@ const (
 	ResourceProjects     = "projects"
 	ResourceApplications = "applications"
 	ResourceRepositories = "repositories"
-
-	ActionGet    = "get"
-	ActionCreate = "create"
-	ActionUpdate = "update"
-	ActionDelete = "delete"
-	ActionSync   = "sync"
+	ResourceAccounts     = "accounts"
+
+	ActionGet         = "get"
+	ActionCreate      = "create"
+	ActionUpdate      = "update"
+	ActionDelete      = "delete"
+	ActionSync        = "sync"
+	ActionUnredact    = "unredact"
+	ActionPatch       = "patch"
+	ActionImpersonate = "impersonate"
 )
 
 // RBACPolicyEnforcer provides an RBAC Claims Enforcer which additionally consults AppProject
@@ -78,6 +82,19 @@ func (p *RBACPolicyEnforcer) EnforceClaims(claims jwt.Claims, rvals ...interface
 	return false
 }
 
+// EvaluateSubject evaluates a request of a hypothetical subject which is a member of the groups, as
+// EnforceClaims does for the claims of a token of the subject. Returns whether the request is allowed
+// and the lines of the policies which match the request.
+func (p *RBACPolicyEnforcer) EvaluateSubject(subject string, groups []string, res, act, obj string) (bool, []string) {
+	claims := jwt.MapClaims{"sub": subject, "groups": groups}
+	allowed := p.enf.Enforce(claims, res, act, obj)
+	var runtimePolicy string
+	if proj := p.getProjectFromRequest(claims, res, act, obj); proj != nil {
+		runtimePolicy = proj.ProjectPoliciesString()
+	}
+	return allowed, p.enf.MatchingPolicies(runtimePolicy, append([]string{subject}, groups...), res, act, obj)
+}
+
 // getProjectFromRequest parses the project name from the RBAC request and returns the associated
 // project (if it exists)
 func (p *RBACPolicyEnforcer) getProjectFromRequest(rvals ...interface{}) *v1alpha1.AppProject {
@@ -22,7 +22,9 @@ import (
 	"github.com/argoproj/argo-cd/util/db"
 	"github.com/argoproj/argo-cd/util/git"
 	"github.com/argoproj/argo-cd/util/grpc"
+	"github.com/argoproj/argo-cd/util/helm"
 	"github.com/argoproj/argo-cd/util/rbac"
+	"github.com/argoproj/argo-cd/util/webhook"
 	"github.com/ghodss/yaml"
 	log "github.com/sirupsen/logrus"
 )
@@ -33,6 +35,7 @@ type Server struct {
 	repoClientset reposerver.Clientset
 	enf           *rbac.Enforcer
 	cache         cache.Cache
+	webhooks      *webhook.Registrar
 }
 
 const (
@@ -45,12 +48,14 @@ func NewServer(
 	db db.ArgoDB,
 	enf *rbac.Enforcer,
 	cache cache.Cache,
+	webhooks *webhook.Registrar,
 ) *Server {
 	return &Server{
 		db:            db,
 		repoClientset: repoClientset,
 		enf:           enf,
 		cache:         cache,
+		webhooks:      webhooks,
 	}
 }
 
@@ -67,7 +72,7 @@ func (s *Server) getConnectionState(ctx context.Context, url string) appsv1.Conn
 	}
 	repo, err := s.db.GetRepository(ctx, url)
 	if err == nil {
-		err = git.TestRepo(repo.Repo, repo.Username, repo.Password, repo.SSHPrivateKey)
+		err = testRepo(repo)
 	}
 	if err != nil {
 		connectionState.Status = appsv1.ConnectionStatusFailed
@@ -84,6 +89,14 @@ func (s *Server) getConnectionState(ctx context.Context, url string) appsv1.Conn
 	return connectionState
 }
 
+// testRepo tests the connection to the repository with its credentials
+func testRepo(repo *appsv1.Repository) error {
+	if repo.IsHelm() {
+		return helm.TestRepo(repo.Repo, repo.Username, repo.Password)
+	}
+	return git.TestRepo(repo.Repo, repo.Username, repo.Password, repo.SSHPrivateKey)
+}
+
 // List returns list of repositories
 func (s *Server) List(ctx context.Context, q *RepoQuery) (*appsv1.RepositoryList, error) {
 	urls, err := s.db.ListRepoURLs(ctx)
@@ -173,6 +186,50 @@ func (s *Server) ListApps(ctx context.Context, q *RepoAppsQuery) (*RepoAppsRespo
 	return &RepoAppsResponse{Items: items}, nil
 }
 
+// ConvertKsonnetApp converts a ksonnet app of the repo to plain manifests of each environment
+func (s *Server) ConvertKsonnetApp(ctx context.Context, q *RepoKsonnetConversionQuery) (*RepoKsonnetConversionResponse, error) {
+	if !s.enf.Enforce(ctx.Value("claims"), rbacpolicy.ResourceRepositories, rbacpolicy.ActionGet, q.Repo) {
+		return nil, grpc.ErrPermissionDenied
+	}
+	repo, err := s.db.GetRepository(ctx, q.Repo)
+	if err != nil {
+		if errStatus, ok := status.FromError(err); ok && errStatus.Code() == codes.NotFound {
+			repo = &appsv1.Repository{
+				Repo: q.Repo,
+			}
+		} else {
+			return nil, err
+		}
+	}
+
+	conn, repoClient, err := s.repoClientset.NewRepositoryClient()
+	if err != nil {
+		return nil, err
+	}
+	defer util.Close(conn)
+
+	revision := q.Revision
+	if revision == "" {
+		revision = "HEAD"
+	}
+
+	convRes, err := repoClient.ConvertKsonnetApp(ctx, &repository.KsonnetConversionRequest{
+		Repo:         repo,
+		Revision:     revision,
+		Path:         q.Path,
+		Format:       q.Format,
+		Environments: q.Environments,
+	})
+	if err != nil {
+		return nil, err
+	}
+	res := RepoKsonnetConversionResponse{Revision: convRes.Revision}
+	for _, f := range convRes.Files {
+		res.Files = append(res.Files, &ConvertedAppFile{Path: f.Path, Data: f.Data})
+	}
+	return &res, nil
+}
+
 func (s *Server) GetAppDetails(ctx context.Context, q *RepoAppDetailsQuery) (*RepoAppDetailsResponse, error) {
 	if !s.enf.Enforce(ctx.Value("claims"), rbacpolicy.ResourceRepositories, rbacpolicy.ActionGet, q.Repo) {
 		return nil, grpc.ErrPermissionDenied
@@ -268,7 +325,15 @@ func (s *Server) Create(ctx context.Context, q *RepoCreateRequest) (*appsv1.Repo
 		return nil, grpc.ErrPermissionDenied
 	}
 	r := q.Repo
-	err := git.TestRepo(git.NormalizeGitURL(r.Repo), r.Username, r.Password, r.SSHPrivateKey)
+	err := validateRepositoryType(r)
+	if err != nil {
+		return nil, err
+	}
+	if r.IsHelm() {
+		err = helm.TestRepo(r.Repo, r.Username, r.Password)
+	} else {
+		err = git.TestRepo(git.NormalizeGitURL(r.Repo), r.Username, r.Password, r.SSHPrivateKey)
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -292,7 +357,11 @@ func (s *Server) Create(ctx context.Context, q *RepoCreateRequest) (*appsv1.Repo
 			return nil, status.Errorf(codes.InvalidArgument, "existing repository spec is different; use upsert flag to force update")
 		}
 	}
-	return &appsv1.Repository{Repo: repo.Repo}, err
+	if err != nil {
+		return nil, err
+	}
+	s.registerWebhook(ctx, repo.Repo)
+	return &appsv1.Repository{Repo: repo.Repo}, nil
 }
 
 // Update updates a repository
@@ -300,8 +369,43 @@ func (s *Server) Update(ctx context.Context, q *RepoUpdateRequest) (*appsv1.Repo
 	if !s.enf.Enforce(ctx.Value("claims"), rbacpolicy.ResourceRepositories, "update", q.Repo.Repo) {
 		return nil, grpc.ErrPermissionDenied
 	}
-	_, err := s.db.UpdateRepository(ctx, q.Repo)
-	return &appsv1.Repository{Repo: q.Repo.Repo}, err
+	err := validateRepositoryType(q.Repo)
+	if err != nil {
+		return nil, err
+	}
+	_, err = s.db.UpdateRepository(ctx, q.Repo)
+	if err != nil {
+		return nil, err
+	}
+	s.registerWebhook(ctx, q.Repo.Repo)
+	return &appsv1.Repository{Repo: q.Repo.Repo}, nil
+}
+
+// validateRepositoryType returns an error if the type of the repository is neither empty, git nor helm
+func validateRepositoryType(r *appsv1.Repository) error {
+	if r.Type != "" && r.Type != appsv1.RepositoryTypeGit && !r.IsHelm() {
+		return status.Errorf(codes.InvalidArgument, "unknown repository type '%s'", r.Type)
+	}
+	return nil
+}
+
+// registerWebhook registers the Argo CD webhook in the repository, if webhook management is enabled.
+// Failures are logged since the repository remains usable without the webhook.
+func (s *Server) registerWebhook(ctx context.Context, url string) {
+	if !s.webhooks.Enabled() {
+		return
+	}
+	repo, err := s.db.GetRepository(ctx, url)
+	if err == nil && repo.IsHelm() {
+		// helm repositories do not send events
+		return
+	}
+	if err == nil {
+		err = s.webhooks.RegisterWebhook(repo)
+	}
+	if err != nil {
+		log.Warnf("Failed to register webhook of repository %s: %v", url, err)
+	}
 }
 
 // Delete updates a repository
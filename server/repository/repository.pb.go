@@ -46,7 +46,7 @@ func (m *RepoAppsQuery) Reset()         { *m = RepoAppsQuery{} }
 func (m *RepoAppsQuery) String() string { return proto.CompactTextString(m) }
 func (*RepoAppsQuery) ProtoMessage()    {}
 func (*RepoAppsQuery) Descriptor() ([]byte, []int) {
-	return fileDescriptor_repository_324bad698d34f88e, []int{0}
+	return fileDescriptor_repository_d7fb67d1ac719e10, []int{0}
 }
 func (m *RepoAppsQuery) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -102,7 +102,7 @@ func (m *AppInfo) Reset()         { *m = AppInfo{} }
 func (m *AppInfo) String() string { return proto.CompactTextString(m) }
 func (*AppInfo) ProtoMessage()    {}
 func (*AppInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_repository_324bad698d34f88e, []int{1}
+	return fileDescriptor_repository_d7fb67d1ac719e10, []int{1}
 }
 func (m *AppInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -159,7 +159,7 @@ func (m *RepoAppDetailsQuery) Reset()         { *m = RepoAppDetailsQuery{} }
 func (m *RepoAppDetailsQuery) String() string { return proto.CompactTextString(m) }
 func (*RepoAppDetailsQuery) ProtoMessage()    {}
 func (*RepoAppDetailsQuery) Descriptor() ([]byte, []int) {
-	return fileDescriptor_repository_324bad698d34f88e, []int{2}
+	return fileDescriptor_repository_d7fb67d1ac719e10, []int{2}
 }
 func (m *RepoAppDetailsQuery) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -224,7 +224,7 @@ func (m *RepoAppDetailsResponse) Reset()         { *m = RepoAppDetailsResponse{}
 func (m *RepoAppDetailsResponse) String() string { return proto.CompactTextString(m) }
 func (*RepoAppDetailsResponse) ProtoMessage()    {}
 func (*RepoAppDetailsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_repository_324bad698d34f88e, []int{3}
+	return fileDescriptor_repository_d7fb67d1ac719e10, []int{3}
 }
 func (m *RepoAppDetailsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -293,7 +293,7 @@ func (m *RepoAppsResponse) Reset()         { *m = RepoAppsResponse{} }
 func (m *RepoAppsResponse) String() string { return proto.CompactTextString(m) }
 func (*RepoAppsResponse) ProtoMessage()    {}
 func (*RepoAppsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_repository_324bad698d34f88e, []int{4}
+	return fileDescriptor_repository_d7fb67d1ac719e10, []int{4}
 }
 func (m *RepoAppsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -344,7 +344,7 @@ func (m *KsonnetAppSpec) Reset()         { *m = KsonnetAppSpec{} }
 func (m *KsonnetAppSpec) String() string { return proto.CompactTextString(m) }
 func (*KsonnetAppSpec) ProtoMessage()    {}
 func (*KsonnetAppSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_repository_324bad698d34f88e, []int{5}
+	return fileDescriptor_repository_d7fb67d1ac719e10, []int{5}
 }
 func (m *KsonnetAppSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -408,7 +408,7 @@ func (m *HelmAppSpec) Reset()         { *m = HelmAppSpec{} }
 func (m *HelmAppSpec) String() string { return proto.CompactTextString(m) }
 func (*HelmAppSpec) ProtoMessage()    {}
 func (*HelmAppSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_repository_324bad698d34f88e, []int{6}
+	return fileDescriptor_repository_d7fb67d1ac719e10, []int{6}
 }
 func (m *HelmAppSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -470,7 +470,7 @@ func (m *KustomizeAppSpec) Reset()         { *m = KustomizeAppSpec{} }
 func (m *KustomizeAppSpec) String() string { return proto.CompactTextString(m) }
 func (*KustomizeAppSpec) ProtoMessage()    {}
 func (*KustomizeAppSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_repository_324bad698d34f88e, []int{7}
+	return fileDescriptor_repository_d7fb67d1ac719e10, []int{7}
 }
 func (m *KustomizeAppSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -524,7 +524,7 @@ func (m *KsonnetEnvironment) Reset()         { *m = KsonnetEnvironment{} }
 func (m *KsonnetEnvironment) String() string { return proto.CompactTextString(m) }
 func (*KsonnetEnvironment) ProtoMessage()    {}
 func (*KsonnetEnvironment) Descriptor() ([]byte, []int) {
-	return fileDescriptor_repository_324bad698d34f88e, []int{8}
+	return fileDescriptor_repository_d7fb67d1ac719e10, []int{8}
 }
 func (m *KsonnetEnvironment) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -595,7 +595,7 @@ func (m *KsonnetEnvironmentDestination) Reset()         { *m = KsonnetEnvironmen
 func (m *KsonnetEnvironmentDestination) String() string { return proto.CompactTextString(m) }
 func (*KsonnetEnvironmentDestination) ProtoMessage()    {}
 func (*KsonnetEnvironmentDestination) Descriptor() ([]byte, []int) {
-	return fileDescriptor_repository_324bad698d34f88e, []int{9}
+	return fileDescriptor_repository_d7fb67d1ac719e10, []int{9}
 }
 func (m *KsonnetEnvironmentDestination) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -650,7 +650,7 @@ func (m *RepoQuery) Reset()         { *m = RepoQuery{} }
 func (m *RepoQuery) String() string { return proto.CompactTextString(m) }
 func (*RepoQuery) ProtoMessage()    {}
 func (*RepoQuery) Descriptor() ([]byte, []int) {
-	return fileDescriptor_repository_324bad698d34f88e, []int{10}
+	return fileDescriptor_repository_d7fb67d1ac719e10, []int{10}
 }
 func (m *RepoQuery) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -696,7 +696,7 @@ func (m *RepoResponse) Reset()         { *m = RepoResponse{} }
 func (m *RepoResponse) String() string { return proto.CompactTextString(m) }
 func (*RepoResponse) ProtoMessage()    {}
 func (*RepoResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_repository_324bad698d34f88e, []int{11}
+	return fileDescriptor_repository_d7fb67d1ac719e10, []int{11}
 }
 func (m *RepoResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -737,7 +737,7 @@ func (m *RepoCreateRequest) Reset()         { *m = RepoCreateRequest{} }
 func (m *RepoCreateRequest) String() string { return proto.CompactTextString(m) }
 func (*RepoCreateRequest) ProtoMessage()    {}
 func (*RepoCreateRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_repository_324bad698d34f88e, []int{12}
+	return fileDescriptor_repository_d7fb67d1ac719e10, []int{12}
 }
 func (m *RepoCreateRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -791,7 +791,7 @@ func (m *RepoUpdateRequest) Reset()         { *m = RepoUpdateRequest{} }
 func (m *RepoUpdateRequest) String() string { return proto.CompactTextString(m) }
 func (*RepoUpdateRequest) ProtoMessage()    {}
 func (*RepoUpdateRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_repository_324bad698d34f88e, []int{13}
+	return fileDescriptor_repository_d7fb67d1ac719e10, []int{13}
 }
 func (m *RepoUpdateRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -827,6 +827,201 @@ func (m *RepoUpdateRequest) GetRepo() *v1alpha1.Repository {
 	return nil
 }
 
+// RepoKsonnetConversionQuery is a query for the conversion of a ksonnet app to plain manifests
+type RepoKsonnetConversionQuery struct {
+	Repo     string `protobuf:"bytes,1,opt,name=repo,proto3" json:"repo,omitempty"`
+	Revision string `protobuf:"bytes,2,opt,name=revision,proto3" json:"revision,omitempty"`
+	// path is the directory of the ksonnet app in the repository
+	Path string `protobuf:"bytes,3,opt,name=path,proto3" json:"path,omitempty"`
+	// format is the structure of the converted app: directory (default) or kustomize
+	Format string `protobuf:"bytes,4,opt,name=format,proto3" json:"format,omitempty"`
+	// environments are the converted environments. All environments are converted if empty
+	Environments         []string `protobuf:"bytes,5,rep,name=environments" json:"environments,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RepoKsonnetConversionQuery) Reset()         { *m = RepoKsonnetConversionQuery{} }
+func (m *RepoKsonnetConversionQuery) String() string { return proto.CompactTextString(m) }
+func (*RepoKsonnetConversionQuery) ProtoMessage()    {}
+func (*RepoKsonnetConversionQuery) Descriptor() ([]byte, []int) {
+	return fileDescriptor_repository_d7fb67d1ac719e10, []int{14}
+}
+func (m *RepoKsonnetConversionQuery) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RepoKsonnetConversionQuery) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RepoKsonnetConversionQuery.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (dst *RepoKsonnetConversionQuery) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RepoKsonnetConversionQuery.Merge(dst, src)
+}
+func (m *RepoKsonnetConversionQuery) XXX_Size() int {
+	return m.Size()
+}
+func (m *RepoKsonnetConversionQuery) XXX_DiscardUnknown() {
+	xxx_messageInfo_RepoKsonnetConversionQuery.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RepoKsonnetConversionQuery proto.InternalMessageInfo
+
+func (m *RepoKsonnetConversionQuery) GetRepo() string {
+	if m != nil {
+		return m.Repo
+	}
+	return ""
+}
+
+func (m *RepoKsonnetConversionQuery) GetRevision() string {
+	if m != nil {
+		return m.Revision
+	}
+	return ""
+}
+
+func (m *RepoKsonnetConversionQuery) GetPath() string {
+	if m != nil {
+		return m.Path
+	}
+	return ""
+}
+
+func (m *RepoKsonnetConversionQuery) GetFormat() string {
+	if m != nil {
+		return m.Format
+	}
+	return ""
+}
+
+func (m *RepoKsonnetConversionQuery) GetEnvironments() []string {
+	if m != nil {
+		return m.Environments
+	}
+	return nil
+}
+
+// ConvertedAppFile is a file of a converted app
+type ConvertedAppFile struct {
+	Path                 string   `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	Data                 []byte   `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ConvertedAppFile) Reset()         { *m = ConvertedAppFile{} }
+func (m *ConvertedAppFile) String() string { return proto.CompactTextString(m) }
+func (*ConvertedAppFile) ProtoMessage()    {}
+func (*ConvertedAppFile) Descriptor() ([]byte, []int) {
+	return fileDescriptor_repository_d7fb67d1ac719e10, []int{15}
+}
+func (m *ConvertedAppFile) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ConvertedAppFile) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ConvertedAppFile.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (dst *ConvertedAppFile) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConvertedAppFile.Merge(dst, src)
+}
+func (m *ConvertedAppFile) XXX_Size() int {
+	return m.Size()
+}
+func (m *ConvertedAppFile) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConvertedAppFile.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ConvertedAppFile proto.InternalMessageInfo
+
+func (m *ConvertedAppFile) GetPath() string {
+	if m != nil {
+		return m.Path
+	}
+	return ""
+}
+
+func (m *ConvertedAppFile) GetData() []byte {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+// RepoKsonnetConversionResponse contains the files of a converted ksonnet app
+type RepoKsonnetConversionResponse struct {
+	Revision             string              `protobuf:"bytes,1,opt,name=revision,proto3" json:"revision,omitempty"`
+	Files                []*ConvertedAppFile `protobuf:"bytes,2,rep,name=files" json:"files,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
+}
+
+func (m *RepoKsonnetConversionResponse) Reset()         { *m = RepoKsonnetConversionResponse{} }
+func (m *RepoKsonnetConversionResponse) String() string { return proto.CompactTextString(m) }
+func (*RepoKsonnetConversionResponse) ProtoMessage()    {}
+func (*RepoKsonnetConversionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_repository_d7fb67d1ac719e10, []int{16}
+}
+func (m *RepoKsonnetConversionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RepoKsonnetConversionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RepoKsonnetConversionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (dst *RepoKsonnetConversionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RepoKsonnetConversionResponse.Merge(dst, src)
+}
+func (m *RepoKsonnetConversionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *RepoKsonnetConversionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RepoKsonnetConversionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RepoKsonnetConversionResponse proto.InternalMessageInfo
+
+func (m *RepoKsonnetConversionResponse) GetRevision() string {
+	if m != nil {
+		return m.Revision
+	}
+	return ""
+}
+
+func (m *RepoKsonnetConversionResponse) GetFiles() []*ConvertedAppFile {
+	if m != nil {
+		return m.Files
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*RepoAppsQuery)(nil), "repository.RepoAppsQuery")
 	proto.RegisterType((*AppInfo)(nil), "repository.AppInfo")
@@ -843,6 +1038,9 @@ func init() {
 	proto.RegisterType((*RepoResponse)(nil), "repository.RepoResponse")
 	proto.RegisterType((*RepoCreateRequest)(nil), "repository.RepoCreateRequest")
 	proto.RegisterType((*RepoUpdateRequest)(nil), "repository.RepoUpdateRequest")
+	proto.RegisterType((*RepoKsonnetConversionQuery)(nil), "repository.RepoKsonnetConversionQuery")
+	proto.RegisterType((*ConvertedAppFile)(nil), "repository.ConvertedAppFile")
+	proto.RegisterType((*RepoKsonnetConversionResponse)(nil), "repository.RepoKsonnetConversionResponse")
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -862,6 +1060,8 @@ type RepositoryServiceClient interface {
 	ListApps(ctx context.Context, in *RepoAppsQuery, opts ...grpc.CallOption) (*RepoAppsResponse, error)
 	// GetAppDetails returns application details by given path
 	GetAppDetails(ctx context.Context, in *RepoAppDetailsQuery, opts ...grpc.CallOption) (*RepoAppDetailsResponse, error)
+	// ConvertKsonnetApp converts a ksonnet app to plain manifests of each environment
+	ConvertKsonnetApp(ctx context.Context, in *RepoKsonnetConversionQuery, opts ...grpc.CallOption) (*RepoKsonnetConversionResponse, error)
 	// Create creates a repo
 	Create(ctx context.Context, in *RepoCreateRequest, opts ...grpc.CallOption) (*v1alpha1.Repository, error)
 	// Update updates a repo
@@ -905,6 +1105,15 @@ func (c *repositoryServiceClient) GetAppDetails(ctx context.Context, in *RepoApp
 	return out, nil
 }
 
+func (c *repositoryServiceClient) ConvertKsonnetApp(ctx context.Context, in *RepoKsonnetConversionQuery, opts ...grpc.CallOption) (*RepoKsonnetConversionResponse, error) {
+	out := new(RepoKsonnetConversionResponse)
+	err := c.cc.Invoke(ctx, "/repository.RepositoryService/ConvertKsonnetApp", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *repositoryServiceClient) Create(ctx context.Context, in *RepoCreateRequest, opts ...grpc.CallOption) (*v1alpha1.Repository, error) {
 	out := new(v1alpha1.Repository)
 	err := c.cc.Invoke(ctx, "/repository.RepositoryService/Create", in, out, opts...)
@@ -941,6 +1150,8 @@ type RepositoryServiceServer interface {
 	ListApps(context.Context, *RepoAppsQuery) (*RepoAppsResponse, error)
 	// GetAppDetails returns application details by given path
 	GetAppDetails(context.Context, *RepoAppDetailsQuery) (*RepoAppDetailsResponse, error)
+	// ConvertKsonnetApp converts a ksonnet app to plain manifests of each environment
+	ConvertKsonnetApp(context.Context, *RepoKsonnetConversionQuery) (*RepoKsonnetConversionResponse, error)
 	// Create creates a repo
 	Create(context.Context, *RepoCreateRequest) (*v1alpha1.Repository, error)
 	// Update updates a repo
@@ -1007,6 +1218,24 @@ func _RepositoryService_GetAppDetails_Handler(srv interface{}, ctx context.Conte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _RepositoryService_ConvertKsonnetApp_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RepoKsonnetConversionQuery)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RepositoryServiceServer).ConvertKsonnetApp(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/repository.RepositoryService/ConvertKsonnetApp",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RepositoryServiceServer).ConvertKsonnetApp(ctx, req.(*RepoKsonnetConversionQuery))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _RepositoryService_Create_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(RepoCreateRequest)
 	if err := dec(in); err != nil {
@@ -1077,6 +1306,10 @@ var _RepositoryService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetAppDetails",
 			Handler:    _RepositoryService_GetAppDetails_Handler,
 		},
+		{
+			MethodName: "ConvertKsonnetApp",
+			Handler:    _RepositoryService_ConvertKsonnetApp_Handler,
+		},
 		{
 			MethodName: "Create",
 			Handler:    _RepositoryService_Create_Handler,
@@ -1627,80 +1860,212 @@ func (m *RepoUpdateRequest) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
-func encodeVarintRepository(dAtA []byte, offset int, v uint64) int {
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *RepoKsonnetConversionQuery) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return offset + 1
+	return dAtA[:n], nil
 }
-func (m *RepoAppsQuery) Size() (n int) {
+
+func (m *RepoKsonnetConversionQuery) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Repo)
-	if l > 0 {
-		n += 1 + l + sovRepository(uint64(l))
+	if len(m.Repo) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintRepository(dAtA, i, uint64(len(m.Repo)))
+		i += copy(dAtA[i:], m.Repo)
 	}
-	l = len(m.Revision)
-	if l > 0 {
-		n += 1 + l + sovRepository(uint64(l))
+	if len(m.Revision) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintRepository(dAtA, i, uint64(len(m.Revision)))
+		i += copy(dAtA[i:], m.Revision)
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if len(m.Path) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintRepository(dAtA, i, uint64(len(m.Path)))
+		i += copy(dAtA[i:], m.Path)
 	}
-	return n
-}
-
-func (m *AppInfo) Size() (n int) {
-	var l int
-	_ = l
-	l = len(m.Type)
-	if l > 0 {
-		n += 1 + l + sovRepository(uint64(l))
+	if len(m.Format) > 0 {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintRepository(dAtA, i, uint64(len(m.Format)))
+		i += copy(dAtA[i:], m.Format)
 	}
-	l = len(m.Path)
-	if l > 0 {
-		n += 1 + l + sovRepository(uint64(l))
+	if len(m.Environments) > 0 {
+		for _, s := range m.Environments {
+			dAtA[i] = 0x2a
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
 	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	return i, nil
 }
 
-func (m *RepoAppDetailsQuery) Size() (n int) {
+func (m *ConvertedAppFile) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ConvertedAppFile) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Repo)
-	if l > 0 {
-		n += 1 + l + sovRepository(uint64(l))
-	}
-	l = len(m.Revision)
-	if l > 0 {
-		n += 1 + l + sovRepository(uint64(l))
+	if len(m.Path) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintRepository(dAtA, i, uint64(len(m.Path)))
+		i += copy(dAtA[i:], m.Path)
 	}
-	l = len(m.Path)
-	if l > 0 {
-		n += 1 + l + sovRepository(uint64(l))
+	if len(m.Data) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintRepository(dAtA, i, uint64(len(m.Data)))
+		i += copy(dAtA[i:], m.Data)
 	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	return i, nil
 }
 
-func (m *RepoAppDetailsResponse) Size() (n int) {
-	var l int
-	_ = l
-	l = len(m.Type)
-	if l > 0 {
-		n += 1 + l + sovRepository(uint64(l))
-	}
-	if m.Ksonnet != nil {
-		l = m.Ksonnet.Size()
-		n += 1 + l + sovRepository(uint64(l))
+func (m *RepoKsonnetConversionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RepoKsonnetConversionResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Revision) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintRepository(dAtA, i, uint64(len(m.Revision)))
+		i += copy(dAtA[i:], m.Revision)
+	}
+	if len(m.Files) > 0 {
+		for _, msg := range m.Files {
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintRepository(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func encodeVarintRepository(dAtA []byte, offset int, v uint64) int {
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return offset + 1
+}
+func (m *RepoAppsQuery) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Repo)
+	if l > 0 {
+		n += 1 + l + sovRepository(uint64(l))
+	}
+	l = len(m.Revision)
+	if l > 0 {
+		n += 1 + l + sovRepository(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *AppInfo) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Type)
+	if l > 0 {
+		n += 1 + l + sovRepository(uint64(l))
+	}
+	l = len(m.Path)
+	if l > 0 {
+		n += 1 + l + sovRepository(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *RepoAppDetailsQuery) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Repo)
+	if l > 0 {
+		n += 1 + l + sovRepository(uint64(l))
+	}
+	l = len(m.Revision)
+	if l > 0 {
+		n += 1 + l + sovRepository(uint64(l))
+	}
+	l = len(m.Path)
+	if l > 0 {
+		n += 1 + l + sovRepository(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *RepoAppDetailsResponse) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Type)
+	if l > 0 {
+		n += 1 + l + sovRepository(uint64(l))
+	}
+	if m.Ksonnet != nil {
+		l = m.Ksonnet.Size()
+		n += 1 + l + sovRepository(uint64(l))
 	}
 	if m.Helm != nil {
 		l = m.Helm.Size()
@@ -1890,6 +2255,73 @@ func (m *RepoUpdateRequest) Size() (n int) {
 	return n
 }
 
+func (m *RepoKsonnetConversionQuery) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Repo)
+	if l > 0 {
+		n += 1 + l + sovRepository(uint64(l))
+	}
+	l = len(m.Revision)
+	if l > 0 {
+		n += 1 + l + sovRepository(uint64(l))
+	}
+	l = len(m.Path)
+	if l > 0 {
+		n += 1 + l + sovRepository(uint64(l))
+	}
+	l = len(m.Format)
+	if l > 0 {
+		n += 1 + l + sovRepository(uint64(l))
+	}
+	if len(m.Environments) > 0 {
+		for _, s := range m.Environments {
+			l = len(s)
+			n += 1 + l + sovRepository(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ConvertedAppFile) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Path)
+	if l > 0 {
+		n += 1 + l + sovRepository(uint64(l))
+	}
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovRepository(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *RepoKsonnetConversionResponse) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Revision)
+	if l > 0 {
+		n += 1 + l + sovRepository(uint64(l))
+	}
+	if len(m.Files) > 0 {
+		for _, e := range m.Files {
+			l = e.Size()
+			n += 1 + l + sovRepository(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
 func sovRepository(x uint64) (n int) {
 	for {
 		n++
@@ -3569,6 +4001,424 @@ func (m *RepoUpdateRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *RepoKsonnetConversionQuery) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRepository
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RepoKsonnetConversionQuery: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RepoKsonnetConversionQuery: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Repo", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRepository
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Repo = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Revision", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRepository
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Revision = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRepository
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Path = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Format", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRepository
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Format = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Environments", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRepository
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Environments = append(m.Environments, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRepository(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRepository
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ConvertedAppFile) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRepository
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ConvertedAppFile: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ConvertedAppFile: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRepository
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Path = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthRepository
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRepository(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRepository
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RepoKsonnetConversionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRepository
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RepoKsonnetConversionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RepoKsonnetConversionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Revision", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRepository
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Revision = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Files", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRepository
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Files = append(m.Files, &ConvertedAppFile{})
+			if err := m.Files[len(m.Files)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRepository(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRepository
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipRepository(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -3675,65 +4525,73 @@ var (
 )
 
 func init() {
-	proto.RegisterFile("server/repository/repository.proto", fileDescriptor_repository_324bad698d34f88e)
+	proto.RegisterFile("server/repository/repository.proto", fileDescriptor_repository_d7fb67d1ac719e10)
 }
 
-var fileDescriptor_repository_324bad698d34f88e = []byte{
-	// 893 bytes of a gzipped FileDescriptorProto
+var fileDescriptor_repository_d7fb67d1ac719e10 = []byte{
+	// 1015 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x56, 0x5f, 0x6f, 0x1b, 0x45,
-	0x10, 0xd7, 0x25, 0xa9, 0x1b, 0x8f, 0xdb, 0x2a, 0xdd, 0x96, 0x60, 0x0e, 0xc7, 0x8d, 0x16, 0x09,
-	0x12, 0xa0, 0x77, 0x8a, 0xe9, 0x43, 0x14, 0x84, 0x50, 0x20, 0x01, 0xa2, 0xf2, 0x00, 0x57, 0x05,
-	0xa9, 0x3c, 0x50, 0x5d, 0xed, 0xc1, 0x59, 0x7c, 0xbe, 0x5d, 0x76, 0xd7, 0x96, 0x4c, 0x95, 0x17,
-	0x24, 0xfa, 0x01, 0xe0, 0x9d, 0xf7, 0x7e, 0x13, 0x24, 0x5e, 0x90, 0xf8, 0x02, 0x28, 0xe2, 0x8d,
-	0x2f, 0x81, 0x76, 0xef, 0x8f, 0xd7, 0xf1, 0xd9, 0x20, 0x14, 0xf1, 0x36, 0x3b, 0xfb, 0x9b, 0x99,
-	0xdf, 0xce, 0xcc, 0xce, 0x2e, 0x50, 0x85, 0x72, 0x8c, 0x32, 0x94, 0x28, 0xb8, 0x62, 0x9a, 0xcb,
-	0x89, 0x23, 0x06, 0x42, 0x72, 0xcd, 0x09, 0x4c, 0x35, 0xfe, 0xdd, 0x3e, 0xef, 0x73, 0xab, 0x0e,
-	0x8d, 0x94, 0x21, 0xfc, 0x56, 0x9f, 0xf3, 0x7e, 0x82, 0x61, 0x2c, 0x58, 0x18, 0xa7, 0x29, 0xd7,
-	0xb1, 0x66, 0x3c, 0x55, 0xf9, 0x2e, 0x1d, 0xec, 0xab, 0x80, 0x71, 0xbb, 0xdb, 0xe5, 0x12, 0xc3,
-	0xf1, 0x5e, 0xd8, 0xc7, 0x14, 0x65, 0xac, 0xb1, 0x97, 0x63, 0x4e, 0xfa, 0x4c, 0x9f, 0x8d, 0x9e,
-	0x06, 0x5d, 0x3e, 0x0c, 0x63, 0x69, 0x43, 0x7c, 0x63, 0x85, 0xfb, 0xdd, 0x5e, 0x28, 0x06, 0x7d,
-	0x63, 0xac, 0xc2, 0x58, 0x88, 0x84, 0x75, 0xad, 0xf3, 0x70, 0xbc, 0x17, 0x27, 0xe2, 0x2c, 0x9e,
-	0x73, 0x45, 0xdf, 0x87, 0x9b, 0x11, 0x0a, 0x7e, 0x28, 0x84, 0xfa, 0x7c, 0x84, 0x72, 0x42, 0x08,
-	0xac, 0x99, 0x13, 0x34, 0xbd, 0x6d, 0x6f, 0xa7, 0x1e, 0x59, 0x99, 0xf8, 0xb0, 0x2e, 0x71, 0xcc,
-	0x14, 0xe3, 0x69, 0x73, 0xc5, 0xea, 0xcb, 0x35, 0xdd, 0x83, 0xeb, 0x87, 0x42, 0x9c, 0xa4, 0x5f,
-	0x73, 0x63, 0xaa, 0x27, 0x02, 0x0b, 0x53, 0x23, 0x1b, 0x9d, 0x88, 0xf5, 0x59, 0x6e, 0x66, 0x65,
-	0xfa, 0x18, 0xee, 0xe4, 0x31, 0x8f, 0x50, 0xc7, 0x2c, 0xf9, 0x6f, 0x91, 0x4b, 0xd7, 0xab, 0x8e,
-	0xeb, 0x5f, 0x3d, 0xd8, 0x9c, 0xf5, 0x1d, 0xa1, 0x12, 0x3c, 0x55, 0x58, 0xc9, 0xee, 0x01, 0x5c,
-	0x1f, 0x28, 0x9e, 0xa6, 0xa8, 0xad, 0xf7, 0x46, 0xc7, 0x0f, 0x9c, 0x82, 0x3e, 0xcc, 0xb6, 0x0e,
-	0x85, 0x78, 0x24, 0xb0, 0x1b, 0x15, 0x50, 0xf2, 0x16, 0xac, 0x9d, 0x61, 0x32, 0xb4, 0x81, 0x1b,
-	0x9d, 0x97, 0x5d, 0x93, 0x4f, 0x30, 0x19, 0x16, 0x78, 0x0b, 0x22, 0x07, 0x50, 0x1f, 0x8c, 0x94,
-	0xe6, 0x43, 0xf6, 0x1d, 0x36, 0xd7, 0xac, 0x45, 0x6b, 0x26, 0x48, 0xb1, 0x59, 0x98, 0x4d, 0xe1,
-	0xf4, 0x3d, 0xd8, 0x28, 0x8a, 0x53, 0x1e, 0x63, 0x17, 0xae, 0x31, 0x8d, 0x43, 0xd5, 0xf4, 0xb6,
-	0x57, 0x77, 0x1a, 0x9d, 0x3b, 0xae, 0xaf, 0xbc, 0x10, 0x51, 0x86, 0xa0, 0x7f, 0x79, 0x70, 0x6b,
-	0xf6, 0x0c, 0x26, 0x09, 0x69, 0x3c, 0x2c, 0x93, 0x60, 0xe4, 0xaa, 0x12, 0x91, 0xcf, 0xe0, 0x06,
-	0xa6, 0x63, 0x26, 0x79, 0x3a, 0xc4, 0x54, 0xab, 0xe6, 0xaa, 0x0d, 0xf6, 0xf6, 0xe2, 0xec, 0x04,
-	0xc7, 0x0e, 0xfc, 0x38, 0xd5, 0x72, 0x12, 0xcd, 0x78, 0xf0, 0x9f, 0xc0, 0xed, 0x39, 0x08, 0xd9,
-	0x80, 0xd5, 0x01, 0x4e, 0x72, 0x36, 0x46, 0x24, 0x0f, 0xe0, 0xda, 0x38, 0x4e, 0x46, 0x98, 0xd7,
-	0xa3, 0x5d, 0x11, 0xd1, 0x71, 0x13, 0x65, 0xe0, 0x83, 0x95, 0x7d, 0x8f, 0x9e, 0x42, 0xc3, 0xc9,
-	0xfe, 0xbf, 0x3e, 0x69, 0x1b, 0xc0, 0xfa, 0xf8, 0x88, 0x25, 0x98, 0x9d, 0xb3, 0x1e, 0x39, 0x1a,
-	0xfa, 0x3a, 0x6c, 0x5c, 0x2e, 0x51, 0xe9, 0xc7, 0x73, 0x3a, 0xef, 0x85, 0x07, 0x64, 0x9e, 0x60,
-	0x25, 0x8d, 0x36, 0xc0, 0x60, 0x5f, 0x7d, 0x81, 0xd2, 0x69, 0x6b, 0x47, 0x53, 0xd5, 0xd8, 0xe4,
-	0x21, 0x34, 0x7a, 0xa8, 0x34, 0x4b, 0xed, 0x7d, 0xce, 0x1b, 0x69, 0x77, 0x79, 0x76, 0x8e, 0xa6,
-	0x06, 0x91, 0x6b, 0x4d, 0x4f, 0x61, 0x6b, 0x29, 0x9a, 0x6c, 0x42, 0x2d, 0x1b, 0x75, 0x39, 0xef,
-	0x7c, 0x45, 0x5a, 0x50, 0x37, 0x27, 0x50, 0x22, 0xee, 0x62, 0x4e, 0x7c, 0xaa, 0xa0, 0xf7, 0xa0,
-	0x6e, 0xda, 0x75, 0xe1, 0x6d, 0xa6, 0xb7, 0xe0, 0x86, 0x01, 0x14, 0xbd, 0x4c, 0x9f, 0x7b, 0x70,
-	0xdb, 0x28, 0x3e, 0x94, 0x18, 0x6b, 0x8c, 0xf0, 0xdb, 0x11, 0x2a, 0x4d, 0x1e, 0x3b, 0x96, 0x8d,
-	0xce, 0x71, 0x30, 0x1d, 0x76, 0x41, 0x31, 0xec, 0xac, 0xf0, 0xa4, 0xdb, 0x0b, 0xc4, 0xa0, 0x1f,
-	0x98, 0x61, 0x17, 0x38, 0xc3, 0x2e, 0x28, 0x86, 0x5d, 0x10, 0x95, 0xd9, 0xc9, 0xc7, 0xc9, 0x26,
-	0xd4, 0x46, 0x42, 0xa1, 0xcc, 0xae, 0xfb, 0x7a, 0x94, 0xaf, 0x68, 0x9a, 0xf1, 0x38, 0x15, 0xbd,
-	0xff, 0x85, 0x47, 0xe7, 0x45, 0x2d, 0x0b, 0x98, 0x29, 0x1f, 0xa1, 0x1c, 0xb3, 0x2e, 0x92, 0xe7,
-	0x1e, 0xac, 0x7d, 0xca, 0x94, 0x26, 0x2f, 0xb9, 0x75, 0x2d, 0x53, 0xea, 0x9f, 0x5c, 0x09, 0x05,
-	0x13, 0x81, 0xb6, 0xbe, 0xff, 0xfd, 0xcf, 0x9f, 0x56, 0x36, 0xc9, 0x5d, 0xfb, 0xce, 0x8c, 0xf7,
-	0xa6, 0xef, 0x18, 0x43, 0x45, 0x86, 0xb0, 0x6e, 0x50, 0x66, 0xee, 0x90, 0x57, 0x2e, 0x73, 0x29,
-	0x9f, 0x0a, 0xbf, 0x55, 0xb5, 0x55, 0x16, 0x77, 0xc7, 0x86, 0xa0, 0x64, 0xbb, 0x2a, 0x44, 0xf8,
-	0xcc, 0xac, 0xce, 0xcd, 0x1b, 0xa5, 0xc8, 0x0f, 0x1e, 0xdc, 0xfc, 0xd8, 0x4e, 0x92, 0x7c, 0x66,
-	0x93, 0x7b, 0x15, 0x9e, 0xdd, 0xb7, 0xc2, 0xa7, 0x8b, 0x01, 0x25, 0x81, 0xd0, 0x12, 0xd8, 0x25,
-	0x6f, 0xfc, 0x13, 0x81, 0xf0, 0x99, 0xb9, 0x62, 0xe7, 0xe4, 0x47, 0x0f, 0x6a, 0x59, 0x2b, 0x92,
-	0xad, 0xcb, 0xfe, 0x67, 0x5a, 0xd4, 0xbf, 0x9a, 0x66, 0xa0, 0xd4, 0x32, 0x6c, 0xd1, 0xca, 0x2a,
-	0x1c, 0x64, 0x2d, 0xfb, 0xb3, 0x07, 0xb5, 0xac, 0x2f, 0xe7, 0x49, 0xcd, 0xf4, 0xeb, 0x55, 0x91,
-	0x0a, 0x2c, 0xa9, 0x1d, 0x7f, 0x49, 0xdd, 0x2c, 0x8f, 0xf3, 0x9c, 0xe0, 0x57, 0x50, 0x3b, 0xc2,
-	0x04, 0x35, 0x2e, 0x6a, 0xdb, 0xe6, 0x65, 0x75, 0x59, 0xa1, 0xd7, 0x6c, 0xa8, 0xad, 0x37, 0x5f,
-	0x5d, 0x52, 0xa1, 0x0f, 0xde, 0xfd, 0xe5, 0xa2, 0xed, 0xfd, 0x76, 0xd1, 0xf6, 0xfe, 0xb8, 0x68,
-	0x7b, 0x5f, 0xde, 0x5f, 0xf6, 0xf5, 0x99, 0xfb, 0x9e, 0x3d, 0xad, 0xd9, 0x5f, 0xce, 0x3b, 0x7f,
-	0x07, 0x00, 0x00, 0xff, 0xff, 0x7b, 0x47, 0xe7, 0xb1, 0xba, 0x09, 0x00, 0x00,
+	0x10, 0xd7, 0xc6, 0x89, 0x13, 0x8f, 0xd3, 0x2a, 0xd9, 0x96, 0x60, 0x0e, 0xc7, 0x8d, 0x16, 0xa9,
+	0x24, 0x40, 0xee, 0x88, 0xa9, 0x50, 0x14, 0x84, 0x50, 0x68, 0x02, 0x44, 0xe5, 0x01, 0xae, 0x0a,
+	0x52, 0x79, 0xa0, 0xba, 0xda, 0x13, 0xe7, 0xb0, 0x7d, 0xbb, 0xdc, 0xae, 0x2d, 0x99, 0x2a, 0x2f,
+	0x48, 0xf4, 0x03, 0xc0, 0x2b, 0xea, 0x3b, 0xdf, 0x04, 0xc1, 0x0b, 0x12, 0x5f, 0x00, 0x45, 0xbc,
+	0xf1, 0x25, 0xd0, 0xee, 0x9d, 0xcf, 0xeb, 0xf3, 0xc5, 0x45, 0x28, 0xe2, 0x6d, 0x6e, 0xf6, 0x37,
+	0x33, 0xbf, 0xd9, 0xf9, 0xb3, 0x07, 0x4c, 0x62, 0x3c, 0xc4, 0xd8, 0x8b, 0x51, 0x70, 0x19, 0x2a,
+	0x1e, 0x8f, 0x2c, 0xd1, 0x15, 0x31, 0x57, 0x9c, 0xc2, 0x44, 0xe3, 0xdc, 0xee, 0xf0, 0x0e, 0x37,
+	0x6a, 0x4f, 0x4b, 0x09, 0xc2, 0xa9, 0x77, 0x38, 0xef, 0xf4, 0xd0, 0x0b, 0x44, 0xe8, 0x05, 0x51,
+	0xc4, 0x55, 0xa0, 0x42, 0x1e, 0xc9, 0xf4, 0x94, 0x75, 0xf7, 0xa5, 0x1b, 0x72, 0x73, 0xda, 0xe2,
+	0x31, 0x7a, 0xc3, 0x3d, 0xaf, 0x83, 0x11, 0xc6, 0x81, 0xc2, 0x76, 0x8a, 0x39, 0xe9, 0x84, 0xea,
+	0x7c, 0xf0, 0xc4, 0x6d, 0xf1, 0xbe, 0x17, 0xc4, 0x26, 0xc4, 0xd7, 0x46, 0xd8, 0x6d, 0xb5, 0x3d,
+	0xd1, 0xed, 0x68, 0x63, 0xe9, 0x05, 0x42, 0xf4, 0xc2, 0x96, 0x71, 0xee, 0x0d, 0xf7, 0x82, 0x9e,
+	0x38, 0x0f, 0x66, 0x5c, 0xb1, 0x0f, 0xe0, 0x86, 0x8f, 0x82, 0x1f, 0x0a, 0x21, 0x3f, 0x1f, 0x60,
+	0x3c, 0xa2, 0x14, 0x16, 0x75, 0x06, 0x35, 0xb2, 0x45, 0xb6, 0x2b, 0xbe, 0x91, 0xa9, 0x03, 0x2b,
+	0x31, 0x0e, 0x43, 0x19, 0xf2, 0xa8, 0xb6, 0x60, 0xf4, 0xd9, 0x37, 0xdb, 0x83, 0xe5, 0x43, 0x21,
+	0x4e, 0xa2, 0x33, 0xae, 0x4d, 0xd5, 0x48, 0xe0, 0xd8, 0x54, 0xcb, 0x5a, 0x27, 0x02, 0x75, 0x9e,
+	0x9a, 0x19, 0x99, 0x3d, 0x82, 0x5b, 0x69, 0xcc, 0x23, 0x54, 0x41, 0xd8, 0xfb, 0x6f, 0x91, 0x33,
+	0xd7, 0x25, 0xcb, 0xf5, 0x6f, 0x04, 0x36, 0xa6, 0x7d, 0xfb, 0x28, 0x05, 0x8f, 0x24, 0x16, 0xb2,
+	0xbb, 0x07, 0xcb, 0x5d, 0xc9, 0xa3, 0x08, 0x95, 0xf1, 0x5e, 0x6d, 0x3a, 0xae, 0x55, 0xd0, 0x07,
+	0xc9, 0xd1, 0xa1, 0x10, 0x0f, 0x05, 0xb6, 0xfc, 0x31, 0x94, 0xbe, 0x09, 0x8b, 0xe7, 0xd8, 0xeb,
+	0x9b, 0xc0, 0xd5, 0xe6, 0xcb, 0xb6, 0xc9, 0x27, 0xd8, 0xeb, 0x8f, 0xf1, 0x06, 0x44, 0x0f, 0xa0,
+	0xd2, 0x1d, 0x48, 0xc5, 0xfb, 0xe1, 0xb7, 0x58, 0x5b, 0x34, 0x16, 0xf5, 0xa9, 0x20, 0xe3, 0xc3,
+	0xb1, 0xd9, 0x04, 0xce, 0xde, 0x87, 0xb5, 0x71, 0x71, 0xb2, 0x34, 0x76, 0x60, 0x29, 0x54, 0xd8,
+	0x97, 0x35, 0xb2, 0x55, 0xda, 0xae, 0x36, 0x6f, 0xd9, 0xbe, 0xd2, 0x42, 0xf8, 0x09, 0x82, 0xfd,
+	0x4d, 0xe0, 0xe6, 0x74, 0x0e, 0xfa, 0x12, 0xa2, 0xa0, 0x9f, 0x5d, 0x82, 0x96, 0x8b, 0x4a, 0x44,
+	0x3f, 0x83, 0x55, 0x8c, 0x86, 0x61, 0xcc, 0xa3, 0x3e, 0x46, 0x4a, 0xd6, 0x4a, 0x26, 0xd8, 0x5b,
+	0x57, 0xdf, 0x8e, 0x7b, 0x6c, 0xc1, 0x8f, 0x23, 0x15, 0x8f, 0xfc, 0x29, 0x0f, 0xce, 0x63, 0x58,
+	0x9f, 0x81, 0xd0, 0x35, 0x28, 0x75, 0x71, 0x94, 0xb2, 0xd1, 0x22, 0xbd, 0x07, 0x4b, 0xc3, 0xa0,
+	0x37, 0xc0, 0xb4, 0x1e, 0x8d, 0x82, 0x88, 0x96, 0x1b, 0x3f, 0x01, 0x1f, 0x2c, 0xec, 0x13, 0x76,
+	0x0a, 0x55, 0xeb, 0xf6, 0xff, 0x75, 0xa6, 0x0d, 0x00, 0xe3, 0xe3, 0xa3, 0xb0, 0x87, 0x49, 0x9e,
+	0x15, 0xdf, 0xd2, 0xb0, 0xbb, 0xb0, 0x96, 0x2f, 0x51, 0xe6, 0x87, 0x58, 0x9d, 0xf7, 0x33, 0x01,
+	0x3a, 0x4b, 0xb0, 0x90, 0x46, 0x03, 0xa0, 0xbb, 0x2f, 0xbf, 0xc0, 0xd8, 0x6a, 0x6b, 0x4b, 0x53,
+	0xd4, 0xd8, 0xf4, 0x01, 0x54, 0xdb, 0x28, 0x55, 0x18, 0x99, 0x79, 0x4e, 0x1b, 0x69, 0x67, 0xfe,
+	0xed, 0x1c, 0x4d, 0x0c, 0x7c, 0xdb, 0x9a, 0x9d, 0xc2, 0xe6, 0x5c, 0x34, 0xdd, 0x80, 0x72, 0xb2,
+	0xea, 0x52, 0xde, 0xe9, 0x17, 0xad, 0x43, 0x45, 0x67, 0x20, 0x45, 0xd0, 0xc2, 0x94, 0xf8, 0x44,
+	0xc1, 0xee, 0x40, 0x45, 0xb7, 0xeb, 0x95, 0xd3, 0xcc, 0x6e, 0xc2, 0xaa, 0x06, 0x8c, 0x7b, 0x99,
+	0x3d, 0x23, 0xb0, 0xae, 0x15, 0xf7, 0x63, 0x0c, 0x14, 0xfa, 0xf8, 0xcd, 0x00, 0xa5, 0xa2, 0x8f,
+	0x2c, 0xcb, 0x6a, 0xf3, 0xd8, 0x9d, 0x2c, 0x3b, 0x77, 0xbc, 0xec, 0x8c, 0xf0, 0xb8, 0xd5, 0x76,
+	0x45, 0xb7, 0xe3, 0xea, 0x65, 0xe7, 0x5a, 0xcb, 0xce, 0x1d, 0x2f, 0x3b, 0xd7, 0xcf, 0x6e, 0x27,
+	0x5d, 0x27, 0x1b, 0x50, 0x1e, 0x08, 0x89, 0x71, 0x32, 0xee, 0x2b, 0x7e, 0xfa, 0xc5, 0xa2, 0x84,
+	0xc7, 0xa9, 0x68, 0xff, 0x2f, 0x3c, 0xd8, 0x4f, 0x04, 0x1c, 0xad, 0x4c, 0xab, 0x70, 0x9f, 0x47,
+	0xc3, 0xa4, 0xf6, 0xd7, 0xb6, 0x09, 0x75, 0xaa, 0x67, 0x3c, 0xee, 0x07, 0xca, 0xf4, 0x4a, 0xc5,
+	0x4f, 0xbf, 0x28, 0xcb, 0x4d, 0xf6, 0x92, 0xe9, 0xf8, 0x29, 0x1d, 0x3b, 0x80, 0xb5, 0x84, 0x92,
+	0xc2, 0xf6, 0xa1, 0x10, 0x7a, 0x10, 0x8a, 0x7a, 0x5e, 0xeb, 0xda, 0x81, 0x0a, 0x0c, 0x9f, 0x55,
+	0xdf, 0xc8, 0x8c, 0xc3, 0x66, 0x61, 0x66, 0xd9, 0x02, 0xb3, 0x13, 0x21, 0xb9, 0x44, 0x9a, 0xb0,
+	0x74, 0x66, 0xe6, 0x70, 0x61, 0xab, 0x94, 0x5f, 0x94, 0x79, 0x46, 0x7e, 0x02, 0x6d, 0xfe, 0xba,
+	0x9c, 0x14, 0x2f, 0x81, 0x3d, 0xc4, 0x78, 0x18, 0xb6, 0x90, 0x3e, 0x23, 0xb0, 0xf8, 0x69, 0x28,
+	0x15, 0x7d, 0xc9, 0xf6, 0x91, 0xb5, 0xa7, 0x73, 0x72, 0x2d, 0xe5, 0xd4, 0x11, 0x58, 0xfd, 0xbb,
+	0x3f, 0xfe, 0xfa, 0x71, 0x61, 0x83, 0xde, 0x36, 0x6f, 0xf6, 0x70, 0x6f, 0xf2, 0x4f, 0x10, 0xa2,
+	0xa4, 0x7d, 0x58, 0xd1, 0x28, 0xbd, 0xc3, 0xe9, 0x2b, 0x79, 0x2e, 0xd9, 0xb3, 0xeb, 0xd4, 0x8b,
+	0x8e, 0xb2, 0x41, 0xd9, 0x36, 0x21, 0x18, 0xdd, 0x2a, 0x0a, 0xe1, 0x3d, 0xd5, 0x5f, 0x17, 0xfa,
+	0xbd, 0x97, 0xf4, 0x7b, 0x02, 0x37, 0x3e, 0x46, 0x35, 0x79, 0xff, 0xe8, 0x9d, 0x02, 0xcf, 0xf6,
+	0xbb, 0xeb, 0xb0, 0xab, 0x01, 0x19, 0x01, 0xcf, 0x10, 0xd8, 0xa1, 0xaf, 0xbf, 0x88, 0x80, 0xf7,
+	0x54, 0x77, 0xc6, 0x05, 0x7d, 0x4e, 0x60, 0x3d, 0xad, 0xd8, 0xe4, 0xa1, 0xa0, 0x77, 0xf3, 0xa1,
+	0x8a, 0x07, 0xc0, 0xd9, 0x79, 0x21, 0x2e, 0x63, 0xf6, 0xae, 0x61, 0xf6, 0x36, 0x75, 0xe7, 0x31,
+	0x4b, 0x5f, 0xee, 0xdd, 0x56, 0x66, 0x4f, 0x7f, 0x20, 0x50, 0x4e, 0xf6, 0x0e, 0xdd, 0xcc, 0x47,
+	0x9b, 0xda, 0x47, 0xce, 0xf5, 0x4c, 0x3e, 0x63, 0x86, 0x68, 0x9d, 0x15, 0xb6, 0xc9, 0x41, 0x32,
+	0xe4, 0xcf, 0x09, 0x94, 0x93, 0x25, 0x34, 0x4b, 0x6a, 0x6a, 0x39, 0x5d, 0x17, 0x29, 0xd7, 0x90,
+	0xda, 0x76, 0xe6, 0x34, 0x96, 0xe1, 0x71, 0x91, 0x12, 0xfc, 0x0a, 0xca, 0x47, 0xd8, 0x43, 0x85,
+	0x57, 0xcd, 0x55, 0x2d, 0xaf, 0xce, 0x0a, 0xf5, 0x9a, 0x09, 0xb5, 0xf9, 0xc6, 0xab, 0x73, 0x0a,
+	0xf5, 0xe1, 0x7b, 0xbf, 0x5c, 0x36, 0xc8, 0xef, 0x97, 0x0d, 0xf2, 0xe7, 0x65, 0x83, 0x7c, 0xb9,
+	0x3b, 0xef, 0x3f, 0x77, 0xe6, 0x5f, 0xfc, 0x49, 0xd9, 0xfc, 0xd2, 0xbe, 0xf3, 0xcf, 0x00, 0x2a,
+	0x14, 0x36, 0x3f, 0xa7, 0x0b, 0x00, 0x00,
 }
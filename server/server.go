@@ -46,6 +46,7 @@ import (
 	"github.com/argoproj/argo-cd/reposerver"
 	"github.com/argoproj/argo-cd/server/account"
 	"github.com/argoproj/argo-cd/server/application"
+	"github.com/argoproj/argo-cd/server/badge"
 	"github.com/argoproj/argo-cd/server/cluster"
 	"github.com/argoproj/argo-cd/server/metrics"
 	"github.com/argoproj/argo-cd/server/project"
@@ -56,6 +57,7 @@ import (
 	"github.com/argoproj/argo-cd/server/version"
 	"github.com/argoproj/argo-cd/util"
 	argocache "github.com/argoproj/argo-cd/util/cache"
+	"github.com/argoproj/argo-cd/util/cli"
 	"github.com/argoproj/argo-cd/util/db"
 	"github.com/argoproj/argo-cd/util/dex"
 	dexutil "github.com/argoproj/argo-cd/util/dex"
@@ -113,6 +115,7 @@ type ArgoCDServer struct {
 	sessionMgr   *util_session.SessionManager
 	settingsMgr  *settings_util.SettingsManager
 	enf          *rbac.Enforcer
+	policyEnf    *rbacpolicy.RBACPolicyEnforcer
 	appInformer  cache.SharedIndexInformer
 	appLister    applister.ApplicationLister
 	projInformer cache.SharedIndexInformer
@@ -132,6 +135,8 @@ type ArgoCDServerOpts struct {
 	RepoClientset          reposerver.Clientset
 	AppControllerClientset controller.Clientset
 	TLSConfigCustomizer    tlsutil.ConfigCustomizer
+	// LogLevel is the log level of the --loglevel flag, which applies unless argocd-cm sets the log level
+	LogLevel string
 }
 
 // initializeDefaultProject creates the default project if it does not already exist
@@ -198,11 +203,12 @@ func NewServer(opts ArgoCDServerOpts) *ArgoCDServer {
 
 	return &ArgoCDServer{
 		ArgoCDServerOpts: opts,
-		log:              log.NewEntry(log.New()),
+		log:              log.NewEntry(log.StandardLogger()),
 		settings:         settings,
 		sessionMgr:       sessionMgr,
 		settingsMgr:      settingsMgr,
 		enf:              enf,
+		policyEnf:        policyEnf,
 		appInformer:      appInformer,
 		appLister:        appLister,
 		projInformer:     projInformer,
@@ -279,6 +285,7 @@ func (a *ArgoCDServer) Run(ctx context.Context, port int) {
 	}
 	go a.watchSettings(ctx)
 	go a.rbacPolicyLoader(ctx)
+	go a.registerWebhooks(ctx)
 	go func() { a.checkServeErr("tcpm", tcpm.Serve()) }()
 	go func() { a.checkServeErr("metrics", metricsServ.ListenAndServe()) }()
 	if !cache.WaitForCacheSync(ctx.Done(), a.appInformer.HasSynced) {
@@ -317,7 +324,8 @@ func (a *ArgoCDServer) Shutdown() {
 }
 
 // watchSettings watches the configmap and secret for any setting updates that would warrant a
-// restart of the API server.
+// restart of the API server. Settings which are safe to change at runtime, such as the log level,
+// are applied without a restart.
 func (a *ArgoCDServer) watchSettings(ctx context.Context) {
 	a.settingsMgr.StartNotifier(ctx, a.settings)
 	updateCh := make(chan struct{}, 1)
@@ -330,6 +338,7 @@ func (a *ArgoCDServer) watchSettings(ctx context.Context) {
 	prevGitHubSecret := a.settings.WebhookGitHubSecret
 	prevGitLabSecret := a.settings.WebhookGitLabSecret
 	prevBitBucketUUID := a.settings.WebhookBitbucketUUID
+	prevManageWebhooks := a.settings.ManageWebhooks
 	var prevCert, prevCertKey string
 	if a.settings.Certificate != nil {
 		prevCert, prevCertKey = tlsutil.EncodeX509KeyPairString(*a.settings.Certificate)
@@ -337,6 +346,7 @@ func (a *ArgoCDServer) watchSettings(ctx context.Context) {
 
 	for {
 		<-updateCh
+		cli.UpdateLogLevel(a.settings.ServerLogLevel, a.LogLevel)
 		newDexCfgBytes, err := dex.GenerateDexConfigYAML(a.settings)
 		errors.CheckError(err)
 		if string(newDexCfgBytes) != string(prevDexCfgBytes) {
@@ -363,6 +373,10 @@ func (a *ArgoCDServer) watchSettings(ctx context.Context) {
 			log.Infof("bitbucket uuid modified. restarting")
 			break
 		}
+		if prevManageWebhooks != a.settings.ManageWebhooks {
+			log.Infof("webhook management modified. restarting")
+			break
+		}
 		var newCert, newCertKey string
 		if a.settings.Certificate != nil {
 			newCert, newCertKey = tlsutil.EncodeX509KeyPairString(*a.settings.Certificate)
@@ -378,6 +392,22 @@ func (a *ArgoCDServer) watchSettings(ctx context.Context) {
 	close(updateCh)
 }
 
+// registerWebhooks registers the Argo CD webhook in all repositories, if webhook management is
+// enabled. Since the server restarts when the URL or webhook secrets change, this keeps the
+// webhooks of the repositories up-to-date.
+func (a *ArgoCDServer) registerWebhooks(ctx context.Context) {
+	registrar := webhook.NewRegistrar(a.settings)
+	if !registrar.Enabled() {
+		return
+	}
+	repos, err := db.NewDB(a.Namespace, a.settingsMgr, a.KubeClientset).ListRepositories(ctx)
+	if err != nil {
+		log.Warnf("Failed to list repositories to register webhooks: %v", err)
+		return
+	}
+	registrar.RegisterWebhooks(repos)
+}
+
 func (a *ArgoCDServer) rbacPolicyLoader(ctx context.Context) {
 	err := a.enf.RunPolicyLoader(ctx)
 	errors.CheckError(err)
@@ -409,6 +439,7 @@ func (a *ArgoCDServer) newGRPCServer() *grpc.Server {
 	// This is because TLS handshaking occurs in cmux handling
 	sOpts = append(sOpts, grpc.StreamInterceptor(grpc_middleware.ChainStreamServer(
 		grpc_logrus.StreamServerInterceptor(a.log),
+		grpc_util.CorrelationIDStreamServerInterceptor(true),
 		grpc_auth.StreamServerInterceptor(a.authenticate),
 		grpc_util.PayloadStreamServerInterceptor(a.log, true, func(ctx netCtx.Context, fullMethodName string, servingObject interface{}) bool {
 			return !sensitiveMethods[fullMethodName]
@@ -419,6 +450,7 @@ func (a *ArgoCDServer) newGRPCServer() *grpc.Server {
 	sOpts = append(sOpts, grpc.UnaryInterceptor(grpc_middleware.ChainUnaryServer(
 		bug21955WorkaroundInterceptor,
 		grpc_logrus.UnaryServerInterceptor(a.log),
+		grpc_util.CorrelationIDUnaryServerInterceptor(true),
 		grpc_auth.UnaryServerInterceptor(a.authenticate),
 		grpc_util.PayloadUnaryServerInterceptor(a.log, true, func(ctx netCtx.Context, fullMethodName string, servingObject interface{}) bool {
 			return !sensitiveMethods[fullMethodName]
@@ -429,13 +461,13 @@ func (a *ArgoCDServer) newGRPCServer() *grpc.Server {
 	grpcS := grpc.NewServer(sOpts...)
 	db := db.NewDB(a.Namespace, a.settingsMgr, a.KubeClientset)
 	clusterService := cluster.NewServer(db, a.enf, argocache.NewInMemoryCache(cluster.DefaultClusterStatusCacheExpiration))
-	repoService := repository.NewServer(a.RepoClientset, db, a.enf, argocache.NewInMemoryCache(repository.DefaultRepoStatusCacheExpiration))
+	repoService := repository.NewServer(a.RepoClientset, db, a.enf, argocache.NewInMemoryCache(repository.DefaultRepoStatusCacheExpiration), webhook.NewRegistrar(a.settings))
 	sessionService := session.NewServer(a.sessionMgr)
 	projectLock := util.NewKeyLock()
-	applicationService := application.NewServer(a.Namespace, a.KubeClientset, a.AppClientset, a.RepoClientset, a.AppControllerClientset, kube.KubectlCmd{}, db, a.enf, projectLock)
+	applicationService := application.NewServer(a.Namespace, a.KubeClientset, a.AppClientset, a.RepoClientset, a.AppControllerClientset, kube.KubectlCmd{}, db, a.enf, projectLock, a.settingsMgr)
 	projectService := project.NewServer(a.Namespace, a.KubeClientset, a.AppClientset, a.enf, projectLock, a.sessionMgr)
 	settingsService := settings.NewServer(a.settingsMgr)
-	accountService := account.NewServer(a.sessionMgr, a.settingsMgr)
+	accountService := account.NewServer(a.sessionMgr, a.settingsMgr, a.enf, a.policyEnf)
 	version.RegisterVersionServiceServer(grpcS, &version.Server{})
 	cluster.RegisterClusterServiceServer(grpcS, clusterService)
 	application.RegisterApplicationServiceServer(grpcS, applicationService)
@@ -494,7 +526,8 @@ func (a *ArgoCDServer) newHTTPServer(ctx context.Context, port int) *http.Server
 	// we use our own Marshaler
 	gwMuxOpts := runtime.WithMarshalerOption(runtime.MIMEWildcard, new(jsonutil.JSONMarshaler))
 	gwCookieOpts := runtime.WithForwardResponseOption(a.translateGrpcCookieHeader)
-	gwmux := runtime.NewServeMux(gwMuxOpts, gwCookieOpts)
+	gwHeaderOpts := runtime.WithIncomingHeaderMatcher(incomingHeaderMatcher)
+	gwmux := runtime.NewServeMux(gwMuxOpts, gwCookieOpts, gwHeaderOpts)
 	mux.Handle("/api/", gwmux)
 	mustRegisterGWHandler(version.RegisterVersionServiceHandlerFromEndpoint, ctx, gwmux, endpoint, dOpts)
 	mustRegisterGWHandler(cluster.RegisterClusterServiceHandlerFromEndpoint, ctx, gwmux, endpoint, dOpts)
@@ -518,6 +551,9 @@ func (a *ArgoCDServer) newHTTPServer(ctx context.Context, port int) *http.Server
 	acdWebhookHandler := webhook.NewHandler(a.Namespace, a.AppClientset, a.settings)
 	mux.HandleFunc("/api/webhook", acdWebhookHandler.Handler)
 
+	// Status badges of applications, which are served without authentication
+	mux.Handle(badge.BadgePath, badge.NewHandler(a.appLister, a.Namespace, a.settings))
+
 	// Serve cli binaries directly from API server
 	registerDownloadHandlers(mux, "/download")
 
@@ -528,6 +564,15 @@ func (a *ArgoCDServer) newHTTPServer(ctx context.Context, port int) *http.Server
 	return &httpS
 }
 
+// incomingHeaderMatcher forwards the correlation ID header of HTTP requests to the gRPC call, in
+// addition to the headers forwarded by default
+func incomingHeaderMatcher(key string) (string, bool) {
+	if strings.EqualFold(key, grpc_util.CorrelationIDKey) {
+		return grpc_util.CorrelationIDKey, true
+	}
+	return runtime.DefaultHeaderMatcher(key)
+}
+
 // registerDexHandlers will register dex HTTP handlers, creating the the OAuth client app
 func (a *ArgoCDServer) registerDexHandlers(mux *http.ServeMux) {
 	if !a.settings.IsSSOConfigured() {
@@ -361,3 +361,34 @@ func TestRevokedToken(t *testing.T) {
 	assert.False(t, s.enf.Enforce(claims, "projects", "get", existingProj.ObjectMeta.Name))
 	assert.False(t, s.enf.Enforce(claims, "applications", "get", defaultTestObject))
 }
+
+func TestEvaluateSubject(t *testing.T) {
+	kubeclientset := fake.NewSimpleClientset(fakeConfigMap())
+	enf := rbac.NewEnforcer(kubeclientset, fakeNamespace, common.ArgoCDConfigMapName, nil)
+	enf.SetBuiltinPolicy(box.String(builtinPolicyFile))
+	rbacEnf := rbacpolicy.NewRBACPolicyEnforcer(enf, newFakeProjLister())
+	enf.SetClaimsEnforcerFunc(rbacEnf.EnforceClaims)
+	enf.SetUserPolicy(`
+p, role:deployer, applications, sync, */*, allow
+p, role:deployer, applications, sync, foo/prod-*, deny
+g, org1:team1, role:deployer
+`)
+
+	allowed, policies := rbacEnf.EvaluateSubject("alice", []string{"org1:team1"}, "applications", "sync", "foo/guestbook")
+	assert.True(t, allowed)
+	assert.Equal(t, []string{"p, role:deployer, applications, sync, */*, allow"}, policies)
+
+	allowed, policies = rbacEnf.EvaluateSubject("alice", []string{"org1:team1"}, "applications", "sync", "foo/prod-guestbook")
+	assert.False(t, allowed)
+	assert.Equal(t, []string{
+		"p, role:deployer, applications, sync, */*, allow",
+		"p, role:deployer, applications, sync, foo/prod-*, deny",
+	}, policies)
+
+	allowed, policies = rbacEnf.EvaluateSubject("alice", nil, "applications", "sync", "foo/guestbook")
+	assert.False(t, allowed)
+	assert.Empty(t, policies)
+
+	allowed, _ = rbacEnf.EvaluateSubject("admin", nil, "accounts", "impersonate", "alice")
+	assert.True(t, allowed)
+}
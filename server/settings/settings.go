@@ -3,6 +3,7 @@ package settings
 import (
 	"github.com/argoproj/argo-cd/util/settings"
 	"github.com/ghodss/yaml"
+	log "github.com/sirupsen/logrus"
 	"golang.org/x/net/context"
 )
 
@@ -41,6 +42,28 @@ func (s *Server) Get(ctx context.Context, q *SettingsQuery) (*Settings, error) {
 			ClientID: oidcConfig.ClientID,
 		}
 	}
+	ignoreDifferences, err := argoCDSettings.GetResourceIgnoreDifferences()
+	if err != nil {
+		log.Warnf("Failed to get the ignored differences of the resource customizations: %v", err)
+	}
+	for _, item := range ignoreDifferences {
+		set.ResourceIgnoreDifferences = append(set.ResourceIgnoreDifferences, &ResourceIgnoreDifferences{
+			Group:        item.Group,
+			Kind:         item.Kind,
+			JSONPointers: item.JSONPointers,
+		})
+	}
+	scripts, err := argoCDSettings.GetResourceNormalizerScripts()
+	if err != nil {
+		log.Warnf("Failed to get the normalizer scripts of the resource customizations: %v", err)
+	}
+	for _, item := range scripts {
+		set.ResourceNormalizerScripts = append(set.ResourceNormalizerScripts, &ResourceNormalizerScript{
+			Group:  item.Group,
+			Kind:   item.Kind,
+			Script: item.Script,
+		})
+	}
 	return &set, nil
 }
 
@@ -42,7 +42,7 @@ func (m *SettingsQuery) Reset()         { *m = SettingsQuery{} }
 func (m *SettingsQuery) String() string { return proto.CompactTextString(m) }
 func (*SettingsQuery) ProtoMessage()    {}
 func (*SettingsQuery) Descriptor() ([]byte, []int) {
-	return fileDescriptor_settings_902e174a76eb35c2, []int{0}
+	return fileDescriptor_settings_c856682a6904e082, []int{0}
 }
 func (m *SettingsQuery) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -72,19 +72,21 @@ func (m *SettingsQuery) XXX_DiscardUnknown() {
 var xxx_messageInfo_SettingsQuery proto.InternalMessageInfo
 
 type Settings struct {
-	URL                  string      `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
-	DexConfig            *DexConfig  `protobuf:"bytes,2,opt,name=dexConfig" json:"dexConfig,omitempty"`
-	OIDCConfig           *OIDCConfig `protobuf:"bytes,3,opt,name=oidcConfig" json:"oidcConfig,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
-	XXX_unrecognized     []byte      `json:"-"`
-	XXX_sizecache        int32       `json:"-"`
+	URL                       string                       `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	DexConfig                 *DexConfig                   `protobuf:"bytes,2,opt,name=dexConfig" json:"dexConfig,omitempty"`
+	OIDCConfig                *OIDCConfig                  `protobuf:"bytes,3,opt,name=oidcConfig" json:"oidcConfig,omitempty"`
+	ResourceIgnoreDifferences []*ResourceIgnoreDifferences `protobuf:"bytes,4,rep,name=resourceIgnoreDifferences" json:"resourceIgnoreDifferences,omitempty"`
+	ResourceNormalizerScripts []*ResourceNormalizerScript  `protobuf:"bytes,5,rep,name=resourceNormalizerScripts" json:"resourceNormalizerScripts,omitempty"`
+	XXX_NoUnkeyedLiteral      struct{}                     `json:"-"`
+	XXX_unrecognized          []byte                       `json:"-"`
+	XXX_sizecache             int32                        `json:"-"`
 }
 
 func (m *Settings) Reset()         { *m = Settings{} }
 func (m *Settings) String() string { return proto.CompactTextString(m) }
 func (*Settings) ProtoMessage()    {}
 func (*Settings) Descriptor() ([]byte, []int) {
-	return fileDescriptor_settings_902e174a76eb35c2, []int{1}
+	return fileDescriptor_settings_c856682a6904e082, []int{1}
 }
 func (m *Settings) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -134,6 +136,20 @@ func (m *Settings) GetOIDCConfig() *OIDCConfig {
 	return nil
 }
 
+func (m *Settings) GetResourceIgnoreDifferences() []*ResourceIgnoreDifferences {
+	if m != nil {
+		return m.ResourceIgnoreDifferences
+	}
+	return nil
+}
+
+func (m *Settings) GetResourceNormalizerScripts() []*ResourceNormalizerScript {
+	if m != nil {
+		return m.ResourceNormalizerScripts
+	}
+	return nil
+}
+
 type DexConfig struct {
 	Connectors           []*Connector `protobuf:"bytes,1,rep,name=connectors" json:"connectors,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
@@ -145,7 +161,7 @@ func (m *DexConfig) Reset()         { *m = DexConfig{} }
 func (m *DexConfig) String() string { return proto.CompactTextString(m) }
 func (*DexConfig) ProtoMessage()    {}
 func (*DexConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_settings_902e174a76eb35c2, []int{2}
+	return fileDescriptor_settings_c856682a6904e082, []int{2}
 }
 func (m *DexConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -193,7 +209,7 @@ func (m *Connector) Reset()         { *m = Connector{} }
 func (m *Connector) String() string { return proto.CompactTextString(m) }
 func (*Connector) ProtoMessage()    {}
 func (*Connector) Descriptor() ([]byte, []int) {
-	return fileDescriptor_settings_902e174a76eb35c2, []int{3}
+	return fileDescriptor_settings_c856682a6904e082, []int{3}
 }
 func (m *Connector) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -249,7 +265,7 @@ func (m *OIDCConfig) Reset()         { *m = OIDCConfig{} }
 func (m *OIDCConfig) String() string { return proto.CompactTextString(m) }
 func (*OIDCConfig) ProtoMessage()    {}
 func (*OIDCConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_settings_902e174a76eb35c2, []int{4}
+	return fileDescriptor_settings_c856682a6904e082, []int{4}
 }
 func (m *OIDCConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -299,12 +315,141 @@ func (m *OIDCConfig) GetClientID() string {
 	return ""
 }
 
+type ResourceIgnoreDifferences struct {
+	Group                string   `protobuf:"bytes,1,opt,name=group,proto3" json:"group,omitempty"`
+	Kind                 string   `protobuf:"bytes,2,opt,name=kind,proto3" json:"kind,omitempty"`
+	JSONPointers         []string `protobuf:"bytes,3,rep,name=jsonPointers" json:"jsonPointers,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ResourceIgnoreDifferences) Reset()         { *m = ResourceIgnoreDifferences{} }
+func (m *ResourceIgnoreDifferences) String() string { return proto.CompactTextString(m) }
+func (*ResourceIgnoreDifferences) ProtoMessage()    {}
+func (*ResourceIgnoreDifferences) Descriptor() ([]byte, []int) {
+	return fileDescriptor_settings_c856682a6904e082, []int{5}
+}
+func (m *ResourceIgnoreDifferences) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ResourceIgnoreDifferences) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ResourceIgnoreDifferences.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (dst *ResourceIgnoreDifferences) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ResourceIgnoreDifferences.Merge(dst, src)
+}
+func (m *ResourceIgnoreDifferences) XXX_Size() int {
+	return m.Size()
+}
+func (m *ResourceIgnoreDifferences) XXX_DiscardUnknown() {
+	xxx_messageInfo_ResourceIgnoreDifferences.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ResourceIgnoreDifferences proto.InternalMessageInfo
+
+func (m *ResourceIgnoreDifferences) GetGroup() string {
+	if m != nil {
+		return m.Group
+	}
+	return ""
+}
+
+func (m *ResourceIgnoreDifferences) GetKind() string {
+	if m != nil {
+		return m.Kind
+	}
+	return ""
+}
+
+func (m *ResourceIgnoreDifferences) GetJSONPointers() []string {
+	if m != nil {
+		return m.JSONPointers
+	}
+	return nil
+}
+
+// ResourceNormalizerScript is a Lua script which normalizes the resources of a kind before diffing all applications
+type ResourceNormalizerScript struct {
+	Group                string   `protobuf:"bytes,1,opt,name=group,proto3" json:"group,omitempty"`
+	Kind                 string   `protobuf:"bytes,2,opt,name=kind,proto3" json:"kind,omitempty"`
+	Script               string   `protobuf:"bytes,3,opt,name=script,proto3" json:"script,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ResourceNormalizerScript) Reset()         { *m = ResourceNormalizerScript{} }
+func (m *ResourceNormalizerScript) String() string { return proto.CompactTextString(m) }
+func (*ResourceNormalizerScript) ProtoMessage()    {}
+func (*ResourceNormalizerScript) Descriptor() ([]byte, []int) {
+	return fileDescriptor_settings_c856682a6904e082, []int{6}
+}
+func (m *ResourceNormalizerScript) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ResourceNormalizerScript) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ResourceNormalizerScript.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (dst *ResourceNormalizerScript) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ResourceNormalizerScript.Merge(dst, src)
+}
+func (m *ResourceNormalizerScript) XXX_Size() int {
+	return m.Size()
+}
+func (m *ResourceNormalizerScript) XXX_DiscardUnknown() {
+	xxx_messageInfo_ResourceNormalizerScript.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ResourceNormalizerScript proto.InternalMessageInfo
+
+func (m *ResourceNormalizerScript) GetGroup() string {
+	if m != nil {
+		return m.Group
+	}
+	return ""
+}
+
+func (m *ResourceNormalizerScript) GetKind() string {
+	if m != nil {
+		return m.Kind
+	}
+	return ""
+}
+
+func (m *ResourceNormalizerScript) GetScript() string {
+	if m != nil {
+		return m.Script
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*SettingsQuery)(nil), "cluster.SettingsQuery")
 	proto.RegisterType((*Settings)(nil), "cluster.Settings")
 	proto.RegisterType((*DexConfig)(nil), "cluster.DexConfig")
 	proto.RegisterType((*Connector)(nil), "cluster.Connector")
 	proto.RegisterType((*OIDCConfig)(nil), "cluster.OIDCConfig")
+	proto.RegisterType((*ResourceIgnoreDifferences)(nil), "cluster.ResourceIgnoreDifferences")
+	proto.RegisterType((*ResourceNormalizerScript)(nil), "cluster.ResourceNormalizerScript")
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -443,6 +588,30 @@ func (m *Settings) MarshalTo(dAtA []byte) (int, error) {
 		}
 		i += n2
 	}
+	if len(m.ResourceIgnoreDifferences) > 0 {
+		for _, msg := range m.ResourceIgnoreDifferences {
+			dAtA[i] = 0x22
+			i++
+			i = encodeVarintSettings(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if len(m.ResourceNormalizerScripts) > 0 {
+		for _, msg := range m.ResourceNormalizerScripts {
+			dAtA[i] = 0x2a
+			i++
+			i = encodeVarintSettings(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
@@ -554,6 +723,93 @@ func (m *OIDCConfig) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
+func (m *ResourceIgnoreDifferences) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ResourceIgnoreDifferences) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Group) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintSettings(dAtA, i, uint64(len(m.Group)))
+		i += copy(dAtA[i:], m.Group)
+	}
+	if len(m.Kind) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintSettings(dAtA, i, uint64(len(m.Kind)))
+		i += copy(dAtA[i:], m.Kind)
+	}
+	if len(m.JSONPointers) > 0 {
+		for _, s := range m.JSONPointers {
+			dAtA[i] = 0x1a
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *ResourceNormalizerScript) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ResourceNormalizerScript) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Group) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintSettings(dAtA, i, uint64(len(m.Group)))
+		i += copy(dAtA[i:], m.Group)
+	}
+	if len(m.Kind) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintSettings(dAtA, i, uint64(len(m.Kind)))
+		i += copy(dAtA[i:], m.Kind)
+	}
+	if len(m.Script) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintSettings(dAtA, i, uint64(len(m.Script)))
+		i += copy(dAtA[i:], m.Script)
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
 func encodeVarintSettings(dAtA []byte, offset int, v uint64) int {
 	for v >= 1<<7 {
 		dAtA[offset] = uint8(v&0x7f | 0x80)
@@ -587,6 +843,18 @@ func (m *Settings) Size() (n int) {
 		l = m.OIDCConfig.Size()
 		n += 1 + l + sovSettings(uint64(l))
 	}
+	if len(m.ResourceIgnoreDifferences) > 0 {
+		for _, e := range m.ResourceIgnoreDifferences {
+			l = e.Size()
+			n += 1 + l + sovSettings(uint64(l))
+		}
+	}
+	if len(m.ResourceNormalizerScripts) > 0 {
+		for _, e := range m.ResourceNormalizerScripts {
+			l = e.Size()
+			n += 1 + l + sovSettings(uint64(l))
+		}
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -646,6 +914,50 @@ func (m *OIDCConfig) Size() (n int) {
 	return n
 }
 
+func (m *ResourceIgnoreDifferences) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Group)
+	if l > 0 {
+		n += 1 + l + sovSettings(uint64(l))
+	}
+	l = len(m.Kind)
+	if l > 0 {
+		n += 1 + l + sovSettings(uint64(l))
+	}
+	if len(m.JSONPointers) > 0 {
+		for _, s := range m.JSONPointers {
+			l = len(s)
+			n += 1 + l + sovSettings(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ResourceNormalizerScript) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Group)
+	if l > 0 {
+		n += 1 + l + sovSettings(uint64(l))
+	}
+	l = len(m.Kind)
+	if l > 0 {
+		n += 1 + l + sovSettings(uint64(l))
+	}
+	l = len(m.Script)
+	if l > 0 {
+		n += 1 + l + sovSettings(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
 func sovSettings(x uint64) (n int) {
 	for {
 		n++
@@ -834,40 +1146,102 @@ func (m *Settings) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipSettings(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResourceIgnoreDifferences", wireType)
 			}
-			if skippy < 0 {
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSettings
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
 				return ErrInvalidLengthSettings
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + msglen
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *DexConfig) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowSettings
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			m.ResourceIgnoreDifferences = append(m.ResourceIgnoreDifferences, &ResourceIgnoreDifferences{})
+			if err := m.ResourceIgnoreDifferences[len(m.ResourceIgnoreDifferences)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResourceNormalizerScripts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSettings
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSettings
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ResourceNormalizerScripts = append(m.ResourceNormalizerScripts, &ResourceNormalizerScript{})
+			if err := m.ResourceNormalizerScripts[len(m.ResourceNormalizerScripts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSettings(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthSettings
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DexConfig) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSettings
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
 			b := dAtA[iNdEx]
 			iNdEx++
@@ -1185,6 +1559,282 @@ func (m *OIDCConfig) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *ResourceIgnoreDifferences) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSettings
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ResourceIgnoreDifferences: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ResourceIgnoreDifferences: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Group", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSettings
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSettings
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Group = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSettings
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSettings
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Kind = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JSONPointers", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSettings
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSettings
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.JSONPointers = append(m.JSONPointers, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSettings(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthSettings
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ResourceNormalizerScript) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSettings
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ResourceNormalizerScript: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ResourceNormalizerScript: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Group", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSettings
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSettings
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Group = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSettings
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSettings
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Kind = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Script", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSettings
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSettings
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Script = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSettings(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthSettings
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipSettings(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -1291,34 +1941,43 @@ var (
 )
 
 func init() {
-	proto.RegisterFile("server/settings/settings.proto", fileDescriptor_settings_902e174a76eb35c2)
-}
-
-var fileDescriptor_settings_902e174a76eb35c2 = []byte{
-	// 397 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x52, 0xcd, 0x8a, 0xdb, 0x30,
-	0x18, 0x44, 0x71, 0x49, 0xe2, 0xaf, 0x3f, 0x69, 0xd5, 0x12, 0xdc, 0x50, 0x9c, 0xe0, 0x53, 0xa0,
-	0xd4, 0x6e, 0x93, 0x53, 0x4f, 0x05, 0x3b, 0x50, 0x52, 0x0a, 0xa5, 0x0a, 0xbd, 0x14, 0x7a, 0x70,
-	0x14, 0xd5, 0x55, 0x71, 0xa4, 0x20, 0xcb, 0xa1, 0xb9, 0xf6, 0x15, 0xf6, 0xba, 0x0f, 0xb4, 0xc7,
-	0x85, 0xbd, 0x87, 0xc5, 0xec, 0x83, 0x2c, 0x51, 0x6c, 0x27, 0xd9, 0xdd, 0xdb, 0x68, 0x46, 0x23,
-	0xbe, 0xd1, 0x37, 0xe0, 0x66, 0x4c, 0xad, 0x99, 0x0a, 0x32, 0xa6, 0x35, 0x17, 0x49, 0x56, 0x03,
-	0x7f, 0xa5, 0xa4, 0x96, 0xb8, 0x45, 0xd3, 0x3c, 0xd3, 0x4c, 0xf5, 0x5e, 0x25, 0x32, 0x91, 0x86,
-	0x0b, 0x76, 0x68, 0x2f, 0xf7, 0xde, 0x24, 0x52, 0x26, 0x29, 0x0b, 0xe2, 0x15, 0x0f, 0x62, 0x21,
-	0xa4, 0x8e, 0x35, 0x97, 0xa2, 0x34, 0x7b, 0x1d, 0x78, 0x3a, 0x2b, 0x9f, 0xfb, 0x9e, 0x33, 0xb5,
-	0xf1, 0xce, 0x11, 0xb4, 0x2b, 0x06, 0xbf, 0x06, 0x2b, 0x57, 0xa9, 0x83, 0x06, 0x68, 0x68, 0x87,
-	0xad, 0x62, 0xdb, 0xb7, 0x7e, 0x90, 0xaf, 0x64, 0xc7, 0xe1, 0xf7, 0x60, 0x2f, 0xd8, 0xbf, 0x48,
-	0x8a, 0xdf, 0x3c, 0x71, 0x1a, 0x03, 0x34, 0x7c, 0x3c, 0xc2, 0x7e, 0x39, 0x89, 0x3f, 0xa9, 0x14,
-	0x72, 0xb8, 0x84, 0x23, 0x00, 0xc9, 0x17, 0xb4, 0xb4, 0x58, 0xc6, 0xf2, 0xb2, 0xb6, 0x7c, 0x9b,
-	0x4e, 0xa2, 0xbd, 0x14, 0x3e, 0x2b, 0xb6, 0x7d, 0x38, 0x9c, 0xc9, 0x91, 0xcd, 0xfb, 0x04, 0x76,
-	0xfd, 0x38, 0x1e, 0x01, 0x50, 0x29, 0x04, 0xa3, 0x5a, 0xaa, 0xcc, 0x41, 0x03, 0xeb, 0x64, 0x88,
-	0xa8, 0x92, 0xc8, 0xd1, 0x2d, 0x6f, 0x0c, 0x76, 0x2d, 0x60, 0x0c, 0x8f, 0x44, 0xbc, 0x64, 0xfb,
-	0x80, 0xc4, 0xe0, 0x1d, 0xa7, 0x37, 0x2b, 0x66, 0x32, 0xd9, 0xc4, 0x60, 0x6f, 0x0e, 0x47, 0xf3,
-	0x3c, 0xe8, 0xea, 0x42, 0x93, 0x67, 0x59, 0xce, 0x54, 0xe9, 0x2b, 0x4f, 0x78, 0x08, 0x6d, 0x9a,
-	0x72, 0x26, 0xf4, 0x74, 0x62, 0x22, 0xdb, 0xe1, 0x93, 0x62, 0xdb, 0x6f, 0x47, 0x25, 0x47, 0x6a,
-	0x75, 0xf4, 0x0b, 0x3a, 0xd5, 0xbf, 0xcf, 0x98, 0x5a, 0x73, 0xca, 0xf0, 0x17, 0xb0, 0x3e, 0x33,
-	0x8d, 0xbb, 0x75, 0xa4, 0x93, 0x55, 0xf5, 0x5e, 0xdc, 0xe3, 0x3d, 0xe7, 0xff, 0xd5, 0xcd, 0x59,
-	0x03, 0xe3, 0xe7, 0x66, 0xdd, 0xeb, 0x0f, 0x75, 0x57, 0xc2, 0x8f, 0x17, 0x85, 0x8b, 0x2e, 0x0b,
-	0x17, 0x5d, 0x17, 0x2e, 0xfa, 0xf9, 0x36, 0xe1, 0xfa, 0x4f, 0x3e, 0xf7, 0xa9, 0x5c, 0x06, 0xb1,
-	0x32, 0xad, 0xf9, 0x6b, 0xc0, 0x3b, 0xba, 0x08, 0xee, 0xf4, 0x6d, 0xde, 0x34, 0x55, 0x19, 0xdf,
-	0x06, 0x00, 0x00, 0xff, 0xff, 0xbf, 0xa2, 0x52, 0xc6, 0x89, 0x02, 0x00, 0x00,
+	proto.RegisterFile("server/settings/settings.proto", fileDescriptor_settings_c856682a6904e082)
+}
+
+var fileDescriptor_settings_c856682a6904e082 = []byte{
+	// 537 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x54, 0x4f, 0x8f, 0x12, 0x4f,
+	0x10, 0xcd, 0xec, 0xec, 0x1f, 0xa6, 0x96, 0xdf, 0x6f, 0xd7, 0x76, 0x43, 0x06, 0x62, 0x00, 0xe7,
+	0x44, 0x62, 0x64, 0x94, 0xf5, 0xe2, 0xc9, 0x04, 0x48, 0x0c, 0x1b, 0xb3, 0xab, 0x4d, 0xbc, 0x18,
+	0x8d, 0x0e, 0x43, 0x31, 0xf6, 0x0a, 0xdd, 0xa4, 0xbb, 0x87, 0xb8, 0x7a, 0xf3, 0x2b, 0xf8, 0xa5,
+	0x3c, 0x9a, 0x78, 0x47, 0x33, 0xf1, 0x83, 0x18, 0x9a, 0x99, 0x01, 0x76, 0xe5, 0xe0, 0xad, 0xea,
+	0xbd, 0x7a, 0x55, 0x3d, 0x55, 0x79, 0x03, 0x55, 0x85, 0x72, 0x86, 0xd2, 0x57, 0xa8, 0x35, 0xe3,
+	0x91, 0xca, 0x83, 0xe6, 0x54, 0x0a, 0x2d, 0xc8, 0x41, 0x38, 0x8e, 0x95, 0x46, 0x59, 0x39, 0x89,
+	0x44, 0x24, 0x0c, 0xe6, 0x2f, 0xa2, 0x25, 0x5d, 0xb9, 0x13, 0x09, 0x11, 0x8d, 0xd1, 0x0f, 0xa6,
+	0xcc, 0x0f, 0x38, 0x17, 0x3a, 0xd0, 0x4c, 0xf0, 0x54, 0xec, 0x1d, 0xc1, 0x7f, 0xfd, 0xb4, 0xdd,
+	0x8b, 0x18, 0xe5, 0x95, 0xf7, 0x73, 0x07, 0x0a, 0x19, 0x42, 0xca, 0x60, 0xc7, 0x72, 0xec, 0x5a,
+	0x75, 0xab, 0xe1, 0xb4, 0x0f, 0x92, 0x79, 0xcd, 0x7e, 0x49, 0x9f, 0xd1, 0x05, 0x46, 0x1e, 0x80,
+	0x33, 0xc4, 0x8f, 0x1d, 0xc1, 0x47, 0x2c, 0x72, 0x77, 0xea, 0x56, 0xe3, 0xb0, 0x45, 0x9a, 0xe9,
+	0x4b, 0x9a, 0xdd, 0x8c, 0xa1, 0xab, 0x22, 0xd2, 0x01, 0x10, 0x6c, 0x18, 0xa6, 0x12, 0xdb, 0x48,
+	0x6e, 0xe7, 0x92, 0x8b, 0x5e, 0xb7, 0xb3, 0xa4, 0xda, 0xff, 0x27, 0xf3, 0x1a, 0xac, 0x72, 0xba,
+	0x26, 0x23, 0xef, 0xa0, 0x2c, 0x51, 0x89, 0x58, 0x86, 0xd8, 0x8b, 0xb8, 0x90, 0xd8, 0x65, 0xa3,
+	0x11, 0x4a, 0xe4, 0x21, 0x2a, 0x77, 0xb7, 0x6e, 0x37, 0x0e, 0x5b, 0x5e, 0xde, 0x93, 0x6e, 0xab,
+	0xa4, 0xdb, 0x9b, 0x90, 0xb7, 0xab, 0x09, 0xe7, 0x42, 0x4e, 0x82, 0x31, 0xfb, 0x84, 0xb2, 0x1f,
+	0x4a, 0x36, 0xd5, 0xca, 0xdd, 0x33, 0x13, 0xee, 0xde, 0x98, 0x70, 0xbd, 0x92, 0x6e, 0xef, 0xe1,
+	0x3d, 0x01, 0x27, 0xdf, 0x0f, 0x69, 0x01, 0x84, 0x82, 0x73, 0x0c, 0xb5, 0x90, 0xca, 0xb5, 0xea,
+	0xf6, 0xc6, 0x1e, 0x3b, 0x19, 0x45, 0xd7, 0xaa, 0xbc, 0x53, 0x70, 0x72, 0x82, 0x10, 0xd8, 0xe5,
+	0xc1, 0x04, 0x97, 0x37, 0xa2, 0x26, 0x5e, 0x60, 0xfa, 0x6a, 0x8a, 0xe6, 0x2c, 0x0e, 0x35, 0xb1,
+	0x37, 0x80, 0xb5, 0x95, 0xfe, 0x55, 0x55, 0x82, 0x7d, 0xa6, 0x54, 0x8c, 0x32, 0xd5, 0xa5, 0x19,
+	0x69, 0x40, 0x21, 0x1c, 0x33, 0xe4, 0xba, 0xd7, 0x35, 0x57, 0x73, 0xda, 0xc5, 0x64, 0x5e, 0x2b,
+	0x74, 0x52, 0x8c, 0xe6, 0xac, 0xf7, 0x19, 0xca, 0x5b, 0x57, 0x4e, 0x4e, 0x60, 0x2f, 0x92, 0x22,
+	0x9e, 0xa6, 0x33, 0x97, 0xc9, 0xe2, 0x21, 0x1f, 0x18, 0x1f, 0x66, 0x4f, 0x5d, 0xc4, 0xe4, 0x11,
+	0x14, 0x2f, 0x95, 0xe0, 0xcf, 0x05, 0xe3, 0x1a, 0xa5, 0x72, 0xed, 0xba, 0xdd, 0x70, 0xda, 0xc7,
+	0xc9, 0xbc, 0x56, 0x3c, 0xeb, 0x5f, 0x9c, 0x67, 0x38, 0xdd, 0xa8, 0xf2, 0x5e, 0x83, 0xbb, 0xed,
+	0x1a, 0xff, 0x30, 0xbb, 0x04, 0xfb, 0xca, 0x68, 0x96, 0x9f, 0x4a, 0xd3, 0xac, 0xf5, 0x06, 0x8e,
+	0x32, 0x57, 0xf4, 0x51, 0xce, 0x58, 0x88, 0xe4, 0x0c, 0xec, 0xa7, 0xa8, 0x49, 0x29, 0xbf, 0xd6,
+	0x86, 0x91, 0x2a, 0xb7, 0x6e, 0xe0, 0x9e, 0xfb, 0xe5, 0xc7, 0xef, 0xaf, 0x3b, 0x84, 0x1c, 0x1b,
+	0x33, 0xce, 0x1e, 0xe6, 0x4e, 0x6e, 0x3f, 0xfe, 0x96, 0x54, 0xad, 0xef, 0x49, 0xd5, 0xfa, 0x95,
+	0x54, 0xad, 0x57, 0xf7, 0x22, 0xa6, 0xdf, 0xc7, 0x83, 0x66, 0x28, 0x26, 0x7e, 0x20, 0x8d, 0xa7,
+	0x2f, 0x4d, 0x70, 0x3f, 0x1c, 0xfa, 0xd7, 0xfe, 0x06, 0x83, 0x7d, 0x63, 0xe4, 0xd3, 0x3f, 0x03,
+	0x00, 0x2a, 0x5d, 0x49, 0x6e, 0x27, 0x04, 0x00, 0x00,
 }
@@ -345,7 +345,18 @@ func (f *Fixture) createController() *controller.ApplicationController {
 		f.KubeClient,
 		f.AppClient,
 		reposerver.NewRepositoryServerClientset(f.RepoServerAddress),
-		10*time.Second)
+		10*time.Second,
+		0,
+		controller.LiveStateSnapshots{},
+		false,
+		false,
+		nil,
+		0,
+		controller.HistoryRetention{},
+		"",
+		0,
+		nil,
+		0)
 }
 
 func (f *Fixture) NewApiClientset() (argocdclient.Client, error) {
@@ -444,6 +455,10 @@ func (c *FakeGitClient) LsRemote(s string) (string, error) {
 	return "abcdef123456890", nil
 }
 
+func (c *FakeGitClient) LsRemoteRef(s string) (string, string, error) {
+	return "abcdef123456890", "HEAD", nil
+}
+
 func (c *FakeGitClient) LsFiles(s string) ([]string, error) {
 	matches, err := filepath.Glob(path.Join(c.root, s))
 	if err != nil {
@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"path"
 	"path/filepath"
+	"sort"
 	"strings"
 	"time"
 
@@ -15,7 +16,9 @@ import (
 	"google.golang.org/grpc/status"
 	apierr "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/watch"
@@ -28,7 +31,9 @@ import (
 	"github.com/argoproj/argo-cd/reposerver/repository"
 	"github.com/argoproj/argo-cd/util"
 	"github.com/argoproj/argo-cd/util/db"
+	"github.com/argoproj/argo-cd/util/diff"
 	"github.com/argoproj/argo-cd/util/git"
+	"github.com/argoproj/argo-cd/util/helm"
 	"github.com/argoproj/argo-cd/util/ksonnet"
 )
 
@@ -65,6 +70,19 @@ func FilterByProjects(apps []argoappv1.Application, projects []string) []argoapp
 
 }
 
+// ApplyParameterPreset returns the parameter overrides with the parameters of the preset applied on
+// top. Parameters of the preset replace overrides of the same component and name.
+func ApplyParameterPreset(overrides []argoappv1.ComponentParameter, preset argoappv1.ParameterPreset) []argoappv1.ComponentParameter {
+	params := make([]argoappv1.ComponentParameter, 0, len(overrides)+len(preset.Parameters))
+	presetParams := ParamToMap(preset.Parameters)
+	for _, p := range overrides {
+		if !CheckValidParam(presetParams, p) {
+			params = append(params, p)
+		}
+	}
+	return append(params, preset.Parameters...)
+}
+
 // ParamToMap converts a ComponentParameter list to a map for easy filtering
 func ParamToMap(params []argoappv1.ComponentParameter) map[string]map[string]bool {
 	validAppSet := make(map[string]map[string]bool)
@@ -87,19 +105,26 @@ func CheckValidParam(appMap map[string]map[string]bool, newParam argoappv1.Compo
 	return false
 }
 
-// RefreshApp updates the refresh annotation of an application to coerce the controller to process it
-func RefreshApp(appIf v1alpha1.ApplicationInterface, name string) (*argoappv1.Application, error) {
+// RefreshApp updates the refresh annotation of an application to coerce the controller to process it.
+// A hard refresh also updates the hard refresh annotation, so that the controller regenerates the
+// manifests of the application.
+func RefreshApp(appIf v1alpha1.ApplicationInterface, name string, refreshType argoappv1.RefreshType) (*argoappv1.Application, error) {
 	refreshString := time.Now().UTC().Format(time.RFC3339)
+	annotations := map[string]string{
+		common.AnnotationKeyRefresh: refreshString,
+	}
+	if refreshType == argoappv1.RefreshTypeHard {
+		annotations[common.AnnotationKeyHardRefresh] = refreshString
+	}
 	metadata := map[string]interface{}{
 		"metadata": map[string]interface{}{
-			"annotations": map[string]string{
-				common.AnnotationKeyRefresh: refreshString,
-			},
+			"annotations": annotations,
 		},
 		"status": map[string]interface{}{
 			"comparisonResult": map[string]interface{}{
 				"comparedAt": nil,
 			},
+			"reconciledAt": nil,
 		},
 	}
 	var err error
@@ -114,7 +139,7 @@ func RefreshApp(appIf v1alpha1.ApplicationInterface, name string) (*argoappv1.Ap
 				return nil, err
 			}
 		} else {
-			log.Infof("Refreshed app '%s' for controller reprocessing (%s)", name, refreshString)
+			log.Infof("Refreshed app '%s' for controller reprocessing (%s, %s)", name, refreshType, refreshString)
 			return app, nil
 		}
 		time.Sleep(100 * time.Millisecond)
@@ -171,7 +196,9 @@ func WaitForRefresh(appIf v1alpha1.ApplicationInterface, name string, timeout *t
 			if err != nil {
 				return nil, fmt.Errorf("Unable to parse '%s': %v", common.AnnotationKeyRefresh, err)
 			}
-			if app.Status.ComparisonResult.ComparedAt.After(refreshTimestamp) {
+			// the refresh resets the comparison timestamp, so a comparison in the same second as the
+			// refresh happened after it
+			if !app.Status.ComparisonResult.ComparedAt.IsZero() && !app.Status.ComparisonResult.ComparedAt.Time.Before(refreshTimestamp) {
 				return app, nil
 			}
 		}
@@ -181,10 +208,14 @@ func WaitForRefresh(appIf v1alpha1.ApplicationInterface, name string, timeout *t
 // GetSpecErrors returns list of conditions which indicates that app spec is invalid. Following is checked:
 // * the git repository is accessible
 // * the git path contains valid manifests
+// * helm charts: the chart version is set, and the chart of the helm repository is valid
 // * the referenced cluster has been added to Argo CD
 // * the app source repo and destination namespace/cluster are permitted in app project
 // * there are parameters of only one app source type
 // * ksonnet: the specified environment exists
+// * the ignored differences have a kind and valid JSON pointers
+// * the progressing deadline is a positive duration
+// * the retry strategy of the sync policy is valid
 func GetSpecErrors(
 	ctx context.Context,
 	spec *argoappv1.ApplicationSpec,
@@ -193,13 +224,20 @@ func GetSpecErrors(
 	db db.ArgoDB,
 ) ([]argoappv1.ApplicationCondition, error) {
 	conditions := make([]argoappv1.ApplicationCondition, 0)
-	if spec.Source.RepoURL == "" || spec.Source.Path == "" {
+	if spec.Source.RepoURL == "" || (spec.Source.Path == "" && !spec.Source.IsHelmChart()) {
 		conditions = append(conditions, argoappv1.ApplicationCondition{
 			Type:    argoappv1.ApplicationConditionInvalidSpecError,
 			Message: "spec.source.repoURL and spec.source.path are required",
 		})
 		return conditions, nil
 	}
+	if spec.Source.IsHelmChart() && spec.Source.TargetRevision == "" {
+		conditions = append(conditions, argoappv1.ApplicationCondition{
+			Type:    argoappv1.ApplicationConditionInvalidSpecError,
+			Message: "spec.source.targetRevision is required to deploy the version of a chart",
+		})
+		return conditions, nil
+	}
 
 	// Test the repo
 	conn, repoClient, err := repoClientset.NewRepositoryClient()
@@ -214,7 +252,11 @@ func GetSpecErrors(
 			// The repo has not been added to Argo CD so we do not have credentials to access it.
 			// We support the mode where apps can be created from public repositories. Test the
 			// repo to make sure it is publicly accessible
-			err = git.TestRepo(spec.Source.RepoURL, "", "", "")
+			if spec.Source.IsHelmChart() {
+				err = helm.TestRepo(spec.Source.RepoURL, "", "")
+			} else {
+				err = git.TestRepo(spec.Source.RepoURL, "", "", "")
+			}
 			if err != nil {
 				conditions = append(conditions, argoappv1.ApplicationCondition{
 					Type:    argoappv1.ApplicationConditionInvalidSpecError,
@@ -235,9 +277,14 @@ func GetSpecErrors(
 		conditions = append(conditions, *multiSourceErr)
 	}
 
-	if repoAccessable {
+	if repoAccessable && spec.Source.IsHelmChart() {
+		// charts are fetched from helm repositories to generate their manifests
+		conditions = append(conditions, verifyGenerateManifests(ctx, repoRes, spec, repoClient)...)
+	} else if repoAccessable {
 		appSourceType, err := queryAppSourceType(ctx, spec, repoRes, repoClient)
-		if err != nil {
+		if reposerver.IsUnavailable(err) {
+			conditions = append(conditions, RepoServerUnavailableCondition(err))
+		} else if err != nil {
 			conditions = append(conditions, argoappv1.ApplicationCondition{
 				Type:    argoappv1.ApplicationConditionInvalidSpecError,
 				Message: fmt.Sprintf("Unable to determine app source type: %v", err),
@@ -277,20 +324,62 @@ func GetSpecErrors(
 		})
 	}
 
-	if spec.Destination.Server != "" && spec.Destination.Namespace != "" {
-		if !proj.IsDestinationPermitted(spec.Destination) {
+	for _, dest := range spec.AdditionalDestinations {
+		if dest.IsMissing() {
 			conditions = append(conditions, argoappv1.ApplicationCondition{
 				Type:    argoappv1.ApplicationConditionInvalidSpecError,
-				Message: fmt.Sprintf("application destination %v is not permitted in project '%s'", spec.Destination, spec.Project),
+				Message: "server (or selector) and namespace are required for additional destinations",
+			})
+			break
+		}
+	}
+
+	for _, dest := range spec.GetDestinations() {
+		if cond := verifyDestinationSelector(dest); cond != nil {
+			conditions = append(conditions, *cond)
+		}
+	}
+
+	if _, err := spec.ProgressingDeadlineDuration(); err != nil {
+		conditions = append(conditions, argoappv1.ApplicationCondition{
+			Type:    argoappv1.ApplicationConditionInvalidSpecError,
+			Message: err.Error(),
+		})
+	}
+
+	if err := diff.ValidateIgnoreDifferences(spec.IgnoreDifferences); err != nil {
+		conditions = append(conditions, argoappv1.ApplicationCondition{
+			Type:    argoappv1.ApplicationConditionInvalidSpecError,
+			Message: err.Error(),
+		})
+	}
+
+	if spec.SyncPolicy != nil {
+		if err := spec.SyncPolicy.Retry.Validate(); err != nil {
+			conditions = append(conditions, argoappv1.ApplicationCondition{
+				Type:    argoappv1.ApplicationConditionInvalidSpecError,
+				Message: fmt.Sprintf("Invalid retry strategy of the sync policy: %v", err),
+			})
+		}
+	}
+
+	for _, dest := range spec.GetDestinations() {
+		if dest.Server == "" || dest.Namespace == "" {
+			continue
+		}
+		if !proj.IsDestinationPermitted(dest) {
+			conditions = append(conditions, argoappv1.ApplicationCondition{
+				Type:    argoappv1.ApplicationConditionInvalidSpecError,
+				Message: fmt.Sprintf("application destination %v is not permitted in project '%s'", dest, spec.Project),
 			})
 		}
 		// Ensure the k8s cluster the app is referencing, is configured in Argo CD
-		_, err = db.GetCluster(ctx, spec.Destination.Server)
+		_, err = db.GetCluster(ctx, dest.Server)
 		if err != nil {
 			if errStatus, ok := status.FromError(err); ok && errStatus.Code() == codes.NotFound {
 				conditions = append(conditions, argoappv1.ApplicationCondition{
 					Type:    argoappv1.ApplicationConditionInvalidSpecError,
-					Message: fmt.Sprintf("cluster '%s' has not been configured", spec.Destination.Server),
+					Message: fmt.Sprintf("cluster '%s' has not been configured", dest.Server),
 				})
 			} else {
 				return nil, err
@@ -300,6 +389,97 @@ func GetSpecErrors(
 	return conditions, nil
 }
 
+// RepoServerUnavailableCondition returns the condition of an application whose manifests cannot be
+// generated since the calls to the repo server failed with the given error
+func RepoServerUnavailableCondition(err error) argoappv1.ApplicationCondition {
+	return argoappv1.ApplicationCondition{
+		Type:    argoappv1.ApplicationConditionRepoServerUnavailableError,
+		Message: fmt.Sprintf("Manifest generation is unavailable since no repo server is available: %s", status.Convert(err).Message()),
+	}
+}
+
+// verifyDestinationSelector verifies that a destination which selects clusters has a valid selector,
+// and no server
+func verifyDestinationSelector(dest argoappv1.ApplicationDestination) *argoappv1.ApplicationCondition {
+	if !dest.SelectsClusters() {
+		return nil
+	}
+	if dest.Server != "" {
+		return &argoappv1.ApplicationCondition{
+			Type:    argoappv1.ApplicationConditionInvalidSpecError,
+			Message: fmt.Sprintf("destination selector '%s' and server '%s' are mutually exclusive", dest.Selector, dest.Server),
+		}
+	}
+	if _, err := labels.Parse(dest.Selector); err != nil {
+		return &argoappv1.ApplicationCondition{
+			Type:    argoappv1.ApplicationConditionInvalidSpecError,
+			Message: fmt.Sprintf("invalid destination selector '%s': %v", dest.Selector, err),
+		}
+	}
+	return nil
+}
+
+// DestinationSelectsCluster returns whether the destination is in the cluster, or selects it by its labels
+func DestinationSelectsCluster(dest argoappv1.ApplicationDestination, cluster *argoappv1.Cluster) bool {
+	if !dest.SelectsClusters() {
+		return dest.Server == cluster.Server
+	}
+	selector, err := labels.Parse(dest.Selector)
+	return err == nil && selector.Matches(labels.Set(cluster.Labels))
+}
+
+// SelectDestinations returns the destinations, in which the destinations which select clusters by their
+// labels are replaced by a destination in each selected cluster. Clusters whose destination is not
+// permitted are not selected.
+func SelectDestinations(dests []argoappv1.ApplicationDestination, clusters []argoappv1.Cluster, permitted func(argoappv1.ApplicationDestination) bool) ([]argoappv1.ApplicationDestination, error) {
+	selected := make([]argoappv1.ApplicationDestination, 0)
+	visited := make(map[argoappv1.ApplicationDestination]bool)
+	for _, dest := range dests {
+		candidates := []argoappv1.ApplicationDestination{dest}
+		if dest.SelectsClusters() {
+			if _, err := labels.Parse(dest.Selector); err != nil {
+				return nil, fmt.Errorf("invalid destination selector '%s': %v", dest.Selector, err)
+			}
+			candidates = nil
+			for i := range clusters {
+				clusterDest := argoappv1.ApplicationDestination{Server: clusters[i].Server, Namespace: dest.Namespace}
+				if DestinationSelectsCluster(dest, &clusters[i]) && permitted(clusterDest) {
+					candidates = append(candidates, clusterDest)
+				}
+			}
+		}
+		for _, candidate := range candidates {
+			if !visited[candidate] {
+				visited[candidate] = true
+				selected = append(selected, candidate)
+			}
+		}
+	}
+	return selected, nil
+}
+
+// ResolveDestinations returns the destinations of the application, in which the destinations which select
+// clusters by their labels are resolved into a destination in each selected cluster permitted by the
+// project. The clusters are selected in a stable order, so that the primary destination does not change.
+// An error is returned if no destination remains.
+func ResolveDestinations(ctx context.Context, argoDB db.ArgoDB, app *argoappv1.Application, proj *argoappv1.AppProject) ([]argoappv1.ApplicationDestination, error) {
+	clusters, err := argoDB.ListClusters(ctx)
+	if err != nil {
+		return nil, err
+	}
+	sort.Slice(clusters.Items, func(i, j int) bool {
+		return clusters.Items[i].Server < clusters.Items[j].Server
+	})
+	dests, err := SelectDestinations(app.Spec.GetDestinations(), clusters.Items, proj.IsDestinationPermitted)
+	if err != nil {
+		return nil, err
+	}
+	if len(dests) == 0 {
+		return nil, fmt.Errorf("the destination selectors of the application select no permitted cluster")
+	}
+	return dests, nil
+}
+
 func verifyOneSourceType(source *argoappv1.ApplicationSource) *argoappv1.ApplicationCondition {
 	var appTypes []string
 	if source.Kustomize != nil {
@@ -395,7 +575,7 @@ func verifyAppYAML(ctx context.Context, repoRes *argoappv1.Repository, spec *arg
 	}
 
 	// If server and namespace are not supplied, pull it from the app.yaml
-	if spec.Destination.Server == "" {
+	if spec.Destination.Server == "" && !spec.Destination.SelectsClusters() {
 		spec.Destination.Server = dest.Server
 	}
 	if spec.Destination.Namespace == "" {
@@ -408,7 +588,7 @@ func verifyAppYAML(ctx context.Context, repoRes *argoappv1.Repository, spec *arg
 // verifyHelmChart verifies a helm chart is functional
 func verifyHelmChart(ctx context.Context, repoRes *argoappv1.Repository, spec *argoappv1.ApplicationSpec, repoClient repository.RepositoryServiceClient) []argoappv1.ApplicationCondition {
 	var conditions []argoappv1.ApplicationCondition
-	if spec.Destination.Server == "" || spec.Destination.Namespace == "" {
+	if spec.Destination.IsMissing() {
 		conditions = append(conditions, argoappv1.ApplicationCondition{
 			Type:    argoappv1.ApplicationConditionInvalidSpecError,
 			Message: errDestinationMissing,
@@ -439,7 +619,7 @@ func verifyHelmChart(ctx context.Context, repoRes *argoappv1.Repository, spec *a
 // verifyGenerateManifests verifies a repo path can generate manifests
 func verifyGenerateManifests(ctx context.Context, repoRes *argoappv1.Repository, spec *argoappv1.ApplicationSpec, repoClient repository.RepositoryServiceClient) []argoappv1.ApplicationCondition {
 	var conditions []argoappv1.ApplicationCondition
-	if spec.Destination.Server == "" || spec.Destination.Namespace == "" {
+	if spec.Destination.IsMissing() {
 		conditions = append(conditions, argoappv1.ApplicationCondition{
 			Type:    argoappv1.ApplicationConditionInvalidSpecError,
 			Message: errDestinationMissing,
@@ -457,6 +637,7 @@ func verifyGenerateManifests(ctx context.Context, repoRes *argoappv1.Repository,
 		req.Repo.Username = repoRes.Username
 		req.Repo.Password = repoRes.Password
 		req.Repo.SSHPrivateKey = repoRes.SSHPrivateKey
+		req.Repos = []*argoappv1.Repository{repoRes}
 	}
 
 	// Only check whether we can access the application's path,
@@ -465,20 +646,33 @@ func verifyGenerateManifests(ctx context.Context, repoRes *argoappv1.Repository,
 	if err != nil {
 		conditions = append(conditions, argoappv1.ApplicationCondition{
 			Type:    argoappv1.ApplicationConditionInvalidSpecError,
-			Message: fmt.Sprintf("Unable to generate manifests in %s: %v", spec.Source.Path, err),
+			Message: fmt.Sprintf("Unable to generate manifests in %s: %v", sourceLocation(spec.Source), err),
 		})
 	}
 
 	return conditions
 }
 
-// SetAppOperation updates an application with the specified operation, retrying conflict errors
+// sourceLocation returns the path of the source, or the chart and version of charts of helm repositories
+func sourceLocation(source argoappv1.ApplicationSource) string {
+	if source.IsHelmChart() {
+		return fmt.Sprintf("chart %s:%s", source.Chart, source.TargetRevision)
+	}
+	return source.Path
+}
+
+// SetAppOperation updates an application with the specified operation, retrying conflict errors.
+// If an operation with the idempotency key of the operation was already started, the application is
+// returned unchanged.
 func SetAppOperation(appIf v1alpha1.ApplicationInterface, appName string, op *argoappv1.Operation) (*argoappv1.Application, error) {
 	for {
 		a, err := appIf.Get(appName, metav1.GetOptions{})
 		if err != nil {
 			return nil, err
 		}
+		if HasOperation(a, op.IdempotencyKey) {
+			return a, nil
+		}
 		if a.Operation != nil {
 			return nil, status.Errorf(codes.FailedPrecondition, "another operation is already in progress")
 		}
@@ -498,6 +692,27 @@ func SetAppOperation(appIf v1alpha1.ApplicationInterface, appName string, op *ar
 	}
 }
 
+// HasOperation returns whether an operation with the idempotency key was started for the
+// application: the operation in progress, the last operation, or an operation which synced a
+// deployment of the history. Always false for an empty key.
+func HasOperation(a *argoappv1.Application, idempotencyKey string) bool {
+	if idempotencyKey == "" {
+		return false
+	}
+	if a.Operation != nil && a.Operation.IdempotencyKey == idempotencyKey {
+		return true
+	}
+	if a.Status.OperationState != nil && a.Status.OperationState.Operation.IdempotencyKey == idempotencyKey {
+		return true
+	}
+	for _, info := range a.Status.History {
+		if info.IdempotencyKey == idempotencyKey {
+			return true
+		}
+	}
+	return false
+}
+
 // ContainsSyncResource determines if the given resource exists in the provided slice of sync operation resources.
 func ContainsSyncResource(name string, gvk schema.GroupVersionKind, rr []argoappv1.SyncOperationResource) bool {
 	for _, r := range rr {
@@ -507,3 +722,13 @@ func ContainsSyncResource(name string, gvk schema.GroupVersionKind, rr []argoapp
 	}
 	return false
 }
+
+// HasSyncOption returns whether the sync options annotation of the object contains the given option
+func HasSyncOption(obj *unstructured.Unstructured, option string) bool {
+	for _, item := range strings.Split(obj.GetAnnotations()[common.AnnotationSyncOptions], ",") {
+		if strings.TrimSpace(item) == option {
+			return true
+		}
+	}
+	return false
+}
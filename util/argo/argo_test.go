@@ -22,7 +22,7 @@ func TestRefreshApp(t *testing.T) {
 	testApp.Namespace = "default"
 	appClientset := appclientset.NewSimpleClientset(&testApp)
 	appIf := appClientset.ArgoprojV1alpha1().Applications("default")
-	_, err := RefreshApp(appIf, "test-app")
+	_, err := RefreshApp(appIf, "test-app", argoappv1.RefreshTypeNormal)
 	assert.Nil(t, err)
 	// For some reason, the fake Application inferface doesn't reflect the patch status after Patch(),
 	// so can't verify it was set in unit tests.
@@ -65,6 +65,28 @@ func TestCheckValidParam(t *testing.T) {
 	assert.False(t, CheckValidParam(oldAppSet, badParam))
 }
 
+func TestApplyParameterPreset(t *testing.T) {
+	overrides := []argoappv1.ComponentParameter{
+		{Component: "guestbook", Name: "replicas", Value: "1"},
+		{Component: "guestbook", Name: "image", Value: "guestbook:v1"},
+	}
+	preset := argoappv1.ParameterPreset{
+		Name: "canary",
+		Parameters: []argoappv1.ComponentParameter{
+			{Component: "guestbook", Name: "image", Value: "guestbook:v2"},
+			{Component: "guestbook", Name: "canary", Value: "true"},
+		},
+	}
+	params := ApplyParameterPreset(overrides, preset)
+	assert.Equal(t, []argoappv1.ComponentParameter{
+		{Component: "guestbook", Name: "replicas", Value: "1"},
+		{Component: "guestbook", Name: "image", Value: "guestbook:v2"},
+		{Component: "guestbook", Name: "canary", Value: "true"},
+	}, params)
+	// the overrides are not modified
+	assert.Equal(t, "guestbook:v1", overrides[1].Value)
+}
+
 func TestWaitForRefresh(t *testing.T) {
 	appClientset := appclientset.NewSimpleClientset()
 
@@ -119,6 +141,44 @@ func TestContainsSyncResource(t *testing.T) {
 	}
 }
 
+func TestSetAppOperationIdempotencyKey(t *testing.T) {
+	testApp := argoappv1.Application{ObjectMeta: metav1.ObjectMeta{Name: "test-app", Namespace: "default"}}
+	testApp.Status.History = []argoappv1.DeploymentInfo{{ID: 0, IdempotencyKey: "deployed"}}
+	appIf := appclientset.NewSimpleClientset(&testApp).ArgoprojV1alpha1().Applications("default")
+	newOp := func(key string) *argoappv1.Operation {
+		return &argoappv1.Operation{Sync: &argoappv1.SyncOperation{}, IdempotencyKey: key}
+	}
+
+	app, err := SetAppOperation(appIf, "test-app", newOp("first"))
+	assert.Nil(t, err)
+	assert.Equal(t, "first", app.Operation.IdempotencyKey)
+
+	// a retried request returns the operation in progress
+	app, err = SetAppOperation(appIf, "test-app", newOp("first"))
+	assert.Nil(t, err)
+	assert.Equal(t, "first", app.Operation.IdempotencyKey)
+
+	_, err = SetAppOperation(appIf, "test-app", newOp("second"))
+	assert.NotNil(t, err)
+
+	// once completed, a retried request does not replace the state of the operation
+	app.Status.OperationState = &argoappv1.OperationState{Operation: *app.Operation, Phase: argoappv1.OperationSucceeded}
+	app.Operation = nil
+	_, err = appIf.Update(app)
+	assert.Nil(t, err)
+	app, err = SetAppOperation(appIf, "test-app", newOp("first"))
+	assert.Nil(t, err)
+	assert.Nil(t, app.Operation)
+	assert.NotNil(t, app.Status.OperationState)
+
+	// the key of an operation which synced a deployment is remembered in the history
+	app, err = SetAppOperation(appIf, "test-app", newOp("deployed"))
+	assert.Nil(t, err)
+	assert.Nil(t, app.Operation)
+
+	assert.False(t, HasOperation(app, ""))
+}
+
 func TestVerifyOneSourceType(t *testing.T) {
 	src := argoappv1.ApplicationSource{
 		Ksonnet: &argoappv1.ApplicationSourceKsonnet{
@@ -139,3 +199,52 @@ func TestVerifyOneSourceType(t *testing.T) {
 	}
 	assert.Nil(t, verifyOneSourceType(&src))
 }
+
+func TestVerifyDestinationSelector(t *testing.T) {
+	assert.Nil(t, verifyDestinationSelector(argoappv1.ApplicationDestination{Server: "https://cluster-a", Namespace: "default"}))
+	assert.Nil(t, verifyDestinationSelector(argoappv1.ApplicationDestination{Selector: "env=prod,region!=eu", Namespace: "default"}))
+	assert.NotNil(t, verifyDestinationSelector(argoappv1.ApplicationDestination{Server: "https://cluster-a", Selector: "env=prod", Namespace: "default"}))
+	assert.NotNil(t, verifyDestinationSelector(argoappv1.ApplicationDestination{Selector: "env in (prod", Namespace: "default"}))
+}
+
+func TestSelectDestinations(t *testing.T) {
+	destA := argoappv1.ApplicationDestination{Server: "https://cluster-a", Namespace: "default"}
+	destB := argoappv1.ApplicationDestination{Server: "https://cluster-b", Namespace: "default"}
+	clusters := []argoappv1.Cluster{
+		{Server: "https://cluster-a", Labels: map[string]string{"env": "prod", "region": "us"}},
+		{Server: "https://cluster-b", Labels: map[string]string{"env": "prod", "region": "eu"}},
+		{Server: "https://cluster-c", Labels: map[string]string{"env": "staging"}},
+	}
+	permitAll := func(argoappv1.ApplicationDestination) bool { return true }
+
+	dests, err := SelectDestinations([]argoappv1.ApplicationDestination{{Selector: "env=prod", Namespace: "default"}}, clusters, permitAll)
+	assert.NoError(t, err)
+	assert.Equal(t, []argoappv1.ApplicationDestination{destA, destB}, dests)
+
+	// destinations selected more than once are deployed to once
+	dests, err = SelectDestinations([]argoappv1.ApplicationDestination{destB, {Selector: "env=prod,region!=eu", Namespace: "default"}}, clusters, permitAll)
+	assert.NoError(t, err)
+	assert.Equal(t, []argoappv1.ApplicationDestination{destB, destA}, dests)
+
+	// clusters which are not permitted are not selected
+	dests, err = SelectDestinations([]argoappv1.ApplicationDestination{{Selector: "env=prod", Namespace: "default"}}, clusters, func(dest argoappv1.ApplicationDestination) bool {
+		return dest.Server != destA.Server
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, []argoappv1.ApplicationDestination{destB}, dests)
+
+	dests, err = SelectDestinations([]argoappv1.ApplicationDestination{{Selector: "env=dev", Namespace: "default"}}, clusters, permitAll)
+	assert.NoError(t, err)
+	assert.Empty(t, dests)
+
+	_, err = SelectDestinations([]argoappv1.ApplicationDestination{{Selector: "env in (prod", Namespace: "default"}}, clusters, permitAll)
+	assert.Error(t, err)
+}
+
+func TestDestinationSelectsCluster(t *testing.T) {
+	cluster := &argoappv1.Cluster{Server: "https://cluster-a", Labels: map[string]string{"env": "prod"}}
+	assert.True(t, DestinationSelectsCluster(argoappv1.ApplicationDestination{Server: "https://cluster-a"}, cluster))
+	assert.False(t, DestinationSelectsCluster(argoappv1.ApplicationDestination{Server: "https://cluster-b"}, cluster))
+	assert.True(t, DestinationSelectsCluster(argoappv1.ApplicationDestination{Selector: "env=prod"}, cluster))
+	assert.False(t, DestinationSelectsCluster(argoappv1.ApplicationDestination{Selector: "env=staging"}, cluster))
+}
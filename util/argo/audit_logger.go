@@ -31,6 +31,9 @@ const (
 	EventReasonResourceDeleted    = "ResourceDeleted"
 	EventReasonOperationStarted   = "OperationStarted"
 	EventReasonOperationCompleted = "OperationCompleted"
+	EventReasonResourceSyncFailed = "ResourceSyncFailed"
+	EventReasonResourcePruned     = "ResourcePruned"
+	EventReasonHookFailed         = "HookFailed"
 )
 
 func (l *AuditLogger) logEvent(objMeta metav1.ObjectMeta, gvk schema.GroupVersionKind, info EventInfo, message string) {
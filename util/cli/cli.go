@@ -139,6 +139,40 @@ func SetLogLevel(logLevel string) {
 	log.SetLevel(level)
 }
 
+// UpdateLogLevel sets the level of the logrus logs to the configured level, or to the default level
+// if none is configured. Invalid levels are ignored, so that a misconfiguration does not terminate
+// a running component.
+func UpdateLogLevel(configured string, defaultLevel string) {
+	logLevel := defaultLevel
+	if configured != "" {
+		logLevel = configured
+	}
+	if logLevel == "" {
+		return
+	}
+	level, err := log.ParseLevel(logLevel)
+	if err != nil {
+		log.Warnf("Ignoring invalid log level '%s': %v", logLevel, err)
+		return
+	}
+	if level != log.GetLevel() {
+		log.Infof("Changing log level from %s to %s", log.GetLevel(), level)
+		log.SetLevel(level)
+	}
+}
+
+// SetLogFormat sets the format of the logrus logs. One of: text|json
+func SetLogFormat(logFormat string) {
+	switch strings.ToLower(logFormat) {
+	case "json":
+		log.SetFormatter(&log.JSONFormatter{})
+	case "text":
+		log.SetFormatter(&log.TextFormatter{})
+	default:
+		log.Fatalf("Unknown log format '%s'", logFormat)
+	}
+}
+
 // SetGLogLevel set the glog level for the k8s go-client
 func SetGLogLevel(glogLevel int) {
 	_ = flag.CommandLine.Parse([]string{})
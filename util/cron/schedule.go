@@ -0,0 +1,183 @@
+package cron
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Schedule is a parsed cron schedule of five fields: minute, hour, day of month, month and day of week
+type Schedule struct {
+	minute     uint64
+	hour       uint64
+	dayOfMonth uint64
+	month      uint64
+	dayOfWeek  uint64
+	// anyDayOfMonth and anyDayOfWeek are whether the day fields are unrestricted. If only one of them
+	// is restricted, a time matches when that one matches. If both are, a time matches when either does.
+	anyDayOfMonth bool
+	anyDayOfWeek  bool
+}
+
+type fieldBounds struct {
+	name     string
+	min, max int
+}
+
+var (
+	minuteBounds     = fieldBounds{"minute", 0, 59}
+	hourBounds       = fieldBounds{"hour", 0, 23}
+	dayOfMonthBounds = fieldBounds{"day of month", 1, 31}
+	monthBounds      = fieldBounds{"month", 1, 12}
+	dayOfWeekBounds  = fieldBounds{"day of week", 0, 7}
+)
+
+var descriptors = map[string]string{
+	"@yearly":   "0 0 1 1 *",
+	"@annually": "0 0 1 1 *",
+	"@monthly":  "0 0 1 * *",
+	"@weekly":   "0 0 * * 0",
+	"@daily":    "0 0 * * *",
+	"@midnight": "0 0 * * *",
+	"@hourly":   "0 * * * *",
+}
+
+// Parse parses a cron schedule, e.g. "0 22 * * 1-5" or "*/15 * * * *". Fields support lists, ranges
+// and steps. The predefined schedules @yearly, @monthly, @weekly, @daily and @hourly are supported too.
+func Parse(spec string) (*Schedule, error) {
+	spec = strings.TrimSpace(spec)
+	if expanded, ok := descriptors[spec]; ok {
+		spec = expanded
+	}
+	fields := strings.Fields(spec)
+	if len(fields) != 5 {
+		return nil, fmt.Errorf("schedule '%s' should have 5 fields, has %d", spec, len(fields))
+	}
+	var s Schedule
+	var err error
+	if s.minute, err = parseField(fields[0], minuteBounds); err != nil {
+		return nil, err
+	}
+	if s.hour, err = parseField(fields[1], hourBounds); err != nil {
+		return nil, err
+	}
+	if s.dayOfMonth, err = parseField(fields[2], dayOfMonthBounds); err != nil {
+		return nil, err
+	}
+	if s.month, err = parseField(fields[3], monthBounds); err != nil {
+		return nil, err
+	}
+	if s.dayOfWeek, err = parseField(fields[4], dayOfWeekBounds); err != nil {
+		return nil, err
+	}
+	// both 0 and 7 are sunday
+	if s.dayOfWeek&(1<<7) != 0 {
+		s.dayOfWeek |= 1
+	}
+	s.anyDayOfMonth = fields[2] == "*"
+	s.anyDayOfWeek = fields[4] == "*"
+	return &s, nil
+}
+
+// parseField parses a comma separated list of values, ranges and steps into a bit set
+func parseField(field string, bounds fieldBounds) (uint64, error) {
+	var bits uint64
+	for _, part := range strings.Split(field, ",") {
+		step := 1
+		if i := strings.Index(part, "/"); i >= 0 {
+			var err error
+			step, err = strconv.Atoi(part[i+1:])
+			if err != nil || step <= 0 {
+				return 0, fmt.Errorf("invalid step '%s' in %s field '%s'", part[i+1:], bounds.name, field)
+			}
+			part = part[:i]
+		}
+		start, end := bounds.min, bounds.max
+		switch {
+		case part == "*":
+		case strings.Contains(part, "-"):
+			i := strings.Index(part, "-")
+			var err error
+			if start, err = parseValue(part[:i], bounds); err != nil {
+				return 0, err
+			}
+			if end, err = parseValue(part[i+1:], bounds); err != nil {
+				return 0, err
+			}
+			if start > end {
+				return 0, fmt.Errorf("invalid range '%s' in %s field '%s'", part, bounds.name, field)
+			}
+		default:
+			value, err := parseValue(part, bounds)
+			if err != nil {
+				return 0, err
+			}
+			start = value
+			// a single value with a step, e.g. 5/15, ranges up to the maximum
+			if step == 1 {
+				end = value
+			}
+		}
+		for value := start; value <= end; value += step {
+			bits |= 1 << uint(value)
+		}
+	}
+	return bits, nil
+}
+
+func parseValue(value string, bounds fieldBounds) (int, error) {
+	i, err := strconv.Atoi(value)
+	if err != nil || i < bounds.min || i > bounds.max {
+		return 0, fmt.Errorf("invalid %s '%s': should be between %d and %d", bounds.name, value, bounds.min, bounds.max)
+	}
+	return i, nil
+}
+
+// Matches returns whether the schedule fires at the minute of the given time
+func (s *Schedule) Matches(t time.Time) bool {
+	return s.minute&(1<<uint(t.Minute())) != 0 && s.hour&(1<<uint(t.Hour())) != 0 && s.matchesDay(t)
+}
+
+// matchesDay returns whether the schedule fires on the day of the given time
+func (s *Schedule) matchesDay(t time.Time) bool {
+	if s.month&(1<<uint(t.Month())) == 0 {
+		return false
+	}
+	dayOfMonth := s.dayOfMonth&(1<<uint(t.Day())) != 0
+	dayOfWeek := s.dayOfWeek&(1<<uint(t.Weekday())) != 0
+	switch {
+	case s.anyDayOfMonth && s.anyDayOfWeek:
+		return true
+	case s.anyDayOfMonth:
+		return dayOfWeek
+	case s.anyDayOfWeek:
+		return dayOfMonth
+	default:
+		return dayOfMonth || dayOfWeek
+	}
+}
+
+// Previous returns the last time at or before the given time, truncated to the minute, at which the
+// schedule fired less than the given duration ago. Returns false if the schedule did not fire
+// within the duration. Days and hours at which the schedule does not fire are skipped as a whole, so
+// long durations are searched quickly.
+func (s *Schedule) Previous(t time.Time, within time.Duration) (time.Time, bool) {
+	t = t.Truncate(time.Minute)
+	earliest := t.Add(-within)
+	for t.After(earliest) {
+		switch {
+		case !s.matchesDay(t):
+			// the last minute of the previous day
+			t = time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, t.Location()).Add(-time.Minute)
+		case s.hour&(1<<uint(t.Hour())) == 0:
+			// the last minute of the previous hour
+			t = t.Add(-time.Duration(t.Minute()+1) * time.Minute)
+		case s.minute&(1<<uint(t.Minute())) == 0:
+			t = t.Add(-time.Minute)
+		default:
+			return t, true
+		}
+	}
+	return time.Time{}, false
+}
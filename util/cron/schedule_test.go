@@ -0,0 +1,80 @@
+package cron
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParse(t *testing.T) {
+	for _, spec := range []string{"* * * * *", "0 22 * * 1-5", "*/15 0-6,18-23 1,15 * 7", "5/10 * * 1-12/2 *", "@daily"} {
+		_, err := Parse(spec)
+		assert.NoError(t, err, spec)
+	}
+	for _, spec := range []string{"", "* * * *", "60 * * * *", "* 24 * * *", "* * 0 * *", "* * * 13 *", "* * * * 8", "5-1 * * * *", "*/0 * * * *", "a * * * *"} {
+		_, err := Parse(spec)
+		assert.Error(t, err, spec)
+	}
+}
+
+func TestMatches(t *testing.T) {
+	// 2019-03-04 is a monday
+	monday := time.Date(2019, 3, 4, 22, 30, 0, 0, time.UTC)
+	tests := []struct {
+		spec    string
+		time    time.Time
+		matches bool
+	}{
+		{"* * * * *", monday, true},
+		{"30 22 * * *", monday, true},
+		{"31 22 * * *", monday, false},
+		{"*/15 * * * *", monday, true},
+		{"*/20 * * * *", monday, false},
+		{"30 22 * * 1-5", monday, true},
+		{"30 22 * * 0,6", monday, false},
+		{"30 22 * * 0", monday.AddDate(0, 0, 6), true},
+		{"30 22 * * 7", monday.AddDate(0, 0, 6), true},
+		// either day field matches when both are restricted
+		{"30 22 4 * 0", monday, true},
+		{"30 22 5 * 0", monday, false},
+		{"30 22 4 4 *", monday, false},
+		{"@hourly", monday, false},
+		{"@daily", time.Date(2019, 3, 4, 0, 0, 0, 0, time.UTC), true},
+	}
+	for _, test := range tests {
+		schedule, err := Parse(test.spec)
+		assert.NoError(t, err)
+		assert.Equal(t, test.matches, schedule.Matches(test.time), test.spec)
+	}
+}
+
+func TestPrevious(t *testing.T) {
+	now := time.Date(2019, 3, 4, 22, 30, 15, 0, time.UTC)
+	schedule, err := Parse("0 22 * * *")
+	assert.NoError(t, err)
+
+	previous, ok := schedule.Previous(now, time.Hour)
+	assert.True(t, ok)
+	assert.Equal(t, time.Date(2019, 3, 4, 22, 0, 0, 0, time.UTC), previous)
+
+	_, ok = schedule.Previous(now, 30*time.Minute)
+	assert.False(t, ok)
+}
+
+func TestPreviousLongDuration(t *testing.T) {
+	now := time.Date(2019, 3, 4, 22, 30, 15, 0, time.UTC)
+	schedule, err := Parse("@yearly")
+	assert.NoError(t, err)
+
+	previous, ok := schedule.Previous(now, 2*365*24*time.Hour)
+	assert.True(t, ok)
+	assert.Equal(t, time.Date(2019, 1, 1, 0, 0, 0, 0, time.UTC), previous)
+
+	schedule, err = Parse("45 6 * * 5")
+	assert.NoError(t, err)
+	// the last friday before the monday
+	previous, ok = schedule.Previous(now, 10*24*time.Hour)
+	assert.True(t, ok)
+	assert.Equal(t, time.Date(2019, 3, 1, 6, 45, 0, 0, time.UTC), previous)
+}
@@ -23,6 +23,7 @@ import (
 var (
 	localCluster = appv1.Cluster{
 		Server:          common.KubernetesInternalAPIServerAddr,
+		Name:            common.InClusterName,
 		ConnectionState: appv1.ConnectionState{Status: appv1.ConnectionStatusSuccessful},
 	}
 )
@@ -60,6 +61,7 @@ func (db *db) ListClusters(ctx context.Context) (*appv1.ClusterList, error) {
 
 // CreateCluster creates a cluster
 func (db *db) CreateCluster(ctx context.Context, c *appv1.Cluster) (*appv1.Cluster, error) {
+	c = normalizeCluster(c)
 	secName, err := serverToSecretName(c.Server)
 	if err != nil {
 		return nil, err
@@ -152,6 +154,7 @@ func (db *db) WatchClusters(ctx context.Context, callback func(*ClusterEvent)) e
 }
 
 func (db *db) getClusterSecret(server string) (*apiv1.Secret, error) {
+	server = appv1.NormalizeServer(server)
 	secName, err := serverToSecretName(server)
 	if err != nil {
 		return nil, err
@@ -177,8 +180,10 @@ func (db *db) getClusterSecret(server string) (*apiv1.Secret, error) {
 
 }
 
-// GetCluster returns a cluster from a query
+// GetCluster returns a cluster from a query. The cluster Argo CD runs in may also be retrieved by
+// its in-cluster name.
 func (db *db) GetCluster(ctx context.Context, server string) (*appv1.Cluster, error) {
+	server = appv1.NormalizeServer(server)
 	clusterSecret, err := db.getClusterSecret(server)
 	if err != nil {
 		if errorStatus, ok := status.FromError(err); ok && errorStatus.Code() == codes.NotFound && server == common.KubernetesInternalAPIServerAddr {
@@ -192,6 +197,7 @@ func (db *db) GetCluster(ctx context.Context, server string) (*appv1.Cluster, er
 
 // UpdateCluster updates a cluster
 func (db *db) UpdateCluster(ctx context.Context, c *appv1.Cluster) (*appv1.Cluster, error) {
+	c = normalizeCluster(c)
 	clusterSecret, err := db.getClusterSecret(c.Server)
 	if err != nil {
 		return nil, err
@@ -230,6 +236,17 @@ func (db *db) DeleteCluster(ctx context.Context, name string) error {
 	}
 }
 
+// normalizeCluster returns the cluster with the in-cluster name resolved, so that the address of the
+// cluster Argo CD runs in is persisted
+func normalizeCluster(c *appv1.Cluster) *appv1.Cluster {
+	if c.Server != common.InClusterName {
+		return c
+	}
+	inCluster := *c
+	inCluster.Server = appv1.NormalizeServer(c.Server)
+	return &inCluster
+}
+
 // serverToSecretName
 func serverToSecretName(server string) (string, error) {
 	serverURL, err := url.ParseRequestURI(server)
@@ -272,6 +289,16 @@ func clusterToData(c *appv1.Cluster) map[string][]byte {
 		panic(err)
 	}
 	data["config"] = configBytes
+	if len(c.NormalizerProfiles) > 0 {
+		data["normalizerProfiles"] = []byte(strings.Join(c.NormalizerProfiles, ","))
+	}
+	if len(c.Labels) > 0 {
+		labelsBytes, err := json.Marshal(c.Labels)
+		if err != nil {
+			panic(err)
+		}
+		data["labels"] = labelsBytes
+	}
 	return data
 }
 
@@ -287,5 +314,14 @@ func secretToCluster(s *apiv1.Secret) *appv1.Cluster {
 		Name:   string(s.Data["name"]),
 		Config: config,
 	}
+	if profiles := string(s.Data["normalizerProfiles"]); profiles != "" {
+		cluster.NormalizerProfiles = strings.Split(profiles, ",")
+	}
+	if labelsBytes := s.Data["labels"]; len(labelsBytes) > 0 {
+		err = json.Unmarshal(labelsBytes, &cluster.Labels)
+		if err != nil {
+			panic(err)
+		}
+	}
 	return &cluster
 }
@@ -24,6 +24,8 @@ type ArgoDB interface {
 
 	// ListRepoURLs lists repositories
 	ListRepoURLs(ctx context.Context) ([]string, error)
+	// ListRepositories lists repositories including their credentials
+	ListRepositories(ctx context.Context) ([]*appv1.Repository, error)
 	// CreateRepository creates a repository
 	CreateRepository(ctx context.Context, r *appv1.Repository) (*appv1.Repository, error)
 	// GetRepository returns a repository by URL
@@ -206,6 +206,27 @@ func TestUpdateRepositoryWithManagedSecrets(t *testing.T) {
 	assert.Equal(t, "- url: https://github.com/argoproj/argocd-example-apps", strings.Trim(cm.Data["repositories"], "\n"))
 }
 
+func TestUpdateRepositoryKeepsType(t *testing.T) {
+	clientset := getClientset(map[string]string{
+		"repositories": `
+- url: https://charts.example.com
+  type: helm
+`})
+	db := NewDB(testNamespace, settings.NewSettingsManager(clientset, testNamespace), clientset)
+
+	_, err := db.UpdateRepository(context.Background(), &v1alpha1.Repository{Repo: "https://charts.example.com"})
+	assert.Nil(t, err)
+	repo, err := db.GetRepository(context.Background(), "https://charts.example.com")
+	assert.Nil(t, err)
+	assert.Equal(t, v1alpha1.RepositoryTypeHelm, repo.Type)
+
+	_, err = db.UpdateRepository(context.Background(), &v1alpha1.Repository{Repo: "https://charts.example.com", Type: v1alpha1.RepositoryTypeGit})
+	assert.Nil(t, err)
+	repo, err = db.GetRepository(context.Background(), "https://charts.example.com")
+	assert.Nil(t, err)
+	assert.Equal(t, v1alpha1.RepositoryTypeGit, repo.Type)
+}
+
 func TestGetClusterSuccessful(t *testing.T) {
 	clusterURL := "https://mycluster"
 	clientset := getClientset(nil, &v1.Secret{
@@ -275,6 +296,95 @@ func TestCreateClusterSuccessful(t *testing.T) {
 	assert.Equal(t, common.ManagedByArgoCDAnnotationValue, secret.Annotations[common.ManagedByAnnotation])
 }
 
+func TestCreateClusterWithNormalizerProfiles(t *testing.T) {
+	clusterURL := "https://mycluster"
+	clientset := getClientset(nil)
+	db := NewDB(testNamespace, settings.NewSettingsManager(clientset, testNamespace), clientset)
+
+	_, err := db.CreateCluster(context.Background(), &v1alpha1.Cluster{
+		Server:             clusterURL,
+		NormalizerProfiles: []string{"istio", "cert-manager"},
+	})
+	assert.Nil(t, err)
+
+	cluster, err := db.GetCluster(context.Background(), clusterURL)
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"istio", "cert-manager"}, cluster.NormalizerProfiles)
+}
+
+func TestCreateClusterWithLabels(t *testing.T) {
+	clusterURL := "https://mycluster"
+	clientset := getClientset(nil)
+	db := NewDB(testNamespace, settings.NewSettingsManager(clientset, testNamespace), clientset)
+
+	_, err := db.CreateCluster(context.Background(), &v1alpha1.Cluster{
+		Server: clusterURL,
+		Labels: map[string]string{"env": "prod", "region": "eu"},
+	})
+	assert.Nil(t, err)
+
+	cluster, err := db.GetCluster(context.Background(), clusterURL)
+	assert.Nil(t, err)
+	assert.Equal(t, map[string]string{"env": "prod", "region": "eu"}, cluster.Labels)
+}
+
+func TestGetInClusterByName(t *testing.T) {
+	clientset := getClientset(nil)
+	db := NewDB(testNamespace, settings.NewSettingsManager(clientset, testNamespace), clientset)
+
+	cluster, err := db.GetCluster(context.Background(), common.InClusterName)
+	assert.Nil(t, err)
+	assert.Equal(t, common.KubernetesInternalAPIServerAddr, cluster.Server)
+	assert.Equal(t, common.InClusterName, cluster.Name)
+	assert.True(t, cluster.IsInCluster())
+}
+
+func TestCreateInClusterByName(t *testing.T) {
+	clientset := getClientset(nil)
+	db := NewDB(testNamespace, settings.NewSettingsManager(clientset, testNamespace), clientset)
+
+	_, err := db.CreateCluster(context.Background(), &v1alpha1.Cluster{
+		Server: common.InClusterName,
+		Name:   common.InClusterName,
+		Config: v1alpha1.ClusterConfig{
+			NetworkConfig: &v1alpha1.ClusterNetworkConfig{RetryLimit: 3},
+		},
+	})
+	assert.Nil(t, err)
+
+	cluster, err := db.GetCluster(context.Background(), common.InClusterName)
+	assert.Nil(t, err)
+	assert.Equal(t, common.KubernetesInternalAPIServerAddr, cluster.Server)
+	assert.True(t, cluster.IsInCluster())
+	assert.Equal(t, int64(3), cluster.Config.NetworkConfig.RetryLimit)
+
+	cluster.Config.BearerToken = "token"
+	assert.False(t, cluster.IsInCluster())
+}
+
+func TestUpdateInClusterByName(t *testing.T) {
+	clientset := getClientset(nil)
+	db := NewDB(testNamespace, settings.NewSettingsManager(clientset, testNamespace), clientset)
+
+	_, err := db.CreateCluster(context.Background(), &v1alpha1.Cluster{Server: common.InClusterName, Name: common.InClusterName})
+	assert.Nil(t, err)
+
+	updated, err := db.UpdateCluster(context.Background(), &v1alpha1.Cluster{
+		Server: common.InClusterName,
+		Name:   common.InClusterName,
+		Config: v1alpha1.ClusterConfig{
+			NetworkConfig: &v1alpha1.ClusterNetworkConfig{RetryLimit: 5},
+		},
+	})
+	assert.Nil(t, err)
+	// the address of the cluster is persisted rather than its in-cluster name
+	assert.Equal(t, common.KubernetesInternalAPIServerAddr, updated.Server)
+
+	cluster, err := db.GetCluster(context.Background(), common.KubernetesInternalAPIServerAddr)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(5), cluster.Config.NetworkConfig.RetryLimit)
+}
+
 func TestDeleteClusterWithLegacyName(t *testing.T) {
 	clusterURL := "https://mycluster"
 	legacyClusterName := "cluster-mycluster-3274446258"
@@ -38,6 +38,22 @@ func (db *db) ListRepoURLs(ctx context.Context) ([]string, error) {
 	return urls, nil
 }
 
+// ListRepositories returns list of repositories including their credentials
+func (db *db) ListRepositories(ctx context.Context) ([]*appsv1.Repository, error) {
+	urls, err := db.ListRepoURLs(ctx)
+	if err != nil {
+		return nil, err
+	}
+	repos := make([]*appsv1.Repository, len(urls))
+	for i := range urls {
+		repos[i], err = db.GetRepository(ctx, urls[i])
+		if err != nil {
+			return nil, err
+		}
+	}
+	return repos, nil
+}
+
 // CreateRepository creates a repository
 func (db *db) CreateRepository(ctx context.Context, r *appsv1.Repository) (*appsv1.Repository, error) {
 	s, err := db.settingsMgr.GetSettings()
@@ -61,7 +77,7 @@ func (db *db) CreateRepository(ctx context.Context, r *appsv1.Repository) (*apps
 		data[sshPrivateKey] = []byte(r.SSHPrivateKey)
 	}
 
-	repoInfo := settings.RepoCredentials{URL: r.Repo}
+	repoInfo := settings.RepoCredentials{URL: r.Repo, Type: r.Type}
 	err = db.updateSecrets(&repoInfo, r)
 	if err != nil {
 		return nil, err
@@ -88,7 +104,7 @@ func (db *db) GetRepository(ctx context.Context, repoURL string) (*appsv1.Reposi
 	}
 
 	repoInfo := s.Repositories[index]
-	repo := &appsv1.Repository{Repo: repoURL}
+	repo := &appsv1.Repository{Repo: repoURL, Type: repoInfo.Type}
 
 	cache := make(map[string]*apiv1.Secret)
 	getSecret := func(secretName string) (*apiv1.Secret, error) {
@@ -139,6 +155,10 @@ func (db *db) UpdateRepository(ctx context.Context, r *appsv1.Repository) (*apps
 	}
 
 	repoInfo := s.Repositories[index]
+	// the type is kept unless the update sets it
+	if r.Type != "" {
+		repoInfo.Type = r.Type
+	}
 	err = db.updateSecrets(&repoInfo, r)
 	if err != nil {
 		return nil, err
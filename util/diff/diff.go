@@ -4,6 +4,8 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"sync"
+	"sync/atomic"
 
 	log "github.com/sirupsen/logrus"
 	"github.com/yudai/gojsondiff"
@@ -21,6 +23,12 @@ import (
 type DiffResult struct {
 	Diff     gojsondiff.Diff
 	Modified bool
+	// NormalizedLive is the live object as it was compared: normalized, and without the fields which
+	// are not managed by the config. It is nil if the live object is missing.
+	NormalizedLive map[string]interface{}
+	// PredictedLive is the live object predicted after applying the config, normalized like the
+	// normalized live object. It is nil if the config is missing.
+	PredictedLive map[string]interface{}
 }
 
 type DiffResultList struct {
@@ -29,8 +37,9 @@ type DiffResultList struct {
 }
 
 // Diff performs a diff on two unstructured objects. If the live object happens to have a
-// "kubectl.kubernetes.io/last-applied-configuration", then perform a three way diff.
-func Diff(config, live *unstructured.Unstructured) *DiffResult {
+// "kubectl.kubernetes.io/last-applied-configuration", then perform a three way diff. The fields
+// removed by the built-in normalizer and by the normalizer, if not nil, are ignored.
+func Diff(config, live *unstructured.Unstructured, normalizer Normalizer) *DiffResult {
 	if config != nil {
 		config = stripTypeInformation(config)
 		encodeSecretStringData(config)
@@ -39,6 +48,15 @@ func Diff(config, live *unstructured.Unstructured) *DiffResult {
 		live = stripTypeInformation(live)
 	}
 	orig := getLastAppliedConfigAnnotation(live)
+	for _, obj := range []*unstructured.Unstructured{config, live, orig} {
+		if obj == nil {
+			continue
+		}
+		builtinNormalizer.Normalize(obj)
+		if normalizer != nil {
+			normalizer.Normalize(obj)
+		}
+	}
 	if orig != nil && config != nil {
 		dr, err := ThreeWayDiff(orig, config, live)
 		if err == nil {
@@ -49,6 +67,42 @@ func Diff(config, live *unstructured.Unstructured) *DiffResult {
 	return TwoWayDiff(config, live)
 }
 
+// PredictedDiff diffs the live object against the object predicted by a server-side dry-run apply of
+// the config. Unlike the config, the predicted object is defaulted and mutated by admission webhooks
+// like the live object, so the diff only reports the changes an apply would make. The fields removed
+// by the built-in normalizer and by the normalizer, if not nil, are ignored.
+// The predicted object is complete, so unlike a two-way diff, the fields of the live object which it
+// lacks are reported as removed rather than ignored.
+func PredictedDiff(predicted, live *unstructured.Unstructured, normalizer Normalizer) *DiffResult {
+	predicted = stripTypeInformation(predicted)
+	live = stripTypeInformation(live)
+	for _, obj := range []*unstructured.Unstructured{predicted, live} {
+		removeServerFields(obj)
+		builtinNormalizer.Normalize(obj)
+		if normalizer != nil {
+			normalizer.Normalize(obj)
+		}
+	}
+	predicted = removeNamespaceAnnotation(predicted)
+	live = removeNamespaceAnnotation(live)
+	gjDiff := gojsondiff.New().CompareObjects(live.Object, predicted.Object)
+	return &DiffResult{
+		Diff:           gjDiff,
+		Modified:       gjDiff.Modified(),
+		NormalizedLive: live.Object,
+		PredictedLive:  predicted.Object,
+	}
+}
+
+// removeServerFields removes the metadata which is maintained by the API server, and which a
+// server-side dry-run apply changes regardless of the changes of the object
+func removeServerFields(obj *unstructured.Unstructured) {
+	unstructured.RemoveNestedField(obj.Object, "metadata", "resourceVersion")
+	unstructured.RemoveNestedField(obj.Object, "metadata", "generation")
+	unstructured.RemoveNestedField(obj.Object, "metadata", "managedFields")
+	unstructured.RemoveNestedField(obj.Object, "metadata", "annotations", corev1.LastAppliedConfigAnnotation)
+}
+
 // TwoWayDiff performs a normal two-way diff between two unstructured objects. Ignores extra fields
 // in the live object.
 // Inputs are assumed to be stripped of type information
@@ -63,8 +117,10 @@ func TwoWayDiff(config, live *unstructured.Unstructured) *DiffResult {
 	}
 	gjDiff := gojsondiff.New().CompareObjects(liveObj, configObj)
 	dr := DiffResult{
-		Diff:     gjDiff,
-		Modified: gjDiff.Modified(),
+		Diff:           gjDiff,
+		Modified:       gjDiff.Modified(),
+		NormalizedLive: liveObj,
+		PredictedLive:  configObj,
 	}
 	return &dr
 }
@@ -108,8 +164,10 @@ func ThreeWayDiff(orig, config, live *unstructured.Unstructured) (*DiffResult, e
 	// 3. diff the live object vs. the patched live object
 	gjDiff := gojsondiff.New().CompareObjects(live.Object, patchedLive.Object)
 	dr := DiffResult{
-		Diff:     gjDiff,
-		Modified: gjDiff.Modified(),
+		Diff:           gjDiff,
+		Modified:       gjDiff.Modified(),
+		NormalizedLive: live.Object,
+		PredictedLive:  patchedLive.Object,
 	}
 	return &dr, nil
 }
@@ -250,9 +308,26 @@ func objByKindName(objs []*unstructured.Unstructured, kind, name string) *unstru
 	return nil
 }
 
+// DiffArrayOpts are the options of DiffArrayWithOpts
+type DiffArrayOpts struct {
+	// Parallelism is the number of objects which are diffed concurrently. The objects are diffed one
+	// at a time unless it is greater than 1.
+	Parallelism int
+	// StopOnModified stops diffing the objects once a modified object is found, for callers which only
+	// need to know whether any object is modified. The diffs of the objects which were not diffed are
+	// left empty. The comparisons of applications need the diff of every resource, so do not set it.
+	StopOnModified bool
+}
+
 // DiffArray performs a diff on a list of unstructured objects. Objects are expected to match
 // environments
-func DiffArray(configArray, liveArray []*unstructured.Unstructured) (*DiffResultList, error) {
+func DiffArray(configArray, liveArray []*unstructured.Unstructured, normalizer Normalizer) (*DiffResultList, error) {
+	return DiffArrayWithOpts(configArray, liveArray, normalizer, DiffArrayOpts{})
+}
+
+// DiffArrayWithOpts performs a diff on a list of unstructured objects like DiffArray, concurrently
+// and stopping at the first modified object if requested by the options
+func DiffArrayWithOpts(configArray, liveArray []*unstructured.Unstructured, normalizer Normalizer, opts DiffArrayOpts) (*DiffResultList, error) {
 	numItems := len(configArray)
 	if len(liveArray) != numItems {
 		return nil, fmt.Errorf("left and right arrays have mismatched lengths")
@@ -261,15 +336,38 @@ func DiffArray(configArray, liveArray []*unstructured.Unstructured) (*DiffResult
 	diffResultList := DiffResultList{
 		Diffs: make([]DiffResult, numItems),
 	}
+	workers := opts.Parallelism
+	if workers < 1 {
+		workers = 1
+	}
+	if workers > numItems {
+		workers = numItems
+	}
+	var modified int32
+	items := make(chan int)
+	var wg sync.WaitGroup
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range items {
+				diffRes := Diff(configArray[i], liveArray[i], normalizer)
+				diffResultList.Diffs[i] = *diffRes
+				if diffRes.Modified {
+					atomic.StoreInt32(&modified, 1)
+				}
+			}
+		}()
+	}
 	for i := 0; i < numItems; i++ {
-		config := configArray[i]
-		live := liveArray[i]
-		diffRes := Diff(config, live)
-		diffResultList.Diffs[i] = *diffRes
-		if diffRes.Modified {
-			diffResultList.Modified = true
+		if opts.StopOnModified && atomic.LoadInt32(&modified) == 1 {
+			break
 		}
+		items <- i
 	}
+	close(items)
+	wg.Wait()
+	diffResultList.Modified = modified == 1
 	return &diffResultList, nil
 }
 
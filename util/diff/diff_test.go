@@ -29,7 +29,7 @@ func TestDiff(t *testing.T) {
 	leftDep := test.DemoDeployment()
 	leftUn := kube.MustToUnstructured(leftDep)
 
-	diffRes := Diff(leftUn, leftUn)
+	diffRes := Diff(leftUn, leftUn, nil)
 	assert.False(t, diffRes.Diff.Modified())
 	ascii, err := diffRes.ASCIIFormat(leftUn, formatOpts)
 	assert.Nil(t, err)
@@ -42,14 +42,14 @@ func TestDiffWithNils(t *testing.T) {
 	dep := test.DemoDeployment()
 	resource := kube.MustToUnstructured(dep)
 
-	diffRes := Diff(nil, resource)
+	diffRes := Diff(nil, resource, nil)
 	// NOTE: if live is non-nil, and config is nil, this is not considered difference
 	// This "difference" is checked at the comparator.
 	assert.False(t, diffRes.Diff.Modified())
 	diffRes = TwoWayDiff(nil, resource)
 	assert.False(t, diffRes.Diff.Modified())
 
-	diffRes = Diff(resource, nil)
+	diffRes = Diff(resource, nil, nil)
 	assert.True(t, diffRes.Diff.Modified())
 	diffRes = TwoWayDiff(resource, nil)
 	assert.True(t, diffRes.Diff.Modified())
@@ -64,7 +64,7 @@ func TestDiffArraySame(t *testing.T) {
 
 	left := []*unstructured.Unstructured{leftUn}
 	right := []*unstructured.Unstructured{rightUn}
-	diffResList, err := DiffArray(left, right)
+	diffResList, err := DiffArray(left, right, nil)
 	assert.Nil(t, err)
 	assert.False(t, diffResList.Modified)
 }
@@ -79,7 +79,7 @@ func TestDiffArrayAdditions(t *testing.T) {
 
 	left := []*unstructured.Unstructured{leftUn}
 	right := []*unstructured.Unstructured{rightUn}
-	diffResList, err := DiffArray(left, right)
+	diffResList, err := DiffArray(left, right, nil)
 	assert.Nil(t, err)
 	assert.False(t, diffResList.Modified)
 }
@@ -95,11 +95,60 @@ func TestDiffArrayModification(t *testing.T) {
 
 	left := []*unstructured.Unstructured{leftUn}
 	right := []*unstructured.Unstructured{rightUn}
-	diffResList, err := DiffArray(left, right)
+	diffResList, err := DiffArray(left, right, nil)
 	assert.Nil(t, err)
 	assert.True(t, diffResList.Modified)
 }
 
+func TestDiffPredictedLive(t *testing.T) {
+	configDep := test.DemoDeployment()
+	liveDep := configDep.DeepCopy()
+	ten := int32(10)
+	configDep.Spec.Replicas = &ten
+	liveDep.Status.Replicas = 1
+
+	res := Diff(kube.MustToUnstructured(configDep), kube.MustToUnstructured(liveDep), nil)
+	assert.True(t, res.Modified)
+	// the fields which are not set by the config are not compared
+	_, found, _ := unstructured.NestedInt64(res.NormalizedLive, "status", "replicas")
+	assert.False(t, found)
+	replicas, _, _ := unstructured.NestedInt64(res.NormalizedLive, "spec", "replicas")
+	assert.Equal(t, int64(2), replicas)
+	replicas, _, _ = unstructured.NestedInt64(res.PredictedLive, "spec", "replicas")
+	assert.Equal(t, int64(10), replicas)
+
+	res = Diff(kube.MustToUnstructured(configDep), nil, nil)
+	assert.Nil(t, res.NormalizedLive)
+	assert.NotNil(t, res.PredictedLive)
+}
+
+func TestDiffArrayWithOpts(t *testing.T) {
+	dep := kube.MustToUnstructured(test.DemoDeployment())
+	modifiedDep := test.DemoDeployment()
+	ten := int32(10)
+	modifiedDep.Spec.Replicas = &ten
+
+	left := []*unstructured.Unstructured{dep, dep, dep, dep}
+	right := []*unstructured.Unstructured{dep.DeepCopy(), kube.MustToUnstructured(modifiedDep), dep.DeepCopy(), dep.DeepCopy()}
+	diffResList, err := DiffArrayWithOpts(left, right, nil, DiffArrayOpts{Parallelism: 3})
+	assert.Nil(t, err)
+	assert.True(t, diffResList.Modified)
+	for i, diffRes := range diffResList.Diffs {
+		assert.NotNil(t, diffRes.Diff)
+		assert.Equal(t, i == 1, diffRes.Modified)
+	}
+
+	// the objects after the modified object are not diffed
+	diffResList, err = DiffArrayWithOpts(left, right, nil, DiffArrayOpts{StopOnModified: true})
+	assert.Nil(t, err)
+	assert.True(t, diffResList.Modified)
+	assert.True(t, diffResList.Diffs[1].Modified)
+	assert.Nil(t, diffResList.Diffs[3].Diff)
+
+	_, err = DiffArrayWithOpts(left, right[:1], nil, DiffArrayOpts{Parallelism: 3})
+	assert.Error(t, err)
+}
+
 // TestThreeWayDiff will perform a diff when there is a kubectl.kubernetes.io/last-applied-configuration
 // present in the live object.
 func TestThreeWayDiff(t *testing.T) {
@@ -116,7 +165,7 @@ func TestThreeWayDiff(t *testing.T) {
 	liveDep.SetNamespace("default")
 	configUn := kube.MustToUnstructured(configDep)
 	liveUn := kube.MustToUnstructured(liveDep)
-	res := Diff(configUn, liveUn)
+	res := Diff(configUn, liveUn, nil)
 	if !assert.False(t, res.Modified) {
 		ascii, err := res.ASCIIFormat(liveUn, formatOpts)
 		assert.Nil(t, err)
@@ -130,7 +179,7 @@ func TestThreeWayDiff(t *testing.T) {
 	liveDep.Annotations[v1.LastAppliedConfigAnnotation] = string(configBytes)
 	configUn = kube.MustToUnstructured(configDep)
 	liveUn = kube.MustToUnstructured(liveDep)
-	res = Diff(configUn, liveUn)
+	res = Diff(configUn, liveUn, nil)
 	if !assert.False(t, res.Modified) {
 		ascii, err := res.ASCIIFormat(liveUn, formatOpts)
 		assert.Nil(t, err)
@@ -142,7 +191,7 @@ func TestThreeWayDiff(t *testing.T) {
 	delete(configDep.Annotations, "foo")
 	configUn = kube.MustToUnstructured(configDep)
 	liveUn = kube.MustToUnstructured(liveDep)
-	res = Diff(configUn, liveUn)
+	res = Diff(configUn, liveUn, nil)
 	assert.True(t, res.Modified)
 
 	// 5. Just to prove three way diff incorporates last-applied-configuration, remove the
@@ -152,7 +201,7 @@ func TestThreeWayDiff(t *testing.T) {
 	delete(liveDep.Annotations, v1.LastAppliedConfigAnnotation)
 	configUn = kube.MustToUnstructured(configDep)
 	liveUn = kube.MustToUnstructured(liveDep)
-	res = Diff(configUn, liveUn)
+	res = Diff(configUn, liveUn, nil)
 	ascii, err := res.ASCIIFormat(liveUn, formatOpts)
 	assert.Nil(t, err)
 	if ascii != "" {
@@ -210,7 +259,7 @@ func TestThreeWayDiffExample1(t *testing.T) {
 	assert.Nil(t, err)
 	err = json.Unmarshal([]byte(demoLive), &liveUn.Object)
 	assert.Nil(t, err)
-	dr := Diff(&configUn, &liveUn)
+	dr := Diff(&configUn, &liveUn, nil)
 	assert.False(t, dr.Modified)
 	ascii, err := dr.ASCIIFormat(&liveUn, formatOpts)
 	assert.Nil(t, err)
@@ -230,7 +279,7 @@ func TestThreeWayDiffExample2(t *testing.T) {
 	assert.NoError(t, err)
 	err = json.Unmarshal(liveData, &liveUn.Object)
 	assert.NoError(t, err)
-	dr := Diff(&configUn, &liveUn)
+	dr := Diff(&configUn, &liveUn, nil)
 	assert.False(t, dr.Modified)
 	ascii, err := dr.ASCIIFormat(&liveUn, formatOpts)
 	assert.Nil(t, err)
@@ -258,7 +307,7 @@ func TestThreeWayDiffExample2WithDifference(t *testing.T) {
 	delete(labels, "release")
 	configUn.SetLabels(labels)
 
-	dr := Diff(&configUn, &liveUn)
+	dr := Diff(&configUn, &liveUn, nil)
 	assert.True(t, dr.Modified)
 	ascii, err := dr.ASCIIFormat(&liveUn, formatOpts)
 	assert.Nil(t, err)
@@ -297,7 +346,7 @@ func TestThreeWayDiffExplicitNamespace(t *testing.T) {
 	assert.NoError(t, err)
 	err = json.Unmarshal(liveData, &liveUn.Object)
 	assert.NoError(t, err)
-	dr := Diff(&configUn, &liveUn)
+	dr := Diff(&configUn, &liveUn, nil)
 	assert.False(t, dr.Modified)
 	ascii, err := dr.ASCIIFormat(&liveUn, formatOpts)
 	assert.Nil(t, err)
@@ -354,7 +403,7 @@ func TestIgnoreNamespaceForClusterScopedResources(t *testing.T) {
 	assert.Nil(t, err)
 	err = yaml.Unmarshal([]byte(customObjConfig), &configUn)
 	assert.Nil(t, err)
-	dr := Diff(&configUn, &liveUn)
+	dr := Diff(&configUn, &liveUn, nil)
 	assert.False(t, dr.Modified)
 }
 
@@ -398,7 +447,7 @@ func TestSecretStringData(t *testing.T) {
 	err = yaml.Unmarshal([]byte(secretLive), &liveUn)
 	assert.Nil(t, err)
 
-	dr := Diff(&configUn, &liveUn)
+	dr := Diff(&configUn, &liveUn, nil)
 	if !assert.False(t, dr.Modified) {
 		ascii, err := dr.ASCIIFormat(&liveUn, formatOpts)
 		assert.Nil(t, err)
@@ -442,6 +491,35 @@ func TestInvalidSecretStringData(t *testing.T) {
 	err = yaml.Unmarshal([]byte(secretInvalidLive), &liveUn)
 	assert.Nil(t, err)
 
-	dr := Diff(&configUn, nil)
+	dr := Diff(&configUn, nil, nil)
 	assert.True(t, dr.Modified)
 }
+
+func TestPredictedDiff(t *testing.T) {
+	liveDep := test.DemoDeployment()
+	liveDep.ResourceVersion = "123"
+	liveDep.Generation = 2
+	liveDep.Annotations = map[string]string{v1.LastAppliedConfigAnnotation: "{}"}
+	liveDep.Spec.Template.Spec.Containers[0].TerminationMessagePath = "/dev/termination-log"
+	live := kube.MustToUnstructured(liveDep)
+
+	// the predicted object is defaulted like the live object
+	predictedDep := liveDep.DeepCopy()
+	predictedDep.ResourceVersion = "124"
+	predictedDep.Generation = 3
+	predictedDep.Annotations = map[string]string{v1.LastAppliedConfigAnnotation: `{"kind":"Deployment"}`}
+	diffRes := PredictedDiff(kube.MustToUnstructured(predictedDep), live, nil)
+	assert.False(t, diffRes.Modified)
+
+	predictedDep.Spec.Template.Spec.Containers[0].Image = "nginx:1.15"
+	diffRes = PredictedDiff(kube.MustToUnstructured(predictedDep), live, nil)
+	assert.True(t, diffRes.Modified)
+	// the live object is not changed by the diff
+	assert.Equal(t, "123", live.GetResourceVersion())
+
+	// fields which the apply removes from the live object are reported
+	predictedDep = liveDep.DeepCopy()
+	predictedDep.Spec.Template.Spec.Containers[0].TerminationMessagePath = ""
+	diffRes = PredictedDiff(kube.MustToUnstructured(predictedDep), live, nil)
+	assert.True(t, diffRes.Modified)
+}
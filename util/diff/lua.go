@@ -0,0 +1,284 @@
+package diff
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	lua "github.com/yuin/gopher-lua"
+	"github.com/yuin/gopher-lua/parse"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+// luaScriptTimeout is the maximum duration of a normalization script
+const luaScriptTimeout = time.Second
+
+// NormalizerScript is a Lua script which normalizes the objects of a group and kind before they are
+// diffed. The script gets the object as the global 'obj' table, and returns the normalized object.
+type NormalizerScript struct {
+	Group string
+	// Kind is the kind of the objects, or "*" to normalize the objects of every group and kind
+	Kind   string
+	Script string
+}
+
+// scriptNormalizer normalizes objects with Lua scripts, which are compiled once
+type scriptNormalizer struct {
+	scripts []compiledScript
+	// states are the Lua states which are reused by the runs of the scripts
+	states sync.Pool
+}
+
+// compiledScript is a normalizer script and its compiled function
+type compiledScript struct {
+	NormalizerScript
+	proto *lua.FunctionProto
+}
+
+// compileNormalizerScript returns an error if the script has no kind, or is not valid Lua
+func compileNormalizerScript(script NormalizerScript) (*lua.FunctionProto, error) {
+	if script.Kind == "" {
+		return nil, fmt.Errorf("kind is required for normalizer scripts")
+	}
+	chunk, err := parse.Parse(strings.NewReader(script.Script), script.Kind)
+	if err != nil {
+		return nil, fmt.Errorf("invalid normalizer script of %s: %v", script.Kind, err)
+	}
+	proto, err := lua.Compile(chunk, script.Kind)
+	if err != nil {
+		return nil, fmt.Errorf("invalid normalizer script of %s: %v", script.Kind, err)
+	}
+	return proto, nil
+}
+
+// ValidateNormalizerScripts returns an error if one of the scripts has no kind, or is not valid Lua
+func ValidateNormalizerScripts(scripts []NormalizerScript) error {
+	for _, script := range scripts {
+		if _, err := compileNormalizerScript(script); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// NewScriptNormalizer returns a normalizer which normalizes objects with Lua scripts, or nil if no
+// scripts are given. The scripts are compiled once, so the normalizer should be reused as long as the
+// scripts do not change.
+func NewScriptNormalizer(scripts []NormalizerScript) (Normalizer, error) {
+	compiled := make([]compiledScript, len(scripts))
+	for i, script := range scripts {
+		proto, err := compileNormalizerScript(script)
+		if err != nil {
+			return nil, err
+		}
+		compiled[i] = compiledScript{NormalizerScript: script, proto: proto}
+	}
+	if len(scripts) == 0 {
+		return nil, nil
+	}
+	return &scriptNormalizer{scripts: compiled}, nil
+}
+
+// Normalize runs the scripts of the group and kind of the object. The object is left unchanged by
+// scripts which fail.
+func (n *scriptNormalizer) Normalize(un *unstructured.Unstructured) {
+	gvk := un.GroupVersionKind()
+	for _, script := range n.scripts {
+		if script.Kind != "*" && (gvk.Group != script.Group || gvk.Kind != script.Kind) {
+			continue
+		}
+		obj, err := n.runScript(script.proto, un.Object)
+		if err != nil {
+			log.Warnf("Failed to run the normalizer script of %s on %s/%s: %v", script.Kind, gvk.Kind, un.GetName(), err)
+			continue
+		}
+		un.Object = obj
+	}
+}
+
+// runScript runs a compiled script on a copy of the object in a pooled Lua state, and returns the
+// normalized object
+func (n *scriptNormalizer) runScript(proto *lua.FunctionProto, obj map[string]interface{}) (map[string]interface{}, error) {
+	l, ok := n.states.Get().(*lua.LState)
+	if !ok {
+		var err error
+		if l, err = newLuaState(); err != nil {
+			return nil, err
+		}
+	}
+	normalized, err := runNormalizerScript(l, proto, obj)
+	if err != nil {
+		// the state of a failed or interrupted script is not reused
+		l.Close()
+		return nil, err
+	}
+	n.states.Put(l)
+	return normalized, nil
+}
+
+// runNormalizerScript runs a compiled script on a copy of the object, and returns the normalized
+// object. The script runs with fresh globals, so that the globals and libraries it changes are not seen
+// by the next runs in the same state.
+func runNormalizerScript(l *lua.LState, proto *lua.FunctionProto, obj map[string]interface{}) (map[string]interface{}, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), luaScriptTimeout)
+	defer cancel()
+	l.SetContext(ctx)
+	defer l.RemoveContext()
+
+	env := newScriptGlobals(l)
+	env.RawSetString("obj", toLuaValue(l, obj))
+	fn := l.NewFunctionFromProto(proto)
+	fn.Env = env
+	if err := l.CallByParam(lua.P{Fn: fn, NRet: 1, Protect: true}); err != nil {
+		return nil, err
+	}
+	ret := l.Get(-1)
+	l.Pop(1)
+	// scripts which modify the object in place may not return it
+	if ret == lua.LNil {
+		ret = env.RawGetString("obj")
+	}
+	table, ok := ret.(*lua.LTable)
+	if !ok {
+		return nil, fmt.Errorf("the script returned a %s rather than the object", ret.Type())
+	}
+	normalized, ok := fromLuaValue(table, obj).(map[string]interface{})
+	if !ok {
+		return nil, fmt.Errorf("the script returned a list rather than the object")
+	}
+	return normalized, nil
+}
+
+// newScriptGlobals returns a copy of the globals of the state for a run of a script, including copies of
+// the library tables, and whose _G is the copy
+func newScriptGlobals(l *lua.LState) *lua.LTable {
+	globals := l.NewTable()
+	l.G.Global.ForEach(func(key, value lua.LValue) {
+		if lib, ok := value.(*lua.LTable); ok && lib != l.G.Global {
+			libCopy := l.NewTable()
+			lib.ForEach(libCopy.RawSet)
+			value = libCopy
+		}
+		globals.RawSet(key, value)
+	})
+	globals.RawSetString("_G", globals)
+	return globals
+}
+
+// newLuaState returns a Lua state with the base, table, string and math libraries, and without the
+// functions which access files
+func newLuaState() (*lua.LState, error) {
+	l := lua.NewState(lua.Options{SkipOpenLibs: true})
+	libs := []struct {
+		name string
+		open lua.LGFunction
+	}{
+		{lua.BaseLibName, lua.OpenBase},
+		{lua.TabLibName, lua.OpenTable},
+		{lua.StringLibName, lua.OpenString},
+		{lua.MathLibName, lua.OpenMath},
+	}
+	for _, lib := range libs {
+		if err := l.CallByParam(lua.P{Fn: l.NewFunction(lib.open), NRet: 0, Protect: true}, lua.LString(lib.name)); err != nil {
+			l.Close()
+			return nil, err
+		}
+	}
+	for _, name := range []string{"dofile", "loadfile", "load", "loadstring"} {
+		l.SetGlobal(name, lua.LNil)
+	}
+	return l, nil
+}
+
+// toLuaValue converts a value of an unstructured object to a Lua value. Maps and lists are converted
+// to tables.
+func toLuaValue(l *lua.LState, value interface{}) lua.LValue {
+	switch val := value.(type) {
+	case map[string]interface{}:
+		table := l.NewTable()
+		keys := make([]string, 0, len(val))
+		for key := range val {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		for _, key := range keys {
+			table.RawSetString(key, toLuaValue(l, val[key]))
+		}
+		return table
+	case []interface{}:
+		table := l.NewTable()
+		for _, item := range val {
+			table.Append(toLuaValue(l, item))
+		}
+		return table
+	case string:
+		return lua.LString(val)
+	case bool:
+		return lua.LBool(val)
+	case int64:
+		return lua.LNumber(val)
+	case int:
+		return lua.LNumber(val)
+	case float64:
+		return lua.LNumber(val)
+	default:
+		return lua.LNil
+	}
+}
+
+// fromLuaValue converts a Lua value to a value of an unstructured object. The original value is used
+// to tell empty lists from empty maps, since both are empty tables in Lua.
+func fromLuaValue(value lua.LValue, orig interface{}) interface{} {
+	switch val := value.(type) {
+	case *lua.LTable:
+		if n := val.MaxN(); n > 0 || isList(orig) && isEmptyTable(val) {
+			origList, _ := orig.([]interface{})
+			list := make([]interface{}, 0, n)
+			for i := 1; i <= n; i++ {
+				var origItem interface{}
+				if i <= len(origList) {
+					origItem = origList[i-1]
+				} else if len(origList) > 0 {
+					origItem = origList[0]
+				}
+				list = append(list, fromLuaValue(val.RawGetInt(i), origItem))
+			}
+			return list
+		}
+		origMap, _ := orig.(map[string]interface{})
+		obj := make(map[string]interface{})
+		val.ForEach(func(key, item lua.LValue) {
+			if item == lua.LNil {
+				return
+			}
+			obj[key.String()] = fromLuaValue(item, origMap[key.String()])
+		})
+		return obj
+	case lua.LString:
+		return string(val)
+	case lua.LBool:
+		return bool(val)
+	case lua.LNumber:
+		if f := float64(val); f == math.Trunc(f) && math.Abs(f) < 1<<53 {
+			return int64(f)
+		}
+		return float64(val)
+	default:
+		return nil
+	}
+}
+
+func isList(value interface{}) bool {
+	_, ok := value.([]interface{})
+	return ok
+}
+
+func isEmptyTable(table *lua.LTable) bool {
+	key, _ := table.Next(lua.LNil)
+	return key == lua.LNil
+}
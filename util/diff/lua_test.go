@@ -0,0 +1,149 @@
+package diff
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+const routeConfig = `
+apiVersion: example.com/v1
+kind: Route
+metadata:
+  name: frontend
+spec:
+  rules:
+  - name: api
+    port: 8080
+  - name: web
+    port: 80
+`
+
+const sortRulesScript = `
+table.sort(obj.spec.rules, function(a, b) return a.name < b.name end)
+return obj
+`
+
+func TestNewScriptNormalizer(t *testing.T) {
+	normalizer, err := NewScriptNormalizer(nil)
+	assert.Nil(t, err)
+	assert.Nil(t, normalizer)
+
+	_, err = NewScriptNormalizer([]NormalizerScript{{Script: sortRulesScript}})
+	assert.Error(t, err)
+	_, err = NewScriptNormalizer([]NormalizerScript{{Kind: "Route", Script: "obj.spec ="}})
+	assert.Error(t, err)
+}
+
+func TestScriptNormalizer(t *testing.T) {
+	config := unmarshalUnstructured(t, routeConfig)
+	// the controller of the routes sorts the rules by port
+	live := unmarshalUnstructured(t, `
+apiVersion: example.com/v1
+kind: Route
+metadata:
+  name: frontend
+spec:
+  rules:
+  - name: web
+    port: 80
+  - name: api
+    port: 8080
+`)
+	assert.True(t, Diff(config, live, nil).Modified)
+
+	normalizer, err := NewScriptNormalizer([]NormalizerScript{{Group: "example.com", Kind: "Route", Script: sortRulesScript}})
+	assert.Nil(t, err)
+	assert.False(t, Diff(config, live, normalizer).Modified)
+	// the other fields are still compared
+	live.SetName("backend")
+	assert.True(t, Diff(config, live, normalizer).Modified)
+
+	// the scripts of other kinds are not run
+	normalizer, err = NewScriptNormalizer([]NormalizerScript{{Group: "example.com", Kind: "Gateway", Script: sortRulesScript}})
+	assert.Nil(t, err)
+	live.SetName("frontend")
+	assert.True(t, Diff(config, live, normalizer).Modified)
+}
+
+func TestScriptNormalizerInPlace(t *testing.T) {
+	un := unmarshalUnstructured(t, routeConfig)
+	normalizer, err := NewScriptNormalizer([]NormalizerScript{{Kind: "*", Script: "obj.spec.rules = {}\nobj.spec.paused = false"}})
+	assert.Nil(t, err)
+	normalizer.Normalize(un)
+	assert.Equal(t, map[string]interface{}{"rules": []interface{}{}, "paused": false}, un.Object["spec"])
+}
+
+func TestScriptNormalizerFailure(t *testing.T) {
+	scripts := []string{
+		"while true do end",
+		"return 'frontend'",
+		"error('failed')",
+		"dofile('/etc/passwd')",
+	}
+	for _, script := range scripts {
+		un := unmarshalUnstructured(t, routeConfig)
+		normalizer, err := NewScriptNormalizer([]NormalizerScript{{Kind: "*", Script: script}})
+		assert.Nil(t, err)
+		normalizer.Normalize(un)
+		assert.Equal(t, unmarshalUnstructured(t, routeConfig), un, script)
+	}
+}
+
+func TestComposeNormalizers(t *testing.T) {
+	assert.Nil(t, ComposeNormalizers(nil, nil))
+
+	scriptNormalizer, err := NewScriptNormalizer([]NormalizerScript{{Kind: "*", Script: sortRulesScript}})
+	assert.Nil(t, err)
+	assert.Equal(t, scriptNormalizer, ComposeNormalizers(nil, scriptNormalizer))
+
+	noNormalizer, err := NewNormalizer(nil, nil)
+	assert.Nil(t, err)
+	un := unmarshalUnstructured(t, routeConfig)
+	ComposeNormalizers(scriptNormalizer, noNormalizer, &ruleNormalizer{rules: []ignoreRule{
+		{kind: "*", jsonPointers: []string{"/spec/rules/0/port"}},
+	}}).Normalize(un)
+	rules, _, _ := unstructured.NestedSlice(un.Object, "spec", "rules")
+	assert.Equal(t, []interface{}{map[string]interface{}{"name": "api"}, map[string]interface{}{"name": "web", "port": int64(80)}}, rules)
+}
+
+func TestScriptNormalizerReusesStates(t *testing.T) {
+	// the globals set by a run are not seen by the next runs
+	normalizer, err := NewScriptNormalizer([]NormalizerScript{{Kind: "*", Script: `
+if sorted then obj.spec.sorted = true end
+sorted = true
+` + sortRulesScript}})
+	assert.Nil(t, err)
+	for i := 0; i < 3; i++ {
+		un := unmarshalUnstructured(t, routeConfig)
+		normalizer.Normalize(un)
+		_, found, _ := unstructured.NestedFieldNoCopy(un.Object, "spec", "sorted")
+		assert.False(t, found)
+	}
+}
+
+func TestScriptNormalizerDoesNotShareGlobals(t *testing.T) {
+	// the globals and libraries changed by a run, including through _G, are not seen by the next runs
+	normalizer, err := NewScriptNormalizer([]NormalizerScript{{Kind: "*", Script: `
+if _G.sorted or string.sorted then obj.spec.sorted = true end
+_G.sorted = true
+string.sorted = true
+table.sort = nil
+rawset(_G, "tostring", nil)
+return obj
+`}, {Kind: "*", Script: `
+table.sort(obj.spec.rules, function(a, b) return a.name > b.name end)
+return obj
+`}})
+	assert.Nil(t, err)
+	for i := 0; i < 3; i++ {
+		un := unmarshalUnstructured(t, routeConfig)
+		normalizer.Normalize(un)
+		_, found, _ := unstructured.NestedFieldNoCopy(un.Object, "spec", "sorted")
+		assert.False(t, found)
+		rules, _, _ := unstructured.NestedSlice(un.Object, "spec", "rules")
+		// the second script still sorts the rules
+		assert.Equal(t, "web", rules[0].(map[string]interface{})["name"])
+	}
+}
@@ -0,0 +1,263 @@
+package diff
+
+import (
+	"fmt"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1"
+)
+
+// Normalizer removes the fields of objects which are ignored when diffing
+type Normalizer interface {
+	Normalize(un *unstructured.Unstructured)
+}
+
+// ignoreRule ignores fields of the objects of a group and kind, which are set by defaulting or
+// mutating webhooks of an operator
+type ignoreRule struct {
+	group string
+	// kind is the kind of the objects, or "*" to match the objects of every group and kind
+	kind string
+	// name is a pattern of the names of the objects, or empty to match every object
+	name string
+	// annotations lists the annotations of which the objects must have one, unless empty
+	annotations []string
+	// field is a top level field the objects must have, unless empty
+	field string
+	// jsonPointers are the JSON pointers (RFC 6901) of the ignored fields. A "*" token matches every
+	// item of a list.
+	jsonPointers []string
+}
+
+const (
+	webhookCABundle      = "/webhooks/*/clientConfig/caBundle"
+	webhookFailurePolicy = "/webhooks/*/failurePolicy"
+)
+
+// normalizerProfiles holds the built-in profiles of the fields which are set by well-known operators
+var normalizerProfiles = map[string][]ignoreRule{
+	// istio injects the CA bundle of citadel into its webhooks, and galley enables its validating
+	// webhook once it is ready
+	"istio": {
+		{group: "admissionregistration.k8s.io", kind: "MutatingWebhookConfiguration", name: "istio-sidecar-injector", jsonPointers: []string{webhookCABundle}},
+		{group: "admissionregistration.k8s.io", kind: "ValidatingWebhookConfiguration", name: "istio-galley", jsonPointers: []string{webhookCABundle, webhookFailurePolicy}},
+	},
+	// the CA injector of cert-manager injects the CA bundle into the objects annotated with its source
+	"cert-manager": {
+		{group: "admissionregistration.k8s.io", kind: "MutatingWebhookConfiguration", annotations: certManagerInjectAnnotations, jsonPointers: []string{webhookCABundle}},
+		{group: "admissionregistration.k8s.io", kind: "ValidatingWebhookConfiguration", annotations: certManagerInjectAnnotations, jsonPointers: []string{webhookCABundle}},
+		{group: "apiregistration.k8s.io", kind: "APIService", annotations: certManagerInjectAnnotations, jsonPointers: []string{"/spec/caBundle"}},
+		{group: "apiextensions.k8s.io", kind: "CustomResourceDefinition", annotations: certManagerInjectAnnotations, jsonPointers: []string{"/spec/conversion/webhookClientConfig/caBundle"}},
+	},
+	// the admission webhooks of the prometheus operator are patched with the generated CA bundle and
+	// failure policy once their certificate is created
+	"prometheus-operator": {
+		{group: "admissionregistration.k8s.io", kind: "MutatingWebhookConfiguration", name: "*-admission", jsonPointers: []string{webhookCABundle, webhookFailurePolicy}},
+		{group: "admissionregistration.k8s.io", kind: "ValidatingWebhookConfiguration", name: "*-admission", jsonPointers: []string{webhookCABundle, webhookFailurePolicy}},
+	},
+}
+
+// builtinNormalizer removes the fields which are ignored when diffing any object, regardless of the
+// profiles and ignored differences
+var builtinNormalizer Normalizer = &ruleNormalizer{rules: []ignoreRule{
+	// the rules of aggregated cluster roles are populated by the cluster role aggregation controller
+	{group: "rbac.authorization.k8s.io", kind: "ClusterRole", field: "aggregationRule", jsonPointers: []string{"/rules"}},
+}}
+
+var certManagerInjectAnnotations = []string{
+	"certmanager.k8s.io/inject-ca-from",
+	"certmanager.k8s.io/inject-ca-from-secret",
+	"cert-manager.io/inject-ca-from",
+	"cert-manager.io/inject-ca-from-secret",
+}
+
+// NormalizerProfiles returns the names of the built-in normalizer profiles
+func NormalizerProfiles() []string {
+	names := make([]string, 0, len(normalizerProfiles))
+	for name := range normalizerProfiles {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// ValidateNormalizerProfiles returns an error if one of the profiles is not a built-in profile
+func ValidateNormalizerProfiles(profiles []string) error {
+	for _, profile := range profiles {
+		if _, ok := normalizerProfiles[profile]; !ok {
+			return fmt.Errorf("unknown normalizer profile '%s'. Known profiles: %s", profile, strings.Join(NormalizerProfiles(), ", "))
+		}
+	}
+	return nil
+}
+
+// ValidateIgnoreDifferences returns an error if one of the ignored differences has no kind, or an
+// invalid JSON pointer
+func ValidateIgnoreDifferences(ignoreDifferences []v1alpha1.ResourceIgnoreDifferences) error {
+	for _, ignore := range ignoreDifferences {
+		if ignore.Kind == "" {
+			return fmt.Errorf("kind is required for ignored differences")
+		}
+		if _, err := filepath.Match(ignore.Name, ""); err != nil {
+			return fmt.Errorf("invalid name pattern '%s' of ignored differences of %s: %v", ignore.Name, ignore.Kind, err)
+		}
+		if len(ignore.JSONPointers) == 0 {
+			return fmt.Errorf("jsonPointers are required for ignored differences of %s", ignore.Kind)
+		}
+		for _, pointer := range ignore.JSONPointers {
+			if !strings.HasPrefix(pointer, "/") || pointer == "/" {
+				return fmt.Errorf("invalid JSON pointer '%s' of ignored differences of %s: must start with '/'", pointer, ignore.Kind)
+			}
+		}
+	}
+	return nil
+}
+
+// ruleNormalizer removes the fields of ignore rules
+type ruleNormalizer struct {
+	rules []ignoreRule
+}
+
+// NewProfileNormalizer returns a normalizer which removes the fields ignored by the profiles, or nil if
+// no profiles are given
+func NewProfileNormalizer(profiles []string) (Normalizer, error) {
+	return NewNormalizer(profiles, nil)
+}
+
+// NewNormalizer returns a normalizer which removes the fields ignored by the profiles and the ignored
+// differences of an application, or nil if no fields are ignored
+func NewNormalizer(profiles []string, ignoreDifferences []v1alpha1.ResourceIgnoreDifferences) (Normalizer, error) {
+	if err := ValidateNormalizerProfiles(profiles); err != nil {
+		return nil, err
+	}
+	if err := ValidateIgnoreDifferences(ignoreDifferences); err != nil {
+		return nil, err
+	}
+	if len(profiles) == 0 && len(ignoreDifferences) == 0 {
+		return nil, nil
+	}
+	normalizer := ruleNormalizer{}
+	for _, profile := range profiles {
+		normalizer.rules = append(normalizer.rules, normalizerProfiles[profile]...)
+	}
+	for _, ignore := range ignoreDifferences {
+		normalizer.rules = append(normalizer.rules, ignoreRule{
+			group:        ignore.Group,
+			kind:         ignore.Kind,
+			name:         ignore.Name,
+			jsonPointers: ignore.JSONPointers,
+		})
+	}
+	return &normalizer, nil
+}
+
+// composedNormalizer runs normalizers in order
+type composedNormalizer []Normalizer
+
+// ComposeNormalizers returns a normalizer which runs the normalizers which are not nil in order, or nil
+// if all of them are nil
+func ComposeNormalizers(normalizers ...Normalizer) Normalizer {
+	var composed composedNormalizer
+	for _, normalizer := range normalizers {
+		if normalizer != nil {
+			composed = append(composed, normalizer)
+		}
+	}
+	switch len(composed) {
+	case 0:
+		return nil
+	case 1:
+		return composed[0]
+	}
+	return composed
+}
+
+// Normalize runs the normalizers on the object
+func (n composedNormalizer) Normalize(un *unstructured.Unstructured) {
+	for _, normalizer := range n {
+		normalizer.Normalize(un)
+	}
+}
+
+// Normalize removes the ignored fields from the object
+func (n *ruleNormalizer) Normalize(un *unstructured.Unstructured) {
+	for _, rule := range n.rules {
+		if !rule.matches(un) {
+			continue
+		}
+		for _, pointer := range rule.jsonPointers {
+			removeJSONPointer(un.Object, parseJSONPointer(pointer))
+		}
+	}
+}
+
+func (r *ignoreRule) matches(un *unstructured.Unstructured) bool {
+	gvk := un.GroupVersionKind()
+	if r.kind != "*" && (gvk.Group != r.group || gvk.Kind != r.kind) {
+		return false
+	}
+	if r.name != "" {
+		if ok, err := filepath.Match(r.name, un.GetName()); err != nil || !ok {
+			return false
+		}
+	}
+	if r.field != "" {
+		if _, ok := un.Object[r.field]; !ok {
+			return false
+		}
+	}
+	if len(r.annotations) == 0 {
+		return true
+	}
+	annotations := un.GetAnnotations()
+	for _, annotation := range r.annotations {
+		if _, ok := annotations[annotation]; ok {
+			return true
+		}
+	}
+	return false
+}
+
+// parseJSONPointer splits a JSON pointer into its unescaped reference tokens
+func parseJSONPointer(pointer string) []string {
+	tokens := strings.Split(strings.TrimPrefix(pointer, "/"), "/")
+	for i, token := range tokens {
+		tokens[i] = strings.Replace(strings.Replace(token, "~1", "/", -1), "~0", "~", -1)
+	}
+	return tokens
+}
+
+// removeJSONPointer removes the field referenced by the tokens from the object, if it exists
+func removeJSONPointer(obj interface{}, tokens []string) {
+	if len(tokens) == 0 {
+		return
+	}
+	token := tokens[0]
+	switch val := obj.(type) {
+	case map[string]interface{}:
+		if len(tokens) == 1 {
+			delete(val, token)
+			return
+		}
+		if child, ok := val[token]; ok {
+			removeJSONPointer(child, tokens[1:])
+		}
+	case []interface{}:
+		if token == "*" {
+			for _, item := range val {
+				removeJSONPointer(item, tokens[1:])
+			}
+			return
+		}
+		// items are never removed from lists, since this would shift the following items
+		index, err := strconv.Atoi(token)
+		if err == nil && index >= 0 && index < len(val) && len(tokens) > 1 {
+			removeJSONPointer(val[index], tokens[1:])
+		}
+	}
+}
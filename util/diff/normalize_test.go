@@ -0,0 +1,200 @@
+package diff
+
+import (
+	"testing"
+
+	"github.com/ghodss/yaml"
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1"
+)
+
+const webhookConfig = `
+apiVersion: admissionregistration.k8s.io/v1beta1
+kind: MutatingWebhookConfiguration
+metadata:
+  name: istio-sidecar-injector
+webhooks:
+- name: sidecar-injector.istio.io
+  clientConfig:
+    caBundle: ""
+    service:
+      name: istio-sidecar-injector
+      namespace: istio-system
+  failurePolicy: Fail
+`
+
+func unmarshalUnstructured(t *testing.T, text string) *unstructured.Unstructured {
+	var un unstructured.Unstructured
+	err := yaml.Unmarshal([]byte(text), &un.Object)
+	assert.Nil(t, err)
+	return &un
+}
+
+func TestNewProfileNormalizer(t *testing.T) {
+	normalizer, err := NewProfileNormalizer(nil)
+	assert.Nil(t, err)
+	assert.Nil(t, normalizer)
+
+	_, err = NewProfileNormalizer([]string{"istio", "linkerd"})
+	assert.Error(t, err)
+}
+
+func TestProfileNormalizer(t *testing.T) {
+	normalizer, err := NewProfileNormalizer([]string{"istio"})
+	assert.Nil(t, err)
+
+	un := unmarshalUnstructured(t, webhookConfig)
+	normalizer.Normalize(un)
+	webhooks, _, _ := unstructured.NestedSlice(un.Object, "webhooks")
+	clientConfig := webhooks[0].(map[string]interface{})["clientConfig"].(map[string]interface{})
+	_, ok := clientConfig["caBundle"]
+	assert.False(t, ok)
+	assert.NotNil(t, clientConfig["service"])
+	assert.Equal(t, "Fail", webhooks[0].(map[string]interface{})["failurePolicy"])
+
+	// other webhooks are not normalized
+	un = unmarshalUnstructured(t, webhookConfig)
+	un.SetName("other-injector")
+	normalizer.Normalize(un)
+	webhooks, _, _ = unstructured.NestedSlice(un.Object, "webhooks")
+	clientConfig = webhooks[0].(map[string]interface{})["clientConfig"].(map[string]interface{})
+	assert.Equal(t, "", clientConfig["caBundle"])
+}
+
+func TestProfileNormalizerAnnotations(t *testing.T) {
+	normalizer, err := NewProfileNormalizer([]string{"cert-manager"})
+	assert.Nil(t, err)
+
+	un := unmarshalUnstructured(t, webhookConfig)
+	normalizer.Normalize(un)
+	webhooks, _, _ := unstructured.NestedSlice(un.Object, "webhooks")
+	assert.Contains(t, webhooks[0].(map[string]interface{})["clientConfig"], "caBundle")
+
+	un.SetAnnotations(map[string]string{"cert-manager.io/inject-ca-from": "istio-system/injector"})
+	normalizer.Normalize(un)
+	webhooks, _, _ = unstructured.NestedSlice(un.Object, "webhooks")
+	assert.NotContains(t, webhooks[0].(map[string]interface{})["clientConfig"], "caBundle")
+}
+
+func TestDiffWithNormalizer(t *testing.T) {
+	config := unmarshalUnstructured(t, webhookConfig)
+	live := unmarshalUnstructured(t, webhookConfig)
+	webhooks, _, _ := unstructured.NestedSlice(live.Object, "webhooks")
+	webhooks[0].(map[string]interface{})["clientConfig"].(map[string]interface{})["caBundle"] = "Y2VydGlmaWNhdGU="
+	err := unstructured.SetNestedSlice(live.Object, webhooks, "webhooks")
+	assert.Nil(t, err)
+
+	assert.True(t, Diff(config, live, nil).Modified)
+
+	normalizer, err := NewProfileNormalizer([]string{"istio"})
+	assert.Nil(t, err)
+	assert.False(t, Diff(config, live, normalizer).Modified)
+	// the objects themselves are not normalized
+	webhooks, _, _ = unstructured.NestedSlice(live.Object, "webhooks")
+	assert.Equal(t, "Y2VydGlmaWNhdGU=", webhooks[0].(map[string]interface{})["clientConfig"].(map[string]interface{})["caBundle"])
+}
+
+func TestIgnoreDifferencesNormalizer(t *testing.T) {
+	normalizer, err := NewNormalizer(nil, nil)
+	assert.Nil(t, err)
+	assert.Nil(t, normalizer)
+
+	config := unmarshalUnstructured(t, webhookConfig)
+	live := unmarshalUnstructured(t, webhookConfig)
+	webhooks, _, _ := unstructured.NestedSlice(live.Object, "webhooks")
+	webhooks[0].(map[string]interface{})["clientConfig"].(map[string]interface{})["caBundle"] = "Y2VydGlmaWNhdGU="
+	err = unstructured.SetNestedSlice(live.Object, webhooks, "webhooks")
+	assert.Nil(t, err)
+
+	normalizer, err = NewNormalizer(nil, []v1alpha1.ResourceIgnoreDifferences{{
+		Group:        "admissionregistration.k8s.io",
+		Kind:         "MutatingWebhookConfiguration",
+		Name:         "istio-*",
+		JSONPointers: []string{"/webhooks/*/clientConfig/caBundle"},
+	}})
+	assert.Nil(t, err)
+	assert.False(t, Diff(config, live, normalizer).Modified)
+
+	// other kinds are not normalized
+	normalizer, err = NewNormalizer(nil, []v1alpha1.ResourceIgnoreDifferences{{
+		Group:        "admissionregistration.k8s.io",
+		Kind:         "ValidatingWebhookConfiguration",
+		JSONPointers: []string{"/webhooks/*/clientConfig/caBundle"},
+	}})
+	assert.Nil(t, err)
+	assert.True(t, Diff(config, live, normalizer).Modified)
+}
+
+func TestValidateIgnoreDifferences(t *testing.T) {
+	assert.Nil(t, ValidateIgnoreDifferences([]v1alpha1.ResourceIgnoreDifferences{{Kind: "Service", JSONPointers: []string{"/spec/clusterIP"}}}))
+	assert.Error(t, ValidateIgnoreDifferences([]v1alpha1.ResourceIgnoreDifferences{{JSONPointers: []string{"/spec/clusterIP"}}}))
+	assert.Error(t, ValidateIgnoreDifferences([]v1alpha1.ResourceIgnoreDifferences{{Kind: "Service"}}))
+	assert.Error(t, ValidateIgnoreDifferences([]v1alpha1.ResourceIgnoreDifferences{{Kind: "Service", JSONPointers: []string{"spec/clusterIP"}}}))
+	assert.Error(t, ValidateIgnoreDifferences([]v1alpha1.ResourceIgnoreDifferences{{Kind: "Service", Name: "[", JSONPointers: []string{"/spec/clusterIP"}}}))
+
+	_, err := NewNormalizer([]string{"istio"}, []v1alpha1.ResourceIgnoreDifferences{{Kind: "Service"}})
+	assert.Error(t, err)
+}
+
+const aggregatedClusterRole = `
+apiVersion: rbac.authorization.k8s.io/v1
+kind: ClusterRole
+metadata:
+  name: monitoring
+aggregationRule:
+  clusterRoleSelectors:
+  - matchLabels:
+      rbac.example.com/aggregate-to-monitoring: "true"
+rules: []
+`
+
+func TestDiffAggregatedClusterRole(t *testing.T) {
+	config := unmarshalUnstructured(t, aggregatedClusterRole)
+	live := unmarshalUnstructured(t, aggregatedClusterRole)
+	err := unstructured.SetNestedSlice(live.Object, []interface{}{map[string]interface{}{
+		"apiGroups": []interface{}{""},
+		"resources": []interface{}{"services", "endpoints", "pods"},
+		"verbs":     []interface{}{"get", "list", "watch"},
+	}}, "rules")
+	assert.Nil(t, err)
+	assert.False(t, Diff(config, live, nil).Modified)
+
+	// the rules of cluster roles which are not aggregated are compared
+	unstructured.RemoveNestedField(config.Object, "aggregationRule")
+	unstructured.RemoveNestedField(live.Object, "aggregationRule")
+	assert.True(t, Diff(config, live, nil).Modified)
+}
+
+func TestIgnoreDifferencesOfAllKinds(t *testing.T) {
+	// manifests generated by kubectl have a null creation timestamp and an empty status
+	config := unmarshalUnstructured(t, `
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: my-config
+  creationTimestamp: null
+status: {}
+`)
+	live := unmarshalUnstructured(t, `
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: my-config
+  creationTimestamp: "2019-06-01T10:00:00Z"
+  resourceVersion: "123"
+  uid: 5b6f8a4e-8456-11e9-a3c7-42010a800002
+`)
+	assert.True(t, Diff(config, live, nil).Modified)
+
+	normalizer, err := NewNormalizer(nil, []v1alpha1.ResourceIgnoreDifferences{{
+		Kind:         "*",
+		JSONPointers: []string{"/metadata/creationTimestamp", "/metadata/resourceVersion", "/metadata/uid", "/status"},
+	}})
+	assert.Nil(t, err)
+	assert.False(t, Diff(config, live, normalizer).Modified)
+	// the other fields are still compared
+	live.SetName("other-config")
+	assert.True(t, Diff(config, live, normalizer).Modified)
+}
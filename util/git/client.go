@@ -5,6 +5,7 @@ import (
 	"os"
 	"os/exec"
 	"strings"
+	"time"
 
 	log "github.com/sirupsen/logrus"
 	"golang.org/x/crypto/ssh"
@@ -24,6 +25,7 @@ type Client interface {
 	Fetch() error
 	Checkout(revision string) error
 	LsRemote(revision string) (string, error)
+	LsRemoteRef(revision string) (string, string, error)
 	LsFiles(path string) ([]string, error)
 	CommitSHA() (string, error)
 }
@@ -39,6 +41,8 @@ type nativeGitClient struct {
 	repoURL string
 	root    string
 	auth    transport.AuthMethod
+	// initialized is set if Init created the local repository, in which case the first fetch clones it
+	initialized bool
 }
 
 type factory struct{}
@@ -97,11 +101,28 @@ func (m *nativeGitClient) Init() error {
 		Name: git.DefaultRemoteName,
 		URLs: []string{m.repoURL},
 	})
-	return err
+	if err != nil {
+		return err
+	}
+	m.initialized = true
+	return nil
 }
 
-// Fetch fetches latest updates from origin
+// Fetch fetches latest updates from origin. The first fetch of a repository initialized by the
+// client is reported as a clone in the metrics.
 func (m *nativeGitClient) Fetch() error {
+	operation := operationFetch
+	if m.initialized {
+		operation = operationClone
+		m.initialized = false
+	}
+	start := time.Now()
+	err := m.fetch()
+	observeOperation(m.repoURL, operation, start, err)
+	return err
+}
+
+func (m *nativeGitClient) fetch() error {
 	log.Debugf("Fetching repo %s at %s", m.repoURL, m.root)
 	repo, err := git.PlainOpen(m.root)
 	if err != nil {
@@ -143,6 +164,13 @@ func (m *nativeGitClient) LsFiles(path string) ([]string, error) {
 
 // Checkout checkout specified git sha
 func (m *nativeGitClient) Checkout(revision string) error {
+	start := time.Now()
+	err := m.checkout(revision)
+	observeOperation(m.repoURL, operationCheckout, start, err)
+	return err
+}
+
+func (m *nativeGitClient) checkout(revision string) error {
 	if revision == "" || revision == "HEAD" {
 		revision = "origin/HEAD"
 	}
@@ -161,23 +189,22 @@ func (m *nativeGitClient) Checkout(revision string) error {
 // runs with in-memory storage and is safe to run concurrently, or to be run without a git
 // repository locally cloned.
 func (m *nativeGitClient) LsRemote(revision string) (string, error) {
+	commitSHA, _, err := m.LsRemoteRef(revision)
+	return commitSHA, err
+}
+
+// LsRemoteRef resolves the commit SHA of a revision like LsRemote, and also returns the name of the
+// ref the revision resolved to (e.g. refs/heads/master, refs/tags/v1.0 or HEAD). The ref is empty
+// if the revision is a commit SHA.
+func (m *nativeGitClient) LsRemoteRef(revision string) (string, string, error) {
 	if IsCommitSHA(revision) {
-		return revision, nil
+		return revision, "", nil
 	}
-	repo, err := git.Init(memory.NewStorage(), nil)
+	start := time.Now()
+	refs, err := m.listRemote()
+	observeOperation(m.repoURL, operationLsRemote, start, err)
 	if err != nil {
-		return "", err
-	}
-	remote, err := repo.CreateRemote(&config.RemoteConfig{
-		Name: git.DefaultRemoteName,
-		URLs: []string{m.repoURL},
-	})
-	if err != nil {
-		return "", err
-	}
-	refs, err := remote.List(&git.ListOptions{Auth: m.auth})
-	if err != nil {
-		return "", err
+		return "", "", err
 	}
 	if revision == "" {
 		revision = "HEAD"
@@ -202,7 +229,7 @@ func (m *nativeGitClient) LsRemote(revision string) (string, error) {
 		if ref.Name().Short() == revision {
 			if ref.Type() == plumbing.HashReference {
 				log.Debugf("revision '%s' resolved to '%s'", revision, hash)
-				return hash, nil
+				return hash, refName, nil
 			}
 			if ref.Type() == plumbing.SymbolicReference {
 				refToResolve = ref.Target().String()
@@ -214,17 +241,33 @@ func (m *nativeGitClient) LsRemote(revision string) (string, error) {
 		// It should exist in our refToHash map
 		if hash, ok := refToHash[refToResolve]; ok {
 			log.Debugf("symbolic reference '%s' (%s) resolved to '%s'", revision, refToResolve, hash)
-			return hash, nil
+			return hash, revision, nil
 		}
 	}
 	// We support the ability to use a truncated commit-SHA (e.g. first 7 characters of a SHA)
 	if IsTruncatedCommitSHA(revision) {
 		log.Debugf("revision '%s' assumed to be commit sha", revision)
-		return revision, nil
+		return revision, "", nil
 	}
 	// If we get here, revision string had non hexadecimal characters (indicating its a branch, tag,
 	// or symbolic ref) and we were unable to resolve it to a commit SHA.
-	return "", fmt.Errorf("Unable to resolve '%s' to a commit SHA", revision)
+	return "", "", fmt.Errorf("Unable to resolve '%s' to a commit SHA", revision)
+}
+
+// listRemote lists the refs of the remote repository
+func (m *nativeGitClient) listRemote() ([]*plumbing.Reference, error) {
+	repo, err := git.Init(memory.NewStorage(), nil)
+	if err != nil {
+		return nil, err
+	}
+	remote, err := repo.CreateRemote(&config.RemoteConfig{
+		Name: git.DefaultRemoteName,
+		URLs: []string{m.repoURL},
+	})
+	if err != nil {
+		return nil, err
+	}
+	return remote.List(&git.ListOptions{Auth: m.auth})
 }
 
 // CommitSHA returns current commit sha from `git rev-parse HEAD`
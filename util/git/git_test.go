@@ -1,8 +1,12 @@
 package git
 
 import (
+	"fmt"
 	"testing"
+	"time"
 
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -126,3 +130,23 @@ func TestLsRemote(t *testing.T) {
 		assert.Error(t, err)
 	}
 }
+
+func TestObserveOperation(t *testing.T) {
+	repoURL := "https://github.com/argoproj/argocd-example-apps-metrics.git"
+	repo := NormalizeGitURL(repoURL)
+	observeOperation(repoURL, operationFetch, time.Now(), nil)
+	observeOperation(repoURL, operationFetch, time.Now(), fmt.Errorf("connection reset"))
+	assert.Equal(t, 1, int(testutil.ToFloat64(gitRequestFailures.WithLabelValues(repo, operationFetch))))
+	assert.Equal(t, 0, int(testutil.ToFloat64(gitRequestFailures.WithLabelValues(repo, operationLsRemote))))
+
+	registry := prometheus.NewRegistry()
+	assert.NoError(t, RegisterMetrics(registry))
+	families, err := registry.Gather()
+	assert.NoError(t, err)
+	var names []string
+	for _, family := range families {
+		names = append(names, family.GetName())
+	}
+	assert.Contains(t, names, "argocd_git_request_duration_seconds")
+	assert.Contains(t, names, "argocd_git_request_failures_total")
+}
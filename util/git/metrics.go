@@ -0,0 +1,50 @@
+package git
+
+import (
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// Operations of git clients, as reported in the operation label of the metrics
+const (
+	operationClone    = "clone"
+	operationFetch    = "fetch"
+	operationCheckout = "checkout"
+	operationLsRemote = "ls-remote"
+)
+
+var (
+	gitRequestLabels = []string{"repo", "operation"}
+
+	gitRequestDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "argocd_git_request_duration_seconds",
+		Help:    "Duration of git operations against a repository.",
+		Buckets: []float64{0.1, 0.25, 0.5, 1, 2.5, 5, 10, 30, 60, 120},
+	}, gitRequestLabels)
+	gitRequestFailures = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "argocd_git_request_failures_total",
+		Help: "Number of failed git operations against a repository.",
+	}, gitRequestLabels)
+)
+
+// RegisterMetrics registers the metrics of the git operations performed by the clients of the
+// package in the registry
+func RegisterMetrics(registry prometheus.Registerer) error {
+	for _, collector := range []prometheus.Collector{gitRequestDuration, gitRequestFailures} {
+		if err := registry.Register(collector); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// observeOperation records the duration of a git operation which started at the given time, and
+// whether it failed
+func observeOperation(repoURL, operation string, start time.Time, err error) {
+	repo := NormalizeGitURL(repoURL)
+	gitRequestDuration.WithLabelValues(repo, operation).Observe(time.Since(start).Seconds())
+	if err != nil {
+		gitRequestFailures.WithLabelValues(repo, operation).Inc()
+	}
+}
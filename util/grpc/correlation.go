@@ -0,0 +1,104 @@
+package grpc
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+
+	"github.com/grpc-ecosystem/go-grpc-middleware"
+	"github.com/grpc-ecosystem/go-grpc-middleware/tags/logrus"
+	"github.com/sirupsen/logrus"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+const (
+	// CorrelationIDKey is the gRPC metadata key, and HTTP header, which carries the correlation ID of
+	// a request between Argo CD components
+	CorrelationIDKey = "x-correlation-id"
+	// CorrelationIDField is the log field which holds the correlation ID
+	CorrelationIDField = "correlation_id"
+)
+
+type correlationIDContextKey struct{}
+
+// NewCorrelationID returns a new random correlation ID
+func NewCorrelationID() string {
+	b := make([]byte, 8)
+	_, _ = rand.Read(b)
+	return hex.EncodeToString(b)
+}
+
+// ContextWithCorrelationID returns a context which carries the correlation ID. The ID is propagated
+// to the gRPC calls made with the context.
+func ContextWithCorrelationID(ctx context.Context, id string) context.Context {
+	if id == "" {
+		return ctx
+	}
+	ctx = context.WithValue(ctx, correlationIDContextKey{}, id)
+	return metadata.AppendToOutgoingContext(ctx, CorrelationIDKey, id)
+}
+
+// CorrelationID returns the correlation ID carried by the context, or an empty string if there is none
+func CorrelationID(ctx context.Context) string {
+	if id, ok := ctx.Value(correlationIDContextKey{}).(string); ok {
+		return id
+	}
+	return ""
+}
+
+// LogEntry returns a log entry annotated with the correlation ID of the context, if any
+func LogEntry(ctx context.Context) *logrus.Entry {
+	entry := logrus.NewEntry(logrus.StandardLogger())
+	if id := CorrelationID(ctx); id != "" {
+		entry = entry.WithField(CorrelationIDField, id)
+	}
+	return entry
+}
+
+// incomingCorrelationID returns the correlation ID of an incoming call. If the caller did not supply
+// an ID and generate is true, a new ID is returned.
+func incomingCorrelationID(ctx context.Context, generate bool) string {
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if ids := md.Get(CorrelationIDKey); len(ids) > 0 && ids[0] != "" {
+			return ids[0]
+		}
+	}
+	if generate {
+		return NewCorrelationID()
+	}
+	return ""
+}
+
+// withCorrelationID stores the correlation ID of an incoming call in the context, and adds it to the
+// fields of the request logger
+func withCorrelationID(ctx context.Context, generate bool) context.Context {
+	id := incomingCorrelationID(ctx, generate)
+	if id == "" {
+		return ctx
+	}
+	ctx_logrus.AddFields(ctx, logrus.Fields{CorrelationIDField: id})
+	// return the ID to the caller, so that it can be used to find the logs of the request
+	_ = grpc.SetHeader(ctx, metadata.Pairs(CorrelationIDKey, id))
+	return ContextWithCorrelationID(ctx, id)
+}
+
+// CorrelationIDUnaryServerInterceptor returns a unary server interceptor which propagates the
+// correlation ID of incoming calls. Calls without a correlation ID are assigned a new one if
+// generate is true. Must be chained after the logrus interceptor.
+func CorrelationIDUnaryServerInterceptor(generate bool) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		return handler(withCorrelationID(ctx, generate), req)
+	}
+}
+
+// CorrelationIDStreamServerInterceptor returns a streaming server interceptor which propagates the
+// correlation ID of incoming calls. Calls without a correlation ID are assigned a new one if
+// generate is true. Must be chained after the logrus interceptor.
+func CorrelationIDStreamServerInterceptor(generate bool) grpc.StreamServerInterceptor {
+	return func(srv interface{}, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		wrapped := grpc_middleware.WrapServerStream(stream)
+		wrapped.WrappedContext = withCorrelationID(stream.Context(), generate)
+		return handler(srv, wrapped)
+	}
+}
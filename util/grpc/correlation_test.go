@@ -0,0 +1,42 @@
+package grpc
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+func handleWithCorrelationID(ctx context.Context, generate bool) context.Context {
+	var handledCtx context.Context
+	interceptor := CorrelationIDUnaryServerInterceptor(generate)
+	_, _ = interceptor(ctx, nil, &grpc.UnaryServerInfo{}, func(ctx context.Context, req interface{}) (interface{}, error) {
+		handledCtx = ctx
+		return nil, nil
+	})
+	return handledCtx
+}
+
+func TestCorrelationIDFromIncomingCall(t *testing.T) {
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(CorrelationIDKey, "abc123"))
+	ctx = handleWithCorrelationID(ctx, true)
+	assert.Equal(t, "abc123", CorrelationID(ctx))
+	assert.Equal(t, "abc123", LogEntry(ctx).Data[CorrelationIDField])
+
+	// the ID is propagated to outgoing calls
+	md, ok := metadata.FromOutgoingContext(ctx)
+	assert.True(t, ok)
+	assert.Equal(t, []string{"abc123"}, md.Get(CorrelationIDKey))
+}
+
+func TestCorrelationIDGenerated(t *testing.T) {
+	ctx := handleWithCorrelationID(context.Background(), true)
+	assert.NotEmpty(t, CorrelationID(ctx))
+	assert.NotEqual(t, CorrelationID(ctx), CorrelationID(handleWithCorrelationID(context.Background(), true)))
+
+	ctx = handleWithCorrelationID(context.Background(), false)
+	assert.Empty(t, CorrelationID(ctx))
+	assert.NotContains(t, LogEntry(ctx).Data, CorrelationIDField)
+}
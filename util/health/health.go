@@ -2,15 +2,18 @@ package health
 
 import (
 	"fmt"
+	"time"
 
 	"k8s.io/api/apps/v1"
 	appsv1 "k8s.io/api/apps/v1"
 	coreV1 "k8s.io/api/core/v1"
 	extv1beta1 "k8s.io/api/extensions/v1beta1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/kubernetes/pkg/apis/apps"
 
+	"github.com/argoproj/argo-cd/pkg/apis/application"
 	appv1 "github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1"
 	"github.com/argoproj/argo-cd/util/kube"
 )
@@ -35,6 +38,11 @@ func GetAppHealth(kubectl kube.Kubectl, obj *unstructured.Unstructured) (*appv1.
 		case kube.DaemonSetKind:
 			health, err = getDaemonSetHealth(kubectl, obj)
 		}
+	case application.Group:
+		switch gvk.Kind {
+		case application.ApplicationKind:
+			health, err = getApplicationHealth(obj)
+		}
 	case "":
 		switch gvk.Kind {
 		case kube.ServiceKind:
@@ -54,6 +62,30 @@ func GetAppHealth(kubectl kube.Kubectl, obj *unstructured.Unstructured) (*appv1.
 	return health, err
 }
 
+// SetProgressingDeadline records the time a Progressing resource became Progressing, which is carried
+// over from its previous health, and marks the resource Degraded once it has been Progressing for
+// longer than the deadline. A deadline of 0 never expires. Returns whether the deadline expired.
+func SetProgressingDeadline(health *appv1.HealthStatus, previous *appv1.HealthStatus, deadline time.Duration, now time.Time) bool {
+	if health.Status != appv1.HealthStatusProgressing {
+		return false
+	}
+	since := metav1.NewTime(now)
+	if previous != nil && previous.ProgressingSince != nil {
+		since = *previous.ProgressingSince
+	}
+	health.ProgressingSince = &since
+	if deadline <= 0 || now.Sub(since.Time) <= deadline {
+		return false
+	}
+	health.Status = appv1.HealthStatusDegraded
+	message := fmt.Sprintf("Progressing for longer than the progressing deadline of %s", deadline)
+	if health.StatusDetails != "" {
+		message = fmt.Sprintf("%s: %s", message, health.StatusDetails)
+	}
+	health.StatusDetails = message
+	return true
+}
+
 // healthOrder is a list of health codes in order of most healthy to least healthy
 var healthOrder = []appv1.HealthStatusCode{
 	appv1.HealthStatusHealthy,
@@ -78,6 +110,51 @@ func IsWorse(current, new appv1.HealthStatusCode) bool {
 	return newIndex > currentIndex
 }
 
+// getApplicationHealth returns the health of a child application (i.e. of an app of apps), which
+// aggregates the health and sync status of the application: it is Progressing while it syncs or is
+// out of sync, and has the health of its own resources otherwise.
+func getApplicationHealth(obj *unstructured.Unstructured) (*appv1.HealthStatus, error) {
+	var app appv1.Application
+	err := runtime.DefaultUnstructuredConverter.FromUnstructured(obj.Object, &app)
+	if err != nil {
+		return nil, err
+	}
+	if app.Operation != nil || (app.Status.OperationState != nil && !app.Status.OperationState.Phase.Completed()) {
+		return &appv1.HealthStatus{
+			Status:        appv1.HealthStatusProgressing,
+			StatusDetails: fmt.Sprintf("Application %s is syncing", app.Name),
+		}, nil
+	}
+	// the status of an application whose spec was just updated is still the one of its previous spec
+	if !app.Spec.Source.Equals(app.Status.ComparisonResult.ComparedTo) || app.Spec.Destination != app.Status.ComparisonResult.ComparedToDestination {
+		return &appv1.HealthStatus{
+			Status:        appv1.HealthStatusProgressing,
+			StatusDetails: fmt.Sprintf("Application %s was not compared to its spec yet", app.Name),
+		}, nil
+	}
+	switch app.Status.Health.Status {
+	case "":
+		return &appv1.HealthStatus{
+			Status:        appv1.HealthStatusProgressing,
+			StatusDetails: fmt.Sprintf("Application %s was not assessed yet", app.Name),
+		}, nil
+	case appv1.HealthStatusHealthy:
+	default:
+		details := fmt.Sprintf("Application %s is %s", app.Name, app.Status.Health.Status)
+		if app.Status.Health.StatusDetails != "" {
+			details = fmt.Sprintf("%s: %s", details, app.Status.Health.StatusDetails)
+		}
+		return &appv1.HealthStatus{Status: app.Status.Health.Status, StatusDetails: details}, nil
+	}
+	if app.Status.ComparisonResult.Status == appv1.ComparisonStatusOutOfSync {
+		return &appv1.HealthStatus{
+			Status:        appv1.HealthStatusProgressing,
+			StatusDetails: fmt.Sprintf("Application %s is OutOfSync", app.Name),
+		}, nil
+	}
+	return &appv1.HealthStatus{Status: appv1.HealthStatusHealthy}, nil
+}
+
 func getPVCHealth(kubectl kube.Kubectl, obj *unstructured.Unstructured) (*appv1.HealthStatus, error) {
 	obj, err := kubectl.ConvertToVersion(obj, "", "v1")
 	if err != nil {
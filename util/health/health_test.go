@@ -3,9 +3,11 @@ package health
 import (
 	"io/ioutil"
 	"testing"
+	"time"
 
 	"github.com/ghodss/yaml"
 	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 
 	appv1 "github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1"
@@ -50,7 +52,43 @@ func TestIngressHealth(t *testing.T) {
 	assertAppHealth(t, "./testdata/ingress-unassigned.yaml", appv1.HealthStatusProgressing)
 }
 
+func TestApplicationHealth(t *testing.T) {
+	assertAppHealth(t, "./testdata/application-healthy.yaml", appv1.HealthStatusHealthy)
+	assertAppHealth(t, "./testdata/application-degraded.yaml", appv1.HealthStatusDegraded)
+	assertAppHealth(t, "./testdata/application-outofsync.yaml", appv1.HealthStatusProgressing)
+	assertAppHealth(t, "./testdata/application-not-compared.yaml", appv1.HealthStatusProgressing)
+}
+
 func TestCRD(t *testing.T) {
 	// This ensures we do not try to compare only based on "Kind"
 	assertAppHealth(t, "./testdata/knative-service.yaml", appv1.HealthStatusHealthy)
 }
+
+func TestSetProgressingDeadline(t *testing.T) {
+	now := time.Now()
+	health := &appv1.HealthStatus{Status: appv1.HealthStatusProgressing}
+	assert.False(t, SetProgressingDeadline(health, nil, 10*time.Minute, now))
+	assert.Equal(t, appv1.HealthStatusProgressing, health.Status)
+	assert.Equal(t, now.Unix(), health.ProgressingSince.Unix())
+
+	// the time the resource became Progressing is carried over
+	since := metav1.NewTime(now.Add(-5 * time.Minute))
+	health = &appv1.HealthStatus{Status: appv1.HealthStatusProgressing}
+	assert.False(t, SetProgressingDeadline(health, &appv1.HealthStatus{Status: appv1.HealthStatusProgressing, ProgressingSince: &since}, 10*time.Minute, now))
+	assert.Equal(t, since, *health.ProgressingSince)
+
+	since = metav1.NewTime(now.Add(-15 * time.Minute))
+	health = &appv1.HealthStatus{Status: appv1.HealthStatusProgressing, StatusDetails: "Waiting for rollout to finish"}
+	assert.True(t, SetProgressingDeadline(health, &appv1.HealthStatus{Status: appv1.HealthStatusDegraded, ProgressingSince: &since}, 10*time.Minute, now))
+	assert.Equal(t, appv1.HealthStatusDegraded, health.Status)
+	assert.Equal(t, "Progressing for longer than the progressing deadline of 10m0s: Waiting for rollout to finish", health.StatusDetails)
+
+	// resources may be Progressing indefinitely without a deadline
+	health = &appv1.HealthStatus{Status: appv1.HealthStatusProgressing}
+	assert.False(t, SetProgressingDeadline(health, &appv1.HealthStatus{Status: appv1.HealthStatusProgressing, ProgressingSince: &since}, 0, now))
+	assert.Equal(t, appv1.HealthStatusProgressing, health.Status)
+
+	health = &appv1.HealthStatus{Status: appv1.HealthStatusHealthy}
+	assert.False(t, SetProgressingDeadline(health, &appv1.HealthStatus{Status: appv1.HealthStatusProgressing, ProgressingSince: &since}, 10*time.Minute, now))
+	assert.Nil(t, health.ProgressingSince)
+}
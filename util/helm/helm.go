@@ -55,8 +55,9 @@ func (h *helm) Template(appName string, opts HelmTemplateOpts, overrides []*argo
 	args := []string{
 		"template", ".",
 	}
+	// the release is named after the application, unless a release name is set
 	if opts.ReleaseName != "" {
-		args = append(args, "--name", appName)
+		args = append(args, "--name", opts.ReleaseName)
 	} else {
 		args = append(args, "--name", appName)
 	}
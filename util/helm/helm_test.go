@@ -66,6 +66,19 @@ func TestHelmTemplateValues(t *testing.T) {
 	}
 }
 
+func TestHelmTemplateReleaseName(t *testing.T) {
+	h := NewHelmApp("./testdata/minio")
+	objs, err := h.Template("test", HelmTemplateOpts{ReleaseName: "my-release"}, nil)
+	assert.Nil(t, err)
+	names := make([]string, 0)
+	for _, obj := range objs {
+		if obj.GetKind() == "Service" {
+			names = append(names, obj.GetName())
+		}
+	}
+	assert.Equal(t, []string{"my-release-minio"}, names)
+}
+
 func TestHelmTemplateValuesURL(t *testing.T) {
 	h := NewHelmApp("./testdata/redis")
 	valuesFiles := []string{"https://raw.githubusercontent.com/argoproj/argo-cd/master/util/helm/testdata/redis/values-production.yaml"}
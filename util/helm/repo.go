@@ -0,0 +1,148 @@
+package helm
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/ghodss/yaml"
+)
+
+const (
+	// repoTimeout is the timeout of the requests to helm repositories
+	repoTimeout = 30 * time.Second
+	// fetchRepoName is the name of the repository in the helm home of the charts which are fetched
+	fetchRepoName = "argocd"
+)
+
+// repoFile is the repository config of a helm home (repository/repositories.yaml)
+type repoFile struct {
+	APIVersion   string      `json:"apiVersion"`
+	Repositories []repoEntry `json:"repositories"`
+}
+
+type repoEntry struct {
+	Name     string `json:"name"`
+	URL      string `json:"url"`
+	Cache    string `json:"cache"`
+	Username string `json:"username,omitempty"`
+	Password string `json:"password,omitempty"`
+}
+
+// FetchChart downloads the version of the chart of the helm repository, and unpacks it into the
+// directory. The latest version is fetched if the version is empty. It returns the path of the chart.
+// The credentials are passed to helm in the repository config of a helm home in the directory, since
+// command line arguments are visible to the other processes.
+func FetchChart(repoURL, chart, version, username, password, dir string) (string, error) {
+	if err := validateChartName(chart); err != nil {
+		return "", err
+	}
+	home := filepath.Join(dir, "home")
+	if err := writeRepoHome(home, repoURL, username, password); err != nil {
+		return "", err
+	}
+	untarDir := filepath.Join(dir, "charts")
+	if err := os.MkdirAll(untarDir, 0700); err != nil {
+		return "", err
+	}
+	args := []string{"fetch", fmt.Sprintf("%s/%s", fetchRepoName, chart), "--untar", "--untardir", untarDir}
+	if version != "" {
+		args = append(args, "--version", version)
+	}
+	h := &helm{path: dir, home: home}
+	if _, err := h.helmCmd(args...); err != nil {
+		return "", err
+	}
+	return untarredChartPath(untarDir)
+}
+
+// validateChartName returns an error if the chart name is not a plain name, which could be used to
+// reach a path outside of the directory of the chart
+func validateChartName(chart string) error {
+	if chart == "" || strings.ContainsAny(chart, `/\`) || strings.Contains(chart, "..") {
+		return fmt.Errorf("invalid chart name '%s'", chart)
+	}
+	return nil
+}
+
+// untarredChartPath returns the path of the chart unpacked into the directory, which is named after
+// the chart in its archive
+func untarredChartPath(untarDir string) (string, error) {
+	files, err := ioutil.ReadDir(untarDir)
+	if err != nil {
+		return "", err
+	}
+	if len(files) != 1 || !files[0].IsDir() {
+		return "", fmt.Errorf("chart archive does not contain a single chart directory")
+	}
+	return filepath.Join(untarDir, files[0].Name()), nil
+}
+
+// writeRepoHome creates a helm home which holds the repository, along with its credentials and its
+// index, so that its charts are fetched without passing the credentials on the command line
+func writeRepoHome(home, repoURL, username, password string) error {
+	cacheDir := filepath.Join(home, "repository", "cache")
+	if err := os.MkdirAll(cacheDir, 0700); err != nil {
+		return err
+	}
+	index, err := getIndex(repoURL, username, password)
+	if err != nil {
+		return err
+	}
+	indexPath := filepath.Join(cacheDir, fmt.Sprintf("%s-index.yaml", fetchRepoName))
+	if err := ioutil.WriteFile(indexPath, index, 0600); err != nil {
+		return err
+	}
+	repos, err := yaml.Marshal(repoFile{
+		APIVersion: "v1",
+		Repositories: []repoEntry{{
+			Name:     fetchRepoName,
+			URL:      strings.TrimSuffix(repoURL, "/"),
+			Cache:    indexPath,
+			Username: username,
+			Password: password,
+		}},
+	})
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(filepath.Join(home, "repository", "repositories.yaml"), repos, 0600)
+}
+
+// TestRepo tests that the index of the helm repository can be downloaded with the credentials
+func TestRepo(repoURL, username, password string) error {
+	_, err := getIndex(repoURL, username, password)
+	return err
+}
+
+// getIndex downloads the index of the helm repository with the credentials
+func getIndex(repoURL, username, password string) ([]byte, error) {
+	req, err := http.NewRequest("GET", indexURL(repoURL), nil)
+	if err != nil {
+		return nil, err
+	}
+	if username != "" || password != "" {
+		req.SetBasicAuth(username, password)
+	}
+	client := &http.Client{Timeout: repoTimeout}
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = resp.Body.Close()
+	}()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("failed to get the index of helm repository %s: %s", repoURL, resp.Status)
+	}
+	return ioutil.ReadAll(resp.Body)
+}
+
+// indexURL returns the URL of the index of the helm repository
+func indexURL(repoURL string) string {
+	return strings.TrimSuffix(repoURL, "/") + "/index.yaml"
+}
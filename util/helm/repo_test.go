@@ -0,0 +1,94 @@
+package helm
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ghodss/yaml"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTestRepo(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		username, password, ok := r.BasicAuth()
+		if !ok || username != "admin" || password != "secret" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		if r.URL.Path != "/charts/index.yaml" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		_, _ = w.Write([]byte("apiVersion: v1\nentries: {}\n"))
+	}))
+	defer server.Close()
+
+	assert.NoError(t, TestRepo(server.URL+"/charts", "admin", "secret"))
+	assert.NoError(t, TestRepo(server.URL+"/charts/", "admin", "secret"))
+	assert.Error(t, TestRepo(server.URL+"/charts", "admin", "wrong"))
+	assert.Error(t, TestRepo(server.URL+"/other", "admin", "secret"))
+}
+
+func TestWriteRepoHome(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		username, password, ok := r.BasicAuth()
+		if !ok || username != "admin" || password != "secret" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		_, _ = w.Write([]byte("apiVersion: v1\nentries: {}\n"))
+	}))
+	defer server.Close()
+	dir, err := ioutil.TempDir("", "helm-home")
+	assert.NoError(t, err)
+	defer func() {
+		_ = os.RemoveAll(dir)
+	}()
+
+	assert.Error(t, writeRepoHome(dir, server.URL, "admin", "wrong"))
+
+	err = writeRepoHome(dir, server.URL+"/", "admin", "secret")
+	assert.NoError(t, err)
+	data, err := ioutil.ReadFile(filepath.Join(dir, "repository", "repositories.yaml"))
+	assert.NoError(t, err)
+	var repos repoFile
+	assert.NoError(t, yaml.Unmarshal(data, &repos))
+	assert.Equal(t, []repoEntry{{
+		Name:     fetchRepoName,
+		URL:      server.URL,
+		Cache:    filepath.Join(dir, "repository", "cache", "argocd-index.yaml"),
+		Username: "admin",
+		Password: "secret",
+	}}, repos.Repositories)
+	index, err := ioutil.ReadFile(repos.Repositories[0].Cache)
+	assert.NoError(t, err)
+	assert.Equal(t, "apiVersion: v1\nentries: {}\n", string(index))
+}
+
+func TestFetchChartInvalidName(t *testing.T) {
+	for _, chart := range []string{"", "../guestbook", "charts/guestbook", `charts\guestbook`, ".."} {
+		_, err := FetchChart("https://charts.example.com", chart, "1.0.0", "", "", "/tmp")
+		assert.Error(t, err, chart)
+	}
+	assert.NoError(t, validateChartName("guestbook"))
+}
+
+func TestUntarredChartPath(t *testing.T) {
+	dir, err := ioutil.TempDir("", "helm-charts")
+	assert.NoError(t, err)
+	defer func() {
+		_ = os.RemoveAll(dir)
+	}()
+
+	_, err = untarredChartPath(dir)
+	assert.Error(t, err)
+
+	assert.NoError(t, os.Mkdir(filepath.Join(dir, "guestbook"), 0700))
+	path, err := untarredChartPath(dir)
+	assert.NoError(t, err)
+	assert.Equal(t, filepath.Join(dir, "guestbook"), path)
+}
@@ -0,0 +1,230 @@
+package helm
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/url"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/ghodss/yaml"
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/spec"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/validate"
+
+	argoappv1 "github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/util/config"
+)
+
+const (
+	// DefaultValuesSchemaFile is the name of the values schema file bundled with a chart
+	DefaultValuesSchemaFile = "values.schema.json"
+	// valuesFile is the name of the default values file of a chart
+	valuesFile = "values.yaml"
+)
+
+// ValidateValues validates the chart values, merged with the supplied value files and parameter
+// overrides, against the values schema. If schemaFile is empty, the schema bundled with the chart
+// is used. Charts without a schema are not validated.
+func ValidateValues(chartPath string, schemaFile string, valueFiles []string, overrides []*argoappv1.ComponentParameter) error {
+	explicitSchema := schemaFile != ""
+	if !explicitSchema {
+		schemaFile = DefaultValuesSchemaFile
+	}
+	schemaBytes, err := readValuesFile(chartPath, schemaFile)
+	if err != nil {
+		if !explicitSchema && os.IsNotExist(err) {
+			return nil
+		}
+		return fmt.Errorf("failed to read values schema %s: %v", schemaFile, err)
+	}
+	var schema spec.Schema
+	if err = yaml.Unmarshal(schemaBytes, &schema); err != nil {
+		return fmt.Errorf("failed to parse values schema %s: %v", schemaFile, err)
+	}
+
+	values := map[string]interface{}{}
+	for _, file := range append([]string{valuesFile}, valueFiles...) {
+		fileValues, err := readValuesFile(chartPath, file)
+		if err != nil {
+			if file == valuesFile && os.IsNotExist(err) {
+				continue
+			}
+			return fmt.Errorf("failed to read value file %s: %v", file, err)
+		}
+		var parsed map[string]interface{}
+		if err = yaml.Unmarshal(fileValues, &parsed); err != nil {
+			return fmt.Errorf("failed to parse value file %s: %v", file, err)
+		}
+		mergeValues(values, parsed)
+	}
+	for _, p := range overrides {
+		setValue(values, strings.Split(p.Name, "."), parseValue(p.Value))
+	}
+
+	// remarshal the values so that numbers are represented the same way as in the schema validator
+	valuesBytes, err := json.Marshal(values)
+	if err != nil {
+		return err
+	}
+	var data interface{}
+	if err = json.Unmarshal(valuesBytes, &data); err != nil {
+		return err
+	}
+	err = validate.AgainstSchema(&schema, data, strfmt.Default)
+	if err != nil {
+		return fmt.Errorf("values don't meet the specifications of the schema: %s", formatSchemaErrors(err))
+	}
+	return nil
+}
+
+// readValuesFile reads a file relative to the chart path, or from a remote http(s) URL
+func readValuesFile(chartPath string, file string) ([]byte, error) {
+	parsedURL, err := url.ParseRequestURI(file)
+	if err == nil && (parsedURL.Scheme == "http" || parsedURL.Scheme == "https") {
+		return config.ReadRemoteFile(file)
+	}
+	filePath, err := valuesFilePath(chartPath, file)
+	if err != nil {
+		return nil, err
+	}
+	return ioutil.ReadFile(filePath)
+}
+
+// valuesFilePath returns the path of a file relative to the chart path. Files which resolve outside of
+// the chart path, e.g. using ../ or symlinks, are rejected.
+func valuesFilePath(chartPath string, file string) (string, error) {
+	root, err := filepath.EvalSymlinks(chartPath)
+	if err != nil {
+		return "", err
+	}
+	filePath := filepath.Join(root, filepath.FromSlash(file))
+	resolved, err := filepath.EvalSymlinks(filePath)
+	if err != nil {
+		if !os.IsNotExist(err) {
+			return "", err
+		}
+		resolved = filePath
+	}
+	if !strings.HasPrefix(resolved, filepath.Clean(root)+string(filepath.Separator)) {
+		return "", fmt.Errorf("file %s resolves outside of the application path", file)
+	}
+	return resolved, nil
+}
+
+// formatSchemaErrors returns a sorted, field level list of schema validation errors
+func formatSchemaErrors(err error) string {
+	var messages []string
+	if compositeErr, ok := err.(*errors.CompositeError); ok {
+		for _, e := range flattenErrors(compositeErr) {
+			messages = append(messages, e.Error())
+		}
+	} else {
+		messages = append(messages, err.Error())
+	}
+	sort.Strings(messages)
+	return strings.Join(messages, "; ")
+}
+
+func flattenErrors(compositeErr *errors.CompositeError) []error {
+	var res []error
+	for _, e := range compositeErr.Errors {
+		if nested, ok := e.(*errors.CompositeError); ok {
+			res = append(res, flattenErrors(nested)...)
+		} else {
+			res = append(res, e)
+		}
+	}
+	return res
+}
+
+// mergeValues merges the src values into dst, overriding any values which already exist in dst
+func mergeValues(dst, src map[string]interface{}) {
+	for key, val := range src {
+		srcMap, srcIsMap := val.(map[string]interface{})
+		dstMap, dstIsMap := dst[key].(map[string]interface{})
+		if srcIsMap && dstIsMap {
+			mergeValues(dstMap, srcMap)
+		} else {
+			dst[key] = val
+		}
+	}
+}
+
+// setValue sets a value at the given path, creating intermediate maps as necessary
+func setValue(values map[string]interface{}, keys []string, val interface{}) {
+	for _, key := range keys[:len(keys)-1] {
+		next, ok := values[key].(map[string]interface{})
+		if !ok {
+			next = map[string]interface{}{}
+			values[key] = next
+		}
+		values = next
+	}
+	values[keys[len(keys)-1]] = val
+}
+
+// parseValue converts a parameter override into a typed value, the way `helm --set` types values:
+// true, false and null are converted to booleans and nil, integers without leading zeros to integers,
+// and {a,b} to a list of typed values. Like in helm, floats are kept as strings. Backslashes escape
+// the next character, e.g. the commas of list items.
+func parseValue(val string) interface{} {
+	if len(val) >= 2 && strings.HasPrefix(val, "{") && strings.HasSuffix(val, "}") {
+		list := make([]interface{}, 0)
+		if inner := val[1 : len(val)-1]; inner != "" {
+			for _, item := range splitEscaped(inner, ',') {
+				list = append(list, typedValue(item))
+			}
+		}
+		return list
+	}
+	return typedValue(splitEscaped(val, -1)[0])
+}
+
+// typedValue converts an unescaped value like helm's strvals package does
+func typedValue(val string) interface{} {
+	switch strings.ToLower(val) {
+	case "true":
+		return true
+	case "false":
+		return false
+	case "null":
+		return nil
+	case "0":
+		return int64(0)
+	}
+	// values with leading zeros are kept as strings, e.g. 0755
+	if val != "" && val[0] != '0' {
+		if i, err := strconv.ParseInt(val, 10, 64); err == nil {
+			return i
+		}
+	}
+	return val
+}
+
+// splitEscaped splits the value at the unescaped separators, and removes the escaping backslashes
+func splitEscaped(val string, sep rune) []string {
+	var parts []string
+	var current []rune
+	escaped := false
+	for _, r := range val {
+		switch {
+		case escaped:
+			current = append(current, r)
+			escaped = false
+		case r == '\\':
+			escaped = true
+		case r == sep:
+			parts = append(parts, string(current))
+			current = nil
+		default:
+			current = append(current, r)
+		}
+	}
+	return append(parts, string(current))
+}
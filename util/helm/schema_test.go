@@ -0,0 +1,76 @@
+package helm
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	argoappv1 "github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1"
+)
+
+func TestValidateValues(t *testing.T) {
+	err := ValidateValues("./testdata/schema", "", nil, nil)
+	assert.NoError(t, err)
+
+	// charts without a schema are not validated
+	err = ValidateValues("./testdata/redis", "", nil, nil)
+	assert.NoError(t, err)
+}
+
+func TestValidateValuesInvalidValueFile(t *testing.T) {
+	err := ValidateValues("./testdata/schema", "", []string{"values-invalid.yaml"}, nil)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "replicaCount")
+}
+
+func TestValidateValuesOverrides(t *testing.T) {
+	overrides := []*argoappv1.ComponentParameter{
+		{Name: "replicaCount", Value: "3"},
+		{Name: "service.port", Value: "8080"},
+	}
+	err := ValidateValues("./testdata/schema", "", nil, overrides)
+	assert.NoError(t, err)
+
+	overrides = []*argoappv1.ComponentParameter{
+		{Name: "service.port", Value: "100000"},
+	}
+	err = ValidateValues("./testdata/schema", "", nil, overrides)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "service.port")
+}
+
+func TestValidateValuesSchemaFile(t *testing.T) {
+	overrides := []*argoappv1.ComponentParameter{
+		{Name: "replicaCount", Value: "2"},
+	}
+	err := ValidateValues("./testdata/schema", "strict.schema.json", nil, overrides)
+	assert.Error(t, err)
+
+	err = ValidateValues("./testdata/schema", "missing.schema.json", nil, nil)
+	assert.Error(t, err)
+}
+
+func TestValidateValuesOutsideAppPath(t *testing.T) {
+	err := ValidateValues("./testdata/schema", "", []string{"../redis/values.yaml"}, nil)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "outside of the application path")
+
+	err = ValidateValues("./testdata/schema", "../redis/values.yaml", nil, nil)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "outside of the application path")
+}
+
+func TestParseValue(t *testing.T) {
+	assert.Equal(t, true, parseValue("true"))
+	assert.Equal(t, false, parseValue("FALSE"))
+	assert.Nil(t, parseValue("null"))
+	assert.Equal(t, int64(0), parseValue("0"))
+	assert.Equal(t, int64(42), parseValue("42"))
+	// like helm, floats and numbers with leading zeros are kept as strings
+	assert.Equal(t, "1.5", parseValue("1.5"))
+	assert.Equal(t, "0755", parseValue("0755"))
+	assert.Equal(t, []interface{}{"a", int64(1), true}, parseValue("{a,1,true}"))
+	assert.Equal(t, []interface{}{"a,b", "c"}, parseValue(`{a\,b,c}`))
+	assert.Equal(t, []interface{}{}, parseValue("{}"))
+	assert.Equal(t, "a,b", parseValue(`a\,b`))
+}
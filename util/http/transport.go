@@ -0,0 +1,107 @@
+package http
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"time"
+)
+
+// TransportOpts are options of a transport created by NewTransport
+type TransportOpts struct {
+	// RequestTimeout is the maximum duration of a single request, excluding watch requests
+	RequestTimeout time.Duration
+	// RetryLimit is the number of times failed idempotent requests are retried
+	RetryLimit int
+	// RetryBackoff is the duration to wait between retries. Doubles after every retry
+	RetryBackoff time.Duration
+}
+
+type transport struct {
+	delegate http.RoundTripper
+	opts     TransportOpts
+}
+
+// NewTransport returns a transport which enforces a request timeout, and retries idempotent
+// requests which failed due to network errors or unavailability of the server
+func NewTransport(delegate http.RoundTripper, opts TransportOpts) http.RoundTripper {
+	return &transport{delegate: delegate, opts: opts}
+}
+
+func (t *transport) RoundTrip(req *http.Request) (*http.Response, error) {
+	retries := 0
+	if isIdempotent(req) && (req.Body == nil || req.GetBody != nil) {
+		retries = t.opts.RetryLimit
+	}
+	backoff := t.opts.RetryBackoff
+	for attempt := 0; ; attempt++ {
+		if attempt > 0 && req.GetBody != nil {
+			body, err := req.GetBody()
+			if err != nil {
+				return nil, err
+			}
+			req.Body = body
+		}
+		resp, err := t.roundTrip(req)
+		if attempt >= retries || !isRetryable(resp, err) {
+			return resp, err
+		}
+		if resp != nil {
+			_ = resp.Body.Close()
+		}
+		select {
+		case <-req.Context().Done():
+			return nil, req.Context().Err()
+		case <-time.After(backoff):
+		}
+		backoff *= 2
+	}
+}
+
+func (t *transport) roundTrip(req *http.Request) (*http.Response, error) {
+	if t.opts.RequestTimeout <= 0 || isWatch(req) {
+		return t.delegate.RoundTrip(req)
+	}
+	ctx, cancel := context.WithTimeout(req.Context(), t.opts.RequestTimeout)
+	resp, err := t.delegate.RoundTrip(req.WithContext(ctx))
+	if err != nil {
+		cancel()
+		return nil, err
+	}
+	// the timeout context must stay alive until the response body is consumed
+	resp.Body = &cancelOnCloseBody{ReadCloser: resp.Body, cancel: cancel}
+	return resp, nil
+}
+
+type cancelOnCloseBody struct {
+	io.ReadCloser
+	cancel context.CancelFunc
+}
+
+func (b *cancelOnCloseBody) Close() error {
+	defer b.cancel()
+	return b.ReadCloser.Close()
+}
+
+func isIdempotent(req *http.Request) bool {
+	switch req.Method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions:
+		return true
+	}
+	return false
+}
+
+func isWatch(req *http.Request) bool {
+	return req.URL.Query().Get("watch") == "true"
+}
+
+func isRetryable(resp *http.Response, err error) bool {
+	if err != nil {
+		return true
+	}
+	switch resp.StatusCode {
+	case http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return true
+	}
+	return false
+}
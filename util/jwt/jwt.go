@@ -64,3 +64,17 @@ func GetIssuedAt(m jwtgo.MapClaims) (int64, error) {
 		return 0, fmt.Errorf("iat '%v' is not a number", iat)
 	}
 }
+
+// GetExpiresAt returns the expiry time as an int64
+func GetExpiresAt(m jwtgo.MapClaims) (int64, error) {
+	switch exp := m["exp"].(type) {
+	case float64:
+		return int64(exp), nil
+	case json.Number:
+		return exp.Int64()
+	case int64:
+		return exp, nil
+	default:
+		return 0, fmt.Errorf("exp '%v' is not a number", exp)
+	}
+}
@@ -34,6 +34,18 @@ func TestKsonnet(t *testing.T) {
 	assert.Equal(t, "https://1.2.3.4", defaultDest.Server)
 }
 
+func TestEnvironments(t *testing.T) {
+	ksApp, err := NewKsonnetApp(filepath.Join(testDataDir, testAppName))
+	assert.Nil(t, err)
+	envs, err := ksApp.Environments()
+	assert.Nil(t, err)
+	assert.Equal(t, []string{testEnvName}, envs)
+
+	envs, err = Environments([]byte("environments:\n  prod: {}\n  dev: {}\n"))
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"dev", "prod"}, envs)
+}
+
 func TestShow(t *testing.T) {
 	ksApp, err := NewKsonnetApp(filepath.Join(testDataDir, testAppName))
 	assert.Nil(t, err)
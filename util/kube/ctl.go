@@ -9,12 +9,15 @@ import (
 	"os/exec"
 	"strings"
 	"sync"
+	"time"
 
 	"github.com/pkg/errors"
 	log "github.com/sirupsen/logrus"
+	apiv1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/watch"
 	"k8s.io/client-go/discovery"
 	"k8s.io/client-go/dynamic"
@@ -23,9 +26,13 @@ import (
 )
 
 type Kubectl interface {
-	ApplyResource(config *rest.Config, obj *unstructured.Unstructured, namespace string, dryRun, force bool) (string, error)
+	ApplyResource(config *rest.Config, obj *unstructured.Unstructured, namespace string, dryRun, force, validate bool) (string, error)
+	ReplaceResource(config *rest.Config, obj *unstructured.Unstructured, namespace string, validate bool) (string, error)
+	DryRunApplyResource(config *rest.Config, obj *unstructured.Unstructured, namespace string) (*unstructured.Unstructured, error)
 	ConvertToVersion(obj *unstructured.Unstructured, group, version string) (*unstructured.Unstructured, error)
-	DeleteResource(config *rest.Config, obj *unstructured.Unstructured, namespace string) error
+	DeleteResource(config *rest.Config, obj *unstructured.Unstructured, namespace string, deleteOptions metav1.DeleteOptions) error
+	PatchResource(config *rest.Config, obj *unstructured.Unstructured, namespace string, patchType types.PatchType, patch []byte) (*unstructured.Unstructured, error)
+	GetPodLogs(config *rest.Config, namespace, podName, container string, tailLines int64) (string, error)
 	WatchResources(ctx context.Context, config *rest.Config, namespace string, selector func(kind schema.GroupVersionKind) metav1.ListOptions) (chan watch.Event, error)
 }
 
@@ -65,8 +72,8 @@ func (k KubectlCmd) WatchResources(
 	return ch, nil
 }
 
-// DeleteResource deletes resource
-func (k KubectlCmd) DeleteResource(config *rest.Config, obj *unstructured.Unstructured, namespace string) error {
+// DeleteResource deletes resource. The requests fail after the timeout of the config, if any.
+func (k KubectlCmd) DeleteResource(config *rest.Config, obj *unstructured.Unstructured, namespace string, deleteOptions metav1.DeleteOptions) error {
 	dynamicIf, err := dynamic.NewForConfig(config)
 	if err != nil {
 		return err
@@ -82,12 +89,45 @@ func (k KubectlCmd) DeleteResource(config *rest.Config, obj *unstructured.Unstru
 	}
 	resource := gvk.GroupVersion().WithResource(apiResource.Name)
 	resourceIf := ToResourceInterface(dynamicIf, apiResource, resource, namespace)
-	propagationPolicy := metav1.DeletePropagationForeground
-	return resourceIf.Delete(obj.GetName(), &metav1.DeleteOptions{PropagationPolicy: &propagationPolicy})
+	return resourceIf.Delete(obj.GetName(), &deleteOptions)
 }
 
-// ApplyResource performs an apply of a unstructured resource
-func (k KubectlCmd) ApplyResource(config *rest.Config, obj *unstructured.Unstructured, namespace string, dryRun, force bool) (string, error) {
+// PatchResource patches a live resource and returns the patched resource
+func (k KubectlCmd) PatchResource(config *rest.Config, obj *unstructured.Unstructured, namespace string, patchType types.PatchType, patch []byte) (*unstructured.Unstructured, error) {
+	dynamicIf, err := dynamic.NewForConfig(config)
+	if err != nil {
+		return nil, err
+	}
+	gvk := obj.GroupVersionKind()
+	disco, err := discovery.NewDiscoveryClientForConfig(config)
+	if err != nil {
+		return nil, err
+	}
+	apiResource, err := ServerResourceForGroupVersionKind(disco, gvk)
+	if err != nil {
+		return nil, err
+	}
+	resource := gvk.GroupVersion().WithResource(apiResource.Name)
+	resourceIf := ToResourceInterface(dynamicIf, apiResource, resource, namespace)
+	return resourceIf.Patch(obj.GetName(), patchType, patch, metav1.UpdateOptions{})
+}
+
+// GetPodLogs returns the last lines of the logs of a container of a pod
+func (k KubectlCmd) GetPodLogs(config *rest.Config, namespace, podName, container string, tailLines int64) (string, error) {
+	kubeClient, err := kubernetes.NewForConfig(config)
+	if err != nil {
+		return "", err
+	}
+	logs, err := kubeClient.CoreV1().Pods(namespace).GetLogs(podName, &apiv1.PodLogOptions{Container: container, TailLines: &tailLines}).Do().Raw()
+	if err != nil {
+		return "", err
+	}
+	return string(logs), nil
+}
+
+// ApplyResource performs an apply of a unstructured resource. If validate is false, the resource is
+// not validated against its schema. The apply fails after the timeout of the config, if any.
+func (k KubectlCmd) ApplyResource(config *rest.Config, obj *unstructured.Unstructured, namespace string, dryRun, force, validate bool) (string, error) {
 	log.Infof("Applying resource %s/%s in cluster: %s, namespace: %s", obj.GetKind(), obj.GetName(), config.Host, namespace)
 	f, err := ioutil.TempFile(kubectlTempDir, "")
 	if err != nil {
@@ -112,8 +152,9 @@ func (k KubectlCmd) ApplyResource(config *rest.Config, obj *unstructured.Unstruc
 		// `kubectl auth reconcile` has a side effect of auto-creating namespaces if it doesn't exist.
 		// See: https://github.com/kubernetes/kubernetes/issues/71185. This is behavior which we do
 		// not want. We need to check if the namespace exists, before know if it is safe to run this
-		// command. Skip this for dryRuns.
-		if !dryRun {
+		// command. Skip this for dryRuns and cluster-scoped RBAC resources, which may be applied
+		// before the namespace is created (e.g. by a PreSync hook).
+		if !dryRun && obj.GetKind() != ClusterRoleKind && obj.GetKind() != ClusterRoleBindingKind {
 			kubeClient, err := kubernetes.NewForConfig(config)
 			if err != nil {
 				return "", err
@@ -123,7 +164,10 @@ func (k KubectlCmd) ApplyResource(config *rest.Config, obj *unstructured.Unstruc
 				return "", err
 			}
 		}
-		outReconcile, err := runKubectl(f.Name(), namespace, []string{"auth", "reconcile"}, manifestBytes, dryRun)
+		// `auth reconcile` has no --validate flag: it decodes the manifest into the built-in RBAC types,
+		// and never validates it against the schema of the cluster. The validation of RBAC resources
+		// is only done, or skipped, by the apply below.
+		outReconcile, err := runKubectl(f.Name(), namespace, []string{"auth", "reconcile"}, manifestBytes, dryRun, config.Timeout)
 		if err != nil {
 			return "", err
 		}
@@ -137,7 +181,10 @@ func (k KubectlCmd) ApplyResource(config *rest.Config, obj *unstructured.Unstruc
 	if force {
 		applyArgs = append(applyArgs, "--force")
 	}
-	outApply, err := runKubectl(f.Name(), namespace, applyArgs, manifestBytes, dryRun)
+	if !validate {
+		applyArgs = append(applyArgs, "--validate=false")
+	}
+	outApply, err := runKubectl(f.Name(), namespace, applyArgs, manifestBytes, dryRun, config.Timeout)
 	if err != nil {
 		return "", err
 	}
@@ -145,15 +192,83 @@ func (k KubectlCmd) ApplyResource(config *rest.Config, obj *unstructured.Unstruc
 	return strings.Join(out, ". "), nil
 }
 
-func runKubectl(kubeconfigPath string, namespace string, args []string, manifestBytes []byte, dryRun bool) (string, error) {
+// ReplaceResource deletes and re-creates the resource using `kubectl replace --force`. Unlike apply,
+// this succeeds when the resource differs from the live resource in immutable fields. If validate is
+// false, the resource is not validated against its schema.
+func (k KubectlCmd) ReplaceResource(config *rest.Config, obj *unstructured.Unstructured, namespace string, validate bool) (string, error) {
+	log.Infof("Replacing resource %s/%s in cluster: %s, namespace: %s", obj.GetKind(), obj.GetName(), config.Host, namespace)
+	f, err := ioutil.TempFile(kubectlTempDir, "")
+	if err != nil {
+		return "", fmt.Errorf("Failed to generate temp file for kubeconfig: %v", err)
+	}
+	_ = f.Close()
+	err = WriteKubeConfig(config, namespace, f.Name())
+	if err != nil {
+		return "", fmt.Errorf("Failed to write kubeconfig: %v", err)
+	}
+	defer deleteFile(f.Name())
+	manifestBytes, err := json.Marshal(obj)
+	if err != nil {
+		return "", err
+	}
+	replaceArgs := []string{"replace", "--force"}
+	if !validate {
+		replaceArgs = append(replaceArgs, "--validate=false")
+	}
+	return runKubectl(f.Name(), namespace, replaceArgs, manifestBytes, false, config.Timeout)
+}
+
+// DryRunApplyResource applies the resource using `kubectl apply --server-dry-run`, and returns the
+// object the API server would persist, i.e. defaulted and mutated by admission webhooks. Requires
+// Kubernetes 1.13 or later.
+func (k KubectlCmd) DryRunApplyResource(config *rest.Config, obj *unstructured.Unstructured, namespace string) (*unstructured.Unstructured, error) {
+	f, err := ioutil.TempFile(kubectlTempDir, "")
+	if err != nil {
+		return nil, fmt.Errorf("Failed to generate temp file for kubeconfig: %v", err)
+	}
+	_ = f.Close()
+	err = WriteKubeConfig(config, namespace, f.Name())
+	if err != nil {
+		return nil, fmt.Errorf("Failed to write kubeconfig: %v", err)
+	}
+	defer deleteFile(f.Name())
+	manifestBytes, err := json.Marshal(obj)
+	if err != nil {
+		return nil, err
+	}
+	out, err := runKubectl(f.Name(), namespace, []string{"apply", "--server-dry-run", "-o", "json"}, manifestBytes, false, config.Timeout)
+	if err != nil {
+		return nil, err
+	}
+	var predictedObj unstructured.Unstructured
+	if err := json.Unmarshal([]byte(out), &predictedObj); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal the server-side dry-run of %s/%s: %v", obj.GetKind(), obj.GetName(), err)
+	}
+	return &predictedObj, nil
+}
+
+// runKubectl runs a kubectl command on the manifest. The command is killed if it runs for longer than
+// the timeout, unless the timeout is 0.
+func runKubectl(kubeconfigPath string, namespace string, args []string, manifestBytes []byte, dryRun bool, timeout time.Duration) (string, error) {
 	cmdArgs := append(append([]string{"--kubeconfig", kubeconfigPath, "-n", namespace}, args...), "-f", "-")
 	if dryRun {
 		cmdArgs = append(cmdArgs, "--dry-run")
 	}
-	cmd := exec.Command("kubectl", cmdArgs...)
+	ctx := context.Background()
+	if timeout > 0 {
+		// the timeout also bounds the single requests kubectl sends to the cluster
+		cmdArgs = append(cmdArgs, "--request-timeout", timeout.String())
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
+	}
+	cmd := exec.CommandContext(ctx, "kubectl", cmdArgs...)
 	log.Info(cmd.Args)
 	cmd.Stdin = bytes.NewReader(manifestBytes)
 	out, err := cmd.Output()
+	if ctx.Err() == context.DeadlineExceeded {
+		return "", fmt.Errorf("kubectl %s timed out after %v", strings.Join(args, " "), timeout)
+	}
 	if err != nil {
 		if exErr, ok := err.(*exec.ExitError); ok {
 			errMsg := cleanKubectlOutput(string(exErr.Stderr))
@@ -164,6 +279,12 @@ func runKubectl(kubeconfigPath string, namespace string, args []string, manifest
 	return strings.TrimSpace(string(out)), nil
 }
 
+// IsImmutableFieldError returns whether the error of a kubectl command was caused by a change of an
+// immutable field of the resource, e.g. the template of a Job or the selector of a Deployment
+func IsImmutableFieldError(err error) bool {
+	return err != nil && strings.Contains(err.Error(), "field is immutable")
+}
+
 // ConvertToVersion converts an unstructured object into the specified group/version
 func (k KubectlCmd) ConvertToVersion(obj *unstructured.Unstructured, group, version string) (*unstructured.Unstructured, error) {
 	gvk := obj.GroupVersionKind()
@@ -1,6 +1,7 @@
 package kube
 
 import (
+	"fmt"
 	"io/ioutil"
 	"testing"
 
@@ -31,3 +32,9 @@ func TestConvertToVersion(t *testing.T) {
 	assert.Equal(t, "apps", gvk.Group)
 	assert.Equal(t, "v1", gvk.Version)
 }
+
+func TestIsImmutableFieldError(t *testing.T) {
+	assert.True(t, IsImmutableFieldError(fmt.Errorf(`The Service "my-service" is invalid: spec.clusterIP: Invalid value: "": field is immutable`)))
+	assert.False(t, IsImmutableFieldError(fmt.Errorf("connection refused")))
+	assert.False(t, IsImmutableFieldError(nil))
+}
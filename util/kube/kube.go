@@ -5,6 +5,8 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"net"
+	"net/http"
 	"os"
 	"reflect"
 	"regexp"
@@ -19,8 +21,10 @@ import (
 	apierr "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	k8slabels "k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/watch"
 	"k8s.io/client-go/discovery"
 	"k8s.io/client-go/dynamic"
@@ -30,7 +34,9 @@ import (
 	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
 	"k8s.io/kubernetes/pkg/kubectl/scheme"
 
+	"github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1"
 	"github.com/argoproj/argo-cd/util/cache"
+	httputil "github.com/argoproj/argo-cd/util/http"
 	jsonutil "github.com/argoproj/argo-cd/util/json"
 )
 
@@ -53,6 +59,9 @@ const (
 	JobKind                      = "Job"
 	PersistentVolumeClaimKind    = "PersistentVolumeClaim"
 	CustomResourceDefinitionKind = "CustomResourceDefinition"
+	ClusterRoleKind              = "ClusterRole"
+	ClusterRoleBindingKind       = "ClusterRoleBinding"
+	NamespaceKind                = "Namespace"
 )
 
 const (
@@ -86,6 +95,37 @@ func TestConfig(config *rest.Config) error {
 	return nil
 }
 
+// ClusterRESTConfig returns the REST config of the given cluster, with the dial timeout, request
+// timeout and retries of the network config of the cluster applied
+func ClusterRESTConfig(clst *v1alpha1.Cluster) *rest.Config {
+	config := clst.RESTConfig()
+	n := clst.Config.NetworkConfig
+	if n == nil {
+		return config
+	}
+	if n.DialTimeoutSeconds > 0 {
+		dialer := &net.Dialer{
+			Timeout:   time.Duration(n.DialTimeoutSeconds) * time.Second,
+			KeepAlive: 30 * time.Second,
+		}
+		config.Dial = dialer.DialContext
+	}
+	if n.RequestTimeoutSeconds > 0 || n.RetryLimit > 0 {
+		opts := httputil.TransportOpts{
+			RequestTimeout: time.Duration(n.RequestTimeoutSeconds) * time.Second,
+			RetryLimit:     int(n.RetryLimit),
+			RetryBackoff:   time.Duration(n.RetryBackoffSeconds) * time.Second,
+		}
+		if opts.RetryBackoff <= 0 {
+			opts.RetryBackoff = time.Second
+		}
+		config.WrapTransport = func(rt http.RoundTripper) http.RoundTripper {
+			return httputil.NewTransport(rt, opts)
+		}
+	}
+	return config
+}
+
 // ToUnstructured converts a concrete K8s API type to a un unstructured object
 func ToUnstructured(obj interface{}) (*unstructured.Unstructured, error) {
 	uObj, err := runtime.NewTestUnstructuredConverter(equality.Semantic).ToUnstructured(obj)
@@ -193,7 +233,7 @@ func SetLabel(target *unstructured.Unstructured, key, val string) error {
 // Caches the results for apiResourceCacheDuration (per host)
 func GetCachedServerResources(host string, disco discovery.DiscoveryInterface) ([]*metav1.APIResourceList, error) {
 	var resList []*metav1.APIResourceList
-	cacheKey := fmt.Sprintf("apires|%s", host)
+	cacheKey := apiResourcesCacheKey(host)
 	err := apiResourceCache.Get(cacheKey, &resList)
 	if err == nil {
 		log.Debugf("cache hit: %s", cacheKey)
@@ -226,6 +266,18 @@ func FlushServerResourcesCache() {
 	apiResourceCache.Flush()
 }
 
+// FlushCachedServerResources discards the cached API resources of a Kube API server, so that they are
+// discovered again
+func FlushCachedServerResources(host string) {
+	if err := apiResourceCache.Delete(apiResourcesCacheKey(host)); err != nil && err != cache.ErrCacheMiss {
+		log.Warnf("Failed to flush cached API resources of %s: %v", host, err)
+	}
+}
+
+func apiResourcesCacheKey(host string) string {
+	return fmt.Sprintf("apires|%s", host)
+}
+
 func ToGroupVersionResource(groupVersion string, apiResource *metav1.APIResource) schema.GroupVersionResource {
 	gvk := schema.FromAPIVersionAndKind(groupVersion, apiResource.Kind)
 	gv := gvk.GroupVersion()
@@ -267,6 +319,33 @@ func IsCRD(obj *unstructured.Unstructured) bool {
 	return IsCRDGroupVersionKind(obj.GroupVersionKind())
 }
 
+// ListCRDInstances returns the custom resources, in all namespaces, of the given custom resource definition
+func ListCRDInstances(dynamicIf dynamic.Interface, crd *unstructured.Unstructured) ([]unstructured.Unstructured, error) {
+	group, _, _ := unstructured.NestedString(crd.Object, "spec", "group")
+	plural, _, _ := unstructured.NestedString(crd.Object, "spec", "names", "plural")
+	version, _, _ := unstructured.NestedString(crd.Object, "spec", "version")
+	if version == "" {
+		versions, _, _ := unstructured.NestedSlice(crd.Object, "spec", "versions")
+		if len(versions) > 0 {
+			if v, ok := versions[0].(map[string]interface{}); ok {
+				version, _ = v["name"].(string)
+			}
+		}
+	}
+	if group == "" || plural == "" || version == "" {
+		return nil, fmt.Errorf("custom resource definition '%s' does not specify group, version and plural name", crd.GetName())
+	}
+	resource := schema.GroupVersionResource{Group: group, Version: version, Resource: plural}
+	list, err := dynamicIf.Resource(resource).List(metav1.ListOptions{})
+	if err != nil {
+		if apierr.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return list.Items, nil
+}
+
 // temporal solution for https://github.com/argoproj/argo-cd/issues/650.
 func isExcludedResourceGroup(resource metav1.APIResource) bool {
 	return resource.Group == "servicecatalog.k8s.io"
@@ -325,6 +404,31 @@ func watchSupported(groupVersion string, apiResource *metav1.APIResource) bool {
 	return isSupportedVerb(apiResource, watchVerb) && !isExcludedResourceGroup(*apiResource)
 }
 
+// APIResourceInfo is a kind of resources served by a Kube API server
+type APIResourceInfo struct {
+	GroupVersionKind schema.GroupVersionKind
+	APIResource      metav1.APIResource
+}
+
+// GetWatchableAPIResources returns the kinds of resources of the cluster which can be listed and watched
+func GetWatchableAPIResources(config *rest.Config) ([]APIResourceInfo, error) {
+	listWatchSupported := func(groupVersion string, apiResource *metav1.APIResource) bool {
+		return isSupportedVerb(apiResource, listVerb) && watchSupported(groupVersion, apiResource)
+	}
+	apiResIfs, err := filterAPIResources(config, listWatchSupported, "")
+	if err != nil {
+		return nil, err
+	}
+	infos := make([]APIResourceInfo, len(apiResIfs))
+	for i, apiResIf := range apiResIfs {
+		infos[i] = APIResourceInfo{
+			GroupVersionKind: schema.FromAPIVersionAndKind(apiResIf.groupVersion, apiResIf.apiResource.Kind),
+			APIResource:      apiResIf.apiResource,
+		}
+	}
+	return infos, nil
+}
+
 func WatchResourcesWithLabel(ctx context.Context, config *rest.Config, namespace string, labelName string) (chan watch.Event, error) {
 	log.Infof("Start watching for resources changes with label %s in cluster %s", labelName, config.Host)
 	apiResIfs, err := filterAPIResources(config, watchSupported, namespace)
@@ -372,16 +476,103 @@ func copyEventsChannel(ctx context.Context, src <-chan watch.Event, dst chan wat
 	}
 }
 
-// GetResourcesWithLabel returns all kubernetes resources with specified label
-func GetResourcesWithLabel(config *rest.Config, namespace string, labelName string, labelValue string) ([]*unstructured.Unstructured, error) {
+// GetResourcesWithSelector returns all kubernetes resources which match the specified label selector
+func GetResourcesWithSelector(config *rest.Config, namespace string, selector k8slabels.Selector) ([]*unstructured.Unstructured, error) {
+	return listResourcesWithLabel(config, namespace, selector.String(), func(labels map[string]string) bool {
+		return selector.Matches(k8slabels.Set(labels))
+	})
+}
+
+// GetResourcesWithLabelKey returns all kubernetes resources of all namespaces which have the
+// specified label, regardless of the label value
+func GetResourcesWithLabelKey(config *rest.Config, labelName string) ([]*unstructured.Unstructured, error) {
+	return listResourcesWithLabel(config, "", labelName, func(labels map[string]string) bool {
+		_, ok := labels[labelName]
+		return ok
+	})
+}
+
+// GetNamespaceResources returns the namespaced kubernetes resources of the namespace, of the preferred
+// version of every kind, except events and metrics. Resources served by several groups, such as the
+// deployments of the apps and extensions groups, are returned once.
+func GetNamespaceResources(config *rest.Config, namespace string) ([]*unstructured.Unstructured, error) {
+	dynamicIf, err := dynamic.NewForConfig(config)
+	if err != nil {
+		return nil, err
+	}
+	disco, err := discovery.NewDiscoveryClientForConfig(config)
+	if err != nil {
+		return nil, err
+	}
+	serverResources, err := disco.ServerPreferredNamespacedResources()
+	if err != nil {
+		if len(serverResources) == 0 {
+			return nil, errors.WithStack(err)
+		}
+		log.Warnf("Resource discovery partially successful. Encountered error: %v", err)
+	}
+	apiResIfs := make([]apiResourceInterface, 0)
+	seen := make(map[schema.GroupKind]bool)
+	for _, apiResourcesList := range serverResources {
+		gv, err := schema.ParseGroupVersion(apiResourcesList.GroupVersion)
+		if err != nil || gv.Group == "metrics.k8s.io" {
+			continue
+		}
+		for _, apiResource := range apiResourcesList.APIResources {
+			gk := schema.GroupKind{Group: gv.Group, Kind: apiResource.Kind}
+			if seen[gk] || apiResource.Kind == "Event" || !isSupportedVerb(&apiResource, listVerb) || isExcludedResourceGroup(apiResource) {
+				continue
+			}
+			seen[gk] = true
+			resource := ToGroupVersionResource(apiResourcesList.GroupVersion, &apiResource)
+			apiResIfs = append(apiResIfs, apiResourceInterface{
+				groupVersion: apiResourcesList.GroupVersion,
+				apiResource:  apiResource,
+				resourceIf:   ToResourceInterface(dynamicIf, &apiResource, resource, namespace),
+			})
+		}
+	}
+	objs, err := listAPIResources(apiResIfs, "", func(labels map[string]string) bool {
+		return true
+	})
+	if err != nil {
+		return nil, err
+	}
+	result := make([]*unstructured.Unstructured, 0, len(objs))
+	uids := make(map[types.UID]bool)
+	for _, obj := range objs {
+		if uid := obj.GetUID(); uid != "" {
+			if uids[uid] {
+				continue
+			}
+			uids[uid] = true
+		}
+		result = append(result, obj)
+	}
+	return result, nil
+}
+
+// listResourcesWithLabel lists resources of every supported type using a single request per type
+func listResourcesWithLabel(config *rest.Config, namespace string, labelSelector string, matches func(labels map[string]string) bool) ([]*unstructured.Unstructured, error) {
 	listSupported := func(groupVersion string, apiResource *metav1.APIResource) bool {
 		return isSupportedVerb(apiResource, listVerb) && !isExcludedResourceGroup(*apiResource)
 	}
-	apiResIfs, err := filterAPIResources(config, listSupported, namespace)
+	return listResources(config, namespace, listSupported, labelSelector, func(labels map[string]string) bool {
+		return labels != nil && matches(labels)
+	})
+}
+
+// listResources lists the resources of the types which pass the filter, which match the label selector
+func listResources(config *rest.Config, namespace string, filter filterFunc, labelSelector string, matches func(labels map[string]string) bool) ([]*unstructured.Unstructured, error) {
+	apiResIfs, err := filterAPIResources(config, filter, namespace)
 	if err != nil {
 		return nil, err
 	}
+	return listAPIResources(apiResIfs, labelSelector, matches)
+}
 
+// listAPIResources lists the resources of the types in parallel, which match the label selector
+func listAPIResources(apiResIfs []apiResourceInterface, labelSelector string, matches func(labels map[string]string) bool) ([]*unstructured.Unstructured, error) {
 	var asyncErr error
 	var result []*unstructured.Unstructured
 	var wg sync.WaitGroup
@@ -391,7 +582,7 @@ func GetResourcesWithLabel(config *rest.Config, namespace string, labelName stri
 		go func(resourceIf dynamic.ResourceInterface) {
 			defer wg.Done()
 			list, err := resourceIf.List(metav1.ListOptions{
-				LabelSelector: fmt.Sprintf("%s=%s", labelName, labelValue),
+				LabelSelector: labelSelector,
 			})
 			if err != nil {
 				if !apierr.IsNotFound(err) {
@@ -403,12 +594,10 @@ func GetResourcesWithLabel(config *rest.Config, namespace string, labelName stri
 			for i := range list.Items {
 				item := list.Items[i]
 				labels := item.GetLabels()
-				if labels != nil {
-					if value, ok := labels[labelName]; ok && value == labelValue {
-						lock.Lock()
-						result = append(result, &item)
-						lock.Unlock()
-					}
+				if matches(labels) {
+					lock.Lock()
+					result = append(result, &item)
+					lock.Unlock()
 				}
 			}
 		}(apiResIf.resourceIf)
@@ -417,8 +606,9 @@ func GetResourcesWithLabel(config *rest.Config, namespace string, labelName stri
 	return result, asyncErr
 }
 
-// DeleteResourcesWithLabel delete all resources which match to specified label selector
-func DeleteResourcesWithLabel(config *rest.Config, namespace string, labelName string, labelValue string) error {
+// DeleteResourcesWithSelector delete all resources which match to specified label selector, with the
+// deletion propagation policy
+func DeleteResourcesWithSelector(config *rest.Config, namespace string, selector k8slabels.Selector, propagationPolicy metav1.DeletionPropagation) error {
 	deleteSupported := func(groupVersion string, apiResource *metav1.APIResource) bool {
 		if !isSupportedVerb(apiResource, deleteCollectionVerb) {
 			// if we can't delete by collection, we better be able to list and delete
@@ -439,7 +629,6 @@ func DeleteResourcesWithLabel(config *rest.Config, namespace string, labelName s
 		return err
 	}
 	var asyncErr error
-	propagationPolicy := metav1.DeletePropagationForeground
 
 	var wg sync.WaitGroup
 	wg.Add(len(apiResIfs))
@@ -451,28 +640,25 @@ func DeleteResourcesWithLabel(config *rest.Config, namespace string, labelName s
 			if deleteCollectionSupported {
 				err = resourceIf.DeleteCollection(&metav1.DeleteOptions{
 					PropagationPolicy: &propagationPolicy,
-				}, metav1.ListOptions{LabelSelector: fmt.Sprintf("%s=%s", labelName, labelValue)})
+				}, metav1.ListOptions{LabelSelector: selector.String()})
 				if err != nil && !apierr.IsNotFound(err) {
 					asyncErr = err
 				}
 			} else {
-				items, err := resourceIf.List(metav1.ListOptions{LabelSelector: fmt.Sprintf("%s=%s", labelName, labelValue)})
+				items, err := resourceIf.List(metav1.ListOptions{LabelSelector: selector.String()})
 				if err != nil {
 					asyncErr = err
 					return
 				}
 				for _, item := range items.Items {
 					// apply client side filtering since not every kubernetes API supports label filtering
-					labels := item.GetLabels()
-					if labels != nil {
-						if value, ok := labels[labelName]; ok && value == labelValue {
-							err = resourceIf.Delete(item.GetName(), &metav1.DeleteOptions{
-								PropagationPolicy: &propagationPolicy,
-							})
-							if err != nil && !apierr.IsNotFound(err) {
-								asyncErr = err
-								return
-							}
+					if selector.Matches(k8slabels.Set(item.GetLabels())) {
+						err = resourceIf.Delete(item.GetName(), &metav1.DeleteOptions{
+							PropagationPolicy: &propagationPolicy,
+						})
+						if err != nil && !apierr.IsNotFound(err) {
+							asyncErr = err
+							return
 						}
 					}
 				}
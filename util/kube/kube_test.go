@@ -5,6 +5,7 @@ import (
 	"io/ioutil"
 	"log"
 	"testing"
+	"time"
 
 	"github.com/argoproj/argo-cd/test"
 	"github.com/ghodss/yaml"
@@ -169,6 +170,20 @@ spec:
         - containerPort: 80
 `
 
+func TestClusterRESTConfig(t *testing.T) {
+	clst := &argoappv1.Cluster{Server: "https://cluster-a"}
+	config := ClusterRESTConfig(clst)
+	assert.Nil(t, config.Dial)
+	assert.Nil(t, config.WrapTransport)
+
+	clst.Config.NetworkConfig = &argoappv1.ClusterNetworkConfig{DialTimeoutSeconds: 5, RequestTimeoutSeconds: 30}
+	config = ClusterRESTConfig(clst)
+	assert.NotNil(t, config.Dial)
+	assert.NotNil(t, config.WrapTransport)
+	// the request timeout is enforced by the transport, so that it does not apply to watches
+	assert.Equal(t, time.Duration(0), config.Timeout)
+}
+
 func TestUnsetLabels(t *testing.T) {
 	for _, yamlStr := range []string{depWithLabel} {
 		var obj unstructured.Unstructured
@@ -0,0 +1,116 @@
+package kustomize
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/ghodss/yaml"
+)
+
+// kustomizationFileNames are the file names recognized as a kustomization
+var kustomizationFileNames = []string{"kustomization.yaml", "kustomization.yml"}
+
+// RemoteBase is a kustomize base hosted in a remote git repository
+type RemoteBase struct {
+	// RepoURL is the URL of the git repository
+	RepoURL string
+	// Path is the path of the base within the repository
+	Path string
+	// Ref is the git revision of the base. Defaults to HEAD
+	Ref string
+}
+
+// ParseRemoteBase parses a kustomize remote base, such as
+// github.com/org/repo//path?ref=v1 or git::https://host/org/repo.git//path?ref=master.
+// Returns false if the base is a local path.
+func ParseRemoteBase(base string) (*RemoteBase, bool) {
+	base = strings.TrimPrefix(strings.TrimSpace(base), "git::")
+	switch {
+	case strings.HasPrefix(base, "github.com/"):
+		base = "https://" + base
+	case strings.Contains(base, "://"), strings.HasPrefix(base, "git@"):
+	default:
+		return nil, false
+	}
+	remote := RemoteBase{Ref: "HEAD"}
+	if i := strings.Index(base, "?"); i >= 0 {
+		for _, param := range strings.Split(base[i+1:], "&") {
+			if strings.HasPrefix(param, "ref=") {
+				remote.Ref = strings.TrimPrefix(param, "ref=")
+			}
+		}
+		base = base[:i]
+	}
+	hostStart := 0
+	if i := strings.Index(base, "://"); i >= 0 {
+		hostStart = i + len("://")
+	}
+	if i := strings.Index(base[hostStart:], "//"); i >= 0 {
+		remote.Path = strings.Trim(base[hostStart+i+len("//"):], "/")
+		base = base[:hostStart+i]
+	}
+	remote.RepoURL = base
+	return &remote, true
+}
+
+func kustomizationPath(appPath string) (string, error) {
+	for _, name := range kustomizationFileNames {
+		path := filepath.Join(appPath, name)
+		if _, err := os.Stat(path); err == nil {
+			return path, nil
+		}
+	}
+	return "", os.ErrNotExist
+}
+
+// GetBases returns the bases referenced by the kustomization in the given directory
+func GetBases(appPath string) ([]string, error) {
+	path, err := kustomizationPath(appPath)
+	if err != nil {
+		return nil, err
+	}
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var kustomization struct {
+		Bases []string `json:"bases"`
+	}
+	err = yaml.Unmarshal(data, &kustomization)
+	if err != nil {
+		return nil, err
+	}
+	return kustomization.Bases, nil
+}
+
+// ReplaceBases replaces bases of the kustomization in the given directory with the supplied ones
+func ReplaceBases(appPath string, replacements map[string]string) error {
+	path, err := kustomizationPath(appPath)
+	if err != nil {
+		return err
+	}
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return err
+	}
+	var kustomization map[string]interface{}
+	err = yaml.Unmarshal(data, &kustomization)
+	if err != nil {
+		return err
+	}
+	bases, _ := kustomization["bases"].([]interface{})
+	for i := range bases {
+		if base, ok := bases[i].(string); ok {
+			if replacement, ok := replacements[base]; ok {
+				bases[i] = replacement
+			}
+		}
+	}
+	data, err = yaml.Marshal(kustomization)
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(path, data, 0644)
+}
@@ -0,0 +1,48 @@
+package kustomize
+
+import (
+	"io/ioutil"
+	"os"
+	"path"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseRemoteBase(t *testing.T) {
+	remote, ok := ParseRemoteBase("github.com/argoproj/argocd-example-apps//kustomize-guestbook?ref=v1.0")
+	assert.True(t, ok)
+	assert.Equal(t, "https://github.com/argoproj/argocd-example-apps", remote.RepoURL)
+	assert.Equal(t, "kustomize-guestbook", remote.Path)
+	assert.Equal(t, "v1.0", remote.Ref)
+
+	remote, ok = ParseRemoteBase("git::https://gitlab.com/org/repo.git//base")
+	assert.True(t, ok)
+	assert.Equal(t, "https://gitlab.com/org/repo.git", remote.RepoURL)
+	assert.Equal(t, "base", remote.Path)
+	assert.Equal(t, "HEAD", remote.Ref)
+
+	remote, ok = ParseRemoteBase("git@github.com:org/repo.git//overlays/prod?ref=master")
+	assert.True(t, ok)
+	assert.Equal(t, "git@github.com:org/repo.git", remote.RepoURL)
+	assert.Equal(t, "overlays/prod", remote.Path)
+	assert.Equal(t, "master", remote.Ref)
+
+	_, ok = ParseRemoteBase("../base")
+	assert.False(t, ok)
+}
+
+func TestReplaceBases(t *testing.T) {
+	appPath, err := ioutil.TempDir("", "kustomize-test")
+	assert.Nil(t, err)
+	defer func() { _ = os.RemoveAll(appPath) }()
+	kustomization := "bases:\n- ../base\n- github.com/org/repo//base?ref=v1\n"
+	err = ioutil.WriteFile(path.Join(appPath, "kustomization.yaml"), []byte(kustomization), 0644)
+	assert.Nil(t, err)
+
+	err = ReplaceBases(appPath, map[string]string{"github.com/org/repo//base?ref=v1": "../cached/base"})
+	assert.Nil(t, err)
+	bases, err := GetBases(appPath)
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"../base", "../cached/base"}, bases)
+}
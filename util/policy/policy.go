@@ -0,0 +1,106 @@
+package policy
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"time"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/util/settings"
+)
+
+const (
+	// checkTimeout is the maximum duration of a single request to the policy service
+	checkTimeout = 30 * time.Second
+)
+
+// Input is the document the policy service decides upon
+type Input struct {
+	Application string                          `json:"application"`
+	Project     string                          `json:"project"`
+	Destination v1alpha1.ApplicationDestination `json:"destination"`
+	Revision    string                          `json:"revision"`
+	Manifests   []*unstructured.Unstructured    `json:"manifests"`
+}
+
+// Decision is the decision of the policy service whether a sync is allowed
+type Decision struct {
+	Allowed bool `json:"allowed"`
+	// Reasons explains why the sync is denied
+	Reasons []string `json:"reasons,omitempty"`
+}
+
+// Checker consults a policy service before applications are synced
+type Checker interface {
+	Check(ctx context.Context, input Input) (*Decision, error)
+}
+
+// httpChecker consults a policy service over HTTP, using the request and response envelopes of the
+// data API of Open Policy Agent: the input is posted as {"input": ...} and the decision is read from
+// {"result": ...}
+type httpChecker struct {
+	url    string
+	client *http.Client
+}
+
+// NewChecker returns a checker of the policy service of the config
+func NewChecker(config *settings.PolicyConfig) (Checker, error) {
+	if config.URL == "" {
+		return nil, fmt.Errorf("url of the policy service must be configured")
+	}
+	return &httpChecker{
+		url:    config.URL,
+		client: &http.Client{Timeout: checkTimeout},
+	}, nil
+}
+
+type checkRequest struct {
+	Input Input `json:"input"`
+}
+
+type checkResponse struct {
+	Result *Decision `json:"result"`
+}
+
+// Check posts the input to the policy service and returns its decision
+func (c *httpChecker) Check(ctx context.Context, input Input) (*Decision, error) {
+	var reqBody bytes.Buffer
+	err := json.NewEncoder(&reqBody).Encode(checkRequest{Input: input})
+	if err != nil {
+		return nil, err
+	}
+	req, err := http.NewRequest(http.MethodPost, c.url, &reqBody)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+	respBody, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return nil, fmt.Errorf("policy service %s failed: %s", c.url, resp.Status)
+	}
+	var checkResp checkResponse
+	err = json.Unmarshal(respBody, &checkResp)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode decision of policy service %s: %v", c.url, err)
+	}
+	// OPA omits the result if the policy is undefined, e.g. if the policy path is wrong
+	if checkResp.Result == nil {
+		return nil, fmt.Errorf("policy service %s returned no decision", c.url)
+	}
+	return checkResp.Result, nil
+}
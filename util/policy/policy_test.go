@@ -0,0 +1,83 @@
+package policy
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/util/settings"
+)
+
+func newTestInput() Input {
+	pod := unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "v1",
+		"kind":       "Pod",
+		"metadata":   map[string]interface{}{"name": "my-pod"},
+	}}
+	return Input{
+		Application: "my-app",
+		Project:     "default",
+		Destination: v1alpha1.ApplicationDestination{Server: "https://kubernetes.default.svc", Namespace: "default"},
+		Revision:    "abc123",
+		Manifests:   []*unstructured.Unstructured{&pod},
+	}
+}
+
+func TestCheck(t *testing.T) {
+	var received map[string]interface{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&received))
+		_, _ = w.Write([]byte(`{"result": {"allowed": false, "reasons": ["pods must not run as root"]}}`))
+	}))
+	defer server.Close()
+
+	checker, err := NewChecker(&settings.PolicyConfig{URL: server.URL})
+	assert.NoError(t, err)
+	decision, err := checker.Check(context.Background(), newTestInput())
+	assert.NoError(t, err)
+	assert.False(t, decision.Allowed)
+	assert.Equal(t, []string{"pods must not run as root"}, decision.Reasons)
+
+	input := received["input"].(map[string]interface{})
+	assert.Equal(t, "my-app", input["application"])
+	assert.Equal(t, "abc123", input["revision"])
+	manifests := input["manifests"].([]interface{})
+	assert.Len(t, manifests, 1)
+	assert.Equal(t, "Pod", manifests[0].(map[string]interface{})["kind"])
+}
+
+func TestCheckUndefinedDecision(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{}`))
+	}))
+	defer server.Close()
+
+	checker, err := NewChecker(&settings.PolicyConfig{URL: server.URL})
+	assert.NoError(t, err)
+	_, err = checker.Check(context.Background(), newTestInput())
+	assert.Error(t, err)
+}
+
+func TestCheckServiceError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer server.Close()
+
+	checker, err := NewChecker(&settings.PolicyConfig{URL: server.URL})
+	assert.NoError(t, err)
+	_, err = checker.Check(context.Background(), newTestInput())
+	assert.Error(t, err)
+}
+
+func TestNewCheckerWithoutURL(t *testing.T) {
+	_, err := NewChecker(&settings.PolicyConfig{})
+	assert.Error(t, err)
+}
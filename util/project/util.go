@@ -83,6 +83,12 @@ func ValidateProject(p *v1alpha1.AppProject) error {
 		}
 	}
 
+	for i := range p.Spec.SyncWindows {
+		if err := p.Spec.SyncWindows[i].Validate(); err != nil {
+			return status.Errorf(codes.InvalidArgument, "invalid sync window '%s': %v", p.Spec.SyncWindows[i].Schedule, err)
+		}
+	}
+
 	return nil
 }
 
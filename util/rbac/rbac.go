@@ -3,10 +3,12 @@ package rbac
 import (
 	"context"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/casbin/casbin"
 	"github.com/casbin/casbin/model"
+	"github.com/casbin/casbin/util"
 	jwt "github.com/dgrijalva/jwt-go"
 	"github.com/gobuffalo/packr"
 	scas "github.com/qiangmzsx/string-adapter"
@@ -99,15 +101,54 @@ func (e *Enforcer) Enforce(rvals ...interface{}) bool {
 // user-defined policy. This allows any explicit denies of the built-in, and user-defined policies
 // to override the run-time policy. Runs normal enforcement if run-time policy is empty.
 func (e *Enforcer) EnforceRuntimePolicy(policy string, rvals ...interface{}) bool {
-	var enf *casbin.Enforcer
+	return enforce(e.runtimeEnforcer(policy), e.defaultRole, e.claimsEnforcerFunc, rvals...)
+}
+
+// runtimeEnforcer returns an enforcer of the built-in, user-defined and run-time policies, or the
+// enforcer itself if the run-time policy is empty
+func (e *Enforcer) runtimeEnforcer(policy string) *casbin.Enforcer {
 	if policy == "" {
-		enf = e.Enforcer
-	} else {
-		policies := fmt.Sprintf("%s\n%s\n%s", e.builtinPolicy, e.userDefinedPolicy, policy)
-		adapter := scas.NewAdapter(policies)
-		enf = casbin.NewEnforcer(builtInModel, adapter)
+		return e.Enforcer
+	}
+	policies := fmt.Sprintf("%s\n%s\n%s", e.builtinPolicy, e.userDefinedPolicy, policy)
+	adapter := scas.NewAdapter(policies)
+	return casbin.NewEnforcer(builtInModel, adapter)
+}
+
+// MatchingPolicies returns the lines of the built-in, user-defined and run-time policies which allow
+// or deny the request to one of the subjects, the roles they are assigned to, or the default role
+func (e *Enforcer) MatchingPolicies(policy string, subjects []string, res, act, obj string) []string {
+	enf := e.runtimeEnforcer(policy)
+	if e.defaultRole != "" {
+		subjects = append(subjects, e.defaultRole)
+	}
+	roles := make(map[string]bool)
+	grouping := enf.GetGroupingPolicy()
+	var addRoles func(subject string)
+	addRoles = func(subject string) {
+		if roles[subject] {
+			return
+		}
+		roles[subject] = true
+		for _, rule := range grouping {
+			if len(rule) >= 2 && rule[0] == subject {
+				addRoles(rule[1])
+			}
+		}
+	}
+	for _, subject := range subjects {
+		addRoles(subject)
+	}
+	var lines []string
+	for _, rule := range enf.GetPolicy() {
+		if len(rule) < 4 || !roles[rule[0]] {
+			continue
+		}
+		if util.KeyMatch(res, rule[1]) && util.KeyMatch(act, rule[2]) && util.KeyMatch(obj, rule[3]) {
+			lines = append(lines, "p, "+strings.Join(rule, ", "))
+		}
 	}
-	return enforce(enf, e.defaultRole, e.claimsEnforcerFunc, rvals...)
+	return lines
 }
 
 // enforce is a helper to additionally check a default role and invoke a custom claims enforcement function
@@ -353,3 +353,31 @@ func TestClaimsEnforcerFuncWithRuntimePolicy(t *testing.T) {
 	})
 	assert.True(t, enf.EnforceRuntimePolicy(runtimePolicy, claims, "applications", "get", "foo/bar"))
 }
+
+// TestMatchingPolicies verifies the policies which match a request of subjects are found through
+// their roles, the default role and the run-time policy
+func TestMatchingPolicies(t *testing.T) {
+	kubeclientset := fake.NewSimpleClientset(fakeConfigMap())
+	enf := NewEnforcer(kubeclientset, fakeNamespace, fakeConfgMapName, nil)
+	err := enf.SetBuiltinPolicy(box.String(builtinPolicyFile))
+	assert.Nil(t, err)
+	err = enf.SetUserPolicy(`
+p, role:deployer, applications, sync, my-proj/*, allow
+p, role:deployer, applications, sync, my-proj/prod-*, deny
+g, my-org:team, role:deployer
+`)
+	assert.Nil(t, err)
+
+	assert.Equal(t, []string{
+		"p, role:deployer, applications, sync, my-proj/*, allow",
+		"p, role:deployer, applications, sync, my-proj/prod-*, deny",
+	}, enf.MatchingPolicies("", []string{"alice", "my-org:team"}, "applications", "sync", "my-proj/prod-guestbook"))
+	assert.Empty(t, enf.MatchingPolicies("", []string{"alice"}, "applications", "sync", "my-proj/guestbook"))
+
+	enf.SetDefaultRole("role:readonly")
+	assert.Equal(t, []string{"p, role:readonly, applications, get, */*, allow"},
+		enf.MatchingPolicies("", []string{"alice"}, "applications", "get", "my-proj/guestbook"))
+
+	assert.Equal(t, []string{"p, alice, applications, delete, my-proj/*, allow"},
+		enf.MatchingPolicies("p, alice, applications, delete, my-proj/*, allow", []string{"alice"}, "applications", "delete", "my-proj/guestbook"))
+}
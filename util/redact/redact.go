@@ -0,0 +1,140 @@
+package redact
+
+import (
+	"encoding/json"
+	"reflect"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	appv1 "github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/util/settings"
+)
+
+const (
+	// mask replaces the values of redacted fields
+	mask = "********"
+	// changedMask replaces the values of redacted fields which differ between the live and target
+	// state, so that users can still see which fields are out of sync
+	changedMask = mask + "*"
+)
+
+// Redactor masks the fields of resources according to the configured redactions. A nil Redactor
+// leaves resources unchanged.
+type Redactor struct {
+	redactions []settings.ResourceRedaction
+}
+
+// NewRedactor returns a redactor which masks the given fields of resources
+func NewRedactor(redactions []settings.ResourceRedaction) *Redactor {
+	return &Redactor{redactions: redactions}
+}
+
+// RedactState masks the fields of the resource serialized in the state
+func (r *Redactor) RedactState(state string) string {
+	redacted, _ := r.RedactStates(state, "")
+	return redacted
+}
+
+// RedactStates masks the fields of the live and target state of a resource. The values which
+// differ between the states are masked with an extra star.
+func (r *Redactor) RedactStates(liveState, targetState string) (string, string) {
+	if r == nil {
+		return liveState, targetState
+	}
+	// states which are not resources are returned as is
+	liveObj, _ := appv1.UnmarshalToUnstructured(liveState)
+	targetObj, _ := appv1.UnmarshalToUnstructured(targetState)
+	var liveOrig *unstructured.Unstructured
+	if liveObj != nil {
+		liveOrig = liveObj.DeepCopy()
+	}
+	return r.redactState(liveState, liveObj, targetObj), r.redactState(targetState, targetObj, liveOrig)
+}
+
+// RedactObjects masks the fields of the live and target resources in place, so that they can be
+// diffed. Only the values of the target resource which differ from the live resource are masked
+// with an extra star, so that the masked changes remain visible in the diff.
+func (r *Redactor) RedactObjects(liveObj, targetObj *unstructured.Unstructured) {
+	if r == nil {
+		return
+	}
+	var liveOrig *unstructured.Unstructured
+	if liveObj != nil {
+		liveOrig = liveObj.DeepCopy()
+		r.redact(liveObj, nil)
+	}
+	if targetObj != nil {
+		r.redact(targetObj, liveOrig)
+	}
+}
+
+// RedactNodes masks the fields of the resources in the resource tree
+func (r *Redactor) RedactNodes(nodes []appv1.ResourceNode) []appv1.ResourceNode {
+	if r == nil {
+		return nodes
+	}
+	for i := range nodes {
+		nodes[i].State = r.RedactState(nodes[i].State)
+		nodes[i].Children = r.RedactNodes(nodes[i].Children)
+	}
+	return nodes
+}
+
+// redactState masks the fields of obj, and returns it serialized. The original state is returned
+// if no field is masked.
+func (r *Redactor) redactState(state string, obj *unstructured.Unstructured, other *unstructured.Unstructured) string {
+	if obj == nil || !r.redact(obj, other) {
+		return state
+	}
+	data, err := json.Marshal(obj)
+	if err != nil {
+		return state
+	}
+	return string(data)
+}
+
+// redact masks the fields of obj in place, and returns whether any field was masked
+func (r *Redactor) redact(obj *unstructured.Unstructured, other *unstructured.Unstructured) bool {
+	gvk := obj.GroupVersionKind()
+	redacted := false
+	for _, redaction := range r.redactions {
+		if redaction.Group != gvk.Group || redaction.Kind != gvk.Kind {
+			continue
+		}
+		for _, field := range redaction.Fields {
+			path := strings.Split(field, ".")
+			val, ok, err := unstructured.NestedFieldNoCopy(obj.Object, path...)
+			if err != nil || !ok {
+				continue
+			}
+			var otherVal interface{}
+			otherOk := false
+			if other != nil {
+				otherVal, otherOk, _ = unstructured.NestedFieldNoCopy(other.Object, path...)
+			}
+			if err := unstructured.SetNestedField(obj.Object, maskValue(val, otherVal, otherOk), path...); err == nil {
+				redacted = true
+			}
+		}
+	}
+	return redacted
+}
+
+// maskValue returns the masked value. The values of a map are masked individually, so that its
+// keys remain visible.
+func maskValue(val interface{}, other interface{}, hasOther bool) interface{} {
+	if m, ok := val.(map[string]interface{}); ok {
+		otherMap, _ := other.(map[string]interface{})
+		masked := make(map[string]interface{}, len(m))
+		for k, v := range m {
+			otherVal, otherOk := otherMap[k]
+			masked[k] = maskValue(v, otherVal, otherOk)
+		}
+		return masked
+	}
+	if hasOther && !reflect.DeepEqual(val, other) {
+		return changedMask
+	}
+	return mask
+}
@@ -0,0 +1,88 @@
+package redact
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	appv1 "github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/util/settings"
+)
+
+const liveSecret = `{"apiVersion":"v1","kind":"Secret","metadata":{"name":"my-secret"},"data":{"password":"cGFzcw==","username":"YWRtaW4="}}`
+const targetSecret = `{"apiVersion":"v1","kind":"Secret","metadata":{"name":"my-secret"},"data":{"password":"bmV3","username":"YWRtaW4="}}`
+
+func unmarshal(t *testing.T, state string) *unstructured.Unstructured {
+	obj, err := appv1.UnmarshalToUnstructured(state)
+	assert.NoError(t, err)
+	return obj
+}
+
+func TestRedactStates(t *testing.T) {
+	r := NewRedactor((&settings.ArgoCDSettings{}).GetResourceRedactions())
+	live, target := r.RedactStates(liveSecret, targetSecret)
+
+	data, _, _ := unstructured.NestedStringMap(unmarshal(t, live).Object, "data")
+	assert.Equal(t, map[string]string{"password": "*********", "username": "********"}, data)
+	data, _, _ = unstructured.NestedStringMap(unmarshal(t, target).Object, "data")
+	assert.Equal(t, map[string]string{"password": "*********", "username": "********"}, data)
+
+	live, target = r.RedactStates(liveSecret, "")
+	data, _, _ = unstructured.NestedStringMap(unmarshal(t, live).Object, "data")
+	assert.Equal(t, map[string]string{"password": "********", "username": "********"}, data)
+	assert.Equal(t, "", target)
+}
+
+func TestRedactObjects(t *testing.T) {
+	r := NewRedactor((&settings.ArgoCDSettings{}).GetResourceRedactions())
+	live, target := unmarshal(t, liveSecret), unmarshal(t, targetSecret)
+	r.RedactObjects(live, target)
+
+	data, _, _ := unstructured.NestedStringMap(live.Object, "data")
+	assert.Equal(t, map[string]string{"password": "********", "username": "********"}, data)
+	data, _, _ = unstructured.NestedStringMap(target.Object, "data")
+	assert.Equal(t, map[string]string{"password": "*********", "username": "********"}, data)
+
+	target = unmarshal(t, targetSecret)
+	r.RedactObjects(nil, target)
+	data, _, _ = unstructured.NestedStringMap(target.Object, "data")
+	assert.Equal(t, map[string]string{"password": "********", "username": "********"}, data)
+}
+
+func TestRedactFields(t *testing.T) {
+	r := NewRedactor([]settings.ResourceRedaction{
+		{Kind: "ConfigMap", Fields: []string{"data.password", "metadata.annotations"}},
+		{Group: "apps", Kind: "Deployment", Fields: []string{"spec.replicas"}},
+	})
+
+	state := r.RedactState(`{"apiVersion":"v1","kind":"ConfigMap","metadata":{"name":"my-config","annotations":{"owner":"me"}},"data":{"password":"pass","user":"admin"}}`)
+	obj := unmarshal(t, state)
+	data, _, _ := unstructured.NestedStringMap(obj.Object, "data")
+	assert.Equal(t, map[string]string{"password": "********", "user": "admin"}, data)
+	assert.Equal(t, map[string]string{"owner": "********"}, obj.GetAnnotations())
+
+	state = r.RedactState(`{"apiVersion":"apps/v1","kind":"Deployment","metadata":{"name":"my-deploy"},"spec":{"replicas":2}}`)
+	replicas, _, _ := unstructured.NestedString(unmarshal(t, state).Object, "spec", "replicas")
+	assert.Equal(t, "********", replicas)
+
+	// secrets are not masked unless configured
+	assert.Equal(t, liveSecret, r.RedactState(liveSecret))
+}
+
+func TestRedactNodes(t *testing.T) {
+	r := NewRedactor((&settings.ArgoCDSettings{}).GetResourceRedactions())
+	nodes := r.RedactNodes([]appv1.ResourceNode{{
+		State:    `{"apiVersion":"v1","kind":"ConfigMap","metadata":{"name":"my-config"}}`,
+		Children: []appv1.ResourceNode{{State: liveSecret}},
+	}})
+	assert.NotContains(t, nodes[0].Children[0].State, "cGFzcw==")
+	assert.Equal(t, `{"apiVersion":"v1","kind":"ConfigMap","metadata":{"name":"my-config"}}`, nodes[0].State)
+}
+
+func TestNilRedactor(t *testing.T) {
+	var r *Redactor
+	live, target := r.RedactStates(liveSecret, targetSecret)
+	assert.Equal(t, liveSecret, live)
+	assert.Equal(t, targetSecret, target)
+}
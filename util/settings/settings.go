@@ -7,6 +7,9 @@ import (
 	"crypto/x509"
 	"encoding/base64"
 	"fmt"
+	"path/filepath"
+	"sort"
+	"strings"
 	"sync"
 	"time"
 
@@ -23,8 +26,10 @@ import (
 	"k8s.io/client-go/tools/cache"
 
 	"github.com/argoproj/argo-cd/common"
+	"github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1"
 	"github.com/argoproj/argo-cd/util"
 	"github.com/argoproj/argo-cd/util/cli"
+	"github.com/argoproj/argo-cd/util/diff"
 	"github.com/argoproj/argo-cd/util/password"
 	tlsutil "github.com/argoproj/argo-cd/util/tls"
 )
@@ -52,10 +57,143 @@ type ArgoCDSettings struct {
 	WebhookGitLabSecret string `json:"webhookGitLabSecret,omitempty"`
 	// WebhookBitbucketUUID holds the UUID for authenticating Bitbucket webhook events
 	WebhookBitbucketUUID string `json:"webhookBitbucketUUID,omitempty"`
+	// ManageWebhooks indicates whether Argo CD registers its own webhook in the GitHub and GitLab
+	// repositories it tracks, using the credentials of the repositories
+	ManageWebhooks bool `json:"manageWebhooks,omitempty"`
+	// StatusBadgeEnabled enables the unauthenticated endpoint which renders the sync and health
+	// status of applications as SVG badges
+	StatusBadgeEnabled bool `json:"statusBadgeEnabled,omitempty"`
 	// Secrets holds all secrets in argocd-secret as a map[string]string
 	Secrets map[string]string `json:"secrets,omitempty"`
 	// Repositories holds list of configured git repositories
 	Repositories []RepoCredentials
+	// SelfManagement holds the source of Argo CD's own manifests. If set, Argo CD manages and
+	// reports on its own components as the "argocd" application.
+	SelfManagement *SelfManagementConfig `json:"selfManagement,omitempty"`
+	// ResourceRedactions holds the fields of resources which are masked in API responses. If nil,
+	// the data of secrets is masked.
+	ResourceRedactions []ResourceRedaction `json:"resourceRedactions,omitempty"`
+	// ResourceOrder holds the order in which resource kinds are applied within a sync wave. If nil,
+	// the built-in order of the controller is used.
+	ResourceOrder []string `json:"resourceOrder,omitempty"`
+	// ResourceApplyTimeouts holds the timeouts of applying, replacing and deleting resources of
+	// specific kinds during syncs. If nil, these calls do not time out.
+	ResourceApplyTimeouts []ResourceApplyTimeout `json:"resourceApplyTimeouts,omitempty"`
+	// ResourceExclusions holds the groups and kinds of resources, optionally per cluster, which are
+	// neither watched, compared nor pruned by the controller
+	ResourceExclusions []FilteredResource `json:"resourceExclusions,omitempty"`
+	// ResourceCustomizations holds the customizations of the resources of a group and kind, keyed by
+	// "group/kind", or by the kind of core resources
+	ResourceCustomizations map[string]ResourceCustomization `json:"resourceCustomizations,omitempty"`
+	// ServerLogLevel is the log level of the API server. If empty, the level of the --loglevel flag is used.
+	ServerLogLevel string `json:"serverLogLevel,omitempty"`
+	// ControllerLogLevel is the log level of the application controller. If empty, the level of the
+	// --loglevel flag is used.
+	ControllerLogLevel string `json:"controllerLogLevel,omitempty"`
+	// AppResyncPeriod is the period after which the controller compares applications with their
+	// target state again (e.g. "3m"). If empty, the period of the --app-resync flag is used.
+	AppResyncPeriod string `json:"appResyncPeriod,omitempty"`
+	// Policy holds the external policy service which is consulted before applications are synced.
+	// If nil, syncs are not checked against policies.
+	Policy *PolicyConfig `json:"policy,omitempty"`
+}
+
+// SelfManagementConfig describes the git source of Argo CD's own installation manifests
+type SelfManagementConfig struct {
+	RepoURL        string `json:"repoURL"`
+	Path           string `json:"path"`
+	TargetRevision string `json:"targetRevision,omitempty"`
+}
+
+// PolicyConfig describes the external policy service (e.g. Open Policy Agent) which decides whether
+// the rendered manifests of an application may be synced
+type PolicyConfig struct {
+	// URL is the endpoint the manifests are posted to, e.g. the data API of an OPA policy
+	URL string `json:"url"`
+	// FailOpen allows syncs if the policy service cannot be consulted. By default such syncs fail.
+	FailOpen bool `json:"failOpen,omitempty"`
+}
+
+// ResourceRedaction describes the fields of the resources of a kind which are masked in API responses
+type ResourceRedaction struct {
+	Group string `json:"group,omitempty"`
+	Kind  string `json:"kind"`
+	// Fields holds the dot separated paths of the masked fields. The values of a masked map are
+	// masked individually, so that its keys remain visible.
+	Fields []string `json:"fields"`
+}
+
+// ResourceApplyTimeout describes the timeout of the individual apply, replace and delete calls of the
+// resources of a kind during syncs
+type ResourceApplyTimeout struct {
+	// Group and Kind are glob patterns of the group and kind of the resources, e.g. '*'
+	Group string `json:"group,omitempty"`
+	Kind  string `json:"kind"`
+	// Timeout is the duration after which the call fails, e.g. 30s
+	Timeout string `json:"timeout"`
+}
+
+// FilteredResource describes the resources of some groups and kinds in some clusters. Each field holds
+// glob patterns (e.g. '*.k8s.io'), any of which has to match. An empty field matches everything.
+type FilteredResource struct {
+	APIGroups []string `json:"apiGroups,omitempty"`
+	Kinds     []string `json:"kinds,omitempty"`
+	// Clusters holds patterns of the API server URLs of the clusters
+	Clusters []string `json:"clusters,omitempty"`
+}
+
+// Match returns whether the resources of the group and kind in the cluster are described by the filter
+func (r FilteredResource) Match(group, kind, server string) bool {
+	return matchAny(r.APIGroups, group) && matchAny(r.Kinds, kind) && matchAny(r.Clusters, server)
+}
+
+// matchAny returns whether the value matches any of the glob patterns, or whether there are no patterns
+func matchAny(patterns []string, value string) bool {
+	if len(patterns) == 0 {
+		return true
+	}
+	for _, pattern := range patterns {
+		if ok, err := filepath.Match(pattern, value); err == nil && ok {
+			return true
+		}
+	}
+	return false
+}
+
+// ResourceCustomization customizes the handling of the resources of a group and kind by all applications
+type ResourceCustomization struct {
+	// IgnoreDifferences holds the fields which are ignored when diffing the resources, in addition to
+	// the ignored differences of each application
+	IgnoreDifferences *IgnoreDifferencesCustomization `json:"ignoreDifferences,omitempty"`
+	// IgnoreServerFields ignores the fields which are managed by Kubernetes rather than by the
+	// manifests (e.g. the creation timestamp and the status) when diffing the resources
+	IgnoreServerFields bool `json:"ignoreServerFields,omitempty"`
+	// NormalizerLua is a Lua script which normalizes the resources before they are diffed. The script
+	// gets the resource as the global 'obj' table, and returns the normalized resource.
+	NormalizerLua string `json:"normalizer.lua,omitempty"`
+}
+
+// IgnoreDifferencesCustomization lists the fields of resources which are ignored when diffing
+type IgnoreDifferencesCustomization struct {
+	// JSONPointers are the JSON pointers (RFC 6901) of the ignored fields. A "*" token matches every
+	// item of a list.
+	JSONPointers []string `json:"jsonPointers"`
+}
+
+// serverFieldsJSONPointers are the JSON pointers of the fields which are managed by Kubernetes, and
+// which are ignored by the resource customizations which ignore server fields
+var serverFieldsJSONPointers = []string{
+	"/metadata/creationTimestamp",
+	"/metadata/resourceVersion",
+	"/metadata/uid",
+	"/metadata/selfLink",
+	"/metadata/generation",
+	"/status",
+}
+
+// defaultResourceRedactions masks the data of secrets
+var defaultResourceRedactions = []ResourceRedaction{
+	{Kind: "Secret", Fields: []string{"data", "stringData"}},
 }
 
 type OIDCConfig struct {
@@ -70,6 +208,7 @@ type RepoCredentials struct {
 	UsernameSecret      *apiv1.SecretKeySelector `json:"usernameSecret,omitempty"`
 	PasswordSecret      *apiv1.SecretKeySelector `json:"passwordSecret,omitempty"`
 	SshPrivateKeySecret *apiv1.SecretKeySelector `json:"sshPrivateKeySecret,omitempty"`
+	Type                string                   `json:"type,omitempty"`
 }
 
 const (
@@ -97,6 +236,30 @@ const (
 	settingsWebhookGitLabSecretKey = "webhook.gitlab.secret"
 	// settingsWebhookBitbucketUUID is the key for Bitbucket webhook UUID
 	settingsWebhookBitbucketUUIDKey = "webhook.bitbucket.uuid"
+	// settingsManageWebhooksKey designates the key which enables management of repository webhooks
+	settingsManageWebhooksKey = "webhook.manage"
+	// statusBadgeEnabledKey designates the key which enables the status badges of applications
+	statusBadgeEnabledKey = "statusbadge.enabled"
+	// selfManagementKey designates the key where the source of Argo CD's own manifests is set
+	selfManagementKey = "selfManagement"
+	// resourceRedactionsKey designates the key where the fields of resources masked in API responses are set
+	resourceRedactionsKey = "resource.redactions"
+	// resourceOrderKey designates the key where the order in which resource kinds are applied is set
+	resourceOrderKey = "resource.order"
+	// resourceApplyTimeoutsKey designates the key where the timeouts of applying resources are set
+	resourceApplyTimeoutsKey = "resource.applyTimeouts"
+	// resourceExclusionsKey designates the key where the resources ignored by the controller are set
+	resourceExclusionsKey = "resource.exclusions"
+	// resourceCustomizationsKey designates the key where the customizations of resource kinds are set
+	resourceCustomizationsKey = "resource.customizations"
+	// serverLogLevelKey designates the key where the log level of the API server is set
+	serverLogLevelKey = "server.log.level"
+	// controllerLogLevelKey designates the key where the log level of the application controller is set
+	controllerLogLevelKey = "controller.log.level"
+	// controllerAppResyncKey designates the key where the application resync period of the controller is set
+	controllerAppResyncKey = "controller.app.resync"
+	// policyKey designates the key where the external policy service consulted before syncs is set
+	policyKey = "policy"
 )
 
 // SettingsManager holds config info for a new manager with which to access Kubernetes ConfigMaps.
@@ -186,6 +349,8 @@ func (mgr *SettingsManager) updateSettingsFromConfigMap(settings *ArgoCDSettings
 	settings.DexConfig = argoCDCM.Data[settingDexConfigKey]
 	settings.OIDCConfigRAW = argoCDCM.Data[settingsOIDCConfigKey]
 	settings.URL = argoCDCM.Data[settingURLKey]
+	settings.ManageWebhooks = argoCDCM.Data[settingsManageWebhooksKey] == "true"
+	settings.StatusBadgeEnabled = argoCDCM.Data[statusBadgeEnabledKey] == "true"
 	repositoriesStr := argoCDCM.Data[repositoriesKey]
 	if repositoriesStr != "" {
 		settings.Repositories = make([]RepoCredentials, 0)
@@ -194,6 +359,70 @@ func (mgr *SettingsManager) updateSettingsFromConfigMap(settings *ArgoCDSettings
 			return err
 		}
 	}
+	settings.SelfManagement = nil
+	selfManagementStr := argoCDCM.Data[selfManagementKey]
+	if selfManagementStr != "" {
+		var selfManagement SelfManagementConfig
+		err := yaml.Unmarshal([]byte(selfManagementStr), &selfManagement)
+		if err != nil {
+			return err
+		}
+		settings.SelfManagement = &selfManagement
+	}
+	settings.ResourceRedactions = nil
+	resourceRedactionsStr := argoCDCM.Data[resourceRedactionsKey]
+	if resourceRedactionsStr != "" {
+		settings.ResourceRedactions = make([]ResourceRedaction, 0)
+		err := yaml.Unmarshal([]byte(resourceRedactionsStr), &settings.ResourceRedactions)
+		if err != nil {
+			return err
+		}
+	}
+	settings.ResourceOrder = nil
+	resourceOrderStr := argoCDCM.Data[resourceOrderKey]
+	if resourceOrderStr != "" {
+		err := yaml.Unmarshal([]byte(resourceOrderStr), &settings.ResourceOrder)
+		if err != nil {
+			return err
+		}
+	}
+	settings.ResourceApplyTimeouts = nil
+	resourceApplyTimeoutsStr := argoCDCM.Data[resourceApplyTimeoutsKey]
+	if resourceApplyTimeoutsStr != "" {
+		err := yaml.Unmarshal([]byte(resourceApplyTimeoutsStr), &settings.ResourceApplyTimeouts)
+		if err != nil {
+			return err
+		}
+	}
+	settings.ResourceExclusions = nil
+	resourceExclusionsStr := argoCDCM.Data[resourceExclusionsKey]
+	if resourceExclusionsStr != "" {
+		err := yaml.Unmarshal([]byte(resourceExclusionsStr), &settings.ResourceExclusions)
+		if err != nil {
+			return err
+		}
+	}
+	settings.ResourceCustomizations = nil
+	resourceCustomizationsStr := argoCDCM.Data[resourceCustomizationsKey]
+	if resourceCustomizationsStr != "" {
+		err := yaml.Unmarshal([]byte(resourceCustomizationsStr), &settings.ResourceCustomizations)
+		if err != nil {
+			return err
+		}
+	}
+	settings.Policy = nil
+	policyStr := argoCDCM.Data[policyKey]
+	if policyStr != "" {
+		var policy PolicyConfig
+		err := yaml.Unmarshal([]byte(policyStr), &policy)
+		if err != nil {
+			return err
+		}
+		settings.Policy = &policy
+	}
+	settings.ServerLogLevel = argoCDCM.Data[serverLogLevelKey]
+	settings.ControllerLogLevel = argoCDCM.Data[controllerLogLevelKey]
+	settings.AppResyncPeriod = argoCDCM.Data[controllerAppResyncKey]
 	return nil
 }
 
@@ -278,6 +507,18 @@ func (mgr *SettingsManager) SaveSettings(settings *ArgoCDSettings) error {
 		delete(argoCDCM.Data, settingsOIDCConfigKey)
 	}
 
+	if settings.ManageWebhooks {
+		argoCDCM.Data[settingsManageWebhooksKey] = "true"
+	} else {
+		delete(argoCDCM.Data, settingsManageWebhooksKey)
+	}
+
+	if settings.StatusBadgeEnabled {
+		argoCDCM.Data[statusBadgeEnabledKey] = "true"
+	} else {
+		delete(argoCDCM.Data, statusBadgeEnabledKey)
+	}
+
 	if len(settings.Repositories) > 0 {
 		yamlStr, err := yaml.Marshal(settings.Repositories)
 		if err != nil {
@@ -288,6 +529,88 @@ func (mgr *SettingsManager) SaveSettings(settings *ArgoCDSettings) error {
 		delete(argoCDCM.Data, repositoriesKey)
 	}
 
+	if settings.SelfManagement != nil {
+		yamlStr, err := yaml.Marshal(settings.SelfManagement)
+		if err != nil {
+			return err
+		}
+		argoCDCM.Data[selfManagementKey] = string(yamlStr)
+	} else {
+		delete(argoCDCM.Data, selfManagementKey)
+	}
+
+	if settings.ResourceRedactions != nil {
+		yamlStr, err := yaml.Marshal(settings.ResourceRedactions)
+		if err != nil {
+			return err
+		}
+		argoCDCM.Data[resourceRedactionsKey] = string(yamlStr)
+	} else {
+		delete(argoCDCM.Data, resourceRedactionsKey)
+	}
+
+	if len(settings.ResourceOrder) > 0 {
+		yamlStr, err := yaml.Marshal(settings.ResourceOrder)
+		if err != nil {
+			return err
+		}
+		argoCDCM.Data[resourceOrderKey] = string(yamlStr)
+	} else {
+		delete(argoCDCM.Data, resourceOrderKey)
+	}
+
+	if len(settings.ResourceApplyTimeouts) > 0 {
+		yamlStr, err := yaml.Marshal(settings.ResourceApplyTimeouts)
+		if err != nil {
+			return err
+		}
+		argoCDCM.Data[resourceApplyTimeoutsKey] = string(yamlStr)
+	} else {
+		delete(argoCDCM.Data, resourceApplyTimeoutsKey)
+	}
+
+	if len(settings.ResourceExclusions) > 0 {
+		yamlStr, err := yaml.Marshal(settings.ResourceExclusions)
+		if err != nil {
+			return err
+		}
+		argoCDCM.Data[resourceExclusionsKey] = string(yamlStr)
+	} else {
+		delete(argoCDCM.Data, resourceExclusionsKey)
+	}
+
+	if len(settings.ResourceCustomizations) > 0 {
+		yamlStr, err := yaml.Marshal(settings.ResourceCustomizations)
+		if err != nil {
+			return err
+		}
+		argoCDCM.Data[resourceCustomizationsKey] = string(yamlStr)
+	} else {
+		delete(argoCDCM.Data, resourceCustomizationsKey)
+	}
+
+	if settings.Policy != nil {
+		yamlStr, err := yaml.Marshal(settings.Policy)
+		if err != nil {
+			return err
+		}
+		argoCDCM.Data[policyKey] = string(yamlStr)
+	} else {
+		delete(argoCDCM.Data, policyKey)
+	}
+
+	for key, value := range map[string]string{
+		serverLogLevelKey:      settings.ServerLogLevel,
+		controllerLogLevelKey:  settings.ControllerLogLevel,
+		controllerAppResyncKey: settings.AppResyncPeriod,
+	} {
+		if value != "" {
+			argoCDCM.Data[key] = value
+		} else {
+			delete(argoCDCM.Data, key)
+		}
+	}
+
 	if createCM {
 		_, err = mgr.clientset.CoreV1().ConfigMaps(mgr.namespace).Create(argoCDCM)
 	} else {
@@ -354,6 +677,104 @@ func NewSettingsManager(clientset kubernetes.Interface, namespace string) *Setti
 	}
 }
 
+// IsSelfManaged returns whether or not Argo CD is configured to manage its own components
+func (a *ArgoCDSettings) IsSelfManaged() bool {
+	return a.SelfManagement != nil && a.SelfManagement.RepoURL != "" && a.SelfManagement.Path != ""
+}
+
+// GetResourceRedactions returns the fields of resources which are masked in API responses
+func (a *ArgoCDSettings) GetResourceRedactions() []ResourceRedaction {
+	if a.ResourceRedactions == nil {
+		return defaultResourceRedactions
+	}
+	return a.ResourceRedactions
+}
+
+// IsExcludedResource returns whether the resources of the group and kind in the cluster are excluded
+// from the watches, comparisons and prunes of the controller
+func (a *ArgoCDSettings) IsExcludedResource(group, kind, server string) bool {
+	for _, exclusion := range a.ResourceExclusions {
+		if exclusion.Match(group, kind, server) {
+			return true
+		}
+	}
+	return false
+}
+
+// GetResourceIgnoreDifferences returns the fields of resources which are ignored when diffing the
+// resources of all applications, ordered by the group and kind of the resources. The customization
+// keyed by "*" applies to the resources of every kind.
+func (a *ArgoCDSettings) GetResourceIgnoreDifferences() ([]v1alpha1.ResourceIgnoreDifferences, error) {
+	keys := make([]string, 0, len(a.ResourceCustomizations))
+	for key := range a.ResourceCustomizations {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	var ignoreDifferences []v1alpha1.ResourceIgnoreDifferences
+	for _, key := range keys {
+		customization := a.ResourceCustomizations[key]
+		var jsonPointers []string
+		if customization.IgnoreDifferences != nil {
+			jsonPointers = append(jsonPointers, customization.IgnoreDifferences.JSONPointers...)
+		}
+		if customization.IgnoreServerFields {
+			jsonPointers = append(jsonPointers, serverFieldsJSONPointers...)
+		}
+		if len(jsonPointers) == 0 {
+			continue
+		}
+		group, kind, err := parseResourceCustomizationKey(key)
+		if err != nil {
+			return nil, err
+		}
+		ignoreDifferences = append(ignoreDifferences, v1alpha1.ResourceIgnoreDifferences{
+			Group:        group,
+			Kind:         kind,
+			JSONPointers: jsonPointers,
+		})
+	}
+	return ignoreDifferences, nil
+}
+
+// GetResourceNormalizerScripts returns the Lua scripts which normalize resources before they are
+// diffed, ordered by the group and kind of the resources
+func (a *ArgoCDSettings) GetResourceNormalizerScripts() ([]diff.NormalizerScript, error) {
+	keys := make([]string, 0, len(a.ResourceCustomizations))
+	for key := range a.ResourceCustomizations {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	var scripts []diff.NormalizerScript
+	for _, key := range keys {
+		customization := a.ResourceCustomizations[key]
+		if customization.NormalizerLua == "" {
+			continue
+		}
+		group, kind, err := parseResourceCustomizationKey(key)
+		if err != nil {
+			return nil, err
+		}
+		scripts = append(scripts, diff.NormalizerScript{
+			Group:  group,
+			Kind:   kind,
+			Script: customization.NormalizerLua,
+		})
+	}
+	return scripts, nil
+}
+
+// parseResourceCustomizationKey returns the group and kind of a key of the resource customizations
+func parseResourceCustomizationKey(key string) (string, string, error) {
+	group, kind := "", key
+	if i := strings.LastIndex(key, "/"); i >= 0 {
+		group, kind = key[:i], key[i+1:]
+	}
+	if kind == "" {
+		return "", "", fmt.Errorf("invalid resource customization key '%s': expected group/kind or kind", key)
+	}
+	return group, kind, nil
+}
+
 // IsSSOConfigured returns whether or not single-sign-on is configured
 func (a *ArgoCDSettings) IsSSOConfigured() bool {
 	if a.IsDexConfigured() {
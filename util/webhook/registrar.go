@@ -0,0 +1,235 @@
+package webhook
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+
+	"github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/util/git"
+	"github.com/argoproj/argo-cd/util/settings"
+)
+
+const (
+	githubAPIURL = "https://api.github.com"
+	// registrarTimeout is the maximum duration of a single request to the git provider API
+	registrarTimeout = 30 * time.Second
+)
+
+type gitProvider string
+
+const (
+	providerGitHub gitProvider = "github"
+	providerGitLab gitProvider = "gitlab"
+)
+
+// Registrar registers the Argo CD webhook in GitHub and GitLab repositories, so that push events
+// of tracked repositories are delivered to Argo CD without configuring the webhook manually
+type Registrar struct {
+	settings *settings.ArgoCDSettings
+	client   *http.Client
+}
+
+// NewRegistrar returns a new webhook registrar
+func NewRegistrar(set *settings.ArgoCDSettings) *Registrar {
+	return &Registrar{
+		settings: set,
+		client:   &http.Client{Timeout: registrarTimeout},
+	}
+}
+
+// Enabled returns whether management of repository webhooks is enabled in the settings
+func (r *Registrar) Enabled() bool {
+	return r != nil && r.settings.ManageWebhooks
+}
+
+// RegisterWebhooks registers the webhook of every repository which is hosted in a supported git
+// provider and has credentials. Failures are logged and do not prevent other registrations.
+func (r *Registrar) RegisterWebhooks(repos []*v1alpha1.Repository) {
+	for _, repo := range repos {
+		if !isManageable(repo) {
+			log.Debugf("Skipping webhook registration of repository %s", repo.Repo)
+			continue
+		}
+		err := r.RegisterWebhook(repo)
+		if err != nil {
+			log.Warnf("Failed to register webhook of repository %s: %v", repo.Repo, err)
+		}
+	}
+}
+
+// RegisterWebhook creates the Argo CD webhook in the repository, or refreshes the webhook if it
+// already exists. The password of the repository is used as the API token of the git provider.
+func (r *Registrar) RegisterWebhook(repo *v1alpha1.Repository) error {
+	if r.settings.URL == "" {
+		return fmt.Errorf("url of Argo CD must be configured to register webhooks")
+	}
+	if repo.Password == "" {
+		return fmt.Errorf("repository %s has no credentials to register webhooks with", repo.Repo)
+	}
+	provider, apiURL, project, err := parseRepoURL(repo.Repo)
+	if err != nil {
+		return err
+	}
+	hookURL := strings.TrimSuffix(r.settings.URL, "/") + "/api/webhook"
+	switch provider {
+	case providerGitHub:
+		return r.registerGitHubWebhook(apiURL, project, repo.Password, hookURL)
+	case providerGitLab:
+		return r.registerGitLabWebhook(apiURL, project, repo.Password, hookURL)
+	}
+	return fmt.Errorf("unsupported git provider of repository %s", repo.Repo)
+}
+
+// isManageable returns whether the webhook of the repository can be registered by Argo CD
+func isManageable(repo *v1alpha1.Repository) bool {
+	if repo.Password == "" {
+		return false
+	}
+	_, _, _, err := parseRepoURL(repo.Repo)
+	return err == nil
+}
+
+// parseRepoURL returns the git provider of a repository, the base URL of the provider API and the
+// path of the repository project
+func parseRepoURL(repoURL string) (gitProvider, string, string, error) {
+	if git.IsSSHURL(repoURL) {
+		return "", "", "", fmt.Errorf("webhooks can only be registered for HTTPS repositories")
+	}
+	parsed, err := url.Parse(repoURL)
+	if err != nil {
+		return "", "", "", err
+	}
+	host := strings.ToLower(parsed.Host)
+	project := strings.TrimSuffix(strings.Trim(parsed.Path, "/"), ".git")
+	if project == "" {
+		return "", "", "", fmt.Errorf("repository url %s has no project path", repoURL)
+	}
+	switch {
+	case host == "github.com":
+		return providerGitHub, githubAPIURL, project, nil
+	case strings.Contains(host, "gitlab"):
+		return providerGitLab, fmt.Sprintf("%s://%s/api/v4", parsed.Scheme, parsed.Host), project, nil
+	}
+	return "", "", "", fmt.Errorf("unsupported git provider %s", parsed.Host)
+}
+
+type githubHookConfig struct {
+	URL         string `json:"url"`
+	ContentType string `json:"content_type,omitempty"`
+	Secret      string `json:"secret,omitempty"`
+}
+
+type githubHook struct {
+	ID     int64            `json:"id,omitempty"`
+	Name   string           `json:"name,omitempty"`
+	Active bool             `json:"active"`
+	Events []string         `json:"events,omitempty"`
+	Config githubHookConfig `json:"config"`
+}
+
+// registerGitHubWebhook creates or updates the webhook of a GitHub repository.
+// See: https://developer.github.com/v3/repos/hooks/
+func (r *Registrar) registerGitHubWebhook(apiURL, project, token, hookURL string) error {
+	setAuth := func(req *http.Request) {
+		req.Header.Set("Authorization", "token "+token)
+	}
+	hooksURL := fmt.Sprintf("%s/repos/%s/hooks", apiURL, project)
+	var hooks []githubHook
+	err := r.doRequest(http.MethodGet, hooksURL, setAuth, nil, &hooks)
+	if err != nil {
+		return err
+	}
+	hook := githubHook{
+		Active: true,
+		Events: []string{"push"},
+		Config: githubHookConfig{URL: hookURL, ContentType: "json", Secret: r.settings.WebhookGitHubSecret},
+	}
+	for _, existing := range hooks {
+		if existing.Config.URL == hookURL {
+			log.Infof("Updating webhook %d of GitHub repository %s", existing.ID, project)
+			return r.doRequest(http.MethodPatch, fmt.Sprintf("%s/%d", hooksURL, existing.ID), setAuth, hook, nil)
+		}
+	}
+	log.Infof("Creating webhook of GitHub repository %s", project)
+	hook.Name = "web"
+	return r.doRequest(http.MethodPost, hooksURL, setAuth, hook, nil)
+}
+
+type gitlabHook struct {
+	ID                    int64  `json:"id,omitempty"`
+	URL                   string `json:"url"`
+	PushEvents            bool   `json:"push_events"`
+	TagPushEvents         bool   `json:"tag_push_events"`
+	Token                 string `json:"token,omitempty"`
+	EnableSSLVerification bool   `json:"enable_ssl_verification"`
+}
+
+// registerGitLabWebhook creates or updates the webhook of a GitLab project.
+// See: https://docs.gitlab.com/ee/api/projects.html#hooks
+func (r *Registrar) registerGitLabWebhook(apiURL, project, token, hookURL string) error {
+	setAuth := func(req *http.Request) {
+		req.Header.Set("Private-Token", token)
+	}
+	hooksURL := fmt.Sprintf("%s/projects/%s/hooks", apiURL, url.PathEscape(project))
+	var hooks []gitlabHook
+	err := r.doRequest(http.MethodGet, hooksURL, setAuth, nil, &hooks)
+	if err != nil {
+		return err
+	}
+	hook := gitlabHook{
+		URL:                   hookURL,
+		PushEvents:            true,
+		TagPushEvents:         true,
+		Token:                 r.settings.WebhookGitLabSecret,
+		EnableSSLVerification: true,
+	}
+	for _, existing := range hooks {
+		if existing.URL == hookURL {
+			log.Infof("Updating webhook %d of GitLab project %s", existing.ID, project)
+			return r.doRequest(http.MethodPut, fmt.Sprintf("%s/%d", hooksURL, existing.ID), setAuth, hook, nil)
+		}
+	}
+	log.Infof("Creating webhook of GitLab project %s", project)
+	return r.doRequest(http.MethodPost, hooksURL, setAuth, hook, nil)
+}
+
+// doRequest sends a JSON request to the git provider API and decodes the response into result
+func (r *Registrar) doRequest(method, reqURL string, setAuth func(*http.Request), body interface{}, result interface{}) error {
+	var reqBody bytes.Buffer
+	if body != nil {
+		err := json.NewEncoder(&reqBody).Encode(body)
+		if err != nil {
+			return err
+		}
+	}
+	req, err := http.NewRequest(method, reqURL, &reqBody)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	setAuth(req)
+	resp, err := r.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = resp.Body.Close() }()
+	respBody, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("%s %s failed: %s", method, req.URL.Path, resp.Status)
+	}
+	if result == nil {
+		return nil
+	}
+	return json.Unmarshal(respBody, result)
+}
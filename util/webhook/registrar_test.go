@@ -0,0 +1,123 @@
+package webhook
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/util/settings"
+)
+
+// redirectTransport sends all requests to the test server, regardless of their host
+type redirectTransport struct {
+	server *httptest.Server
+}
+
+func (t *redirectTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	serverURL, err := url.Parse(t.server.URL)
+	if err != nil {
+		return nil, err
+	}
+	req.URL.Scheme = serverURL.Scheme
+	req.URL.Host = serverURL.Host
+	return http.DefaultTransport.RoundTrip(req)
+}
+
+func newTestRegistrar(server *httptest.Server) *Registrar {
+	r := NewRegistrar(&settings.ArgoCDSettings{
+		URL:                 "https://argocd.example.com/",
+		ManageWebhooks:      true,
+		WebhookGitHubSecret: "github-secret",
+		WebhookGitLabSecret: "gitlab-secret",
+	})
+	r.client = &http.Client{Transport: &redirectTransport{server: server}}
+	return r
+}
+
+func TestParseRepoURL(t *testing.T) {
+	provider, apiURL, project, err := parseRepoURL("https://github.com/argoproj/argocd-example-apps.git")
+	assert.NoError(t, err)
+	assert.Equal(t, providerGitHub, provider)
+	assert.Equal(t, githubAPIURL, apiURL)
+	assert.Equal(t, "argoproj/argocd-example-apps", project)
+
+	provider, apiURL, project, err = parseRepoURL("https://gitlab.example.com/group/subgroup/project")
+	assert.NoError(t, err)
+	assert.Equal(t, providerGitLab, provider)
+	assert.Equal(t, "https://gitlab.example.com/api/v4", apiURL)
+	assert.Equal(t, "group/subgroup/project", project)
+
+	_, _, _, err = parseRepoURL("git@github.com:argoproj/argocd-example-apps.git")
+	assert.Error(t, err)
+	_, _, _, err = parseRepoURL("https://bitbucket.org/argoproj/argocd-example-apps.git")
+	assert.Error(t, err)
+}
+
+func TestRegisterGitHubWebhook(t *testing.T) {
+	var created githubHook
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		assert.Equal(t, "token my-token", req.Header.Get("Authorization"))
+		assert.Equal(t, "/repos/argoproj/argocd-example-apps/hooks", req.URL.Path)
+		switch req.Method {
+		case http.MethodGet:
+			_, _ = w.Write([]byte(`[{"id":1,"config":{"url":"https://ci.example.com/hook"}}]`))
+		case http.MethodPost:
+			assert.NoError(t, json.NewDecoder(req.Body).Decode(&created))
+			w.WriteHeader(http.StatusCreated)
+		default:
+			w.WriteHeader(http.StatusMethodNotAllowed)
+		}
+	}))
+	defer server.Close()
+
+	r := newTestRegistrar(server)
+	err := r.RegisterWebhook(&v1alpha1.Repository{Repo: "https://github.com/argoproj/argocd-example-apps.git", Password: "my-token"})
+	assert.NoError(t, err)
+	assert.Equal(t, "web", created.Name)
+	assert.Equal(t, []string{"push"}, created.Events)
+	assert.Equal(t, "https://argocd.example.com/api/webhook", created.Config.URL)
+	assert.Equal(t, "json", created.Config.ContentType)
+	assert.Equal(t, "github-secret", created.Config.Secret)
+}
+
+func TestRefreshGitLabWebhook(t *testing.T) {
+	var updated gitlabHook
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		assert.Equal(t, "my-token", req.Header.Get("Private-Token"))
+		switch {
+		case req.Method == http.MethodGet && req.URL.EscapedPath() == "/api/v4/projects/group%2Fproject/hooks":
+			_, _ = w.Write([]byte(`[{"id":7,"url":"https://argocd.example.com/api/webhook"}]`))
+		case req.Method == http.MethodPut && req.URL.EscapedPath() == "/api/v4/projects/group%2Fproject/hooks/7":
+			assert.NoError(t, json.NewDecoder(req.Body).Decode(&updated))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	r := newTestRegistrar(server)
+	err := r.RegisterWebhook(&v1alpha1.Repository{Repo: "https://gitlab.example.com/group/project.git", Password: "my-token"})
+	assert.NoError(t, err)
+	assert.Equal(t, "https://argocd.example.com/api/webhook", updated.URL)
+	assert.True(t, updated.PushEvents)
+	assert.Equal(t, "gitlab-secret", updated.Token)
+}
+
+func TestRegisterWebhookFailure(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		w.WriteHeader(http.StatusForbidden)
+	}))
+	defer server.Close()
+
+	r := newTestRegistrar(server)
+	err := r.RegisterWebhook(&v1alpha1.Repository{Repo: "https://github.com/argoproj/argocd-example-apps.git", Password: "my-token"})
+	assert.Error(t, err)
+
+	err = r.RegisterWebhook(&v1alpha1.Repository{Repo: "https://github.com/argoproj/argocd-example-apps.git"})
+	assert.Error(t, err)
+}